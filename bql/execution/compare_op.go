@@ -0,0 +1,60 @@
+package execution
+
+import (
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// CompareOp evaluates a comparison operator, i.e. parser.Equal,
+// parser.NotEqual, parser.Less, parser.LessOrEqual, parser.Greater, or
+// parser.GreaterOrEqual, with two values and returns data.True or
+// data.False. As in SQL, the result is Null when either of the operands is
+// Null.
+//
+// parser.Equal and parser.NotEqual use data.Equal, so values of any types
+// can be compared for equality and values of different non-numeric types
+// aren't equal. The other operators order the values by data.Compare, so an
+// Int and a Float can be compared with each other, and Arrays are compared
+// element-wise. CompareOp returns an error when the values cannot be
+// ordered, e.g. when they are Maps, have different non-numeric types, or one
+// of them is NaN, or when op isn't a comparison operator.
+func CompareOp(op parser.Operator, a, b data.Value) (data.Value, error) {
+	switch op {
+	case parser.Equal, parser.NotEqual, parser.Less, parser.LessOrEqual,
+		parser.Greater, parser.GreaterOrEqual:
+	default:
+		return nil, fmt.Errorf("%v isn't a comparison operator", op)
+	}
+
+	// NULL propagation
+	if a.Type() == data.TypeNull || b.Type() == data.TypeNull {
+		return data.Null{}, nil
+	}
+
+	// equality is checked in the same way as the evaluator of "=" and "!="
+	switch op {
+	case parser.Equal:
+		return data.Bool(data.Equal(a, b)), nil
+	case parser.NotEqual:
+		return data.Bool(!data.Equal(a, b)), nil
+	}
+
+	c, err := data.Compare(a, b)
+	if err != nil {
+		return nil, err
+	}
+
+	var res bool
+	switch op {
+	case parser.Less:
+		res = c < 0
+	case parser.LessOrEqual:
+		res = c <= 0
+	case parser.Greater:
+		res = c > 0
+	case parser.GreaterOrEqual:
+		res = c >= 0
+	}
+	return data.Bool(res), nil
+}
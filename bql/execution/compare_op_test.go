@@ -0,0 +1,142 @@
+package execution
+
+import (
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"math"
+	"testing"
+)
+
+func TestCompareOp(t *testing.T) {
+	ops := []parser.Operator{parser.Equal, parser.NotEqual, parser.Less,
+		parser.LessOrEqual, parser.Greater, parser.GreaterOrEqual}
+
+	Convey("Given pairs of comparable values", t, func() {
+		cases := []struct {
+			a, b data.Value
+			// expected results in the order of ops
+			expected []data.Bool
+		}{
+			{data.Int(1), data.Int(2), []data.Bool{false, true, true, true, false, false}},
+			{data.Int(2), data.Int(2), []data.Bool{true, false, false, true, false, true}},
+			{data.Float(2.5), data.Int(2), []data.Bool{false, true, false, false, true, true}},
+			{data.Int(2), data.Float(2.0), []data.Bool{true, false, false, true, false, true}},
+			{data.String("a"), data.String("b"), []data.Bool{false, true, true, true, false, false}},
+			{data.False, data.True, []data.Bool{false, true, true, true, false, false}},
+			{data.Array{data.Int(1), data.Int(2)}, data.Array{data.Int(1)}, []data.Bool{false, true, false, false, true, true}},
+		}
+
+		for _, c := range cases {
+			for i, op := range ops {
+				Convey(fmt.Sprintf("When evaluating %v %v %v", c.a, op, c.b), func() {
+					res, err := CompareOp(op, c.a, c.b)
+
+					Convey(fmt.Sprintf("Then the result should be %v", c.expected[i]), func() {
+						So(err, ShouldBeNil)
+						So(res, ShouldEqual, c.expected[i])
+					})
+				})
+			}
+		}
+	})
+
+	Convey("Given Maps", t, func() {
+		m1 := data.Map{"a": data.Int(1)}
+		m2 := data.Map{"a": data.Float(1)}
+		m3 := data.Map{"a": data.Int(2)}
+
+		Convey("When comparing them for equality", func() {
+			eq, err1 := CompareOp(parser.Equal, m1, m2)
+			ne, err2 := CompareOp(parser.NotEqual, m1, m3)
+
+			Convey("Then it should succeed", func() {
+				So(err1, ShouldBeNil)
+				So(eq, ShouldEqual, data.True)
+				So(err2, ShouldBeNil)
+				So(ne, ShouldEqual, data.True)
+			})
+		})
+
+		Convey("When ordering them", func() {
+			_, err := CompareOp(parser.Less, m1, m3)
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+
+	Convey("Given a NULL operand", t, func() {
+		for _, op := range ops {
+			Convey(fmt.Sprintf("When evaluating %v with it", op), func() {
+				res1, err1 := CompareOp(op, data.Null{}, data.Int(1))
+				res2, err2 := CompareOp(op, data.String("a"), data.Null{})
+				res3, err3 := CompareOp(op, data.Null{}, data.Null{})
+
+				Convey("Then the result should be NULL", func() {
+					So(err1, ShouldBeNil)
+					So(res1, ShouldResemble, data.Null{})
+					So(err2, ShouldBeNil)
+					So(res2, ShouldResemble, data.Null{})
+					So(err3, ShouldBeNil)
+					So(res3, ShouldResemble, data.Null{})
+				})
+			})
+		}
+	})
+
+	Convey("Given values of different types", t, func() {
+		a, b := data.Int(1), data.String("a")
+
+		Convey("When comparing them for equality", func() {
+			eq, err1 := CompareOp(parser.Equal, a, b)
+			ne, err2 := CompareOp(parser.NotEqual, a, b)
+
+			Convey("Then they should be different", func() {
+				So(err1, ShouldBeNil)
+				So(eq, ShouldEqual, data.False)
+				So(err2, ShouldBeNil)
+				So(ne, ShouldEqual, data.True)
+			})
+		})
+	})
+
+	Convey("Given incomparable values", t, func() {
+		Convey("When ordering values of different types", func() {
+			_, err := CompareOp(parser.Less, data.Int(1), data.String("1"))
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "cannot compare")
+			})
+		})
+
+		Convey("When ordering NaN", func() {
+			_, err := CompareOp(parser.Less, data.Float(math.NaN()), data.Float(1))
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When comparing NaN for equality", func() {
+			eq, err := CompareOp(parser.Equal, data.Float(math.NaN()), data.Float(1))
+
+			Convey("Then they should be different", func() {
+				So(err, ShouldBeNil)
+				So(eq, ShouldEqual, data.False)
+			})
+		})
+
+		Convey("When evaluating a non-comparison operator", func() {
+			_, err := CompareOp(parser.Plus, data.Int(1), data.Int(2))
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "isn't a comparison operator")
+			})
+		})
+	})
+}
@@ -0,0 +1,30 @@
+package execution
+
+import (
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// Eval evaluates an expression obtained by the BQL parser, such as a
+// BinaryOpAST, against a tuple. A column without a relation name (e.g. "a")
+// is looked up in the tuple directly and a column with a relation name (e.g.
+// "s:a") is looked up as tuple["s"]["a"]. Functions are looked up in the
+// global UDF registry. Aggregate functions cannot be used.
+//
+// Eval converts the expression to an Evaluator every time it's called, so
+// ParserExprToFlatExpr and ExpressionToEvaluator should be used instead when
+// the same expression is evaluated many times.
+func Eval(expr parser.Expression, tuple data.Map) (data.Value, error) {
+	reg := udf.CopyGlobalUDFRegistry(core.NewContext(nil))
+	flatExpr, err := ParserExprToFlatExpr(expr, reg)
+	if err != nil {
+		return nil, err
+	}
+	eval, err := ExpressionToEvaluator(flatExpr, reg)
+	if err != nil {
+		return nil, err
+	}
+	return eval.Eval(tuple)
+}
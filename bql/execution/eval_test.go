@@ -0,0 +1,103 @@
+package execution
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"testing"
+)
+
+func TestEval(t *testing.T) {
+	Convey("Given a sample tuple", t, func() {
+		tuple := data.Map{
+			"a": data.Int(2),
+			"b": data.Float(1.5),
+			"c": data.Null{},
+			"s": data.Map{
+				"d": data.Int(5),
+			},
+		}
+
+		Convey("When evaluating a + b", func() {
+			v, err := Eval(parser.BinaryOpAST{parser.Plus,
+				parser.RowValue{"", "a"}, parser.RowValue{"", "b"}}, tuple)
+
+			Convey("Then it should return the sum", func() {
+				So(err, ShouldBeNil)
+				So(v, ShouldResemble, data.Float(3.5))
+			})
+		})
+
+		Convey("When evaluating a > 3", func() {
+			v, err := Eval(parser.BinaryOpAST{parser.Greater,
+				parser.RowValue{"", "a"}, parser.NumericLiteral{3}}, tuple)
+
+			Convey("Then it should return false", func() {
+				So(err, ShouldBeNil)
+				So(v, ShouldResemble, data.Bool(false))
+			})
+		})
+
+		Convey("When evaluating s:d > 3", func() {
+			v, err := Eval(parser.BinaryOpAST{parser.Greater,
+				parser.RowValue{"s", "d"}, parser.NumericLiteral{3}}, tuple)
+
+			Convey("Then it should return true", func() {
+				So(err, ShouldBeNil)
+				So(v, ShouldResemble, data.Bool(true))
+			})
+		})
+
+		Convey("When evaluating an expression having NULL", func() {
+			v, err := Eval(parser.BinaryOpAST{parser.Multiply,
+				parser.BinaryOpAST{parser.Plus, parser.RowValue{"", "a"}, parser.RowValue{"", "c"}},
+				parser.NumericLiteral{2}}, tuple)
+
+			Convey("Then NULL should be propagated", func() {
+				So(err, ShouldBeNil)
+				So(v, ShouldResemble, data.Null{})
+			})
+		})
+
+		Convey("When evaluating a function call", func() {
+			v, err := Eval(parser.FuncAppAST{parser.FuncName("abs"),
+				parser.ExpressionsAST{[]parser.Expression{
+					parser.UnaryOpAST{parser.UnaryMinus, parser.RowValue{"", "a"}},
+				}}, nil}, tuple)
+
+			Convey("Then it should use the global UDF registry", func() {
+				So(err, ShouldBeNil)
+				So(v, ShouldResemble, data.Int(2))
+			})
+		})
+
+		Convey("When evaluating a missing column", func() {
+			_, err := Eval(parser.RowValue{"", "x"}, tuple)
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When evaluating an unbound placeholder", func() {
+			_, err := Eval(parser.BinaryOpAST{parser.Plus,
+				parser.RowValue{"", "a"}, parser.ParamAST{"1", true}}, tuple)
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "must be bound")
+			})
+		})
+
+		Convey("When evaluating an aggregate function", func() {
+			_, err := Eval(parser.FuncAppAST{parser.FuncName("count"),
+				parser.ExpressionsAST{[]parser.Expression{
+					parser.RowValue{"", "a"},
+				}}, nil}, tuple)
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
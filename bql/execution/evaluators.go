@@ -149,8 +149,16 @@ func ExpressionToEvaluator(ast FlatExpression, reg udf.FunctionRegistry) (Evalua
 			return newGreaterOrnewEqual(bo), nil
 		case parser.NotEqual:
 			return newNot(newEqual(bo)), nil
+		case parser.In:
+			return &in{bo}, nil
+		case parser.NotIn:
+			return newNot(&in{bo}), nil
 		case parser.Concat:
 			return &concat{bo}, nil
+		case parser.Regex:
+			return &regexMatch{bo}, nil
+		case parser.NotRegex:
+			return newNot(&regexMatch{bo}), nil
 		case parser.Is:
 			// at the moment there is only NULL allowed after IS,
 			// but maybe we want to allow other types later on
@@ -163,6 +171,10 @@ func ExpressionToEvaluator(ast FlatExpression, reg udf.FunctionRegistry) (Evalua
 			if obj.Right == (nullLiteral{}) {
 				return newNot(newIsNull(left)), nil
 			}
+		case parser.IsDistinctFrom:
+			return newIsDistinctFrom(bo), nil
+		case parser.IsNotDistinctFrom:
+			return newNot(newIsDistinctFrom(bo)), nil
 		case parser.Plus:
 			return newPlus(bo), nil
 		case parser.Minus:
@@ -748,6 +760,70 @@ func newNotEqual(bo binOp) Evaluator {
 	return newNot(newEqual(bo))
 }
 
+// in checks whether the left value is equal to one of the elements
+// of the array that the right side evaluates to. As in SQL, the
+// result is NULL if there is no match, but a NULL candidate. Since rows
+// are evaluated like arrays, an array is compared with an array candidate
+// element by element as a row: a NULL element makes the comparison
+// unknown, which also results in NULL if there is no match.
+type in struct {
+	binOp
+}
+
+func (i *in) Eval(input data.Value) (data.Value, error) {
+	leftVal, rightVal, err := i.evalLeftAndRight(input)
+	if err != nil {
+		return nil, err
+	}
+	// NULL propagation
+	if leftVal.Type() == data.TypeNull {
+		return data.Null{}, nil
+	}
+	candidates, err := data.AsArray(rightVal)
+	if err != nil {
+		return nil, fmt.Errorf("right operand of IN must be a list: %v", rightVal)
+	}
+	leftRow, leftIsRow := leftVal.(data.Array)
+	foundNull := false
+	for _, c := range candidates {
+		if c.Type() == data.TypeNull {
+			foundNull = true
+			continue
+		}
+		if row, ok := c.(data.Array); ok && leftIsRow {
+			equal, unknown := rowEqual(leftRow, row)
+			if equal {
+				return data.Bool(true), nil
+			}
+			if unknown {
+				foundNull = true
+			}
+		} else if data.Equal(leftVal, c) {
+			return data.Bool(true), nil
+		}
+	}
+	if foundNull {
+		return data.Null{}, nil
+	}
+	return data.Bool(false), nil
+}
+
+// rowEqual compares two rows element by element. unknown is true when the
+// rows have NULL elements and they are equal except for those elements.
+func rowEqual(l, r data.Array) (equal, unknown bool) {
+	if len(l) != len(r) {
+		return false, false
+	}
+	for i := range l {
+		if l[i].Type() == data.TypeNull || r[i].Type() == data.TypeNull {
+			unknown = true
+		} else if !data.Equal(l[i], r[i]) {
+			return false, false
+		}
+	}
+	return !unknown, unknown
+}
+
 /// A Unary Comparison Operation
 
 type isNull struct {
@@ -766,6 +842,30 @@ func newIsNull(e Evaluator) Evaluator {
 	return &isNull{e}
 }
 
+// isDistinctFrom is a NULL-safe inequality check. Unlike `!=`, it never
+// results in NULL: two NULLs are not distinct and NULL is distinct from
+// any other value.
+type isDistinctFrom struct {
+	binOp
+}
+
+func (d *isDistinctFrom) Eval(input data.Value) (data.Value, error) {
+	leftVal, rightVal, err := d.evalLeftAndRight(input)
+	if err != nil {
+		return nil, err
+	}
+	leftNull := leftVal.Type() == data.TypeNull
+	rightNull := rightVal.Type() == data.TypeNull
+	if leftNull || rightNull {
+		return data.Bool(leftNull != rightNull), nil
+	}
+	return data.Bool(!data.Equal(leftVal, rightVal)), nil
+}
+
+func newIsDistinctFrom(bo binOp) Evaluator {
+	return &isDistinctFrom{bo}
+}
+
 /// Binary Numerical Operations
 
 // numBinOp provides functionality for evaluating binary operations
@@ -916,6 +1016,37 @@ func (nbo *concat) Eval(input data.Value) (v data.Value, err error) {
 	return data.String(leftString + rightString), nil
 }
 
+// regexMatch checks whether the left operand contains a match of the
+// regular expression given as the right operand.
+type regexMatch struct {
+	binOp
+}
+
+func (rm *regexMatch) Eval(input data.Value) (data.Value, error) {
+	// evalate both sides
+	leftVal, rightVal, err := rm.evalLeftAndRight(input)
+	if err != nil {
+		return nil, err
+	}
+	// NULL propagation
+	if leftVal.Type() == data.TypeNull || rightVal.Type() == data.TypeNull {
+		return data.Null{}, nil
+	}
+	s, err := data.AsString(leftVal)
+	if err != nil {
+		return nil, fmt.Errorf("left operand of ~ must be string: %v", leftVal)
+	}
+	pattern, err := data.AsString(rightVal)
+	if err != nil {
+		return nil, fmt.Errorf("right operand of ~ must be string: %v", rightVal)
+	}
+	m, err := data.RegexMatch(s, pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid regular expression: %v", err)
+	}
+	return data.Bool(m), nil
+}
+
 /// Function Evaluation
 
 type funcApp struct {
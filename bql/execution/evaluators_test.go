@@ -122,6 +122,10 @@ func TestFoldableExecution(t *testing.T) {
 			false, nil},
 		{parser.BinaryOpAST{parser.Concat, parser.StringLiteral{"7"}, parser.StringLiteral{"b"}},
 			true, data.String("7b")},
+		{parser.BinaryOpAST{parser.Regex, parser.RowValue{"", "a"}, parser.StringLiteral{"b"}},
+			false, nil},
+		{parser.BinaryOpAST{parser.NotRegex, parser.StringLiteral{"abc"}, parser.StringLiteral{"^b"}},
+			true, data.Bool(true)},
 		// Other
 		{parser.AliasAST{parser.RowValue{"", "a"}, "hoge"},
 			false, nil},
@@ -335,6 +339,153 @@ func TestFuncAppConversion(t *testing.T) {
 	})
 }
 
+func TestCoalesceEvaluation(t *testing.T) {
+	Convey("Given the default function registry", t, func() {
+		reg := udf.CopyGlobalUDFRegistry(core.NewContext(nil))
+
+		Convey("When converting a three-argument COALESCE", func() {
+			ast := parser.FuncAppAST{parser.FuncName("COALESCE"),
+				parser.ExpressionsAST{[]parser.Expression{
+					parser.RowValue{"", "a"},
+					parser.RowValue{"", "b"},
+					parser.StringLiteral{"default"},
+				}}, nil}
+			flatExpr, err := ParserExprToFlatExpr(ast, reg)
+			So(err, ShouldBeNil)
+			eval, err := ExpressionToEvaluator(flatExpr, reg)
+			So(err, ShouldBeNil)
+
+			Convey("Then it should return the first non-NULL argument", func() {
+				inputs := []struct {
+					input    data.Map
+					expected data.Value
+				}{
+					{data.Map{"a": data.Int(1), "b": data.Int(2)}, data.Int(1)},
+					{data.Map{"a": data.Null{}, "b": data.Int(2)}, data.Int(2)},
+					{data.Map{"a": data.Null{}, "b": data.Null{}}, data.String("default")},
+					{data.Map{"a": data.Bool(false), "b": data.Null{}}, data.Bool(false)},
+				}
+				for _, in := range inputs {
+					actual, err := eval.Eval(in.input)
+					So(err, ShouldBeNil)
+					So(actual, ShouldResemble, in.expected)
+				}
+			})
+		})
+
+		Convey("When all arguments of COALESCE are NULL", func() {
+			ast := parser.FuncAppAST{parser.FuncName("coalesce"),
+				parser.ExpressionsAST{[]parser.Expression{
+					parser.RowValue{"", "a"},
+					parser.NullLiteral{},
+				}}, nil}
+			flatExpr, err := ParserExprToFlatExpr(ast, reg)
+			So(err, ShouldBeNil)
+			eval, err := ExpressionToEvaluator(flatExpr, reg)
+			So(err, ShouldBeNil)
+
+			Convey("Then it should return NULL", func() {
+				actual, err := eval.Eval(data.Map{"a": data.Null{}})
+				So(err, ShouldBeNil)
+				So(actual, ShouldResemble, data.Null{})
+			})
+		})
+	})
+}
+
+func TestNullIfEvaluation(t *testing.T) {
+	Convey("Given the default function registry", t, func() {
+		reg := udf.CopyGlobalUDFRegistry(core.NewContext(nil))
+
+		Convey("When converting NULLIF with two arguments", func() {
+			ast := parser.FuncAppAST{parser.FuncName("NULLIF"),
+				parser.ExpressionsAST{[]parser.Expression{
+					parser.RowValue{"", "a"},
+					parser.StringLiteral{""},
+				}}, nil}
+			flatExpr, err := ParserExprToFlatExpr(ast, reg)
+			So(err, ShouldBeNil)
+			eval, err := ExpressionToEvaluator(flatExpr, reg)
+			So(err, ShouldBeNil)
+
+			Convey("Then it should return NULL only for equal arguments", func() {
+				actual, err := eval.Eval(data.Map{"a": data.String("")})
+				So(err, ShouldBeNil)
+				So(actual, ShouldResemble, data.Null{})
+
+				actual, err = eval.Eval(data.Map{"a": data.String("x")})
+				So(err, ShouldBeNil)
+				So(actual, ShouldResemble, data.String("x"))
+			})
+		})
+
+		Convey("When converting NULLIF with a wrong number of arguments", func() {
+			ast := parser.FuncAppAST{parser.FuncName("NULLIF"),
+				parser.ExpressionsAST{[]parser.Expression{
+					parser.RowValue{"", "a"},
+				}}, nil}
+
+			Convey("Then converting to an Evaluator fails", func() {
+				_, err := ParserExprToFlatExpr(ast, reg)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "is not 1-ary")
+			})
+		})
+	})
+}
+
+// countingEvaluator returns a constant value and counts how many times it
+// has been evaluated.
+type countingEvaluator struct {
+	value data.Value
+	count int
+}
+
+func (c *countingEvaluator) Eval(input data.Value) (data.Value, error) {
+	c.count++
+	return c.value, nil
+}
+
+func TestBooleanShortCircuit(t *testing.T) {
+	Convey("Given AND and OR evaluators", t, func() {
+		Convey("When the left side of AND is false", func() {
+			right := &countingEvaluator{value: data.Bool(true)}
+			eval := &and{binOp{&countingEvaluator{value: data.Bool(false)}, right}}
+			actual, err := eval.Eval(data.Map{})
+
+			Convey("Then the right side should not be evaluated", func() {
+				So(err, ShouldBeNil)
+				So(actual, ShouldResemble, data.Bool(false))
+				So(right.count, ShouldEqual, 0)
+			})
+		})
+
+		Convey("When the left side of OR is true", func() {
+			right := &countingEvaluator{value: data.Bool(false)}
+			eval := &or{binOp{&countingEvaluator{value: data.Bool(true)}, right}}
+			actual, err := eval.Eval(data.Map{})
+
+			Convey("Then the right side should not be evaluated", func() {
+				So(err, ShouldBeNil)
+				So(actual, ShouldResemble, data.Bool(true))
+				So(right.count, ShouldEqual, 0)
+			})
+		})
+
+		Convey("When the left side of AND is NULL", func() {
+			right := &countingEvaluator{value: data.Bool(false)}
+			eval := &and{binOp{&countingEvaluator{value: data.Null{}}, right}}
+			actual, err := eval.Eval(data.Map{})
+
+			Convey("Then the right side should be evaluated", func() {
+				So(err, ShouldBeNil)
+				So(actual, ShouldResemble, data.Bool(false))
+				So(right.count, ShouldEqual, 1)
+			})
+		})
+	})
+}
+
 func TestAggFuncAppConversion(t *testing.T) {
 	reg := udf.CopyGlobalUDFRegistry(core.NewContext(nil))
 
@@ -1480,6 +1631,157 @@ func getTestCases() []struct {
 					"b": data.String("b")}, data.String("ab")},
 			}, nullOps...),
 		},
+		// Regular expressions
+		{parser.BinaryOpAST{parser.Regex, parser.RowValue{"", "a"}, parser.RowValue{"", "b"}},
+			append([]evalTest{
+				// not a map:
+				{data.Int(17), nil},
+				// keys not present:
+				{data.Map{"x": data.Int(17)}, nil},
+				// only one side present => error
+				{data.Map{"a": data.String("abc")}, nil},
+				{data.Map{"b": data.String("b")}, nil},
+				// non-string operands => error
+				{data.Map{"a": data.Int(1),
+					"b": data.String("1")}, nil},
+				{data.Map{"a": data.String("1"),
+					"b": data.Int(1)}, nil},
+				// invalid pattern => error
+				{data.Map{"a": data.String("abc"),
+					"b": data.String("a(b")}, nil},
+				// match and non-match
+				{data.Map{"a": data.String("abc"),
+					"b": data.String("b")}, data.Bool(true)},
+				{data.Map{"a": data.String("abc"),
+					"b": data.String("^b")}, data.Bool(false)},
+				{data.Map{"a": data.String("日本語"),
+					"b": data.String("^日.語$")}, data.Bool(true)},
+			}, nullOps...),
+		},
+		{parser.BinaryOpAST{parser.NotRegex, parser.RowValue{"", "a"}, parser.RowValue{"", "b"}},
+			append([]evalTest{
+				{data.Map{"a": data.String("abc"),
+					"b": data.String("a(b")}, nil},
+				{data.Map{"a": data.String("abc"),
+					"b": data.String("b")}, data.Bool(false)},
+				{data.Map{"a": data.String("abc"),
+					"b": data.String("^b")}, data.Bool(true)},
+			}, nullOps...),
+		},
+		// In
+		{parser.BinaryOpAST{parser.In, parser.RowValue{"", "a"},
+			parser.RowAST{parser.ExpressionsAST{[]parser.Expression{
+				parser.NumericLiteral{1}, parser.RowValue{"", "b"}}}}},
+			[]evalTest{
+				// not a map:
+				{data.Int(17), nil},
+				// keys not present:
+				{data.Map{"x": data.Int(17)}, nil},
+				// left or right missing => error
+				{data.Map{"a": data.Int(1)}, nil},
+				{data.Map{"b": data.Int(1)}, nil},
+				// left is one of the candidates => true
+				{data.Map{"a": data.Int(1),
+					"b": data.Int(2)}, data.Bool(true)},
+				{data.Map{"a": data.Int(2),
+					"b": data.Int(2)}, data.Bool(true)},
+				{data.Map{"a": data.Array{data.Int(2)},
+					"b": data.Array{data.Int(2)}}, data.Bool(true)},
+				{data.Map{"a": data.Int(1),
+					"b": data.Null{}}, data.Bool(true)},
+				// left is none of the candidates => false
+				{data.Map{"a": data.Int(3),
+					"b": data.Int(2)}, data.Bool(false)},
+				{data.Map{"a": data.String("1"),
+					"b": data.Int(2)}, data.Bool(false)},
+				// NULL propagation
+				{data.Map{"a": data.Null{},
+					"b": data.Int(2)}, data.Null{}},
+				{data.Map{"a": data.Int(3),
+					"b": data.Null{}}, data.Null{}},
+			},
+		},
+		{parser.BinaryOpAST{parser.NotIn,
+			parser.RowAST{parser.ExpressionsAST{[]parser.Expression{
+				parser.RowValue{"", "a"}, parser.RowValue{"", "b"}}}},
+			parser.RowAST{parser.ExpressionsAST{[]parser.Expression{
+				parser.RowAST{parser.ExpressionsAST{[]parser.Expression{
+					parser.NumericLiteral{1}, parser.NumericLiteral{2}}}},
+				parser.RowAST{parser.ExpressionsAST{[]parser.Expression{
+					parser.NumericLiteral{3}, parser.NumericLiteral{4}}}}}}}},
+			[]evalTest{
+				// keys not present:
+				{data.Map{"x": data.Int(17)}, nil},
+				// row is one of the candidates => false
+				{data.Map{"a": data.Int(1),
+					"b": data.Int(2)}, data.Bool(false)},
+				{data.Map{"a": data.Int(3),
+					"b": data.Int(4)}, data.Bool(false)},
+				// row is none of the candidates => true
+				{data.Map{"a": data.Int(1),
+					"b": data.Int(4)}, data.Bool(true)},
+				{data.Map{"a": data.Int(2),
+					"b": data.Int(1)}, data.Bool(true)},
+			},
+		},
+		{parser.BinaryOpAST{parser.In,
+			parser.RowAST{parser.ExpressionsAST{[]parser.Expression{
+				parser.RowValue{"", "a"}, parser.RowValue{"", "b"}}}},
+			parser.RowAST{parser.ExpressionsAST{[]parser.Expression{
+				parser.RowAST{parser.ExpressionsAST{[]parser.Expression{
+					parser.NumericLiteral{1}, parser.NumericLiteral{2}}}},
+				parser.RowAST{parser.ExpressionsAST{[]parser.Expression{
+					parser.NumericLiteral{3}, parser.NullLiteral{}}}}}}}},
+			[]evalTest{
+				// row is one of the candidates => true
+				{data.Map{"a": data.Int(1),
+					"b": data.Int(2)}, data.Bool(true)},
+				// no match, but a comparison with NULL elements => NULL
+				{data.Map{"a": data.Null{},
+					"b": data.Int(2)}, data.Null{}},
+				{data.Map{"a": data.Int(3),
+					"b": data.Int(4)}, data.Null{}},
+				// a different non-NULL element decides the comparison
+				{data.Map{"a": data.Int(5),
+					"b": data.Null{}}, data.Bool(false)},
+				// no match => false
+				{data.Map{"a": data.Int(5),
+					"b": data.Int(4)}, data.Bool(false)},
+			},
+		},
+		// (NULL, 2) IN ((NULL, 2)) => NULL
+		{parser.BinaryOpAST{parser.In,
+			parser.RowAST{parser.ExpressionsAST{[]parser.Expression{
+				parser.NullLiteral{}, parser.NumericLiteral{2}}}},
+			parser.RowAST{parser.ExpressionsAST{[]parser.Expression{
+				parser.RowAST{parser.ExpressionsAST{[]parser.Expression{
+					parser.NullLiteral{}, parser.NumericLiteral{2}}}}}}}},
+			[]evalTest{
+				{data.Map{}, data.Null{}},
+			},
+		},
+		// (1, 2) IN ((1, NULL)) => NULL
+		{parser.BinaryOpAST{parser.In,
+			parser.RowAST{parser.ExpressionsAST{[]parser.Expression{
+				parser.NumericLiteral{1}, parser.NumericLiteral{2}}}},
+			parser.RowAST{parser.ExpressionsAST{[]parser.Expression{
+				parser.RowAST{parser.ExpressionsAST{[]parser.Expression{
+					parser.NumericLiteral{1}, parser.NullLiteral{}}}}}}}},
+			[]evalTest{
+				{data.Map{}, data.Null{}},
+			},
+		},
+		// (1, 2) NOT IN ((1, NULL)) => NULL
+		{parser.BinaryOpAST{parser.NotIn,
+			parser.RowAST{parser.ExpressionsAST{[]parser.Expression{
+				parser.NumericLiteral{1}, parser.NumericLiteral{2}}}},
+			parser.RowAST{parser.ExpressionsAST{[]parser.Expression{
+				parser.RowAST{parser.ExpressionsAST{[]parser.Expression{
+					parser.NumericLiteral{1}, parser.NullLiteral{}}}}}}}},
+			[]evalTest{
+				{data.Map{}, data.Null{}},
+			},
+		},
 		// IsNull
 		{parser.BinaryOpAST{parser.Is, parser.RowValue{"", "a"}, parser.NullLiteral{}},
 			[]evalTest{
@@ -1546,6 +1848,41 @@ func getTestCases() []struct {
 				{data.Map{"a": data.Null{}}, data.Bool(true)},
 			},
 		},
+		// IsDistinctFrom
+		{parser.BinaryOpAST{parser.IsDistinctFrom, parser.RowValue{"", "a"}, parser.RowValue{"", "b"}},
+			[]evalTest{
+				// not a map:
+				{data.Int(17), nil},
+				// keys not present:
+				{data.Map{"a": data.Int(17)}, nil},
+				// both null => false
+				{data.Map{"a": data.Null{}, "b": data.Null{}}, data.Bool(false)},
+				// one of them null => true
+				{data.Map{"a": data.Null{}, "b": data.Int(1)}, data.Bool(true)},
+				{data.Map{"a": data.Int(1), "b": data.Null{}}, data.Bool(true)},
+				// neither null => same as !=
+				{data.Map{"a": data.Int(1), "b": data.Float(1.0)}, data.Bool(false)},
+				{data.Map{"a": data.Int(1), "b": data.Int(2)}, data.Bool(true)},
+				{data.Map{"a": data.String("x"), "b": data.Int(2)}, data.Bool(true)},
+			},
+		},
+		// IsNotDistinctFrom
+		{parser.BinaryOpAST{parser.IsNotDistinctFrom, parser.RowValue{"", "a"}, parser.RowValue{"", "b"}},
+			[]evalTest{
+				// not a map:
+				{data.Int(17), nil},
+				// keys not present:
+				{data.Map{"a": data.Int(17)}, nil},
+				// both null => true
+				{data.Map{"a": data.Null{}, "b": data.Null{}}, data.Bool(true)},
+				// one of them null => false
+				{data.Map{"a": data.Null{}, "b": data.Int(1)}, data.Bool(false)},
+				{data.Map{"a": data.Int(1), "b": data.Null{}}, data.Bool(false)},
+				// neither null => same as =
+				{data.Map{"a": data.Int(1), "b": data.Float(1.0)}, data.Bool(true)},
+				{data.Map{"a": data.Int(1), "b": data.Int(2)}, data.Bool(false)},
+			},
+		},
 		/// Computational Operations
 		// Plus
 		{parser.BinaryOpAST{parser.Plus, parser.RowValue{"", "a"}, parser.RowValue{"", "b"}},
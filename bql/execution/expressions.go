@@ -19,6 +19,25 @@ type aliasedExpression struct {
 	aggrInputs map[string]FlatExpression
 }
 
+// aggregateInFlatExprError is returned by ParserExprToFlatExpr when the
+// expression contains an aggregate function. Callers replace it with an
+// error describing where aggregates are not allowed.
+type aggregateInFlatExprError struct {
+	function string
+}
+
+func (e *aggregateInFlatExprError) Error() string {
+	return fmt.Sprintf("you cannot use aggregate function '%s' "+
+		"in a flat expression", e.function)
+}
+
+// isAggregateInFlatExprError returns true when err is returned because an
+// aggregate function is used in a flat expression.
+func isAggregateInFlatExprError(err error) bool {
+	_, ok := err.(*aggregateInFlatExprError)
+	return ok
+}
+
 // Explanation of the Aggregation Workflow
 // ---------------------------------------
 // For a SELECT or CREATE STREAM FROM SELECT statement, we deal mostly
@@ -102,6 +121,9 @@ func ParserExprToFlatExpr(e parser.Expression, reg udf.FunctionRegistry) (FlatEx
 		return boolLiteral{obj.Value}, nil
 	case parser.StringLiteral:
 		return stringLiteral{obj.Value}, nil
+	case parser.IntervalLiteral:
+		// intervals are represented as seconds, see data.ToDuration
+		return floatLiteral{obj.Value.Seconds()}, nil
 	case parser.BinaryOpAST:
 		// recurse left
 		left, err := ParserExprToFlatExpr(obj.Left, reg)
@@ -169,9 +191,7 @@ func ParserExprToFlatExpr(e parser.Expression, reg udf.FunctionRegistry) (FlatEx
 		}
 		// fail if this is an aggregate function
 		if isAggregateFunc(function, len(obj.Expressions)) {
-			err := fmt.Errorf("you cannot use aggregate function '%s' "+
-				"in a flat expression", obj.Function)
-			return nil, err
+			return nil, &aggregateInFlatExprError{string(obj.Function)}
 		} else if len(obj.Ordering) > 0 {
 			err := fmt.Errorf("you cannot use ORDER BY in non-aggregate "+
 				"function '%s'", obj.Function)
@@ -198,6 +218,9 @@ func ParserExprToFlatExpr(e parser.Expression, reg udf.FunctionRegistry) (FlatEx
 			exprs[i] = expr
 		}
 		return arrayAST{exprs}, nil
+	case parser.RowAST:
+		// rows are evaluated like arrays
+		return ParserExprToFlatExpr(parser.ArrayAST{obj.ExpressionsAST}, reg)
 	case parser.MapAST:
 		// compute child expressions
 		pairs := make([]keyValuePair, len(obj.Entries))
@@ -247,6 +270,8 @@ func ParserExprToFlatExpr(e parser.Expression, reg udf.FunctionRegistry) (FlatEx
 		return caseAST{ref, c.Checks, c.Default}, nil
 	case parser.Wildcard:
 		return wildcardAST{obj.Relation}, nil
+	case parser.ParamAST:
+		return nil, fmt.Errorf("placeholder %v must be bound before execution", obj)
 	}
 	err := fmt.Errorf("don't know how to convert type %#v", e)
 	return nil, err
@@ -358,7 +383,7 @@ func ParserExprToMaybeAggregate(e parser.Expression, aggIdx int, reg udf.Functio
 				expr, err := ParserExprToFlatExpr(ast, reg)
 				if err != nil {
 					// return a prettier error message
-					if strings.HasPrefix(err.Error(), "you cannot use aggregate") {
+					if isAggregateInFlatExprError(err) {
 						err = fmt.Errorf("aggregate functions cannot be nested")
 					}
 					return nil, nil, err
@@ -401,7 +426,7 @@ func ParserExprToMaybeAggregate(e parser.Expression, aggIdx int, reg udf.Functio
 					expr, err := ParserExprToFlatExpr(sortExpr.Expr, reg)
 					if err != nil {
 						// return a prettier error message
-						if strings.HasPrefix(err.Error(), "you cannot use aggregate") {
+						if isAggregateInFlatExprError(err) {
 							err = fmt.Errorf("aggregate functions cannot be used in ORDER BY")
 						}
 						return nil, nil, err
@@ -477,6 +502,9 @@ func ParserExprToMaybeAggregate(e parser.Expression, aggIdx int, reg udf.Functio
 			returnAgg = nil
 		}
 		return arrayAST{exprs}, returnAgg, nil
+	case parser.RowAST:
+		// rows are evaluated like arrays
+		return ParserExprToMaybeAggregate(parser.ArrayAST{obj.ExpressionsAST}, aggIdx, reg)
 	case parser.MapAST:
 		// compute child expressions
 		pairs := make([]keyValuePair, len(obj.Entries))
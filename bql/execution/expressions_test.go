@@ -28,6 +28,8 @@ func TestFlatExpressionConverter(t *testing.T) {
 		`"bql"`: {stringLiteral{"bql"}, Immutable, false, nil},
 		"*":     {wildcardAST{}, Stable, true, nil},
 		"x:*":   {wildcardAST{"x"}, Stable, true, nil},
+
+		`INTERVAL "1.5 minutes"`: {floatLiteral{90}, Immutable, false, nil},
 		// Type Cast
 		"CAST(2 AS FLOAT)": {typeCastAST{numericLiteral{2}, parser.Float}, Immutable, false, nil},
 		// Function Application
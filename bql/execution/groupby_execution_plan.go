@@ -223,12 +223,9 @@ func (ep *groupbyExecutionPlan) performQueryOnBuffer() error {
 				// a NULL value is definitely not "true", so since we
 				// have only a binary decision, we should drop tuples
 				// where the condition evaluates to NULL
-				havingResultBool := false
-				if havingResult.Type() != data.TypeNull {
-					havingResultBool, err = data.AsBool(havingResult)
-					if err != nil {
-						return err
-					}
+				havingResultBool, err := data.AsBoolStrict(havingResult)
+				if err != nil {
+					return err
 				}
 				// if it evaluated to false, do not further process this group
 				if !havingResultBool {
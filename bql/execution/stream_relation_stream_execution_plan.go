@@ -577,12 +577,9 @@ func (ep *streamRelationStreamExecutionPlan) preprocCartProdInt(dataHolder data.
 			// a NULL value is definitely not "true", so since we
 			// have only a binary decision, we should drop tuples
 			// where the filter condition evaluates to NULL
-			filterResultBool := false
-			if filterResult.Type() != data.TypeNull {
-				filterResultBool, err = data.AsBool(filterResult)
-				if err != nil {
-					return err
-				}
+			filterResultBool, err := data.AsBoolStrict(filterResult)
+			if err != nil {
+				return err
 			}
 			// if it evaluated to false, do not further process this tuple
 			if !filterResultBool {
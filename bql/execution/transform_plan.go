@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math"
 	"regexp"
+	"sort"
 	"strings"
 
 	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
@@ -90,6 +91,10 @@ func Analyze(s parser.SelectStmt, reg udf.FunctionRegistry) (*LogicalPlan, error
 	   >   compatible types.
 	*/
 
+	if err := resolveGroupPositions(&s); err != nil {
+		return nil, err
+	}
+
 	if err := makeRelationAliases(&s); err != nil {
 		return nil, err
 	}
@@ -118,18 +123,33 @@ func isAggregateFunc(f udf.UDF, arity int) bool {
 // part in a statement and returns with an error if there are
 // aggregates in structures that may not have some
 func flattenExpressions(s *parser.SelectStmt, reg udf.FunctionRegistry) (*LogicalPlan, error) {
+	lp, errs := flattenAllExpressions(s, reg)
+	if len(errs) > 0 {
+		return nil, errs[0]
+	}
+	return lp, nil
+}
+
+// flattenAllExpressions does the same as flattenExpressions but doesn't
+// stop at the first problem. It returns all errors in the order in which
+// flattenExpressions would find them, and the LogicalPlan only when there
+// is no error.
+func flattenAllExpressions(s *parser.SelectStmt, reg udf.FunctionRegistry) (*LogicalPlan, []error) {
+	var errs []error
+
 	// groupingMode is active when aggregate functions or the
 	// GROUP BY clause are used
 	groupingMode := false
 
-	flatProjExprs := make([]aliasedExpression, len(s.Projections))
+	flatProjExprs := make([]aliasedExpression, 0, len(s.Projections))
 	numAggParams := 0
 	for i, expr := range s.Projections {
 		// convert the parser Expression to a FlatExpression
 		flatExpr, aggrs, err := ParserExprToMaybeAggregate(expr, numAggParams, reg)
 		numAggParams += len(aggrs)
 		if err != nil {
-			return nil, err
+			errs = append(errs, err)
+			continue
 		}
 		// remember if we have aggregates at all
 		if len(aggrs) > 0 {
@@ -174,19 +194,20 @@ func flattenExpressions(s *parser.SelectStmt, reg udf.FunctionRegistry) (*Logica
 			// Evaluator.Eval interface.
 			colHeader = "*"
 		}
-		flatProjExprs[i] = aliasedExpression{colHeader, flatExpr, aggrs}
+		flatProjExprs = append(flatProjExprs, aliasedExpression{colHeader, flatExpr, aggrs})
 	}
 
 	if s.Having != nil {
 		// convert the parser Expression to a FlatExpression
 		flatExpr, aggrs, err := ParserExprToMaybeAggregate(s.Having, numAggParams, reg)
 		if err != nil {
-			return nil, err
+			errs = append(errs, err)
+		} else {
+			// use a special column name
+			colHeader := ":having:"
+			flatProjExprs = append(flatProjExprs,
+				aliasedExpression{colHeader, flatExpr, aggrs})
 		}
-		// use a special column name
-		colHeader := ":having:"
-		flatProjExprs = append(flatProjExprs,
-			aliasedExpression{colHeader, flatExpr, aggrs})
 		// we are definitely in grouping mode
 		groupingMode = true
 	}
@@ -196,52 +217,53 @@ func flattenExpressions(s *parser.SelectStmt, reg udf.FunctionRegistry) (*Logica
 		filterFlatExpr, err := ParserExprToFlatExpr(s.Filter, reg)
 		if err != nil {
 			// return a prettier error message
-			if strings.HasPrefix(err.Error(), "you cannot use aggregate") {
+			if isAggregateInFlatExprError(err) {
 				err = fmt.Errorf("aggregates not allowed in WHERE clause")
 			}
-			return nil, err
+			errs = append(errs, err)
 		}
 		filterExpr = filterFlatExpr
 	}
 
-	groupCols := make([]rowValue, len(s.GroupList))
-	flatGroupExprs := make([]FlatExpression, len(s.GroupList))
-	for i, expr := range s.GroupList {
+	groupCols := make([]rowValue, 0, len(s.GroupList))
+	flatGroupExprs := make([]FlatExpression, 0, len(s.GroupList))
+	for _, expr := range s.GroupList {
 		// convert the parser Expression to a FlatExpression
 		flatExpr, err := ParserExprToFlatExpr(expr, reg)
 		if err != nil {
 			// return a prettier error message
-			if strings.HasPrefix(err.Error(), "you cannot use aggregate") {
+			if isAggregateInFlatExprError(err) {
 				err = fmt.Errorf("aggregates not allowed in GROUP BY clause")
 			}
-			return nil, err
+			errs = append(errs, err)
+			continue
 		}
 		// at the moment we only support grouping by single columns,
 		// not expressions
 		col, ok := flatExpr.(rowValue)
 		if !ok {
-			err := fmt.Errorf("grouping by expressions is not supported yet")
-			return nil, err
+			errs = append(errs, fmt.Errorf("grouping by expressions is not supported yet"))
+			continue
 		}
-		groupCols[i] = col
-		flatGroupExprs[i] = flatExpr
+		groupCols = append(groupCols, col)
+		flatGroupExprs = append(flatGroupExprs, flatExpr)
 	}
-	groupingMode = groupingMode || len(flatGroupExprs) > 0
+	groupingMode = groupingMode || len(s.GroupList) > 0
 
 	// check if grouping is done correctly
 	if groupingMode {
+		// each column is only reported once
+		reported := map[rowValue]bool{}
 		for _, expr := range flatProjExprs {
 			// the wildcard operator cannot be used with GROUP BY
 			if expr.expr.ContainsWildcard() {
-				err := fmt.Errorf("* cannot be used in GROUP BY statements")
-				return nil, err
+				errs = append(errs, fmt.Errorf("* cannot be used in GROUP BY statements"))
 			}
 			// all columns mentioned outside of an aggregate
 			// function must be in the GROUP BY clause
 			if rm, ok := expr.expr.(rowMeta); ok {
-				err := fmt.Errorf("using metadata '%s' in GROUP BY statements is "+
-					"not supported yet", rm.MetaType)
-				return nil, err
+				errs = append(errs, fmt.Errorf("using metadata '%s' in GROUP BY statements is "+
+					"not supported yet", rm.MetaType))
 			}
 			usedCols := expr.expr.Columns()
 			for _, usedCol := range usedCols {
@@ -253,10 +275,10 @@ func flattenExpressions(s *parser.SelectStmt, reg udf.FunctionRegistry) (*Logica
 						break
 					}
 				}
-				if !mentioned {
-					err := fmt.Errorf("column \"%s\" must appear in the GROUP BY "+
-						"clause or be used in an aggregate function", usedCol.Repr())
-					return nil, err
+				if !mentioned && !reported[usedCol] {
+					reported[usedCol] = true
+					errs = append(errs, fmt.Errorf("column \"%s\" must appear in the GROUP BY "+
+						"clause or be used in an aggregate function", usedCol.Repr()))
 				}
 			}
 		}
@@ -269,41 +291,47 @@ func flattenExpressions(s *parser.SelectStmt, reg udf.FunctionRegistry) (*Logica
 	for _, opt := range s.EmitterAST.EmitterOptions {
 		switch obj := opt.(type) {
 		default:
-			return nil, fmt.Errorf("unknown emitter options: %+v", obj)
+			errs = append(errs, fmt.Errorf("unknown emitter options: %+v", obj))
 		case parser.EmitterLimit:
 			l := obj.Limit
 			if l < 0 {
-				return nil, fmt.Errorf("LIMIT parameter must have a "+
-					"positive value, not %d", l)
+				errs = append(errs, fmt.Errorf("LIMIT parameter must have a "+
+					"positive value, not %d", l))
+				continue
 			}
 			emitLimit = l
 		case parser.EmitterSampling:
 			v := obj.Value
 			switch obj.Type {
 			default:
-				return nil, fmt.Errorf("unknown emitter sampling type: %+v", obj.Type)
+				errs = append(errs, fmt.Errorf("unknown emitter sampling type: %+v", obj.Type))
+				continue
 			case parser.CountBasedSampling:
 				if v <= 0 {
-					return nil, fmt.Errorf("EVERY parameter must have a "+
-						"positive value, not %d", v)
+					errs = append(errs, fmt.Errorf("EVERY parameter must have a "+
+						"positive value, not %d", v))
+					continue
 				}
 				if math.Trunc(v) != v {
 					// this should be prevented by the parser, but better
 					// check here again
-					return nil, fmt.Errorf("EVERY parameter must have an "+
-						"integral value for TUPLE, not %d", v)
+					errs = append(errs, fmt.Errorf("EVERY parameter must have an "+
+						"integral value for TUPLE, not %d", v))
+					continue
 				}
 				emitSampling = v
 			case parser.TimeBasedSampling:
 				if v <= 0 {
-					return nil, fmt.Errorf("EVERY parameter must have a "+
-						"positive value, not %d", v)
+					errs = append(errs, fmt.Errorf("EVERY parameter must have a "+
+						"positive value, not %d", v))
+					continue
 				}
 				emitSampling = v
 			case parser.RandomizedSampling:
 				if v < 0 || v > 100 {
-					return nil, fmt.Errorf("SAMPLE parameter must have a "+
-						"value between 0 and 100, not %d", v)
+					errs = append(errs, fmt.Errorf("SAMPLE parameter must have a "+
+						"value between 0 and 100, not %d", v))
+					continue
 				}
 				emitSampling = v / 100 // project to [0,1] interval
 			}
@@ -311,6 +339,9 @@ func flattenExpressions(s *parser.SelectStmt, reg udf.FunctionRegistry) (*Logica
 		}
 	}
 
+	if len(errs) > 0 {
+		return nil, errs
+	}
 	return &LogicalPlan{
 		groupingMode,
 		s.EmitterAST.EmitterType,
@@ -349,11 +380,51 @@ func makeRelationAliases(s *parser.SelectStmt) error {
 	return nil
 }
 
+// resolveGroupPositions replaces integer literals in the GROUP BY clause
+// with the projections they refer to, as in `SELECT a, count(b) FROM s
+// GROUP BY 1`. Positions start from 1 and an alias in a projection is
+// ignored, so the statement above is grouped by a. It returns an error
+// when a position is out of the range of the projections.
+func resolveGroupPositions(s *parser.SelectStmt) error {
+	var newGroup []parser.Expression
+	for i, group := range s.GroupList {
+		pos, ok := group.(parser.NumericLiteral)
+		if !ok {
+			continue
+		}
+		if pos.Value < 1 || pos.Value > int64(len(s.Projections)) {
+			return fmt.Errorf("GROUP BY position %d is not in select list", pos.Value)
+		}
+		if newGroup == nil {
+			newGroup = make([]parser.Expression, len(s.GroupList))
+			copy(newGroup, s.GroupList)
+		}
+		proj := s.Projections[pos.Value-1]
+		if alias, ok := proj.(parser.AliasAST); ok {
+			proj = alias.Expr
+		}
+		newGroup[i] = proj
+	}
+	if newGroup != nil {
+		s.GroupList = newGroup
+	}
+	return nil
+}
+
 // validateReferences checks if the references to input relations
 // in SELECT, WHERE, GROUP BY and HAVING clauses of the given
 // statement are matching the relations mentioned in the FROM
 // clause.
 func validateReferences(s *parser.SelectStmt) error {
+	if errs := referenceErrors(s); len(errs) > 0 {
+		return errs[0]
+	}
+	return nil
+}
+
+// referenceErrors does the same as validateReferences but returns all
+// problems found in the statement.
+func referenceErrors(s *parser.SelectStmt) []error {
 
 	/* We want to check if we can access all relations properly.
 	   If there is just one input relation, we ask that none of the
@@ -387,12 +458,20 @@ func validateReferences(s *parser.SelectStmt) error {
 			refRels[rel] = true
 		}
 	}
+	// sort the relations to report them in a stable order
+	sortedRels := make([]string, 0, len(refRels))
+	for rel := range refRels {
+		sortedRels = append(sortedRels, rel)
+	}
+	sort.Strings(sortedRels)
+
+	var errs []error
 
 	// do the correctness check for SELECT, WHERE, GROUP BY clauses
 	if len(s.Relations) == 0 {
 		// Sample: SELECT a (no FROM clause)
 		// this case should never happen due to parser setup
-		return fmt.Errorf("need at least one relation to select from")
+		return []error{fmt.Errorf("need at least one relation to select from")}
 
 	} else if len(s.Relations) == 1 {
 		inputRel := s.Relations[0].Alias
@@ -400,52 +479,52 @@ func validateReferences(s *parser.SelectStmt) error {
 			// Sample: SELECT a FROM b // SELECT b.a FROM b
 			// check if the one map item is either "" or the name of
 			// the input relation
-			for rel := range refRels {
-				if rel != "" && rel != inputRel {
-					err := fmt.Errorf("cannot refer to relation '%s' "+
-						"when using only '%s'", rel, inputRel)
-					return err
+			if rel := sortedRels[0]; rel != "" && rel != inputRel {
+				errs = append(errs, fmt.Errorf("cannot refer to relation '%s' "+
+					"when using only '%s'", rel, inputRel))
+			} else {
+				// we need to make the references more explicit,
+				// i.e., change all "" references to the name
+				// of the only input relation
+				newProjs := make([]parser.Expression, len(s.Projections))
+				for i, proj := range s.Projections {
+					newProjs[i] = proj.RenameReferencedRelation("", inputRel)
+				}
+				s.Projections = newProjs
+				if s.Filter != nil {
+					s.Filter = s.Filter.RenameReferencedRelation("", inputRel)
+				}
+				newGroup := make([]parser.Expression, len(s.GroupList))
+				for i, group := range s.GroupList {
+					newGroup[i] = group.RenameReferencedRelation("", inputRel)
+				}
+				s.GroupList = newGroup
+				if s.Having != nil {
+					s.Having = s.Having.RenameReferencedRelation("", inputRel)
 				}
-			}
-			// we need to make the references more explicit,
-			// i.e., change all "" references to the name
-			// of the only input relation
-			newProjs := make([]parser.Expression, len(s.Projections))
-			for i, proj := range s.Projections {
-				newProjs[i] = proj.RenameReferencedRelation("", inputRel)
-			}
-			s.Projections = newProjs
-			if s.Filter != nil {
-				s.Filter = s.Filter.RenameReferencedRelation("", inputRel)
-			}
-			newGroup := make([]parser.Expression, len(s.GroupList))
-			for i, group := range s.GroupList {
-				newGroup[i] = group.RenameReferencedRelation("", inputRel)
-			}
-			s.GroupList = newGroup
-			if s.Having != nil {
-				s.Having = s.Having.RenameReferencedRelation("", inputRel)
 			}
 
 		} else if len(refRels) > 1 {
 			// Sample: SELECT a, b.a FROM b // SELECT b.a, x.a FROM b
 			// this is an invalid statement
 			failRels := make([]string, 0, len(refRels))
-			for rel := range refRels {
+			for _, rel := range sortedRels {
 				failRels = append(failRels, fmt.Sprintf("'%s'", rel))
 			}
 			failRelsStr := strings.Join(failRels, ", ")
-			err := fmt.Errorf("cannot refer to relations %s "+
-				"when using only '%s'", failRelsStr, inputRel)
-			return err
+			errs = append(errs, fmt.Errorf("cannot refer to relations %s "+
+				"when using only '%s'", failRelsStr, inputRel))
 		}
-		// if we arrive here, the only referenced relation is valid or
-		// we do not actually reference anything
 
 	} else if len(s.Relations) > 1 {
 		// Sample: SELECT b.a, c.d FROM b, c
 		// check if all referenced relations are actually listed in FROM
-		for rel := range refRels {
+		for _, rel := range sortedRels {
+			if rel == "" {
+				errs = append(errs, fmt.Errorf("column references must specify "+
+					"a relation when using multiple input relations"))
+				continue
+			}
 			found := false
 			for _, inputRel := range s.Relations {
 				if rel == inputRel.Alias {
@@ -459,50 +538,44 @@ func validateReferences(s *parser.SelectStmt) error {
 					prettyRels = append(prettyRels, fmt.Sprintf("'%s'", inRel.Alias))
 				}
 				prettyRelsStr := strings.Join(prettyRels, ", ")
-				err := fmt.Errorf("cannot reference relation '%s' "+
-					"when using input relations %v", rel, prettyRelsStr)
-				return err
+				errs = append(errs, fmt.Errorf("cannot reference relation '%s' "+
+					"when using input relations %v", rel, prettyRelsStr))
 			}
 		}
-		// if we arrive here, all referenced relations exist in the
-		// FROM clause -> OK
 	}
 
 	for _, rel := range s.Relations {
 		if rel.Value <= 0 {
-			err := fmt.Errorf("number in RANGE clause must be positive, not %v", rel.Value)
-			return err
+			errs = append(errs, fmt.Errorf("number in RANGE clause must be positive, not %v", rel.Value))
+			continue
 		}
 		if rel.Unit == parser.Tuples && math.Trunc(rel.Value) != rel.Value {
 			// actually the parser should not allow fractional numbers,
 			// but we check anyway
-			err := fmt.Errorf("number in RANGE clause must be integral "+
-				"for TUPLES, not %v", rel.Value)
-			return err
+			errs = append(errs, fmt.Errorf("number in RANGE clause must be integral "+
+				"for TUPLES, not %v", rel.Value))
+			continue
 		}
 		switch rel.Unit {
 		case parser.Tuples:
 			if rel.Value > MaxRangeTuples {
-				err := fmt.Errorf("RANGE value %d is too large for TUPLES (must be at most %d)",
-					int64(rel.Value), int64(MaxRangeTuples))
-				return err
+				errs = append(errs, fmt.Errorf("RANGE value %d is too large for TUPLES (must be at most %d)",
+					int64(rel.Value), int64(MaxRangeTuples)))
 			}
 		case parser.Seconds:
 			if rel.Value > MaxRangeSec {
-				err := fmt.Errorf("RANGE value %v is too large for SECONDS (must be at most %d)",
-					rel.Value, int64(MaxRangeSec))
-				return err
+				errs = append(errs, fmt.Errorf("RANGE value %v is too large for SECONDS (must be at most %d)",
+					rel.Value, int64(MaxRangeSec)))
 			}
 		case parser.Milliseconds:
 			if rel.Value > MaxRangeMillisec {
-				err := fmt.Errorf("RANGE value %v is too large for MILLISECONDS (must be at most %d)",
-					rel.Value, int64(MaxRangeMillisec))
-				return err
+				errs = append(errs, fmt.Errorf("RANGE value %v is too large for MILLISECONDS (must be at most %d)",
+					rel.Value, int64(MaxRangeMillisec)))
 			}
 		}
 	}
 
-	return nil
+	return errs
 }
 
 // LogicalOptimize does nothing at the moment. In the future, logical
@@ -898,6 +898,26 @@ func TestAggregateChecker(t *testing.T) {
 				"g_77d2dd39": rowValue{"x", "b"},
 			}},
 
+		// positions in the GROUP BY clause refer to projections
+		{"count(b), a FROM x [RANGE 1 TUPLES] GROUP BY 2", "",
+			funcAppAST{"count", []FlatExpression{aggInputRef{"g_77d2dd39"}}},
+			map[string]FlatExpression{
+				"g_77d2dd39": rowValue{"x", "b"},
+			}},
+
+		{"a AS c, count(b) FROM x [RANGE 1 TUPLES] GROUP BY 1", "",
+			rowValue{"x", "a"},
+			nil},
+
+		{"a, count(b) FROM x [RANGE 1 TUPLES] GROUP BY 3",
+			"GROUP BY position 3 is not in select list", nil, nil},
+
+		{"a, count(b) FROM x [RANGE 1 TUPLES] GROUP BY 0",
+			"GROUP BY position 0 is not in select list", nil, nil},
+
+		{"a, count(b) FROM x [RANGE 1 TUPLES] GROUP BY 2",
+			"aggregates not allowed in GROUP BY clause", nil, nil},
+
 		{"udaf(x, a) FROM x [RANGE 1 TUPLES] GROUP BY b",
 			"column \"x:a\" must appear in the GROUP BY clause or be used in an aggregate function", nil, nil},
 
@@ -0,0 +1,47 @@
+package execution
+
+import (
+	multierror "github.com/hashicorp/go-multierror"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+)
+
+// Validate performs semantic checks on a parsed statement without
+// building a topology. Unlike Analyze, it does not stop at the first
+// problem but returns a *multierror.Error listing all of them. It
+// returns nil if no problem was found or if the statement does not
+// contain a SELECT. The registry is used to tell aggregate functions
+// from ordinary ones.
+func Validate(stmt parser.Statement, reg udf.FunctionRegistry) error {
+	var errs *multierror.Error
+	switch s := stmt.(type) {
+	case parser.SelectStmt:
+		errs = multierror.Append(errs, validateSelect(s, reg)...)
+	case parser.SelectUnionStmt:
+		for _, sel := range s.Selects {
+			errs = multierror.Append(errs, validateSelect(sel, reg)...)
+		}
+	case parser.CreateStreamAsSelectStmt:
+		errs = multierror.Append(errs, validateSelect(s.Select, reg)...)
+	case parser.CreateStreamAsSelectUnionStmt:
+		for _, sel := range s.Selects {
+			errs = multierror.Append(errs, validateSelect(sel, reg)...)
+		}
+	}
+	return errs.ErrorOrNil()
+}
+
+// validateSelect returns all problems found in the given SELECT
+// statement. It performs the same checks as Analyze.
+func validateSelect(s parser.SelectStmt, reg udf.FunctionRegistry) []error {
+	var errs []error
+	if err := resolveGroupPositions(&s); err != nil {
+		errs = append(errs, err)
+	}
+	if err := makeRelationAliases(&s); err != nil {
+		errs = append(errs, err)
+	}
+	errs = append(errs, referenceErrors(&s)...)
+	_, flatErrs := flattenAllExpressions(&s, reg)
+	return append(errs, flatErrs...)
+}
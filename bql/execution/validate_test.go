@@ -0,0 +1,86 @@
+package execution
+
+import (
+	"fmt"
+	"testing"
+
+	multierror "github.com/hashicorp/go-multierror"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+)
+
+func TestValidate(t *testing.T) {
+	reg := udf.CopyGlobalUDFRegistry(core.NewContext(nil))
+	reg.Register("udaf", &dummyAggregate{})
+
+	testCases := []struct {
+		bql            string
+		expectedErrors []string
+	}{
+		{"SELECT ISTREAM a, count(b) FROM x [RANGE 1 TUPLES] WHERE a > 1 GROUP BY a HAVING count(b) > 2", nil},
+		{"CREATE STREAM s AS SELECT ISTREAM x:a, y:b FROM x [RANGE 1 TUPLES], y [RANGE 1 TUPLES] WHERE x:c = y:c", nil},
+		{"SELECT ISTREAM a FROM x [RANGE 1 TUPLES] UNION ALL SELECT ISTREAM b FROM y [RANGE 1 TUPLES]", nil},
+		{"CREATE SOURCE a TYPE b", nil},
+		{"SELECT ISTREAM a FROM x [RANGE 1 TUPLES] WHERE count(a) = 1",
+			[]string{"aggregates not allowed in WHERE clause"}},
+		{"SELECT ISTREAM a FROM x [RANGE 1 TUPLES] WHERE udaf(a) = 1 GROUP BY a, count(a)",
+			[]string{"aggregates not allowed in WHERE clause",
+				"aggregates not allowed in GROUP BY clause"}},
+		{"SELECT ISTREAM a, b, b + count(c) FROM x [RANGE 1 TUPLES] GROUP BY d",
+			[]string{"column \"x:a\" must appear in the GROUP BY clause or be used in an aggregate function",
+				"column \"x:b\" must appear in the GROUP BY clause or be used in an aggregate function"}},
+		{"SELECT ISTREAM y:a, z:b FROM x [RANGE 1 TUPLES] WHERE count(a) = 1",
+			[]string{"cannot refer to relations '', 'y', 'z' when using only 'x'",
+				"aggregates not allowed in WHERE clause"}},
+		{"SELECT ISTREAM y:a FROM x [RANGE 1 TUPLES], z [RANGE 0 TUPLES]",
+			[]string{"cannot reference relation 'y' when using input relations 'x', 'z'",
+				"number in RANGE clause must be positive, not 0"}},
+		{"SELECT ISTREAM a, x:b FROM x [RANGE 1 TUPLES], y [RANGE 1 TUPLES]",
+			[]string{"column references must specify a relation when using multiple input relations"}},
+		{"SELECT ISTREAM a FROM x [RANGE 1 TUPLES] AS y, z [RANGE 1 TUPLES] AS y",
+			[]string{"cannot use relations 'z' and 'x' with the same alias 'y'",
+				"column references must specify a relation when using multiple input relations"}},
+		{"SELECT ISTREAM a FROM x [RANGE 1 TUPLES] UNION ALL SELECT ISTREAM count(b), c FROM y [RANGE 1 TUPLES]",
+			[]string{"column \"y:c\" must appear in the GROUP BY clause or be used in an aggregate function"}},
+		{"SELECT ISTREAM ts(), count(b) FROM x [RANGE 1 TUPLES]",
+			[]string{"using metadata 'TS' in GROUP BY statements is not supported yet"}},
+		{"SELECT ISTREAM a, count(b) FROM x [RANGE 1 TUPLES] GROUP BY 1", nil},
+		{"SELECT ISTREAM a, count(b) FROM x [RANGE 1 TUPLES] GROUP BY 3",
+			[]string{"GROUP BY position 3 is not in select list",
+				"grouping by expressions is not supported yet",
+				"column \"x:a\" must appear in the GROUP BY clause or be used in an aggregate function"}},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		Convey(fmt.Sprintf("Given the statement %s", testCase.bql), t, func() {
+			p := parser.New()
+			stmt, _, err := p.ParseStmt(testCase.bql)
+			So(err, ShouldBeNil)
+
+			Convey("When we validate it", func() {
+				err := Validate(stmt.(parser.Statement), reg)
+
+				if len(testCase.expectedErrors) == 0 {
+					Convey("Then there should be no error", func() {
+						So(err, ShouldBeNil)
+					})
+				} else {
+					Convey("Then all problems should be reported", func() {
+						So(err, ShouldNotBeNil)
+						So(err, ShouldHaveSameTypeAs, &multierror.Error{})
+						errs := err.(*multierror.Error).Errors
+						msgs := make([]string, len(errs))
+						for i, e := range errs {
+							msgs[i] = e.Error()
+						}
+						So(msgs, ShouldResemble, testCase.expectedErrors)
+					})
+				}
+			})
+		})
+	}
+}
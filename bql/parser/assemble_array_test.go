@@ -0,0 +1,81 @@
+package parser
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestAssembleArray(t *testing.T) {
+	Convey("Given a parseStack", t, func() {
+		ps := parseStack{}
+
+		Convey("When the stack contains two expressions", func() {
+			ps.PushComponent(0, 6, Raw{"PRE"})
+			ps.PushComponent(7, 8, RowValue{"", "a"})
+			ps.PushComponent(9, 10, NumericLiteral{2})
+			ps.AssembleExpressions(6, 11)
+			ps.AssembleArray()
+
+			Convey("Then AssembleArray transforms them into one item", func() {
+				So(ps.Len(), ShouldEqual, 2)
+
+				Convey("And that item is an ArrayAST", func() {
+					top := ps.Peek()
+					So(top, ShouldNotBeNil)
+					So(top.begin, ShouldEqual, 6)
+					So(top.end, ShouldEqual, 11)
+					So(top.comp, ShouldHaveSameTypeAs, ArrayAST{})
+
+					Convey("And it contains the previously pushed data", func() {
+						comp := top.comp.(ArrayAST)
+						So(len(comp.Expressions), ShouldEqual, 2)
+						So(comp.Expressions[0], ShouldResemble, RowValue{"", "a"})
+						So(comp.Expressions[1], ShouldResemble, NumericLiteral{2})
+					})
+				})
+			})
+		})
+
+		Convey("When the stack contains a wrong item", func() {
+			ps.PushComponent(0, 6, Raw{"PRE"})
+
+			Convey("Then AssembleArray panics", func() {
+				So(ps.AssembleArray, ShouldPanic)
+			})
+		})
+	})
+
+	Convey("Given a parser", t, func() {
+		p := &bqlPeg{}
+
+		Convey("When selecting a nested array literal", func() {
+			p.Buffer = `SELECT ISTREAM [1, [2, "a"], [], {"b": [3]}]`
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldBeNil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, SelectStmt{})
+				s := top.(SelectStmt)
+				So(len(s.Projections), ShouldEqual, 1)
+				So(s.Projections[0], ShouldResemble, ArrayAST{ExpressionsAST{[]Expression{
+					NumericLiteral{1},
+					ArrayAST{ExpressionsAST{[]Expression{NumericLiteral{2}, StringLiteral{"a"}}}},
+					ArrayAST{ExpressionsAST{[]Expression{}}},
+					MapAST{[]KeyValuePairAST{
+						{"b", ArrayAST{ExpressionsAST{[]Expression{NumericLiteral{3}}}}},
+					}},
+				}}})
+
+				Convey("And String() should return the original statement", func() {
+					So(s.String(), ShouldEqual, `SELECT ISTREAM [1, [2, "a"], [], {"b":[3]}]`)
+				})
+			})
+		})
+	})
+}
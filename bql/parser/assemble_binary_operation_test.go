@@ -130,3 +130,82 @@ func TestAssembleBinaryOperation(t *testing.T) {
 		})
 	})
 }
+
+func TestAssembleBooleanOperation(t *testing.T) {
+	Convey("Given a parseStack", t, func() {
+		ps := parseStack{}
+
+		Convey("When assembling a AND b OR c", func() {
+			// the grammar assembles the AND level before the OR level
+			ps.PushComponent(0, 1, RowValue{"", "a"})
+			ps.PushComponent(2, 5, And)
+			ps.PushComponent(6, 7, RowValue{"", "b"})
+			ps.AssembleBinaryOperation(0, 7)
+			ps.PushComponent(8, 10, Or)
+			ps.PushComponent(11, 12, RowValue{"", "c"})
+			ps.AssembleBinaryOperation(0, 12)
+
+			Convey("Then AND should bind more tightly than OR", func() {
+				So(ps.Len(), ShouldEqual, 1)
+				So(ps.Peek().comp, ShouldResemble,
+					BinaryOpAST{Or,
+						BinaryOpAST{And, RowValue{"", "a"}, RowValue{"", "b"}},
+						RowValue{"", "c"}})
+			})
+		})
+
+		Convey("When assembling NOT (a OR b)", func() {
+			ps.PushComponent(0, 3, Not)
+			ps.PushComponent(5, 6, RowValue{"", "a"})
+			ps.PushComponent(7, 9, Or)
+			ps.PushComponent(10, 11, RowValue{"", "b"})
+			ps.AssembleBinaryOperation(5, 11)
+			ps.AssembleUnaryPrefixOperation(0, 12)
+
+			Convey("Then NOT should be applied to the OR", func() {
+				So(ps.Len(), ShouldEqual, 1)
+				So(ps.Peek().comp, ShouldResemble,
+					UnaryOpAST{Not,
+						BinaryOpAST{Or, RowValue{"", "a"}, RowValue{"", "b"}}})
+			})
+		})
+
+		Convey("When an operand is in the place of a boolean operator", func() {
+			ps.PushComponent(0, 1, RowValue{"", "a"})
+			ps.PushComponent(2, 3, RowValue{"", "b"})
+			ps.PushComponent(4, 5, RowValue{"", "c"})
+			f := func() {
+				ps.AssembleBinaryOperation(0, 5)
+			}
+
+			Convey("Then AssembleBinaryOperation panics", func() {
+				So(f, ShouldPanic)
+			})
+		})
+
+		Convey("When NOT is followed by too many items", func() {
+			ps.PushComponent(0, 3, Not)
+			ps.PushComponent(4, 5, RowValue{"", "a"})
+			ps.PushComponent(6, 7, RowValue{"", "b"})
+			f := func() {
+				ps.AssembleUnaryPrefixOperation(0, 7)
+			}
+
+			Convey("Then AssembleUnaryPrefixOperation panics", func() {
+				So(f, ShouldPanic)
+			})
+		})
+
+		Convey("When NOT isn't the first item", func() {
+			ps.PushComponent(0, 1, RowValue{"", "a"})
+			ps.PushComponent(2, 5, Not)
+			f := func() {
+				ps.AssembleUnaryPrefixOperation(0, 5)
+			}
+
+			Convey("Then AssembleUnaryPrefixOperation panics", func() {
+				So(f, ShouldPanic)
+			})
+		})
+	})
+}
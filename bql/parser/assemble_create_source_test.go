@@ -98,5 +98,31 @@ func TestAssembleCreateSource(t *testing.T) {
 				})
 			})
 		})
+
+		Convey("When doing a CREATE SOURCE with a dollar-quoted parameter", func() {
+			p.Buffer = `CREATE SOURCE a_1 TYPE b_b WITH template=$json${"id": "it's", "v": [1, 2]}$json$`
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldBeNil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, CreateSourceStmt{})
+				comp := top.(CreateSourceStmt)
+
+				So(len(comp.Params), ShouldEqual, 1)
+				So(comp.Params[0].Key, ShouldEqual, "template")
+				So(comp.Params[0].Value, ShouldEqual, data.String(`{"id": "it's", "v": [1, 2]}`))
+
+				Convey("And String() should return an equivalent statement", func() {
+					So(comp.String(), ShouldEqual,
+						`CREATE SOURCE a_1 TYPE b_b WITH template="{""id"": ""it's"", ""v"": [1, 2]}"`)
+				})
+			})
+		})
 	})
 }
@@ -126,5 +126,51 @@ func TestAssembleEval(t *testing.T) {
 				})
 			})
 		})
+
+		Convey("When doing an EVAL of an arithmetic expression", func() {
+			p.Buffer = "EVAL a + b * 2"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldBeNil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, EvalStmt{})
+				comp := top.(EvalStmt)
+
+				So(comp.Expr, ShouldResemble, BinaryOpAST{Plus, RowValue{"", "a"},
+					BinaryOpAST{Multiply, RowValue{"", "b"}, NumericLiteral{2}}})
+				So(comp.Input, ShouldBeNil)
+			})
+		})
+
+		Convey("When doing an EVAL of a boolean expression with ON", func() {
+			p.Buffer = `EVAL a > 1 AND NOT b ON {"a":2, "b":false}`
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldBeNil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, EvalStmt{})
+				comp := top.(EvalStmt)
+
+				So(comp.Expr, ShouldResemble, BinaryOpAST{And,
+					BinaryOpAST{Greater, RowValue{"", "a"}, NumericLiteral{1}},
+					UnaryOpAST{Not, RowValue{"", "b"}}})
+				So(*comp.Input, ShouldResemble, MapAST{[]KeyValuePairAST{
+					{"a", NumericLiteral{2}},
+					{"b", BoolLiteral{false}},
+				}})
+			})
+		})
 	})
 }
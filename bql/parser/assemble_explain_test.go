@@ -0,0 +1,153 @@
+package parser
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"testing"
+)
+
+func TestAssembleExplain(t *testing.T) {
+	Convey("Given a parseStack", t, func() {
+		ps := parseStack{}
+		Convey("When the stack contains a statement", func() {
+			ps.PushComponent(2, 4, Raw{"PRE"})
+			ps.PushComponent(12, 25, DropStreamStmt{"s"})
+			ps.AssembleExplain(4, 25)
+
+			Convey("Then AssembleExplain transforms it into one item", func() {
+				So(ps.Len(), ShouldEqual, 2)
+
+				Convey("And that item is an ExplainStmt", func() {
+					top := ps.Peek()
+					So(top, ShouldNotBeNil)
+					So(top.begin, ShouldEqual, 4)
+					So(top.end, ShouldEqual, 25)
+					So(top.comp, ShouldHaveSameTypeAs, ExplainStmt{})
+
+					Convey("And it contains the previously pushed data", func() {
+						comp := top.comp.(ExplainStmt)
+						So(comp.Stmt, ShouldResemble, DropStreamStmt{"s"})
+					})
+				})
+			})
+		})
+
+		Convey("When the stack does not contain enough items", func() {
+			f := func() { ps.AssembleExplain(4, 25) }
+			Convey("Then AssembleExplain panics", func() {
+				So(f, ShouldPanic)
+			})
+		})
+
+		Convey("When the stack contains a wrong item", func() {
+			ps.PushComponent(2, 4, Raw{"PRE"})
+			ps.PushComponent(12, 25, StreamIdentifier("s"))
+
+			f := func() { ps.AssembleExplain(4, 25) }
+			Convey("Then AssembleExplain panics", func() {
+				So(f, ShouldPanic)
+			})
+		})
+	})
+
+	Convey("Given a parser", t, func() {
+		p := &bqlPeg{}
+
+		Convey("When doing an EXPLAIN of a SELECT", func() {
+			p.Buffer = "EXPLAIN SELECT ISTREAM a FROM s [RANGE 1 TUPLES] WHERE b > 1"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldBeNil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, ExplainStmt{})
+				comp := top.(ExplainStmt)
+
+				So(comp.Stmt, ShouldHaveSameTypeAs, SelectStmt{})
+				sel := comp.Stmt.(SelectStmt)
+				So(sel.EmitterType, ShouldEqual, Istream)
+				So(sel.Projections, ShouldResemble, []Expression{RowValue{"", "a"}})
+				So(sel.Filter, ShouldResemble, BinaryOpAST{Greater, RowValue{"", "b"}, NumericLiteral{1}})
+
+				Convey("And String() should return the original statement", func() {
+					So(comp.String(), ShouldEqual, p.Buffer)
+				})
+
+				Convey("And Plan should return the inner statement as a Map", func() {
+					plan, err := comp.Plan()
+					So(err, ShouldBeNil)
+					So(plan["type"], ShouldEqual, data.String("SelectStmt"))
+					So(plan["EmitterType"], ShouldEqual, data.String("ISTREAM"))
+					So(plan["Filter"], ShouldResemble, data.Map{
+						"type": data.String("BinaryOp"),
+						"Op":   data.String(">"),
+						"Left": data.Map{
+							"type":     data.String("RowValue"),
+							"Relation": data.String(""),
+							"Column":   data.String("b"),
+						},
+						"Right": data.Map{
+							"type":  data.String("NumericLiteral"),
+							"Value": data.Int(1),
+						},
+					})
+				})
+			})
+		})
+
+		Convey("When doing an EXPLAIN of a DROP STREAM", func() {
+			p.Buffer = "EXPLAIN DROP STREAM s"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldBeNil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, ExplainStmt{})
+				comp := top.(ExplainStmt)
+
+				So(comp.Stmt, ShouldResemble, DropStreamStmt{"s"})
+
+				Convey("And String() should return the original statement", func() {
+					So(comp.String(), ShouldEqual, p.Buffer)
+				})
+
+				Convey("And Plan should return the inner statement as a Map", func() {
+					plan, err := comp.Plan()
+					So(err, ShouldBeNil)
+					So(plan, ShouldResemble, data.Map{
+						"type":   data.String("DropStreamStmt"),
+						"Stream": data.String("s"),
+					})
+				})
+			})
+		})
+
+		Convey("When doing an EXPLAIN of an EXPLAIN", func() {
+			p.Buffer = "EXPLAIN EXPLAIN DROP STREAM s"
+			p.Init()
+
+			Convey("Then parsing the statement should fail", func() {
+				So(p.Parse(), ShouldNotBeNil)
+			})
+		})
+
+		Convey("When doing an EXPLAIN without a statement", func() {
+			p.Buffer = "EXPLAIN"
+			p.Init()
+
+			Convey("Then parsing the statement should fail", func() {
+				So(p.Parse(), ShouldNotBeNil)
+			})
+		})
+	})
+}
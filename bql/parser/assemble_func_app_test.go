@@ -53,4 +53,61 @@ func TestAssembleFuncApp(t *testing.T) {
 			})
 		})
 	})
+
+	Convey("Given a parser", t, func() {
+		p := &bqlPeg{}
+
+		Convey("When doing a full SELECT with NULLIF", func() {
+			p.Buffer = `SELECT ISTREAM NULLIF(a, "") AS b FROM s [RANGE 1 TUPLES]`
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, SelectStmt{})
+				comp := top.(SelectStmt)
+
+				So(comp.Projections, ShouldResemble, []Expression{
+					AliasAST{FuncAppAST{FuncName("NULLIF"), ExpressionsAST{[]Expression{
+						RowValue{"", "a"},
+						StringLiteral{""},
+					}}, nil}, "b"},
+				})
+			})
+		})
+
+		Convey("When doing a full SELECT with a three-argument COALESCE", func() {
+			p.Buffer = `SELECT ISTREAM COALESCE(a, b:c, "default") FROM s [RANGE 1 TUPLES]`
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, SelectStmt{})
+				comp := top.(SelectStmt)
+
+				So(comp.Projections, ShouldResemble, []Expression{
+					FuncAppAST{FuncName("COALESCE"), ExpressionsAST{[]Expression{
+						RowValue{"", "a"},
+						RowValue{"b", "c"},
+						StringLiteral{"default"},
+					}}, nil},
+				})
+
+				Convey("And String() should return the original statement", func() {
+					So(comp.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+	})
 }
@@ -0,0 +1,121 @@
+package parser
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+func TestAssembleIntervalLiteral(t *testing.T) {
+	Convey("Given a parser", t, func() {
+		p := &bqlPeg{}
+
+		units := map[string]time.Duration{
+			"3 microseconds":         3 * time.Microsecond,
+			"1 MILLISECOND":          time.Millisecond,
+			"90 seconds":             90 * time.Second,
+			"0.5 second":             500 * time.Millisecond,
+			"2 Minutes":              2 * time.Minute,
+			"1 hour":                 time.Hour,
+			"1.25 hours":             75 * time.Minute,
+			"1 day":                  24 * time.Hour,
+			"7 days":                 7 * 24 * time.Hour,
+			"-1 day":                 -24 * time.Hour,
+			"  10   seconds  ":       10 * time.Second,
+			"0.0000001 second":       100 * time.Nanosecond,
+			"-1.000000001 seconds":   -time.Second - time.Nanosecond,
+			"3600.000000001 seconds": time.Hour + time.Nanosecond,
+		}
+
+		for input, expected := range units {
+			input, expected := input, expected
+
+			Convey(fmt.Sprintf("When selecting INTERVAL \"%s\"", input), func() {
+				p.Buffer = fmt.Sprintf(`SELECT ISTREAM INTERVAL "%s"`, input)
+				p.Init()
+
+				Convey("Then the statement should be parsed correctly", func() {
+					err := p.Parse()
+					So(err, ShouldBeNil)
+					p.Execute()
+
+					ps := p.parseStack
+					So(ps.Len(), ShouldEqual, 1)
+					top := ps.Peek().comp
+					So(top, ShouldHaveSameTypeAs, SelectStmt{})
+					comp := top.(SelectStmt)
+
+					So(len(comp.Projections), ShouldEqual, 1)
+					So(comp.Projections[0], ShouldResemble, IntervalLiteral{expected})
+
+					Convey("And String() should return an equivalent statement", func() {
+						p2 := &bqlPeg{}
+						p2.Buffer = comp.String()
+						p2.Init()
+						So(p2.Parse(), ShouldBeNil)
+						p2.Execute()
+						So(p2.parseStack.Peek().comp, ShouldResemble, comp)
+					})
+				})
+			})
+		}
+
+		Convey("When doing a CREATE SOURCE with an interval parameter", func() {
+			p.Buffer = `CREATE SOURCE a TYPE b WITH interval=INTERVAL "1.5 minutes"`
+			p.Init()
+
+			Convey("Then the parameter should be the duration in seconds", func() {
+				err := p.Parse()
+				So(err, ShouldBeNil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, CreateSourceStmt{})
+				comp := top.(CreateSourceStmt)
+
+				So(len(comp.Params), ShouldEqual, 1)
+				So(comp.Params[0].Key, ShouldEqual, "interval")
+				So(comp.Params[0].Value, ShouldEqual, data.Float(90))
+				d, err := data.ToDuration(comp.Params[0].Value)
+				So(err, ShouldBeNil)
+				So(d, ShouldEqual, 90*time.Second)
+			})
+		})
+
+		Convey("When using an unknown unit", func() {
+			p.Buffer = `SELECT ISTREAM INTERVAL "2 weeks"`
+			p.Init()
+
+			Convey("Then parsing should fail", func() {
+				So(p.Parse(), ShouldBeNil)
+				So(p.Execute, ShouldPanic)
+			})
+		})
+	})
+
+	Convey("Given a BQL parser", t, func() {
+		p := New()
+
+		Convey("When using an unknown unit", func() {
+			_, _, err := p.ParseStmt(`SELECT ISTREAM INTERVAL "2 weeks"`)
+
+			Convey("Then the error should mention the unit", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "Error in BQL parser: unknown interval unit: weeks")
+			})
+		})
+
+		Convey("When the interval has no unit", func() {
+			_, _, err := p.ParseStmt(`SELECT ISTREAM INTERVAL "2"`)
+
+			Convey("Then parsing should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
@@ -39,6 +39,16 @@ func TestAssembleMap(t *testing.T) {
 			})
 		})
 
+		Convey("When the stack contains a duplicate key", func() {
+			ps.PushComponent(0, 6, Raw{"PRE"})
+			ps.PushComponent(6, 7, KeyValuePairAST{"foo", RowValue{"", "a"}})
+			ps.PushComponent(7, 8, KeyValuePairAST{"foo", RowValue{"", "b"}})
+
+			Convey("Then AssembleMap panics", func() {
+				So(func() { ps.AssembleMap(6, 8) }, ShouldPanic)
+			})
+		})
+
 		Convey("When the stack contains no elements in the given range", func() {
 			ps.PushComponent(0, 6, Raw{"PRE"})
 			ps.AssembleMap(6, 8)
@@ -109,5 +119,70 @@ func TestAssembleMap(t *testing.T) {
 				So(m.Entries[1], ShouldResemble, KeyValuePairAST{"bar", RowValue{"", "b"}})
 			})
 		})
+
+		Convey("When selecting a nested map literal", func() {
+			p.Buffer = `SELECT ISTREAM {"a": 1, "b": [2, 3], "c": {"d": {"e": true}}}`
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldBeNil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				s := ps.Peek().comp.(SelectStmt)
+				So(len(s.Projections), ShouldEqual, 1)
+				So(s.Projections[0], ShouldResemble, MapAST{[]KeyValuePairAST{
+					{"a", NumericLiteral{1}},
+					{"b", ArrayAST{ExpressionsAST{[]Expression{NumericLiteral{2}, NumericLiteral{3}}}}},
+					{"c", MapAST{[]KeyValuePairAST{
+						{"d", MapAST{[]KeyValuePairAST{{"e", BoolLiteral{true}}}}},
+					}}},
+				}})
+
+				Convey("And String() should return the original statement", func() {
+					So(s.String(), ShouldEqual, `SELECT ISTREAM {"a":1, "b":[2, 3], "c":{"d":{"e":TRUE}}}`)
+				})
+			})
+		})
+	})
+
+	Convey("Given a BQL parser", t, func() {
+		p := New()
+
+		Convey("When parsing a map literal having a duplicate key", func() {
+			_, _, err := p.ParseStmt(`SELECT ISTREAM {"a": 1, "b": 2, "a": 3} FROM s [RANGE 1 TUPLES]`)
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, `duplicate key in a map literal: "a"`)
+			})
+		})
+
+		Convey("When parsing a nested map literal having a duplicate key", func() {
+			_, _, err := p.ParseStmt(`EVAL [{"a": {"b": 1, "b": 2}}]`)
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, `duplicate key in a map literal: "b"`)
+			})
+		})
+
+		Convey("When parsing a parameter having a duplicate key", func() {
+			_, _, err := p.ParseStmt(`CREATE SOURCE s TYPE t WITH p = {"a": 1, "a": 2}`)
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When parsing the same key in different map literals", func() {
+			_, _, err := p.ParseStmt(`EVAL [{"a": 1}, {"a": 2}]`)
+
+			Convey("Then it should succeed", func() {
+				So(err, ShouldBeNil)
+			})
+		})
 	})
 }
@@ -0,0 +1,78 @@
+package parser
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestAssembleParam(t *testing.T) {
+	Convey("Given a parser", t, func() {
+		p := New()
+
+		Convey("When parsing a statement having positional placeholders", func() {
+			s := "SELECT ISTREAM ? FROM s [RANGE 1 TUPLES] WHERE x = ? AND y > ?"
+			stmt, _, err := p.ParseStmt(s)
+			So(err, ShouldBeNil)
+
+			Convey("Then they should be numbered in the order of appearance", func() {
+				comp := stmt.(SelectStmt)
+				So(comp.Projections, ShouldResemble, []Expression{ParamAST{"1", true}})
+				So(comp.Filter, ShouldResemble, BinaryOpAST{And,
+					BinaryOpAST{Equal, RowValue{"", "x"}, ParamAST{"2", true}},
+					BinaryOpAST{Greater, RowValue{"", "y"}, ParamAST{"3", true}}})
+
+				Convey("And String() should return the original statement", func() {
+					So(comp.String(), ShouldEqual, s)
+				})
+			})
+
+			Convey("Then the next statement should be numbered from 1", func() {
+				stmt, _, err := p.ParseStmt("EVAL ?")
+				So(err, ShouldBeNil)
+				So(stmt, ShouldResemble, EvalStmt{Expr: ParamAST{"1", true}})
+			})
+		})
+
+		Convey("When parsing a statement having named placeholders", func() {
+			s := `SELECT ISTREAM s:a, :name AS n FROM s [RANGE 1 TUPLES] WHERE x::INT = :min_x + 1`
+			stmt, _, err := p.ParseStmt(s)
+			So(err, ShouldBeNil)
+
+			Convey("Then they should have the names", func() {
+				comp := stmt.(SelectStmt)
+				So(comp.Projections, ShouldResemble, []Expression{
+					RowValue{"s", "a"},
+					AliasAST{ParamAST{"name", false}, "n"},
+				})
+				So(comp.Filter, ShouldResemble, BinaryOpAST{Equal,
+					TypeCastAST{RowValue{"", "x"}, Int},
+					BinaryOpAST{Plus, ParamAST{"min_x", false}, NumericLiteral{1}}})
+
+				Convey("And String() should return the original statement", func() {
+					So(comp.String(), ShouldEqual, s)
+				})
+			})
+		})
+
+		Convey("When parsing placeholders in a map and a function call", func() {
+			stmt, _, err := p.ParseStmt(`EVAL f(?, :b) ON {"a":?}`)
+			So(err, ShouldBeNil)
+
+			Convey("Then they should be parsed", func() {
+				So(stmt, ShouldResemble, EvalStmt{
+					Expr: FuncAppAST{FuncName("f"), ExpressionsAST{[]Expression{
+						ParamAST{"1", true}, ParamAST{"b", false}}}, nil},
+					Input: &MapAST{[]KeyValuePairAST{{"a", ParamAST{"2", true}}}},
+				})
+			})
+		})
+
+		Convey("When parsing a colon without a name", func() {
+			_, _, err := p.ParseStmt("EVAL :")
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
@@ -0,0 +1,70 @@
+package parser
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestAssembleRegex(t *testing.T) {
+	Convey("Given a parseStack", t, func() {
+		ps := parseStack{}
+
+		Convey("When there is a regular expression match in the given range", func() {
+			ps.PushComponent(0, 2, Raw{"PRE"})
+			ps.PushComponent(2, 3, RowValue{"", "a"})
+			ps.PushComponent(3, 4, Regex)
+			ps.PushComponent(4, 5, StringLiteral{"^x"})
+			ps.AssembleBinaryOperation(2, 5)
+
+			Convey("Then AssembleBinaryOperation adds the ~ operator", func() {
+				So(ps.Len(), ShouldEqual, 2)
+				top := ps.Peek()
+				So(top, ShouldNotBeNil)
+				So(top.begin, ShouldEqual, 2)
+				So(top.end, ShouldEqual, 5)
+				So(top.comp, ShouldResemble,
+					BinaryOpAST{Regex, RowValue{"", "a"}, StringLiteral{"^x"}})
+			})
+		})
+	})
+
+	Convey("Given a parser", t, func() {
+		p := &bqlPeg{}
+
+		Convey("When doing a full SELECT with ~ and !~", func() {
+			p.Buffer = `SELECT ISTREAM a ~ "^x" AS m FROM s [RANGE 1 TUPLES] WHERE b !~ "[0-9]+"`
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, SelectStmt{})
+				comp := top.(SelectStmt)
+
+				So(comp.Projections, ShouldResemble, []Expression{
+					AliasAST{BinaryOpAST{Regex, RowValue{"", "a"}, StringLiteral{"^x"}}, "m"},
+				})
+				So(comp.Filter, ShouldResemble,
+					BinaryOpAST{NotRegex, RowValue{"", "b"}, StringLiteral{"[0-9]+"}})
+
+				Convey("And String() should return the original statement", func() {
+					So(comp.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When using ! without ~", func() {
+			p.Buffer = `SELECT ISTREAM a ! "x" FROM s [RANGE 1 TUPLES]`
+			p.Init()
+
+			Convey("Then parsing should fail", func() {
+				So(p.Parse(), ShouldNotBeNil)
+			})
+		})
+	})
+}
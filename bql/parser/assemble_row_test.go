@@ -0,0 +1,181 @@
+package parser
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestAssembleRow(t *testing.T) {
+	Convey("Given a parseStack", t, func() {
+		ps := parseStack{}
+
+		Convey("When the stack contains two expressions", func() {
+			ps.PushComponent(0, 6, Raw{"PRE"})
+			ps.PushComponent(6, 7, RowValue{"", "a"})
+			ps.PushComponent(8, 9, NumericLiteral{2})
+			ps.AssembleExpressions(6, 10)
+			ps.AssembleRow()
+
+			Convey("Then AssembleRow transforms them into one item", func() {
+				So(ps.Len(), ShouldEqual, 2)
+
+				Convey("And that item is a RowAST", func() {
+					top := ps.Peek()
+					So(top, ShouldNotBeNil)
+					So(top.begin, ShouldEqual, 6)
+					So(top.end, ShouldEqual, 10)
+					So(top.comp, ShouldHaveSameTypeAs, RowAST{})
+
+					Convey("And it contains the previously pushed data", func() {
+						comp := top.comp.(RowAST)
+						So(len(comp.Expressions), ShouldEqual, 2)
+						So(comp.Expressions[0], ShouldResemble, RowValue{"", "a"})
+						So(comp.Expressions[1], ShouldResemble, NumericLiteral{2})
+					})
+				})
+			})
+		})
+
+		Convey("When the stack contains a wrong item", func() {
+			ps.PushComponent(0, 6, Raw{"PRE"})
+
+			Convey("Then AssembleRow panics", func() {
+				So(ps.AssembleRow, ShouldPanic)
+			})
+		})
+	})
+
+	Convey("Given a parser", t, func() {
+		p := &bqlPeg{}
+
+		Convey("When selecting a two-element row", func() {
+			p.Buffer = "SELECT ISTREAM (a, 2)"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldBeNil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, SelectStmt{})
+				comp := top.(SelectStmt)
+
+				So(len(comp.Projections), ShouldEqual, 1)
+				So(comp.Projections[0], ShouldResemble, RowAST{ExpressionsAST{[]Expression{
+					RowValue{"", "a"}, NumericLiteral{2}}}})
+
+				Convey("And String() should return the original statement", func() {
+					So(comp.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When selecting a single expression in parentheses", func() {
+			p.Buffer = "SELECT ISTREAM (a)"
+			p.Init()
+
+			Convey("Then it should not become a row", func() {
+				err := p.Parse()
+				So(err, ShouldBeNil)
+				p.Execute()
+
+				comp := p.parseStack.Peek().comp.(SelectStmt)
+				So(len(comp.Projections), ShouldEqual, 1)
+				So(comp.Projections[0], ShouldResemble, RowValue{"", "a"})
+			})
+		})
+
+		Convey("When comparing rows using IN", func() {
+			p.Buffer = "SELECT ISTREAM a FROM s [RANGE 1 TUPLES] WHERE (a, b) IN ((1, 2), (3, 4))"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldBeNil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, SelectStmt{})
+				comp := top.(SelectStmt)
+
+				So(comp.Filter, ShouldResemble, BinaryOpAST{In,
+					RowAST{ExpressionsAST{[]Expression{
+						RowValue{"", "a"}, RowValue{"", "b"}}}},
+					RowAST{ExpressionsAST{[]Expression{
+						RowAST{ExpressionsAST{[]Expression{
+							NumericLiteral{1}, NumericLiteral{2}}}},
+						RowAST{ExpressionsAST{[]Expression{
+							NumericLiteral{3}, NumericLiteral{4}}}},
+					}}},
+				})
+
+				Convey("And String() should return the original statement", func() {
+					So(comp.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When comparing rows having NULL elements using IN", func() {
+			p.Buffer = "EVAL (NULL, 2) IN ((NULL, 2)) OR (1, 2) IN ((1, NULL))"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				So(p.Parse(), ShouldBeNil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, EvalStmt{})
+				comp := top.(EvalStmt)
+
+				So(comp.Expr, ShouldResemble, BinaryOpAST{Or,
+					BinaryOpAST{In,
+						RowAST{ExpressionsAST{[]Expression{
+							NullLiteral{}, NumericLiteral{2}}}},
+						RowAST{ExpressionsAST{[]Expression{
+							RowAST{ExpressionsAST{[]Expression{
+								NullLiteral{}, NumericLiteral{2}}}},
+						}}},
+					},
+					BinaryOpAST{In,
+						RowAST{ExpressionsAST{[]Expression{
+							NumericLiteral{1}, NumericLiteral{2}}}},
+						RowAST{ExpressionsAST{[]Expression{
+							RowAST{ExpressionsAST{[]Expression{
+								NumericLiteral{1}, NullLiteral{}}}},
+						}}},
+					},
+				})
+			})
+		})
+
+		Convey("When the arity of the rows does not match", func() {
+			p.Buffer = "SELECT ISTREAM a FROM s [RANGE 1 TUPLES] WHERE (a, b) IN ((1, 2), (3, 4, 5))"
+			p.Init()
+
+			Convey("Then assembling the statement should fail", func() {
+				So(p.Parse(), ShouldBeNil)
+				So(p.Execute, ShouldPanic)
+			})
+		})
+	})
+
+	Convey("Given a BQL parser", t, func() {
+		p := New()
+
+		Convey("When the arity of the rows does not match", func() {
+			_, _, err := p.ParseStmt("SELECT ISTREAM a WHERE (a, b) IN ((1, 2), 3)")
+
+			Convey("Then the error should mention both rows", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "Error in BQL parser: (a, b) has 2 elements, but 3 has 1")
+			})
+		})
+	})
+}
@@ -0,0 +1,58 @@
+package parser
+
+import (
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestAssembleRowValue(t *testing.T) {
+	Convey("Given a parser", t, func() {
+		p := &bqlPeg{}
+
+		testCases := []struct {
+			input    string
+			expected RowValue
+		}{
+			// a column qualified by its relation
+			{"a:x", RowValue{"a", "x"}},
+			// an unqualified column
+			{"x", RowValue{"", "x"}},
+			// a nested field of a qualified column
+			{"a:b.c", RowValue{"a", "b.c"}},
+			{`a:b["c"][0]`, RowValue{"a", `b["c"][0]`}},
+			// a dot does not separate the relation
+			{"a.x", RowValue{"", "a.x"}},
+			{"a.b.c", RowValue{"", "a.b.c"}},
+		}
+
+		for _, testCase := range testCases {
+			testCase := testCase
+
+			Convey(fmt.Sprintf("When selecting %s from two relations", testCase.input), func() {
+				p.Buffer = fmt.Sprintf("SELECT ISTREAM %s FROM a [RANGE 1 TUPLES], b [RANGE 1 TUPLES]",
+					testCase.input)
+				p.Init()
+
+				Convey("Then the statement should be parsed correctly", func() {
+					err := p.Parse()
+					So(err, ShouldBeNil)
+					p.Execute()
+
+					ps := p.parseStack
+					So(ps.Len(), ShouldEqual, 1)
+					top := ps.Peek().comp
+					So(top, ShouldHaveSameTypeAs, SelectStmt{})
+					comp := top.(SelectStmt)
+
+					So(len(comp.Projections), ShouldEqual, 1)
+					So(comp.Projections[0], ShouldResemble, testCase.expected)
+
+					Convey("And String() should return the original statement", func() {
+						So(comp.String(), ShouldEqual, p.Buffer)
+					})
+				})
+			})
+		}
+	})
+}
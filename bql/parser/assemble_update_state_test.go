@@ -94,5 +94,100 @@ func TestAssembleUpdateState(t *testing.T) {
 				})
 			})
 		})
+
+		Convey("When doing an UPDATE STATE with a map literal", func() {
+			p.Buffer = `UPDATE STATE a_1 SET {"c": 27, "e_": {"f": ["g", {"h": true}]}, "i": {}}`
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldBeNil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, UpdateStateStmt{})
+				comp := top.(UpdateStateStmt)
+
+				So(comp.Name, ShouldEqual, "a_1")
+				So(len(comp.Params), ShouldEqual, 3)
+				So(comp.Params[0].Key, ShouldEqual, "c")
+				So(comp.Params[0].Value, ShouldEqual, data.Int(27))
+				So(comp.Params[1].Key, ShouldEqual, "e_")
+				So(comp.Params[1].Value, ShouldResemble, data.Map{
+					"f": data.Array{data.String("g"), data.Map{"h": data.True}},
+				})
+				So(comp.Params[2].Key, ShouldEqual, "i")
+				So(comp.Params[2].Value, ShouldResemble, data.Map{})
+
+				Convey("And String() should return an equivalent statement", func() {
+					// each map has at most one key so that the output is deterministic
+					So(comp.String(), ShouldEqual, `UPDATE STATE a_1 SET c=27, e_={"f":["g",{"h":true}]}, i={}`)
+				})
+			})
+		})
+
+		Convey("When doing an UPDATE STATE with an empty map literal", func() {
+			p.Buffer = `UPDATE STATE a SET {}`
+			p.Init()
+
+			Convey("Then the statement should have no parameters", func() {
+				err := p.Parse()
+				So(err, ShouldBeNil)
+				p.Execute()
+
+				comp := p.parseStack.Peek().comp.(UpdateStateStmt)
+				So(comp.Params, ShouldBeEmpty)
+			})
+		})
+	})
+
+	Convey("Given a BQL parser", t, func() {
+		p := New()
+
+		Convey("When parsing an UPDATE STATE with a map literal", func() {
+			res, _, err := p.ParseStmt(`UPDATE STATE s SET {"a": 1, "b": {"c": "d"}}`)
+
+			Convey("Then it should be the same as the one with parameters", func() {
+				So(err, ShouldBeNil)
+				ex, _, err := p.ParseStmt(`UPDATE STATE s SET a=1, b={"c": "d"}`)
+				So(err, ShouldBeNil)
+				So(res, ShouldResemble, ex)
+			})
+		})
+
+		Convey("When parsing an UPDATE STATE without a SET clause", func() {
+			_, _, err := p.ParseStmt(`UPDATE STATE s {"a": 1}`)
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When parsing an UPDATE STATE having an invalid state name", func() {
+			_, _, err := p.ParseStmt(`UPDATE STATE 1s SET {"a": 1}`)
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When parsing an UPDATE STATE with a map having a non-identifier key", func() {
+			_, _, err := p.ParseStmt(`UPDATE STATE s SET {"a b": 1}`)
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, `"a b" cannot be used as a key of a parameter`)
+			})
+		})
+
+		Convey("When parsing an UPDATE STATE with a map having a non-literal value", func() {
+			_, _, err := p.ParseStmt(`UPDATE STATE s SET {"a": b}`)
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
 	})
 }
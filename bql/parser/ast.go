@@ -2,12 +2,20 @@ package parser
 
 import (
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
+	"time"
 
 	"gopkg.in/sensorbee/sensorbee.v0/data"
 )
 
+// Statement is implemented by all statements returned by the parser,
+// such as SelectStmt or CreateSourceStmt.
+type Statement interface {
+	String() string
+}
+
 type Expression interface {
 	ReferencedRelations() map[string]bool
 	RenameReferencedRelation(string, string) Expression
@@ -317,6 +325,16 @@ func (s EvalStmt) String() string {
 	return strings.Join(str, " ")
 }
 
+// ExplainStmt is a statement wrapped by EXPLAIN. The wrapped statement
+// isn't executed but returned as a data.Map created by Plan.
+type ExplainStmt struct {
+	Stmt Statement
+}
+
+func (s ExplainStmt) String() string {
+	return "EXPLAIN " + s.Stmt.String()
+}
+
 type EmitterAST struct {
 	EmitterType    Emitter
 	EmitterOptions []interface{}
@@ -858,6 +876,47 @@ func (a ArrayAST) String() string {
 	return "[" + a.ExpressionsAST.string() + "]"
 }
 
+// RowAST represents a row value such as `(a, b)`, i.e., an ordered
+// list of expressions. It is used with the IN operator, e.g., in
+// `(a, b) IN ((1, 2), (3, 4))`, where the right hand side is also
+// a RowAST.
+type RowAST struct {
+	ExpressionsAST
+}
+
+func (r RowAST) ReferencedRelations() map[string]bool {
+	rels := map[string]bool{}
+	for _, expr := range r.Expressions {
+		for rel := range expr.ReferencedRelations() {
+			rels[rel] = true
+		}
+	}
+	return rels
+}
+
+func (r RowAST) RenameReferencedRelation(from, to string) Expression {
+	newExprs := make([]Expression, len(r.Expressions))
+	for i, expr := range r.Expressions {
+		newExprs[i] = expr.RenameReferencedRelation(from, to)
+	}
+	return RowAST{ExpressionsAST{newExprs}}
+}
+
+func (r RowAST) Foldable() bool {
+	foldable := true
+	for _, expr := range r.Expressions {
+		if !expr.Foldable() {
+			foldable = false
+			break
+		}
+	}
+	return foldable
+}
+
+func (r RowAST) String() string {
+	return "(" + r.ExpressionsAST.string() + ")"
+}
+
 type ExpressionsAST struct {
 	Expressions []Expression
 }
@@ -978,6 +1037,11 @@ func (w Wildcard) String() string {
 	return "*"
 }
 
+// RowValue is a reference to a column of an input relation. The
+// relation is separated from the column by a colon, as in `s:col`,
+// and is empty for unqualified references such as `col`. A dot
+// does not separate a relation, but accesses a nested field, so
+// `s:a.b` refers to the field b of the column a of relation s.
 type RowValue struct {
 	Relation string
 	Column   string
@@ -1019,7 +1083,7 @@ func NewRowValue(s string) RowValue {
 		// just "col"
 		return RowValue{"", components[0]}
 	}
-	// "table.col"
+	// "table:col"
 	return RowValue{components[0], components[1]}
 }
 
@@ -1328,7 +1392,7 @@ func (l StringLiteral) Foldable() bool {
 }
 
 func (l StringLiteral) String() string {
-	return `"` + strings.Replace(l.Value, `"`, `""`, -1) + `"`
+	return QuoteLiteral(l.Value)
 }
 
 func NewStringLiteral(s string) StringLiteral {
@@ -1338,6 +1402,148 @@ func NewStringLiteral(s string) StringLiteral {
 	return StringLiteral{unescaped}
 }
 
+// NewDollarQuotedStringLiteral creates a StringLiteral from a
+// dollar-quoted string such as `$tag$content$tag$`. The content is
+// taken as it is, no unescaping takes place.
+func NewDollarQuotedStringLiteral(s string) StringLiteral {
+	// the tag consists of ASCII characters only and the opening
+	// and closing delimiters have the same length
+	delimLen := strings.Index(s[1:], "$") + 2
+	runes := []rune(s)
+	return StringLiteral{string(runes[delimLen : len(runes)-delimLen])}
+}
+
+// ParamAST is a placeholder in a parameterized statement, which is
+// replaced with a value by Bind. A named placeholder `:name` has the name
+// and a positional placeholder `?` is named by its 1-based position in the
+// statement, i.e., the first `?` is named "1", the second one "2", and so on.
+type ParamAST struct {
+	Name       string
+	Positional bool
+}
+
+func (p ParamAST) ReferencedRelations() map[string]bool {
+	return nil
+}
+
+func (p ParamAST) RenameReferencedRelation(from, to string) Expression {
+	return p
+}
+
+func (p ParamAST) Foldable() bool {
+	// a placeholder cannot be evaluated until it's bound
+	return false
+}
+
+func (p ParamAST) String() string {
+	if p.Positional {
+		return "?"
+	}
+	return ":" + p.Name
+}
+
+func NewPositionalParam(pos int) ParamAST {
+	return ParamAST{fmt.Sprint(pos), true}
+}
+
+func NewNamedParam(s string) ParamAST {
+	return ParamAST{strings.TrimPrefix(s, ":"), false}
+}
+
+// IntervalLiteral represents a duration such as `INTERVAL "90 seconds"`.
+// When evaluated, it becomes a data.Float holding the number of seconds,
+// which is what data.ToDuration expects.
+type IntervalLiteral struct {
+	Value time.Duration
+}
+
+func (l IntervalLiteral) ReferencedRelations() map[string]bool {
+	return nil
+}
+
+func (l IntervalLiteral) RenameReferencedRelation(from, to string) Expression {
+	return l
+}
+
+func (l IntervalLiteral) Foldable() bool {
+	return true
+}
+
+func (l IntervalLiteral) String() string {
+	if l.Value == 0 {
+		return `INTERVAL "0 seconds"`
+	}
+	// use the largest unit that represents the value exactly
+	for _, u := range intervalUnits {
+		if l.Value%u.duration == 0 {
+			n := int64(l.Value / u.duration)
+			name := u.name
+			if n != 1 && n != -1 {
+				name += "s"
+			}
+			return fmt.Sprintf(`INTERVAL "%d %s"`, n, name)
+		}
+	}
+	// print the exact decimal number of seconds since the value has
+	// nanoseconds, which %v of a float would print as e.g. "1e-07"
+	sign := ""
+	sec, nsec := l.Value/time.Second, l.Value%time.Second
+	if l.Value < 0 {
+		sign, sec, nsec = "-", -sec, -nsec
+	}
+	frac := strings.TrimRight(fmt.Sprintf("%09d", nsec), "0")
+	return fmt.Sprintf(`INTERVAL "%s%d.%s seconds"`, sign, sec, frac)
+}
+
+// intervalUnits lists the units that can be used in an IntervalLiteral,
+// from the largest to the smallest. Each of them can also be written in
+// plural form, e.g., "1 hour" or "2 hours". The suffix is the unit of
+// time.ParseDuration used to parse the value.
+var intervalUnits = []struct {
+	name     string
+	duration time.Duration
+	suffix   string
+}{
+	{"day", 24 * time.Hour, "h"},
+	{"hour", time.Hour, "h"},
+	{"minute", time.Minute, "m"},
+	{"second", time.Second, "s"},
+	{"millisecond", time.Millisecond, "ms"},
+	{"microsecond", time.Microsecond, "us"},
+}
+
+// NewIntervalLiteral creates an IntervalLiteral from a string such as
+// `INTERVAL "1.5 hours"`. It panics if the unit is not supported or the
+// value cannot be represented as a time.Duration.
+func NewIntervalLiteral(s string) IntervalLiteral {
+	// the grammar ensures that s is ASCII-only and of the form
+	// `INTERVAL "<number> <unit>"`
+	first := strings.IndexRune(s, '"')
+	inner := strings.Fields(s[first+1 : len(s)-1])
+	if len(inner) != 2 {
+		panic(fmt.Sprintf("invalid interval: %s", s))
+	}
+	value, unit := inner[0], strings.TrimSuffix(strings.ToLower(inner[1]), "s")
+	for _, u := range intervalUnits {
+		if u.name != unit {
+			continue
+		}
+		d, err := time.ParseDuration(value + u.suffix)
+		if err != nil {
+			panic(fmt.Sprintf("invalid interval: %s", s))
+		}
+		// time.ParseDuration has no unit for days
+		if u.duration == 24*time.Hour {
+			if d > math.MaxInt64/24 || d < math.MinInt64/24 {
+				panic(fmt.Sprintf("invalid interval: %s", s))
+			}
+			d *= 24
+		}
+		return IntervalLiteral{d}
+	}
+	panic(fmt.Sprintf("unknown interval unit: %s", inner[1]))
+}
+
 type FuncName string
 
 type StreamIdentifier string
@@ -1564,9 +1770,15 @@ const (
 	Greater
 	GreaterOrEqual
 	NotEqual
+	In
+	NotIn
 	Concat
+	Regex
+	NotRegex
 	Is
 	IsNot
+	IsDistinctFrom
+	IsNotDistinctFrom
 	Plus
 	Minus
 	Multiply
@@ -1583,7 +1795,13 @@ func (op Operator) hasSamePrecedenceAs(rhs Operator) bool {
 	if Less <= op && op <= GreaterOrEqual && Less <= rhs && rhs <= GreaterOrEqual {
 		return true
 	}
-	if Is <= op && op <= IsNot && Is <= rhs && rhs <= IsNot {
+	if In <= op && op <= NotIn && In <= rhs && rhs <= NotIn {
+		return true
+	}
+	if Concat <= op && op <= NotRegex && Concat <= rhs && rhs <= NotRegex {
+		return true
+	}
+	if Is <= op && op <= IsNotDistinctFrom && Is <= rhs && rhs <= IsNotDistinctFrom {
 		return true
 	}
 	if Plus <= op && op <= Minus && Plus <= rhs && rhs <= Minus {
@@ -1625,12 +1843,24 @@ func (o Operator) String() string {
 		s = ">="
 	case NotEqual:
 		s = "!="
+	case In:
+		s = "IN"
+	case NotIn:
+		s = "NOT IN"
 	case Concat:
 		s = "||"
+	case Regex:
+		s = "~"
+	case NotRegex:
+		s = "!~"
 	case Is:
 		s = "IS"
 	case IsNot:
 		s = "IS NOT"
+	case IsDistinctFrom:
+		s = "IS DISTINCT FROM"
+	case IsNotDistinctFrom:
+		s = "IS NOT DISTINCT FROM"
 	case Plus:
 		s = "+"
 	case Minus:
@@ -0,0 +1,117 @@
+package parser
+
+import (
+	"encoding/base64"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// Bind returns a copy of stmt whose placeholders (ParamAST) are replaced
+// with literals of the values in params. Values of positional placeholders
+// are looked up by their positions, i.e., "1", "2", etc., and named ones by
+// their names without the leading colon. Bind returns an error when a value
+// of a placeholder isn't given, and values in params not used by stmt are
+// ignored. Because values are embedded as literals, not as a part of the
+// statement string, they cannot change the structure of the statement.
+//
+// Blobs and Timestamps are bound as strings casted to the corresponding type.
+// NaN and infinities cannot be bound since BQL has no literals of them.
+func Bind(stmt Statement, params map[string]data.Value) (Statement, error) {
+	missing := map[string]bool{}
+	var errs []string
+	res := rewriteStmt(stmt, func(e Expression) Expression {
+		p, ok := e.(ParamAST)
+		if !ok {
+			return e
+		}
+		v, ok := params[p.Name]
+		if !ok {
+			missing[p.String()+placeholderPosition(p)] = true
+			return e
+		}
+		l, err := valueToLiteral(v)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%v%v: %v", p, placeholderPosition(p), err))
+			return e
+		}
+		return l
+	})
+
+	if len(missing) > 0 {
+		ps := make([]string, 0, len(missing))
+		for p := range missing {
+			ps = append(ps, p)
+		}
+		sort.Strings(ps)
+		errs = append(errs, fmt.Sprintf("missing values of placeholders: %v", strings.Join(ps, ", ")))
+	}
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("cannot bind values: %v", strings.Join(errs, "; "))
+	}
+	return res, nil
+}
+
+func placeholderPosition(p ParamAST) string {
+	if p.Positional {
+		return "(" + p.Name + ")"
+	}
+	return ""
+}
+
+// valueToLiteral converts v to an expression which is evaluated to v.
+func valueToLiteral(v data.Value) (Expression, error) {
+	switch v.Type() {
+	case data.TypeNull:
+		return NullLiteral{}, nil
+	case data.TypeBool:
+		b, _ := data.AsBool(v)
+		return BoolLiteral{b}, nil
+	case data.TypeInt:
+		i, _ := data.AsInt(v)
+		return NumericLiteral{i}, nil
+	case data.TypeFloat:
+		f, _ := data.AsFloat(v)
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			// BQL doesn't have literals of them
+			return nil, fmt.Errorf("cannot bind %v", f)
+		}
+		return FloatLiteral{f}, nil
+	case data.TypeString:
+		s, _ := data.AsString(v)
+		return StringLiteral{s}, nil
+	case data.TypeBlob:
+		b, _ := data.AsBlob(v)
+		return TypeCastAST{StringLiteral{base64.StdEncoding.EncodeToString(b)}, Blob}, nil
+	case data.TypeTimestamp:
+		t, _ := data.AsTimestamp(v)
+		return TypeCastAST{StringLiteral{t.Format(time.RFC3339Nano)}, Timestamp}, nil
+	case data.TypeArray:
+		a, _ := data.AsArray(v)
+		exprs := make([]Expression, len(a))
+		for i, e := range a {
+			l, err := valueToLiteral(e)
+			if err != nil {
+				return nil, err
+			}
+			exprs[i] = l
+		}
+		return ArrayAST{ExpressionsAST{exprs}}, nil
+	case data.TypeMap:
+		m, _ := data.AsMap(v)
+		entries := make([]KeyValuePairAST, 0, len(m))
+		for _, k := range m.SortedKeys() {
+			l, err := valueToLiteral(m[k])
+			if err != nil {
+				return nil, err
+			}
+			entries = append(entries, KeyValuePairAST{k, l})
+		}
+		return MapAST{entries}, nil
+	}
+	return nil, fmt.Errorf("unsupported type: %v", v.Type())
+}
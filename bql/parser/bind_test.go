@@ -0,0 +1,133 @@
+package parser
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestBind(t *testing.T) {
+	p := New()
+	parse := func(s string) Statement {
+		stmt, _, err := p.ParseStmt(s)
+		So(err, ShouldBeNil)
+		return stmt.(Statement)
+	}
+
+	Convey("Given a statement having positional placeholders", t, func() {
+		stmt := parse("SELECT ISTREAM * FROM s [RANGE 1 TUPLES] WHERE x = ? AND y = ?")
+
+		Convey("When binding values", func() {
+			res, err := Bind(stmt, map[string]data.Value{
+				"1": data.Int(3),
+				"2": data.String(`a" OR true OR "`),
+			})
+			So(err, ShouldBeNil)
+
+			Convey("Then placeholders should be replaced with literals", func() {
+				So(res.(SelectStmt).Filter, ShouldResemble, BinaryOpAST{And,
+					BinaryOpAST{Equal, RowValue{"", "x"}, NumericLiteral{3}},
+					BinaryOpAST{Equal, RowValue{"", "y"}, StringLiteral{`a" OR true OR "`}}})
+			})
+
+			Convey("Then the string should be quoted in the result", func() {
+				s := res.String()
+				So(s, ShouldEqual, `SELECT ISTREAM * FROM s [RANGE 1 TUPLES] WHERE x = 3 AND y = "a"" OR true OR """`)
+
+				Convey("And parsing it should give the same statement", func() {
+					So(parse(s), ShouldResemble, res)
+				})
+			})
+
+			Convey("Then the original statement should not be modified", func() {
+				So(stmt.String(), ShouldEqual, "SELECT ISTREAM * FROM s [RANGE 1 TUPLES] WHERE x = ? AND y = ?")
+			})
+		})
+
+		Convey("When binding a value to only one of them", func() {
+			_, err := Bind(stmt, map[string]data.Value{
+				"1": data.Int(3),
+			})
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "missing values of placeholders: ?(2)")
+			})
+		})
+	})
+
+	Convey("Given a statement having named placeholders", t, func() {
+		stmt := parse(`EVAL [:a, :b, :a] ON {"x": :c}`)
+
+		Convey("When binding values of various types", func() {
+			ts := time.Date(2015, time.May, 1, 14, 27, 0, 0, time.UTC)
+			res, err := Bind(stmt, map[string]data.Value{
+				"a": data.Null{},
+				"b": data.Map{
+					"f": data.Float(1.5),
+					"t": data.Bool(true),
+					"l": data.Array{data.Int(1)},
+				},
+				"c":      data.Timestamp(ts),
+				"unused": data.Int(1),
+			})
+			So(err, ShouldBeNil)
+
+			Convey("Then placeholders should be replaced with literals", func() {
+				So(res, ShouldResemble, EvalStmt{
+					Expr: ArrayAST{ExpressionsAST{[]Expression{
+						NullLiteral{},
+						MapAST{[]KeyValuePairAST{
+							{"f", FloatLiteral{1.5}},
+							{"l", ArrayAST{ExpressionsAST{[]Expression{NumericLiteral{1}}}}},
+							{"t", BoolLiteral{true}},
+						}},
+						NullLiteral{},
+					}}},
+					Input: &MapAST{[]KeyValuePairAST{
+						{"x", TypeCastAST{StringLiteral{"2015-05-01T14:27:00Z"}, Timestamp}},
+					}},
+				})
+			})
+		})
+
+		Convey("When binding no values", func() {
+			_, err := Bind(stmt, nil)
+
+			Convey("Then it should report all missing placeholders", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "missing values of placeholders: :a, :b, :c")
+			})
+		})
+
+		Convey("When binding floats which don't have literals", func() {
+			for _, f := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+				_, err := Bind(stmt, map[string]data.Value{
+					"a": data.Int(1),
+					"b": data.Array{data.Float(f)},
+					"c": data.Int(1),
+				})
+
+				Convey("Then it should fail for "+data.Float(f).String(), func() {
+					So(err, ShouldNotBeNil)
+					So(err.Error(), ShouldContainSubstring, ":b: cannot bind")
+				})
+			}
+		})
+	})
+
+	Convey("Given a statement without placeholders", t, func() {
+		stmt := parse("DROP SOURCE s")
+
+		Convey("When binding values", func() {
+			res, err := Bind(stmt, nil)
+
+			Convey("Then it should be returned as it is", func() {
+				So(err, ShouldBeNil)
+				So(res, ShouldResemble, stmt)
+			})
+		})
+	})
+}
@@ -18,6 +18,7 @@ const (
 	ruleStatementWithRest
 	ruleStatementWithoutRest
 	ruleStatement
+	ruleExplainableStmt
 	ruleSourceStmt
 	ruleSinkStmt
 	ruleStateStmt
@@ -44,6 +45,7 @@ const (
 	ruleLoadStateOrCreateStmt
 	ruleSaveStateStmt
 	ruleEvalStmt
+	ruleExplainStmt
 	ruleEmitter
 	ruleEmitterOptions
 	ruleEmitterOptionCombinations
@@ -76,6 +78,7 @@ const (
 	ruleSheddingOption
 	ruleSourceSinkSpecs
 	ruleUpdateSourceSinkSpecs
+	ruleUpdateStateMapSpecs
 	ruleSetOptSpecs
 	ruleStateTagOpt
 	ruleSourceSinkParam
@@ -91,6 +94,7 @@ const (
 	ruleandExpr
 	rulenotExpr
 	rulecomparisonExpr
+	ruleinExpr
 	ruleotherOpExpr
 	ruleisExpr
 	ruletermExpr
@@ -109,6 +113,8 @@ const (
 	ruleSortedExpression
 	ruleOrderDirectionOpt
 	ruleArrayExpr
+	ruleRowExpr
+	ruleInList
 	ruleMapExpr
 	ruleKeyValuePair
 	ruleCase
@@ -117,14 +123,19 @@ const (
 	ruleWhenThenPair
 	ruleLiteral
 	ruleComparisonOp
+	ruleInOp
 	ruleOtherOp
 	ruleIsOp
+	ruleDistinctFromOp
 	rulePlusMinusOp
 	ruleMultDivOp
 	ruleStream
 	ruleRowMeta
 	ruleRowTimestamp
 	ruleRowValue
+	ruleParam
+	rulePositionalParam
+	ruleNamedParam
 	ruleNumericLiteral
 	ruleNonNegativeNumericLiteral
 	ruleFloatLiteral
@@ -136,6 +147,9 @@ const (
 	ruleFALSE
 	ruleWildcard
 	ruleStringLiteral
+	ruleQuotedStringLiteral
+	ruleDollarQuotedStringLiteral
+	ruleIntervalLiteral
 	ruleISTREAM
 	ruleDSTREAM
 	ruleRSTREAM
@@ -170,9 +184,15 @@ const (
 	ruleGreater
 	ruleGreaterOrEqual
 	ruleNotEqual
+	ruleIn
+	ruleNotIn
 	ruleConcat
+	ruleRegex
+	ruleNotRegex
 	ruleIs
 	ruleIsNot
+	ruleIsDistinctFrom
+	ruleIsNotDistinctFrom
 	rulePlus
 	ruleMinus
 	ruleMultiply
@@ -182,6 +202,9 @@ const (
 	ruleIdentifier
 	ruleTargetIdentifier
 	ruleident
+	ruledollarQuoteOpen
+	ruledollarQuoteClose
+	ruledollarQuoteTag
 	rulejsonGetPath
 	rulejsonSetPath
 	rulejsonPathHead
@@ -339,6 +362,21 @@ const (
 	ruleAction133
 	ruleAction134
 	ruleAction135
+	ruleAction136
+	ruleAction137
+	ruleAction138
+	ruleAction139
+	ruleAction140
+	ruleAction141
+	ruleAction142
+	ruleAction143
+	ruleAction144
+	ruleAction145
+	ruleAction146
+	ruleAction147
+	ruleAction148
+	ruleAction149
+	ruleAction150
 )
 
 var rul3s = [...]string{
@@ -347,6 +385,7 @@ var rul3s = [...]string{
 	"StatementWithRest",
 	"StatementWithoutRest",
 	"Statement",
+	"ExplainableStmt",
 	"SourceStmt",
 	"SinkStmt",
 	"StateStmt",
@@ -373,6 +412,7 @@ var rul3s = [...]string{
 	"LoadStateOrCreateStmt",
 	"SaveStateStmt",
 	"EvalStmt",
+	"ExplainStmt",
 	"Emitter",
 	"EmitterOptions",
 	"EmitterOptionCombinations",
@@ -405,6 +445,7 @@ var rul3s = [...]string{
 	"SheddingOption",
 	"SourceSinkSpecs",
 	"UpdateSourceSinkSpecs",
+	"UpdateStateMapSpecs",
 	"SetOptSpecs",
 	"StateTagOpt",
 	"SourceSinkParam",
@@ -420,6 +461,7 @@ var rul3s = [...]string{
 	"andExpr",
 	"notExpr",
 	"comparisonExpr",
+	"inExpr",
 	"otherOpExpr",
 	"isExpr",
 	"termExpr",
@@ -438,6 +480,8 @@ var rul3s = [...]string{
 	"SortedExpression",
 	"OrderDirectionOpt",
 	"ArrayExpr",
+	"RowExpr",
+	"InList",
 	"MapExpr",
 	"KeyValuePair",
 	"Case",
@@ -446,14 +490,19 @@ var rul3s = [...]string{
 	"WhenThenPair",
 	"Literal",
 	"ComparisonOp",
+	"InOp",
 	"OtherOp",
 	"IsOp",
+	"DistinctFromOp",
 	"PlusMinusOp",
 	"MultDivOp",
 	"Stream",
 	"RowMeta",
 	"RowTimestamp",
 	"RowValue",
+	"Param",
+	"PositionalParam",
+	"NamedParam",
 	"NumericLiteral",
 	"NonNegativeNumericLiteral",
 	"FloatLiteral",
@@ -465,6 +514,9 @@ var rul3s = [...]string{
 	"FALSE",
 	"Wildcard",
 	"StringLiteral",
+	"QuotedStringLiteral",
+	"DollarQuotedStringLiteral",
+	"IntervalLiteral",
 	"ISTREAM",
 	"DSTREAM",
 	"RSTREAM",
@@ -499,9 +551,15 @@ var rul3s = [...]string{
 	"Greater",
 	"GreaterOrEqual",
 	"NotEqual",
+	"In",
+	"NotIn",
 	"Concat",
+	"Regex",
+	"NotRegex",
 	"Is",
 	"IsNot",
+	"IsDistinctFrom",
+	"IsNotDistinctFrom",
 	"Plus",
 	"Minus",
 	"Multiply",
@@ -511,6 +569,9 @@ var rul3s = [...]string{
 	"Identifier",
 	"TargetIdentifier",
 	"ident",
+	"dollarQuoteOpen",
+	"dollarQuoteClose",
+	"dollarQuoteTag",
 	"jsonGetPath",
 	"jsonSetPath",
 	"jsonPathHead",
@@ -668,6 +729,21 @@ var rul3s = [...]string{
 	"Action133",
 	"Action134",
 	"Action135",
+	"Action136",
+	"Action137",
+	"Action138",
+	"Action139",
+	"Action140",
+	"Action141",
+	"Action142",
+	"Action143",
+	"Action144",
+	"Action145",
+	"Action146",
+	"Action147",
+	"Action148",
+	"Action149",
+	"Action150",
 }
 
 type token32 struct {
@@ -781,10 +857,13 @@ func (t *tokens32) Tokens() []token32 {
 
 type bqlPegBackend struct {
 	parseStack
+	dollarQuoteTag      string
+	foldIdentifiers     bool
+	numPositionalParams int
 
 	Buffer string
 	buffer []rune
-	rules  [326]func() bool
+	rules  [364]func() bool
 	parse  func(rule ...int) error
 	reset  func()
 	Pretty bool
@@ -974,99 +1053,99 @@ func (p *bqlPegBackend) Execute() {
 
 		case ruleAction24:
 
-			p.AssembleEmitter()
+			p.AssembleExplain(begin, end)
 
 		case ruleAction25:
 
-			p.AssembleEmitterOptions(begin, end)
+			p.AssembleEmitter()
 
 		case ruleAction26:
 
-			p.AssembleEmitterLimit()
+			p.AssembleEmitterOptions(begin, end)
 
 		case ruleAction27:
 
-			p.AssembleEmitterSampling(CountBasedSampling, 1)
+			p.AssembleEmitterLimit()
 
 		case ruleAction28:
 
-			p.AssembleEmitterSampling(RandomizedSampling, 1)
+			p.AssembleEmitterSampling(CountBasedSampling, 1)
 
 		case ruleAction29:
 
-			p.AssembleEmitterSampling(TimeBasedSampling, 1)
+			p.AssembleEmitterSampling(RandomizedSampling, 1)
 
 		case ruleAction30:
 
-			p.AssembleEmitterSampling(TimeBasedSampling, 0.001)
+			p.AssembleEmitterSampling(TimeBasedSampling, 1)
 
 		case ruleAction31:
 
-			p.AssembleProjections(begin, end)
+			p.AssembleEmitterSampling(TimeBasedSampling, 0.001)
 
 		case ruleAction32:
 
-			p.AssembleAlias()
+			p.AssembleProjections(begin, end)
 
 		case ruleAction33:
 
+			p.AssembleAlias()
+
+		case ruleAction34:
+
 			// This is *always* executed, even if there is no
 			// FROM clause present in the statement.
 			p.AssembleWindowedFrom(begin, end)
 
-		case ruleAction34:
+		case ruleAction35:
 
 			p.AssembleInterval()
 
-		case ruleAction35:
+		case ruleAction36:
 
 			p.AssembleInterval()
 
-		case ruleAction36:
+		case ruleAction37:
 
 			// This is *always* executed, even if there is no
 			// WHERE clause present in the statement.
 			p.AssembleFilter(begin, end)
 
-		case ruleAction37:
+		case ruleAction38:
 
 			// This is *always* executed, even if there is no
 			// GROUP BY clause present in the statement.
 			p.AssembleGrouping(begin, end)
 
-		case ruleAction38:
+		case ruleAction39:
 
 			// This is *always* executed, even if there is no
 			// HAVING clause present in the statement.
 			p.AssembleHaving(begin, end)
 
-		case ruleAction39:
-
-			p.EnsureAliasedStreamWindow()
-
 		case ruleAction40:
 
-			p.AssembleAliasedStreamWindow()
+			p.EnsureAliasedStreamWindow()
 
 		case ruleAction41:
 
-			p.AssembleStreamWindow()
+			p.AssembleAliasedStreamWindow()
 
 		case ruleAction42:
 
-			p.AssembleUDSFFuncApp()
+			p.AssembleStreamWindow()
 
 		case ruleAction43:
 
-			p.EnsureCapacitySpec(begin, end)
+			p.AssembleUDSFFuncApp()
 
 		case ruleAction44:
 
-			p.EnsureSheddingSpec(begin, end)
+			p.EnsureCapacitySpec(begin, end)
 
 		case ruleAction45:
 
-			p.AssembleSourceSinkSpecs(begin, end)
+			p.EnsureSheddingSpec(begin, end)
 
 		case ruleAction46:
 
@@ -1078,40 +1157,40 @@ func (p *bqlPegBackend) Execute() {
 
 		case ruleAction48:
 
-			p.EnsureIdentifier(begin, end)
+			p.AssembleSourceSinkSpecsFromMap(begin, end)
 
 		case ruleAction49:
 
-			p.AssembleSourceSinkParam()
+			p.AssembleSourceSinkSpecs(begin, end)
 
 		case ruleAction50:
 
-			p.AssembleExpressions(begin, end)
-			p.AssembleArray()
+			p.EnsureIdentifier(begin, end)
 
 		case ruleAction51:
 
-			p.AssembleMap(begin, end)
+			p.AssembleSourceSinkParam()
 
 		case ruleAction52:
 
-			p.AssembleKeyValuePair()
+			p.AssembleExpressions(begin, end)
+			p.AssembleArray()
 
 		case ruleAction53:
 
-			p.EnsureKeywordPresent(begin, end)
+			p.AssembleMap(begin, end)
 
 		case ruleAction54:
 
-			p.AssembleBinaryOperation(begin, end)
+			p.AssembleKeyValuePair()
 
 		case ruleAction55:
 
-			p.AssembleBinaryOperation(begin, end)
+			p.EnsureKeywordPresent(begin, end)
 
 		case ruleAction56:
 
-			p.AssembleUnaryPrefixOperation(begin, end)
+			p.AssembleBinaryOperation(begin, end)
 
 		case ruleAction57:
 
@@ -1119,7 +1198,7 @@ func (p *bqlPegBackend) Execute() {
 
 		case ruleAction58:
 
-			p.AssembleBinaryOperation(begin, end)
+			p.AssembleUnaryPrefixOperation(begin, end)
 
 		case ruleAction59:
 
@@ -1127,7 +1206,7 @@ func (p *bqlPegBackend) Execute() {
 
 		case ruleAction60:
 
-			p.AssembleBinaryOperation(begin, end)
+			p.AssembleIn(begin, end)
 
 		case ruleAction61:
 
@@ -1135,341 +1214,407 @@ func (p *bqlPegBackend) Execute() {
 
 		case ruleAction62:
 
-			p.AssembleUnaryPrefixOperation(begin, end)
+			p.AssembleBinaryOperation(begin, end)
 
 		case ruleAction63:
 
-			p.AssembleTypeCast(begin, end)
+			p.AssembleBinaryOperation(begin, end)
 
 		case ruleAction64:
 
-			p.AssembleTypeCast(begin, end)
+			p.AssembleBinaryOperation(begin, end)
 
 		case ruleAction65:
 
-			p.AssembleFuncAppSelector()
+			p.AssembleUnaryPrefixOperation(begin, end)
 
 		case ruleAction66:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, NewRaw(substr))
+			p.AssembleTypeCast(begin, end)
 
 		case ruleAction67:
 
-			p.AssembleFuncApp()
+			p.AssembleTypeCast(begin, end)
 
 		case ruleAction68:
 
-			p.AssembleExpressions(begin, end)
-			p.AssembleFuncApp()
+			p.AssembleFuncAppSelector()
 
 		case ruleAction69:
 
-			p.AssembleExpressions(begin, end)
+			substr := p.foldIdentifier(string([]rune(buffer)[begin:end]))
+			p.PushComponent(begin, end, NewRaw(substr))
 
 		case ruleAction70:
 
-			p.AssembleExpressions(begin, end)
+			p.AssembleFuncApp()
 
 		case ruleAction71:
 
-			p.AssembleSortedExpression()
+			p.AssembleExpressions(begin, end)
+			p.AssembleFuncApp()
 
 		case ruleAction72:
 
-			p.EnsureKeywordPresent(begin, end)
+			p.AssembleExpressions(begin, end)
 
 		case ruleAction73:
 
 			p.AssembleExpressions(begin, end)
-			p.AssembleArray()
 
 		case ruleAction74:
 
-			p.AssembleMap(begin, end)
+			p.AssembleSortedExpression()
 
 		case ruleAction75:
 
-			p.AssembleKeyValuePair()
+			p.EnsureKeywordPresent(begin, end)
 
 		case ruleAction76:
 
-			p.AssembleConditionCase(begin, end)
+			p.AssembleExpressions(begin, end)
+			p.AssembleArray()
 
 		case ruleAction77:
 
-			p.AssembleExpressionCase(begin, end)
+			p.AssembleExpressions(begin, end)
+			p.AssembleRow()
 
 		case ruleAction78:
 
-			p.AssembleWhenThenPair()
+			p.AssembleExpressions(begin, end)
+			p.AssembleRow()
 
 		case ruleAction79:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, NewStream(substr))
+			p.AssembleMap(begin, end)
 
 		case ruleAction80:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, NewRowMeta(substr, TimestampMeta))
+			p.AssembleKeyValuePair()
 
 		case ruleAction81:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, NewRowValue(substr))
+			p.AssembleConditionCase(begin, end)
 
 		case ruleAction82:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, NewNumericLiteral(substr))
+			p.AssembleExpressionCase(begin, end)
 
 		case ruleAction83:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, NewNumericLiteral(substr))
+			p.AssembleWhenThenPair()
 
 		case ruleAction84:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, NewFloatLiteral(substr))
+			substr := p.foldIdentifier(string([]rune(buffer)[begin:end]))
+			p.PushComponent(begin, end, NewStream(substr))
 
 		case ruleAction85:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, FuncName(substr))
+			substr := p.foldIdentifier(string([]rune(buffer)[begin:end]))
+			p.PushComponent(begin, end, NewRowMeta(substr, TimestampMeta))
 
 		case ruleAction86:
 
-			p.PushComponent(begin, end, NewNullLiteral())
+			substr := p.foldIdentifier(string([]rune(buffer)[begin:end]))
+			p.PushComponent(begin, end, NewRowValue(substr))
 
 		case ruleAction87:
 
-			p.PushComponent(begin, end, NewMissing())
+			p.numPositionalParams++
+			p.PushComponent(begin, end, NewPositionalParam(p.numPositionalParams))
 
 		case ruleAction88:
 
-			p.PushComponent(begin, end, NewBoolLiteral(true))
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, NewNamedParam(substr))
 
 		case ruleAction89:
 
-			p.PushComponent(begin, end, NewBoolLiteral(false))
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, NewNumericLiteral(substr))
 
 		case ruleAction90:
 
 			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, NewWildcard(substr))
+			p.PushComponent(begin, end, NewNumericLiteral(substr))
 
 		case ruleAction91:
 
 			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, NewStringLiteral(substr))
+			p.PushComponent(begin, end, NewFloatLiteral(substr))
 
 		case ruleAction92:
 
-			p.PushComponent(begin, end, Istream)
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, FuncName(substr))
 
 		case ruleAction93:
 
-			p.PushComponent(begin, end, Dstream)
+			p.PushComponent(begin, end, NewNullLiteral())
 
 		case ruleAction94:
 
-			p.PushComponent(begin, end, Rstream)
+			p.PushComponent(begin, end, NewMissing())
 
 		case ruleAction95:
 
-			p.PushComponent(begin, end, Tuples)
+			p.PushComponent(begin, end, NewBoolLiteral(true))
 
 		case ruleAction96:
 
-			p.PushComponent(begin, end, Seconds)
+			p.PushComponent(begin, end, NewBoolLiteral(false))
 
 		case ruleAction97:
 
-			p.PushComponent(begin, end, Milliseconds)
+			substr := p.foldIdentifier(string([]rune(buffer)[begin:end]))
+			p.PushComponent(begin, end, NewWildcard(substr))
 
 		case ruleAction98:
 
-			p.PushComponent(begin, end, Wait)
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, NewStringLiteral(substr))
 
 		case ruleAction99:
 
-			p.PushComponent(begin, end, DropOldest)
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, NewDollarQuotedStringLiteral(substr))
 
 		case ruleAction100:
 
-			p.PushComponent(begin, end, DropNewest)
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, NewIntervalLiteral(substr))
 
 		case ruleAction101:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, StreamIdentifier(substr))
+			p.PushComponent(begin, end, Istream)
 
 		case ruleAction102:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, SourceSinkType(substr))
+			p.PushComponent(begin, end, Dstream)
 
 		case ruleAction103:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, SourceSinkParamKey(substr))
+			p.PushComponent(begin, end, Rstream)
 
 		case ruleAction104:
 
-			p.PushComponent(begin, end, Yes)
+			p.PushComponent(begin, end, Tuples)
 
 		case ruleAction105:
 
-			p.PushComponent(begin, end, No)
+			p.PushComponent(begin, end, Seconds)
 
 		case ruleAction106:
 
-			p.PushComponent(begin, end, Yes)
+			p.PushComponent(begin, end, Milliseconds)
 
 		case ruleAction107:
 
-			p.PushComponent(begin, end, No)
+			p.PushComponent(begin, end, Wait)
 
 		case ruleAction108:
 
-			p.PushComponent(begin, end, Bool)
+			p.PushComponent(begin, end, DropOldest)
 
 		case ruleAction109:
 
-			p.PushComponent(begin, end, Int)
+			p.PushComponent(begin, end, DropNewest)
 
 		case ruleAction110:
 
-			p.PushComponent(begin, end, Float)
+			substr := p.foldIdentifier(string([]rune(buffer)[begin:end]))
+			p.PushComponent(begin, end, StreamIdentifier(substr))
 
 		case ruleAction111:
 
-			p.PushComponent(begin, end, String)
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, SourceSinkType(substr))
 
 		case ruleAction112:
 
-			p.PushComponent(begin, end, Blob)
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, SourceSinkParamKey(substr))
 
 		case ruleAction113:
 
-			p.PushComponent(begin, end, Timestamp)
+			p.PushComponent(begin, end, Yes)
 
 		case ruleAction114:
 
-			p.PushComponent(begin, end, Array)
+			p.PushComponent(begin, end, No)
 
 		case ruleAction115:
 
-			p.PushComponent(begin, end, Map)
+			p.PushComponent(begin, end, Yes)
 
 		case ruleAction116:
 
-			p.PushComponent(begin, end, Or)
+			p.PushComponent(begin, end, No)
 
 		case ruleAction117:
 
-			p.PushComponent(begin, end, And)
+			p.PushComponent(begin, end, Bool)
 
 		case ruleAction118:
 
-			p.PushComponent(begin, end, Not)
+			p.PushComponent(begin, end, Int)
 
 		case ruleAction119:
 
-			p.PushComponent(begin, end, Equal)
+			p.PushComponent(begin, end, Float)
 
 		case ruleAction120:
 
-			p.PushComponent(begin, end, Less)
+			p.PushComponent(begin, end, String)
 
 		case ruleAction121:
 
-			p.PushComponent(begin, end, LessOrEqual)
+			p.PushComponent(begin, end, Blob)
 
 		case ruleAction122:
 
-			p.PushComponent(begin, end, Greater)
+			p.PushComponent(begin, end, Timestamp)
 
 		case ruleAction123:
 
-			p.PushComponent(begin, end, GreaterOrEqual)
+			p.PushComponent(begin, end, Array)
 
 		case ruleAction124:
 
-			p.PushComponent(begin, end, NotEqual)
+			p.PushComponent(begin, end, Map)
 
 		case ruleAction125:
 
-			p.PushComponent(begin, end, Concat)
+			p.PushComponent(begin, end, Or)
 
 		case ruleAction126:
 
-			p.PushComponent(begin, end, Is)
+			p.PushComponent(begin, end, And)
 
 		case ruleAction127:
 
-			p.PushComponent(begin, end, IsNot)
+			p.PushComponent(begin, end, Not)
 
 		case ruleAction128:
 
-			p.PushComponent(begin, end, Plus)
+			p.PushComponent(begin, end, Equal)
 
 		case ruleAction129:
 
-			p.PushComponent(begin, end, Minus)
+			p.PushComponent(begin, end, Less)
 
 		case ruleAction130:
 
-			p.PushComponent(begin, end, Multiply)
+			p.PushComponent(begin, end, LessOrEqual)
 
 		case ruleAction131:
 
-			p.PushComponent(begin, end, Divide)
+			p.PushComponent(begin, end, Greater)
 
 		case ruleAction132:
 
-			p.PushComponent(begin, end, Modulo)
+			p.PushComponent(begin, end, GreaterOrEqual)
 
 		case ruleAction133:
 
-			p.PushComponent(begin, end, UnaryMinus)
+			p.PushComponent(begin, end, NotEqual)
 
 		case ruleAction134:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, Identifier(substr))
+			p.PushComponent(begin, end, In)
 
 		case ruleAction135:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, Identifier(substr))
+			p.PushComponent(begin, end, NotIn)
 
-		}
-	}
-	_, _, _, _, _ = buffer, _buffer, text, begin, end
-}
+		case ruleAction136:
 
-func (p *bqlPegBackend) Init() {
-	var (
-		max                  token32
-		position, tokenIndex uint32
-		buffer               []rune
-	)
-	p.reset = func() {
-		max = token32{}
-		position, tokenIndex = 0, 0
+			p.PushComponent(begin, end, Concat)
 
-		p.buffer = []rune(p.Buffer)
-		if len(p.buffer) == 0 || p.buffer[len(p.buffer)-1] != endSymbol {
-			p.buffer = append(p.buffer, endSymbol)
-		}
-		buffer = p.buffer
-	}
-	p.reset()
+		case ruleAction137:
 
-	_rules := p.rules
+			p.PushComponent(begin, end, Regex)
+
+		case ruleAction138:
+
+			p.PushComponent(begin, end, NotRegex)
+
+		case ruleAction139:
+
+			p.PushComponent(begin, end, Is)
+
+		case ruleAction140:
+
+			p.PushComponent(begin, end, IsNot)
+
+		case ruleAction141:
+
+			p.PushComponent(begin, end, IsDistinctFrom)
+
+		case ruleAction142:
+
+			p.PushComponent(begin, end, IsNotDistinctFrom)
+
+		case ruleAction143:
+
+			p.PushComponent(begin, end, Plus)
+
+		case ruleAction144:
+
+			p.PushComponent(begin, end, Minus)
+
+		case ruleAction145:
+
+			p.PushComponent(begin, end, Multiply)
+
+		case ruleAction146:
+
+			p.PushComponent(begin, end, Divide)
+
+		case ruleAction147:
+
+			p.PushComponent(begin, end, Modulo)
+
+		case ruleAction148:
+
+			p.PushComponent(begin, end, UnaryMinus)
+
+		case ruleAction149:
+
+			substr := p.foldIdentifier(string([]rune(buffer)[begin:end]))
+			p.PushComponent(begin, end, Identifier(substr))
+
+		case ruleAction150:
+
+			substr := p.foldIdentifier(string([]rune(buffer)[begin:end]))
+			p.PushComponent(begin, end, Identifier(substr))
+
+		}
+	}
+	_, _, _, _, _ = buffer, _buffer, text, begin, end
+}
+
+func (p *bqlPegBackend) Init() {
+	var (
+		max                  token32
+		position, tokenIndex uint32
+		buffer               []rune
+	)
+	p.reset = func() {
+		max = token32{}
+		position, tokenIndex = 0, 0
+
+		p.buffer = []rune(p.Buffer)
+		if len(p.buffer) == 0 || p.buffer[len(p.buffer)-1] != endSymbol {
+			p.buffer = append(p.buffer, endSymbol)
+		}
+		buffer = p.buffer
+	}
+	p.reset()
+
+	_rules := p.rules
 	tree := tokens32{tree: make([]token32, math.MaxInt16)}
 	p.parse = func(rule ...int) error {
 		r := 1
@@ -1623,273 +1768,268 @@ func (p *bqlPegBackend) Init() {
 			position, tokenIndex = position10, tokenIndex10
 			return false
 		},
-		/* 3 Statement <- <(SelectUnionStmt / SelectStmt / SourceStmt / SinkStmt / StateStmt / StreamStmt / EvalStmt)> */
+		/* 3 Statement <- <(ExplainStmt / ExplainableStmt)> */
 		func() bool {
 			position13, tokenIndex13 := position, tokenIndex
 			{
 				position14 := position
 				{
 					position15, tokenIndex15 := position, tokenIndex
-					if !_rules[ruleSelectUnionStmt]() {
+					if !_rules[ruleExplainStmt]() {
 						goto l16
 					}
 					goto l15
 				l16:
 					position, tokenIndex = position15, tokenIndex15
+					if !_rules[ruleExplainableStmt]() {
+						goto l13
+					}
+				}
+			l15:
+				add(ruleStatement, position14)
+			}
+			return true
+		l13:
+			position, tokenIndex = position13, tokenIndex13
+			return false
+		},
+		/* 4 ExplainableStmt <- <(SelectUnionStmt / SelectStmt / SourceStmt / SinkStmt / StateStmt / StreamStmt / EvalStmt)> */
+		func() bool {
+			position17, tokenIndex17 := position, tokenIndex
+			{
+				position18 := position
+				{
+					position19, tokenIndex19 := position, tokenIndex
+					if !_rules[ruleSelectUnionStmt]() {
+						goto l20
+					}
+					goto l19
+				l20:
+					position, tokenIndex = position19, tokenIndex19
 					if !_rules[ruleSelectStmt]() {
-						goto l17
+						goto l21
 					}
-					goto l15
-				l17:
-					position, tokenIndex = position15, tokenIndex15
+					goto l19
+				l21:
+					position, tokenIndex = position19, tokenIndex19
 					if !_rules[ruleSourceStmt]() {
-						goto l18
+						goto l22
 					}
-					goto l15
-				l18:
-					position, tokenIndex = position15, tokenIndex15
+					goto l19
+				l22:
+					position, tokenIndex = position19, tokenIndex19
 					if !_rules[ruleSinkStmt]() {
-						goto l19
+						goto l23
 					}
-					goto l15
-				l19:
-					position, tokenIndex = position15, tokenIndex15
+					goto l19
+				l23:
+					position, tokenIndex = position19, tokenIndex19
 					if !_rules[ruleStateStmt]() {
-						goto l20
+						goto l24
 					}
-					goto l15
-				l20:
-					position, tokenIndex = position15, tokenIndex15
+					goto l19
+				l24:
+					position, tokenIndex = position19, tokenIndex19
 					if !_rules[ruleStreamStmt]() {
-						goto l21
+						goto l25
 					}
-					goto l15
-				l21:
-					position, tokenIndex = position15, tokenIndex15
+					goto l19
+				l25:
+					position, tokenIndex = position19, tokenIndex19
 					if !_rules[ruleEvalStmt]() {
-						goto l13
+						goto l17
 					}
 				}
-			l15:
-				add(ruleStatement, position14)
+			l19:
+				add(ruleExplainableStmt, position18)
 			}
 			return true
-		l13:
-			position, tokenIndex = position13, tokenIndex13
+		l17:
+			position, tokenIndex = position17, tokenIndex17
 			return false
 		},
-		/* 4 SourceStmt <- <(CreateSourceStmt / UpdateSourceStmt / DropSourceStmt / PauseSourceStmt / ResumeSourceStmt / RewindSourceStmt)> */
+		/* 5 SourceStmt <- <(CreateSourceStmt / UpdateSourceStmt / DropSourceStmt / PauseSourceStmt / ResumeSourceStmt / RewindSourceStmt)> */
 		func() bool {
-			position22, tokenIndex22 := position, tokenIndex
+			position26, tokenIndex26 := position, tokenIndex
 			{
-				position23 := position
+				position27 := position
 				{
-					position24, tokenIndex24 := position, tokenIndex
+					position28, tokenIndex28 := position, tokenIndex
 					if !_rules[ruleCreateSourceStmt]() {
-						goto l25
+						goto l29
 					}
-					goto l24
-				l25:
-					position, tokenIndex = position24, tokenIndex24
+					goto l28
+				l29:
+					position, tokenIndex = position28, tokenIndex28
 					if !_rules[ruleUpdateSourceStmt]() {
-						goto l26
+						goto l30
 					}
-					goto l24
-				l26:
-					position, tokenIndex = position24, tokenIndex24
+					goto l28
+				l30:
+					position, tokenIndex = position28, tokenIndex28
 					if !_rules[ruleDropSourceStmt]() {
-						goto l27
+						goto l31
 					}
-					goto l24
-				l27:
-					position, tokenIndex = position24, tokenIndex24
+					goto l28
+				l31:
+					position, tokenIndex = position28, tokenIndex28
 					if !_rules[rulePauseSourceStmt]() {
-						goto l28
+						goto l32
 					}
-					goto l24
-				l28:
-					position, tokenIndex = position24, tokenIndex24
+					goto l28
+				l32:
+					position, tokenIndex = position28, tokenIndex28
 					if !_rules[ruleResumeSourceStmt]() {
-						goto l29
+						goto l33
 					}
-					goto l24
-				l29:
-					position, tokenIndex = position24, tokenIndex24
+					goto l28
+				l33:
+					position, tokenIndex = position28, tokenIndex28
 					if !_rules[ruleRewindSourceStmt]() {
-						goto l22
+						goto l26
 					}
 				}
-			l24:
-				add(ruleSourceStmt, position23)
+			l28:
+				add(ruleSourceStmt, position27)
 			}
 			return true
-		l22:
-			position, tokenIndex = position22, tokenIndex22
+		l26:
+			position, tokenIndex = position26, tokenIndex26
 			return false
 		},
-		/* 5 SinkStmt <- <(CreateSinkStmt / UpdateSinkStmt / DropSinkStmt)> */
+		/* 6 SinkStmt <- <(CreateSinkStmt / UpdateSinkStmt / DropSinkStmt)> */
 		func() bool {
-			position30, tokenIndex30 := position, tokenIndex
+			position34, tokenIndex34 := position, tokenIndex
 			{
-				position31 := position
+				position35 := position
 				{
-					position32, tokenIndex32 := position, tokenIndex
+					position36, tokenIndex36 := position, tokenIndex
 					if !_rules[ruleCreateSinkStmt]() {
-						goto l33
+						goto l37
 					}
-					goto l32
-				l33:
-					position, tokenIndex = position32, tokenIndex32
+					goto l36
+				l37:
+					position, tokenIndex = position36, tokenIndex36
 					if !_rules[ruleUpdateSinkStmt]() {
-						goto l34
+						goto l38
 					}
-					goto l32
-				l34:
-					position, tokenIndex = position32, tokenIndex32
+					goto l36
+				l38:
+					position, tokenIndex = position36, tokenIndex36
 					if !_rules[ruleDropSinkStmt]() {
-						goto l30
+						goto l34
 					}
 				}
-			l32:
-				add(ruleSinkStmt, position31)
+			l36:
+				add(ruleSinkStmt, position35)
 			}
 			return true
-		l30:
-			position, tokenIndex = position30, tokenIndex30
+		l34:
+			position, tokenIndex = position34, tokenIndex34
 			return false
 		},
-		/* 6 StateStmt <- <(CreateStateStmt / UpdateStateStmt / DropStateStmt / LoadStateOrCreateStmt / LoadStateStmt / SaveStateStmt)> */
+		/* 7 StateStmt <- <(CreateStateStmt / UpdateStateStmt / DropStateStmt / LoadStateOrCreateStmt / LoadStateStmt / SaveStateStmt)> */
 		func() bool {
-			position35, tokenIndex35 := position, tokenIndex
+			position39, tokenIndex39 := position, tokenIndex
 			{
-				position36 := position
+				position40 := position
 				{
-					position37, tokenIndex37 := position, tokenIndex
+					position41, tokenIndex41 := position, tokenIndex
 					if !_rules[ruleCreateStateStmt]() {
-						goto l38
+						goto l42
 					}
-					goto l37
-				l38:
-					position, tokenIndex = position37, tokenIndex37
+					goto l41
+				l42:
+					position, tokenIndex = position41, tokenIndex41
 					if !_rules[ruleUpdateStateStmt]() {
-						goto l39
+						goto l43
 					}
-					goto l37
-				l39:
-					position, tokenIndex = position37, tokenIndex37
+					goto l41
+				l43:
+					position, tokenIndex = position41, tokenIndex41
 					if !_rules[ruleDropStateStmt]() {
-						goto l40
+						goto l44
 					}
-					goto l37
-				l40:
-					position, tokenIndex = position37, tokenIndex37
+					goto l41
+				l44:
+					position, tokenIndex = position41, tokenIndex41
 					if !_rules[ruleLoadStateOrCreateStmt]() {
-						goto l41
+						goto l45
 					}
-					goto l37
-				l41:
-					position, tokenIndex = position37, tokenIndex37
+					goto l41
+				l45:
+					position, tokenIndex = position41, tokenIndex41
 					if !_rules[ruleLoadStateStmt]() {
-						goto l42
+						goto l46
 					}
-					goto l37
-				l42:
-					position, tokenIndex = position37, tokenIndex37
+					goto l41
+				l46:
+					position, tokenIndex = position41, tokenIndex41
 					if !_rules[ruleSaveStateStmt]() {
-						goto l35
+						goto l39
 					}
 				}
-			l37:
-				add(ruleStateStmt, position36)
+			l41:
+				add(ruleStateStmt, position40)
 			}
 			return true
-		l35:
-			position, tokenIndex = position35, tokenIndex35
+		l39:
+			position, tokenIndex = position39, tokenIndex39
 			return false
 		},
-		/* 7 StreamStmt <- <(CreateStreamAsSelectUnionStmt / CreateStreamAsSelectStmt / DropStreamStmt / InsertIntoFromStmt)> */
+		/* 8 StreamStmt <- <(CreateStreamAsSelectUnionStmt / CreateStreamAsSelectStmt / DropStreamStmt / InsertIntoFromStmt)> */
 		func() bool {
-			position43, tokenIndex43 := position, tokenIndex
+			position47, tokenIndex47 := position, tokenIndex
 			{
-				position44 := position
+				position48 := position
 				{
-					position45, tokenIndex45 := position, tokenIndex
+					position49, tokenIndex49 := position, tokenIndex
 					if !_rules[ruleCreateStreamAsSelectUnionStmt]() {
-						goto l46
+						goto l50
 					}
-					goto l45
-				l46:
-					position, tokenIndex = position45, tokenIndex45
+					goto l49
+				l50:
+					position, tokenIndex = position49, tokenIndex49
 					if !_rules[ruleCreateStreamAsSelectStmt]() {
-						goto l47
+						goto l51
 					}
-					goto l45
-				l47:
-					position, tokenIndex = position45, tokenIndex45
+					goto l49
+				l51:
+					position, tokenIndex = position49, tokenIndex49
 					if !_rules[ruleDropStreamStmt]() {
-						goto l48
+						goto l52
 					}
-					goto l45
-				l48:
-					position, tokenIndex = position45, tokenIndex45
+					goto l49
+				l52:
+					position, tokenIndex = position49, tokenIndex49
 					if !_rules[ruleInsertIntoFromStmt]() {
-						goto l43
+						goto l47
 					}
 				}
-			l45:
-				add(ruleStreamStmt, position44)
+			l49:
+				add(ruleStreamStmt, position48)
 			}
 			return true
-		l43:
-			position, tokenIndex = position43, tokenIndex43
+		l47:
+			position, tokenIndex = position47, tokenIndex47
 			return false
 		},
-		/* 8 SelectStmt <- <(('s' / 'S') ('e' / 'E') ('l' / 'L') ('e' / 'E') ('c' / 'C') ('t' / 'T') Emitter Projections WindowedFrom Filter Grouping Having Action2)> */
+		/* 9 SelectStmt <- <(('s' / 'S') ('e' / 'E') ('l' / 'L') ('e' / 'E') ('c' / 'C') ('t' / 'T') Emitter Projections WindowedFrom Filter Grouping Having Action2)> */
 		func() bool {
-			position49, tokenIndex49 := position, tokenIndex
+			position53, tokenIndex53 := position, tokenIndex
 			{
-				position50 := position
-				{
-					position51, tokenIndex51 := position, tokenIndex
-					if buffer[position] != rune('s') {
-						goto l52
-					}
-					position++
-					goto l51
-				l52:
-					position, tokenIndex = position51, tokenIndex51
-					if buffer[position] != rune('S') {
-						goto l49
-					}
-					position++
-				}
-			l51:
-				{
-					position53, tokenIndex53 := position, tokenIndex
-					if buffer[position] != rune('e') {
-						goto l54
-					}
-					position++
-					goto l53
-				l54:
-					position, tokenIndex = position53, tokenIndex53
-					if buffer[position] != rune('E') {
-						goto l49
-					}
-					position++
-				}
-			l53:
+				position54 := position
 				{
 					position55, tokenIndex55 := position, tokenIndex
-					if buffer[position] != rune('l') {
+					if buffer[position] != rune('s') {
 						goto l56
 					}
 					position++
 					goto l55
 				l56:
 					position, tokenIndex = position55, tokenIndex55
-					if buffer[position] != rune('L') {
-						goto l49
+					if buffer[position] != rune('S') {
+						goto l53
 					}
 					position++
 				}
@@ -1904,559 +2044,550 @@ func (p *bqlPegBackend) Init() {
 				l58:
 					position, tokenIndex = position57, tokenIndex57
 					if buffer[position] != rune('E') {
-						goto l49
+						goto l53
 					}
 					position++
 				}
 			l57:
 				{
 					position59, tokenIndex59 := position, tokenIndex
-					if buffer[position] != rune('c') {
+					if buffer[position] != rune('l') {
 						goto l60
 					}
 					position++
 					goto l59
 				l60:
 					position, tokenIndex = position59, tokenIndex59
-					if buffer[position] != rune('C') {
-						goto l49
+					if buffer[position] != rune('L') {
+						goto l53
 					}
 					position++
 				}
 			l59:
 				{
 					position61, tokenIndex61 := position, tokenIndex
-					if buffer[position] != rune('t') {
+					if buffer[position] != rune('e') {
 						goto l62
 					}
 					position++
 					goto l61
 				l62:
 					position, tokenIndex = position61, tokenIndex61
-					if buffer[position] != rune('T') {
-						goto l49
+					if buffer[position] != rune('E') {
+						goto l53
 					}
 					position++
 				}
 			l61:
+				{
+					position63, tokenIndex63 := position, tokenIndex
+					if buffer[position] != rune('c') {
+						goto l64
+					}
+					position++
+					goto l63
+				l64:
+					position, tokenIndex = position63, tokenIndex63
+					if buffer[position] != rune('C') {
+						goto l53
+					}
+					position++
+				}
+			l63:
+				{
+					position65, tokenIndex65 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l66
+					}
+					position++
+					goto l65
+				l66:
+					position, tokenIndex = position65, tokenIndex65
+					if buffer[position] != rune('T') {
+						goto l53
+					}
+					position++
+				}
+			l65:
 				if !_rules[ruleEmitter]() {
-					goto l49
+					goto l53
 				}
 				if !_rules[ruleProjections]() {
-					goto l49
+					goto l53
 				}
 				if !_rules[ruleWindowedFrom]() {
-					goto l49
+					goto l53
 				}
 				if !_rules[ruleFilter]() {
-					goto l49
+					goto l53
 				}
 				if !_rules[ruleGrouping]() {
-					goto l49
+					goto l53
 				}
 				if !_rules[ruleHaving]() {
-					goto l49
+					goto l53
 				}
 				if !_rules[ruleAction2]() {
-					goto l49
+					goto l53
 				}
-				add(ruleSelectStmt, position50)
+				add(ruleSelectStmt, position54)
 			}
 			return true
-		l49:
-			position, tokenIndex = position49, tokenIndex49
+		l53:
+			position, tokenIndex = position53, tokenIndex53
 			return false
 		},
-		/* 9 SelectUnionStmt <- <(<(SelectStmt (sp (('u' / 'U') ('n' / 'N') ('i' / 'I') ('o' / 'O') ('n' / 'N')) sp (('a' / 'A') ('l' / 'L') ('l' / 'L')) sp SelectStmt)+)> Action3)> */
+		/* 10 SelectUnionStmt <- <(<(SelectStmt (sp (('u' / 'U') ('n' / 'N') ('i' / 'I') ('o' / 'O') ('n' / 'N')) sp (('a' / 'A') ('l' / 'L') ('l' / 'L')) sp SelectStmt)+)> Action3)> */
 		func() bool {
-			position63, tokenIndex63 := position, tokenIndex
+			position67, tokenIndex67 := position, tokenIndex
 			{
-				position64 := position
+				position68 := position
 				{
-					position65 := position
+					position69 := position
 					if !_rules[ruleSelectStmt]() {
-						goto l63
+						goto l67
 					}
 					if !_rules[rulesp]() {
-						goto l63
-					}
-					{
-						position68, tokenIndex68 := position, tokenIndex
-						if buffer[position] != rune('u') {
-							goto l69
-						}
-						position++
-						goto l68
-					l69:
-						position, tokenIndex = position68, tokenIndex68
-						if buffer[position] != rune('U') {
-							goto l63
-						}
-						position++
-					}
-				l68:
-					{
-						position70, tokenIndex70 := position, tokenIndex
-						if buffer[position] != rune('n') {
-							goto l71
-						}
-						position++
-						goto l70
-					l71:
-						position, tokenIndex = position70, tokenIndex70
-						if buffer[position] != rune('N') {
-							goto l63
-						}
-						position++
+						goto l67
 					}
-				l70:
 					{
 						position72, tokenIndex72 := position, tokenIndex
-						if buffer[position] != rune('i') {
+						if buffer[position] != rune('u') {
 							goto l73
 						}
 						position++
 						goto l72
 					l73:
 						position, tokenIndex = position72, tokenIndex72
-						if buffer[position] != rune('I') {
-							goto l63
+						if buffer[position] != rune('U') {
+							goto l67
 						}
 						position++
 					}
 				l72:
 					{
 						position74, tokenIndex74 := position, tokenIndex
-						if buffer[position] != rune('o') {
+						if buffer[position] != rune('n') {
 							goto l75
 						}
 						position++
 						goto l74
 					l75:
 						position, tokenIndex = position74, tokenIndex74
-						if buffer[position] != rune('O') {
-							goto l63
+						if buffer[position] != rune('N') {
+							goto l67
 						}
 						position++
 					}
 				l74:
 					{
 						position76, tokenIndex76 := position, tokenIndex
-						if buffer[position] != rune('n') {
+						if buffer[position] != rune('i') {
 							goto l77
 						}
 						position++
 						goto l76
 					l77:
 						position, tokenIndex = position76, tokenIndex76
-						if buffer[position] != rune('N') {
-							goto l63
+						if buffer[position] != rune('I') {
+							goto l67
 						}
 						position++
 					}
 				l76:
-					if !_rules[rulesp]() {
-						goto l63
-					}
 					{
 						position78, tokenIndex78 := position, tokenIndex
-						if buffer[position] != rune('a') {
+						if buffer[position] != rune('o') {
 							goto l79
 						}
 						position++
 						goto l78
 					l79:
 						position, tokenIndex = position78, tokenIndex78
-						if buffer[position] != rune('A') {
-							goto l63
+						if buffer[position] != rune('O') {
+							goto l67
 						}
 						position++
 					}
 				l78:
 					{
 						position80, tokenIndex80 := position, tokenIndex
-						if buffer[position] != rune('l') {
+						if buffer[position] != rune('n') {
 							goto l81
 						}
 						position++
 						goto l80
 					l81:
 						position, tokenIndex = position80, tokenIndex80
-						if buffer[position] != rune('L') {
-							goto l63
-						}
+						if buffer[position] != rune('N') {
+							goto l67
+						}
 						position++
 					}
 				l80:
+					if !_rules[rulesp]() {
+						goto l67
+					}
 					{
 						position82, tokenIndex82 := position, tokenIndex
-						if buffer[position] != rune('l') {
+						if buffer[position] != rune('a') {
 							goto l83
 						}
 						position++
 						goto l82
 					l83:
 						position, tokenIndex = position82, tokenIndex82
-						if buffer[position] != rune('L') {
-							goto l63
+						if buffer[position] != rune('A') {
+							goto l67
 						}
 						position++
 					}
 				l82:
+					{
+						position84, tokenIndex84 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l85
+						}
+						position++
+						goto l84
+					l85:
+						position, tokenIndex = position84, tokenIndex84
+						if buffer[position] != rune('L') {
+							goto l67
+						}
+						position++
+					}
+				l84:
+					{
+						position86, tokenIndex86 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l87
+						}
+						position++
+						goto l86
+					l87:
+						position, tokenIndex = position86, tokenIndex86
+						if buffer[position] != rune('L') {
+							goto l67
+						}
+						position++
+					}
+				l86:
 					if !_rules[rulesp]() {
-						goto l63
+						goto l67
 					}
 					if !_rules[ruleSelectStmt]() {
-						goto l63
+						goto l67
 					}
-				l66:
+				l70:
 					{
-						position67, tokenIndex67 := position, tokenIndex
+						position71, tokenIndex71 := position, tokenIndex
 						if !_rules[rulesp]() {
-							goto l67
-						}
-						{
-							position84, tokenIndex84 := position, tokenIndex
-							if buffer[position] != rune('u') {
-								goto l85
-							}
-							position++
-							goto l84
-						l85:
-							position, tokenIndex = position84, tokenIndex84
-							if buffer[position] != rune('U') {
-								goto l67
-							}
-							position++
-						}
-					l84:
-						{
-							position86, tokenIndex86 := position, tokenIndex
-							if buffer[position] != rune('n') {
-								goto l87
-							}
-							position++
-							goto l86
-						l87:
-							position, tokenIndex = position86, tokenIndex86
-							if buffer[position] != rune('N') {
-								goto l67
-							}
-							position++
+							goto l71
 						}
-					l86:
 						{
 							position88, tokenIndex88 := position, tokenIndex
-							if buffer[position] != rune('i') {
+							if buffer[position] != rune('u') {
 								goto l89
 							}
 							position++
 							goto l88
 						l89:
 							position, tokenIndex = position88, tokenIndex88
-							if buffer[position] != rune('I') {
-								goto l67
+							if buffer[position] != rune('U') {
+								goto l71
 							}
 							position++
 						}
 					l88:
 						{
 							position90, tokenIndex90 := position, tokenIndex
-							if buffer[position] != rune('o') {
+							if buffer[position] != rune('n') {
 								goto l91
 							}
 							position++
 							goto l90
 						l91:
 							position, tokenIndex = position90, tokenIndex90
-							if buffer[position] != rune('O') {
-								goto l67
+							if buffer[position] != rune('N') {
+								goto l71
 							}
 							position++
 						}
 					l90:
 						{
 							position92, tokenIndex92 := position, tokenIndex
-							if buffer[position] != rune('n') {
+							if buffer[position] != rune('i') {
 								goto l93
 							}
 							position++
 							goto l92
 						l93:
 							position, tokenIndex = position92, tokenIndex92
-							if buffer[position] != rune('N') {
-								goto l67
+							if buffer[position] != rune('I') {
+								goto l71
 							}
 							position++
 						}
 					l92:
-						if !_rules[rulesp]() {
-							goto l67
-						}
 						{
 							position94, tokenIndex94 := position, tokenIndex
-							if buffer[position] != rune('a') {
+							if buffer[position] != rune('o') {
 								goto l95
 							}
 							position++
 							goto l94
 						l95:
 							position, tokenIndex = position94, tokenIndex94
-							if buffer[position] != rune('A') {
-								goto l67
+							if buffer[position] != rune('O') {
+								goto l71
 							}
 							position++
 						}
 					l94:
 						{
 							position96, tokenIndex96 := position, tokenIndex
-							if buffer[position] != rune('l') {
+							if buffer[position] != rune('n') {
 								goto l97
 							}
 							position++
 							goto l96
 						l97:
 							position, tokenIndex = position96, tokenIndex96
-							if buffer[position] != rune('L') {
-								goto l67
+							if buffer[position] != rune('N') {
+								goto l71
 							}
 							position++
 						}
 					l96:
+						if !_rules[rulesp]() {
+							goto l71
+						}
 						{
 							position98, tokenIndex98 := position, tokenIndex
-							if buffer[position] != rune('l') {
+							if buffer[position] != rune('a') {
 								goto l99
 							}
 							position++
 							goto l98
 						l99:
 							position, tokenIndex = position98, tokenIndex98
-							if buffer[position] != rune('L') {
-								goto l67
+							if buffer[position] != rune('A') {
+								goto l71
 							}
 							position++
 						}
 					l98:
+						{
+							position100, tokenIndex100 := position, tokenIndex
+							if buffer[position] != rune('l') {
+								goto l101
+							}
+							position++
+							goto l100
+						l101:
+							position, tokenIndex = position100, tokenIndex100
+							if buffer[position] != rune('L') {
+								goto l71
+							}
+							position++
+						}
+					l100:
+						{
+							position102, tokenIndex102 := position, tokenIndex
+							if buffer[position] != rune('l') {
+								goto l103
+							}
+							position++
+							goto l102
+						l103:
+							position, tokenIndex = position102, tokenIndex102
+							if buffer[position] != rune('L') {
+								goto l71
+							}
+							position++
+						}
+					l102:
 						if !_rules[rulesp]() {
-							goto l67
+							goto l71
 						}
 						if !_rules[ruleSelectStmt]() {
-							goto l67
+							goto l71
 						}
-						goto l66
-					l67:
-						position, tokenIndex = position67, tokenIndex67
+						goto l70
+					l71:
+						position, tokenIndex = position71, tokenIndex71
 					}
-					add(rulePegText, position65)
+					add(rulePegText, position69)
 				}
 				if !_rules[ruleAction3]() {
-					goto l63
+					goto l67
 				}
-				add(ruleSelectUnionStmt, position64)
+				add(ruleSelectUnionStmt, position68)
 			}
 			return true
-		l63:
-			position, tokenIndex = position63, tokenIndex63
+		l67:
+			position, tokenIndex = position67, tokenIndex67
 			return false
 		},
-		/* 10 CreateStreamAsSelectStmt <- <(('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M')) sp StreamIdentifier sp (('a' / 'A') ('s' / 'S')) sp SelectStmt Action4)> */
+		/* 11 CreateStreamAsSelectStmt <- <(('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M')) sp StreamIdentifier sp (('a' / 'A') ('s' / 'S')) sp SelectStmt Action4)> */
 		func() bool {
-			position100, tokenIndex100 := position, tokenIndex
+			position104, tokenIndex104 := position, tokenIndex
 			{
-				position101 := position
-				{
-					position102, tokenIndex102 := position, tokenIndex
-					if buffer[position] != rune('c') {
-						goto l103
-					}
-					position++
-					goto l102
-				l103:
-					position, tokenIndex = position102, tokenIndex102
-					if buffer[position] != rune('C') {
-						goto l100
-					}
-					position++
-				}
-			l102:
-				{
-					position104, tokenIndex104 := position, tokenIndex
-					if buffer[position] != rune('r') {
-						goto l105
-					}
-					position++
-					goto l104
-				l105:
-					position, tokenIndex = position104, tokenIndex104
-					if buffer[position] != rune('R') {
-						goto l100
-					}
-					position++
-				}
-			l104:
+				position105 := position
 				{
 					position106, tokenIndex106 := position, tokenIndex
-					if buffer[position] != rune('e') {
+					if buffer[position] != rune('c') {
 						goto l107
 					}
 					position++
 					goto l106
 				l107:
 					position, tokenIndex = position106, tokenIndex106
-					if buffer[position] != rune('E') {
-						goto l100
+					if buffer[position] != rune('C') {
+						goto l104
 					}
 					position++
 				}
 			l106:
 				{
 					position108, tokenIndex108 := position, tokenIndex
-					if buffer[position] != rune('a') {
+					if buffer[position] != rune('r') {
 						goto l109
 					}
 					position++
 					goto l108
 				l109:
 					position, tokenIndex = position108, tokenIndex108
-					if buffer[position] != rune('A') {
-						goto l100
+					if buffer[position] != rune('R') {
+						goto l104
 					}
 					position++
 				}
 			l108:
 				{
 					position110, tokenIndex110 := position, tokenIndex
-					if buffer[position] != rune('t') {
+					if buffer[position] != rune('e') {
 						goto l111
 					}
 					position++
 					goto l110
 				l111:
 					position, tokenIndex = position110, tokenIndex110
-					if buffer[position] != rune('T') {
-						goto l100
+					if buffer[position] != rune('E') {
+						goto l104
 					}
 					position++
 				}
 			l110:
 				{
 					position112, tokenIndex112 := position, tokenIndex
-					if buffer[position] != rune('e') {
+					if buffer[position] != rune('a') {
 						goto l113
 					}
 					position++
 					goto l112
 				l113:
 					position, tokenIndex = position112, tokenIndex112
-					if buffer[position] != rune('E') {
-						goto l100
+					if buffer[position] != rune('A') {
+						goto l104
 					}
 					position++
 				}
 			l112:
-				if !_rules[rulesp]() {
-					goto l100
-				}
 				{
 					position114, tokenIndex114 := position, tokenIndex
-					if buffer[position] != rune('s') {
+					if buffer[position] != rune('t') {
 						goto l115
 					}
 					position++
 					goto l114
 				l115:
 					position, tokenIndex = position114, tokenIndex114
-					if buffer[position] != rune('S') {
-						goto l100
+					if buffer[position] != rune('T') {
+						goto l104
 					}
 					position++
 				}
 			l114:
 				{
 					position116, tokenIndex116 := position, tokenIndex
-					if buffer[position] != rune('t') {
+					if buffer[position] != rune('e') {
 						goto l117
 					}
 					position++
 					goto l116
 				l117:
 					position, tokenIndex = position116, tokenIndex116
-					if buffer[position] != rune('T') {
-						goto l100
+					if buffer[position] != rune('E') {
+						goto l104
 					}
 					position++
 				}
 			l116:
+				if !_rules[rulesp]() {
+					goto l104
+				}
 				{
 					position118, tokenIndex118 := position, tokenIndex
-					if buffer[position] != rune('r') {
+					if buffer[position] != rune('s') {
 						goto l119
 					}
 					position++
 					goto l118
 				l119:
 					position, tokenIndex = position118, tokenIndex118
-					if buffer[position] != rune('R') {
-						goto l100
+					if buffer[position] != rune('S') {
+						goto l104
 					}
 					position++
 				}
 			l118:
 				{
 					position120, tokenIndex120 := position, tokenIndex
-					if buffer[position] != rune('e') {
+					if buffer[position] != rune('t') {
 						goto l121
 					}
 					position++
 					goto l120
 				l121:
 					position, tokenIndex = position120, tokenIndex120
-					if buffer[position] != rune('E') {
-						goto l100
+					if buffer[position] != rune('T') {
+						goto l104
 					}
 					position++
 				}
 			l120:
 				{
 					position122, tokenIndex122 := position, tokenIndex
-					if buffer[position] != rune('a') {
+					if buffer[position] != rune('r') {
 						goto l123
 					}
 					position++
 					goto l122
 				l123:
 					position, tokenIndex = position122, tokenIndex122
-					if buffer[position] != rune('A') {
-						goto l100
+					if buffer[position] != rune('R') {
+						goto l104
 					}
 					position++
 				}
 			l122:
 				{
 					position124, tokenIndex124 := position, tokenIndex
-					if buffer[position] != rune('m') {
+					if buffer[position] != rune('e') {
 						goto l125
 					}
 					position++
 					goto l124
 				l125:
 					position, tokenIndex = position124, tokenIndex124
-					if buffer[position] != rune('M') {
-						goto l100
+					if buffer[position] != rune('E') {
+						goto l104
 					}
 					position++
 				}
 			l124:
-				if !_rules[rulesp]() {
-					goto l100
-				}
-				if !_rules[ruleStreamIdentifier]() {
-					goto l100
-				}
-				if !_rules[rulesp]() {
-					goto l100
-				}
 				{
 					position126, tokenIndex126 := position, tokenIndex
 					if buffer[position] != rune('a') {
@@ -2467,239 +2598,239 @@ func (p *bqlPegBackend) Init() {
 				l127:
 					position, tokenIndex = position126, tokenIndex126
 					if buffer[position] != rune('A') {
-						goto l100
+						goto l104
 					}
 					position++
 				}
 			l126:
 				{
 					position128, tokenIndex128 := position, tokenIndex
-					if buffer[position] != rune('s') {
+					if buffer[position] != rune('m') {
 						goto l129
 					}
 					position++
 					goto l128
 				l129:
 					position, tokenIndex = position128, tokenIndex128
-					if buffer[position] != rune('S') {
-						goto l100
+					if buffer[position] != rune('M') {
+						goto l104
 					}
 					position++
 				}
 			l128:
 				if !_rules[rulesp]() {
-					goto l100
+					goto l104
 				}
-				if !_rules[ruleSelectStmt]() {
-					goto l100
+				if !_rules[ruleStreamIdentifier]() {
+					goto l104
 				}
-				if !_rules[ruleAction4]() {
-					goto l100
+				if !_rules[rulesp]() {
+					goto l104
 				}
-				add(ruleCreateStreamAsSelectStmt, position101)
-			}
-			return true
-		l100:
-			position, tokenIndex = position100, tokenIndex100
-			return false
-		},
-		/* 11 CreateStreamAsSelectUnionStmt <- <(('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M')) sp StreamIdentifier sp (('a' / 'A') ('s' / 'S')) sp SelectUnionStmt Action5)> */
-		func() bool {
-			position130, tokenIndex130 := position, tokenIndex
-			{
-				position131 := position
+				{
+					position130, tokenIndex130 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l131
+					}
+					position++
+					goto l130
+				l131:
+					position, tokenIndex = position130, tokenIndex130
+					if buffer[position] != rune('A') {
+						goto l104
+					}
+					position++
+				}
+			l130:
 				{
 					position132, tokenIndex132 := position, tokenIndex
-					if buffer[position] != rune('c') {
+					if buffer[position] != rune('s') {
 						goto l133
 					}
 					position++
 					goto l132
 				l133:
 					position, tokenIndex = position132, tokenIndex132
-					if buffer[position] != rune('C') {
-						goto l130
+					if buffer[position] != rune('S') {
+						goto l104
 					}
 					position++
 				}
 			l132:
-				{
-					position134, tokenIndex134 := position, tokenIndex
-					if buffer[position] != rune('r') {
-						goto l135
-					}
-					position++
-					goto l134
-				l135:
-					position, tokenIndex = position134, tokenIndex134
-					if buffer[position] != rune('R') {
-						goto l130
-					}
-					position++
+				if !_rules[rulesp]() {
+					goto l104
+				}
+				if !_rules[ruleSelectStmt]() {
+					goto l104
+				}
+				if !_rules[ruleAction4]() {
+					goto l104
 				}
-			l134:
+				add(ruleCreateStreamAsSelectStmt, position105)
+			}
+			return true
+		l104:
+			position, tokenIndex = position104, tokenIndex104
+			return false
+		},
+		/* 12 CreateStreamAsSelectUnionStmt <- <(('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M')) sp StreamIdentifier sp (('a' / 'A') ('s' / 'S')) sp SelectUnionStmt Action5)> */
+		func() bool {
+			position134, tokenIndex134 := position, tokenIndex
+			{
+				position135 := position
 				{
 					position136, tokenIndex136 := position, tokenIndex
-					if buffer[position] != rune('e') {
+					if buffer[position] != rune('c') {
 						goto l137
 					}
 					position++
 					goto l136
 				l137:
 					position, tokenIndex = position136, tokenIndex136
-					if buffer[position] != rune('E') {
-						goto l130
+					if buffer[position] != rune('C') {
+						goto l134
 					}
 					position++
 				}
 			l136:
 				{
 					position138, tokenIndex138 := position, tokenIndex
-					if buffer[position] != rune('a') {
+					if buffer[position] != rune('r') {
 						goto l139
 					}
 					position++
 					goto l138
 				l139:
 					position, tokenIndex = position138, tokenIndex138
-					if buffer[position] != rune('A') {
-						goto l130
+					if buffer[position] != rune('R') {
+						goto l134
 					}
 					position++
 				}
 			l138:
 				{
 					position140, tokenIndex140 := position, tokenIndex
-					if buffer[position] != rune('t') {
+					if buffer[position] != rune('e') {
 						goto l141
 					}
 					position++
 					goto l140
 				l141:
 					position, tokenIndex = position140, tokenIndex140
-					if buffer[position] != rune('T') {
-						goto l130
+					if buffer[position] != rune('E') {
+						goto l134
 					}
 					position++
 				}
 			l140:
 				{
 					position142, tokenIndex142 := position, tokenIndex
-					if buffer[position] != rune('e') {
+					if buffer[position] != rune('a') {
 						goto l143
 					}
 					position++
 					goto l142
 				l143:
 					position, tokenIndex = position142, tokenIndex142
-					if buffer[position] != rune('E') {
-						goto l130
+					if buffer[position] != rune('A') {
+						goto l134
 					}
 					position++
 				}
 			l142:
-				if !_rules[rulesp]() {
-					goto l130
-				}
 				{
 					position144, tokenIndex144 := position, tokenIndex
-					if buffer[position] != rune('s') {
+					if buffer[position] != rune('t') {
 						goto l145
 					}
 					position++
 					goto l144
 				l145:
 					position, tokenIndex = position144, tokenIndex144
-					if buffer[position] != rune('S') {
-						goto l130
+					if buffer[position] != rune('T') {
+						goto l134
 					}
 					position++
 				}
 			l144:
 				{
 					position146, tokenIndex146 := position, tokenIndex
-					if buffer[position] != rune('t') {
+					if buffer[position] != rune('e') {
 						goto l147
 					}
 					position++
 					goto l146
 				l147:
 					position, tokenIndex = position146, tokenIndex146
-					if buffer[position] != rune('T') {
-						goto l130
+					if buffer[position] != rune('E') {
+						goto l134
 					}
 					position++
 				}
 			l146:
+				if !_rules[rulesp]() {
+					goto l134
+				}
 				{
 					position148, tokenIndex148 := position, tokenIndex
-					if buffer[position] != rune('r') {
+					if buffer[position] != rune('s') {
 						goto l149
 					}
 					position++
 					goto l148
 				l149:
 					position, tokenIndex = position148, tokenIndex148
-					if buffer[position] != rune('R') {
-						goto l130
+					if buffer[position] != rune('S') {
+						goto l134
 					}
 					position++
 				}
 			l148:
 				{
 					position150, tokenIndex150 := position, tokenIndex
-					if buffer[position] != rune('e') {
+					if buffer[position] != rune('t') {
 						goto l151
 					}
 					position++
 					goto l150
 				l151:
 					position, tokenIndex = position150, tokenIndex150
-					if buffer[position] != rune('E') {
-						goto l130
+					if buffer[position] != rune('T') {
+						goto l134
 					}
 					position++
 				}
 			l150:
 				{
 					position152, tokenIndex152 := position, tokenIndex
-					if buffer[position] != rune('a') {
+					if buffer[position] != rune('r') {
 						goto l153
 					}
 					position++
 					goto l152
 				l153:
 					position, tokenIndex = position152, tokenIndex152
-					if buffer[position] != rune('A') {
-						goto l130
+					if buffer[position] != rune('R') {
+						goto l134
 					}
 					position++
 				}
 			l152:
 				{
 					position154, tokenIndex154 := position, tokenIndex
-					if buffer[position] != rune('m') {
+					if buffer[position] != rune('e') {
 						goto l155
 					}
 					position++
 					goto l154
 				l155:
 					position, tokenIndex = position154, tokenIndex154
-					if buffer[position] != rune('M') {
-						goto l130
+					if buffer[position] != rune('E') {
+						goto l134
 					}
 					position++
 				}
 			l154:
-				if !_rules[rulesp]() {
-					goto l130
-				}
-				if !_rules[ruleStreamIdentifier]() {
-					goto l130
-				}
-				if !_rules[rulesp]() {
-					goto l130
-				}
 				{
 					position156, tokenIndex156 := position, tokenIndex
 					if buffer[position] != rune('a') {
@@ -2710,706 +2841,715 @@ func (p *bqlPegBackend) Init() {
 				l157:
 					position, tokenIndex = position156, tokenIndex156
 					if buffer[position] != rune('A') {
-						goto l130
+						goto l134
 					}
 					position++
 				}
 			l156:
 				{
 					position158, tokenIndex158 := position, tokenIndex
-					if buffer[position] != rune('s') {
+					if buffer[position] != rune('m') {
 						goto l159
 					}
 					position++
 					goto l158
 				l159:
 					position, tokenIndex = position158, tokenIndex158
-					if buffer[position] != rune('S') {
-						goto l130
+					if buffer[position] != rune('M') {
+						goto l134
 					}
 					position++
 				}
 			l158:
 				if !_rules[rulesp]() {
-					goto l130
+					goto l134
 				}
-				if !_rules[ruleSelectUnionStmt]() {
-					goto l130
+				if !_rules[ruleStreamIdentifier]() {
+					goto l134
 				}
-				if !_rules[ruleAction5]() {
-					goto l130
+				if !_rules[rulesp]() {
+					goto l134
 				}
-				add(ruleCreateStreamAsSelectUnionStmt, position131)
-			}
-			return true
-		l130:
-			position, tokenIndex = position130, tokenIndex130
-			return false
-		},
-		/* 12 CreateSourceStmt <- <(('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E') PausedOpt sp (('s' / 'S') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('c' / 'C') ('e' / 'E')) sp StreamIdentifier sp (('t' / 'T') ('y' / 'Y') ('p' / 'P') ('e' / 'E')) sp SourceSinkType SourceSinkSpecs Action6)> */
-		func() bool {
-			position160, tokenIndex160 := position, tokenIndex
-			{
-				position161 := position
+				{
+					position160, tokenIndex160 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l161
+					}
+					position++
+					goto l160
+				l161:
+					position, tokenIndex = position160, tokenIndex160
+					if buffer[position] != rune('A') {
+						goto l134
+					}
+					position++
+				}
+			l160:
 				{
 					position162, tokenIndex162 := position, tokenIndex
-					if buffer[position] != rune('c') {
+					if buffer[position] != rune('s') {
 						goto l163
 					}
 					position++
 					goto l162
 				l163:
 					position, tokenIndex = position162, tokenIndex162
-					if buffer[position] != rune('C') {
-						goto l160
+					if buffer[position] != rune('S') {
+						goto l134
 					}
 					position++
 				}
 			l162:
-				{
-					position164, tokenIndex164 := position, tokenIndex
-					if buffer[position] != rune('r') {
-						goto l165
-					}
-					position++
-					goto l164
-				l165:
-					position, tokenIndex = position164, tokenIndex164
-					if buffer[position] != rune('R') {
-						goto l160
-					}
-					position++
+				if !_rules[rulesp]() {
+					goto l134
 				}
-			l164:
+				if !_rules[ruleSelectUnionStmt]() {
+					goto l134
+				}
+				if !_rules[ruleAction5]() {
+					goto l134
+				}
+				add(ruleCreateStreamAsSelectUnionStmt, position135)
+			}
+			return true
+		l134:
+			position, tokenIndex = position134, tokenIndex134
+			return false
+		},
+		/* 13 CreateSourceStmt <- <(('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E') PausedOpt sp (('s' / 'S') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('c' / 'C') ('e' / 'E')) sp StreamIdentifier sp (('t' / 'T') ('y' / 'Y') ('p' / 'P') ('e' / 'E')) sp SourceSinkType SourceSinkSpecs Action6)> */
+		func() bool {
+			position164, tokenIndex164 := position, tokenIndex
+			{
+				position165 := position
 				{
 					position166, tokenIndex166 := position, tokenIndex
-					if buffer[position] != rune('e') {
+					if buffer[position] != rune('c') {
 						goto l167
 					}
 					position++
 					goto l166
 				l167:
 					position, tokenIndex = position166, tokenIndex166
-					if buffer[position] != rune('E') {
-						goto l160
+					if buffer[position] != rune('C') {
+						goto l164
 					}
 					position++
 				}
 			l166:
 				{
 					position168, tokenIndex168 := position, tokenIndex
-					if buffer[position] != rune('a') {
+					if buffer[position] != rune('r') {
 						goto l169
 					}
 					position++
 					goto l168
 				l169:
 					position, tokenIndex = position168, tokenIndex168
-					if buffer[position] != rune('A') {
-						goto l160
+					if buffer[position] != rune('R') {
+						goto l164
 					}
 					position++
 				}
 			l168:
 				{
 					position170, tokenIndex170 := position, tokenIndex
-					if buffer[position] != rune('t') {
+					if buffer[position] != rune('e') {
 						goto l171
 					}
 					position++
 					goto l170
 				l171:
 					position, tokenIndex = position170, tokenIndex170
-					if buffer[position] != rune('T') {
-						goto l160
+					if buffer[position] != rune('E') {
+						goto l164
 					}
 					position++
 				}
 			l170:
 				{
 					position172, tokenIndex172 := position, tokenIndex
-					if buffer[position] != rune('e') {
+					if buffer[position] != rune('a') {
 						goto l173
 					}
 					position++
 					goto l172
 				l173:
 					position, tokenIndex = position172, tokenIndex172
-					if buffer[position] != rune('E') {
-						goto l160
+					if buffer[position] != rune('A') {
+						goto l164
 					}
 					position++
 				}
 			l172:
-				if !_rules[rulePausedOpt]() {
-					goto l160
-				}
-				if !_rules[rulesp]() {
-					goto l160
-				}
 				{
 					position174, tokenIndex174 := position, tokenIndex
-					if buffer[position] != rune('s') {
+					if buffer[position] != rune('t') {
 						goto l175
 					}
 					position++
 					goto l174
 				l175:
 					position, tokenIndex = position174, tokenIndex174
-					if buffer[position] != rune('S') {
-						goto l160
+					if buffer[position] != rune('T') {
+						goto l164
 					}
 					position++
 				}
 			l174:
 				{
 					position176, tokenIndex176 := position, tokenIndex
-					if buffer[position] != rune('o') {
+					if buffer[position] != rune('e') {
 						goto l177
 					}
 					position++
 					goto l176
 				l177:
 					position, tokenIndex = position176, tokenIndex176
-					if buffer[position] != rune('O') {
-						goto l160
+					if buffer[position] != rune('E') {
+						goto l164
 					}
 					position++
 				}
 			l176:
+				if !_rules[rulePausedOpt]() {
+					goto l164
+				}
+				if !_rules[rulesp]() {
+					goto l164
+				}
 				{
 					position178, tokenIndex178 := position, tokenIndex
-					if buffer[position] != rune('u') {
+					if buffer[position] != rune('s') {
 						goto l179
 					}
 					position++
 					goto l178
 				l179:
 					position, tokenIndex = position178, tokenIndex178
-					if buffer[position] != rune('U') {
-						goto l160
+					if buffer[position] != rune('S') {
+						goto l164
 					}
 					position++
 				}
 			l178:
 				{
 					position180, tokenIndex180 := position, tokenIndex
-					if buffer[position] != rune('r') {
+					if buffer[position] != rune('o') {
 						goto l181
 					}
 					position++
 					goto l180
 				l181:
 					position, tokenIndex = position180, tokenIndex180
-					if buffer[position] != rune('R') {
-						goto l160
+					if buffer[position] != rune('O') {
+						goto l164
 					}
 					position++
 				}
 			l180:
 				{
 					position182, tokenIndex182 := position, tokenIndex
-					if buffer[position] != rune('c') {
+					if buffer[position] != rune('u') {
 						goto l183
 					}
 					position++
 					goto l182
 				l183:
 					position, tokenIndex = position182, tokenIndex182
-					if buffer[position] != rune('C') {
-						goto l160
+					if buffer[position] != rune('U') {
+						goto l164
 					}
 					position++
 				}
 			l182:
 				{
 					position184, tokenIndex184 := position, tokenIndex
-					if buffer[position] != rune('e') {
+					if buffer[position] != rune('r') {
 						goto l185
 					}
 					position++
 					goto l184
 				l185:
 					position, tokenIndex = position184, tokenIndex184
-					if buffer[position] != rune('E') {
-						goto l160
+					if buffer[position] != rune('R') {
+						goto l164
 					}
 					position++
 				}
 			l184:
-				if !_rules[rulesp]() {
-					goto l160
-				}
-				if !_rules[ruleStreamIdentifier]() {
-					goto l160
-				}
-				if !_rules[rulesp]() {
-					goto l160
-				}
 				{
 					position186, tokenIndex186 := position, tokenIndex
-					if buffer[position] != rune('t') {
+					if buffer[position] != rune('c') {
 						goto l187
 					}
 					position++
 					goto l186
 				l187:
 					position, tokenIndex = position186, tokenIndex186
-					if buffer[position] != rune('T') {
-						goto l160
+					if buffer[position] != rune('C') {
+						goto l164
 					}
 					position++
 				}
 			l186:
 				{
 					position188, tokenIndex188 := position, tokenIndex
-					if buffer[position] != rune('y') {
+					if buffer[position] != rune('e') {
 						goto l189
 					}
 					position++
 					goto l188
 				l189:
 					position, tokenIndex = position188, tokenIndex188
-					if buffer[position] != rune('Y') {
-						goto l160
+					if buffer[position] != rune('E') {
+						goto l164
 					}
 					position++
 				}
 			l188:
+				if !_rules[rulesp]() {
+					goto l164
+				}
+				if !_rules[ruleStreamIdentifier]() {
+					goto l164
+				}
+				if !_rules[rulesp]() {
+					goto l164
+				}
 				{
 					position190, tokenIndex190 := position, tokenIndex
-					if buffer[position] != rune('p') {
+					if buffer[position] != rune('t') {
 						goto l191
 					}
 					position++
 					goto l190
 				l191:
 					position, tokenIndex = position190, tokenIndex190
-					if buffer[position] != rune('P') {
-						goto l160
+					if buffer[position] != rune('T') {
+						goto l164
 					}
 					position++
 				}
 			l190:
 				{
 					position192, tokenIndex192 := position, tokenIndex
-					if buffer[position] != rune('e') {
+					if buffer[position] != rune('y') {
 						goto l193
 					}
 					position++
 					goto l192
 				l193:
 					position, tokenIndex = position192, tokenIndex192
-					if buffer[position] != rune('E') {
-						goto l160
+					if buffer[position] != rune('Y') {
+						goto l164
 					}
 					position++
 				}
 			l192:
-				if !_rules[rulesp]() {
-					goto l160
-				}
-				if !_rules[ruleSourceSinkType]() {
-					goto l160
-				}
-				if !_rules[ruleSourceSinkSpecs]() {
-					goto l160
-				}
-				if !_rules[ruleAction6]() {
-					goto l160
+				{
+					position194, tokenIndex194 := position, tokenIndex
+					if buffer[position] != rune('p') {
+						goto l195
+					}
+					position++
+					goto l194
+				l195:
+					position, tokenIndex = position194, tokenIndex194
+					if buffer[position] != rune('P') {
+						goto l164
+					}
+					position++
 				}
-				add(ruleCreateSourceStmt, position161)
-			}
-			return true
-		l160:
-			position, tokenIndex = position160, tokenIndex160
-			return false
-		},
-		/* 13 CreateSinkStmt <- <(('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('i' / 'I') ('n' / 'N') ('k' / 'K')) sp StreamIdentifier sp (('t' / 'T') ('y' / 'Y') ('p' / 'P') ('e' / 'E')) sp SourceSinkType SourceSinkSpecs Action7)> */
-		func() bool {
-			position194, tokenIndex194 := position, tokenIndex
-			{
-				position195 := position
+			l194:
 				{
 					position196, tokenIndex196 := position, tokenIndex
-					if buffer[position] != rune('c') {
+					if buffer[position] != rune('e') {
 						goto l197
 					}
 					position++
 					goto l196
 				l197:
 					position, tokenIndex = position196, tokenIndex196
-					if buffer[position] != rune('C') {
-						goto l194
+					if buffer[position] != rune('E') {
+						goto l164
 					}
 					position++
 				}
 			l196:
-				{
-					position198, tokenIndex198 := position, tokenIndex
-					if buffer[position] != rune('r') {
-						goto l199
-					}
-					position++
-					goto l198
-				l199:
-					position, tokenIndex = position198, tokenIndex198
-					if buffer[position] != rune('R') {
-						goto l194
-					}
-					position++
+				if !_rules[rulesp]() {
+					goto l164
+				}
+				if !_rules[ruleSourceSinkType]() {
+					goto l164
+				}
+				if !_rules[ruleSourceSinkSpecs]() {
+					goto l164
+				}
+				if !_rules[ruleAction6]() {
+					goto l164
 				}
-			l198:
+				add(ruleCreateSourceStmt, position165)
+			}
+			return true
+		l164:
+			position, tokenIndex = position164, tokenIndex164
+			return false
+		},
+		/* 14 CreateSinkStmt <- <(('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('i' / 'I') ('n' / 'N') ('k' / 'K')) sp StreamIdentifier sp (('t' / 'T') ('y' / 'Y') ('p' / 'P') ('e' / 'E')) sp SourceSinkType SourceSinkSpecs Action7)> */
+		func() bool {
+			position198, tokenIndex198 := position, tokenIndex
+			{
+				position199 := position
 				{
 					position200, tokenIndex200 := position, tokenIndex
-					if buffer[position] != rune('e') {
+					if buffer[position] != rune('c') {
 						goto l201
 					}
 					position++
 					goto l200
 				l201:
 					position, tokenIndex = position200, tokenIndex200
-					if buffer[position] != rune('E') {
-						goto l194
+					if buffer[position] != rune('C') {
+						goto l198
 					}
 					position++
 				}
 			l200:
 				{
 					position202, tokenIndex202 := position, tokenIndex
-					if buffer[position] != rune('a') {
+					if buffer[position] != rune('r') {
 						goto l203
 					}
 					position++
 					goto l202
 				l203:
 					position, tokenIndex = position202, tokenIndex202
-					if buffer[position] != rune('A') {
-						goto l194
+					if buffer[position] != rune('R') {
+						goto l198
 					}
 					position++
 				}
 			l202:
 				{
 					position204, tokenIndex204 := position, tokenIndex
-					if buffer[position] != rune('t') {
+					if buffer[position] != rune('e') {
 						goto l205
 					}
 					position++
 					goto l204
 				l205:
 					position, tokenIndex = position204, tokenIndex204
-					if buffer[position] != rune('T') {
-						goto l194
+					if buffer[position] != rune('E') {
+						goto l198
 					}
 					position++
 				}
 			l204:
 				{
 					position206, tokenIndex206 := position, tokenIndex
-					if buffer[position] != rune('e') {
+					if buffer[position] != rune('a') {
 						goto l207
 					}
 					position++
 					goto l206
 				l207:
 					position, tokenIndex = position206, tokenIndex206
-					if buffer[position] != rune('E') {
-						goto l194
+					if buffer[position] != rune('A') {
+						goto l198
 					}
 					position++
 				}
 			l206:
-				if !_rules[rulesp]() {
-					goto l194
-				}
 				{
 					position208, tokenIndex208 := position, tokenIndex
-					if buffer[position] != rune('s') {
+					if buffer[position] != rune('t') {
 						goto l209
 					}
 					position++
 					goto l208
 				l209:
 					position, tokenIndex = position208, tokenIndex208
-					if buffer[position] != rune('S') {
-						goto l194
+					if buffer[position] != rune('T') {
+						goto l198
 					}
 					position++
 				}
 			l208:
 				{
 					position210, tokenIndex210 := position, tokenIndex
-					if buffer[position] != rune('i') {
+					if buffer[position] != rune('e') {
 						goto l211
 					}
 					position++
 					goto l210
 				l211:
 					position, tokenIndex = position210, tokenIndex210
-					if buffer[position] != rune('I') {
-						goto l194
+					if buffer[position] != rune('E') {
+						goto l198
 					}
 					position++
 				}
 			l210:
+				if !_rules[rulesp]() {
+					goto l198
+				}
 				{
 					position212, tokenIndex212 := position, tokenIndex
-					if buffer[position] != rune('n') {
+					if buffer[position] != rune('s') {
 						goto l213
 					}
 					position++
 					goto l212
 				l213:
 					position, tokenIndex = position212, tokenIndex212
-					if buffer[position] != rune('N') {
-						goto l194
+					if buffer[position] != rune('S') {
+						goto l198
 					}
 					position++
 				}
 			l212:
 				{
 					position214, tokenIndex214 := position, tokenIndex
-					if buffer[position] != rune('k') {
+					if buffer[position] != rune('i') {
 						goto l215
 					}
 					position++
 					goto l214
 				l215:
 					position, tokenIndex = position214, tokenIndex214
-					if buffer[position] != rune('K') {
-						goto l194
+					if buffer[position] != rune('I') {
+						goto l198
 					}
 					position++
 				}
 			l214:
-				if !_rules[rulesp]() {
-					goto l194
-				}
-				if !_rules[ruleStreamIdentifier]() {
-					goto l194
-				}
-				if !_rules[rulesp]() {
-					goto l194
-				}
 				{
 					position216, tokenIndex216 := position, tokenIndex
-					if buffer[position] != rune('t') {
+					if buffer[position] != rune('n') {
 						goto l217
 					}
 					position++
 					goto l216
 				l217:
 					position, tokenIndex = position216, tokenIndex216
-					if buffer[position] != rune('T') {
-						goto l194
+					if buffer[position] != rune('N') {
+						goto l198
 					}
 					position++
 				}
 			l216:
 				{
 					position218, tokenIndex218 := position, tokenIndex
-					if buffer[position] != rune('y') {
+					if buffer[position] != rune('k') {
 						goto l219
 					}
 					position++
 					goto l218
 				l219:
 					position, tokenIndex = position218, tokenIndex218
-					if buffer[position] != rune('Y') {
-						goto l194
+					if buffer[position] != rune('K') {
+						goto l198
 					}
 					position++
 				}
 			l218:
+				if !_rules[rulesp]() {
+					goto l198
+				}
+				if !_rules[ruleStreamIdentifier]() {
+					goto l198
+				}
+				if !_rules[rulesp]() {
+					goto l198
+				}
 				{
 					position220, tokenIndex220 := position, tokenIndex
-					if buffer[position] != rune('p') {
+					if buffer[position] != rune('t') {
 						goto l221
 					}
 					position++
 					goto l220
 				l221:
 					position, tokenIndex = position220, tokenIndex220
-					if buffer[position] != rune('P') {
-						goto l194
+					if buffer[position] != rune('T') {
+						goto l198
 					}
 					position++
 				}
 			l220:
 				{
 					position222, tokenIndex222 := position, tokenIndex
-					if buffer[position] != rune('e') {
+					if buffer[position] != rune('y') {
 						goto l223
 					}
 					position++
 					goto l222
 				l223:
 					position, tokenIndex = position222, tokenIndex222
-					if buffer[position] != rune('E') {
-						goto l194
+					if buffer[position] != rune('Y') {
+						goto l198
 					}
 					position++
 				}
 			l222:
-				if !_rules[rulesp]() {
-					goto l194
-				}
-				if !_rules[ruleSourceSinkType]() {
-					goto l194
-				}
-				if !_rules[ruleSourceSinkSpecs]() {
-					goto l194
-				}
-				if !_rules[ruleAction7]() {
-					goto l194
+				{
+					position224, tokenIndex224 := position, tokenIndex
+					if buffer[position] != rune('p') {
+						goto l225
+					}
+					position++
+					goto l224
+				l225:
+					position, tokenIndex = position224, tokenIndex224
+					if buffer[position] != rune('P') {
+						goto l198
+					}
+					position++
 				}
-				add(ruleCreateSinkStmt, position195)
-			}
-			return true
-		l194:
-			position, tokenIndex = position194, tokenIndex194
-			return false
-		},
-		/* 14 CreateStateStmt <- <(('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('t' / 'T') ('a' / 'A') ('t' / 'T') ('e' / 'E')) sp StreamIdentifier sp (('t' / 'T') ('y' / 'Y') ('p' / 'P') ('e' / 'E')) sp SourceSinkType SourceSinkSpecs Action8)> */
-		func() bool {
-			position224, tokenIndex224 := position, tokenIndex
-			{
-				position225 := position
+			l224:
 				{
 					position226, tokenIndex226 := position, tokenIndex
-					if buffer[position] != rune('c') {
+					if buffer[position] != rune('e') {
 						goto l227
 					}
 					position++
 					goto l226
 				l227:
 					position, tokenIndex = position226, tokenIndex226
-					if buffer[position] != rune('C') {
-						goto l224
+					if buffer[position] != rune('E') {
+						goto l198
 					}
 					position++
 				}
 			l226:
-				{
-					position228, tokenIndex228 := position, tokenIndex
-					if buffer[position] != rune('r') {
-						goto l229
-					}
-					position++
-					goto l228
-				l229:
-					position, tokenIndex = position228, tokenIndex228
-					if buffer[position] != rune('R') {
-						goto l224
-					}
-					position++
+				if !_rules[rulesp]() {
+					goto l198
+				}
+				if !_rules[ruleSourceSinkType]() {
+					goto l198
+				}
+				if !_rules[ruleSourceSinkSpecs]() {
+					goto l198
+				}
+				if !_rules[ruleAction7]() {
+					goto l198
 				}
-			l228:
+				add(ruleCreateSinkStmt, position199)
+			}
+			return true
+		l198:
+			position, tokenIndex = position198, tokenIndex198
+			return false
+		},
+		/* 15 CreateStateStmt <- <(('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('t' / 'T') ('a' / 'A') ('t' / 'T') ('e' / 'E')) sp StreamIdentifier sp (('t' / 'T') ('y' / 'Y') ('p' / 'P') ('e' / 'E')) sp SourceSinkType SourceSinkSpecs Action8)> */
+		func() bool {
+			position228, tokenIndex228 := position, tokenIndex
+			{
+				position229 := position
 				{
 					position230, tokenIndex230 := position, tokenIndex
-					if buffer[position] != rune('e') {
+					if buffer[position] != rune('c') {
 						goto l231
 					}
 					position++
 					goto l230
 				l231:
 					position, tokenIndex = position230, tokenIndex230
-					if buffer[position] != rune('E') {
-						goto l224
+					if buffer[position] != rune('C') {
+						goto l228
 					}
 					position++
 				}
 			l230:
 				{
 					position232, tokenIndex232 := position, tokenIndex
-					if buffer[position] != rune('a') {
+					if buffer[position] != rune('r') {
 						goto l233
 					}
 					position++
 					goto l232
 				l233:
 					position, tokenIndex = position232, tokenIndex232
-					if buffer[position] != rune('A') {
-						goto l224
+					if buffer[position] != rune('R') {
+						goto l228
 					}
 					position++
 				}
 			l232:
 				{
 					position234, tokenIndex234 := position, tokenIndex
-					if buffer[position] != rune('t') {
+					if buffer[position] != rune('e') {
 						goto l235
 					}
 					position++
 					goto l234
 				l235:
 					position, tokenIndex = position234, tokenIndex234
-					if buffer[position] != rune('T') {
-						goto l224
+					if buffer[position] != rune('E') {
+						goto l228
 					}
 					position++
 				}
 			l234:
 				{
 					position236, tokenIndex236 := position, tokenIndex
-					if buffer[position] != rune('e') {
+					if buffer[position] != rune('a') {
 						goto l237
 					}
 					position++
 					goto l236
 				l237:
 					position, tokenIndex = position236, tokenIndex236
-					if buffer[position] != rune('E') {
-						goto l224
+					if buffer[position] != rune('A') {
+						goto l228
 					}
 					position++
 				}
 			l236:
-				if !_rules[rulesp]() {
-					goto l224
-				}
 				{
 					position238, tokenIndex238 := position, tokenIndex
-					if buffer[position] != rune('s') {
+					if buffer[position] != rune('t') {
 						goto l239
 					}
 					position++
 					goto l238
 				l239:
 					position, tokenIndex = position238, tokenIndex238
-					if buffer[position] != rune('S') {
-						goto l224
+					if buffer[position] != rune('T') {
+						goto l228
 					}
 					position++
 				}
 			l238:
 				{
 					position240, tokenIndex240 := position, tokenIndex
-					if buffer[position] != rune('t') {
+					if buffer[position] != rune('e') {
 						goto l241
 					}
 					position++
 					goto l240
 				l241:
 					position, tokenIndex = position240, tokenIndex240
-					if buffer[position] != rune('T') {
-						goto l224
+					if buffer[position] != rune('E') {
+						goto l228
 					}
 					position++
 				}
 			l240:
+				if !_rules[rulesp]() {
+					goto l228
+				}
 				{
 					position242, tokenIndex242 := position, tokenIndex
-					if buffer[position] != rune('a') {
+					if buffer[position] != rune('s') {
 						goto l243
 					}
 					position++
 					goto l242
 				l243:
 					position, tokenIndex = position242, tokenIndex242
-					if buffer[position] != rune('A') {
-						goto l224
+					if buffer[position] != rune('S') {
+						goto l228
 					}
 					position++
 				}
@@ -3424,35 +3564,26 @@ func (p *bqlPegBackend) Init() {
 				l245:
 					position, tokenIndex = position244, tokenIndex244
 					if buffer[position] != rune('T') {
-						goto l224
+						goto l228
 					}
 					position++
 				}
 			l244:
 				{
 					position246, tokenIndex246 := position, tokenIndex
-					if buffer[position] != rune('e') {
+					if buffer[position] != rune('a') {
 						goto l247
 					}
 					position++
 					goto l246
 				l247:
 					position, tokenIndex = position246, tokenIndex246
-					if buffer[position] != rune('E') {
-						goto l224
+					if buffer[position] != rune('A') {
+						goto l228
 					}
 					position++
 				}
 			l246:
-				if !_rules[rulesp]() {
-					goto l224
-				}
-				if !_rules[ruleStreamIdentifier]() {
-					goto l224
-				}
-				if !_rules[rulesp]() {
-					goto l224
-				}
 				{
 					position248, tokenIndex248 := position, tokenIndex
 					if buffer[position] != rune('t') {
@@ -3463,214 +3594,223 @@ func (p *bqlPegBackend) Init() {
 				l249:
 					position, tokenIndex = position248, tokenIndex248
 					if buffer[position] != rune('T') {
-						goto l224
+						goto l228
 					}
 					position++
 				}
 			l248:
 				{
 					position250, tokenIndex250 := position, tokenIndex
-					if buffer[position] != rune('y') {
+					if buffer[position] != rune('e') {
 						goto l251
 					}
 					position++
 					goto l250
 				l251:
 					position, tokenIndex = position250, tokenIndex250
-					if buffer[position] != rune('Y') {
-						goto l224
+					if buffer[position] != rune('E') {
+						goto l228
 					}
 					position++
 				}
 			l250:
+				if !_rules[rulesp]() {
+					goto l228
+				}
+				if !_rules[ruleStreamIdentifier]() {
+					goto l228
+				}
+				if !_rules[rulesp]() {
+					goto l228
+				}
 				{
 					position252, tokenIndex252 := position, tokenIndex
-					if buffer[position] != rune('p') {
+					if buffer[position] != rune('t') {
 						goto l253
 					}
 					position++
 					goto l252
 				l253:
 					position, tokenIndex = position252, tokenIndex252
-					if buffer[position] != rune('P') {
-						goto l224
+					if buffer[position] != rune('T') {
+						goto l228
 					}
 					position++
 				}
 			l252:
 				{
 					position254, tokenIndex254 := position, tokenIndex
-					if buffer[position] != rune('e') {
+					if buffer[position] != rune('y') {
 						goto l255
 					}
 					position++
 					goto l254
 				l255:
 					position, tokenIndex = position254, tokenIndex254
-					if buffer[position] != rune('E') {
-						goto l224
+					if buffer[position] != rune('Y') {
+						goto l228
 					}
 					position++
 				}
 			l254:
-				if !_rules[rulesp]() {
-					goto l224
-				}
-				if !_rules[ruleSourceSinkType]() {
-					goto l224
-				}
-				if !_rules[ruleSourceSinkSpecs]() {
-					goto l224
-				}
-				if !_rules[ruleAction8]() {
-					goto l224
+				{
+					position256, tokenIndex256 := position, tokenIndex
+					if buffer[position] != rune('p') {
+						goto l257
+					}
+					position++
+					goto l256
+				l257:
+					position, tokenIndex = position256, tokenIndex256
+					if buffer[position] != rune('P') {
+						goto l228
+					}
+					position++
 				}
-				add(ruleCreateStateStmt, position225)
-			}
-			return true
-		l224:
-			position, tokenIndex = position224, tokenIndex224
-			return false
-		},
-		/* 15 UpdateStateStmt <- <(('u' / 'U') ('p' / 'P') ('d' / 'D') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('t' / 'T') ('a' / 'A') ('t' / 'T') ('e' / 'E')) sp StreamIdentifier UpdateSourceSinkSpecs Action9)> */
-		func() bool {
-			position256, tokenIndex256 := position, tokenIndex
-			{
-				position257 := position
+			l256:
 				{
 					position258, tokenIndex258 := position, tokenIndex
-					if buffer[position] != rune('u') {
+					if buffer[position] != rune('e') {
 						goto l259
 					}
 					position++
 					goto l258
 				l259:
 					position, tokenIndex = position258, tokenIndex258
-					if buffer[position] != rune('U') {
-						goto l256
+					if buffer[position] != rune('E') {
+						goto l228
 					}
 					position++
 				}
 			l258:
-				{
-					position260, tokenIndex260 := position, tokenIndex
-					if buffer[position] != rune('p') {
-						goto l261
-					}
-					position++
-					goto l260
-				l261:
-					position, tokenIndex = position260, tokenIndex260
-					if buffer[position] != rune('P') {
-						goto l256
-					}
-					position++
+				if !_rules[rulesp]() {
+					goto l228
+				}
+				if !_rules[ruleSourceSinkType]() {
+					goto l228
+				}
+				if !_rules[ruleSourceSinkSpecs]() {
+					goto l228
+				}
+				if !_rules[ruleAction8]() {
+					goto l228
 				}
-			l260:
+				add(ruleCreateStateStmt, position229)
+			}
+			return true
+		l228:
+			position, tokenIndex = position228, tokenIndex228
+			return false
+		},
+		/* 16 UpdateStateStmt <- <(('u' / 'U') ('p' / 'P') ('d' / 'D') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('t' / 'T') ('a' / 'A') ('t' / 'T') ('e' / 'E')) sp StreamIdentifier (UpdateStateMapSpecs / UpdateSourceSinkSpecs) Action9)> */
+		func() bool {
+			position260, tokenIndex260 := position, tokenIndex
+			{
+				position261 := position
 				{
 					position262, tokenIndex262 := position, tokenIndex
-					if buffer[position] != rune('d') {
+					if buffer[position] != rune('u') {
 						goto l263
 					}
 					position++
 					goto l262
 				l263:
 					position, tokenIndex = position262, tokenIndex262
-					if buffer[position] != rune('D') {
-						goto l256
+					if buffer[position] != rune('U') {
+						goto l260
 					}
 					position++
 				}
 			l262:
 				{
 					position264, tokenIndex264 := position, tokenIndex
-					if buffer[position] != rune('a') {
+					if buffer[position] != rune('p') {
 						goto l265
 					}
 					position++
 					goto l264
 				l265:
 					position, tokenIndex = position264, tokenIndex264
-					if buffer[position] != rune('A') {
-						goto l256
+					if buffer[position] != rune('P') {
+						goto l260
 					}
 					position++
 				}
 			l264:
 				{
 					position266, tokenIndex266 := position, tokenIndex
-					if buffer[position] != rune('t') {
+					if buffer[position] != rune('d') {
 						goto l267
 					}
 					position++
 					goto l266
 				l267:
 					position, tokenIndex = position266, tokenIndex266
-					if buffer[position] != rune('T') {
-						goto l256
+					if buffer[position] != rune('D') {
+						goto l260
 					}
 					position++
 				}
 			l266:
 				{
 					position268, tokenIndex268 := position, tokenIndex
-					if buffer[position] != rune('e') {
+					if buffer[position] != rune('a') {
 						goto l269
 					}
 					position++
 					goto l268
 				l269:
 					position, tokenIndex = position268, tokenIndex268
-					if buffer[position] != rune('E') {
-						goto l256
+					if buffer[position] != rune('A') {
+						goto l260
 					}
 					position++
 				}
 			l268:
-				if !_rules[rulesp]() {
-					goto l256
-				}
 				{
 					position270, tokenIndex270 := position, tokenIndex
-					if buffer[position] != rune('s') {
+					if buffer[position] != rune('t') {
 						goto l271
 					}
 					position++
 					goto l270
 				l271:
 					position, tokenIndex = position270, tokenIndex270
-					if buffer[position] != rune('S') {
-						goto l256
+					if buffer[position] != rune('T') {
+						goto l260
 					}
 					position++
 				}
 			l270:
 				{
 					position272, tokenIndex272 := position, tokenIndex
-					if buffer[position] != rune('t') {
+					if buffer[position] != rune('e') {
 						goto l273
 					}
 					position++
 					goto l272
 				l273:
 					position, tokenIndex = position272, tokenIndex272
-					if buffer[position] != rune('T') {
-						goto l256
+					if buffer[position] != rune('E') {
+						goto l260
 					}
 					position++
 				}
 			l272:
+				if !_rules[rulesp]() {
+					goto l260
+				}
 				{
 					position274, tokenIndex274 := position, tokenIndex
-					if buffer[position] != rune('a') {
+					if buffer[position] != rune('s') {
 						goto l275
 					}
 					position++
 					goto l274
 				l275:
 					position, tokenIndex = position274, tokenIndex274
-					if buffer[position] != rune('A') {
-						goto l256
+					if buffer[position] != rune('S') {
+						goto l260
 					}
 					position++
 				}
@@ -3685,553 +3825,545 @@ func (p *bqlPegBackend) Init() {
 				l277:
 					position, tokenIndex = position276, tokenIndex276
 					if buffer[position] != rune('T') {
-						goto l256
+						goto l260
 					}
 					position++
 				}
 			l276:
 				{
 					position278, tokenIndex278 := position, tokenIndex
-					if buffer[position] != rune('e') {
+					if buffer[position] != rune('a') {
 						goto l279
 					}
 					position++
 					goto l278
 				l279:
 					position, tokenIndex = position278, tokenIndex278
-					if buffer[position] != rune('E') {
-						goto l256
+					if buffer[position] != rune('A') {
+						goto l260
 					}
 					position++
 				}
 			l278:
-				if !_rules[rulesp]() {
-					goto l256
+				{
+					position280, tokenIndex280 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l281
+					}
+					position++
+					goto l280
+				l281:
+					position, tokenIndex = position280, tokenIndex280
+					if buffer[position] != rune('T') {
+						goto l260
+					}
+					position++
 				}
-				if !_rules[ruleStreamIdentifier]() {
-					goto l256
-				}
-				if !_rules[ruleUpdateSourceSinkSpecs]() {
-					goto l256
-				}
-				if !_rules[ruleAction9]() {
-					goto l256
-				}
-				add(ruleUpdateStateStmt, position257)
-			}
-			return true
-		l256:
-			position, tokenIndex = position256, tokenIndex256
-			return false
-		},
-		/* 16 UpdateSourceStmt <- <(('u' / 'U') ('p' / 'P') ('d' / 'D') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('c' / 'C') ('e' / 'E')) sp StreamIdentifier UpdateSourceSinkSpecs Action10)> */
-		func() bool {
-			position280, tokenIndex280 := position, tokenIndex
-			{
-				position281 := position
+			l280:
 				{
 					position282, tokenIndex282 := position, tokenIndex
-					if buffer[position] != rune('u') {
+					if buffer[position] != rune('e') {
 						goto l283
 					}
 					position++
 					goto l282
 				l283:
 					position, tokenIndex = position282, tokenIndex282
-					if buffer[position] != rune('U') {
-						goto l280
+					if buffer[position] != rune('E') {
+						goto l260
 					}
 					position++
 				}
 			l282:
+				if !_rules[rulesp]() {
+					goto l260
+				}
+				if !_rules[ruleStreamIdentifier]() {
+					goto l260
+				}
 				{
 					position284, tokenIndex284 := position, tokenIndex
-					if buffer[position] != rune('p') {
+					if !_rules[ruleUpdateStateMapSpecs]() {
 						goto l285
 					}
-					position++
 					goto l284
 				l285:
 					position, tokenIndex = position284, tokenIndex284
-					if buffer[position] != rune('P') {
-						goto l280
+					if !_rules[ruleUpdateSourceSinkSpecs]() {
+						goto l260
 					}
-					position++
 				}
 			l284:
-				{
-					position286, tokenIndex286 := position, tokenIndex
-					if buffer[position] != rune('d') {
-						goto l287
-					}
-					position++
-					goto l286
-				l287:
-					position, tokenIndex = position286, tokenIndex286
-					if buffer[position] != rune('D') {
-						goto l280
-					}
-					position++
+				if !_rules[ruleAction9]() {
+					goto l260
 				}
-			l286:
+				add(ruleUpdateStateStmt, position261)
+			}
+			return true
+		l260:
+			position, tokenIndex = position260, tokenIndex260
+			return false
+		},
+		/* 17 UpdateSourceStmt <- <(('u' / 'U') ('p' / 'P') ('d' / 'D') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('c' / 'C') ('e' / 'E')) sp StreamIdentifier UpdateSourceSinkSpecs Action10)> */
+		func() bool {
+			position286, tokenIndex286 := position, tokenIndex
+			{
+				position287 := position
 				{
 					position288, tokenIndex288 := position, tokenIndex
-					if buffer[position] != rune('a') {
+					if buffer[position] != rune('u') {
 						goto l289
 					}
 					position++
 					goto l288
 				l289:
 					position, tokenIndex = position288, tokenIndex288
-					if buffer[position] != rune('A') {
-						goto l280
+					if buffer[position] != rune('U') {
+						goto l286
 					}
 					position++
 				}
 			l288:
 				{
 					position290, tokenIndex290 := position, tokenIndex
-					if buffer[position] != rune('t') {
+					if buffer[position] != rune('p') {
 						goto l291
 					}
 					position++
 					goto l290
 				l291:
 					position, tokenIndex = position290, tokenIndex290
-					if buffer[position] != rune('T') {
-						goto l280
+					if buffer[position] != rune('P') {
+						goto l286
 					}
 					position++
 				}
 			l290:
 				{
 					position292, tokenIndex292 := position, tokenIndex
-					if buffer[position] != rune('e') {
+					if buffer[position] != rune('d') {
 						goto l293
 					}
 					position++
 					goto l292
 				l293:
 					position, tokenIndex = position292, tokenIndex292
-					if buffer[position] != rune('E') {
-						goto l280
+					if buffer[position] != rune('D') {
+						goto l286
 					}
 					position++
 				}
 			l292:
-				if !_rules[rulesp]() {
-					goto l280
-				}
 				{
 					position294, tokenIndex294 := position, tokenIndex
-					if buffer[position] != rune('s') {
+					if buffer[position] != rune('a') {
 						goto l295
 					}
 					position++
 					goto l294
 				l295:
 					position, tokenIndex = position294, tokenIndex294
-					if buffer[position] != rune('S') {
-						goto l280
+					if buffer[position] != rune('A') {
+						goto l286
 					}
 					position++
 				}
 			l294:
 				{
 					position296, tokenIndex296 := position, tokenIndex
-					if buffer[position] != rune('o') {
+					if buffer[position] != rune('t') {
 						goto l297
 					}
 					position++
 					goto l296
 				l297:
 					position, tokenIndex = position296, tokenIndex296
-					if buffer[position] != rune('O') {
-						goto l280
+					if buffer[position] != rune('T') {
+						goto l286
 					}
 					position++
 				}
 			l296:
 				{
 					position298, tokenIndex298 := position, tokenIndex
-					if buffer[position] != rune('u') {
+					if buffer[position] != rune('e') {
 						goto l299
 					}
 					position++
 					goto l298
 				l299:
 					position, tokenIndex = position298, tokenIndex298
-					if buffer[position] != rune('U') {
-						goto l280
+					if buffer[position] != rune('E') {
+						goto l286
 					}
 					position++
 				}
 			l298:
+				if !_rules[rulesp]() {
+					goto l286
+				}
 				{
 					position300, tokenIndex300 := position, tokenIndex
-					if buffer[position] != rune('r') {
+					if buffer[position] != rune('s') {
 						goto l301
 					}
 					position++
 					goto l300
 				l301:
 					position, tokenIndex = position300, tokenIndex300
-					if buffer[position] != rune('R') {
-						goto l280
+					if buffer[position] != rune('S') {
+						goto l286
 					}
 					position++
 				}
 			l300:
 				{
 					position302, tokenIndex302 := position, tokenIndex
-					if buffer[position] != rune('c') {
+					if buffer[position] != rune('o') {
 						goto l303
 					}
 					position++
 					goto l302
 				l303:
 					position, tokenIndex = position302, tokenIndex302
-					if buffer[position] != rune('C') {
-						goto l280
+					if buffer[position] != rune('O') {
+						goto l286
 					}
 					position++
 				}
 			l302:
 				{
 					position304, tokenIndex304 := position, tokenIndex
-					if buffer[position] != rune('e') {
+					if buffer[position] != rune('u') {
 						goto l305
 					}
 					position++
 					goto l304
 				l305:
 					position, tokenIndex = position304, tokenIndex304
-					if buffer[position] != rune('E') {
-						goto l280
+					if buffer[position] != rune('U') {
+						goto l286
 					}
 					position++
 				}
 			l304:
-				if !_rules[rulesp]() {
-					goto l280
-				}
-				if !_rules[ruleStreamIdentifier]() {
-					goto l280
-				}
-				if !_rules[ruleUpdateSourceSinkSpecs]() {
-					goto l280
-				}
-				if !_rules[ruleAction10]() {
-					goto l280
+				{
+					position306, tokenIndex306 := position, tokenIndex
+					if buffer[position] != rune('r') {
+						goto l307
+					}
+					position++
+					goto l306
+				l307:
+					position, tokenIndex = position306, tokenIndex306
+					if buffer[position] != rune('R') {
+						goto l286
+					}
+					position++
 				}
-				add(ruleUpdateSourceStmt, position281)
-			}
-			return true
-		l280:
-			position, tokenIndex = position280, tokenIndex280
-			return false
-		},
-		/* 17 UpdateSinkStmt <- <(('u' / 'U') ('p' / 'P') ('d' / 'D') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('i' / 'I') ('n' / 'N') ('k' / 'K')) sp StreamIdentifier UpdateSourceSinkSpecs Action11)> */
-		func() bool {
-			position306, tokenIndex306 := position, tokenIndex
-			{
-				position307 := position
+			l306:
 				{
 					position308, tokenIndex308 := position, tokenIndex
-					if buffer[position] != rune('u') {
+					if buffer[position] != rune('c') {
 						goto l309
 					}
 					position++
 					goto l308
 				l309:
 					position, tokenIndex = position308, tokenIndex308
-					if buffer[position] != rune('U') {
-						goto l306
+					if buffer[position] != rune('C') {
+						goto l286
 					}
 					position++
 				}
 			l308:
 				{
 					position310, tokenIndex310 := position, tokenIndex
-					if buffer[position] != rune('p') {
+					if buffer[position] != rune('e') {
 						goto l311
 					}
 					position++
 					goto l310
 				l311:
 					position, tokenIndex = position310, tokenIndex310
-					if buffer[position] != rune('P') {
-						goto l306
+					if buffer[position] != rune('E') {
+						goto l286
 					}
 					position++
 				}
 			l310:
-				{
-					position312, tokenIndex312 := position, tokenIndex
-					if buffer[position] != rune('d') {
-						goto l313
-					}
-					position++
-					goto l312
-				l313:
-					position, tokenIndex = position312, tokenIndex312
-					if buffer[position] != rune('D') {
-						goto l306
-					}
-					position++
+				if !_rules[rulesp]() {
+					goto l286
+				}
+				if !_rules[ruleStreamIdentifier]() {
+					goto l286
+				}
+				if !_rules[ruleUpdateSourceSinkSpecs]() {
+					goto l286
 				}
-			l312:
+				if !_rules[ruleAction10]() {
+					goto l286
+				}
+				add(ruleUpdateSourceStmt, position287)
+			}
+			return true
+		l286:
+			position, tokenIndex = position286, tokenIndex286
+			return false
+		},
+		/* 18 UpdateSinkStmt <- <(('u' / 'U') ('p' / 'P') ('d' / 'D') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('i' / 'I') ('n' / 'N') ('k' / 'K')) sp StreamIdentifier UpdateSourceSinkSpecs Action11)> */
+		func() bool {
+			position312, tokenIndex312 := position, tokenIndex
+			{
+				position313 := position
 				{
 					position314, tokenIndex314 := position, tokenIndex
-					if buffer[position] != rune('a') {
+					if buffer[position] != rune('u') {
 						goto l315
 					}
 					position++
 					goto l314
 				l315:
 					position, tokenIndex = position314, tokenIndex314
-					if buffer[position] != rune('A') {
-						goto l306
+					if buffer[position] != rune('U') {
+						goto l312
 					}
 					position++
 				}
 			l314:
 				{
 					position316, tokenIndex316 := position, tokenIndex
-					if buffer[position] != rune('t') {
+					if buffer[position] != rune('p') {
 						goto l317
 					}
 					position++
 					goto l316
 				l317:
 					position, tokenIndex = position316, tokenIndex316
-					if buffer[position] != rune('T') {
-						goto l306
+					if buffer[position] != rune('P') {
+						goto l312
 					}
 					position++
 				}
 			l316:
 				{
 					position318, tokenIndex318 := position, tokenIndex
-					if buffer[position] != rune('e') {
+					if buffer[position] != rune('d') {
 						goto l319
 					}
 					position++
 					goto l318
 				l319:
 					position, tokenIndex = position318, tokenIndex318
-					if buffer[position] != rune('E') {
-						goto l306
+					if buffer[position] != rune('D') {
+						goto l312
 					}
 					position++
 				}
 			l318:
-				if !_rules[rulesp]() {
-					goto l306
-				}
 				{
 					position320, tokenIndex320 := position, tokenIndex
-					if buffer[position] != rune('s') {
+					if buffer[position] != rune('a') {
 						goto l321
 					}
 					position++
 					goto l320
 				l321:
 					position, tokenIndex = position320, tokenIndex320
-					if buffer[position] != rune('S') {
-						goto l306
+					if buffer[position] != rune('A') {
+						goto l312
 					}
 					position++
 				}
 			l320:
 				{
 					position322, tokenIndex322 := position, tokenIndex
-					if buffer[position] != rune('i') {
+					if buffer[position] != rune('t') {
 						goto l323
 					}
 					position++
 					goto l322
 				l323:
 					position, tokenIndex = position322, tokenIndex322
-					if buffer[position] != rune('I') {
-						goto l306
+					if buffer[position] != rune('T') {
+						goto l312
 					}
 					position++
 				}
 			l322:
 				{
 					position324, tokenIndex324 := position, tokenIndex
-					if buffer[position] != rune('n') {
+					if buffer[position] != rune('e') {
 						goto l325
 					}
 					position++
 					goto l324
 				l325:
 					position, tokenIndex = position324, tokenIndex324
-					if buffer[position] != rune('N') {
-						goto l306
+					if buffer[position] != rune('E') {
+						goto l312
 					}
 					position++
 				}
 			l324:
+				if !_rules[rulesp]() {
+					goto l312
+				}
 				{
 					position326, tokenIndex326 := position, tokenIndex
-					if buffer[position] != rune('k') {
+					if buffer[position] != rune('s') {
 						goto l327
 					}
 					position++
 					goto l326
 				l327:
 					position, tokenIndex = position326, tokenIndex326
-					if buffer[position] != rune('K') {
-						goto l306
+					if buffer[position] != rune('S') {
+						goto l312
 					}
 					position++
 				}
 			l326:
-				if !_rules[rulesp]() {
-					goto l306
-				}
-				if !_rules[ruleStreamIdentifier]() {
-					goto l306
-				}
-				if !_rules[ruleUpdateSourceSinkSpecs]() {
-					goto l306
-				}
-				if !_rules[ruleAction11]() {
-					goto l306
+				{
+					position328, tokenIndex328 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l329
+					}
+					position++
+					goto l328
+				l329:
+					position, tokenIndex = position328, tokenIndex328
+					if buffer[position] != rune('I') {
+						goto l312
+					}
+					position++
 				}
-				add(ruleUpdateSinkStmt, position307)
-			}
-			return true
-		l306:
-			position, tokenIndex = position306, tokenIndex306
-			return false
-		},
-		/* 18 InsertIntoFromStmt <- <(('i' / 'I') ('n' / 'N') ('s' / 'S') ('e' / 'E') ('r' / 'R') ('t' / 'T') sp (('i' / 'I') ('n' / 'N') ('t' / 'T') ('o' / 'O')) sp StreamIdentifier sp (('f' / 'F') ('r' / 'R') ('o' / 'O') ('m' / 'M')) sp StreamIdentifier Action12)> */
-		func() bool {
-			position328, tokenIndex328 := position, tokenIndex
-			{
-				position329 := position
+			l328:
 				{
 					position330, tokenIndex330 := position, tokenIndex
-					if buffer[position] != rune('i') {
+					if buffer[position] != rune('n') {
 						goto l331
 					}
 					position++
 					goto l330
 				l331:
 					position, tokenIndex = position330, tokenIndex330
-					if buffer[position] != rune('I') {
-						goto l328
+					if buffer[position] != rune('N') {
+						goto l312
 					}
 					position++
 				}
 			l330:
 				{
 					position332, tokenIndex332 := position, tokenIndex
-					if buffer[position] != rune('n') {
+					if buffer[position] != rune('k') {
 						goto l333
 					}
 					position++
 					goto l332
 				l333:
 					position, tokenIndex = position332, tokenIndex332
-					if buffer[position] != rune('N') {
-						goto l328
+					if buffer[position] != rune('K') {
+						goto l312
 					}
 					position++
 				}
 			l332:
-				{
-					position334, tokenIndex334 := position, tokenIndex
-					if buffer[position] != rune('s') {
-						goto l335
-					}
-					position++
-					goto l334
-				l335:
-					position, tokenIndex = position334, tokenIndex334
-					if buffer[position] != rune('S') {
-						goto l328
-					}
-					position++
+				if !_rules[rulesp]() {
+					goto l312
+				}
+				if !_rules[ruleStreamIdentifier]() {
+					goto l312
+				}
+				if !_rules[ruleUpdateSourceSinkSpecs]() {
+					goto l312
 				}
-			l334:
+				if !_rules[ruleAction11]() {
+					goto l312
+				}
+				add(ruleUpdateSinkStmt, position313)
+			}
+			return true
+		l312:
+			position, tokenIndex = position312, tokenIndex312
+			return false
+		},
+		/* 19 InsertIntoFromStmt <- <(('i' / 'I') ('n' / 'N') ('s' / 'S') ('e' / 'E') ('r' / 'R') ('t' / 'T') sp (('i' / 'I') ('n' / 'N') ('t' / 'T') ('o' / 'O')) sp StreamIdentifier sp (('f' / 'F') ('r' / 'R') ('o' / 'O') ('m' / 'M')) sp StreamIdentifier Action12)> */
+		func() bool {
+			position334, tokenIndex334 := position, tokenIndex
+			{
+				position335 := position
 				{
 					position336, tokenIndex336 := position, tokenIndex
-					if buffer[position] != rune('e') {
+					if buffer[position] != rune('i') {
 						goto l337
 					}
 					position++
 					goto l336
 				l337:
 					position, tokenIndex = position336, tokenIndex336
-					if buffer[position] != rune('E') {
-						goto l328
+					if buffer[position] != rune('I') {
+						goto l334
 					}
 					position++
 				}
 			l336:
 				{
 					position338, tokenIndex338 := position, tokenIndex
-					if buffer[position] != rune('r') {
+					if buffer[position] != rune('n') {
 						goto l339
 					}
 					position++
 					goto l338
 				l339:
 					position, tokenIndex = position338, tokenIndex338
-					if buffer[position] != rune('R') {
-						goto l328
+					if buffer[position] != rune('N') {
+						goto l334
 					}
 					position++
 				}
 			l338:
 				{
 					position340, tokenIndex340 := position, tokenIndex
-					if buffer[position] != rune('t') {
+					if buffer[position] != rune('s') {
 						goto l341
 					}
 					position++
 					goto l340
 				l341:
 					position, tokenIndex = position340, tokenIndex340
-					if buffer[position] != rune('T') {
-						goto l328
+					if buffer[position] != rune('S') {
+						goto l334
 					}
 					position++
 				}
 			l340:
-				if !_rules[rulesp]() {
-					goto l328
-				}
 				{
 					position342, tokenIndex342 := position, tokenIndex
-					if buffer[position] != rune('i') {
+					if buffer[position] != rune('e') {
 						goto l343
 					}
 					position++
 					goto l342
 				l343:
 					position, tokenIndex = position342, tokenIndex342
-					if buffer[position] != rune('I') {
-						goto l328
+					if buffer[position] != rune('E') {
+						goto l334
 					}
 					position++
 				}
 			l342:
 				{
 					position344, tokenIndex344 := position, tokenIndex
-					if buffer[position] != rune('n') {
+					if buffer[position] != rune('r') {
 						goto l345
 					}
 					position++
 					goto l344
 				l345:
 					position, tokenIndex = position344, tokenIndex344
-					if buffer[position] != rune('N') {
-						goto l328
+					if buffer[position] != rune('R') {
+						goto l334
 					}
 					position++
 				}
@@ -4246,61 +4378,55 @@ func (p *bqlPegBackend) Init() {
 				l347:
 					position, tokenIndex = position346, tokenIndex346
 					if buffer[position] != rune('T') {
-						goto l328
+						goto l334
 					}
 					position++
 				}
 			l346:
+				if !_rules[rulesp]() {
+					goto l334
+				}
 				{
 					position348, tokenIndex348 := position, tokenIndex
-					if buffer[position] != rune('o') {
+					if buffer[position] != rune('i') {
 						goto l349
 					}
 					position++
 					goto l348
 				l349:
 					position, tokenIndex = position348, tokenIndex348
-					if buffer[position] != rune('O') {
-						goto l328
+					if buffer[position] != rune('I') {
+						goto l334
 					}
 					position++
 				}
 			l348:
-				if !_rules[rulesp]() {
-					goto l328
-				}
-				if !_rules[ruleStreamIdentifier]() {
-					goto l328
-				}
-				if !_rules[rulesp]() {
-					goto l328
-				}
 				{
 					position350, tokenIndex350 := position, tokenIndex
-					if buffer[position] != rune('f') {
+					if buffer[position] != rune('n') {
 						goto l351
 					}
 					position++
 					goto l350
 				l351:
 					position, tokenIndex = position350, tokenIndex350
-					if buffer[position] != rune('F') {
-						goto l328
+					if buffer[position] != rune('N') {
+						goto l334
 					}
 					position++
 				}
 			l350:
 				{
 					position352, tokenIndex352 := position, tokenIndex
-					if buffer[position] != rune('r') {
+					if buffer[position] != rune('t') {
 						goto l353
 					}
 					position++
 					goto l352
 				l353:
 					position, tokenIndex = position352, tokenIndex352
-					if buffer[position] != rune('R') {
-						goto l328
+					if buffer[position] != rune('T') {
+						goto l334
 					}
 					position++
 				}
@@ -4315,247 +4441,250 @@ func (p *bqlPegBackend) Init() {
 				l355:
 					position, tokenIndex = position354, tokenIndex354
 					if buffer[position] != rune('O') {
-						goto l328
+						goto l334
 					}
 					position++
 				}
 			l354:
+				if !_rules[rulesp]() {
+					goto l334
+				}
+				if !_rules[ruleStreamIdentifier]() {
+					goto l334
+				}
+				if !_rules[rulesp]() {
+					goto l334
+				}
 				{
 					position356, tokenIndex356 := position, tokenIndex
-					if buffer[position] != rune('m') {
+					if buffer[position] != rune('f') {
 						goto l357
 					}
 					position++
 					goto l356
 				l357:
 					position, tokenIndex = position356, tokenIndex356
-					if buffer[position] != rune('M') {
-						goto l328
+					if buffer[position] != rune('F') {
+						goto l334
 					}
 					position++
 				}
 			l356:
-				if !_rules[rulesp]() {
-					goto l328
-				}
-				if !_rules[ruleStreamIdentifier]() {
-					goto l328
-				}
-				if !_rules[ruleAction12]() {
-					goto l328
+				{
+					position358, tokenIndex358 := position, tokenIndex
+					if buffer[position] != rune('r') {
+						goto l359
+					}
+					position++
+					goto l358
+				l359:
+					position, tokenIndex = position358, tokenIndex358
+					if buffer[position] != rune('R') {
+						goto l334
+					}
+					position++
 				}
-				add(ruleInsertIntoFromStmt, position329)
-			}
-			return true
-		l328:
-			position, tokenIndex = position328, tokenIndex328
-			return false
-		},
-		/* 19 PauseSourceStmt <- <(('p' / 'P') ('a' / 'A') ('u' / 'U') ('s' / 'S') ('e' / 'E') sp (('s' / 'S') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('c' / 'C') ('e' / 'E')) sp StreamIdentifier Action13)> */
-		func() bool {
-			position358, tokenIndex358 := position, tokenIndex
-			{
-				position359 := position
+			l358:
 				{
 					position360, tokenIndex360 := position, tokenIndex
-					if buffer[position] != rune('p') {
+					if buffer[position] != rune('o') {
 						goto l361
 					}
 					position++
 					goto l360
 				l361:
 					position, tokenIndex = position360, tokenIndex360
-					if buffer[position] != rune('P') {
-						goto l358
+					if buffer[position] != rune('O') {
+						goto l334
 					}
 					position++
 				}
 			l360:
 				{
 					position362, tokenIndex362 := position, tokenIndex
-					if buffer[position] != rune('a') {
+					if buffer[position] != rune('m') {
 						goto l363
 					}
 					position++
 					goto l362
 				l363:
 					position, tokenIndex = position362, tokenIndex362
-					if buffer[position] != rune('A') {
-						goto l358
+					if buffer[position] != rune('M') {
+						goto l334
 					}
 					position++
 				}
 			l362:
-				{
-					position364, tokenIndex364 := position, tokenIndex
-					if buffer[position] != rune('u') {
-						goto l365
-					}
-					position++
-					goto l364
-				l365:
-					position, tokenIndex = position364, tokenIndex364
-					if buffer[position] != rune('U') {
-						goto l358
-					}
-					position++
+				if !_rules[rulesp]() {
+					goto l334
+				}
+				if !_rules[ruleStreamIdentifier]() {
+					goto l334
 				}
-			l364:
+				if !_rules[ruleAction12]() {
+					goto l334
+				}
+				add(ruleInsertIntoFromStmt, position335)
+			}
+			return true
+		l334:
+			position, tokenIndex = position334, tokenIndex334
+			return false
+		},
+		/* 20 PauseSourceStmt <- <(('p' / 'P') ('a' / 'A') ('u' / 'U') ('s' / 'S') ('e' / 'E') sp (('s' / 'S') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('c' / 'C') ('e' / 'E')) sp StreamIdentifier Action13)> */
+		func() bool {
+			position364, tokenIndex364 := position, tokenIndex
+			{
+				position365 := position
 				{
 					position366, tokenIndex366 := position, tokenIndex
-					if buffer[position] != rune('s') {
+					if buffer[position] != rune('p') {
 						goto l367
 					}
 					position++
 					goto l366
 				l367:
 					position, tokenIndex = position366, tokenIndex366
-					if buffer[position] != rune('S') {
-						goto l358
+					if buffer[position] != rune('P') {
+						goto l364
 					}
 					position++
 				}
 			l366:
 				{
 					position368, tokenIndex368 := position, tokenIndex
-					if buffer[position] != rune('e') {
+					if buffer[position] != rune('a') {
 						goto l369
 					}
 					position++
 					goto l368
 				l369:
 					position, tokenIndex = position368, tokenIndex368
-					if buffer[position] != rune('E') {
-						goto l358
+					if buffer[position] != rune('A') {
+						goto l364
 					}
 					position++
 				}
 			l368:
-				if !_rules[rulesp]() {
-					goto l358
-				}
 				{
 					position370, tokenIndex370 := position, tokenIndex
-					if buffer[position] != rune('s') {
+					if buffer[position] != rune('u') {
 						goto l371
 					}
 					position++
 					goto l370
 				l371:
 					position, tokenIndex = position370, tokenIndex370
-					if buffer[position] != rune('S') {
-						goto l358
+					if buffer[position] != rune('U') {
+						goto l364
 					}
 					position++
 				}
 			l370:
 				{
 					position372, tokenIndex372 := position, tokenIndex
-					if buffer[position] != rune('o') {
+					if buffer[position] != rune('s') {
 						goto l373
 					}
 					position++
 					goto l372
 				l373:
 					position, tokenIndex = position372, tokenIndex372
-					if buffer[position] != rune('O') {
-						goto l358
+					if buffer[position] != rune('S') {
+						goto l364
 					}
 					position++
 				}
 			l372:
 				{
 					position374, tokenIndex374 := position, tokenIndex
-					if buffer[position] != rune('u') {
+					if buffer[position] != rune('e') {
 						goto l375
 					}
 					position++
 					goto l374
 				l375:
 					position, tokenIndex = position374, tokenIndex374
-					if buffer[position] != rune('U') {
-						goto l358
+					if buffer[position] != rune('E') {
+						goto l364
 					}
 					position++
 				}
 			l374:
+				if !_rules[rulesp]() {
+					goto l364
+				}
 				{
 					position376, tokenIndex376 := position, tokenIndex
-					if buffer[position] != rune('r') {
+					if buffer[position] != rune('s') {
 						goto l377
 					}
 					position++
 					goto l376
 				l377:
 					position, tokenIndex = position376, tokenIndex376
-					if buffer[position] != rune('R') {
-						goto l358
+					if buffer[position] != rune('S') {
+						goto l364
 					}
 					position++
 				}
 			l376:
 				{
 					position378, tokenIndex378 := position, tokenIndex
-					if buffer[position] != rune('c') {
+					if buffer[position] != rune('o') {
 						goto l379
 					}
 					position++
 					goto l378
 				l379:
 					position, tokenIndex = position378, tokenIndex378
-					if buffer[position] != rune('C') {
-						goto l358
+					if buffer[position] != rune('O') {
+						goto l364
 					}
 					position++
 				}
 			l378:
 				{
 					position380, tokenIndex380 := position, tokenIndex
-					if buffer[position] != rune('e') {
+					if buffer[position] != rune('u') {
 						goto l381
 					}
 					position++
 					goto l380
 				l381:
 					position, tokenIndex = position380, tokenIndex380
-					if buffer[position] != rune('E') {
-						goto l358
+					if buffer[position] != rune('U') {
+						goto l364
 					}
 					position++
 				}
 			l380:
-				if !_rules[rulesp]() {
-					goto l358
-				}
-				if !_rules[ruleStreamIdentifier]() {
-					goto l358
-				}
-				if !_rules[ruleAction13]() {
-					goto l358
+				{
+					position382, tokenIndex382 := position, tokenIndex
+					if buffer[position] != rune('r') {
+						goto l383
+					}
+					position++
+					goto l382
+				l383:
+					position, tokenIndex = position382, tokenIndex382
+					if buffer[position] != rune('R') {
+						goto l364
+					}
+					position++
 				}
-				add(rulePauseSourceStmt, position359)
-			}
-			return true
-		l358:
-			position, tokenIndex = position358, tokenIndex358
-			return false
-		},
-		/* 20 ResumeSourceStmt <- <(('r' / 'R') ('e' / 'E') ('s' / 'S') ('u' / 'U') ('m' / 'M') ('e' / 'E') sp (('s' / 'S') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('c' / 'C') ('e' / 'E')) sp StreamIdentifier Action14)> */
-		func() bool {
-			position382, tokenIndex382 := position, tokenIndex
-			{
-				position383 := position
+			l382:
 				{
 					position384, tokenIndex384 := position, tokenIndex
-					if buffer[position] != rune('r') {
+					if buffer[position] != rune('c') {
 						goto l385
 					}
 					position++
 					goto l384
 				l385:
 					position, tokenIndex = position384, tokenIndex384
-					if buffer[position] != rune('R') {
-						goto l382
+					if buffer[position] != rune('C') {
+						goto l364
 					}
 					position++
 				}
@@ -4570,196 +4699,196 @@ func (p *bqlPegBackend) Init() {
 				l387:
 					position, tokenIndex = position386, tokenIndex386
 					if buffer[position] != rune('E') {
-						goto l382
+						goto l364
 					}
 					position++
 				}
 			l386:
-				{
-					position388, tokenIndex388 := position, tokenIndex
-					if buffer[position] != rune('s') {
-						goto l389
-					}
-					position++
-					goto l388
-				l389:
-					position, tokenIndex = position388, tokenIndex388
-					if buffer[position] != rune('S') {
-						goto l382
-					}
-					position++
+				if !_rules[rulesp]() {
+					goto l364
+				}
+				if !_rules[ruleStreamIdentifier]() {
+					goto l364
+				}
+				if !_rules[ruleAction13]() {
+					goto l364
 				}
-			l388:
+				add(rulePauseSourceStmt, position365)
+			}
+			return true
+		l364:
+			position, tokenIndex = position364, tokenIndex364
+			return false
+		},
+		/* 21 ResumeSourceStmt <- <(('r' / 'R') ('e' / 'E') ('s' / 'S') ('u' / 'U') ('m' / 'M') ('e' / 'E') sp (('s' / 'S') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('c' / 'C') ('e' / 'E')) sp StreamIdentifier Action14)> */
+		func() bool {
+			position388, tokenIndex388 := position, tokenIndex
+			{
+				position389 := position
 				{
 					position390, tokenIndex390 := position, tokenIndex
-					if buffer[position] != rune('u') {
+					if buffer[position] != rune('r') {
 						goto l391
 					}
 					position++
 					goto l390
 				l391:
 					position, tokenIndex = position390, tokenIndex390
-					if buffer[position] != rune('U') {
-						goto l382
+					if buffer[position] != rune('R') {
+						goto l388
 					}
 					position++
 				}
 			l390:
 				{
 					position392, tokenIndex392 := position, tokenIndex
-					if buffer[position] != rune('m') {
+					if buffer[position] != rune('e') {
 						goto l393
 					}
 					position++
 					goto l392
 				l393:
 					position, tokenIndex = position392, tokenIndex392
-					if buffer[position] != rune('M') {
-						goto l382
+					if buffer[position] != rune('E') {
+						goto l388
 					}
 					position++
 				}
 			l392:
 				{
 					position394, tokenIndex394 := position, tokenIndex
-					if buffer[position] != rune('e') {
+					if buffer[position] != rune('s') {
 						goto l395
 					}
 					position++
 					goto l394
 				l395:
 					position, tokenIndex = position394, tokenIndex394
-					if buffer[position] != rune('E') {
-						goto l382
+					if buffer[position] != rune('S') {
+						goto l388
 					}
 					position++
 				}
 			l394:
-				if !_rules[rulesp]() {
-					goto l382
-				}
 				{
 					position396, tokenIndex396 := position, tokenIndex
-					if buffer[position] != rune('s') {
+					if buffer[position] != rune('u') {
 						goto l397
 					}
 					position++
 					goto l396
 				l397:
 					position, tokenIndex = position396, tokenIndex396
-					if buffer[position] != rune('S') {
-						goto l382
+					if buffer[position] != rune('U') {
+						goto l388
 					}
 					position++
 				}
 			l396:
 				{
 					position398, tokenIndex398 := position, tokenIndex
-					if buffer[position] != rune('o') {
+					if buffer[position] != rune('m') {
 						goto l399
 					}
 					position++
 					goto l398
 				l399:
 					position, tokenIndex = position398, tokenIndex398
-					if buffer[position] != rune('O') {
-						goto l382
+					if buffer[position] != rune('M') {
+						goto l388
 					}
 					position++
 				}
 			l398:
 				{
 					position400, tokenIndex400 := position, tokenIndex
-					if buffer[position] != rune('u') {
+					if buffer[position] != rune('e') {
 						goto l401
 					}
 					position++
 					goto l400
 				l401:
 					position, tokenIndex = position400, tokenIndex400
-					if buffer[position] != rune('U') {
-						goto l382
+					if buffer[position] != rune('E') {
+						goto l388
 					}
 					position++
 				}
 			l400:
+				if !_rules[rulesp]() {
+					goto l388
+				}
 				{
 					position402, tokenIndex402 := position, tokenIndex
-					if buffer[position] != rune('r') {
+					if buffer[position] != rune('s') {
 						goto l403
 					}
 					position++
 					goto l402
 				l403:
 					position, tokenIndex = position402, tokenIndex402
-					if buffer[position] != rune('R') {
-						goto l382
+					if buffer[position] != rune('S') {
+						goto l388
 					}
 					position++
 				}
 			l402:
 				{
 					position404, tokenIndex404 := position, tokenIndex
-					if buffer[position] != rune('c') {
+					if buffer[position] != rune('o') {
 						goto l405
 					}
 					position++
 					goto l404
 				l405:
 					position, tokenIndex = position404, tokenIndex404
-					if buffer[position] != rune('C') {
-						goto l382
+					if buffer[position] != rune('O') {
+						goto l388
 					}
 					position++
 				}
 			l404:
 				{
 					position406, tokenIndex406 := position, tokenIndex
-					if buffer[position] != rune('e') {
+					if buffer[position] != rune('u') {
 						goto l407
 					}
 					position++
 					goto l406
 				l407:
 					position, tokenIndex = position406, tokenIndex406
-					if buffer[position] != rune('E') {
-						goto l382
+					if buffer[position] != rune('U') {
+						goto l388
 					}
 					position++
 				}
 			l406:
-				if !_rules[rulesp]() {
-					goto l382
-				}
-				if !_rules[ruleStreamIdentifier]() {
-					goto l382
-				}
-				if !_rules[ruleAction14]() {
-					goto l382
+				{
+					position408, tokenIndex408 := position, tokenIndex
+					if buffer[position] != rune('r') {
+						goto l409
+					}
+					position++
+					goto l408
+				l409:
+					position, tokenIndex = position408, tokenIndex408
+					if buffer[position] != rune('R') {
+						goto l388
+					}
+					position++
 				}
-				add(ruleResumeSourceStmt, position383)
-			}
-			return true
-		l382:
-			position, tokenIndex = position382, tokenIndex382
-			return false
-		},
-		/* 21 RewindSourceStmt <- <(('r' / 'R') ('e' / 'E') ('w' / 'W') ('i' / 'I') ('n' / 'N') ('d' / 'D') sp (('s' / 'S') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('c' / 'C') ('e' / 'E')) sp StreamIdentifier Action15)> */
-		func() bool {
-			position408, tokenIndex408 := position, tokenIndex
-			{
-				position409 := position
+			l408:
 				{
 					position410, tokenIndex410 := position, tokenIndex
-					if buffer[position] != rune('r') {
+					if buffer[position] != rune('c') {
 						goto l411
 					}
 					position++
 					goto l410
 				l411:
 					position, tokenIndex = position410, tokenIndex410
-					if buffer[position] != rune('R') {
-						goto l408
+					if buffer[position] != rune('C') {
+						goto l388
 					}
 					position++
 				}
@@ -4774,259 +4903,262 @@ func (p *bqlPegBackend) Init() {
 				l413:
 					position, tokenIndex = position412, tokenIndex412
 					if buffer[position] != rune('E') {
-						goto l408
+						goto l388
 					}
 					position++
 				}
 			l412:
-				{
-					position414, tokenIndex414 := position, tokenIndex
-					if buffer[position] != rune('w') {
-						goto l415
-					}
-					position++
-					goto l414
-				l415:
-					position, tokenIndex = position414, tokenIndex414
-					if buffer[position] != rune('W') {
-						goto l408
-					}
-					position++
-				}
-			l414:
+				if !_rules[rulesp]() {
+					goto l388
+				}
+				if !_rules[ruleStreamIdentifier]() {
+					goto l388
+				}
+				if !_rules[ruleAction14]() {
+					goto l388
+				}
+				add(ruleResumeSourceStmt, position389)
+			}
+			return true
+		l388:
+			position, tokenIndex = position388, tokenIndex388
+			return false
+		},
+		/* 22 RewindSourceStmt <- <(('r' / 'R') ('e' / 'E') ('w' / 'W') ('i' / 'I') ('n' / 'N') ('d' / 'D') sp (('s' / 'S') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('c' / 'C') ('e' / 'E')) sp StreamIdentifier Action15)> */
+		func() bool {
+			position414, tokenIndex414 := position, tokenIndex
+			{
+				position415 := position
 				{
 					position416, tokenIndex416 := position, tokenIndex
-					if buffer[position] != rune('i') {
+					if buffer[position] != rune('r') {
 						goto l417
 					}
 					position++
 					goto l416
 				l417:
 					position, tokenIndex = position416, tokenIndex416
-					if buffer[position] != rune('I') {
-						goto l408
+					if buffer[position] != rune('R') {
+						goto l414
 					}
 					position++
 				}
 			l416:
 				{
 					position418, tokenIndex418 := position, tokenIndex
-					if buffer[position] != rune('n') {
+					if buffer[position] != rune('e') {
 						goto l419
 					}
 					position++
 					goto l418
 				l419:
 					position, tokenIndex = position418, tokenIndex418
-					if buffer[position] != rune('N') {
-						goto l408
+					if buffer[position] != rune('E') {
+						goto l414
 					}
 					position++
 				}
 			l418:
 				{
 					position420, tokenIndex420 := position, tokenIndex
-					if buffer[position] != rune('d') {
+					if buffer[position] != rune('w') {
 						goto l421
 					}
 					position++
 					goto l420
 				l421:
 					position, tokenIndex = position420, tokenIndex420
-					if buffer[position] != rune('D') {
-						goto l408
+					if buffer[position] != rune('W') {
+						goto l414
 					}
 					position++
 				}
 			l420:
-				if !_rules[rulesp]() {
-					goto l408
-				}
 				{
 					position422, tokenIndex422 := position, tokenIndex
-					if buffer[position] != rune('s') {
+					if buffer[position] != rune('i') {
 						goto l423
 					}
 					position++
 					goto l422
 				l423:
 					position, tokenIndex = position422, tokenIndex422
-					if buffer[position] != rune('S') {
-						goto l408
+					if buffer[position] != rune('I') {
+						goto l414
 					}
 					position++
 				}
 			l422:
 				{
 					position424, tokenIndex424 := position, tokenIndex
-					if buffer[position] != rune('o') {
+					if buffer[position] != rune('n') {
 						goto l425
 					}
 					position++
 					goto l424
 				l425:
 					position, tokenIndex = position424, tokenIndex424
-					if buffer[position] != rune('O') {
-						goto l408
+					if buffer[position] != rune('N') {
+						goto l414
 					}
 					position++
 				}
 			l424:
 				{
 					position426, tokenIndex426 := position, tokenIndex
-					if buffer[position] != rune('u') {
+					if buffer[position] != rune('d') {
 						goto l427
 					}
 					position++
 					goto l426
 				l427:
 					position, tokenIndex = position426, tokenIndex426
-					if buffer[position] != rune('U') {
-						goto l408
+					if buffer[position] != rune('D') {
+						goto l414
 					}
 					position++
 				}
 			l426:
+				if !_rules[rulesp]() {
+					goto l414
+				}
 				{
 					position428, tokenIndex428 := position, tokenIndex
-					if buffer[position] != rune('r') {
+					if buffer[position] != rune('s') {
 						goto l429
 					}
 					position++
 					goto l428
 				l429:
 					position, tokenIndex = position428, tokenIndex428
-					if buffer[position] != rune('R') {
-						goto l408
+					if buffer[position] != rune('S') {
+						goto l414
 					}
 					position++
 				}
 			l428:
 				{
 					position430, tokenIndex430 := position, tokenIndex
-					if buffer[position] != rune('c') {
+					if buffer[position] != rune('o') {
 						goto l431
 					}
 					position++
 					goto l430
 				l431:
 					position, tokenIndex = position430, tokenIndex430
-					if buffer[position] != rune('C') {
-						goto l408
+					if buffer[position] != rune('O') {
+						goto l414
 					}
 					position++
 				}
 			l430:
 				{
 					position432, tokenIndex432 := position, tokenIndex
-					if buffer[position] != rune('e') {
+					if buffer[position] != rune('u') {
 						goto l433
 					}
 					position++
 					goto l432
 				l433:
 					position, tokenIndex = position432, tokenIndex432
-					if buffer[position] != rune('E') {
-						goto l408
+					if buffer[position] != rune('U') {
+						goto l414
 					}
 					position++
 				}
 			l432:
-				if !_rules[rulesp]() {
-					goto l408
-				}
-				if !_rules[ruleStreamIdentifier]() {
-					goto l408
-				}
-				if !_rules[ruleAction15]() {
-					goto l408
+				{
+					position434, tokenIndex434 := position, tokenIndex
+					if buffer[position] != rune('r') {
+						goto l435
+					}
+					position++
+					goto l434
+				l435:
+					position, tokenIndex = position434, tokenIndex434
+					if buffer[position] != rune('R') {
+						goto l414
+					}
+					position++
 				}
-				add(ruleRewindSourceStmt, position409)
-			}
-			return true
-		l408:
-			position, tokenIndex = position408, tokenIndex408
-			return false
-		},
-		/* 22 DropSourceStmt <- <(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('s' / 'S') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('c' / 'C') ('e' / 'E')) sp StreamIdentifier Action16)> */
-		func() bool {
-			position434, tokenIndex434 := position, tokenIndex
-			{
-				position435 := position
+			l434:
 				{
 					position436, tokenIndex436 := position, tokenIndex
-					if buffer[position] != rune('d') {
+					if buffer[position] != rune('c') {
 						goto l437
 					}
 					position++
 					goto l436
 				l437:
 					position, tokenIndex = position436, tokenIndex436
-					if buffer[position] != rune('D') {
-						goto l434
+					if buffer[position] != rune('C') {
+						goto l414
 					}
 					position++
 				}
 			l436:
 				{
 					position438, tokenIndex438 := position, tokenIndex
-					if buffer[position] != rune('r') {
+					if buffer[position] != rune('e') {
 						goto l439
 					}
 					position++
 					goto l438
 				l439:
 					position, tokenIndex = position438, tokenIndex438
-					if buffer[position] != rune('R') {
-						goto l434
+					if buffer[position] != rune('E') {
+						goto l414
 					}
 					position++
 				}
 			l438:
-				{
-					position440, tokenIndex440 := position, tokenIndex
-					if buffer[position] != rune('o') {
-						goto l441
-					}
-					position++
-					goto l440
-				l441:
-					position, tokenIndex = position440, tokenIndex440
-					if buffer[position] != rune('O') {
-						goto l434
-					}
-					position++
+				if !_rules[rulesp]() {
+					goto l414
+				}
+				if !_rules[ruleStreamIdentifier]() {
+					goto l414
 				}
-			l440:
+				if !_rules[ruleAction15]() {
+					goto l414
+				}
+				add(ruleRewindSourceStmt, position415)
+			}
+			return true
+		l414:
+			position, tokenIndex = position414, tokenIndex414
+			return false
+		},
+		/* 23 DropSourceStmt <- <(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('s' / 'S') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('c' / 'C') ('e' / 'E')) sp StreamIdentifier Action16)> */
+		func() bool {
+			position440, tokenIndex440 := position, tokenIndex
+			{
+				position441 := position
 				{
 					position442, tokenIndex442 := position, tokenIndex
-					if buffer[position] != rune('p') {
+					if buffer[position] != rune('d') {
 						goto l443
 					}
 					position++
 					goto l442
 				l443:
 					position, tokenIndex = position442, tokenIndex442
-					if buffer[position] != rune('P') {
-						goto l434
+					if buffer[position] != rune('D') {
+						goto l440
 					}
 					position++
 				}
 			l442:
-				if !_rules[rulesp]() {
-					goto l434
-				}
 				{
 					position444, tokenIndex444 := position, tokenIndex
-					if buffer[position] != rune('s') {
+					if buffer[position] != rune('r') {
 						goto l445
 					}
 					position++
 					goto l444
 				l445:
 					position, tokenIndex = position444, tokenIndex444
-					if buffer[position] != rune('S') {
-						goto l434
+					if buffer[position] != rune('R') {
+						goto l440
 					}
 					position++
 				}
@@ -5041,913 +5173,916 @@ func (p *bqlPegBackend) Init() {
 				l447:
 					position, tokenIndex = position446, tokenIndex446
 					if buffer[position] != rune('O') {
-						goto l434
+						goto l440
 					}
 					position++
 				}
 			l446:
 				{
 					position448, tokenIndex448 := position, tokenIndex
-					if buffer[position] != rune('u') {
+					if buffer[position] != rune('p') {
 						goto l449
 					}
 					position++
 					goto l448
 				l449:
 					position, tokenIndex = position448, tokenIndex448
-					if buffer[position] != rune('U') {
-						goto l434
+					if buffer[position] != rune('P') {
+						goto l440
 					}
 					position++
 				}
 			l448:
+				if !_rules[rulesp]() {
+					goto l440
+				}
 				{
 					position450, tokenIndex450 := position, tokenIndex
-					if buffer[position] != rune('r') {
+					if buffer[position] != rune('s') {
 						goto l451
 					}
 					position++
 					goto l450
 				l451:
 					position, tokenIndex = position450, tokenIndex450
-					if buffer[position] != rune('R') {
-						goto l434
+					if buffer[position] != rune('S') {
+						goto l440
 					}
 					position++
 				}
 			l450:
 				{
 					position452, tokenIndex452 := position, tokenIndex
-					if buffer[position] != rune('c') {
+					if buffer[position] != rune('o') {
 						goto l453
 					}
 					position++
 					goto l452
 				l453:
 					position, tokenIndex = position452, tokenIndex452
-					if buffer[position] != rune('C') {
-						goto l434
+					if buffer[position] != rune('O') {
+						goto l440
 					}
 					position++
 				}
 			l452:
 				{
 					position454, tokenIndex454 := position, tokenIndex
-					if buffer[position] != rune('e') {
+					if buffer[position] != rune('u') {
 						goto l455
 					}
 					position++
 					goto l454
 				l455:
 					position, tokenIndex = position454, tokenIndex454
-					if buffer[position] != rune('E') {
-						goto l434
+					if buffer[position] != rune('U') {
+						goto l440
 					}
 					position++
 				}
 			l454:
-				if !_rules[rulesp]() {
-					goto l434
-				}
-				if !_rules[ruleStreamIdentifier]() {
-					goto l434
-				}
-				if !_rules[ruleAction16]() {
-					goto l434
+				{
+					position456, tokenIndex456 := position, tokenIndex
+					if buffer[position] != rune('r') {
+						goto l457
+					}
+					position++
+					goto l456
+				l457:
+					position, tokenIndex = position456, tokenIndex456
+					if buffer[position] != rune('R') {
+						goto l440
+					}
+					position++
 				}
-				add(ruleDropSourceStmt, position435)
-			}
-			return true
-		l434:
-			position, tokenIndex = position434, tokenIndex434
-			return false
-		},
-		/* 23 DropStreamStmt <- <(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M')) sp StreamIdentifier Action17)> */
-		func() bool {
-			position456, tokenIndex456 := position, tokenIndex
-			{
-				position457 := position
+			l456:
 				{
 					position458, tokenIndex458 := position, tokenIndex
-					if buffer[position] != rune('d') {
+					if buffer[position] != rune('c') {
 						goto l459
 					}
 					position++
 					goto l458
 				l459:
 					position, tokenIndex = position458, tokenIndex458
-					if buffer[position] != rune('D') {
-						goto l456
+					if buffer[position] != rune('C') {
+						goto l440
 					}
 					position++
 				}
 			l458:
 				{
 					position460, tokenIndex460 := position, tokenIndex
-					if buffer[position] != rune('r') {
+					if buffer[position] != rune('e') {
 						goto l461
 					}
 					position++
 					goto l460
 				l461:
 					position, tokenIndex = position460, tokenIndex460
-					if buffer[position] != rune('R') {
-						goto l456
+					if buffer[position] != rune('E') {
+						goto l440
 					}
 					position++
 				}
 			l460:
-				{
-					position462, tokenIndex462 := position, tokenIndex
-					if buffer[position] != rune('o') {
-						goto l463
-					}
-					position++
-					goto l462
-				l463:
-					position, tokenIndex = position462, tokenIndex462
-					if buffer[position] != rune('O') {
-						goto l456
-					}
-					position++
+				if !_rules[rulesp]() {
+					goto l440
+				}
+				if !_rules[ruleStreamIdentifier]() {
+					goto l440
+				}
+				if !_rules[ruleAction16]() {
+					goto l440
 				}
-			l462:
+				add(ruleDropSourceStmt, position441)
+			}
+			return true
+		l440:
+			position, tokenIndex = position440, tokenIndex440
+			return false
+		},
+		/* 24 DropStreamStmt <- <(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M')) sp StreamIdentifier Action17)> */
+		func() bool {
+			position462, tokenIndex462 := position, tokenIndex
+			{
+				position463 := position
 				{
 					position464, tokenIndex464 := position, tokenIndex
-					if buffer[position] != rune('p') {
+					if buffer[position] != rune('d') {
 						goto l465
 					}
 					position++
 					goto l464
 				l465:
 					position, tokenIndex = position464, tokenIndex464
-					if buffer[position] != rune('P') {
-						goto l456
+					if buffer[position] != rune('D') {
+						goto l462
 					}
 					position++
 				}
 			l464:
-				if !_rules[rulesp]() {
-					goto l456
-				}
 				{
 					position466, tokenIndex466 := position, tokenIndex
-					if buffer[position] != rune('s') {
+					if buffer[position] != rune('r') {
 						goto l467
 					}
 					position++
 					goto l466
 				l467:
 					position, tokenIndex = position466, tokenIndex466
-					if buffer[position] != rune('S') {
-						goto l456
+					if buffer[position] != rune('R') {
+						goto l462
 					}
 					position++
 				}
 			l466:
 				{
 					position468, tokenIndex468 := position, tokenIndex
-					if buffer[position] != rune('t') {
+					if buffer[position] != rune('o') {
 						goto l469
 					}
 					position++
 					goto l468
 				l469:
 					position, tokenIndex = position468, tokenIndex468
-					if buffer[position] != rune('T') {
-						goto l456
+					if buffer[position] != rune('O') {
+						goto l462
 					}
 					position++
 				}
 			l468:
 				{
 					position470, tokenIndex470 := position, tokenIndex
-					if buffer[position] != rune('r') {
+					if buffer[position] != rune('p') {
 						goto l471
 					}
 					position++
 					goto l470
 				l471:
 					position, tokenIndex = position470, tokenIndex470
-					if buffer[position] != rune('R') {
-						goto l456
+					if buffer[position] != rune('P') {
+						goto l462
 					}
 					position++
 				}
 			l470:
+				if !_rules[rulesp]() {
+					goto l462
+				}
 				{
 					position472, tokenIndex472 := position, tokenIndex
-					if buffer[position] != rune('e') {
+					if buffer[position] != rune('s') {
 						goto l473
 					}
 					position++
 					goto l472
 				l473:
 					position, tokenIndex = position472, tokenIndex472
-					if buffer[position] != rune('E') {
-						goto l456
+					if buffer[position] != rune('S') {
+						goto l462
 					}
 					position++
 				}
 			l472:
 				{
 					position474, tokenIndex474 := position, tokenIndex
-					if buffer[position] != rune('a') {
+					if buffer[position] != rune('t') {
 						goto l475
 					}
 					position++
 					goto l474
 				l475:
 					position, tokenIndex = position474, tokenIndex474
-					if buffer[position] != rune('A') {
-						goto l456
+					if buffer[position] != rune('T') {
+						goto l462
 					}
 					position++
 				}
 			l474:
 				{
 					position476, tokenIndex476 := position, tokenIndex
-					if buffer[position] != rune('m') {
+					if buffer[position] != rune('r') {
 						goto l477
 					}
 					position++
 					goto l476
 				l477:
 					position, tokenIndex = position476, tokenIndex476
-					if buffer[position] != rune('M') {
-						goto l456
+					if buffer[position] != rune('R') {
+						goto l462
 					}
 					position++
 				}
 			l476:
-				if !_rules[rulesp]() {
-					goto l456
-				}
-				if !_rules[ruleStreamIdentifier]() {
-					goto l456
-				}
-				if !_rules[ruleAction17]() {
-					goto l456
+				{
+					position478, tokenIndex478 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l479
+					}
+					position++
+					goto l478
+				l479:
+					position, tokenIndex = position478, tokenIndex478
+					if buffer[position] != rune('E') {
+						goto l462
+					}
+					position++
 				}
-				add(ruleDropStreamStmt, position457)
-			}
-			return true
-		l456:
-			position, tokenIndex = position456, tokenIndex456
-			return false
-		},
-		/* 24 DropSinkStmt <- <(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('s' / 'S') ('i' / 'I') ('n' / 'N') ('k' / 'K')) sp StreamIdentifier Action18)> */
-		func() bool {
-			position478, tokenIndex478 := position, tokenIndex
-			{
-				position479 := position
+			l478:
 				{
 					position480, tokenIndex480 := position, tokenIndex
-					if buffer[position] != rune('d') {
+					if buffer[position] != rune('a') {
 						goto l481
 					}
 					position++
 					goto l480
 				l481:
 					position, tokenIndex = position480, tokenIndex480
-					if buffer[position] != rune('D') {
-						goto l478
+					if buffer[position] != rune('A') {
+						goto l462
 					}
 					position++
 				}
 			l480:
 				{
 					position482, tokenIndex482 := position, tokenIndex
-					if buffer[position] != rune('r') {
+					if buffer[position] != rune('m') {
 						goto l483
 					}
 					position++
 					goto l482
 				l483:
 					position, tokenIndex = position482, tokenIndex482
-					if buffer[position] != rune('R') {
-						goto l478
+					if buffer[position] != rune('M') {
+						goto l462
 					}
 					position++
 				}
 			l482:
-				{
-					position484, tokenIndex484 := position, tokenIndex
-					if buffer[position] != rune('o') {
-						goto l485
-					}
-					position++
-					goto l484
-				l485:
-					position, tokenIndex = position484, tokenIndex484
-					if buffer[position] != rune('O') {
-						goto l478
-					}
-					position++
+				if !_rules[rulesp]() {
+					goto l462
+				}
+				if !_rules[ruleStreamIdentifier]() {
+					goto l462
 				}
-			l484:
+				if !_rules[ruleAction17]() {
+					goto l462
+				}
+				add(ruleDropStreamStmt, position463)
+			}
+			return true
+		l462:
+			position, tokenIndex = position462, tokenIndex462
+			return false
+		},
+		/* 25 DropSinkStmt <- <(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('s' / 'S') ('i' / 'I') ('n' / 'N') ('k' / 'K')) sp StreamIdentifier Action18)> */
+		func() bool {
+			position484, tokenIndex484 := position, tokenIndex
+			{
+				position485 := position
 				{
 					position486, tokenIndex486 := position, tokenIndex
-					if buffer[position] != rune('p') {
+					if buffer[position] != rune('d') {
 						goto l487
 					}
 					position++
 					goto l486
 				l487:
 					position, tokenIndex = position486, tokenIndex486
-					if buffer[position] != rune('P') {
-						goto l478
+					if buffer[position] != rune('D') {
+						goto l484
 					}
 					position++
 				}
 			l486:
-				if !_rules[rulesp]() {
-					goto l478
-				}
 				{
 					position488, tokenIndex488 := position, tokenIndex
-					if buffer[position] != rune('s') {
+					if buffer[position] != rune('r') {
 						goto l489
 					}
 					position++
 					goto l488
 				l489:
 					position, tokenIndex = position488, tokenIndex488
-					if buffer[position] != rune('S') {
-						goto l478
+					if buffer[position] != rune('R') {
+						goto l484
 					}
 					position++
 				}
 			l488:
 				{
 					position490, tokenIndex490 := position, tokenIndex
-					if buffer[position] != rune('i') {
+					if buffer[position] != rune('o') {
 						goto l491
 					}
 					position++
 					goto l490
 				l491:
 					position, tokenIndex = position490, tokenIndex490
-					if buffer[position] != rune('I') {
-						goto l478
+					if buffer[position] != rune('O') {
+						goto l484
 					}
 					position++
 				}
 			l490:
 				{
 					position492, tokenIndex492 := position, tokenIndex
-					if buffer[position] != rune('n') {
+					if buffer[position] != rune('p') {
 						goto l493
 					}
 					position++
 					goto l492
 				l493:
 					position, tokenIndex = position492, tokenIndex492
-					if buffer[position] != rune('N') {
-						goto l478
+					if buffer[position] != rune('P') {
+						goto l484
 					}
 					position++
 				}
 			l492:
+				if !_rules[rulesp]() {
+					goto l484
+				}
 				{
 					position494, tokenIndex494 := position, tokenIndex
-					if buffer[position] != rune('k') {
+					if buffer[position] != rune('s') {
 						goto l495
 					}
 					position++
 					goto l494
 				l495:
 					position, tokenIndex = position494, tokenIndex494
-					if buffer[position] != rune('K') {
-						goto l478
+					if buffer[position] != rune('S') {
+						goto l484
 					}
 					position++
 				}
 			l494:
-				if !_rules[rulesp]() {
-					goto l478
-				}
-				if !_rules[ruleStreamIdentifier]() {
-					goto l478
-				}
-				if !_rules[ruleAction18]() {
-					goto l478
+				{
+					position496, tokenIndex496 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l497
+					}
+					position++
+					goto l496
+				l497:
+					position, tokenIndex = position496, tokenIndex496
+					if buffer[position] != rune('I') {
+						goto l484
+					}
+					position++
 				}
-				add(ruleDropSinkStmt, position479)
-			}
-			return true
-		l478:
-			position, tokenIndex = position478, tokenIndex478
-			return false
-		},
-		/* 25 DropStateStmt <- <(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('s' / 'S') ('t' / 'T') ('a' / 'A') ('t' / 'T') ('e' / 'E')) sp StreamIdentifier Action19)> */
-		func() bool {
-			position496, tokenIndex496 := position, tokenIndex
-			{
-				position497 := position
+			l496:
 				{
 					position498, tokenIndex498 := position, tokenIndex
-					if buffer[position] != rune('d') {
+					if buffer[position] != rune('n') {
 						goto l499
 					}
 					position++
 					goto l498
 				l499:
 					position, tokenIndex = position498, tokenIndex498
-					if buffer[position] != rune('D') {
-						goto l496
+					if buffer[position] != rune('N') {
+						goto l484
 					}
 					position++
 				}
 			l498:
 				{
 					position500, tokenIndex500 := position, tokenIndex
-					if buffer[position] != rune('r') {
+					if buffer[position] != rune('k') {
 						goto l501
 					}
 					position++
 					goto l500
 				l501:
 					position, tokenIndex = position500, tokenIndex500
-					if buffer[position] != rune('R') {
-						goto l496
+					if buffer[position] != rune('K') {
+						goto l484
 					}
 					position++
 				}
 			l500:
-				{
-					position502, tokenIndex502 := position, tokenIndex
-					if buffer[position] != rune('o') {
-						goto l503
-					}
-					position++
-					goto l502
-				l503:
-					position, tokenIndex = position502, tokenIndex502
-					if buffer[position] != rune('O') {
-						goto l496
-					}
-					position++
+				if !_rules[rulesp]() {
+					goto l484
+				}
+				if !_rules[ruleStreamIdentifier]() {
+					goto l484
+				}
+				if !_rules[ruleAction18]() {
+					goto l484
 				}
-			l502:
+				add(ruleDropSinkStmt, position485)
+			}
+			return true
+		l484:
+			position, tokenIndex = position484, tokenIndex484
+			return false
+		},
+		/* 26 DropStateStmt <- <(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('s' / 'S') ('t' / 'T') ('a' / 'A') ('t' / 'T') ('e' / 'E')) sp StreamIdentifier Action19)> */
+		func() bool {
+			position502, tokenIndex502 := position, tokenIndex
+			{
+				position503 := position
 				{
 					position504, tokenIndex504 := position, tokenIndex
-					if buffer[position] != rune('p') {
+					if buffer[position] != rune('d') {
 						goto l505
 					}
 					position++
 					goto l504
 				l505:
 					position, tokenIndex = position504, tokenIndex504
-					if buffer[position] != rune('P') {
-						goto l496
+					if buffer[position] != rune('D') {
+						goto l502
 					}
 					position++
 				}
 			l504:
-				if !_rules[rulesp]() {
-					goto l496
-				}
 				{
 					position506, tokenIndex506 := position, tokenIndex
-					if buffer[position] != rune('s') {
+					if buffer[position] != rune('r') {
 						goto l507
 					}
 					position++
 					goto l506
 				l507:
 					position, tokenIndex = position506, tokenIndex506
-					if buffer[position] != rune('S') {
-						goto l496
+					if buffer[position] != rune('R') {
+						goto l502
 					}
 					position++
 				}
 			l506:
 				{
 					position508, tokenIndex508 := position, tokenIndex
-					if buffer[position] != rune('t') {
+					if buffer[position] != rune('o') {
 						goto l509
 					}
 					position++
 					goto l508
 				l509:
 					position, tokenIndex = position508, tokenIndex508
-					if buffer[position] != rune('T') {
-						goto l496
+					if buffer[position] != rune('O') {
+						goto l502
 					}
 					position++
 				}
 			l508:
 				{
 					position510, tokenIndex510 := position, tokenIndex
-					if buffer[position] != rune('a') {
+					if buffer[position] != rune('p') {
 						goto l511
 					}
 					position++
 					goto l510
 				l511:
 					position, tokenIndex = position510, tokenIndex510
-					if buffer[position] != rune('A') {
-						goto l496
+					if buffer[position] != rune('P') {
+						goto l502
 					}
 					position++
 				}
 			l510:
+				if !_rules[rulesp]() {
+					goto l502
+				}
 				{
 					position512, tokenIndex512 := position, tokenIndex
-					if buffer[position] != rune('t') {
+					if buffer[position] != rune('s') {
 						goto l513
 					}
 					position++
 					goto l512
 				l513:
 					position, tokenIndex = position512, tokenIndex512
-					if buffer[position] != rune('T') {
-						goto l496
+					if buffer[position] != rune('S') {
+						goto l502
 					}
 					position++
 				}
 			l512:
 				{
 					position514, tokenIndex514 := position, tokenIndex
-					if buffer[position] != rune('e') {
+					if buffer[position] != rune('t') {
 						goto l515
 					}
 					position++
 					goto l514
 				l515:
 					position, tokenIndex = position514, tokenIndex514
-					if buffer[position] != rune('E') {
-						goto l496
+					if buffer[position] != rune('T') {
+						goto l502
 					}
 					position++
 				}
 			l514:
-				if !_rules[rulesp]() {
-					goto l496
-				}
-				if !_rules[ruleStreamIdentifier]() {
-					goto l496
-				}
-				if !_rules[ruleAction19]() {
-					goto l496
+				{
+					position516, tokenIndex516 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l517
+					}
+					position++
+					goto l516
+				l517:
+					position, tokenIndex = position516, tokenIndex516
+					if buffer[position] != rune('A') {
+						goto l502
+					}
+					position++
 				}
-				add(ruleDropStateStmt, position497)
-			}
-			return true
-		l496:
-			position, tokenIndex = position496, tokenIndex496
-			return false
-		},
-		/* 26 LoadStateStmt <- <(('l' / 'L') ('o' / 'O') ('a' / 'A') ('d' / 'D') sp (('s' / 'S') ('t' / 'T') ('a' / 'A') ('t' / 'T') ('e' / 'E')) sp StreamIdentifier sp (('t' / 'T') ('y' / 'Y') ('p' / 'P') ('e' / 'E')) sp SourceSinkType StateTagOpt SetOptSpecs Action20)> */
-		func() bool {
-			position516, tokenIndex516 := position, tokenIndex
-			{
-				position517 := position
+			l516:
 				{
 					position518, tokenIndex518 := position, tokenIndex
-					if buffer[position] != rune('l') {
+					if buffer[position] != rune('t') {
 						goto l519
 					}
 					position++
 					goto l518
 				l519:
 					position, tokenIndex = position518, tokenIndex518
-					if buffer[position] != rune('L') {
-						goto l516
+					if buffer[position] != rune('T') {
+						goto l502
 					}
 					position++
 				}
 			l518:
 				{
 					position520, tokenIndex520 := position, tokenIndex
-					if buffer[position] != rune('o') {
+					if buffer[position] != rune('e') {
 						goto l521
 					}
 					position++
 					goto l520
 				l521:
 					position, tokenIndex = position520, tokenIndex520
-					if buffer[position] != rune('O') {
-						goto l516
+					if buffer[position] != rune('E') {
+						goto l502
 					}
 					position++
 				}
 			l520:
-				{
-					position522, tokenIndex522 := position, tokenIndex
-					if buffer[position] != rune('a') {
-						goto l523
-					}
-					position++
-					goto l522
-				l523:
-					position, tokenIndex = position522, tokenIndex522
-					if buffer[position] != rune('A') {
-						goto l516
-					}
-					position++
+				if !_rules[rulesp]() {
+					goto l502
+				}
+				if !_rules[ruleStreamIdentifier]() {
+					goto l502
 				}
-			l522:
+				if !_rules[ruleAction19]() {
+					goto l502
+				}
+				add(ruleDropStateStmt, position503)
+			}
+			return true
+		l502:
+			position, tokenIndex = position502, tokenIndex502
+			return false
+		},
+		/* 27 LoadStateStmt <- <(('l' / 'L') ('o' / 'O') ('a' / 'A') ('d' / 'D') sp (('s' / 'S') ('t' / 'T') ('a' / 'A') ('t' / 'T') ('e' / 'E')) sp StreamIdentifier sp (('t' / 'T') ('y' / 'Y') ('p' / 'P') ('e' / 'E')) sp SourceSinkType StateTagOpt SetOptSpecs Action20)> */
+		func() bool {
+			position522, tokenIndex522 := position, tokenIndex
+			{
+				position523 := position
 				{
 					position524, tokenIndex524 := position, tokenIndex
-					if buffer[position] != rune('d') {
+					if buffer[position] != rune('l') {
 						goto l525
 					}
 					position++
 					goto l524
 				l525:
 					position, tokenIndex = position524, tokenIndex524
-					if buffer[position] != rune('D') {
-						goto l516
+					if buffer[position] != rune('L') {
+						goto l522
 					}
 					position++
 				}
 			l524:
-				if !_rules[rulesp]() {
-					goto l516
-				}
 				{
 					position526, tokenIndex526 := position, tokenIndex
-					if buffer[position] != rune('s') {
+					if buffer[position] != rune('o') {
 						goto l527
 					}
 					position++
 					goto l526
 				l527:
 					position, tokenIndex = position526, tokenIndex526
-					if buffer[position] != rune('S') {
-						goto l516
+					if buffer[position] != rune('O') {
+						goto l522
 					}
 					position++
 				}
 			l526:
 				{
 					position528, tokenIndex528 := position, tokenIndex
-					if buffer[position] != rune('t') {
+					if buffer[position] != rune('a') {
 						goto l529
 					}
 					position++
 					goto l528
 				l529:
 					position, tokenIndex = position528, tokenIndex528
-					if buffer[position] != rune('T') {
-						goto l516
+					if buffer[position] != rune('A') {
+						goto l522
 					}
 					position++
 				}
 			l528:
 				{
 					position530, tokenIndex530 := position, tokenIndex
-					if buffer[position] != rune('a') {
+					if buffer[position] != rune('d') {
 						goto l531
 					}
 					position++
 					goto l530
 				l531:
 					position, tokenIndex = position530, tokenIndex530
-					if buffer[position] != rune('A') {
-						goto l516
+					if buffer[position] != rune('D') {
+						goto l522
 					}
 					position++
 				}
 			l530:
+				if !_rules[rulesp]() {
+					goto l522
+				}
 				{
 					position532, tokenIndex532 := position, tokenIndex
-					if buffer[position] != rune('t') {
+					if buffer[position] != rune('s') {
 						goto l533
 					}
 					position++
 					goto l532
 				l533:
 					position, tokenIndex = position532, tokenIndex532
-					if buffer[position] != rune('T') {
-						goto l516
+					if buffer[position] != rune('S') {
+						goto l522
 					}
 					position++
 				}
 			l532:
 				{
 					position534, tokenIndex534 := position, tokenIndex
-					if buffer[position] != rune('e') {
+					if buffer[position] != rune('t') {
 						goto l535
 					}
 					position++
 					goto l534
 				l535:
 					position, tokenIndex = position534, tokenIndex534
-					if buffer[position] != rune('E') {
-						goto l516
+					if buffer[position] != rune('T') {
+						goto l522
 					}
 					position++
 				}
 			l534:
-				if !_rules[rulesp]() {
-					goto l516
-				}
-				if !_rules[ruleStreamIdentifier]() {
-					goto l516
-				}
-				if !_rules[rulesp]() {
-					goto l516
-				}
 				{
 					position536, tokenIndex536 := position, tokenIndex
-					if buffer[position] != rune('t') {
+					if buffer[position] != rune('a') {
 						goto l537
 					}
 					position++
 					goto l536
 				l537:
 					position, tokenIndex = position536, tokenIndex536
-					if buffer[position] != rune('T') {
-						goto l516
+					if buffer[position] != rune('A') {
+						goto l522
 					}
 					position++
 				}
 			l536:
 				{
 					position538, tokenIndex538 := position, tokenIndex
-					if buffer[position] != rune('y') {
+					if buffer[position] != rune('t') {
 						goto l539
 					}
 					position++
 					goto l538
 				l539:
 					position, tokenIndex = position538, tokenIndex538
-					if buffer[position] != rune('Y') {
-						goto l516
+					if buffer[position] != rune('T') {
+						goto l522
 					}
 					position++
 				}
 			l538:
 				{
 					position540, tokenIndex540 := position, tokenIndex
-					if buffer[position] != rune('p') {
+					if buffer[position] != rune('e') {
 						goto l541
 					}
 					position++
 					goto l540
 				l541:
 					position, tokenIndex = position540, tokenIndex540
-					if buffer[position] != rune('P') {
-						goto l516
+					if buffer[position] != rune('E') {
+						goto l522
 					}
 					position++
 				}
 			l540:
+				if !_rules[rulesp]() {
+					goto l522
+				}
+				if !_rules[ruleStreamIdentifier]() {
+					goto l522
+				}
+				if !_rules[rulesp]() {
+					goto l522
+				}
 				{
 					position542, tokenIndex542 := position, tokenIndex
-					if buffer[position] != rune('e') {
+					if buffer[position] != rune('t') {
 						goto l543
 					}
 					position++
 					goto l542
 				l543:
 					position, tokenIndex = position542, tokenIndex542
-					if buffer[position] != rune('E') {
-						goto l516
+					if buffer[position] != rune('T') {
+						goto l522
 					}
 					position++
 				}
 			l542:
-				if !_rules[rulesp]() {
-					goto l516
-				}
-				if !_rules[ruleSourceSinkType]() {
-					goto l516
-				}
-				if !_rules[ruleStateTagOpt]() {
-					goto l516
-				}
-				if !_rules[ruleSetOptSpecs]() {
-					goto l516
-				}
-				if !_rules[ruleAction20]() {
-					goto l516
-				}
-				add(ruleLoadStateStmt, position517)
-			}
-			return true
-		l516:
-			position, tokenIndex = position516, tokenIndex516
-			return false
-		},
-		/* 27 LoadStateOrCreateStmt <- <(LoadStateStmt sp (('o' / 'O') ('r' / 'R')) sp (('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E')) sp (('i' / 'I') ('f' / 'F')) sp (('n' / 'N') ('o' / 'O') ('t' / 'T')) sp ((('s' / 'S') ('a' / 'A') ('v' / 'V') ('e' / 'E') ('d' / 'D')) / (('e' / 'E') ('x' / 'X') ('i' / 'I') ('s' / 'S') ('t' / 'T') ('s' / 'S'))) SourceSinkSpecs Action21)> */
-		func() bool {
-			position544, tokenIndex544 := position, tokenIndex
-			{
-				position545 := position
-				if !_rules[ruleLoadStateStmt]() {
-					goto l544
-				}
-				if !_rules[rulesp]() {
-					goto l544
+				{
+					position544, tokenIndex544 := position, tokenIndex
+					if buffer[position] != rune('y') {
+						goto l545
+					}
+					position++
+					goto l544
+				l545:
+					position, tokenIndex = position544, tokenIndex544
+					if buffer[position] != rune('Y') {
+						goto l522
+					}
+					position++
 				}
+			l544:
 				{
 					position546, tokenIndex546 := position, tokenIndex
-					if buffer[position] != rune('o') {
+					if buffer[position] != rune('p') {
 						goto l547
 					}
 					position++
 					goto l546
 				l547:
 					position, tokenIndex = position546, tokenIndex546
-					if buffer[position] != rune('O') {
-						goto l544
+					if buffer[position] != rune('P') {
+						goto l522
 					}
 					position++
 				}
 			l546:
 				{
 					position548, tokenIndex548 := position, tokenIndex
-					if buffer[position] != rune('r') {
+					if buffer[position] != rune('e') {
 						goto l549
 					}
 					position++
 					goto l548
 				l549:
 					position, tokenIndex = position548, tokenIndex548
-					if buffer[position] != rune('R') {
-						goto l544
+					if buffer[position] != rune('E') {
+						goto l522
 					}
 					position++
 				}
 			l548:
 				if !_rules[rulesp]() {
-					goto l544
+					goto l522
 				}
-				{
-					position550, tokenIndex550 := position, tokenIndex
-					if buffer[position] != rune('c') {
-						goto l551
-					}
-					position++
+				if !_rules[ruleSourceSinkType]() {
+					goto l522
+				}
+				if !_rules[ruleStateTagOpt]() {
+					goto l522
+				}
+				if !_rules[ruleSetOptSpecs]() {
+					goto l522
+				}
+				if !_rules[ruleAction20]() {
+					goto l522
+				}
+				add(ruleLoadStateStmt, position523)
+			}
+			return true
+		l522:
+			position, tokenIndex = position522, tokenIndex522
+			return false
+		},
+		/* 28 LoadStateOrCreateStmt <- <(LoadStateStmt sp (('o' / 'O') ('r' / 'R')) sp (('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E')) sp (('i' / 'I') ('f' / 'F')) sp (('n' / 'N') ('o' / 'O') ('t' / 'T')) sp ((('s' / 'S') ('a' / 'A') ('v' / 'V') ('e' / 'E') ('d' / 'D')) / (('e' / 'E') ('x' / 'X') ('i' / 'I') ('s' / 'S') ('t' / 'T') ('s' / 'S'))) SourceSinkSpecs Action21)> */
+		func() bool {
+			position550, tokenIndex550 := position, tokenIndex
+			{
+				position551 := position
+				if !_rules[ruleLoadStateStmt]() {
+					goto l550
+				}
+				if !_rules[rulesp]() {
 					goto l550
-				l551:
-					position, tokenIndex = position550, tokenIndex550
-					if buffer[position] != rune('C') {
-						goto l544
-					}
-					position++
 				}
-			l550:
 				{
 					position552, tokenIndex552 := position, tokenIndex
-					if buffer[position] != rune('r') {
+					if buffer[position] != rune('o') {
 						goto l553
 					}
 					position++
 					goto l552
 				l553:
 					position, tokenIndex = position552, tokenIndex552
-					if buffer[position] != rune('R') {
-						goto l544
+					if buffer[position] != rune('O') {
+						goto l550
 					}
 					position++
 				}
 			l552:
 				{
 					position554, tokenIndex554 := position, tokenIndex
-					if buffer[position] != rune('e') {
+					if buffer[position] != rune('r') {
 						goto l555
 					}
 					position++
 					goto l554
 				l555:
 					position, tokenIndex = position554, tokenIndex554
-					if buffer[position] != rune('E') {
-						goto l544
+					if buffer[position] != rune('R') {
+						goto l550
 					}
 					position++
 				}
 			l554:
+				if !_rules[rulesp]() {
+					goto l550
+				}
 				{
 					position556, tokenIndex556 := position, tokenIndex
-					if buffer[position] != rune('a') {
+					if buffer[position] != rune('c') {
 						goto l557
 					}
 					position++
 					goto l556
 				l557:
 					position, tokenIndex = position556, tokenIndex556
-					if buffer[position] != rune('A') {
-						goto l544
+					if buffer[position] != rune('C') {
+						goto l550
 					}
 					position++
 				}
 			l556:
 				{
 					position558, tokenIndex558 := position, tokenIndex
-					if buffer[position] != rune('t') {
+					if buffer[position] != rune('r') {
 						goto l559
 					}
 					position++
 					goto l558
 				l559:
 					position, tokenIndex = position558, tokenIndex558
-					if buffer[position] != rune('T') {
-						goto l544
+					if buffer[position] != rune('R') {
+						goto l550
 					}
 					position++
 				}
@@ -5962,10940 +6097,12739 @@ func (p *bqlPegBackend) Init() {
 				l561:
 					position, tokenIndex = position560, tokenIndex560
 					if buffer[position] != rune('E') {
-						goto l544
+						goto l550
 					}
 					position++
 				}
 			l560:
-				if !_rules[rulesp]() {
-					goto l544
-				}
 				{
 					position562, tokenIndex562 := position, tokenIndex
-					if buffer[position] != rune('i') {
+					if buffer[position] != rune('a') {
 						goto l563
 					}
 					position++
 					goto l562
 				l563:
 					position, tokenIndex = position562, tokenIndex562
-					if buffer[position] != rune('I') {
-						goto l544
+					if buffer[position] != rune('A') {
+						goto l550
 					}
 					position++
 				}
 			l562:
 				{
 					position564, tokenIndex564 := position, tokenIndex
-					if buffer[position] != rune('f') {
+					if buffer[position] != rune('t') {
 						goto l565
 					}
 					position++
 					goto l564
 				l565:
 					position, tokenIndex = position564, tokenIndex564
-					if buffer[position] != rune('F') {
-						goto l544
+					if buffer[position] != rune('T') {
+						goto l550
 					}
 					position++
 				}
 			l564:
-				if !_rules[rulesp]() {
-					goto l544
-				}
 				{
 					position566, tokenIndex566 := position, tokenIndex
-					if buffer[position] != rune('n') {
+					if buffer[position] != rune('e') {
 						goto l567
 					}
 					position++
 					goto l566
 				l567:
 					position, tokenIndex = position566, tokenIndex566
-					if buffer[position] != rune('N') {
-						goto l544
+					if buffer[position] != rune('E') {
+						goto l550
 					}
 					position++
 				}
 			l566:
+				if !_rules[rulesp]() {
+					goto l550
+				}
 				{
 					position568, tokenIndex568 := position, tokenIndex
-					if buffer[position] != rune('o') {
+					if buffer[position] != rune('i') {
 						goto l569
 					}
 					position++
 					goto l568
 				l569:
 					position, tokenIndex = position568, tokenIndex568
-					if buffer[position] != rune('O') {
-						goto l544
+					if buffer[position] != rune('I') {
+						goto l550
 					}
 					position++
 				}
 			l568:
 				{
 					position570, tokenIndex570 := position, tokenIndex
-					if buffer[position] != rune('t') {
+					if buffer[position] != rune('f') {
 						goto l571
 					}
 					position++
 					goto l570
 				l571:
 					position, tokenIndex = position570, tokenIndex570
-					if buffer[position] != rune('T') {
-						goto l544
+					if buffer[position] != rune('F') {
+						goto l550
 					}
 					position++
 				}
 			l570:
 				if !_rules[rulesp]() {
-					goto l544
+					goto l550
 				}
 				{
 					position572, tokenIndex572 := position, tokenIndex
-					{
-						position574, tokenIndex574 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l575
-						}
-						position++
-						goto l574
-					l575:
-						position, tokenIndex = position574, tokenIndex574
-						if buffer[position] != rune('S') {
-							goto l573
-						}
-						position++
+					if buffer[position] != rune('n') {
+						goto l573
 					}
-				l574:
-					{
-						position576, tokenIndex576 := position, tokenIndex
-						if buffer[position] != rune('a') {
-							goto l577
-						}
-						position++
-						goto l576
-					l577:
-						position, tokenIndex = position576, tokenIndex576
-						if buffer[position] != rune('A') {
-							goto l573
-						}
-						position++
+					position++
+					goto l572
+				l573:
+					position, tokenIndex = position572, tokenIndex572
+					if buffer[position] != rune('N') {
+						goto l550
 					}
-				l576:
-					{
-						position578, tokenIndex578 := position, tokenIndex
-						if buffer[position] != rune('v') {
-							goto l579
-						}
-						position++
-						goto l578
-					l579:
-						position, tokenIndex = position578, tokenIndex578
-						if buffer[position] != rune('V') {
-							goto l573
-						}
-						position++
+					position++
+				}
+			l572:
+				{
+					position574, tokenIndex574 := position, tokenIndex
+					if buffer[position] != rune('o') {
+						goto l575
+					}
+					position++
+					goto l574
+				l575:
+					position, tokenIndex = position574, tokenIndex574
+					if buffer[position] != rune('O') {
+						goto l550
+					}
+					position++
+				}
+			l574:
+				{
+					position576, tokenIndex576 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l577
+					}
+					position++
+					goto l576
+				l577:
+					position, tokenIndex = position576, tokenIndex576
+					if buffer[position] != rune('T') {
+						goto l550
 					}
-				l578:
+					position++
+				}
+			l576:
+				if !_rules[rulesp]() {
+					goto l550
+				}
+				{
+					position578, tokenIndex578 := position, tokenIndex
 					{
 						position580, tokenIndex580 := position, tokenIndex
-						if buffer[position] != rune('e') {
+						if buffer[position] != rune('s') {
 							goto l581
 						}
 						position++
 						goto l580
 					l581:
 						position, tokenIndex = position580, tokenIndex580
-						if buffer[position] != rune('E') {
-							goto l573
+						if buffer[position] != rune('S') {
+							goto l579
 						}
 						position++
 					}
 				l580:
 					{
 						position582, tokenIndex582 := position, tokenIndex
-						if buffer[position] != rune('d') {
+						if buffer[position] != rune('a') {
 							goto l583
 						}
 						position++
 						goto l582
 					l583:
 						position, tokenIndex = position582, tokenIndex582
-						if buffer[position] != rune('D') {
-							goto l573
+						if buffer[position] != rune('A') {
+							goto l579
 						}
 						position++
 					}
 				l582:
-					goto l572
-				l573:
-					position, tokenIndex = position572, tokenIndex572
 					{
 						position584, tokenIndex584 := position, tokenIndex
-						if buffer[position] != rune('e') {
+						if buffer[position] != rune('v') {
 							goto l585
 						}
 						position++
 						goto l584
 					l585:
 						position, tokenIndex = position584, tokenIndex584
-						if buffer[position] != rune('E') {
-							goto l544
+						if buffer[position] != rune('V') {
+							goto l579
 						}
 						position++
 					}
 				l584:
 					{
 						position586, tokenIndex586 := position, tokenIndex
-						if buffer[position] != rune('x') {
+						if buffer[position] != rune('e') {
 							goto l587
 						}
 						position++
 						goto l586
 					l587:
 						position, tokenIndex = position586, tokenIndex586
-						if buffer[position] != rune('X') {
-							goto l544
+						if buffer[position] != rune('E') {
+							goto l579
 						}
 						position++
 					}
 				l586:
 					{
 						position588, tokenIndex588 := position, tokenIndex
-						if buffer[position] != rune('i') {
+						if buffer[position] != rune('d') {
 							goto l589
 						}
 						position++
 						goto l588
 					l589:
 						position, tokenIndex = position588, tokenIndex588
-						if buffer[position] != rune('I') {
-							goto l544
+						if buffer[position] != rune('D') {
+							goto l579
 						}
 						position++
 					}
 				l588:
+					goto l578
+				l579:
+					position, tokenIndex = position578, tokenIndex578
 					{
 						position590, tokenIndex590 := position, tokenIndex
-						if buffer[position] != rune('s') {
+						if buffer[position] != rune('e') {
 							goto l591
 						}
 						position++
 						goto l590
 					l591:
 						position, tokenIndex = position590, tokenIndex590
-						if buffer[position] != rune('S') {
-							goto l544
+						if buffer[position] != rune('E') {
+							goto l550
 						}
 						position++
 					}
 				l590:
 					{
 						position592, tokenIndex592 := position, tokenIndex
-						if buffer[position] != rune('t') {
+						if buffer[position] != rune('x') {
 							goto l593
 						}
 						position++
 						goto l592
 					l593:
 						position, tokenIndex = position592, tokenIndex592
-						if buffer[position] != rune('T') {
-							goto l544
+						if buffer[position] != rune('X') {
+							goto l550
 						}
 						position++
 					}
 				l592:
 					{
 						position594, tokenIndex594 := position, tokenIndex
-						if buffer[position] != rune('s') {
+						if buffer[position] != rune('i') {
 							goto l595
 						}
 						position++
 						goto l594
 					l595:
 						position, tokenIndex = position594, tokenIndex594
-						if buffer[position] != rune('S') {
-							goto l544
+						if buffer[position] != rune('I') {
+							goto l550
 						}
 						position++
 					}
 				l594:
+					{
+						position596, tokenIndex596 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l597
+						}
+						position++
+						goto l596
+					l597:
+						position, tokenIndex = position596, tokenIndex596
+						if buffer[position] != rune('S') {
+							goto l550
+						}
+						position++
+					}
+				l596:
+					{
+						position598, tokenIndex598 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l599
+						}
+						position++
+						goto l598
+					l599:
+						position, tokenIndex = position598, tokenIndex598
+						if buffer[position] != rune('T') {
+							goto l550
+						}
+						position++
+					}
+				l598:
+					{
+						position600, tokenIndex600 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l601
+						}
+						position++
+						goto l600
+					l601:
+						position, tokenIndex = position600, tokenIndex600
+						if buffer[position] != rune('S') {
+							goto l550
+						}
+						position++
+					}
+				l600:
 				}
-			l572:
+			l578:
 				if !_rules[ruleSourceSinkSpecs]() {
-					goto l544
+					goto l550
 				}
 				if !_rules[ruleAction21]() {
-					goto l544
+					goto l550
 				}
-				add(ruleLoadStateOrCreateStmt, position545)
+				add(ruleLoadStateOrCreateStmt, position551)
 			}
 			return true
-		l544:
-			position, tokenIndex = position544, tokenIndex544
+		l550:
+			position, tokenIndex = position550, tokenIndex550
 			return false
 		},
-		/* 28 SaveStateStmt <- <(('s' / 'S') ('a' / 'A') ('v' / 'V') ('e' / 'E') sp (('s' / 'S') ('t' / 'T') ('a' / 'A') ('t' / 'T') ('e' / 'E')) sp StreamIdentifier StateTagOpt Action22)> */
+		/* 29 SaveStateStmt <- <(('s' / 'S') ('a' / 'A') ('v' / 'V') ('e' / 'E') sp (('s' / 'S') ('t' / 'T') ('a' / 'A') ('t' / 'T') ('e' / 'E')) sp StreamIdentifier StateTagOpt Action22)> */
 		func() bool {
-			position596, tokenIndex596 := position, tokenIndex
+			position602, tokenIndex602 := position, tokenIndex
 			{
-				position597 := position
+				position603 := position
 				{
-					position598, tokenIndex598 := position, tokenIndex
+					position604, tokenIndex604 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l599
+						goto l605
 					}
 					position++
-					goto l598
-				l599:
-					position, tokenIndex = position598, tokenIndex598
+					goto l604
+				l605:
+					position, tokenIndex = position604, tokenIndex604
 					if buffer[position] != rune('S') {
-						goto l596
+						goto l602
 					}
 					position++
 				}
-			l598:
+			l604:
 				{
-					position600, tokenIndex600 := position, tokenIndex
+					position606, tokenIndex606 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l601
+						goto l607
 					}
 					position++
-					goto l600
-				l601:
-					position, tokenIndex = position600, tokenIndex600
+					goto l606
+				l607:
+					position, tokenIndex = position606, tokenIndex606
 					if buffer[position] != rune('A') {
-						goto l596
+						goto l602
 					}
 					position++
 				}
-			l600:
+			l606:
 				{
-					position602, tokenIndex602 := position, tokenIndex
+					position608, tokenIndex608 := position, tokenIndex
 					if buffer[position] != rune('v') {
-						goto l603
+						goto l609
 					}
 					position++
-					goto l602
-				l603:
-					position, tokenIndex = position602, tokenIndex602
+					goto l608
+				l609:
+					position, tokenIndex = position608, tokenIndex608
 					if buffer[position] != rune('V') {
-						goto l596
+						goto l602
 					}
 					position++
 				}
-			l602:
+			l608:
 				{
-					position604, tokenIndex604 := position, tokenIndex
+					position610, tokenIndex610 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l605
+						goto l611
 					}
 					position++
-					goto l604
-				l605:
-					position, tokenIndex = position604, tokenIndex604
+					goto l610
+				l611:
+					position, tokenIndex = position610, tokenIndex610
 					if buffer[position] != rune('E') {
-						goto l596
+						goto l602
 					}
 					position++
 				}
-			l604:
+			l610:
 				if !_rules[rulesp]() {
-					goto l596
+					goto l602
 				}
 				{
-					position606, tokenIndex606 := position, tokenIndex
+					position612, tokenIndex612 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l607
+						goto l613
 					}
 					position++
-					goto l606
-				l607:
-					position, tokenIndex = position606, tokenIndex606
+					goto l612
+				l613:
+					position, tokenIndex = position612, tokenIndex612
 					if buffer[position] != rune('S') {
-						goto l596
+						goto l602
 					}
 					position++
 				}
-			l606:
+			l612:
 				{
-					position608, tokenIndex608 := position, tokenIndex
+					position614, tokenIndex614 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l609
+						goto l615
 					}
 					position++
-					goto l608
-				l609:
-					position, tokenIndex = position608, tokenIndex608
+					goto l614
+				l615:
+					position, tokenIndex = position614, tokenIndex614
 					if buffer[position] != rune('T') {
-						goto l596
+						goto l602
 					}
 					position++
 				}
-			l608:
+			l614:
 				{
-					position610, tokenIndex610 := position, tokenIndex
+					position616, tokenIndex616 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l611
+						goto l617
 					}
 					position++
-					goto l610
-				l611:
-					position, tokenIndex = position610, tokenIndex610
+					goto l616
+				l617:
+					position, tokenIndex = position616, tokenIndex616
 					if buffer[position] != rune('A') {
-						goto l596
+						goto l602
 					}
 					position++
 				}
-			l610:
+			l616:
 				{
-					position612, tokenIndex612 := position, tokenIndex
+					position618, tokenIndex618 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l613
+						goto l619
 					}
 					position++
-					goto l612
-				l613:
-					position, tokenIndex = position612, tokenIndex612
+					goto l618
+				l619:
+					position, tokenIndex = position618, tokenIndex618
 					if buffer[position] != rune('T') {
-						goto l596
+						goto l602
 					}
 					position++
 				}
-			l612:
+			l618:
 				{
-					position614, tokenIndex614 := position, tokenIndex
+					position620, tokenIndex620 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l615
+						goto l621
 					}
 					position++
-					goto l614
-				l615:
-					position, tokenIndex = position614, tokenIndex614
+					goto l620
+				l621:
+					position, tokenIndex = position620, tokenIndex620
 					if buffer[position] != rune('E') {
-						goto l596
+						goto l602
 					}
 					position++
 				}
-			l614:
+			l620:
 				if !_rules[rulesp]() {
-					goto l596
+					goto l602
 				}
 				if !_rules[ruleStreamIdentifier]() {
-					goto l596
+					goto l602
 				}
 				if !_rules[ruleStateTagOpt]() {
-					goto l596
+					goto l602
 				}
 				if !_rules[ruleAction22]() {
-					goto l596
+					goto l602
 				}
-				add(ruleSaveStateStmt, position597)
+				add(ruleSaveStateStmt, position603)
 			}
 			return true
-		l596:
-			position, tokenIndex = position596, tokenIndex596
+		l602:
+			position, tokenIndex = position602, tokenIndex602
 			return false
 		},
-		/* 29 EvalStmt <- <(('e' / 'E') ('v' / 'V') ('a' / 'A') ('l' / 'L') sp Expression <(sp (('o' / 'O') ('n' / 'N')) sp MapExpr)?> Action23)> */
+		/* 30 EvalStmt <- <(('e' / 'E') ('v' / 'V') ('a' / 'A') ('l' / 'L') sp Expression <(sp (('o' / 'O') ('n' / 'N')) sp MapExpr)?> Action23)> */
 		func() bool {
-			position616, tokenIndex616 := position, tokenIndex
+			position622, tokenIndex622 := position, tokenIndex
 			{
-				position617 := position
+				position623 := position
 				{
-					position618, tokenIndex618 := position, tokenIndex
+					position624, tokenIndex624 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l619
+						goto l625
 					}
 					position++
-					goto l618
-				l619:
-					position, tokenIndex = position618, tokenIndex618
+					goto l624
+				l625:
+					position, tokenIndex = position624, tokenIndex624
 					if buffer[position] != rune('E') {
-						goto l616
+						goto l622
 					}
 					position++
 				}
-			l618:
+			l624:
 				{
-					position620, tokenIndex620 := position, tokenIndex
+					position626, tokenIndex626 := position, tokenIndex
 					if buffer[position] != rune('v') {
-						goto l621
+						goto l627
 					}
 					position++
-					goto l620
-				l621:
-					position, tokenIndex = position620, tokenIndex620
+					goto l626
+				l627:
+					position, tokenIndex = position626, tokenIndex626
 					if buffer[position] != rune('V') {
-						goto l616
+						goto l622
 					}
 					position++
 				}
-			l620:
+			l626:
 				{
-					position622, tokenIndex622 := position, tokenIndex
+					position628, tokenIndex628 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l623
+						goto l629
 					}
 					position++
-					goto l622
-				l623:
-					position, tokenIndex = position622, tokenIndex622
+					goto l628
+				l629:
+					position, tokenIndex = position628, tokenIndex628
 					if buffer[position] != rune('A') {
-						goto l616
+						goto l622
 					}
 					position++
 				}
-			l622:
+			l628:
 				{
-					position624, tokenIndex624 := position, tokenIndex
+					position630, tokenIndex630 := position, tokenIndex
 					if buffer[position] != rune('l') {
-						goto l625
+						goto l631
 					}
 					position++
-					goto l624
-				l625:
-					position, tokenIndex = position624, tokenIndex624
+					goto l630
+				l631:
+					position, tokenIndex = position630, tokenIndex630
 					if buffer[position] != rune('L') {
-						goto l616
+						goto l622
 					}
 					position++
 				}
-			l624:
+			l630:
 				if !_rules[rulesp]() {
-					goto l616
+					goto l622
 				}
 				if !_rules[ruleExpression]() {
-					goto l616
+					goto l622
 				}
 				{
-					position626 := position
+					position632 := position
 					{
-						position627, tokenIndex627 := position, tokenIndex
+						position633, tokenIndex633 := position, tokenIndex
 						if !_rules[rulesp]() {
-							goto l627
+							goto l633
 						}
 						{
-							position629, tokenIndex629 := position, tokenIndex
+							position635, tokenIndex635 := position, tokenIndex
 							if buffer[position] != rune('o') {
-								goto l630
+								goto l636
 							}
 							position++
-							goto l629
-						l630:
-							position, tokenIndex = position629, tokenIndex629
+							goto l635
+						l636:
+							position, tokenIndex = position635, tokenIndex635
 							if buffer[position] != rune('O') {
-								goto l627
+								goto l633
 							}
 							position++
 						}
-					l629:
+					l635:
 						{
-							position631, tokenIndex631 := position, tokenIndex
+							position637, tokenIndex637 := position, tokenIndex
 							if buffer[position] != rune('n') {
-								goto l632
+								goto l638
 							}
 							position++
-							goto l631
-						l632:
-							position, tokenIndex = position631, tokenIndex631
+							goto l637
+						l638:
+							position, tokenIndex = position637, tokenIndex637
 							if buffer[position] != rune('N') {
-								goto l627
+								goto l633
 							}
 							position++
 						}
-					l631:
+					l637:
 						if !_rules[rulesp]() {
-							goto l627
+							goto l633
 						}
 						if !_rules[ruleMapExpr]() {
-							goto l627
+							goto l633
 						}
-						goto l628
-					l627:
-						position, tokenIndex = position627, tokenIndex627
+						goto l634
+					l633:
+						position, tokenIndex = position633, tokenIndex633
 					}
-				l628:
-					add(rulePegText, position626)
+				l634:
+					add(rulePegText, position632)
 				}
 				if !_rules[ruleAction23]() {
-					goto l616
+					goto l622
+				}
+				add(ruleEvalStmt, position623)
+			}
+			return true
+		l622:
+			position, tokenIndex = position622, tokenIndex622
+			return false
+		},
+		/* 31 ExplainStmt <- <(<(('e' / 'E') ('x' / 'X') ('p' / 'P') ('l' / 'L') ('a' / 'A') ('i' / 'I') ('n' / 'N') sp ExplainableStmt)> Action24)> */
+		func() bool {
+			position639, tokenIndex639 := position, tokenIndex
+			{
+				position640 := position
+				{
+					position641 := position
+					{
+						position642, tokenIndex642 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l643
+						}
+						position++
+						goto l642
+					l643:
+						position, tokenIndex = position642, tokenIndex642
+						if buffer[position] != rune('E') {
+							goto l639
+						}
+						position++
+					}
+				l642:
+					{
+						position644, tokenIndex644 := position, tokenIndex
+						if buffer[position] != rune('x') {
+							goto l645
+						}
+						position++
+						goto l644
+					l645:
+						position, tokenIndex = position644, tokenIndex644
+						if buffer[position] != rune('X') {
+							goto l639
+						}
+						position++
+					}
+				l644:
+					{
+						position646, tokenIndex646 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l647
+						}
+						position++
+						goto l646
+					l647:
+						position, tokenIndex = position646, tokenIndex646
+						if buffer[position] != rune('P') {
+							goto l639
+						}
+						position++
+					}
+				l646:
+					{
+						position648, tokenIndex648 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l649
+						}
+						position++
+						goto l648
+					l649:
+						position, tokenIndex = position648, tokenIndex648
+						if buffer[position] != rune('L') {
+							goto l639
+						}
+						position++
+					}
+				l648:
+					{
+						position650, tokenIndex650 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l651
+						}
+						position++
+						goto l650
+					l651:
+						position, tokenIndex = position650, tokenIndex650
+						if buffer[position] != rune('A') {
+							goto l639
+						}
+						position++
+					}
+				l650:
+					{
+						position652, tokenIndex652 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l653
+						}
+						position++
+						goto l652
+					l653:
+						position, tokenIndex = position652, tokenIndex652
+						if buffer[position] != rune('I') {
+							goto l639
+						}
+						position++
+					}
+				l652:
+					{
+						position654, tokenIndex654 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l655
+						}
+						position++
+						goto l654
+					l655:
+						position, tokenIndex = position654, tokenIndex654
+						if buffer[position] != rune('N') {
+							goto l639
+						}
+						position++
+					}
+				l654:
+					if !_rules[rulesp]() {
+						goto l639
+					}
+					if !_rules[ruleExplainableStmt]() {
+						goto l639
+					}
+					add(rulePegText, position641)
+				}
+				if !_rules[ruleAction24]() {
+					goto l639
 				}
-				add(ruleEvalStmt, position617)
+				add(ruleExplainStmt, position640)
 			}
 			return true
-		l616:
-			position, tokenIndex = position616, tokenIndex616
+		l639:
+			position, tokenIndex = position639, tokenIndex639
 			return false
 		},
-		/* 30 Emitter <- <(sp (ISTREAM / DSTREAM / RSTREAM) EmitterOptions Action24)> */
+		/* 32 Emitter <- <(sp (ISTREAM / DSTREAM / RSTREAM) EmitterOptions Action25)> */
 		func() bool {
-			position633, tokenIndex633 := position, tokenIndex
+			position656, tokenIndex656 := position, tokenIndex
 			{
-				position634 := position
+				position657 := position
 				if !_rules[rulesp]() {
-					goto l633
+					goto l656
 				}
 				{
-					position635, tokenIndex635 := position, tokenIndex
+					position658, tokenIndex658 := position, tokenIndex
 					if !_rules[ruleISTREAM]() {
-						goto l636
+						goto l659
 					}
-					goto l635
-				l636:
-					position, tokenIndex = position635, tokenIndex635
+					goto l658
+				l659:
+					position, tokenIndex = position658, tokenIndex658
 					if !_rules[ruleDSTREAM]() {
-						goto l637
+						goto l660
 					}
-					goto l635
-				l637:
-					position, tokenIndex = position635, tokenIndex635
+					goto l658
+				l660:
+					position, tokenIndex = position658, tokenIndex658
 					if !_rules[ruleRSTREAM]() {
-						goto l633
+						goto l656
 					}
 				}
-			l635:
+			l658:
 				if !_rules[ruleEmitterOptions]() {
-					goto l633
+					goto l656
 				}
-				if !_rules[ruleAction24]() {
-					goto l633
+				if !_rules[ruleAction25]() {
+					goto l656
 				}
-				add(ruleEmitter, position634)
+				add(ruleEmitter, position657)
 			}
 			return true
-		l633:
-			position, tokenIndex = position633, tokenIndex633
+		l656:
+			position, tokenIndex = position656, tokenIndex656
 			return false
 		},
-		/* 31 EmitterOptions <- <(<(spOpt '[' spOpt EmitterOptionCombinations spOpt ']')?> Action25)> */
+		/* 33 EmitterOptions <- <(<(spOpt '[' spOpt EmitterOptionCombinations spOpt ']')?> Action26)> */
 		func() bool {
-			position638, tokenIndex638 := position, tokenIndex
+			position661, tokenIndex661 := position, tokenIndex
 			{
-				position639 := position
+				position662 := position
 				{
-					position640 := position
+					position663 := position
 					{
-						position641, tokenIndex641 := position, tokenIndex
+						position664, tokenIndex664 := position, tokenIndex
 						if !_rules[rulespOpt]() {
-							goto l641
+							goto l664
 						}
 						if buffer[position] != rune('[') {
-							goto l641
+							goto l664
 						}
 						position++
 						if !_rules[rulespOpt]() {
-							goto l641
+							goto l664
 						}
 						if !_rules[ruleEmitterOptionCombinations]() {
-							goto l641
+							goto l664
 						}
 						if !_rules[rulespOpt]() {
-							goto l641
+							goto l664
 						}
 						if buffer[position] != rune(']') {
-							goto l641
+							goto l664
 						}
 						position++
-						goto l642
-					l641:
-						position, tokenIndex = position641, tokenIndex641
+						goto l665
+					l664:
+						position, tokenIndex = position664, tokenIndex664
 					}
-				l642:
-					add(rulePegText, position640)
+				l665:
+					add(rulePegText, position663)
 				}
-				if !_rules[ruleAction25]() {
-					goto l638
+				if !_rules[ruleAction26]() {
+					goto l661
 				}
-				add(ruleEmitterOptions, position639)
+				add(ruleEmitterOptions, position662)
 			}
 			return true
-		l638:
-			position, tokenIndex = position638, tokenIndex638
+		l661:
+			position, tokenIndex = position661, tokenIndex661
 			return false
 		},
-		/* 32 EmitterOptionCombinations <- <(EmitterLimit / (EmitterSample sp EmitterLimit) / EmitterSample)> */
+		/* 34 EmitterOptionCombinations <- <(EmitterLimit / (EmitterSample sp EmitterLimit) / EmitterSample)> */
 		func() bool {
-			position643, tokenIndex643 := position, tokenIndex
+			position666, tokenIndex666 := position, tokenIndex
 			{
-				position644 := position
+				position667 := position
 				{
-					position645, tokenIndex645 := position, tokenIndex
+					position668, tokenIndex668 := position, tokenIndex
 					if !_rules[ruleEmitterLimit]() {
-						goto l646
+						goto l669
 					}
-					goto l645
-				l646:
-					position, tokenIndex = position645, tokenIndex645
+					goto l668
+				l669:
+					position, tokenIndex = position668, tokenIndex668
 					if !_rules[ruleEmitterSample]() {
-						goto l647
+						goto l670
 					}
 					if !_rules[rulesp]() {
-						goto l647
+						goto l670
 					}
 					if !_rules[ruleEmitterLimit]() {
-						goto l647
+						goto l670
 					}
-					goto l645
-				l647:
-					position, tokenIndex = position645, tokenIndex645
+					goto l668
+				l670:
+					position, tokenIndex = position668, tokenIndex668
 					if !_rules[ruleEmitterSample]() {
-						goto l643
+						goto l666
 					}
 				}
-			l645:
-				add(ruleEmitterOptionCombinations, position644)
+			l668:
+				add(ruleEmitterOptionCombinations, position667)
 			}
 			return true
-		l643:
-			position, tokenIndex = position643, tokenIndex643
+		l666:
+			position, tokenIndex = position666, tokenIndex666
 			return false
 		},
-		/* 33 EmitterLimit <- <(('l' / 'L') ('i' / 'I') ('m' / 'M') ('i' / 'I') ('t' / 'T') sp NumericLiteral Action26)> */
+		/* 35 EmitterLimit <- <(('l' / 'L') ('i' / 'I') ('m' / 'M') ('i' / 'I') ('t' / 'T') sp NumericLiteral Action27)> */
 		func() bool {
-			position648, tokenIndex648 := position, tokenIndex
+			position671, tokenIndex671 := position, tokenIndex
 			{
-				position649 := position
+				position672 := position
 				{
-					position650, tokenIndex650 := position, tokenIndex
+					position673, tokenIndex673 := position, tokenIndex
 					if buffer[position] != rune('l') {
-						goto l651
+						goto l674
 					}
 					position++
-					goto l650
-				l651:
-					position, tokenIndex = position650, tokenIndex650
+					goto l673
+				l674:
+					position, tokenIndex = position673, tokenIndex673
 					if buffer[position] != rune('L') {
-						goto l648
+						goto l671
 					}
 					position++
 				}
-			l650:
+			l673:
 				{
-					position652, tokenIndex652 := position, tokenIndex
+					position675, tokenIndex675 := position, tokenIndex
 					if buffer[position] != rune('i') {
-						goto l653
+						goto l676
 					}
 					position++
-					goto l652
-				l653:
-					position, tokenIndex = position652, tokenIndex652
+					goto l675
+				l676:
+					position, tokenIndex = position675, tokenIndex675
 					if buffer[position] != rune('I') {
-						goto l648
+						goto l671
 					}
 					position++
 				}
-			l652:
+			l675:
 				{
-					position654, tokenIndex654 := position, tokenIndex
+					position677, tokenIndex677 := position, tokenIndex
 					if buffer[position] != rune('m') {
-						goto l655
+						goto l678
 					}
 					position++
-					goto l654
-				l655:
-					position, tokenIndex = position654, tokenIndex654
+					goto l677
+				l678:
+					position, tokenIndex = position677, tokenIndex677
 					if buffer[position] != rune('M') {
-						goto l648
+						goto l671
 					}
 					position++
 				}
-			l654:
+			l677:
 				{
-					position656, tokenIndex656 := position, tokenIndex
+					position679, tokenIndex679 := position, tokenIndex
 					if buffer[position] != rune('i') {
-						goto l657
+						goto l680
 					}
 					position++
-					goto l656
-				l657:
-					position, tokenIndex = position656, tokenIndex656
+					goto l679
+				l680:
+					position, tokenIndex = position679, tokenIndex679
 					if buffer[position] != rune('I') {
-						goto l648
+						goto l671
 					}
 					position++
 				}
-			l656:
+			l679:
 				{
-					position658, tokenIndex658 := position, tokenIndex
+					position681, tokenIndex681 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l659
+						goto l682
 					}
 					position++
-					goto l658
-				l659:
-					position, tokenIndex = position658, tokenIndex658
+					goto l681
+				l682:
+					position, tokenIndex = position681, tokenIndex681
 					if buffer[position] != rune('T') {
-						goto l648
+						goto l671
 					}
 					position++
 				}
-			l658:
+			l681:
 				if !_rules[rulesp]() {
-					goto l648
+					goto l671
 				}
 				if !_rules[ruleNumericLiteral]() {
-					goto l648
+					goto l671
 				}
-				if !_rules[ruleAction26]() {
-					goto l648
+				if !_rules[ruleAction27]() {
+					goto l671
 				}
-				add(ruleEmitterLimit, position649)
+				add(ruleEmitterLimit, position672)
 			}
 			return true
-		l648:
-			position, tokenIndex = position648, tokenIndex648
+		l671:
+			position, tokenIndex = position671, tokenIndex671
 			return false
 		},
-		/* 34 EmitterSample <- <(CountBasedSampling / RandomizedSampling / TimeBasedSampling)> */
+		/* 36 EmitterSample <- <(CountBasedSampling / RandomizedSampling / TimeBasedSampling)> */
 		func() bool {
-			position660, tokenIndex660 := position, tokenIndex
+			position683, tokenIndex683 := position, tokenIndex
 			{
-				position661 := position
+				position684 := position
 				{
-					position662, tokenIndex662 := position, tokenIndex
+					position685, tokenIndex685 := position, tokenIndex
 					if !_rules[ruleCountBasedSampling]() {
-						goto l663
+						goto l686
 					}
-					goto l662
-				l663:
-					position, tokenIndex = position662, tokenIndex662
+					goto l685
+				l686:
+					position, tokenIndex = position685, tokenIndex685
 					if !_rules[ruleRandomizedSampling]() {
-						goto l664
+						goto l687
 					}
-					goto l662
-				l664:
-					position, tokenIndex = position662, tokenIndex662
+					goto l685
+				l687:
+					position, tokenIndex = position685, tokenIndex685
 					if !_rules[ruleTimeBasedSampling]() {
-						goto l660
+						goto l683
 					}
 				}
-			l662:
-				add(ruleEmitterSample, position661)
+			l685:
+				add(ruleEmitterSample, position684)
 			}
 			return true
-		l660:
-			position, tokenIndex = position660, tokenIndex660
+		l683:
+			position, tokenIndex = position683, tokenIndex683
 			return false
 		},
-		/* 35 CountBasedSampling <- <(('e' / 'E') ('v' / 'V') ('e' / 'E') ('r' / 'R') ('y' / 'Y') sp NumericLiteral spOpt '-'? spOpt ((('s' / 'S') ('t' / 'T')) / (('n' / 'N') ('d' / 'D')) / (('r' / 'R') ('d' / 'D')) / (('t' / 'T') ('h' / 'H'))) sp (('t' / 'T') ('u' / 'U') ('p' / 'P') ('l' / 'L') ('e' / 'E')) Action27)> */
+		/* 37 CountBasedSampling <- <(('e' / 'E') ('v' / 'V') ('e' / 'E') ('r' / 'R') ('y' / 'Y') sp NumericLiteral spOpt '-'? spOpt ((('s' / 'S') ('t' / 'T')) / (('n' / 'N') ('d' / 'D')) / (('r' / 'R') ('d' / 'D')) / (('t' / 'T') ('h' / 'H'))) sp (('t' / 'T') ('u' / 'U') ('p' / 'P') ('l' / 'L') ('e' / 'E')) Action28)> */
 		func() bool {
-			position665, tokenIndex665 := position, tokenIndex
+			position688, tokenIndex688 := position, tokenIndex
 			{
-				position666 := position
+				position689 := position
 				{
-					position667, tokenIndex667 := position, tokenIndex
+					position690, tokenIndex690 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l668
+						goto l691
 					}
 					position++
-					goto l667
-				l668:
-					position, tokenIndex = position667, tokenIndex667
+					goto l690
+				l691:
+					position, tokenIndex = position690, tokenIndex690
 					if buffer[position] != rune('E') {
-						goto l665
+						goto l688
 					}
 					position++
 				}
-			l667:
+			l690:
 				{
-					position669, tokenIndex669 := position, tokenIndex
+					position692, tokenIndex692 := position, tokenIndex
 					if buffer[position] != rune('v') {
-						goto l670
+						goto l693
 					}
 					position++
-					goto l669
-				l670:
-					position, tokenIndex = position669, tokenIndex669
+					goto l692
+				l693:
+					position, tokenIndex = position692, tokenIndex692
 					if buffer[position] != rune('V') {
-						goto l665
+						goto l688
 					}
 					position++
 				}
-			l669:
+			l692:
 				{
-					position671, tokenIndex671 := position, tokenIndex
+					position694, tokenIndex694 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l672
+						goto l695
 					}
 					position++
-					goto l671
-				l672:
-					position, tokenIndex = position671, tokenIndex671
+					goto l694
+				l695:
+					position, tokenIndex = position694, tokenIndex694
 					if buffer[position] != rune('E') {
-						goto l665
+						goto l688
 					}
 					position++
 				}
-			l671:
+			l694:
 				{
-					position673, tokenIndex673 := position, tokenIndex
+					position696, tokenIndex696 := position, tokenIndex
 					if buffer[position] != rune('r') {
-						goto l674
+						goto l697
 					}
 					position++
-					goto l673
-				l674:
-					position, tokenIndex = position673, tokenIndex673
+					goto l696
+				l697:
+					position, tokenIndex = position696, tokenIndex696
 					if buffer[position] != rune('R') {
-						goto l665
+						goto l688
 					}
 					position++
 				}
-			l673:
+			l696:
 				{
-					position675, tokenIndex675 := position, tokenIndex
+					position698, tokenIndex698 := position, tokenIndex
 					if buffer[position] != rune('y') {
-						goto l676
+						goto l699
 					}
 					position++
-					goto l675
-				l676:
-					position, tokenIndex = position675, tokenIndex675
+					goto l698
+				l699:
+					position, tokenIndex = position698, tokenIndex698
 					if buffer[position] != rune('Y') {
-						goto l665
+						goto l688
 					}
 					position++
 				}
-			l675:
+			l698:
 				if !_rules[rulesp]() {
-					goto l665
+					goto l688
 				}
 				if !_rules[ruleNumericLiteral]() {
-					goto l665
+					goto l688
 				}
 				if !_rules[rulespOpt]() {
-					goto l665
+					goto l688
 				}
 				{
-					position677, tokenIndex677 := position, tokenIndex
+					position700, tokenIndex700 := position, tokenIndex
 					if buffer[position] != rune('-') {
-						goto l677
+						goto l700
 					}
 					position++
-					goto l678
-				l677:
-					position, tokenIndex = position677, tokenIndex677
+					goto l701
+				l700:
+					position, tokenIndex = position700, tokenIndex700
 				}
-			l678:
+			l701:
 				if !_rules[rulespOpt]() {
-					goto l665
+					goto l688
 				}
 				{
-					position679, tokenIndex679 := position, tokenIndex
+					position702, tokenIndex702 := position, tokenIndex
 					{
-						position681, tokenIndex681 := position, tokenIndex
+						position704, tokenIndex704 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l682
+							goto l705
 						}
 						position++
-						goto l681
-					l682:
-						position, tokenIndex = position681, tokenIndex681
+						goto l704
+					l705:
+						position, tokenIndex = position704, tokenIndex704
 						if buffer[position] != rune('S') {
-							goto l680
+							goto l703
 						}
 						position++
 					}
-				l681:
+				l704:
 					{
-						position683, tokenIndex683 := position, tokenIndex
+						position706, tokenIndex706 := position, tokenIndex
 						if buffer[position] != rune('t') {
-							goto l684
+							goto l707
 						}
 						position++
-						goto l683
-					l684:
-						position, tokenIndex = position683, tokenIndex683
+						goto l706
+					l707:
+						position, tokenIndex = position706, tokenIndex706
 						if buffer[position] != rune('T') {
-							goto l680
+							goto l703
 						}
 						position++
 					}
-				l683:
-					goto l679
-				l680:
-					position, tokenIndex = position679, tokenIndex679
+				l706:
+					goto l702
+				l703:
+					position, tokenIndex = position702, tokenIndex702
 					{
-						position686, tokenIndex686 := position, tokenIndex
+						position709, tokenIndex709 := position, tokenIndex
 						if buffer[position] != rune('n') {
-							goto l687
+							goto l710
 						}
 						position++
-						goto l686
-					l687:
-						position, tokenIndex = position686, tokenIndex686
+						goto l709
+					l710:
+						position, tokenIndex = position709, tokenIndex709
 						if buffer[position] != rune('N') {
-							goto l685
+							goto l708
 						}
 						position++
 					}
-				l686:
+				l709:
 					{
-						position688, tokenIndex688 := position, tokenIndex
+						position711, tokenIndex711 := position, tokenIndex
 						if buffer[position] != rune('d') {
-							goto l689
+							goto l712
 						}
 						position++
-						goto l688
-					l689:
-						position, tokenIndex = position688, tokenIndex688
+						goto l711
+					l712:
+						position, tokenIndex = position711, tokenIndex711
 						if buffer[position] != rune('D') {
-							goto l685
+							goto l708
 						}
 						position++
 					}
-				l688:
-					goto l679
-				l685:
-					position, tokenIndex = position679, tokenIndex679
+				l711:
+					goto l702
+				l708:
+					position, tokenIndex = position702, tokenIndex702
 					{
-						position691, tokenIndex691 := position, tokenIndex
+						position714, tokenIndex714 := position, tokenIndex
 						if buffer[position] != rune('r') {
-							goto l692
+							goto l715
 						}
 						position++
-						goto l691
-					l692:
-						position, tokenIndex = position691, tokenIndex691
+						goto l714
+					l715:
+						position, tokenIndex = position714, tokenIndex714
 						if buffer[position] != rune('R') {
-							goto l690
+							goto l713
 						}
 						position++
 					}
-				l691:
+				l714:
 					{
-						position693, tokenIndex693 := position, tokenIndex
+						position716, tokenIndex716 := position, tokenIndex
 						if buffer[position] != rune('d') {
-							goto l694
+							goto l717
 						}
 						position++
-						goto l693
-					l694:
-						position, tokenIndex = position693, tokenIndex693
+						goto l716
+					l717:
+						position, tokenIndex = position716, tokenIndex716
 						if buffer[position] != rune('D') {
-							goto l690
+							goto l713
 						}
 						position++
 					}
-				l693:
-					goto l679
-				l690:
-					position, tokenIndex = position679, tokenIndex679
+				l716:
+					goto l702
+				l713:
+					position, tokenIndex = position702, tokenIndex702
 					{
-						position695, tokenIndex695 := position, tokenIndex
+						position718, tokenIndex718 := position, tokenIndex
 						if buffer[position] != rune('t') {
-							goto l696
+							goto l719
 						}
 						position++
-						goto l695
-					l696:
-						position, tokenIndex = position695, tokenIndex695
+						goto l718
+					l719:
+						position, tokenIndex = position718, tokenIndex718
 						if buffer[position] != rune('T') {
-							goto l665
+							goto l688
 						}
 						position++
 					}
-				l695:
+				l718:
 					{
-						position697, tokenIndex697 := position, tokenIndex
+						position720, tokenIndex720 := position, tokenIndex
 						if buffer[position] != rune('h') {
-							goto l698
+							goto l721
 						}
 						position++
-						goto l697
-					l698:
-						position, tokenIndex = position697, tokenIndex697
+						goto l720
+					l721:
+						position, tokenIndex = position720, tokenIndex720
 						if buffer[position] != rune('H') {
-							goto l665
+							goto l688
 						}
 						position++
 					}
-				l697:
+				l720:
 				}
-			l679:
+			l702:
 				if !_rules[rulesp]() {
-					goto l665
+					goto l688
 				}
 				{
-					position699, tokenIndex699 := position, tokenIndex
+					position722, tokenIndex722 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l700
+						goto l723
 					}
 					position++
-					goto l699
-				l700:
-					position, tokenIndex = position699, tokenIndex699
+					goto l722
+				l723:
+					position, tokenIndex = position722, tokenIndex722
 					if buffer[position] != rune('T') {
-						goto l665
+						goto l688
 					}
 					position++
 				}
-			l699:
+			l722:
 				{
-					position701, tokenIndex701 := position, tokenIndex
+					position724, tokenIndex724 := position, tokenIndex
 					if buffer[position] != rune('u') {
-						goto l702
+						goto l725
 					}
 					position++
-					goto l701
-				l702:
-					position, tokenIndex = position701, tokenIndex701
+					goto l724
+				l725:
+					position, tokenIndex = position724, tokenIndex724
 					if buffer[position] != rune('U') {
-						goto l665
+						goto l688
 					}
 					position++
 				}
-			l701:
+			l724:
 				{
-					position703, tokenIndex703 := position, tokenIndex
+					position726, tokenIndex726 := position, tokenIndex
 					if buffer[position] != rune('p') {
-						goto l704
+						goto l727
 					}
 					position++
-					goto l703
-				l704:
-					position, tokenIndex = position703, tokenIndex703
+					goto l726
+				l727:
+					position, tokenIndex = position726, tokenIndex726
 					if buffer[position] != rune('P') {
-						goto l665
+						goto l688
 					}
 					position++
 				}
-			l703:
+			l726:
 				{
-					position705, tokenIndex705 := position, tokenIndex
+					position728, tokenIndex728 := position, tokenIndex
 					if buffer[position] != rune('l') {
-						goto l706
+						goto l729
 					}
 					position++
-					goto l705
-				l706:
-					position, tokenIndex = position705, tokenIndex705
+					goto l728
+				l729:
+					position, tokenIndex = position728, tokenIndex728
 					if buffer[position] != rune('L') {
-						goto l665
+						goto l688
 					}
 					position++
 				}
-			l705:
+			l728:
 				{
-					position707, tokenIndex707 := position, tokenIndex
+					position730, tokenIndex730 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l708
+						goto l731
 					}
 					position++
-					goto l707
-				l708:
-					position, tokenIndex = position707, tokenIndex707
+					goto l730
+				l731:
+					position, tokenIndex = position730, tokenIndex730
 					if buffer[position] != rune('E') {
-						goto l665
+						goto l688
 					}
 					position++
 				}
-			l707:
-				if !_rules[ruleAction27]() {
-					goto l665
+			l730:
+				if !_rules[ruleAction28]() {
+					goto l688
 				}
-				add(ruleCountBasedSampling, position666)
+				add(ruleCountBasedSampling, position689)
 			}
 			return true
-		l665:
-			position, tokenIndex = position665, tokenIndex665
+		l688:
+			position, tokenIndex = position688, tokenIndex688
 			return false
 		},
-		/* 36 RandomizedSampling <- <(('s' / 'S') ('a' / 'A') ('m' / 'M') ('p' / 'P') ('l' / 'L') ('e' / 'E') sp (FloatLiteral / NumericLiteral) spOpt '%' Action28)> */
+		/* 38 RandomizedSampling <- <(('s' / 'S') ('a' / 'A') ('m' / 'M') ('p' / 'P') ('l' / 'L') ('e' / 'E') sp (FloatLiteral / NumericLiteral) spOpt '%' Action29)> */
 		func() bool {
-			position709, tokenIndex709 := position, tokenIndex
+			position732, tokenIndex732 := position, tokenIndex
 			{
-				position710 := position
+				position733 := position
 				{
-					position711, tokenIndex711 := position, tokenIndex
+					position734, tokenIndex734 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l712
+						goto l735
 					}
 					position++
-					goto l711
-				l712:
-					position, tokenIndex = position711, tokenIndex711
+					goto l734
+				l735:
+					position, tokenIndex = position734, tokenIndex734
 					if buffer[position] != rune('S') {
-						goto l709
+						goto l732
 					}
 					position++
 				}
-			l711:
+			l734:
 				{
-					position713, tokenIndex713 := position, tokenIndex
+					position736, tokenIndex736 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l714
+						goto l737
 					}
 					position++
-					goto l713
-				l714:
-					position, tokenIndex = position713, tokenIndex713
+					goto l736
+				l737:
+					position, tokenIndex = position736, tokenIndex736
 					if buffer[position] != rune('A') {
-						goto l709
+						goto l732
 					}
 					position++
 				}
-			l713:
+			l736:
 				{
-					position715, tokenIndex715 := position, tokenIndex
+					position738, tokenIndex738 := position, tokenIndex
 					if buffer[position] != rune('m') {
-						goto l716
+						goto l739
 					}
 					position++
-					goto l715
-				l716:
-					position, tokenIndex = position715, tokenIndex715
+					goto l738
+				l739:
+					position, tokenIndex = position738, tokenIndex738
 					if buffer[position] != rune('M') {
-						goto l709
+						goto l732
 					}
 					position++
 				}
-			l715:
+			l738:
 				{
-					position717, tokenIndex717 := position, tokenIndex
+					position740, tokenIndex740 := position, tokenIndex
 					if buffer[position] != rune('p') {
-						goto l718
+						goto l741
 					}
 					position++
-					goto l717
-				l718:
-					position, tokenIndex = position717, tokenIndex717
+					goto l740
+				l741:
+					position, tokenIndex = position740, tokenIndex740
 					if buffer[position] != rune('P') {
-						goto l709
+						goto l732
 					}
 					position++
 				}
-			l717:
+			l740:
 				{
-					position719, tokenIndex719 := position, tokenIndex
+					position742, tokenIndex742 := position, tokenIndex
 					if buffer[position] != rune('l') {
-						goto l720
+						goto l743
 					}
 					position++
-					goto l719
-				l720:
-					position, tokenIndex = position719, tokenIndex719
+					goto l742
+				l743:
+					position, tokenIndex = position742, tokenIndex742
 					if buffer[position] != rune('L') {
-						goto l709
+						goto l732
 					}
 					position++
 				}
-			l719:
+			l742:
 				{
-					position721, tokenIndex721 := position, tokenIndex
+					position744, tokenIndex744 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l722
+						goto l745
 					}
 					position++
-					goto l721
-				l722:
-					position, tokenIndex = position721, tokenIndex721
+					goto l744
+				l745:
+					position, tokenIndex = position744, tokenIndex744
 					if buffer[position] != rune('E') {
-						goto l709
+						goto l732
 					}
 					position++
 				}
-			l721:
+			l744:
 				if !_rules[rulesp]() {
-					goto l709
+					goto l732
 				}
 				{
-					position723, tokenIndex723 := position, tokenIndex
+					position746, tokenIndex746 := position, tokenIndex
 					if !_rules[ruleFloatLiteral]() {
-						goto l724
+						goto l747
 					}
-					goto l723
-				l724:
-					position, tokenIndex = position723, tokenIndex723
+					goto l746
+				l747:
+					position, tokenIndex = position746, tokenIndex746
 					if !_rules[ruleNumericLiteral]() {
-						goto l709
+						goto l732
 					}
 				}
-			l723:
+			l746:
 				if !_rules[rulespOpt]() {
-					goto l709
+					goto l732
 				}
 				if buffer[position] != rune('%') {
-					goto l709
+					goto l732
 				}
 				position++
-				if !_rules[ruleAction28]() {
-					goto l709
+				if !_rules[ruleAction29]() {
+					goto l732
 				}
-				add(ruleRandomizedSampling, position710)
+				add(ruleRandomizedSampling, position733)
 			}
 			return true
-		l709:
-			position, tokenIndex = position709, tokenIndex709
+		l732:
+			position, tokenIndex = position732, tokenIndex732
 			return false
 		},
-		/* 37 TimeBasedSampling <- <(TimeBasedSamplingSeconds / TimeBasedSamplingMilliseconds)> */
+		/* 39 TimeBasedSampling <- <(TimeBasedSamplingSeconds / TimeBasedSamplingMilliseconds)> */
 		func() bool {
-			position725, tokenIndex725 := position, tokenIndex
+			position748, tokenIndex748 := position, tokenIndex
 			{
-				position726 := position
+				position749 := position
 				{
-					position727, tokenIndex727 := position, tokenIndex
+					position750, tokenIndex750 := position, tokenIndex
 					if !_rules[ruleTimeBasedSamplingSeconds]() {
-						goto l728
+						goto l751
 					}
-					goto l727
-				l728:
-					position, tokenIndex = position727, tokenIndex727
+					goto l750
+				l751:
+					position, tokenIndex = position750, tokenIndex750
 					if !_rules[ruleTimeBasedSamplingMilliseconds]() {
-						goto l725
+						goto l748
 					}
 				}
-			l727:
-				add(ruleTimeBasedSampling, position726)
+			l750:
+				add(ruleTimeBasedSampling, position749)
 			}
 			return true
-		l725:
-			position, tokenIndex = position725, tokenIndex725
+		l748:
+			position, tokenIndex = position748, tokenIndex748
 			return false
 		},
-		/* 38 TimeBasedSamplingSeconds <- <(('e' / 'E') ('v' / 'V') ('e' / 'E') ('r' / 'R') ('y' / 'Y') sp (FloatLiteral / NumericLiteral) sp (('s' / 'S') ('e' / 'E') ('c' / 'C') ('o' / 'O') ('n' / 'N') ('d' / 'D') ('s' / 'S')) Action29)> */
+		/* 40 TimeBasedSamplingSeconds <- <(('e' / 'E') ('v' / 'V') ('e' / 'E') ('r' / 'R') ('y' / 'Y') sp (FloatLiteral / NumericLiteral) sp (('s' / 'S') ('e' / 'E') ('c' / 'C') ('o' / 'O') ('n' / 'N') ('d' / 'D') ('s' / 'S')) Action30)> */
 		func() bool {
-			position729, tokenIndex729 := position, tokenIndex
+			position752, tokenIndex752 := position, tokenIndex
 			{
-				position730 := position
+				position753 := position
 				{
-					position731, tokenIndex731 := position, tokenIndex
+					position754, tokenIndex754 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l732
+						goto l755
 					}
 					position++
-					goto l731
-				l732:
-					position, tokenIndex = position731, tokenIndex731
+					goto l754
+				l755:
+					position, tokenIndex = position754, tokenIndex754
 					if buffer[position] != rune('E') {
-						goto l729
+						goto l752
 					}
 					position++
 				}
-			l731:
+			l754:
 				{
-					position733, tokenIndex733 := position, tokenIndex
+					position756, tokenIndex756 := position, tokenIndex
 					if buffer[position] != rune('v') {
-						goto l734
+						goto l757
 					}
 					position++
-					goto l733
-				l734:
-					position, tokenIndex = position733, tokenIndex733
+					goto l756
+				l757:
+					position, tokenIndex = position756, tokenIndex756
 					if buffer[position] != rune('V') {
-						goto l729
+						goto l752
 					}
 					position++
 				}
-			l733:
+			l756:
 				{
-					position735, tokenIndex735 := position, tokenIndex
+					position758, tokenIndex758 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l736
+						goto l759
 					}
 					position++
-					goto l735
-				l736:
-					position, tokenIndex = position735, tokenIndex735
+					goto l758
+				l759:
+					position, tokenIndex = position758, tokenIndex758
 					if buffer[position] != rune('E') {
-						goto l729
+						goto l752
 					}
 					position++
 				}
-			l735:
+			l758:
 				{
-					position737, tokenIndex737 := position, tokenIndex
+					position760, tokenIndex760 := position, tokenIndex
 					if buffer[position] != rune('r') {
-						goto l738
+						goto l761
 					}
 					position++
-					goto l737
-				l738:
-					position, tokenIndex = position737, tokenIndex737
+					goto l760
+				l761:
+					position, tokenIndex = position760, tokenIndex760
 					if buffer[position] != rune('R') {
-						goto l729
+						goto l752
 					}
 					position++
 				}
-			l737:
+			l760:
 				{
-					position739, tokenIndex739 := position, tokenIndex
+					position762, tokenIndex762 := position, tokenIndex
 					if buffer[position] != rune('y') {
-						goto l740
+						goto l763
 					}
 					position++
-					goto l739
-				l740:
-					position, tokenIndex = position739, tokenIndex739
+					goto l762
+				l763:
+					position, tokenIndex = position762, tokenIndex762
 					if buffer[position] != rune('Y') {
-						goto l729
+						goto l752
 					}
 					position++
 				}
-			l739:
+			l762:
 				if !_rules[rulesp]() {
-					goto l729
+					goto l752
 				}
 				{
-					position741, tokenIndex741 := position, tokenIndex
+					position764, tokenIndex764 := position, tokenIndex
 					if !_rules[ruleFloatLiteral]() {
-						goto l742
+						goto l765
 					}
-					goto l741
-				l742:
-					position, tokenIndex = position741, tokenIndex741
+					goto l764
+				l765:
+					position, tokenIndex = position764, tokenIndex764
 					if !_rules[ruleNumericLiteral]() {
-						goto l729
+						goto l752
 					}
 				}
-			l741:
+			l764:
 				if !_rules[rulesp]() {
-					goto l729
+					goto l752
 				}
 				{
-					position743, tokenIndex743 := position, tokenIndex
+					position766, tokenIndex766 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l744
+						goto l767
 					}
 					position++
-					goto l743
-				l744:
-					position, tokenIndex = position743, tokenIndex743
+					goto l766
+				l767:
+					position, tokenIndex = position766, tokenIndex766
 					if buffer[position] != rune('S') {
-						goto l729
+						goto l752
 					}
 					position++
 				}
-			l743:
+			l766:
 				{
-					position745, tokenIndex745 := position, tokenIndex
+					position768, tokenIndex768 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l746
+						goto l769
 					}
 					position++
-					goto l745
-				l746:
-					position, tokenIndex = position745, tokenIndex745
+					goto l768
+				l769:
+					position, tokenIndex = position768, tokenIndex768
 					if buffer[position] != rune('E') {
-						goto l729
+						goto l752
 					}
 					position++
 				}
-			l745:
+			l768:
 				{
-					position747, tokenIndex747 := position, tokenIndex
+					position770, tokenIndex770 := position, tokenIndex
 					if buffer[position] != rune('c') {
-						goto l748
+						goto l771
 					}
 					position++
-					goto l747
-				l748:
-					position, tokenIndex = position747, tokenIndex747
+					goto l770
+				l771:
+					position, tokenIndex = position770, tokenIndex770
 					if buffer[position] != rune('C') {
-						goto l729
+						goto l752
 					}
 					position++
 				}
-			l747:
+			l770:
 				{
-					position749, tokenIndex749 := position, tokenIndex
+					position772, tokenIndex772 := position, tokenIndex
 					if buffer[position] != rune('o') {
-						goto l750
+						goto l773
 					}
 					position++
-					goto l749
-				l750:
-					position, tokenIndex = position749, tokenIndex749
+					goto l772
+				l773:
+					position, tokenIndex = position772, tokenIndex772
 					if buffer[position] != rune('O') {
-						goto l729
+						goto l752
 					}
 					position++
 				}
-			l749:
+			l772:
 				{
-					position751, tokenIndex751 := position, tokenIndex
+					position774, tokenIndex774 := position, tokenIndex
 					if buffer[position] != rune('n') {
-						goto l752
+						goto l775
 					}
 					position++
-					goto l751
-				l752:
-					position, tokenIndex = position751, tokenIndex751
+					goto l774
+				l775:
+					position, tokenIndex = position774, tokenIndex774
 					if buffer[position] != rune('N') {
-						goto l729
+						goto l752
 					}
 					position++
 				}
-			l751:
+			l774:
 				{
-					position753, tokenIndex753 := position, tokenIndex
+					position776, tokenIndex776 := position, tokenIndex
 					if buffer[position] != rune('d') {
-						goto l754
+						goto l777
 					}
 					position++
-					goto l753
-				l754:
-					position, tokenIndex = position753, tokenIndex753
+					goto l776
+				l777:
+					position, tokenIndex = position776, tokenIndex776
 					if buffer[position] != rune('D') {
-						goto l729
+						goto l752
 					}
 					position++
 				}
-			l753:
+			l776:
 				{
-					position755, tokenIndex755 := position, tokenIndex
+					position778, tokenIndex778 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l756
+						goto l779
 					}
 					position++
-					goto l755
-				l756:
-					position, tokenIndex = position755, tokenIndex755
+					goto l778
+				l779:
+					position, tokenIndex = position778, tokenIndex778
 					if buffer[position] != rune('S') {
-						goto l729
+						goto l752
 					}
 					position++
 				}
-			l755:
-				if !_rules[ruleAction29]() {
-					goto l729
+			l778:
+				if !_rules[ruleAction30]() {
+					goto l752
 				}
-				add(ruleTimeBasedSamplingSeconds, position730)
+				add(ruleTimeBasedSamplingSeconds, position753)
 			}
 			return true
-		l729:
-			position, tokenIndex = position729, tokenIndex729
+		l752:
+			position, tokenIndex = position752, tokenIndex752
 			return false
 		},
-		/* 39 TimeBasedSamplingMilliseconds <- <(('e' / 'E') ('v' / 'V') ('e' / 'E') ('r' / 'R') ('y' / 'Y') sp (FloatLiteral / NumericLiteral) sp (('m' / 'M') ('i' / 'I') ('l' / 'L') ('l' / 'L') ('i' / 'I') ('s' / 'S') ('e' / 'E') ('c' / 'C') ('o' / 'O') ('n' / 'N') ('d' / 'D') ('s' / 'S')) Action30)> */
+		/* 41 TimeBasedSamplingMilliseconds <- <(('e' / 'E') ('v' / 'V') ('e' / 'E') ('r' / 'R') ('y' / 'Y') sp (FloatLiteral / NumericLiteral) sp (('m' / 'M') ('i' / 'I') ('l' / 'L') ('l' / 'L') ('i' / 'I') ('s' / 'S') ('e' / 'E') ('c' / 'C') ('o' / 'O') ('n' / 'N') ('d' / 'D') ('s' / 'S')) Action31)> */
 		func() bool {
-			position757, tokenIndex757 := position, tokenIndex
+			position780, tokenIndex780 := position, tokenIndex
 			{
-				position758 := position
+				position781 := position
 				{
-					position759, tokenIndex759 := position, tokenIndex
+					position782, tokenIndex782 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l760
+						goto l783
 					}
 					position++
-					goto l759
-				l760:
-					position, tokenIndex = position759, tokenIndex759
+					goto l782
+				l783:
+					position, tokenIndex = position782, tokenIndex782
 					if buffer[position] != rune('E') {
-						goto l757
+						goto l780
 					}
 					position++
 				}
-			l759:
+			l782:
 				{
-					position761, tokenIndex761 := position, tokenIndex
+					position784, tokenIndex784 := position, tokenIndex
 					if buffer[position] != rune('v') {
-						goto l762
+						goto l785
 					}
 					position++
-					goto l761
-				l762:
-					position, tokenIndex = position761, tokenIndex761
+					goto l784
+				l785:
+					position, tokenIndex = position784, tokenIndex784
 					if buffer[position] != rune('V') {
-						goto l757
+						goto l780
 					}
 					position++
 				}
-			l761:
+			l784:
 				{
-					position763, tokenIndex763 := position, tokenIndex
+					position786, tokenIndex786 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l764
+						goto l787
 					}
 					position++
-					goto l763
-				l764:
-					position, tokenIndex = position763, tokenIndex763
+					goto l786
+				l787:
+					position, tokenIndex = position786, tokenIndex786
 					if buffer[position] != rune('E') {
-						goto l757
+						goto l780
 					}
 					position++
 				}
-			l763:
+			l786:
 				{
-					position765, tokenIndex765 := position, tokenIndex
+					position788, tokenIndex788 := position, tokenIndex
 					if buffer[position] != rune('r') {
-						goto l766
+						goto l789
 					}
 					position++
-					goto l765
-				l766:
-					position, tokenIndex = position765, tokenIndex765
+					goto l788
+				l789:
+					position, tokenIndex = position788, tokenIndex788
 					if buffer[position] != rune('R') {
-						goto l757
+						goto l780
 					}
 					position++
 				}
-			l765:
+			l788:
 				{
-					position767, tokenIndex767 := position, tokenIndex
+					position790, tokenIndex790 := position, tokenIndex
 					if buffer[position] != rune('y') {
-						goto l768
+						goto l791
 					}
 					position++
-					goto l767
-				l768:
-					position, tokenIndex = position767, tokenIndex767
+					goto l790
+				l791:
+					position, tokenIndex = position790, tokenIndex790
 					if buffer[position] != rune('Y') {
-						goto l757
+						goto l780
 					}
 					position++
 				}
-			l767:
+			l790:
 				if !_rules[rulesp]() {
-					goto l757
+					goto l780
 				}
 				{
-					position769, tokenIndex769 := position, tokenIndex
+					position792, tokenIndex792 := position, tokenIndex
 					if !_rules[ruleFloatLiteral]() {
-						goto l770
+						goto l793
 					}
-					goto l769
-				l770:
-					position, tokenIndex = position769, tokenIndex769
+					goto l792
+				l793:
+					position, tokenIndex = position792, tokenIndex792
 					if !_rules[ruleNumericLiteral]() {
-						goto l757
+						goto l780
 					}
 				}
-			l769:
+			l792:
 				if !_rules[rulesp]() {
-					goto l757
+					goto l780
 				}
 				{
-					position771, tokenIndex771 := position, tokenIndex
+					position794, tokenIndex794 := position, tokenIndex
 					if buffer[position] != rune('m') {
-						goto l772
+						goto l795
 					}
 					position++
-					goto l771
-				l772:
-					position, tokenIndex = position771, tokenIndex771
+					goto l794
+				l795:
+					position, tokenIndex = position794, tokenIndex794
 					if buffer[position] != rune('M') {
-						goto l757
+						goto l780
 					}
 					position++
 				}
-			l771:
+			l794:
 				{
-					position773, tokenIndex773 := position, tokenIndex
+					position796, tokenIndex796 := position, tokenIndex
 					if buffer[position] != rune('i') {
-						goto l774
+						goto l797
 					}
 					position++
-					goto l773
-				l774:
-					position, tokenIndex = position773, tokenIndex773
+					goto l796
+				l797:
+					position, tokenIndex = position796, tokenIndex796
 					if buffer[position] != rune('I') {
-						goto l757
+						goto l780
 					}
 					position++
 				}
-			l773:
+			l796:
 				{
-					position775, tokenIndex775 := position, tokenIndex
+					position798, tokenIndex798 := position, tokenIndex
 					if buffer[position] != rune('l') {
-						goto l776
+						goto l799
 					}
 					position++
-					goto l775
-				l776:
-					position, tokenIndex = position775, tokenIndex775
+					goto l798
+				l799:
+					position, tokenIndex = position798, tokenIndex798
 					if buffer[position] != rune('L') {
-						goto l757
+						goto l780
 					}
 					position++
 				}
-			l775:
+			l798:
 				{
-					position777, tokenIndex777 := position, tokenIndex
+					position800, tokenIndex800 := position, tokenIndex
 					if buffer[position] != rune('l') {
-						goto l778
+						goto l801
 					}
 					position++
-					goto l777
-				l778:
-					position, tokenIndex = position777, tokenIndex777
+					goto l800
+				l801:
+					position, tokenIndex = position800, tokenIndex800
 					if buffer[position] != rune('L') {
-						goto l757
+						goto l780
 					}
 					position++
 				}
-			l777:
+			l800:
 				{
-					position779, tokenIndex779 := position, tokenIndex
+					position802, tokenIndex802 := position, tokenIndex
 					if buffer[position] != rune('i') {
-						goto l780
+						goto l803
 					}
 					position++
-					goto l779
-				l780:
-					position, tokenIndex = position779, tokenIndex779
+					goto l802
+				l803:
+					position, tokenIndex = position802, tokenIndex802
 					if buffer[position] != rune('I') {
-						goto l757
+						goto l780
 					}
 					position++
 				}
-			l779:
+			l802:
 				{
-					position781, tokenIndex781 := position, tokenIndex
+					position804, tokenIndex804 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l782
+						goto l805
 					}
 					position++
-					goto l781
-				l782:
-					position, tokenIndex = position781, tokenIndex781
+					goto l804
+				l805:
+					position, tokenIndex = position804, tokenIndex804
 					if buffer[position] != rune('S') {
-						goto l757
+						goto l780
 					}
 					position++
 				}
-			l781:
+			l804:
 				{
-					position783, tokenIndex783 := position, tokenIndex
+					position806, tokenIndex806 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l784
+						goto l807
 					}
 					position++
-					goto l783
-				l784:
-					position, tokenIndex = position783, tokenIndex783
+					goto l806
+				l807:
+					position, tokenIndex = position806, tokenIndex806
 					if buffer[position] != rune('E') {
-						goto l757
+						goto l780
 					}
 					position++
 				}
-			l783:
+			l806:
 				{
-					position785, tokenIndex785 := position, tokenIndex
+					position808, tokenIndex808 := position, tokenIndex
 					if buffer[position] != rune('c') {
-						goto l786
+						goto l809
 					}
 					position++
-					goto l785
-				l786:
-					position, tokenIndex = position785, tokenIndex785
+					goto l808
+				l809:
+					position, tokenIndex = position808, tokenIndex808
 					if buffer[position] != rune('C') {
-						goto l757
+						goto l780
 					}
 					position++
 				}
-			l785:
+			l808:
 				{
-					position787, tokenIndex787 := position, tokenIndex
+					position810, tokenIndex810 := position, tokenIndex
 					if buffer[position] != rune('o') {
-						goto l788
+						goto l811
 					}
 					position++
-					goto l787
-				l788:
-					position, tokenIndex = position787, tokenIndex787
+					goto l810
+				l811:
+					position, tokenIndex = position810, tokenIndex810
 					if buffer[position] != rune('O') {
-						goto l757
+						goto l780
 					}
 					position++
 				}
-			l787:
+			l810:
 				{
-					position789, tokenIndex789 := position, tokenIndex
+					position812, tokenIndex812 := position, tokenIndex
 					if buffer[position] != rune('n') {
-						goto l790
+						goto l813
 					}
 					position++
-					goto l789
-				l790:
-					position, tokenIndex = position789, tokenIndex789
+					goto l812
+				l813:
+					position, tokenIndex = position812, tokenIndex812
 					if buffer[position] != rune('N') {
-						goto l757
+						goto l780
 					}
 					position++
 				}
-			l789:
+			l812:
 				{
-					position791, tokenIndex791 := position, tokenIndex
+					position814, tokenIndex814 := position, tokenIndex
 					if buffer[position] != rune('d') {
-						goto l792
+						goto l815
 					}
 					position++
-					goto l791
-				l792:
-					position, tokenIndex = position791, tokenIndex791
+					goto l814
+				l815:
+					position, tokenIndex = position814, tokenIndex814
 					if buffer[position] != rune('D') {
-						goto l757
+						goto l780
 					}
 					position++
 				}
-			l791:
+			l814:
 				{
-					position793, tokenIndex793 := position, tokenIndex
+					position816, tokenIndex816 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l794
+						goto l817
 					}
 					position++
-					goto l793
-				l794:
-					position, tokenIndex = position793, tokenIndex793
+					goto l816
+				l817:
+					position, tokenIndex = position816, tokenIndex816
 					if buffer[position] != rune('S') {
-						goto l757
+						goto l780
 					}
 					position++
 				}
-			l793:
-				if !_rules[ruleAction30]() {
-					goto l757
+			l816:
+				if !_rules[ruleAction31]() {
+					goto l780
 				}
-				add(ruleTimeBasedSamplingMilliseconds, position758)
+				add(ruleTimeBasedSamplingMilliseconds, position781)
 			}
 			return true
-		l757:
-			position, tokenIndex = position757, tokenIndex757
+		l780:
+			position, tokenIndex = position780, tokenIndex780
 			return false
 		},
-		/* 40 Projections <- <(<(sp Projection (spOpt ',' spOpt Projection)*)> Action31)> */
+		/* 42 Projections <- <(<(sp Projection (spOpt ',' spOpt Projection)*)> Action32)> */
 		func() bool {
-			position795, tokenIndex795 := position, tokenIndex
+			position818, tokenIndex818 := position, tokenIndex
 			{
-				position796 := position
+				position819 := position
 				{
-					position797 := position
+					position820 := position
 					if !_rules[rulesp]() {
-						goto l795
+						goto l818
 					}
 					if !_rules[ruleProjection]() {
-						goto l795
+						goto l818
 					}
-				l798:
+				l821:
 					{
-						position799, tokenIndex799 := position, tokenIndex
+						position822, tokenIndex822 := position, tokenIndex
 						if !_rules[rulespOpt]() {
-							goto l799
+							goto l822
 						}
 						if buffer[position] != rune(',') {
-							goto l799
+							goto l822
 						}
 						position++
 						if !_rules[rulespOpt]() {
-							goto l799
+							goto l822
 						}
 						if !_rules[ruleProjection]() {
-							goto l799
+							goto l822
 						}
-						goto l798
-					l799:
-						position, tokenIndex = position799, tokenIndex799
+						goto l821
+					l822:
+						position, tokenIndex = position822, tokenIndex822
 					}
-					add(rulePegText, position797)
+					add(rulePegText, position820)
 				}
-				if !_rules[ruleAction31]() {
-					goto l795
+				if !_rules[ruleAction32]() {
+					goto l818
 				}
-				add(ruleProjections, position796)
+				add(ruleProjections, position819)
 			}
 			return true
-		l795:
-			position, tokenIndex = position795, tokenIndex795
+		l818:
+			position, tokenIndex = position818, tokenIndex818
 			return false
 		},
-		/* 41 Projection <- <(AliasExpression / ExpressionOrWildcard)> */
+		/* 43 Projection <- <(AliasExpression / ExpressionOrWildcard)> */
 		func() bool {
-			position800, tokenIndex800 := position, tokenIndex
+			position823, tokenIndex823 := position, tokenIndex
 			{
-				position801 := position
+				position824 := position
 				{
-					position802, tokenIndex802 := position, tokenIndex
+					position825, tokenIndex825 := position, tokenIndex
 					if !_rules[ruleAliasExpression]() {
-						goto l803
+						goto l826
 					}
-					goto l802
-				l803:
-					position, tokenIndex = position802, tokenIndex802
+					goto l825
+				l826:
+					position, tokenIndex = position825, tokenIndex825
 					if !_rules[ruleExpressionOrWildcard]() {
-						goto l800
+						goto l823
 					}
 				}
-			l802:
-				add(ruleProjection, position801)
+			l825:
+				add(ruleProjection, position824)
 			}
 			return true
-		l800:
-			position, tokenIndex = position800, tokenIndex800
+		l823:
+			position, tokenIndex = position823, tokenIndex823
 			return false
 		},
-		/* 42 AliasExpression <- <(ExpressionOrWildcard sp (('a' / 'A') ('s' / 'S')) sp TargetIdentifier Action32)> */
+		/* 44 AliasExpression <- <(ExpressionOrWildcard sp (('a' / 'A') ('s' / 'S')) sp TargetIdentifier Action33)> */
 		func() bool {
-			position804, tokenIndex804 := position, tokenIndex
+			position827, tokenIndex827 := position, tokenIndex
 			{
-				position805 := position
+				position828 := position
 				if !_rules[ruleExpressionOrWildcard]() {
-					goto l804
+					goto l827
 				}
 				if !_rules[rulesp]() {
-					goto l804
+					goto l827
 				}
 				{
-					position806, tokenIndex806 := position, tokenIndex
+					position829, tokenIndex829 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l807
+						goto l830
 					}
 					position++
-					goto l806
-				l807:
-					position, tokenIndex = position806, tokenIndex806
+					goto l829
+				l830:
+					position, tokenIndex = position829, tokenIndex829
 					if buffer[position] != rune('A') {
-						goto l804
+						goto l827
 					}
 					position++
 				}
-			l806:
+			l829:
 				{
-					position808, tokenIndex808 := position, tokenIndex
+					position831, tokenIndex831 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l809
+						goto l832
 					}
 					position++
-					goto l808
-				l809:
-					position, tokenIndex = position808, tokenIndex808
+					goto l831
+				l832:
+					position, tokenIndex = position831, tokenIndex831
 					if buffer[position] != rune('S') {
-						goto l804
+						goto l827
 					}
 					position++
 				}
-			l808:
+			l831:
 				if !_rules[rulesp]() {
-					goto l804
+					goto l827
 				}
 				if !_rules[ruleTargetIdentifier]() {
-					goto l804
+					goto l827
 				}
-				if !_rules[ruleAction32]() {
-					goto l804
+				if !_rules[ruleAction33]() {
+					goto l827
 				}
-				add(ruleAliasExpression, position805)
+				add(ruleAliasExpression, position828)
 			}
 			return true
-		l804:
-			position, tokenIndex = position804, tokenIndex804
+		l827:
+			position, tokenIndex = position827, tokenIndex827
 			return false
 		},
-		/* 43 WindowedFrom <- <(<(sp (('f' / 'F') ('r' / 'R') ('o' / 'O') ('m' / 'M')) sp Relations)?> Action33)> */
+		/* 45 WindowedFrom <- <(<(sp (('f' / 'F') ('r' / 'R') ('o' / 'O') ('m' / 'M')) sp Relations)?> Action34)> */
 		func() bool {
-			position810, tokenIndex810 := position, tokenIndex
+			position833, tokenIndex833 := position, tokenIndex
 			{
-				position811 := position
+				position834 := position
 				{
-					position812 := position
+					position835 := position
 					{
-						position813, tokenIndex813 := position, tokenIndex
+						position836, tokenIndex836 := position, tokenIndex
 						if !_rules[rulesp]() {
-							goto l813
+							goto l836
 						}
 						{
-							position815, tokenIndex815 := position, tokenIndex
+							position838, tokenIndex838 := position, tokenIndex
 							if buffer[position] != rune('f') {
-								goto l816
+								goto l839
 							}
 							position++
-							goto l815
-						l816:
-							position, tokenIndex = position815, tokenIndex815
+							goto l838
+						l839:
+							position, tokenIndex = position838, tokenIndex838
 							if buffer[position] != rune('F') {
-								goto l813
+								goto l836
 							}
 							position++
 						}
-					l815:
+					l838:
 						{
-							position817, tokenIndex817 := position, tokenIndex
+							position840, tokenIndex840 := position, tokenIndex
 							if buffer[position] != rune('r') {
-								goto l818
+								goto l841
 							}
 							position++
-							goto l817
-						l818:
-							position, tokenIndex = position817, tokenIndex817
+							goto l840
+						l841:
+							position, tokenIndex = position840, tokenIndex840
 							if buffer[position] != rune('R') {
-								goto l813
+								goto l836
 							}
 							position++
 						}
-					l817:
+					l840:
 						{
-							position819, tokenIndex819 := position, tokenIndex
+							position842, tokenIndex842 := position, tokenIndex
 							if buffer[position] != rune('o') {
-								goto l820
+								goto l843
 							}
 							position++
-							goto l819
-						l820:
-							position, tokenIndex = position819, tokenIndex819
+							goto l842
+						l843:
+							position, tokenIndex = position842, tokenIndex842
 							if buffer[position] != rune('O') {
-								goto l813
+								goto l836
 							}
 							position++
 						}
-					l819:
+					l842:
 						{
-							position821, tokenIndex821 := position, tokenIndex
+							position844, tokenIndex844 := position, tokenIndex
 							if buffer[position] != rune('m') {
-								goto l822
+								goto l845
 							}
 							position++
-							goto l821
-						l822:
-							position, tokenIndex = position821, tokenIndex821
+							goto l844
+						l845:
+							position, tokenIndex = position844, tokenIndex844
 							if buffer[position] != rune('M') {
-								goto l813
+								goto l836
 							}
 							position++
 						}
-					l821:
+					l844:
 						if !_rules[rulesp]() {
-							goto l813
+							goto l836
 						}
 						if !_rules[ruleRelations]() {
-							goto l813
+							goto l836
 						}
-						goto l814
-					l813:
-						position, tokenIndex = position813, tokenIndex813
+						goto l837
+					l836:
+						position, tokenIndex = position836, tokenIndex836
 					}
-				l814:
-					add(rulePegText, position812)
+				l837:
+					add(rulePegText, position835)
 				}
-				if !_rules[ruleAction33]() {
-					goto l810
+				if !_rules[ruleAction34]() {
+					goto l833
 				}
-				add(ruleWindowedFrom, position811)
+				add(ruleWindowedFrom, position834)
 			}
 			return true
-		l810:
-			position, tokenIndex = position810, tokenIndex810
+		l833:
+			position, tokenIndex = position833, tokenIndex833
 			return false
 		},
-		/* 44 Interval <- <(TimeInterval / TuplesInterval)> */
+		/* 46 Interval <- <(TimeInterval / TuplesInterval)> */
 		func() bool {
-			position823, tokenIndex823 := position, tokenIndex
+			position846, tokenIndex846 := position, tokenIndex
 			{
-				position824 := position
+				position847 := position
 				{
-					position825, tokenIndex825 := position, tokenIndex
+					position848, tokenIndex848 := position, tokenIndex
 					if !_rules[ruleTimeInterval]() {
-						goto l826
+						goto l849
 					}
-					goto l825
-				l826:
-					position, tokenIndex = position825, tokenIndex825
+					goto l848
+				l849:
+					position, tokenIndex = position848, tokenIndex848
 					if !_rules[ruleTuplesInterval]() {
-						goto l823
+						goto l846
 					}
 				}
-			l825:
-				add(ruleInterval, position824)
+			l848:
+				add(ruleInterval, position847)
 			}
 			return true
-		l823:
-			position, tokenIndex = position823, tokenIndex823
+		l846:
+			position, tokenIndex = position846, tokenIndex846
 			return false
 		},
-		/* 45 TimeInterval <- <((FloatLiteral / NumericLiteral) sp (SECONDS / MILLISECONDS) Action34)> */
+		/* 47 TimeInterval <- <((FloatLiteral / NumericLiteral) sp (SECONDS / MILLISECONDS) Action35)> */
 		func() bool {
-			position827, tokenIndex827 := position, tokenIndex
+			position850, tokenIndex850 := position, tokenIndex
 			{
-				position828 := position
+				position851 := position
 				{
-					position829, tokenIndex829 := position, tokenIndex
+					position852, tokenIndex852 := position, tokenIndex
 					if !_rules[ruleFloatLiteral]() {
-						goto l830
+						goto l853
 					}
-					goto l829
-				l830:
-					position, tokenIndex = position829, tokenIndex829
+					goto l852
+				l853:
+					position, tokenIndex = position852, tokenIndex852
 					if !_rules[ruleNumericLiteral]() {
-						goto l827
+						goto l850
 					}
 				}
-			l829:
+			l852:
 				if !_rules[rulesp]() {
-					goto l827
+					goto l850
 				}
 				{
-					position831, tokenIndex831 := position, tokenIndex
+					position854, tokenIndex854 := position, tokenIndex
 					if !_rules[ruleSECONDS]() {
-						goto l832
+						goto l855
 					}
-					goto l831
-				l832:
-					position, tokenIndex = position831, tokenIndex831
+					goto l854
+				l855:
+					position, tokenIndex = position854, tokenIndex854
 					if !_rules[ruleMILLISECONDS]() {
-						goto l827
+						goto l850
 					}
 				}
-			l831:
-				if !_rules[ruleAction34]() {
-					goto l827
+			l854:
+				if !_rules[ruleAction35]() {
+					goto l850
 				}
-				add(ruleTimeInterval, position828)
+				add(ruleTimeInterval, position851)
 			}
 			return true
-		l827:
-			position, tokenIndex = position827, tokenIndex827
+		l850:
+			position, tokenIndex = position850, tokenIndex850
 			return false
 		},
-		/* 46 TuplesInterval <- <(NumericLiteral sp TUPLES Action35)> */
+		/* 48 TuplesInterval <- <(NumericLiteral sp TUPLES Action36)> */
 		func() bool {
-			position833, tokenIndex833 := position, tokenIndex
+			position856, tokenIndex856 := position, tokenIndex
 			{
-				position834 := position
+				position857 := position
 				if !_rules[ruleNumericLiteral]() {
-					goto l833
+					goto l856
 				}
 				if !_rules[rulesp]() {
-					goto l833
+					goto l856
 				}
 				if !_rules[ruleTUPLES]() {
-					goto l833
+					goto l856
 				}
-				if !_rules[ruleAction35]() {
-					goto l833
+				if !_rules[ruleAction36]() {
+					goto l856
 				}
-				add(ruleTuplesInterval, position834)
+				add(ruleTuplesInterval, position857)
 			}
 			return true
-		l833:
-			position, tokenIndex = position833, tokenIndex833
+		l856:
+			position, tokenIndex = position856, tokenIndex856
 			return false
 		},
-		/* 47 Relations <- <(RelationLike (spOpt ',' spOpt RelationLike)*)> */
+		/* 49 Relations <- <(RelationLike (spOpt ',' spOpt RelationLike)*)> */
 		func() bool {
-			position835, tokenIndex835 := position, tokenIndex
+			position858, tokenIndex858 := position, tokenIndex
 			{
-				position836 := position
+				position859 := position
 				if !_rules[ruleRelationLike]() {
-					goto l835
+					goto l858
 				}
-			l837:
+			l860:
 				{
-					position838, tokenIndex838 := position, tokenIndex
+					position861, tokenIndex861 := position, tokenIndex
 					if !_rules[rulespOpt]() {
-						goto l838
+						goto l861
 					}
 					if buffer[position] != rune(',') {
-						goto l838
+						goto l861
 					}
 					position++
 					if !_rules[rulespOpt]() {
-						goto l838
+						goto l861
 					}
 					if !_rules[ruleRelationLike]() {
-						goto l838
+						goto l861
 					}
-					goto l837
-				l838:
-					position, tokenIndex = position838, tokenIndex838
+					goto l860
+				l861:
+					position, tokenIndex = position861, tokenIndex861
 				}
-				add(ruleRelations, position836)
+				add(ruleRelations, position859)
 			}
 			return true
-		l835:
-			position, tokenIndex = position835, tokenIndex835
+		l858:
+			position, tokenIndex = position858, tokenIndex858
 			return false
 		},
-		/* 48 Filter <- <(<(sp (('w' / 'W') ('h' / 'H') ('e' / 'E') ('r' / 'R') ('e' / 'E')) sp Expression)?> Action36)> */
+		/* 50 Filter <- <(<(sp (('w' / 'W') ('h' / 'H') ('e' / 'E') ('r' / 'R') ('e' / 'E')) sp Expression)?> Action37)> */
 		func() bool {
-			position839, tokenIndex839 := position, tokenIndex
+			position862, tokenIndex862 := position, tokenIndex
 			{
-				position840 := position
+				position863 := position
 				{
-					position841 := position
+					position864 := position
 					{
-						position842, tokenIndex842 := position, tokenIndex
+						position865, tokenIndex865 := position, tokenIndex
 						if !_rules[rulesp]() {
-							goto l842
+							goto l865
 						}
 						{
-							position844, tokenIndex844 := position, tokenIndex
+							position867, tokenIndex867 := position, tokenIndex
 							if buffer[position] != rune('w') {
-								goto l845
+								goto l868
 							}
 							position++
-							goto l844
-						l845:
-							position, tokenIndex = position844, tokenIndex844
+							goto l867
+						l868:
+							position, tokenIndex = position867, tokenIndex867
 							if buffer[position] != rune('W') {
-								goto l842
+								goto l865
 							}
 							position++
 						}
-					l844:
+					l867:
 						{
-							position846, tokenIndex846 := position, tokenIndex
+							position869, tokenIndex869 := position, tokenIndex
 							if buffer[position] != rune('h') {
-								goto l847
+								goto l870
 							}
 							position++
-							goto l846
-						l847:
-							position, tokenIndex = position846, tokenIndex846
+							goto l869
+						l870:
+							position, tokenIndex = position869, tokenIndex869
 							if buffer[position] != rune('H') {
-								goto l842
+								goto l865
 							}
 							position++
 						}
-					l846:
+					l869:
 						{
-							position848, tokenIndex848 := position, tokenIndex
+							position871, tokenIndex871 := position, tokenIndex
 							if buffer[position] != rune('e') {
-								goto l849
+								goto l872
 							}
 							position++
-							goto l848
-						l849:
-							position, tokenIndex = position848, tokenIndex848
+							goto l871
+						l872:
+							position, tokenIndex = position871, tokenIndex871
 							if buffer[position] != rune('E') {
-								goto l842
+								goto l865
 							}
 							position++
 						}
-					l848:
+					l871:
 						{
-							position850, tokenIndex850 := position, tokenIndex
+							position873, tokenIndex873 := position, tokenIndex
 							if buffer[position] != rune('r') {
-								goto l851
+								goto l874
 							}
 							position++
-							goto l850
-						l851:
-							position, tokenIndex = position850, tokenIndex850
+							goto l873
+						l874:
+							position, tokenIndex = position873, tokenIndex873
 							if buffer[position] != rune('R') {
-								goto l842
+								goto l865
 							}
 							position++
 						}
-					l850:
+					l873:
 						{
-							position852, tokenIndex852 := position, tokenIndex
+							position875, tokenIndex875 := position, tokenIndex
 							if buffer[position] != rune('e') {
-								goto l853
+								goto l876
 							}
 							position++
-							goto l852
-						l853:
-							position, tokenIndex = position852, tokenIndex852
+							goto l875
+						l876:
+							position, tokenIndex = position875, tokenIndex875
 							if buffer[position] != rune('E') {
-								goto l842
+								goto l865
 							}
 							position++
 						}
-					l852:
+					l875:
 						if !_rules[rulesp]() {
-							goto l842
+							goto l865
 						}
 						if !_rules[ruleExpression]() {
-							goto l842
+							goto l865
 						}
-						goto l843
-					l842:
-						position, tokenIndex = position842, tokenIndex842
+						goto l866
+					l865:
+						position, tokenIndex = position865, tokenIndex865
 					}
-				l843:
-					add(rulePegText, position841)
+				l866:
+					add(rulePegText, position864)
 				}
-				if !_rules[ruleAction36]() {
-					goto l839
+				if !_rules[ruleAction37]() {
+					goto l862
 				}
-				add(ruleFilter, position840)
+				add(ruleFilter, position863)
 			}
 			return true
-		l839:
-			position, tokenIndex = position839, tokenIndex839
+		l862:
+			position, tokenIndex = position862, tokenIndex862
 			return false
 		},
-		/* 49 Grouping <- <(<(sp (('g' / 'G') ('r' / 'R') ('o' / 'O') ('u' / 'U') ('p' / 'P')) sp (('b' / 'B') ('y' / 'Y')) sp GroupList)?> Action37)> */
+		/* 51 Grouping <- <(<(sp (('g' / 'G') ('r' / 'R') ('o' / 'O') ('u' / 'U') ('p' / 'P')) sp (('b' / 'B') ('y' / 'Y')) sp GroupList)?> Action38)> */
 		func() bool {
-			position854, tokenIndex854 := position, tokenIndex
+			position877, tokenIndex877 := position, tokenIndex
 			{
-				position855 := position
+				position878 := position
 				{
-					position856 := position
+					position879 := position
 					{
-						position857, tokenIndex857 := position, tokenIndex
+						position880, tokenIndex880 := position, tokenIndex
 						if !_rules[rulesp]() {
-							goto l857
+							goto l880
 						}
 						{
-							position859, tokenIndex859 := position, tokenIndex
+							position882, tokenIndex882 := position, tokenIndex
 							if buffer[position] != rune('g') {
-								goto l860
+								goto l883
 							}
 							position++
-							goto l859
-						l860:
-							position, tokenIndex = position859, tokenIndex859
+							goto l882
+						l883:
+							position, tokenIndex = position882, tokenIndex882
 							if buffer[position] != rune('G') {
-								goto l857
+								goto l880
 							}
 							position++
 						}
-					l859:
+					l882:
 						{
-							position861, tokenIndex861 := position, tokenIndex
+							position884, tokenIndex884 := position, tokenIndex
 							if buffer[position] != rune('r') {
-								goto l862
+								goto l885
 							}
 							position++
-							goto l861
-						l862:
-							position, tokenIndex = position861, tokenIndex861
+							goto l884
+						l885:
+							position, tokenIndex = position884, tokenIndex884
 							if buffer[position] != rune('R') {
-								goto l857
+								goto l880
 							}
 							position++
 						}
-					l861:
+					l884:
 						{
-							position863, tokenIndex863 := position, tokenIndex
+							position886, tokenIndex886 := position, tokenIndex
 							if buffer[position] != rune('o') {
-								goto l864
+								goto l887
 							}
 							position++
-							goto l863
-						l864:
-							position, tokenIndex = position863, tokenIndex863
+							goto l886
+						l887:
+							position, tokenIndex = position886, tokenIndex886
 							if buffer[position] != rune('O') {
-								goto l857
+								goto l880
 							}
 							position++
 						}
-					l863:
+					l886:
 						{
-							position865, tokenIndex865 := position, tokenIndex
+							position888, tokenIndex888 := position, tokenIndex
 							if buffer[position] != rune('u') {
-								goto l866
+								goto l889
 							}
 							position++
-							goto l865
-						l866:
-							position, tokenIndex = position865, tokenIndex865
+							goto l888
+						l889:
+							position, tokenIndex = position888, tokenIndex888
 							if buffer[position] != rune('U') {
-								goto l857
+								goto l880
 							}
 							position++
 						}
-					l865:
+					l888:
 						{
-							position867, tokenIndex867 := position, tokenIndex
+							position890, tokenIndex890 := position, tokenIndex
 							if buffer[position] != rune('p') {
-								goto l868
+								goto l891
 							}
 							position++
-							goto l867
-						l868:
-							position, tokenIndex = position867, tokenIndex867
+							goto l890
+						l891:
+							position, tokenIndex = position890, tokenIndex890
 							if buffer[position] != rune('P') {
-								goto l857
+								goto l880
 							}
 							position++
 						}
-					l867:
+					l890:
 						if !_rules[rulesp]() {
-							goto l857
+							goto l880
 						}
 						{
-							position869, tokenIndex869 := position, tokenIndex
+							position892, tokenIndex892 := position, tokenIndex
 							if buffer[position] != rune('b') {
-								goto l870
+								goto l893
 							}
 							position++
-							goto l869
-						l870:
-							position, tokenIndex = position869, tokenIndex869
+							goto l892
+						l893:
+							position, tokenIndex = position892, tokenIndex892
 							if buffer[position] != rune('B') {
-								goto l857
+								goto l880
 							}
 							position++
 						}
-					l869:
+					l892:
 						{
-							position871, tokenIndex871 := position, tokenIndex
+							position894, tokenIndex894 := position, tokenIndex
 							if buffer[position] != rune('y') {
-								goto l872
+								goto l895
 							}
 							position++
-							goto l871
-						l872:
-							position, tokenIndex = position871, tokenIndex871
+							goto l894
+						l895:
+							position, tokenIndex = position894, tokenIndex894
 							if buffer[position] != rune('Y') {
-								goto l857
+								goto l880
 							}
 							position++
 						}
-					l871:
+					l894:
 						if !_rules[rulesp]() {
-							goto l857
+							goto l880
 						}
 						if !_rules[ruleGroupList]() {
-							goto l857
+							goto l880
 						}
-						goto l858
-					l857:
-						position, tokenIndex = position857, tokenIndex857
+						goto l881
+					l880:
+						position, tokenIndex = position880, tokenIndex880
 					}
-				l858:
-					add(rulePegText, position856)
+				l881:
+					add(rulePegText, position879)
 				}
-				if !_rules[ruleAction37]() {
-					goto l854
+				if !_rules[ruleAction38]() {
+					goto l877
 				}
-				add(ruleGrouping, position855)
+				add(ruleGrouping, position878)
 			}
 			return true
-		l854:
-			position, tokenIndex = position854, tokenIndex854
+		l877:
+			position, tokenIndex = position877, tokenIndex877
 			return false
 		},
-		/* 50 GroupList <- <(Expression (spOpt ',' spOpt Expression)*)> */
+		/* 52 GroupList <- <(Expression (spOpt ',' spOpt Expression)*)> */
 		func() bool {
-			position873, tokenIndex873 := position, tokenIndex
+			position896, tokenIndex896 := position, tokenIndex
 			{
-				position874 := position
+				position897 := position
 				if !_rules[ruleExpression]() {
-					goto l873
+					goto l896
 				}
-			l875:
+			l898:
 				{
-					position876, tokenIndex876 := position, tokenIndex
+					position899, tokenIndex899 := position, tokenIndex
 					if !_rules[rulespOpt]() {
-						goto l876
+						goto l899
 					}
 					if buffer[position] != rune(',') {
-						goto l876
+						goto l899
 					}
 					position++
 					if !_rules[rulespOpt]() {
-						goto l876
+						goto l899
 					}
 					if !_rules[ruleExpression]() {
-						goto l876
+						goto l899
 					}
-					goto l875
-				l876:
-					position, tokenIndex = position876, tokenIndex876
+					goto l898
+				l899:
+					position, tokenIndex = position899, tokenIndex899
 				}
-				add(ruleGroupList, position874)
+				add(ruleGroupList, position897)
 			}
 			return true
-		l873:
-			position, tokenIndex = position873, tokenIndex873
+		l896:
+			position, tokenIndex = position896, tokenIndex896
 			return false
 		},
-		/* 51 Having <- <(<(sp (('h' / 'H') ('a' / 'A') ('v' / 'V') ('i' / 'I') ('n' / 'N') ('g' / 'G')) sp Expression)?> Action38)> */
+		/* 53 Having <- <(<(sp (('h' / 'H') ('a' / 'A') ('v' / 'V') ('i' / 'I') ('n' / 'N') ('g' / 'G')) sp Expression)?> Action39)> */
 		func() bool {
-			position877, tokenIndex877 := position, tokenIndex
+			position900, tokenIndex900 := position, tokenIndex
 			{
-				position878 := position
+				position901 := position
 				{
-					position879 := position
+					position902 := position
 					{
-						position880, tokenIndex880 := position, tokenIndex
+						position903, tokenIndex903 := position, tokenIndex
 						if !_rules[rulesp]() {
-							goto l880
+							goto l903
 						}
 						{
-							position882, tokenIndex882 := position, tokenIndex
+							position905, tokenIndex905 := position, tokenIndex
 							if buffer[position] != rune('h') {
-								goto l883
+								goto l906
 							}
 							position++
-							goto l882
-						l883:
-							position, tokenIndex = position882, tokenIndex882
+							goto l905
+						l906:
+							position, tokenIndex = position905, tokenIndex905
 							if buffer[position] != rune('H') {
-								goto l880
+								goto l903
 							}
 							position++
 						}
-					l882:
+					l905:
 						{
-							position884, tokenIndex884 := position, tokenIndex
+							position907, tokenIndex907 := position, tokenIndex
 							if buffer[position] != rune('a') {
-								goto l885
+								goto l908
 							}
 							position++
-							goto l884
-						l885:
-							position, tokenIndex = position884, tokenIndex884
+							goto l907
+						l908:
+							position, tokenIndex = position907, tokenIndex907
 							if buffer[position] != rune('A') {
-								goto l880
+								goto l903
 							}
 							position++
 						}
-					l884:
+					l907:
 						{
-							position886, tokenIndex886 := position, tokenIndex
+							position909, tokenIndex909 := position, tokenIndex
 							if buffer[position] != rune('v') {
-								goto l887
+								goto l910
 							}
 							position++
-							goto l886
-						l887:
-							position, tokenIndex = position886, tokenIndex886
+							goto l909
+						l910:
+							position, tokenIndex = position909, tokenIndex909
 							if buffer[position] != rune('V') {
-								goto l880
+								goto l903
 							}
 							position++
 						}
-					l886:
+					l909:
 						{
-							position888, tokenIndex888 := position, tokenIndex
+							position911, tokenIndex911 := position, tokenIndex
 							if buffer[position] != rune('i') {
-								goto l889
+								goto l912
 							}
 							position++
-							goto l888
-						l889:
-							position, tokenIndex = position888, tokenIndex888
+							goto l911
+						l912:
+							position, tokenIndex = position911, tokenIndex911
 							if buffer[position] != rune('I') {
-								goto l880
+								goto l903
 							}
 							position++
 						}
-					l888:
+					l911:
 						{
-							position890, tokenIndex890 := position, tokenIndex
+							position913, tokenIndex913 := position, tokenIndex
 							if buffer[position] != rune('n') {
-								goto l891
+								goto l914
 							}
 							position++
-							goto l890
-						l891:
-							position, tokenIndex = position890, tokenIndex890
+							goto l913
+						l914:
+							position, tokenIndex = position913, tokenIndex913
 							if buffer[position] != rune('N') {
-								goto l880
+								goto l903
 							}
 							position++
 						}
-					l890:
+					l913:
 						{
-							position892, tokenIndex892 := position, tokenIndex
+							position915, tokenIndex915 := position, tokenIndex
 							if buffer[position] != rune('g') {
-								goto l893
+								goto l916
 							}
 							position++
-							goto l892
-						l893:
-							position, tokenIndex = position892, tokenIndex892
+							goto l915
+						l916:
+							position, tokenIndex = position915, tokenIndex915
 							if buffer[position] != rune('G') {
-								goto l880
+								goto l903
 							}
 							position++
 						}
-					l892:
+					l915:
 						if !_rules[rulesp]() {
-							goto l880
+							goto l903
 						}
 						if !_rules[ruleExpression]() {
-							goto l880
+							goto l903
 						}
-						goto l881
-					l880:
-						position, tokenIndex = position880, tokenIndex880
+						goto l904
+					l903:
+						position, tokenIndex = position903, tokenIndex903
 					}
-				l881:
-					add(rulePegText, position879)
+				l904:
+					add(rulePegText, position902)
 				}
-				if !_rules[ruleAction38]() {
-					goto l877
+				if !_rules[ruleAction39]() {
+					goto l900
 				}
-				add(ruleHaving, position878)
+				add(ruleHaving, position901)
 			}
 			return true
-		l877:
-			position, tokenIndex = position877, tokenIndex877
+		l900:
+			position, tokenIndex = position900, tokenIndex900
 			return false
 		},
-		/* 52 RelationLike <- <(AliasedStreamWindow / (StreamWindow Action39))> */
+		/* 54 RelationLike <- <(AliasedStreamWindow / (StreamWindow Action40))> */
 		func() bool {
-			position894, tokenIndex894 := position, tokenIndex
+			position917, tokenIndex917 := position, tokenIndex
 			{
-				position895 := position
+				position918 := position
 				{
-					position896, tokenIndex896 := position, tokenIndex
+					position919, tokenIndex919 := position, tokenIndex
 					if !_rules[ruleAliasedStreamWindow]() {
-						goto l897
+						goto l920
 					}
-					goto l896
-				l897:
-					position, tokenIndex = position896, tokenIndex896
+					goto l919
+				l920:
+					position, tokenIndex = position919, tokenIndex919
 					if !_rules[ruleStreamWindow]() {
-						goto l894
+						goto l917
 					}
-					if !_rules[ruleAction39]() {
-						goto l894
+					if !_rules[ruleAction40]() {
+						goto l917
 					}
 				}
-			l896:
-				add(ruleRelationLike, position895)
+			l919:
+				add(ruleRelationLike, position918)
 			}
 			return true
-		l894:
-			position, tokenIndex = position894, tokenIndex894
+		l917:
+			position, tokenIndex = position917, tokenIndex917
 			return false
 		},
-		/* 53 AliasedStreamWindow <- <(StreamWindow sp (('a' / 'A') ('s' / 'S')) sp Identifier Action40)> */
+		/* 55 AliasedStreamWindow <- <(StreamWindow sp (('a' / 'A') ('s' / 'S')) sp Identifier Action41)> */
 		func() bool {
-			position898, tokenIndex898 := position, tokenIndex
+			position921, tokenIndex921 := position, tokenIndex
 			{
-				position899 := position
+				position922 := position
 				if !_rules[ruleStreamWindow]() {
-					goto l898
+					goto l921
 				}
 				if !_rules[rulesp]() {
-					goto l898
+					goto l921
 				}
 				{
-					position900, tokenIndex900 := position, tokenIndex
+					position923, tokenIndex923 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l901
+						goto l924
 					}
 					position++
-					goto l900
-				l901:
-					position, tokenIndex = position900, tokenIndex900
+					goto l923
+				l924:
+					position, tokenIndex = position923, tokenIndex923
 					if buffer[position] != rune('A') {
-						goto l898
+						goto l921
 					}
 					position++
 				}
-			l900:
+			l923:
 				{
-					position902, tokenIndex902 := position, tokenIndex
+					position925, tokenIndex925 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l903
+						goto l926
 					}
 					position++
-					goto l902
-				l903:
-					position, tokenIndex = position902, tokenIndex902
+					goto l925
+				l926:
+					position, tokenIndex = position925, tokenIndex925
 					if buffer[position] != rune('S') {
-						goto l898
+						goto l921
 					}
 					position++
 				}
-			l902:
+			l925:
 				if !_rules[rulesp]() {
-					goto l898
+					goto l921
 				}
 				if !_rules[ruleIdentifier]() {
-					goto l898
+					goto l921
 				}
-				if !_rules[ruleAction40]() {
-					goto l898
+				if !_rules[ruleAction41]() {
+					goto l921
 				}
-				add(ruleAliasedStreamWindow, position899)
+				add(ruleAliasedStreamWindow, position922)
 			}
 			return true
-		l898:
-			position, tokenIndex = position898, tokenIndex898
+		l921:
+			position, tokenIndex = position921, tokenIndex921
 			return false
 		},
-		/* 54 StreamWindow <- <(StreamLike spOpt '[' spOpt (('r' / 'R') ('a' / 'A') ('n' / 'N') ('g' / 'G') ('e' / 'E')) sp Interval CapacitySpecOpt SheddingSpecOpt spOpt ']' Action41)> */
+		/* 56 StreamWindow <- <(StreamLike spOpt '[' spOpt (('r' / 'R') ('a' / 'A') ('n' / 'N') ('g' / 'G') ('e' / 'E')) sp Interval CapacitySpecOpt SheddingSpecOpt spOpt ']' Action42)> */
 		func() bool {
-			position904, tokenIndex904 := position, tokenIndex
+			position927, tokenIndex927 := position, tokenIndex
 			{
-				position905 := position
+				position928 := position
 				if !_rules[ruleStreamLike]() {
-					goto l904
+					goto l927
 				}
 				if !_rules[rulespOpt]() {
-					goto l904
+					goto l927
 				}
 				if buffer[position] != rune('[') {
-					goto l904
+					goto l927
 				}
 				position++
 				if !_rules[rulespOpt]() {
-					goto l904
+					goto l927
 				}
 				{
-					position906, tokenIndex906 := position, tokenIndex
+					position929, tokenIndex929 := position, tokenIndex
 					if buffer[position] != rune('r') {
-						goto l907
+						goto l930
 					}
 					position++
-					goto l906
-				l907:
-					position, tokenIndex = position906, tokenIndex906
+					goto l929
+				l930:
+					position, tokenIndex = position929, tokenIndex929
 					if buffer[position] != rune('R') {
-						goto l904
+						goto l927
 					}
 					position++
 				}
-			l906:
+			l929:
 				{
-					position908, tokenIndex908 := position, tokenIndex
+					position931, tokenIndex931 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l909
+						goto l932
 					}
 					position++
-					goto l908
-				l909:
-					position, tokenIndex = position908, tokenIndex908
+					goto l931
+				l932:
+					position, tokenIndex = position931, tokenIndex931
 					if buffer[position] != rune('A') {
-						goto l904
+						goto l927
 					}
 					position++
 				}
-			l908:
+			l931:
 				{
-					position910, tokenIndex910 := position, tokenIndex
+					position933, tokenIndex933 := position, tokenIndex
 					if buffer[position] != rune('n') {
-						goto l911
+						goto l934
 					}
 					position++
-					goto l910
-				l911:
-					position, tokenIndex = position910, tokenIndex910
+					goto l933
+				l934:
+					position, tokenIndex = position933, tokenIndex933
 					if buffer[position] != rune('N') {
-						goto l904
+						goto l927
 					}
 					position++
 				}
-			l910:
+			l933:
 				{
-					position912, tokenIndex912 := position, tokenIndex
+					position935, tokenIndex935 := position, tokenIndex
 					if buffer[position] != rune('g') {
-						goto l913
+						goto l936
 					}
 					position++
-					goto l912
-				l913:
-					position, tokenIndex = position912, tokenIndex912
+					goto l935
+				l936:
+					position, tokenIndex = position935, tokenIndex935
 					if buffer[position] != rune('G') {
-						goto l904
+						goto l927
 					}
 					position++
 				}
-			l912:
+			l935:
 				{
-					position914, tokenIndex914 := position, tokenIndex
+					position937, tokenIndex937 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l915
+						goto l938
 					}
 					position++
-					goto l914
-				l915:
-					position, tokenIndex = position914, tokenIndex914
+					goto l937
+				l938:
+					position, tokenIndex = position937, tokenIndex937
 					if buffer[position] != rune('E') {
-						goto l904
+						goto l927
 					}
 					position++
 				}
-			l914:
+			l937:
 				if !_rules[rulesp]() {
-					goto l904
+					goto l927
 				}
 				if !_rules[ruleInterval]() {
-					goto l904
+					goto l927
 				}
 				if !_rules[ruleCapacitySpecOpt]() {
-					goto l904
+					goto l927
 				}
 				if !_rules[ruleSheddingSpecOpt]() {
-					goto l904
+					goto l927
 				}
 				if !_rules[rulespOpt]() {
-					goto l904
+					goto l927
 				}
 				if buffer[position] != rune(']') {
-					goto l904
+					goto l927
 				}
 				position++
-				if !_rules[ruleAction41]() {
-					goto l904
+				if !_rules[ruleAction42]() {
+					goto l927
 				}
-				add(ruleStreamWindow, position905)
+				add(ruleStreamWindow, position928)
 			}
 			return true
-		l904:
-			position, tokenIndex = position904, tokenIndex904
+		l927:
+			position, tokenIndex = position927, tokenIndex927
 			return false
 		},
-		/* 55 StreamLike <- <(UDSFFuncApp / Stream)> */
+		/* 57 StreamLike <- <(UDSFFuncApp / Stream)> */
 		func() bool {
-			position916, tokenIndex916 := position, tokenIndex
+			position939, tokenIndex939 := position, tokenIndex
 			{
-				position917 := position
+				position940 := position
 				{
-					position918, tokenIndex918 := position, tokenIndex
+					position941, tokenIndex941 := position, tokenIndex
 					if !_rules[ruleUDSFFuncApp]() {
-						goto l919
+						goto l942
 					}
-					goto l918
-				l919:
-					position, tokenIndex = position918, tokenIndex918
+					goto l941
+				l942:
+					position, tokenIndex = position941, tokenIndex941
 					if !_rules[ruleStream]() {
-						goto l916
+						goto l939
 					}
 				}
-			l918:
-				add(ruleStreamLike, position917)
+			l941:
+				add(ruleStreamLike, position940)
 			}
 			return true
-		l916:
-			position, tokenIndex = position916, tokenIndex916
+		l939:
+			position, tokenIndex = position939, tokenIndex939
 			return false
 		},
-		/* 56 UDSFFuncApp <- <(FuncAppWithoutOrderBy Action42)> */
+		/* 58 UDSFFuncApp <- <(FuncAppWithoutOrderBy Action43)> */
 		func() bool {
-			position920, tokenIndex920 := position, tokenIndex
+			position943, tokenIndex943 := position, tokenIndex
 			{
-				position921 := position
+				position944 := position
 				if !_rules[ruleFuncAppWithoutOrderBy]() {
-					goto l920
+					goto l943
 				}
-				if !_rules[ruleAction42]() {
-					goto l920
+				if !_rules[ruleAction43]() {
+					goto l943
 				}
-				add(ruleUDSFFuncApp, position921)
+				add(ruleUDSFFuncApp, position944)
 			}
 			return true
-		l920:
-			position, tokenIndex = position920, tokenIndex920
+		l943:
+			position, tokenIndex = position943, tokenIndex943
 			return false
 		},
-		/* 57 CapacitySpecOpt <- <(<(spOpt ',' spOpt (('b' / 'B') ('u' / 'U') ('f' / 'F') ('f' / 'F') ('e' / 'E') ('r' / 'R')) sp (('s' / 'S') ('i' / 'I') ('z' / 'Z') ('e' / 'E')) sp NonNegativeNumericLiteral)?> Action43)> */
+		/* 59 CapacitySpecOpt <- <(<(spOpt ',' spOpt (('b' / 'B') ('u' / 'U') ('f' / 'F') ('f' / 'F') ('e' / 'E') ('r' / 'R')) sp (('s' / 'S') ('i' / 'I') ('z' / 'Z') ('e' / 'E')) sp NonNegativeNumericLiteral)?> Action44)> */
 		func() bool {
-			position922, tokenIndex922 := position, tokenIndex
+			position945, tokenIndex945 := position, tokenIndex
 			{
-				position923 := position
+				position946 := position
 				{
-					position924 := position
+					position947 := position
 					{
-						position925, tokenIndex925 := position, tokenIndex
+						position948, tokenIndex948 := position, tokenIndex
 						if !_rules[rulespOpt]() {
-							goto l925
+							goto l948
 						}
 						if buffer[position] != rune(',') {
-							goto l925
+							goto l948
 						}
 						position++
 						if !_rules[rulespOpt]() {
-							goto l925
+							goto l948
 						}
 						{
-							position927, tokenIndex927 := position, tokenIndex
+							position950, tokenIndex950 := position, tokenIndex
 							if buffer[position] != rune('b') {
-								goto l928
+								goto l951
 							}
 							position++
-							goto l927
-						l928:
-							position, tokenIndex = position927, tokenIndex927
+							goto l950
+						l951:
+							position, tokenIndex = position950, tokenIndex950
 							if buffer[position] != rune('B') {
-								goto l925
+								goto l948
 							}
 							position++
 						}
-					l927:
+					l950:
 						{
-							position929, tokenIndex929 := position, tokenIndex
+							position952, tokenIndex952 := position, tokenIndex
 							if buffer[position] != rune('u') {
-								goto l930
+								goto l953
 							}
 							position++
-							goto l929
-						l930:
-							position, tokenIndex = position929, tokenIndex929
+							goto l952
+						l953:
+							position, tokenIndex = position952, tokenIndex952
 							if buffer[position] != rune('U') {
-								goto l925
+								goto l948
 							}
 							position++
 						}
-					l929:
+					l952:
 						{
-							position931, tokenIndex931 := position, tokenIndex
+							position954, tokenIndex954 := position, tokenIndex
 							if buffer[position] != rune('f') {
-								goto l932
+								goto l955
 							}
 							position++
-							goto l931
-						l932:
-							position, tokenIndex = position931, tokenIndex931
+							goto l954
+						l955:
+							position, tokenIndex = position954, tokenIndex954
 							if buffer[position] != rune('F') {
-								goto l925
+								goto l948
 							}
 							position++
 						}
-					l931:
+					l954:
 						{
-							position933, tokenIndex933 := position, tokenIndex
+							position956, tokenIndex956 := position, tokenIndex
 							if buffer[position] != rune('f') {
-								goto l934
+								goto l957
 							}
 							position++
-							goto l933
-						l934:
-							position, tokenIndex = position933, tokenIndex933
+							goto l956
+						l957:
+							position, tokenIndex = position956, tokenIndex956
 							if buffer[position] != rune('F') {
-								goto l925
+								goto l948
 							}
 							position++
 						}
-					l933:
+					l956:
 						{
-							position935, tokenIndex935 := position, tokenIndex
+							position958, tokenIndex958 := position, tokenIndex
 							if buffer[position] != rune('e') {
-								goto l936
+								goto l959
 							}
 							position++
-							goto l935
-						l936:
-							position, tokenIndex = position935, tokenIndex935
+							goto l958
+						l959:
+							position, tokenIndex = position958, tokenIndex958
 							if buffer[position] != rune('E') {
-								goto l925
+								goto l948
 							}
 							position++
 						}
-					l935:
+					l958:
 						{
-							position937, tokenIndex937 := position, tokenIndex
+							position960, tokenIndex960 := position, tokenIndex
 							if buffer[position] != rune('r') {
-								goto l938
+								goto l961
 							}
 							position++
-							goto l937
-						l938:
-							position, tokenIndex = position937, tokenIndex937
+							goto l960
+						l961:
+							position, tokenIndex = position960, tokenIndex960
 							if buffer[position] != rune('R') {
-								goto l925
+								goto l948
 							}
 							position++
 						}
-					l937:
+					l960:
 						if !_rules[rulesp]() {
-							goto l925
+							goto l948
 						}
 						{
-							position939, tokenIndex939 := position, tokenIndex
+							position962, tokenIndex962 := position, tokenIndex
 							if buffer[position] != rune('s') {
-								goto l940
+								goto l963
 							}
 							position++
-							goto l939
-						l940:
-							position, tokenIndex = position939, tokenIndex939
+							goto l962
+						l963:
+							position, tokenIndex = position962, tokenIndex962
 							if buffer[position] != rune('S') {
-								goto l925
+								goto l948
 							}
 							position++
 						}
-					l939:
+					l962:
 						{
-							position941, tokenIndex941 := position, tokenIndex
+							position964, tokenIndex964 := position, tokenIndex
 							if buffer[position] != rune('i') {
-								goto l942
+								goto l965
 							}
 							position++
-							goto l941
-						l942:
-							position, tokenIndex = position941, tokenIndex941
+							goto l964
+						l965:
+							position, tokenIndex = position964, tokenIndex964
 							if buffer[position] != rune('I') {
-								goto l925
+								goto l948
 							}
 							position++
 						}
-					l941:
+					l964:
 						{
-							position943, tokenIndex943 := position, tokenIndex
+							position966, tokenIndex966 := position, tokenIndex
 							if buffer[position] != rune('z') {
-								goto l944
+								goto l967
 							}
 							position++
-							goto l943
-						l944:
-							position, tokenIndex = position943, tokenIndex943
+							goto l966
+						l967:
+							position, tokenIndex = position966, tokenIndex966
 							if buffer[position] != rune('Z') {
-								goto l925
+								goto l948
 							}
 							position++
 						}
-					l943:
+					l966:
 						{
-							position945, tokenIndex945 := position, tokenIndex
+							position968, tokenIndex968 := position, tokenIndex
 							if buffer[position] != rune('e') {
-								goto l946
+								goto l969
 							}
 							position++
-							goto l945
-						l946:
-							position, tokenIndex = position945, tokenIndex945
+							goto l968
+						l969:
+							position, tokenIndex = position968, tokenIndex968
 							if buffer[position] != rune('E') {
-								goto l925
+								goto l948
 							}
 							position++
 						}
-					l945:
+					l968:
 						if !_rules[rulesp]() {
-							goto l925
+							goto l948
 						}
 						if !_rules[ruleNonNegativeNumericLiteral]() {
-							goto l925
+							goto l948
 						}
-						goto l926
-					l925:
-						position, tokenIndex = position925, tokenIndex925
+						goto l949
+					l948:
+						position, tokenIndex = position948, tokenIndex948
 					}
-				l926:
-					add(rulePegText, position924)
+				l949:
+					add(rulePegText, position947)
 				}
-				if !_rules[ruleAction43]() {
-					goto l922
+				if !_rules[ruleAction44]() {
+					goto l945
 				}
-				add(ruleCapacitySpecOpt, position923)
+				add(ruleCapacitySpecOpt, position946)
 			}
 			return true
-		l922:
-			position, tokenIndex = position922, tokenIndex922
+		l945:
+			position, tokenIndex = position945, tokenIndex945
 			return false
 		},
-		/* 58 SheddingSpecOpt <- <(<(spOpt ',' spOpt SheddingOption sp (('i' / 'I') ('f' / 'F')) sp (('f' / 'F') ('u' / 'U') ('l' / 'L') ('l' / 'L')))?> Action44)> */
+		/* 60 SheddingSpecOpt <- <(<(spOpt ',' spOpt SheddingOption sp (('i' / 'I') ('f' / 'F')) sp (('f' / 'F') ('u' / 'U') ('l' / 'L') ('l' / 'L')))?> Action45)> */
 		func() bool {
-			position947, tokenIndex947 := position, tokenIndex
+			position970, tokenIndex970 := position, tokenIndex
 			{
-				position948 := position
+				position971 := position
 				{
-					position949 := position
+					position972 := position
 					{
-						position950, tokenIndex950 := position, tokenIndex
+						position973, tokenIndex973 := position, tokenIndex
 						if !_rules[rulespOpt]() {
-							goto l950
+							goto l973
 						}
 						if buffer[position] != rune(',') {
-							goto l950
+							goto l973
 						}
 						position++
 						if !_rules[rulespOpt]() {
-							goto l950
+							goto l973
 						}
 						if !_rules[ruleSheddingOption]() {
-							goto l950
+							goto l973
 						}
 						if !_rules[rulesp]() {
-							goto l950
+							goto l973
 						}
 						{
-							position952, tokenIndex952 := position, tokenIndex
+							position975, tokenIndex975 := position, tokenIndex
 							if buffer[position] != rune('i') {
-								goto l953
+								goto l976
 							}
 							position++
-							goto l952
-						l953:
-							position, tokenIndex = position952, tokenIndex952
+							goto l975
+						l976:
+							position, tokenIndex = position975, tokenIndex975
 							if buffer[position] != rune('I') {
-								goto l950
+								goto l973
 							}
 							position++
 						}
-					l952:
+					l975:
 						{
-							position954, tokenIndex954 := position, tokenIndex
+							position977, tokenIndex977 := position, tokenIndex
 							if buffer[position] != rune('f') {
-								goto l955
+								goto l978
 							}
 							position++
-							goto l954
-						l955:
-							position, tokenIndex = position954, tokenIndex954
+							goto l977
+						l978:
+							position, tokenIndex = position977, tokenIndex977
 							if buffer[position] != rune('F') {
-								goto l950
+								goto l973
 							}
 							position++
 						}
-					l954:
+					l977:
 						if !_rules[rulesp]() {
-							goto l950
+							goto l973
 						}
 						{
-							position956, tokenIndex956 := position, tokenIndex
+							position979, tokenIndex979 := position, tokenIndex
 							if buffer[position] != rune('f') {
-								goto l957
+								goto l980
 							}
 							position++
-							goto l956
-						l957:
-							position, tokenIndex = position956, tokenIndex956
+							goto l979
+						l980:
+							position, tokenIndex = position979, tokenIndex979
 							if buffer[position] != rune('F') {
-								goto l950
+								goto l973
 							}
 							position++
 						}
-					l956:
+					l979:
 						{
-							position958, tokenIndex958 := position, tokenIndex
+							position981, tokenIndex981 := position, tokenIndex
 							if buffer[position] != rune('u') {
-								goto l959
+								goto l982
 							}
 							position++
-							goto l958
-						l959:
-							position, tokenIndex = position958, tokenIndex958
+							goto l981
+						l982:
+							position, tokenIndex = position981, tokenIndex981
 							if buffer[position] != rune('U') {
-								goto l950
+								goto l973
 							}
 							position++
 						}
-					l958:
+					l981:
 						{
-							position960, tokenIndex960 := position, tokenIndex
+							position983, tokenIndex983 := position, tokenIndex
 							if buffer[position] != rune('l') {
-								goto l961
+								goto l984
 							}
 							position++
-							goto l960
-						l961:
-							position, tokenIndex = position960, tokenIndex960
+							goto l983
+						l984:
+							position, tokenIndex = position983, tokenIndex983
 							if buffer[position] != rune('L') {
-								goto l950
+								goto l973
 							}
 							position++
 						}
-					l960:
+					l983:
 						{
-							position962, tokenIndex962 := position, tokenIndex
+							position985, tokenIndex985 := position, tokenIndex
 							if buffer[position] != rune('l') {
-								goto l963
+								goto l986
 							}
 							position++
-							goto l962
-						l963:
-							position, tokenIndex = position962, tokenIndex962
+							goto l985
+						l986:
+							position, tokenIndex = position985, tokenIndex985
 							if buffer[position] != rune('L') {
-								goto l950
+								goto l973
 							}
 							position++
 						}
-					l962:
-						goto l951
-					l950:
-						position, tokenIndex = position950, tokenIndex950
+					l985:
+						goto l974
+					l973:
+						position, tokenIndex = position973, tokenIndex973
 					}
-				l951:
-					add(rulePegText, position949)
+				l974:
+					add(rulePegText, position972)
 				}
-				if !_rules[ruleAction44]() {
-					goto l947
+				if !_rules[ruleAction45]() {
+					goto l970
 				}
-				add(ruleSheddingSpecOpt, position948)
+				add(ruleSheddingSpecOpt, position971)
 			}
 			return true
-		l947:
-			position, tokenIndex = position947, tokenIndex947
+		l970:
+			position, tokenIndex = position970, tokenIndex970
 			return false
 		},
-		/* 59 SheddingOption <- <(Wait / DropOldest / DropNewest)> */
+		/* 61 SheddingOption <- <(Wait / DropOldest / DropNewest)> */
 		func() bool {
-			position964, tokenIndex964 := position, tokenIndex
+			position987, tokenIndex987 := position, tokenIndex
 			{
-				position965 := position
+				position988 := position
 				{
-					position966, tokenIndex966 := position, tokenIndex
+					position989, tokenIndex989 := position, tokenIndex
 					if !_rules[ruleWait]() {
-						goto l967
+						goto l990
 					}
-					goto l966
-				l967:
-					position, tokenIndex = position966, tokenIndex966
+					goto l989
+				l990:
+					position, tokenIndex = position989, tokenIndex989
 					if !_rules[ruleDropOldest]() {
-						goto l968
+						goto l991
 					}
-					goto l966
-				l968:
-					position, tokenIndex = position966, tokenIndex966
+					goto l989
+				l991:
+					position, tokenIndex = position989, tokenIndex989
 					if !_rules[ruleDropNewest]() {
-						goto l964
+						goto l987
 					}
 				}
-			l966:
-				add(ruleSheddingOption, position965)
+			l989:
+				add(ruleSheddingOption, position988)
 			}
 			return true
-		l964:
-			position, tokenIndex = position964, tokenIndex964
+		l987:
+			position, tokenIndex = position987, tokenIndex987
 			return false
 		},
-		/* 60 SourceSinkSpecs <- <(<(sp (('w' / 'W') ('i' / 'I') ('t' / 'T') ('h' / 'H')) sp SourceSinkParam (spOpt ',' spOpt SourceSinkParam)*)?> Action45)> */
+		/* 62 SourceSinkSpecs <- <(<(sp (('w' / 'W') ('i' / 'I') ('t' / 'T') ('h' / 'H')) sp SourceSinkParam (spOpt ',' spOpt SourceSinkParam)*)?> Action46)> */
 		func() bool {
-			position969, tokenIndex969 := position, tokenIndex
+			position992, tokenIndex992 := position, tokenIndex
 			{
-				position970 := position
+				position993 := position
 				{
-					position971 := position
+					position994 := position
 					{
-						position972, tokenIndex972 := position, tokenIndex
+						position995, tokenIndex995 := position, tokenIndex
 						if !_rules[rulesp]() {
-							goto l972
+							goto l995
 						}
 						{
-							position974, tokenIndex974 := position, tokenIndex
+							position997, tokenIndex997 := position, tokenIndex
 							if buffer[position] != rune('w') {
-								goto l975
+								goto l998
 							}
 							position++
-							goto l974
-						l975:
-							position, tokenIndex = position974, tokenIndex974
+							goto l997
+						l998:
+							position, tokenIndex = position997, tokenIndex997
 							if buffer[position] != rune('W') {
-								goto l972
+								goto l995
 							}
 							position++
 						}
-					l974:
+					l997:
 						{
-							position976, tokenIndex976 := position, tokenIndex
+							position999, tokenIndex999 := position, tokenIndex
 							if buffer[position] != rune('i') {
-								goto l977
+								goto l1000
 							}
 							position++
-							goto l976
-						l977:
-							position, tokenIndex = position976, tokenIndex976
+							goto l999
+						l1000:
+							position, tokenIndex = position999, tokenIndex999
 							if buffer[position] != rune('I') {
-								goto l972
+								goto l995
 							}
 							position++
 						}
-					l976:
+					l999:
 						{
-							position978, tokenIndex978 := position, tokenIndex
+							position1001, tokenIndex1001 := position, tokenIndex
 							if buffer[position] != rune('t') {
-								goto l979
+								goto l1002
 							}
 							position++
-							goto l978
-						l979:
-							position, tokenIndex = position978, tokenIndex978
+							goto l1001
+						l1002:
+							position, tokenIndex = position1001, tokenIndex1001
 							if buffer[position] != rune('T') {
-								goto l972
+								goto l995
 							}
 							position++
 						}
-					l978:
+					l1001:
 						{
-							position980, tokenIndex980 := position, tokenIndex
+							position1003, tokenIndex1003 := position, tokenIndex
 							if buffer[position] != rune('h') {
-								goto l981
+								goto l1004
 							}
 							position++
-							goto l980
-						l981:
-							position, tokenIndex = position980, tokenIndex980
+							goto l1003
+						l1004:
+							position, tokenIndex = position1003, tokenIndex1003
 							if buffer[position] != rune('H') {
-								goto l972
+								goto l995
 							}
 							position++
 						}
-					l980:
+					l1003:
 						if !_rules[rulesp]() {
-							goto l972
+							goto l995
 						}
 						if !_rules[ruleSourceSinkParam]() {
-							goto l972
+							goto l995
 						}
-					l982:
+					l1005:
 						{
-							position983, tokenIndex983 := position, tokenIndex
+							position1006, tokenIndex1006 := position, tokenIndex
 							if !_rules[rulespOpt]() {
-								goto l983
+								goto l1006
 							}
 							if buffer[position] != rune(',') {
-								goto l983
+								goto l1006
 							}
 							position++
 							if !_rules[rulespOpt]() {
-								goto l983
+								goto l1006
 							}
 							if !_rules[ruleSourceSinkParam]() {
-								goto l983
+								goto l1006
 							}
-							goto l982
-						l983:
-							position, tokenIndex = position983, tokenIndex983
+							goto l1005
+						l1006:
+							position, tokenIndex = position1006, tokenIndex1006
 						}
-						goto l973
-					l972:
-						position, tokenIndex = position972, tokenIndex972
+						goto l996
+					l995:
+						position, tokenIndex = position995, tokenIndex995
 					}
-				l973:
-					add(rulePegText, position971)
+				l996:
+					add(rulePegText, position994)
 				}
-				if !_rules[ruleAction45]() {
-					goto l969
+				if !_rules[ruleAction46]() {
+					goto l992
 				}
-				add(ruleSourceSinkSpecs, position970)
+				add(ruleSourceSinkSpecs, position993)
 			}
 			return true
-		l969:
-			position, tokenIndex = position969, tokenIndex969
+		l992:
+			position, tokenIndex = position992, tokenIndex992
 			return false
 		},
-		/* 61 UpdateSourceSinkSpecs <- <(<(sp (('s' / 'S') ('e' / 'E') ('t' / 'T')) sp SourceSinkParam (spOpt ',' spOpt SourceSinkParam)*)> Action46)> */
+		/* 63 UpdateSourceSinkSpecs <- <(<(sp (('s' / 'S') ('e' / 'E') ('t' / 'T')) sp SourceSinkParam (spOpt ',' spOpt SourceSinkParam)*)> Action47)> */
 		func() bool {
-			position984, tokenIndex984 := position, tokenIndex
+			position1007, tokenIndex1007 := position, tokenIndex
 			{
-				position985 := position
+				position1008 := position
 				{
-					position986 := position
+					position1009 := position
 					if !_rules[rulesp]() {
-						goto l984
+						goto l1007
 					}
 					{
-						position987, tokenIndex987 := position, tokenIndex
+						position1010, tokenIndex1010 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l988
+							goto l1011
 						}
 						position++
-						goto l987
-					l988:
-						position, tokenIndex = position987, tokenIndex987
+						goto l1010
+					l1011:
+						position, tokenIndex = position1010, tokenIndex1010
 						if buffer[position] != rune('S') {
-							goto l984
+							goto l1007
 						}
 						position++
 					}
-				l987:
+				l1010:
 					{
-						position989, tokenIndex989 := position, tokenIndex
+						position1012, tokenIndex1012 := position, tokenIndex
 						if buffer[position] != rune('e') {
-							goto l990
+							goto l1013
 						}
 						position++
-						goto l989
-					l990:
-						position, tokenIndex = position989, tokenIndex989
+						goto l1012
+					l1013:
+						position, tokenIndex = position1012, tokenIndex1012
 						if buffer[position] != rune('E') {
-							goto l984
+							goto l1007
 						}
 						position++
 					}
-				l989:
+				l1012:
 					{
-						position991, tokenIndex991 := position, tokenIndex
+						position1014, tokenIndex1014 := position, tokenIndex
 						if buffer[position] != rune('t') {
-							goto l992
+							goto l1015
 						}
 						position++
-						goto l991
-					l992:
-						position, tokenIndex = position991, tokenIndex991
+						goto l1014
+					l1015:
+						position, tokenIndex = position1014, tokenIndex1014
 						if buffer[position] != rune('T') {
-							goto l984
+							goto l1007
 						}
 						position++
 					}
-				l991:
+				l1014:
 					if !_rules[rulesp]() {
-						goto l984
+						goto l1007
 					}
 					if !_rules[ruleSourceSinkParam]() {
-						goto l984
+						goto l1007
 					}
-				l993:
+				l1016:
 					{
-						position994, tokenIndex994 := position, tokenIndex
+						position1017, tokenIndex1017 := position, tokenIndex
 						if !_rules[rulespOpt]() {
-							goto l994
+							goto l1017
 						}
 						if buffer[position] != rune(',') {
-							goto l994
+							goto l1017
 						}
 						position++
 						if !_rules[rulespOpt]() {
-							goto l994
+							goto l1017
 						}
 						if !_rules[ruleSourceSinkParam]() {
-							goto l994
+							goto l1017
 						}
-						goto l993
-					l994:
-						position, tokenIndex = position994, tokenIndex994
+						goto l1016
+					l1017:
+						position, tokenIndex = position1017, tokenIndex1017
 					}
-					add(rulePegText, position986)
+					add(rulePegText, position1009)
 				}
-				if !_rules[ruleAction46]() {
-					goto l984
+				if !_rules[ruleAction47]() {
+					goto l1007
 				}
-				add(ruleUpdateSourceSinkSpecs, position985)
+				add(ruleUpdateSourceSinkSpecs, position1008)
 			}
 			return true
-		l984:
-			position, tokenIndex = position984, tokenIndex984
+		l1007:
+			position, tokenIndex = position1007, tokenIndex1007
 			return false
 		},
-		/* 62 SetOptSpecs <- <(<(sp (('s' / 'S') ('e' / 'E') ('t' / 'T')) sp SourceSinkParam (spOpt ',' spOpt SourceSinkParam)*)?> Action47)> */
+		/* 64 UpdateStateMapSpecs <- <(<(sp (('s' / 'S') ('e' / 'E') ('t' / 'T')) sp ParamMapExpr)> Action48)> */
 		func() bool {
-			position995, tokenIndex995 := position, tokenIndex
+			position1018, tokenIndex1018 := position, tokenIndex
 			{
-				position996 := position
+				position1019 := position
 				{
-					position997 := position
+					position1020 := position
+					if !_rules[rulesp]() {
+						goto l1018
+					}
+					{
+						position1021, tokenIndex1021 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l1022
+						}
+						position++
+						goto l1021
+					l1022:
+						position, tokenIndex = position1021, tokenIndex1021
+						if buffer[position] != rune('S') {
+							goto l1018
+						}
+						position++
+					}
+				l1021:
+					{
+						position1023, tokenIndex1023 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l1024
+						}
+						position++
+						goto l1023
+					l1024:
+						position, tokenIndex = position1023, tokenIndex1023
+						if buffer[position] != rune('E') {
+							goto l1018
+						}
+						position++
+					}
+				l1023:
+					{
+						position1025, tokenIndex1025 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l1026
+						}
+						position++
+						goto l1025
+					l1026:
+						position, tokenIndex = position1025, tokenIndex1025
+						if buffer[position] != rune('T') {
+							goto l1018
+						}
+						position++
+					}
+				l1025:
+					if !_rules[rulesp]() {
+						goto l1018
+					}
+					if !_rules[ruleParamMapExpr]() {
+						goto l1018
+					}
+					add(rulePegText, position1020)
+				}
+				if !_rules[ruleAction48]() {
+					goto l1018
+				}
+				add(ruleUpdateStateMapSpecs, position1019)
+			}
+			return true
+		l1018:
+			position, tokenIndex = position1018, tokenIndex1018
+			return false
+		},
+		/* 65 SetOptSpecs <- <(<(sp (('s' / 'S') ('e' / 'E') ('t' / 'T')) sp SourceSinkParam (spOpt ',' spOpt SourceSinkParam)*)?> Action49)> */
+		func() bool {
+			position1027, tokenIndex1027 := position, tokenIndex
+			{
+				position1028 := position
+				{
+					position1029 := position
 					{
-						position998, tokenIndex998 := position, tokenIndex
+						position1030, tokenIndex1030 := position, tokenIndex
 						if !_rules[rulesp]() {
-							goto l998
+							goto l1030
 						}
 						{
-							position1000, tokenIndex1000 := position, tokenIndex
+							position1032, tokenIndex1032 := position, tokenIndex
 							if buffer[position] != rune('s') {
-								goto l1001
+								goto l1033
 							}
 							position++
-							goto l1000
-						l1001:
-							position, tokenIndex = position1000, tokenIndex1000
+							goto l1032
+						l1033:
+							position, tokenIndex = position1032, tokenIndex1032
 							if buffer[position] != rune('S') {
-								goto l998
+								goto l1030
 							}
 							position++
 						}
-					l1000:
+					l1032:
 						{
-							position1002, tokenIndex1002 := position, tokenIndex
+							position1034, tokenIndex1034 := position, tokenIndex
 							if buffer[position] != rune('e') {
-								goto l1003
+								goto l1035
 							}
 							position++
-							goto l1002
-						l1003:
-							position, tokenIndex = position1002, tokenIndex1002
+							goto l1034
+						l1035:
+							position, tokenIndex = position1034, tokenIndex1034
 							if buffer[position] != rune('E') {
-								goto l998
+								goto l1030
 							}
 							position++
 						}
-					l1002:
+					l1034:
 						{
-							position1004, tokenIndex1004 := position, tokenIndex
+							position1036, tokenIndex1036 := position, tokenIndex
 							if buffer[position] != rune('t') {
-								goto l1005
+								goto l1037
 							}
 							position++
-							goto l1004
-						l1005:
-							position, tokenIndex = position1004, tokenIndex1004
+							goto l1036
+						l1037:
+							position, tokenIndex = position1036, tokenIndex1036
 							if buffer[position] != rune('T') {
-								goto l998
+								goto l1030
 							}
 							position++
 						}
-					l1004:
+					l1036:
 						if !_rules[rulesp]() {
-							goto l998
+							goto l1030
 						}
 						if !_rules[ruleSourceSinkParam]() {
-							goto l998
+							goto l1030
 						}
-					l1006:
+					l1038:
 						{
-							position1007, tokenIndex1007 := position, tokenIndex
+							position1039, tokenIndex1039 := position, tokenIndex
 							if !_rules[rulespOpt]() {
-								goto l1007
+								goto l1039
 							}
 							if buffer[position] != rune(',') {
-								goto l1007
+								goto l1039
 							}
 							position++
 							if !_rules[rulespOpt]() {
-								goto l1007
+								goto l1039
 							}
 							if !_rules[ruleSourceSinkParam]() {
-								goto l1007
+								goto l1039
 							}
-							goto l1006
-						l1007:
-							position, tokenIndex = position1007, tokenIndex1007
+							goto l1038
+						l1039:
+							position, tokenIndex = position1039, tokenIndex1039
 						}
-						goto l999
-					l998:
-						position, tokenIndex = position998, tokenIndex998
+						goto l1031
+					l1030:
+						position, tokenIndex = position1030, tokenIndex1030
 					}
-				l999:
-					add(rulePegText, position997)
+				l1031:
+					add(rulePegText, position1029)
 				}
-				if !_rules[ruleAction47]() {
-					goto l995
+				if !_rules[ruleAction49]() {
+					goto l1027
 				}
-				add(ruleSetOptSpecs, position996)
+				add(ruleSetOptSpecs, position1028)
 			}
 			return true
-		l995:
-			position, tokenIndex = position995, tokenIndex995
+		l1027:
+			position, tokenIndex = position1027, tokenIndex1027
 			return false
 		},
-		/* 63 StateTagOpt <- <(<(sp (('t' / 'T') ('a' / 'A') ('g' / 'G')) sp Identifier)?> Action48)> */
+		/* 66 StateTagOpt <- <(<(sp (('t' / 'T') ('a' / 'A') ('g' / 'G')) sp Identifier)?> Action50)> */
 		func() bool {
-			position1008, tokenIndex1008 := position, tokenIndex
+			position1040, tokenIndex1040 := position, tokenIndex
 			{
-				position1009 := position
+				position1041 := position
 				{
-					position1010 := position
+					position1042 := position
 					{
-						position1011, tokenIndex1011 := position, tokenIndex
+						position1043, tokenIndex1043 := position, tokenIndex
 						if !_rules[rulesp]() {
-							goto l1011
+							goto l1043
 						}
 						{
-							position1013, tokenIndex1013 := position, tokenIndex
+							position1045, tokenIndex1045 := position, tokenIndex
 							if buffer[position] != rune('t') {
-								goto l1014
+								goto l1046
 							}
 							position++
-							goto l1013
-						l1014:
-							position, tokenIndex = position1013, tokenIndex1013
+							goto l1045
+						l1046:
+							position, tokenIndex = position1045, tokenIndex1045
 							if buffer[position] != rune('T') {
-								goto l1011
+								goto l1043
 							}
 							position++
 						}
-					l1013:
+					l1045:
 						{
-							position1015, tokenIndex1015 := position, tokenIndex
+							position1047, tokenIndex1047 := position, tokenIndex
 							if buffer[position] != rune('a') {
-								goto l1016
+								goto l1048
 							}
 							position++
-							goto l1015
-						l1016:
-							position, tokenIndex = position1015, tokenIndex1015
+							goto l1047
+						l1048:
+							position, tokenIndex = position1047, tokenIndex1047
 							if buffer[position] != rune('A') {
-								goto l1011
+								goto l1043
 							}
 							position++
 						}
-					l1015:
+					l1047:
 						{
-							position1017, tokenIndex1017 := position, tokenIndex
+							position1049, tokenIndex1049 := position, tokenIndex
 							if buffer[position] != rune('g') {
-								goto l1018
+								goto l1050
 							}
 							position++
-							goto l1017
-						l1018:
-							position, tokenIndex = position1017, tokenIndex1017
+							goto l1049
+						l1050:
+							position, tokenIndex = position1049, tokenIndex1049
 							if buffer[position] != rune('G') {
-								goto l1011
+								goto l1043
 							}
 							position++
 						}
-					l1017:
+					l1049:
 						if !_rules[rulesp]() {
-							goto l1011
+							goto l1043
 						}
 						if !_rules[ruleIdentifier]() {
-							goto l1011
+							goto l1043
 						}
-						goto l1012
-					l1011:
-						position, tokenIndex = position1011, tokenIndex1011
+						goto l1044
+					l1043:
+						position, tokenIndex = position1043, tokenIndex1043
 					}
-				l1012:
-					add(rulePegText, position1010)
+				l1044:
+					add(rulePegText, position1042)
 				}
-				if !_rules[ruleAction48]() {
-					goto l1008
+				if !_rules[ruleAction50]() {
+					goto l1040
 				}
-				add(ruleStateTagOpt, position1009)
+				add(ruleStateTagOpt, position1041)
 			}
 			return true
-		l1008:
-			position, tokenIndex = position1008, tokenIndex1008
+		l1040:
+			position, tokenIndex = position1040, tokenIndex1040
 			return false
 		},
-		/* 64 SourceSinkParam <- <(SourceSinkParamKey spOpt '=' spOpt SourceSinkParamVal Action49)> */
+		/* 67 SourceSinkParam <- <(SourceSinkParamKey spOpt '=' spOpt SourceSinkParamVal Action51)> */
 		func() bool {
-			position1019, tokenIndex1019 := position, tokenIndex
+			position1051, tokenIndex1051 := position, tokenIndex
 			{
-				position1020 := position
+				position1052 := position
 				if !_rules[ruleSourceSinkParamKey]() {
-					goto l1019
+					goto l1051
 				}
 				if !_rules[rulespOpt]() {
-					goto l1019
+					goto l1051
 				}
 				if buffer[position] != rune('=') {
-					goto l1019
+					goto l1051
 				}
 				position++
 				if !_rules[rulespOpt]() {
-					goto l1019
+					goto l1051
 				}
 				if !_rules[ruleSourceSinkParamVal]() {
-					goto l1019
+					goto l1051
 				}
-				if !_rules[ruleAction49]() {
-					goto l1019
+				if !_rules[ruleAction51]() {
+					goto l1051
 				}
-				add(ruleSourceSinkParam, position1020)
+				add(ruleSourceSinkParam, position1052)
 			}
 			return true
-		l1019:
-			position, tokenIndex = position1019, tokenIndex1019
+		l1051:
+			position, tokenIndex = position1051, tokenIndex1051
 			return false
 		},
-		/* 65 SourceSinkParamVal <- <ParamLiteral> */
+		/* 68 SourceSinkParamVal <- <ParamLiteral> */
 		func() bool {
-			position1021, tokenIndex1021 := position, tokenIndex
+			position1053, tokenIndex1053 := position, tokenIndex
 			{
-				position1022 := position
+				position1054 := position
 				if !_rules[ruleParamLiteral]() {
-					goto l1021
+					goto l1053
 				}
-				add(ruleSourceSinkParamVal, position1022)
+				add(ruleSourceSinkParamVal, position1054)
 			}
 			return true
-		l1021:
-			position, tokenIndex = position1021, tokenIndex1021
+		l1053:
+			position, tokenIndex = position1053, tokenIndex1053
 			return false
 		},
-		/* 66 ParamLiteral <- <(BooleanLiteral / Literal / ParamArrayExpr / ParamMapExpr)> */
+		/* 69 ParamLiteral <- <(BooleanLiteral / IntervalLiteral / Literal / ParamArrayExpr / ParamMapExpr)> */
 		func() bool {
-			position1023, tokenIndex1023 := position, tokenIndex
+			position1055, tokenIndex1055 := position, tokenIndex
 			{
-				position1024 := position
+				position1056 := position
 				{
-					position1025, tokenIndex1025 := position, tokenIndex
+					position1057, tokenIndex1057 := position, tokenIndex
 					if !_rules[ruleBooleanLiteral]() {
-						goto l1026
+						goto l1058
+					}
+					goto l1057
+				l1058:
+					position, tokenIndex = position1057, tokenIndex1057
+					if !_rules[ruleIntervalLiteral]() {
+						goto l1059
 					}
-					goto l1025
-				l1026:
-					position, tokenIndex = position1025, tokenIndex1025
+					goto l1057
+				l1059:
+					position, tokenIndex = position1057, tokenIndex1057
 					if !_rules[ruleLiteral]() {
-						goto l1027
+						goto l1060
 					}
-					goto l1025
-				l1027:
-					position, tokenIndex = position1025, tokenIndex1025
+					goto l1057
+				l1060:
+					position, tokenIndex = position1057, tokenIndex1057
 					if !_rules[ruleParamArrayExpr]() {
-						goto l1028
+						goto l1061
 					}
-					goto l1025
-				l1028:
-					position, tokenIndex = position1025, tokenIndex1025
+					goto l1057
+				l1061:
+					position, tokenIndex = position1057, tokenIndex1057
 					if !_rules[ruleParamMapExpr]() {
-						goto l1023
+						goto l1055
 					}
 				}
-			l1025:
-				add(ruleParamLiteral, position1024)
+			l1057:
+				add(ruleParamLiteral, position1056)
 			}
 			return true
-		l1023:
-			position, tokenIndex = position1023, tokenIndex1023
+		l1055:
+			position, tokenIndex = position1055, tokenIndex1055
 			return false
 		},
-		/* 67 ParamArrayExpr <- <(<('[' spOpt (ParamLiteral (',' spOpt ParamLiteral)*)? spOpt ','? spOpt ']')> Action50)> */
+		/* 70 ParamArrayExpr <- <(<('[' spOpt (ParamLiteral (',' spOpt ParamLiteral)*)? spOpt ','? spOpt ']')> Action52)> */
 		func() bool {
-			position1029, tokenIndex1029 := position, tokenIndex
+			position1062, tokenIndex1062 := position, tokenIndex
 			{
-				position1030 := position
+				position1063 := position
 				{
-					position1031 := position
+					position1064 := position
 					if buffer[position] != rune('[') {
-						goto l1029
+						goto l1062
 					}
 					position++
 					if !_rules[rulespOpt]() {
-						goto l1029
+						goto l1062
 					}
 					{
-						position1032, tokenIndex1032 := position, tokenIndex
+						position1065, tokenIndex1065 := position, tokenIndex
 						if !_rules[ruleParamLiteral]() {
-							goto l1032
+							goto l1065
 						}
-					l1034:
+					l1067:
 						{
-							position1035, tokenIndex1035 := position, tokenIndex
+							position1068, tokenIndex1068 := position, tokenIndex
 							if buffer[position] != rune(',') {
-								goto l1035
+								goto l1068
 							}
 							position++
 							if !_rules[rulespOpt]() {
-								goto l1035
+								goto l1068
 							}
 							if !_rules[ruleParamLiteral]() {
-								goto l1035
+								goto l1068
 							}
-							goto l1034
-						l1035:
-							position, tokenIndex = position1035, tokenIndex1035
+							goto l1067
+						l1068:
+							position, tokenIndex = position1068, tokenIndex1068
 						}
-						goto l1033
-					l1032:
-						position, tokenIndex = position1032, tokenIndex1032
+						goto l1066
+					l1065:
+						position, tokenIndex = position1065, tokenIndex1065
 					}
-				l1033:
+				l1066:
 					if !_rules[rulespOpt]() {
-						goto l1029
+						goto l1062
 					}
 					{
-						position1036, tokenIndex1036 := position, tokenIndex
+						position1069, tokenIndex1069 := position, tokenIndex
 						if buffer[position] != rune(',') {
-							goto l1036
+							goto l1069
 						}
 						position++
-						goto l1037
-					l1036:
-						position, tokenIndex = position1036, tokenIndex1036
+						goto l1070
+					l1069:
+						position, tokenIndex = position1069, tokenIndex1069
 					}
-				l1037:
+				l1070:
 					if !_rules[rulespOpt]() {
-						goto l1029
+						goto l1062
 					}
 					if buffer[position] != rune(']') {
-						goto l1029
+						goto l1062
 					}
 					position++
-					add(rulePegText, position1031)
+					add(rulePegText, position1064)
 				}
-				if !_rules[ruleAction50]() {
-					goto l1029
+				if !_rules[ruleAction52]() {
+					goto l1062
 				}
-				add(ruleParamArrayExpr, position1030)
+				add(ruleParamArrayExpr, position1063)
 			}
 			return true
-		l1029:
-			position, tokenIndex = position1029, tokenIndex1029
+		l1062:
+			position, tokenIndex = position1062, tokenIndex1062
 			return false
 		},
-		/* 68 ParamMapExpr <- <(<('{' spOpt (ParamKeyValuePair (spOpt ',' spOpt ParamKeyValuePair)*)? spOpt '}')> Action51)> */
+		/* 71 ParamMapExpr <- <(<('{' spOpt (ParamKeyValuePair (spOpt ',' spOpt ParamKeyValuePair)*)? spOpt '}')> Action53)> */
 		func() bool {
-			position1038, tokenIndex1038 := position, tokenIndex
+			position1071, tokenIndex1071 := position, tokenIndex
 			{
-				position1039 := position
+				position1072 := position
 				{
-					position1040 := position
+					position1073 := position
 					if buffer[position] != rune('{') {
-						goto l1038
+						goto l1071
 					}
 					position++
 					if !_rules[rulespOpt]() {
-						goto l1038
+						goto l1071
 					}
 					{
-						position1041, tokenIndex1041 := position, tokenIndex
+						position1074, tokenIndex1074 := position, tokenIndex
 						if !_rules[ruleParamKeyValuePair]() {
-							goto l1041
+							goto l1074
 						}
-					l1043:
+					l1076:
 						{
-							position1044, tokenIndex1044 := position, tokenIndex
+							position1077, tokenIndex1077 := position, tokenIndex
 							if !_rules[rulespOpt]() {
-								goto l1044
+								goto l1077
 							}
 							if buffer[position] != rune(',') {
-								goto l1044
+								goto l1077
 							}
 							position++
 							if !_rules[rulespOpt]() {
-								goto l1044
+								goto l1077
 							}
 							if !_rules[ruleParamKeyValuePair]() {
-								goto l1044
+								goto l1077
 							}
-							goto l1043
-						l1044:
-							position, tokenIndex = position1044, tokenIndex1044
+							goto l1076
+						l1077:
+							position, tokenIndex = position1077, tokenIndex1077
 						}
-						goto l1042
-					l1041:
-						position, tokenIndex = position1041, tokenIndex1041
+						goto l1075
+					l1074:
+						position, tokenIndex = position1074, tokenIndex1074
 					}
-				l1042:
+				l1075:
 					if !_rules[rulespOpt]() {
-						goto l1038
+						goto l1071
 					}
 					if buffer[position] != rune('}') {
-						goto l1038
+						goto l1071
 					}
 					position++
-					add(rulePegText, position1040)
+					add(rulePegText, position1073)
 				}
-				if !_rules[ruleAction51]() {
-					goto l1038
+				if !_rules[ruleAction53]() {
+					goto l1071
 				}
-				add(ruleParamMapExpr, position1039)
+				add(ruleParamMapExpr, position1072)
 			}
 			return true
-		l1038:
-			position, tokenIndex = position1038, tokenIndex1038
+		l1071:
+			position, tokenIndex = position1071, tokenIndex1071
 			return false
 		},
-		/* 69 ParamKeyValuePair <- <(<(StringLiteral spOpt ':' spOpt ParamLiteral)> Action52)> */
+		/* 72 ParamKeyValuePair <- <(<(StringLiteral spOpt ':' spOpt ParamLiteral)> Action54)> */
 		func() bool {
-			position1045, tokenIndex1045 := position, tokenIndex
+			position1078, tokenIndex1078 := position, tokenIndex
 			{
-				position1046 := position
+				position1079 := position
 				{
-					position1047 := position
+					position1080 := position
 					if !_rules[ruleStringLiteral]() {
-						goto l1045
+						goto l1078
 					}
 					if !_rules[rulespOpt]() {
-						goto l1045
+						goto l1078
 					}
 					if buffer[position] != rune(':') {
-						goto l1045
+						goto l1078
 					}
 					position++
 					if !_rules[rulespOpt]() {
-						goto l1045
+						goto l1078
 					}
 					if !_rules[ruleParamLiteral]() {
-						goto l1045
+						goto l1078
 					}
-					add(rulePegText, position1047)
+					add(rulePegText, position1080)
 				}
-				if !_rules[ruleAction52]() {
-					goto l1045
+				if !_rules[ruleAction54]() {
+					goto l1078
 				}
-				add(ruleParamKeyValuePair, position1046)
+				add(ruleParamKeyValuePair, position1079)
 			}
 			return true
-		l1045:
-			position, tokenIndex = position1045, tokenIndex1045
+		l1078:
+			position, tokenIndex = position1078, tokenIndex1078
 			return false
 		},
-		/* 70 PausedOpt <- <(<(sp (Paused / Unpaused))?> Action53)> */
+		/* 73 PausedOpt <- <(<(sp (Paused / Unpaused))?> Action55)> */
 		func() bool {
-			position1048, tokenIndex1048 := position, tokenIndex
+			position1081, tokenIndex1081 := position, tokenIndex
 			{
-				position1049 := position
+				position1082 := position
 				{
-					position1050 := position
+					position1083 := position
 					{
-						position1051, tokenIndex1051 := position, tokenIndex
+						position1084, tokenIndex1084 := position, tokenIndex
 						if !_rules[rulesp]() {
-							goto l1051
+							goto l1084
 						}
 						{
-							position1053, tokenIndex1053 := position, tokenIndex
+							position1086, tokenIndex1086 := position, tokenIndex
 							if !_rules[rulePaused]() {
-								goto l1054
+								goto l1087
 							}
-							goto l1053
-						l1054:
-							position, tokenIndex = position1053, tokenIndex1053
+							goto l1086
+						l1087:
+							position, tokenIndex = position1086, tokenIndex1086
 							if !_rules[ruleUnpaused]() {
-								goto l1051
+								goto l1084
 							}
 						}
-					l1053:
-						goto l1052
-					l1051:
-						position, tokenIndex = position1051, tokenIndex1051
+					l1086:
+						goto l1085
+					l1084:
+						position, tokenIndex = position1084, tokenIndex1084
 					}
-				l1052:
-					add(rulePegText, position1050)
+				l1085:
+					add(rulePegText, position1083)
 				}
-				if !_rules[ruleAction53]() {
-					goto l1048
+				if !_rules[ruleAction55]() {
+					goto l1081
 				}
-				add(rulePausedOpt, position1049)
+				add(rulePausedOpt, position1082)
 			}
 			return true
-		l1048:
-			position, tokenIndex = position1048, tokenIndex1048
+		l1081:
+			position, tokenIndex = position1081, tokenIndex1081
 			return false
 		},
-		/* 71 ExpressionOrWildcard <- <(Wildcard / Expression)> */
+		/* 74 ExpressionOrWildcard <- <(Wildcard / Expression)> */
 		func() bool {
-			position1055, tokenIndex1055 := position, tokenIndex
+			position1088, tokenIndex1088 := position, tokenIndex
 			{
-				position1056 := position
+				position1089 := position
 				{
-					position1057, tokenIndex1057 := position, tokenIndex
+					position1090, tokenIndex1090 := position, tokenIndex
 					if !_rules[ruleWildcard]() {
-						goto l1058
+						goto l1091
 					}
-					goto l1057
-				l1058:
-					position, tokenIndex = position1057, tokenIndex1057
+					goto l1090
+				l1091:
+					position, tokenIndex = position1090, tokenIndex1090
 					if !_rules[ruleExpression]() {
-						goto l1055
+						goto l1088
 					}
 				}
-			l1057:
-				add(ruleExpressionOrWildcard, position1056)
+			l1090:
+				add(ruleExpressionOrWildcard, position1089)
 			}
 			return true
-		l1055:
-			position, tokenIndex = position1055, tokenIndex1055
+		l1088:
+			position, tokenIndex = position1088, tokenIndex1088
 			return false
 		},
-		/* 72 Expression <- <orExpr> */
+		/* 75 Expression <- <orExpr> */
 		func() bool {
-			position1059, tokenIndex1059 := position, tokenIndex
+			position1092, tokenIndex1092 := position, tokenIndex
 			{
-				position1060 := position
+				position1093 := position
 				if !_rules[ruleorExpr]() {
-					goto l1059
+					goto l1092
 				}
-				add(ruleExpression, position1060)
+				add(ruleExpression, position1093)
 			}
 			return true
-		l1059:
-			position, tokenIndex = position1059, tokenIndex1059
+		l1092:
+			position, tokenIndex = position1092, tokenIndex1092
 			return false
 		},
-		/* 73 orExpr <- <(<(andExpr (sp Or sp andExpr)*)> Action54)> */
+		/* 76 orExpr <- <(<(andExpr (sp Or sp andExpr)*)> Action56)> */
 		func() bool {
-			position1061, tokenIndex1061 := position, tokenIndex
+			position1094, tokenIndex1094 := position, tokenIndex
 			{
-				position1062 := position
+				position1095 := position
 				{
-					position1063 := position
+					position1096 := position
 					if !_rules[ruleandExpr]() {
-						goto l1061
+						goto l1094
 					}
-				l1064:
+				l1097:
 					{
-						position1065, tokenIndex1065 := position, tokenIndex
+						position1098, tokenIndex1098 := position, tokenIndex
 						if !_rules[rulesp]() {
-							goto l1065
+							goto l1098
 						}
 						if !_rules[ruleOr]() {
-							goto l1065
+							goto l1098
 						}
 						if !_rules[rulesp]() {
-							goto l1065
+							goto l1098
 						}
 						if !_rules[ruleandExpr]() {
-							goto l1065
+							goto l1098
 						}
-						goto l1064
-					l1065:
-						position, tokenIndex = position1065, tokenIndex1065
+						goto l1097
+					l1098:
+						position, tokenIndex = position1098, tokenIndex1098
 					}
-					add(rulePegText, position1063)
+					add(rulePegText, position1096)
 				}
-				if !_rules[ruleAction54]() {
-					goto l1061
+				if !_rules[ruleAction56]() {
+					goto l1094
 				}
-				add(ruleorExpr, position1062)
+				add(ruleorExpr, position1095)
 			}
 			return true
-		l1061:
-			position, tokenIndex = position1061, tokenIndex1061
+		l1094:
+			position, tokenIndex = position1094, tokenIndex1094
 			return false
 		},
-		/* 74 andExpr <- <(<(notExpr (sp And sp notExpr)*)> Action55)> */
+		/* 77 andExpr <- <(<(notExpr (sp And sp notExpr)*)> Action57)> */
 		func() bool {
-			position1066, tokenIndex1066 := position, tokenIndex
+			position1099, tokenIndex1099 := position, tokenIndex
 			{
-				position1067 := position
+				position1100 := position
 				{
-					position1068 := position
+					position1101 := position
 					if !_rules[rulenotExpr]() {
-						goto l1066
+						goto l1099
 					}
-				l1069:
+				l1102:
 					{
-						position1070, tokenIndex1070 := position, tokenIndex
+						position1103, tokenIndex1103 := position, tokenIndex
 						if !_rules[rulesp]() {
-							goto l1070
+							goto l1103
 						}
 						if !_rules[ruleAnd]() {
-							goto l1070
+							goto l1103
 						}
 						if !_rules[rulesp]() {
-							goto l1070
+							goto l1103
 						}
 						if !_rules[rulenotExpr]() {
-							goto l1070
+							goto l1103
 						}
-						goto l1069
-					l1070:
-						position, tokenIndex = position1070, tokenIndex1070
+						goto l1102
+					l1103:
+						position, tokenIndex = position1103, tokenIndex1103
 					}
-					add(rulePegText, position1068)
+					add(rulePegText, position1101)
 				}
-				if !_rules[ruleAction55]() {
-					goto l1066
+				if !_rules[ruleAction57]() {
+					goto l1099
 				}
-				add(ruleandExpr, position1067)
+				add(ruleandExpr, position1100)
 			}
 			return true
-		l1066:
-			position, tokenIndex = position1066, tokenIndex1066
+		l1099:
+			position, tokenIndex = position1099, tokenIndex1099
 			return false
 		},
-		/* 75 notExpr <- <(<((Not sp)? comparisonExpr)> Action56)> */
+		/* 78 notExpr <- <(<((Not sp)? comparisonExpr)> Action58)> */
 		func() bool {
-			position1071, tokenIndex1071 := position, tokenIndex
+			position1104, tokenIndex1104 := position, tokenIndex
 			{
-				position1072 := position
+				position1105 := position
 				{
-					position1073 := position
+					position1106 := position
 					{
-						position1074, tokenIndex1074 := position, tokenIndex
+						position1107, tokenIndex1107 := position, tokenIndex
 						if !_rules[ruleNot]() {
-							goto l1074
+							goto l1107
 						}
 						if !_rules[rulesp]() {
-							goto l1074
+							goto l1107
 						}
-						goto l1075
-					l1074:
-						position, tokenIndex = position1074, tokenIndex1074
+						goto l1108
+					l1107:
+						position, tokenIndex = position1107, tokenIndex1107
 					}
-				l1075:
+				l1108:
 					if !_rules[rulecomparisonExpr]() {
-						goto l1071
+						goto l1104
 					}
-					add(rulePegText, position1073)
+					add(rulePegText, position1106)
 				}
-				if !_rules[ruleAction56]() {
-					goto l1071
+				if !_rules[ruleAction58]() {
+					goto l1104
 				}
-				add(rulenotExpr, position1072)
+				add(rulenotExpr, position1105)
 			}
 			return true
-		l1071:
-			position, tokenIndex = position1071, tokenIndex1071
+		l1104:
+			position, tokenIndex = position1104, tokenIndex1104
 			return false
 		},
-		/* 76 comparisonExpr <- <(<(otherOpExpr (spOpt ComparisonOp spOpt otherOpExpr)?)> Action57)> */
+		/* 79 comparisonExpr <- <(<(inExpr (spOpt ComparisonOp spOpt inExpr)?)> Action59)> */
 		func() bool {
-			position1076, tokenIndex1076 := position, tokenIndex
+			position1109, tokenIndex1109 := position, tokenIndex
 			{
-				position1077 := position
+				position1110 := position
 				{
-					position1078 := position
-					if !_rules[ruleotherOpExpr]() {
-						goto l1076
+					position1111 := position
+					if !_rules[ruleinExpr]() {
+						goto l1109
 					}
 					{
-						position1079, tokenIndex1079 := position, tokenIndex
+						position1112, tokenIndex1112 := position, tokenIndex
 						if !_rules[rulespOpt]() {
-							goto l1079
+							goto l1112
 						}
 						if !_rules[ruleComparisonOp]() {
-							goto l1079
+							goto l1112
 						}
 						if !_rules[rulespOpt]() {
-							goto l1079
+							goto l1112
 						}
-						if !_rules[ruleotherOpExpr]() {
-							goto l1079
+						if !_rules[ruleinExpr]() {
+							goto l1112
 						}
-						goto l1080
-					l1079:
-						position, tokenIndex = position1079, tokenIndex1079
+						goto l1113
+					l1112:
+						position, tokenIndex = position1112, tokenIndex1112
 					}
-				l1080:
-					add(rulePegText, position1078)
+				l1113:
+					add(rulePegText, position1111)
 				}
-				if !_rules[ruleAction57]() {
-					goto l1076
+				if !_rules[ruleAction59]() {
+					goto l1109
 				}
-				add(rulecomparisonExpr, position1077)
+				add(rulecomparisonExpr, position1110)
 			}
 			return true
-		l1076:
-			position, tokenIndex = position1076, tokenIndex1076
+		l1109:
+			position, tokenIndex = position1109, tokenIndex1109
 			return false
 		},
-		/* 77 otherOpExpr <- <(<(isExpr (spOpt OtherOp spOpt isExpr)*)> Action58)> */
+		/* 80 inExpr <- <(<(otherOpExpr (sp InOp spOpt InList)?)> Action60)> */
 		func() bool {
-			position1081, tokenIndex1081 := position, tokenIndex
+			position1114, tokenIndex1114 := position, tokenIndex
 			{
-				position1082 := position
+				position1115 := position
 				{
-					position1083 := position
+					position1116 := position
+					if !_rules[ruleotherOpExpr]() {
+						goto l1114
+					}
+					{
+						position1117, tokenIndex1117 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l1117
+						}
+						if !_rules[ruleInOp]() {
+							goto l1117
+						}
+						if !_rules[rulespOpt]() {
+							goto l1117
+						}
+						if !_rules[ruleInList]() {
+							goto l1117
+						}
+						goto l1118
+					l1117:
+						position, tokenIndex = position1117, tokenIndex1117
+					}
+				l1118:
+					add(rulePegText, position1116)
+				}
+				if !_rules[ruleAction60]() {
+					goto l1114
+				}
+				add(ruleinExpr, position1115)
+			}
+			return true
+		l1114:
+			position, tokenIndex = position1114, tokenIndex1114
+			return false
+		},
+		/* 81 otherOpExpr <- <(<(isExpr (spOpt OtherOp spOpt isExpr)*)> Action61)> */
+		func() bool {
+			position1119, tokenIndex1119 := position, tokenIndex
+			{
+				position1120 := position
+				{
+					position1121 := position
 					if !_rules[ruleisExpr]() {
-						goto l1081
+						goto l1119
 					}
-				l1084:
+				l1122:
 					{
-						position1085, tokenIndex1085 := position, tokenIndex
+						position1123, tokenIndex1123 := position, tokenIndex
 						if !_rules[rulespOpt]() {
-							goto l1085
+							goto l1123
 						}
 						if !_rules[ruleOtherOp]() {
-							goto l1085
+							goto l1123
 						}
 						if !_rules[rulespOpt]() {
-							goto l1085
+							goto l1123
 						}
 						if !_rules[ruleisExpr]() {
-							goto l1085
+							goto l1123
 						}
-						goto l1084
-					l1085:
-						position, tokenIndex = position1085, tokenIndex1085
+						goto l1122
+					l1123:
+						position, tokenIndex = position1123, tokenIndex1123
 					}
-					add(rulePegText, position1083)
+					add(rulePegText, position1121)
 				}
-				if !_rules[ruleAction58]() {
-					goto l1081
+				if !_rules[ruleAction61]() {
+					goto l1119
 				}
-				add(ruleotherOpExpr, position1082)
+				add(ruleotherOpExpr, position1120)
 			}
 			return true
-		l1081:
-			position, tokenIndex = position1081, tokenIndex1081
+		l1119:
+			position, tokenIndex = position1119, tokenIndex1119
 			return false
 		},
-		/* 78 isExpr <- <(<((RowValue sp IsOp sp Missing) / (termExpr (sp IsOp sp NullLiteral)?))> Action59)> */
+		/* 82 isExpr <- <(<((RowValue sp IsOp sp Missing) / (termExpr ((sp IsOp sp NullLiteral) / (sp DistinctFromOp sp termExpr))?))> Action62)> */
 		func() bool {
-			position1086, tokenIndex1086 := position, tokenIndex
+			position1124, tokenIndex1124 := position, tokenIndex
 			{
-				position1087 := position
+				position1125 := position
 				{
-					position1088 := position
+					position1126 := position
 					{
-						position1089, tokenIndex1089 := position, tokenIndex
+						position1127, tokenIndex1127 := position, tokenIndex
 						if !_rules[ruleRowValue]() {
-							goto l1090
+							goto l1128
 						}
 						if !_rules[rulesp]() {
-							goto l1090
+							goto l1128
 						}
 						if !_rules[ruleIsOp]() {
-							goto l1090
+							goto l1128
 						}
 						if !_rules[rulesp]() {
-							goto l1090
+							goto l1128
 						}
 						if !_rules[ruleMissing]() {
-							goto l1090
+							goto l1128
 						}
-						goto l1089
-					l1090:
-						position, tokenIndex = position1089, tokenIndex1089
+						goto l1127
+					l1128:
+						position, tokenIndex = position1127, tokenIndex1127
 						if !_rules[ruletermExpr]() {
-							goto l1086
+							goto l1124
 						}
 						{
-							position1091, tokenIndex1091 := position, tokenIndex
-							if !_rules[rulesp]() {
-								goto l1091
-							}
-							if !_rules[ruleIsOp]() {
-								goto l1091
-							}
-							if !_rules[rulesp]() {
-								goto l1091
-							}
-							if !_rules[ruleNullLiteral]() {
-								goto l1091
+							position1129, tokenIndex1129 := position, tokenIndex
+							{
+								position1131, tokenIndex1131 := position, tokenIndex
+								if !_rules[rulesp]() {
+									goto l1132
+								}
+								if !_rules[ruleIsOp]() {
+									goto l1132
+								}
+								if !_rules[rulesp]() {
+									goto l1132
+								}
+								if !_rules[ruleNullLiteral]() {
+									goto l1132
+								}
+								goto l1131
+							l1132:
+								position, tokenIndex = position1131, tokenIndex1131
+								if !_rules[rulesp]() {
+									goto l1129
+								}
+								if !_rules[ruleDistinctFromOp]() {
+									goto l1129
+								}
+								if !_rules[rulesp]() {
+									goto l1129
+								}
+								if !_rules[ruletermExpr]() {
+									goto l1129
+								}
 							}
-							goto l1092
-						l1091:
-							position, tokenIndex = position1091, tokenIndex1091
+						l1131:
+							goto l1130
+						l1129:
+							position, tokenIndex = position1129, tokenIndex1129
 						}
-					l1092:
+					l1130:
 					}
-				l1089:
-					add(rulePegText, position1088)
+				l1127:
+					add(rulePegText, position1126)
 				}
-				if !_rules[ruleAction59]() {
-					goto l1086
+				if !_rules[ruleAction62]() {
+					goto l1124
 				}
-				add(ruleisExpr, position1087)
+				add(ruleisExpr, position1125)
 			}
 			return true
-		l1086:
-			position, tokenIndex = position1086, tokenIndex1086
+		l1124:
+			position, tokenIndex = position1124, tokenIndex1124
 			return false
 		},
-		/* 79 termExpr <- <(<(productExpr (spOpt PlusMinusOp spOpt productExpr)*)> Action60)> */
+		/* 83 termExpr <- <(<(productExpr (spOpt PlusMinusOp spOpt productExpr)*)> Action63)> */
 		func() bool {
-			position1093, tokenIndex1093 := position, tokenIndex
+			position1133, tokenIndex1133 := position, tokenIndex
 			{
-				position1094 := position
+				position1134 := position
 				{
-					position1095 := position
+					position1135 := position
 					if !_rules[ruleproductExpr]() {
-						goto l1093
+						goto l1133
 					}
-				l1096:
+				l1136:
 					{
-						position1097, tokenIndex1097 := position, tokenIndex
+						position1137, tokenIndex1137 := position, tokenIndex
 						if !_rules[rulespOpt]() {
-							goto l1097
+							goto l1137
 						}
 						if !_rules[rulePlusMinusOp]() {
-							goto l1097
+							goto l1137
 						}
 						if !_rules[rulespOpt]() {
-							goto l1097
+							goto l1137
 						}
 						if !_rules[ruleproductExpr]() {
-							goto l1097
+							goto l1137
 						}
-						goto l1096
-					l1097:
-						position, tokenIndex = position1097, tokenIndex1097
+						goto l1136
+					l1137:
+						position, tokenIndex = position1137, tokenIndex1137
 					}
-					add(rulePegText, position1095)
+					add(rulePegText, position1135)
 				}
-				if !_rules[ruleAction60]() {
-					goto l1093
+				if !_rules[ruleAction63]() {
+					goto l1133
 				}
-				add(ruletermExpr, position1094)
+				add(ruletermExpr, position1134)
 			}
 			return true
-		l1093:
-			position, tokenIndex = position1093, tokenIndex1093
+		l1133:
+			position, tokenIndex = position1133, tokenIndex1133
 			return false
 		},
-		/* 80 productExpr <- <(<(minusExpr (spOpt MultDivOp spOpt minusExpr)*)> Action61)> */
+		/* 84 productExpr <- <(<(minusExpr (spOpt MultDivOp spOpt minusExpr)*)> Action64)> */
 		func() bool {
-			position1098, tokenIndex1098 := position, tokenIndex
+			position1138, tokenIndex1138 := position, tokenIndex
 			{
-				position1099 := position
+				position1139 := position
 				{
-					position1100 := position
+					position1140 := position
 					if !_rules[ruleminusExpr]() {
-						goto l1098
+						goto l1138
 					}
-				l1101:
+				l1141:
 					{
-						position1102, tokenIndex1102 := position, tokenIndex
+						position1142, tokenIndex1142 := position, tokenIndex
 						if !_rules[rulespOpt]() {
-							goto l1102
+							goto l1142
 						}
 						if !_rules[ruleMultDivOp]() {
-							goto l1102
+							goto l1142
 						}
 						if !_rules[rulespOpt]() {
-							goto l1102
+							goto l1142
 						}
 						if !_rules[ruleminusExpr]() {
-							goto l1102
+							goto l1142
 						}
-						goto l1101
-					l1102:
-						position, tokenIndex = position1102, tokenIndex1102
+						goto l1141
+					l1142:
+						position, tokenIndex = position1142, tokenIndex1142
 					}
-					add(rulePegText, position1100)
+					add(rulePegText, position1140)
 				}
-				if !_rules[ruleAction61]() {
-					goto l1098
+				if !_rules[ruleAction64]() {
+					goto l1138
 				}
-				add(ruleproductExpr, position1099)
+				add(ruleproductExpr, position1139)
 			}
 			return true
-		l1098:
-			position, tokenIndex = position1098, tokenIndex1098
+		l1138:
+			position, tokenIndex = position1138, tokenIndex1138
 			return false
 		},
-		/* 81 minusExpr <- <(<((UnaryMinus spOpt)? castExpr)> Action62)> */
+		/* 85 minusExpr <- <(<((UnaryMinus spOpt)? castExpr)> Action65)> */
 		func() bool {
-			position1103, tokenIndex1103 := position, tokenIndex
+			position1143, tokenIndex1143 := position, tokenIndex
 			{
-				position1104 := position
+				position1144 := position
 				{
-					position1105 := position
+					position1145 := position
 					{
-						position1106, tokenIndex1106 := position, tokenIndex
+						position1146, tokenIndex1146 := position, tokenIndex
 						if !_rules[ruleUnaryMinus]() {
-							goto l1106
+							goto l1146
 						}
 						if !_rules[rulespOpt]() {
-							goto l1106
+							goto l1146
 						}
-						goto l1107
-					l1106:
-						position, tokenIndex = position1106, tokenIndex1106
+						goto l1147
+					l1146:
+						position, tokenIndex = position1146, tokenIndex1146
 					}
-				l1107:
+				l1147:
 					if !_rules[rulecastExpr]() {
-						goto l1103
+						goto l1143
 					}
-					add(rulePegText, position1105)
+					add(rulePegText, position1145)
 				}
-				if !_rules[ruleAction62]() {
-					goto l1103
+				if !_rules[ruleAction65]() {
+					goto l1143
 				}
-				add(ruleminusExpr, position1104)
+				add(ruleminusExpr, position1144)
 			}
 			return true
-		l1103:
-			position, tokenIndex = position1103, tokenIndex1103
+		l1143:
+			position, tokenIndex = position1143, tokenIndex1143
 			return false
 		},
-		/* 82 castExpr <- <(<(baseExpr (spOpt (':' ':') spOpt Type)?)> Action63)> */
+		/* 86 castExpr <- <(<(baseExpr (spOpt (':' ':') spOpt Type)?)> Action66)> */
 		func() bool {
-			position1108, tokenIndex1108 := position, tokenIndex
+			position1148, tokenIndex1148 := position, tokenIndex
 			{
-				position1109 := position
+				position1149 := position
 				{
-					position1110 := position
+					position1150 := position
 					if !_rules[rulebaseExpr]() {
-						goto l1108
+						goto l1148
 					}
 					{
-						position1111, tokenIndex1111 := position, tokenIndex
+						position1151, tokenIndex1151 := position, tokenIndex
 						if !_rules[rulespOpt]() {
-							goto l1111
+							goto l1151
 						}
 						if buffer[position] != rune(':') {
-							goto l1111
+							goto l1151
 						}
 						position++
 						if buffer[position] != rune(':') {
-							goto l1111
+							goto l1151
 						}
 						position++
 						if !_rules[rulespOpt]() {
-							goto l1111
+							goto l1151
 						}
 						if !_rules[ruleType]() {
-							goto l1111
+							goto l1151
 						}
-						goto l1112
-					l1111:
-						position, tokenIndex = position1111, tokenIndex1111
+						goto l1152
+					l1151:
+						position, tokenIndex = position1151, tokenIndex1151
 					}
-				l1112:
-					add(rulePegText, position1110)
+				l1152:
+					add(rulePegText, position1150)
 				}
-				if !_rules[ruleAction63]() {
-					goto l1108
+				if !_rules[ruleAction66]() {
+					goto l1148
 				}
-				add(rulecastExpr, position1109)
+				add(rulecastExpr, position1149)
 			}
 			return true
-		l1108:
-			position, tokenIndex = position1108, tokenIndex1108
+		l1148:
+			position, tokenIndex = position1148, tokenIndex1148
 			return false
 		},
-		/* 83 baseExpr <- <(('(' spOpt Expression spOpt ')') / MapExpr / BooleanLiteral / NullLiteral / Case / RowMeta / FuncTypeCast / FuncAppSelector / FuncApp / RowValue / ArrayExpr / Literal)> */
+		/* 87 baseExpr <- <(('(' spOpt Expression spOpt ')') / RowExpr / MapExpr / BooleanLiteral / NullLiteral / IntervalLiteral / Case / RowMeta / FuncTypeCast / FuncAppSelector / FuncApp / RowValue / ArrayExpr / Param / Literal)> */
 		func() bool {
-			position1113, tokenIndex1113 := position, tokenIndex
+			position1153, tokenIndex1153 := position, tokenIndex
 			{
-				position1114 := position
+				position1154 := position
 				{
-					position1115, tokenIndex1115 := position, tokenIndex
+					position1155, tokenIndex1155 := position, tokenIndex
 					if buffer[position] != rune('(') {
-						goto l1116
+						goto l1156
 					}
 					position++
 					if !_rules[rulespOpt]() {
-						goto l1116
+						goto l1156
 					}
 					if !_rules[ruleExpression]() {
-						goto l1116
+						goto l1156
 					}
 					if !_rules[rulespOpt]() {
-						goto l1116
+						goto l1156
 					}
 					if buffer[position] != rune(')') {
-						goto l1116
+						goto l1156
 					}
 					position++
-					goto l1115
-				l1116:
-					position, tokenIndex = position1115, tokenIndex1115
+					goto l1155
+				l1156:
+					position, tokenIndex = position1155, tokenIndex1155
+					if !_rules[ruleRowExpr]() {
+						goto l1157
+					}
+					goto l1155
+				l1157:
+					position, tokenIndex = position1155, tokenIndex1155
 					if !_rules[ruleMapExpr]() {
-						goto l1117
+						goto l1158
 					}
-					goto l1115
-				l1117:
-					position, tokenIndex = position1115, tokenIndex1115
+					goto l1155
+				l1158:
+					position, tokenIndex = position1155, tokenIndex1155
 					if !_rules[ruleBooleanLiteral]() {
-						goto l1118
+						goto l1159
 					}
-					goto l1115
-				l1118:
-					position, tokenIndex = position1115, tokenIndex1115
+					goto l1155
+				l1159:
+					position, tokenIndex = position1155, tokenIndex1155
 					if !_rules[ruleNullLiteral]() {
-						goto l1119
+						goto l1160
+					}
+					goto l1155
+				l1160:
+					position, tokenIndex = position1155, tokenIndex1155
+					if !_rules[ruleIntervalLiteral]() {
+						goto l1161
 					}
-					goto l1115
-				l1119:
-					position, tokenIndex = position1115, tokenIndex1115
+					goto l1155
+				l1161:
+					position, tokenIndex = position1155, tokenIndex1155
 					if !_rules[ruleCase]() {
-						goto l1120
+						goto l1162
 					}
-					goto l1115
-				l1120:
-					position, tokenIndex = position1115, tokenIndex1115
+					goto l1155
+				l1162:
+					position, tokenIndex = position1155, tokenIndex1155
 					if !_rules[ruleRowMeta]() {
-						goto l1121
+						goto l1163
 					}
-					goto l1115
-				l1121:
-					position, tokenIndex = position1115, tokenIndex1115
+					goto l1155
+				l1163:
+					position, tokenIndex = position1155, tokenIndex1155
 					if !_rules[ruleFuncTypeCast]() {
-						goto l1122
+						goto l1164
 					}
-					goto l1115
-				l1122:
-					position, tokenIndex = position1115, tokenIndex1115
+					goto l1155
+				l1164:
+					position, tokenIndex = position1155, tokenIndex1155
 					if !_rules[ruleFuncAppSelector]() {
-						goto l1123
+						goto l1165
 					}
-					goto l1115
-				l1123:
-					position, tokenIndex = position1115, tokenIndex1115
+					goto l1155
+				l1165:
+					position, tokenIndex = position1155, tokenIndex1155
 					if !_rules[ruleFuncApp]() {
-						goto l1124
+						goto l1166
 					}
-					goto l1115
-				l1124:
-					position, tokenIndex = position1115, tokenIndex1115
+					goto l1155
+				l1166:
+					position, tokenIndex = position1155, tokenIndex1155
 					if !_rules[ruleRowValue]() {
-						goto l1125
+						goto l1167
 					}
-					goto l1115
-				l1125:
-					position, tokenIndex = position1115, tokenIndex1115
+					goto l1155
+				l1167:
+					position, tokenIndex = position1155, tokenIndex1155
 					if !_rules[ruleArrayExpr]() {
-						goto l1126
+						goto l1168
+					}
+					goto l1155
+				l1168:
+					position, tokenIndex = position1155, tokenIndex1155
+					if !_rules[ruleParam]() {
+						goto l1169
 					}
-					goto l1115
-				l1126:
-					position, tokenIndex = position1115, tokenIndex1115
+					goto l1155
+				l1169:
+					position, tokenIndex = position1155, tokenIndex1155
 					if !_rules[ruleLiteral]() {
-						goto l1113
+						goto l1153
 					}
 				}
-			l1115:
-				add(rulebaseExpr, position1114)
+			l1155:
+				add(rulebaseExpr, position1154)
 			}
 			return true
-		l1113:
-			position, tokenIndex = position1113, tokenIndex1113
+		l1153:
+			position, tokenIndex = position1153, tokenIndex1153
 			return false
 		},
-		/* 84 FuncTypeCast <- <(<(('c' / 'C') ('a' / 'A') ('s' / 'S') ('t' / 'T') spOpt '(' spOpt Expression sp (('a' / 'A') ('s' / 'S')) sp Type spOpt ')')> Action64)> */
+		/* 88 FuncTypeCast <- <(<(('c' / 'C') ('a' / 'A') ('s' / 'S') ('t' / 'T') spOpt '(' spOpt Expression sp (('a' / 'A') ('s' / 'S')) sp Type spOpt ')')> Action67)> */
 		func() bool {
-			position1127, tokenIndex1127 := position, tokenIndex
+			position1170, tokenIndex1170 := position, tokenIndex
 			{
-				position1128 := position
+				position1171 := position
 				{
-					position1129 := position
+					position1172 := position
 					{
-						position1130, tokenIndex1130 := position, tokenIndex
+						position1173, tokenIndex1173 := position, tokenIndex
 						if buffer[position] != rune('c') {
-							goto l1131
+							goto l1174
 						}
 						position++
-						goto l1130
-					l1131:
-						position, tokenIndex = position1130, tokenIndex1130
+						goto l1173
+					l1174:
+						position, tokenIndex = position1173, tokenIndex1173
 						if buffer[position] != rune('C') {
-							goto l1127
+							goto l1170
 						}
 						position++
 					}
-				l1130:
+				l1173:
 					{
-						position1132, tokenIndex1132 := position, tokenIndex
+						position1175, tokenIndex1175 := position, tokenIndex
 						if buffer[position] != rune('a') {
-							goto l1133
+							goto l1176
 						}
 						position++
-						goto l1132
-					l1133:
-						position, tokenIndex = position1132, tokenIndex1132
+						goto l1175
+					l1176:
+						position, tokenIndex = position1175, tokenIndex1175
 						if buffer[position] != rune('A') {
-							goto l1127
+							goto l1170
 						}
 						position++
 					}
-				l1132:
+				l1175:
 					{
-						position1134, tokenIndex1134 := position, tokenIndex
+						position1177, tokenIndex1177 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l1135
+							goto l1178
 						}
 						position++
-						goto l1134
-					l1135:
-						position, tokenIndex = position1134, tokenIndex1134
+						goto l1177
+					l1178:
+						position, tokenIndex = position1177, tokenIndex1177
 						if buffer[position] != rune('S') {
-							goto l1127
+							goto l1170
 						}
 						position++
 					}
-				l1134:
+				l1177:
 					{
-						position1136, tokenIndex1136 := position, tokenIndex
+						position1179, tokenIndex1179 := position, tokenIndex
 						if buffer[position] != rune('t') {
-							goto l1137
+							goto l1180
 						}
 						position++
-						goto l1136
-					l1137:
-						position, tokenIndex = position1136, tokenIndex1136
+						goto l1179
+					l1180:
+						position, tokenIndex = position1179, tokenIndex1179
 						if buffer[position] != rune('T') {
-							goto l1127
+							goto l1170
 						}
 						position++
 					}
-				l1136:
+				l1179:
 					if !_rules[rulespOpt]() {
-						goto l1127
+						goto l1170
 					}
 					if buffer[position] != rune('(') {
-						goto l1127
+						goto l1170
 					}
 					position++
 					if !_rules[rulespOpt]() {
-						goto l1127
+						goto l1170
 					}
 					if !_rules[ruleExpression]() {
-						goto l1127
+						goto l1170
 					}
 					if !_rules[rulesp]() {
-						goto l1127
+						goto l1170
 					}
 					{
-						position1138, tokenIndex1138 := position, tokenIndex
+						position1181, tokenIndex1181 := position, tokenIndex
 						if buffer[position] != rune('a') {
-							goto l1139
+							goto l1182
 						}
 						position++
-						goto l1138
-					l1139:
-						position, tokenIndex = position1138, tokenIndex1138
+						goto l1181
+					l1182:
+						position, tokenIndex = position1181, tokenIndex1181
 						if buffer[position] != rune('A') {
-							goto l1127
+							goto l1170
 						}
 						position++
 					}
-				l1138:
+				l1181:
 					{
-						position1140, tokenIndex1140 := position, tokenIndex
+						position1183, tokenIndex1183 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l1141
+							goto l1184
 						}
 						position++
-						goto l1140
-					l1141:
-						position, tokenIndex = position1140, tokenIndex1140
+						goto l1183
+					l1184:
+						position, tokenIndex = position1183, tokenIndex1183
 						if buffer[position] != rune('S') {
-							goto l1127
+							goto l1170
 						}
 						position++
 					}
-				l1140:
+				l1183:
 					if !_rules[rulesp]() {
-						goto l1127
+						goto l1170
 					}
 					if !_rules[ruleType]() {
-						goto l1127
+						goto l1170
 					}
 					if !_rules[rulespOpt]() {
-						goto l1127
+						goto l1170
 					}
 					if buffer[position] != rune(')') {
-						goto l1127
+						goto l1170
 					}
 					position++
-					add(rulePegText, position1129)
+					add(rulePegText, position1172)
 				}
-				if !_rules[ruleAction64]() {
-					goto l1127
+				if !_rules[ruleAction67]() {
+					goto l1170
 				}
-				add(ruleFuncTypeCast, position1128)
+				add(ruleFuncTypeCast, position1171)
 			}
 			return true
-		l1127:
-			position, tokenIndex = position1127, tokenIndex1127
+		l1170:
+			position, tokenIndex = position1170, tokenIndex1170
 			return false
 		},
-		/* 85 FuncApp <- <(FuncAppWithOrderBy / FuncAppWithoutOrderBy)> */
+		/* 89 FuncApp <- <(FuncAppWithOrderBy / FuncAppWithoutOrderBy)> */
 		func() bool {
-			position1142, tokenIndex1142 := position, tokenIndex
+			position1185, tokenIndex1185 := position, tokenIndex
 			{
-				position1143 := position
+				position1186 := position
 				{
-					position1144, tokenIndex1144 := position, tokenIndex
+					position1187, tokenIndex1187 := position, tokenIndex
 					if !_rules[ruleFuncAppWithOrderBy]() {
-						goto l1145
+						goto l1188
 					}
-					goto l1144
-				l1145:
-					position, tokenIndex = position1144, tokenIndex1144
+					goto l1187
+				l1188:
+					position, tokenIndex = position1187, tokenIndex1187
 					if !_rules[ruleFuncAppWithoutOrderBy]() {
-						goto l1142
+						goto l1185
 					}
 				}
-			l1144:
-				add(ruleFuncApp, position1143)
+			l1187:
+				add(ruleFuncApp, position1186)
 			}
 			return true
-		l1142:
-			position, tokenIndex = position1142, tokenIndex1142
+		l1185:
+			position, tokenIndex = position1185, tokenIndex1185
 			return false
 		},
-		/* 86 FuncAppSelector <- <(FuncApp FuncElemAccessor Action65)> */
+		/* 90 FuncAppSelector <- <(FuncApp FuncElemAccessor Action68)> */
 		func() bool {
-			position1146, tokenIndex1146 := position, tokenIndex
+			position1189, tokenIndex1189 := position, tokenIndex
 			{
-				position1147 := position
+				position1190 := position
 				if !_rules[ruleFuncApp]() {
-					goto l1146
+					goto l1189
 				}
 				if !_rules[ruleFuncElemAccessor]() {
-					goto l1146
+					goto l1189
 				}
-				if !_rules[ruleAction65]() {
-					goto l1146
+				if !_rules[ruleAction68]() {
+					goto l1189
 				}
-				add(ruleFuncAppSelector, position1147)
+				add(ruleFuncAppSelector, position1190)
 			}
 			return true
-		l1146:
-			position, tokenIndex = position1146, tokenIndex1146
+		l1189:
+			position, tokenIndex = position1189, tokenIndex1189
 			return false
 		},
-		/* 87 FuncElemAccessor <- <(<jsonGetPathNonHead+> Action66)> */
+		/* 91 FuncElemAccessor <- <(<jsonGetPathNonHead+> Action69)> */
 		func() bool {
-			position1148, tokenIndex1148 := position, tokenIndex
+			position1191, tokenIndex1191 := position, tokenIndex
 			{
-				position1149 := position
+				position1192 := position
 				{
-					position1150 := position
+					position1193 := position
 					if !_rules[rulejsonGetPathNonHead]() {
-						goto l1148
+						goto l1191
 					}
-				l1151:
+				l1194:
 					{
-						position1152, tokenIndex1152 := position, tokenIndex
+						position1195, tokenIndex1195 := position, tokenIndex
 						if !_rules[rulejsonGetPathNonHead]() {
-							goto l1152
+							goto l1195
 						}
-						goto l1151
-					l1152:
-						position, tokenIndex = position1152, tokenIndex1152
+						goto l1194
+					l1195:
+						position, tokenIndex = position1195, tokenIndex1195
 					}
-					add(rulePegText, position1150)
+					add(rulePegText, position1193)
 				}
-				if !_rules[ruleAction66]() {
-					goto l1148
+				if !_rules[ruleAction69]() {
+					goto l1191
 				}
-				add(ruleFuncElemAccessor, position1149)
+				add(ruleFuncElemAccessor, position1192)
 			}
 			return true
-		l1148:
-			position, tokenIndex = position1148, tokenIndex1148
+		l1191:
+			position, tokenIndex = position1191, tokenIndex1191
 			return false
 		},
-		/* 88 FuncAppWithOrderBy <- <(Function spOpt '(' spOpt FuncParams sp ParamsOrder spOpt ')' Action67)> */
+		/* 92 FuncAppWithOrderBy <- <(Function spOpt '(' spOpt FuncParams sp ParamsOrder spOpt ')' Action70)> */
 		func() bool {
-			position1153, tokenIndex1153 := position, tokenIndex
+			position1196, tokenIndex1196 := position, tokenIndex
 			{
-				position1154 := position
+				position1197 := position
 				if !_rules[ruleFunction]() {
-					goto l1153
+					goto l1196
 				}
 				if !_rules[rulespOpt]() {
-					goto l1153
+					goto l1196
 				}
 				if buffer[position] != rune('(') {
-					goto l1153
+					goto l1196
 				}
 				position++
 				if !_rules[rulespOpt]() {
-					goto l1153
+					goto l1196
 				}
 				if !_rules[ruleFuncParams]() {
-					goto l1153
+					goto l1196
 				}
 				if !_rules[rulesp]() {
-					goto l1153
+					goto l1196
 				}
 				if !_rules[ruleParamsOrder]() {
-					goto l1153
+					goto l1196
 				}
 				if !_rules[rulespOpt]() {
-					goto l1153
+					goto l1196
 				}
 				if buffer[position] != rune(')') {
-					goto l1153
+					goto l1196
 				}
 				position++
-				if !_rules[ruleAction67]() {
-					goto l1153
+				if !_rules[ruleAction70]() {
+					goto l1196
 				}
-				add(ruleFuncAppWithOrderBy, position1154)
+				add(ruleFuncAppWithOrderBy, position1197)
 			}
 			return true
-		l1153:
-			position, tokenIndex = position1153, tokenIndex1153
+		l1196:
+			position, tokenIndex = position1196, tokenIndex1196
 			return false
 		},
-		/* 89 FuncAppWithoutOrderBy <- <(Function spOpt '(' spOpt FuncParams <spOpt> ')' Action68)> */
+		/* 93 FuncAppWithoutOrderBy <- <(Function spOpt '(' spOpt FuncParams <spOpt> ')' Action71)> */
 		func() bool {
-			position1155, tokenIndex1155 := position, tokenIndex
+			position1198, tokenIndex1198 := position, tokenIndex
 			{
-				position1156 := position
+				position1199 := position
 				if !_rules[ruleFunction]() {
-					goto l1155
+					goto l1198
 				}
 				if !_rules[rulespOpt]() {
-					goto l1155
+					goto l1198
 				}
 				if buffer[position] != rune('(') {
-					goto l1155
+					goto l1198
 				}
 				position++
 				if !_rules[rulespOpt]() {
-					goto l1155
+					goto l1198
 				}
 				if !_rules[ruleFuncParams]() {
-					goto l1155
+					goto l1198
 				}
 				{
-					position1157 := position
+					position1200 := position
 					if !_rules[rulespOpt]() {
-						goto l1155
+						goto l1198
 					}
-					add(rulePegText, position1157)
+					add(rulePegText, position1200)
 				}
 				if buffer[position] != rune(')') {
-					goto l1155
+					goto l1198
 				}
 				position++
-				if !_rules[ruleAction68]() {
-					goto l1155
+				if !_rules[ruleAction71]() {
+					goto l1198
 				}
-				add(ruleFuncAppWithoutOrderBy, position1156)
+				add(ruleFuncAppWithoutOrderBy, position1199)
 			}
 			return true
-		l1155:
-			position, tokenIndex = position1155, tokenIndex1155
+		l1198:
+			position, tokenIndex = position1198, tokenIndex1198
 			return false
 		},
-		/* 90 FuncParams <- <(<(ExpressionOrWildcard (spOpt ',' spOpt ExpressionOrWildcard)*)?> Action69)> */
+		/* 94 FuncParams <- <(<(ExpressionOrWildcard (spOpt ',' spOpt ExpressionOrWildcard)*)?> Action72)> */
 		func() bool {
-			position1158, tokenIndex1158 := position, tokenIndex
+			position1201, tokenIndex1201 := position, tokenIndex
 			{
-				position1159 := position
+				position1202 := position
 				{
-					position1160 := position
+					position1203 := position
 					{
-						position1161, tokenIndex1161 := position, tokenIndex
+						position1204, tokenIndex1204 := position, tokenIndex
 						if !_rules[ruleExpressionOrWildcard]() {
-							goto l1161
+							goto l1204
 						}
-					l1163:
+					l1206:
 						{
-							position1164, tokenIndex1164 := position, tokenIndex
+							position1207, tokenIndex1207 := position, tokenIndex
 							if !_rules[rulespOpt]() {
-								goto l1164
+								goto l1207
 							}
 							if buffer[position] != rune(',') {
-								goto l1164
+								goto l1207
 							}
 							position++
 							if !_rules[rulespOpt]() {
-								goto l1164
+								goto l1207
 							}
 							if !_rules[ruleExpressionOrWildcard]() {
-								goto l1164
+								goto l1207
 							}
-							goto l1163
-						l1164:
-							position, tokenIndex = position1164, tokenIndex1164
+							goto l1206
+						l1207:
+							position, tokenIndex = position1207, tokenIndex1207
 						}
-						goto l1162
-					l1161:
-						position, tokenIndex = position1161, tokenIndex1161
+						goto l1205
+					l1204:
+						position, tokenIndex = position1204, tokenIndex1204
 					}
-				l1162:
-					add(rulePegText, position1160)
+				l1205:
+					add(rulePegText, position1203)
 				}
-				if !_rules[ruleAction69]() {
-					goto l1158
+				if !_rules[ruleAction72]() {
+					goto l1201
 				}
-				add(ruleFuncParams, position1159)
+				add(ruleFuncParams, position1202)
 			}
 			return true
-		l1158:
-			position, tokenIndex = position1158, tokenIndex1158
+		l1201:
+			position, tokenIndex = position1201, tokenIndex1201
 			return false
 		},
-		/* 91 ParamsOrder <- <(<(('o' / 'O') ('r' / 'R') ('d' / 'D') ('e' / 'E') ('r' / 'R') sp (('b' / 'B') ('y' / 'Y')) sp SortedExpression (spOpt ',' spOpt SortedExpression)*)> Action70)> */
+		/* 95 ParamsOrder <- <(<(('o' / 'O') ('r' / 'R') ('d' / 'D') ('e' / 'E') ('r' / 'R') sp (('b' / 'B') ('y' / 'Y')) sp SortedExpression (spOpt ',' spOpt SortedExpression)*)> Action73)> */
 		func() bool {
-			position1165, tokenIndex1165 := position, tokenIndex
+			position1208, tokenIndex1208 := position, tokenIndex
 			{
-				position1166 := position
+				position1209 := position
 				{
-					position1167 := position
+					position1210 := position
 					{
-						position1168, tokenIndex1168 := position, tokenIndex
+						position1211, tokenIndex1211 := position, tokenIndex
 						if buffer[position] != rune('o') {
-							goto l1169
+							goto l1212
 						}
 						position++
-						goto l1168
-					l1169:
-						position, tokenIndex = position1168, tokenIndex1168
+						goto l1211
+					l1212:
+						position, tokenIndex = position1211, tokenIndex1211
 						if buffer[position] != rune('O') {
-							goto l1165
+							goto l1208
 						}
 						position++
 					}
-				l1168:
+				l1211:
 					{
-						position1170, tokenIndex1170 := position, tokenIndex
+						position1213, tokenIndex1213 := position, tokenIndex
 						if buffer[position] != rune('r') {
-							goto l1171
+							goto l1214
 						}
 						position++
-						goto l1170
-					l1171:
-						position, tokenIndex = position1170, tokenIndex1170
+						goto l1213
+					l1214:
+						position, tokenIndex = position1213, tokenIndex1213
 						if buffer[position] != rune('R') {
-							goto l1165
+							goto l1208
 						}
 						position++
 					}
-				l1170:
+				l1213:
 					{
-						position1172, tokenIndex1172 := position, tokenIndex
+						position1215, tokenIndex1215 := position, tokenIndex
 						if buffer[position] != rune('d') {
-							goto l1173
+							goto l1216
 						}
 						position++
-						goto l1172
-					l1173:
-						position, tokenIndex = position1172, tokenIndex1172
+						goto l1215
+					l1216:
+						position, tokenIndex = position1215, tokenIndex1215
 						if buffer[position] != rune('D') {
-							goto l1165
+							goto l1208
 						}
 						position++
 					}
-				l1172:
+				l1215:
 					{
-						position1174, tokenIndex1174 := position, tokenIndex
+						position1217, tokenIndex1217 := position, tokenIndex
 						if buffer[position] != rune('e') {
-							goto l1175
+							goto l1218
 						}
 						position++
-						goto l1174
-					l1175:
-						position, tokenIndex = position1174, tokenIndex1174
+						goto l1217
+					l1218:
+						position, tokenIndex = position1217, tokenIndex1217
 						if buffer[position] != rune('E') {
-							goto l1165
+							goto l1208
 						}
 						position++
 					}
-				l1174:
+				l1217:
 					{
-						position1176, tokenIndex1176 := position, tokenIndex
+						position1219, tokenIndex1219 := position, tokenIndex
 						if buffer[position] != rune('r') {
-							goto l1177
+							goto l1220
 						}
 						position++
-						goto l1176
-					l1177:
-						position, tokenIndex = position1176, tokenIndex1176
+						goto l1219
+					l1220:
+						position, tokenIndex = position1219, tokenIndex1219
 						if buffer[position] != rune('R') {
-							goto l1165
+							goto l1208
 						}
 						position++
 					}
-				l1176:
+				l1219:
 					if !_rules[rulesp]() {
-						goto l1165
+						goto l1208
 					}
 					{
-						position1178, tokenIndex1178 := position, tokenIndex
+						position1221, tokenIndex1221 := position, tokenIndex
 						if buffer[position] != rune('b') {
-							goto l1179
+							goto l1222
 						}
 						position++
-						goto l1178
-					l1179:
-						position, tokenIndex = position1178, tokenIndex1178
+						goto l1221
+					l1222:
+						position, tokenIndex = position1221, tokenIndex1221
 						if buffer[position] != rune('B') {
-							goto l1165
+							goto l1208
 						}
 						position++
 					}
-				l1178:
+				l1221:
 					{
-						position1180, tokenIndex1180 := position, tokenIndex
+						position1223, tokenIndex1223 := position, tokenIndex
 						if buffer[position] != rune('y') {
-							goto l1181
+							goto l1224
 						}
 						position++
-						goto l1180
-					l1181:
-						position, tokenIndex = position1180, tokenIndex1180
+						goto l1223
+					l1224:
+						position, tokenIndex = position1223, tokenIndex1223
 						if buffer[position] != rune('Y') {
-							goto l1165
+							goto l1208
 						}
 						position++
 					}
-				l1180:
+				l1223:
 					if !_rules[rulesp]() {
-						goto l1165
+						goto l1208
 					}
 					if !_rules[ruleSortedExpression]() {
-						goto l1165
+						goto l1208
 					}
-				l1182:
+				l1225:
 					{
-						position1183, tokenIndex1183 := position, tokenIndex
+						position1226, tokenIndex1226 := position, tokenIndex
 						if !_rules[rulespOpt]() {
-							goto l1183
+							goto l1226
 						}
 						if buffer[position] != rune(',') {
-							goto l1183
+							goto l1226
 						}
 						position++
 						if !_rules[rulespOpt]() {
-							goto l1183
+							goto l1226
 						}
 						if !_rules[ruleSortedExpression]() {
-							goto l1183
+							goto l1226
 						}
-						goto l1182
-					l1183:
-						position, tokenIndex = position1183, tokenIndex1183
+						goto l1225
+					l1226:
+						position, tokenIndex = position1226, tokenIndex1226
 					}
-					add(rulePegText, position1167)
+					add(rulePegText, position1210)
 				}
-				if !_rules[ruleAction70]() {
-					goto l1165
+				if !_rules[ruleAction73]() {
+					goto l1208
 				}
-				add(ruleParamsOrder, position1166)
+				add(ruleParamsOrder, position1209)
 			}
 			return true
-		l1165:
-			position, tokenIndex = position1165, tokenIndex1165
+		l1208:
+			position, tokenIndex = position1208, tokenIndex1208
 			return false
 		},
-		/* 92 SortedExpression <- <(Expression OrderDirectionOpt Action71)> */
+		/* 96 SortedExpression <- <(Expression OrderDirectionOpt Action74)> */
 		func() bool {
-			position1184, tokenIndex1184 := position, tokenIndex
+			position1227, tokenIndex1227 := position, tokenIndex
 			{
-				position1185 := position
+				position1228 := position
 				if !_rules[ruleExpression]() {
-					goto l1184
+					goto l1227
 				}
 				if !_rules[ruleOrderDirectionOpt]() {
-					goto l1184
+					goto l1227
 				}
-				if !_rules[ruleAction71]() {
-					goto l1184
+				if !_rules[ruleAction74]() {
+					goto l1227
 				}
-				add(ruleSortedExpression, position1185)
+				add(ruleSortedExpression, position1228)
 			}
 			return true
-		l1184:
-			position, tokenIndex = position1184, tokenIndex1184
+		l1227:
+			position, tokenIndex = position1227, tokenIndex1227
 			return false
 		},
-		/* 93 OrderDirectionOpt <- <(<(sp (Ascending / Descending))?> Action72)> */
+		/* 97 OrderDirectionOpt <- <(<(sp (Ascending / Descending))?> Action75)> */
 		func() bool {
-			position1186, tokenIndex1186 := position, tokenIndex
+			position1229, tokenIndex1229 := position, tokenIndex
 			{
-				position1187 := position
+				position1230 := position
 				{
-					position1188 := position
+					position1231 := position
 					{
-						position1189, tokenIndex1189 := position, tokenIndex
+						position1232, tokenIndex1232 := position, tokenIndex
 						if !_rules[rulesp]() {
-							goto l1189
+							goto l1232
 						}
 						{
-							position1191, tokenIndex1191 := position, tokenIndex
+							position1234, tokenIndex1234 := position, tokenIndex
 							if !_rules[ruleAscending]() {
-								goto l1192
+								goto l1235
 							}
-							goto l1191
-						l1192:
-							position, tokenIndex = position1191, tokenIndex1191
+							goto l1234
+						l1235:
+							position, tokenIndex = position1234, tokenIndex1234
 							if !_rules[ruleDescending]() {
-								goto l1189
+								goto l1232
 							}
 						}
-					l1191:
-						goto l1190
-					l1189:
-						position, tokenIndex = position1189, tokenIndex1189
+					l1234:
+						goto l1233
+					l1232:
+						position, tokenIndex = position1232, tokenIndex1232
 					}
-				l1190:
-					add(rulePegText, position1188)
+				l1233:
+					add(rulePegText, position1231)
 				}
-				if !_rules[ruleAction72]() {
-					goto l1186
+				if !_rules[ruleAction75]() {
+					goto l1229
 				}
-				add(ruleOrderDirectionOpt, position1187)
+				add(ruleOrderDirectionOpt, position1230)
 			}
 			return true
-		l1186:
-			position, tokenIndex = position1186, tokenIndex1186
+		l1229:
+			position, tokenIndex = position1229, tokenIndex1229
 			return false
 		},
-		/* 94 ArrayExpr <- <(<('[' spOpt (ExpressionOrWildcard (spOpt ',' spOpt ExpressionOrWildcard)*)? spOpt ','? spOpt ']')> Action73)> */
+		/* 98 ArrayExpr <- <(<('[' spOpt (ExpressionOrWildcard (spOpt ',' spOpt ExpressionOrWildcard)*)? spOpt ','? spOpt ']')> Action76)> */
 		func() bool {
-			position1193, tokenIndex1193 := position, tokenIndex
+			position1236, tokenIndex1236 := position, tokenIndex
 			{
-				position1194 := position
+				position1237 := position
 				{
-					position1195 := position
+					position1238 := position
 					if buffer[position] != rune('[') {
-						goto l1193
+						goto l1236
 					}
 					position++
 					if !_rules[rulespOpt]() {
-						goto l1193
+						goto l1236
 					}
 					{
-						position1196, tokenIndex1196 := position, tokenIndex
+						position1239, tokenIndex1239 := position, tokenIndex
 						if !_rules[ruleExpressionOrWildcard]() {
-							goto l1196
+							goto l1239
 						}
-					l1198:
+					l1241:
 						{
-							position1199, tokenIndex1199 := position, tokenIndex
+							position1242, tokenIndex1242 := position, tokenIndex
 							if !_rules[rulespOpt]() {
-								goto l1199
+								goto l1242
 							}
 							if buffer[position] != rune(',') {
-								goto l1199
+								goto l1242
 							}
 							position++
 							if !_rules[rulespOpt]() {
-								goto l1199
+								goto l1242
 							}
 							if !_rules[ruleExpressionOrWildcard]() {
-								goto l1199
+								goto l1242
 							}
-							goto l1198
-						l1199:
-							position, tokenIndex = position1199, tokenIndex1199
+							goto l1241
+						l1242:
+							position, tokenIndex = position1242, tokenIndex1242
 						}
-						goto l1197
-					l1196:
-						position, tokenIndex = position1196, tokenIndex1196
+						goto l1240
+					l1239:
+						position, tokenIndex = position1239, tokenIndex1239
 					}
-				l1197:
+				l1240:
 					if !_rules[rulespOpt]() {
-						goto l1193
+						goto l1236
 					}
 					{
-						position1200, tokenIndex1200 := position, tokenIndex
+						position1243, tokenIndex1243 := position, tokenIndex
 						if buffer[position] != rune(',') {
-							goto l1200
+							goto l1243
 						}
 						position++
-						goto l1201
-					l1200:
-						position, tokenIndex = position1200, tokenIndex1200
+						goto l1244
+					l1243:
+						position, tokenIndex = position1243, tokenIndex1243
 					}
-				l1201:
+				l1244:
 					if !_rules[rulespOpt]() {
-						goto l1193
+						goto l1236
 					}
 					if buffer[position] != rune(']') {
-						goto l1193
+						goto l1236
 					}
 					position++
-					add(rulePegText, position1195)
+					add(rulePegText, position1238)
 				}
-				if !_rules[ruleAction73]() {
-					goto l1193
+				if !_rules[ruleAction76]() {
+					goto l1236
+				}
+				add(ruleArrayExpr, position1237)
+			}
+			return true
+		l1236:
+			position, tokenIndex = position1236, tokenIndex1236
+			return false
+		},
+		/* 99 RowExpr <- <(<('(' spOpt Expression (spOpt ',' spOpt Expression)+ spOpt ')')> Action77)> */
+		func() bool {
+			position1245, tokenIndex1245 := position, tokenIndex
+			{
+				position1246 := position
+				{
+					position1247 := position
+					if buffer[position] != rune('(') {
+						goto l1245
+					}
+					position++
+					if !_rules[rulespOpt]() {
+						goto l1245
+					}
+					if !_rules[ruleExpression]() {
+						goto l1245
+					}
+					if !_rules[rulespOpt]() {
+						goto l1245
+					}
+					if buffer[position] != rune(',') {
+						goto l1245
+					}
+					position++
+					if !_rules[rulespOpt]() {
+						goto l1245
+					}
+					if !_rules[ruleExpression]() {
+						goto l1245
+					}
+				l1248:
+					{
+						position1249, tokenIndex1249 := position, tokenIndex
+						if !_rules[rulespOpt]() {
+							goto l1249
+						}
+						if buffer[position] != rune(',') {
+							goto l1249
+						}
+						position++
+						if !_rules[rulespOpt]() {
+							goto l1249
+						}
+						if !_rules[ruleExpression]() {
+							goto l1249
+						}
+						goto l1248
+					l1249:
+						position, tokenIndex = position1249, tokenIndex1249
+					}
+					if !_rules[rulespOpt]() {
+						goto l1245
+					}
+					if buffer[position] != rune(')') {
+						goto l1245
+					}
+					position++
+					add(rulePegText, position1247)
+				}
+				if !_rules[ruleAction77]() {
+					goto l1245
+				}
+				add(ruleRowExpr, position1246)
+			}
+			return true
+		l1245:
+			position, tokenIndex = position1245, tokenIndex1245
+			return false
+		},
+		/* 100 InList <- <(<('(' spOpt Expression (spOpt ',' spOpt Expression)* spOpt ')')> Action78)> */
+		func() bool {
+			position1250, tokenIndex1250 := position, tokenIndex
+			{
+				position1251 := position
+				{
+					position1252 := position
+					if buffer[position] != rune('(') {
+						goto l1250
+					}
+					position++
+					if !_rules[rulespOpt]() {
+						goto l1250
+					}
+					if !_rules[ruleExpression]() {
+						goto l1250
+					}
+				l1253:
+					{
+						position1254, tokenIndex1254 := position, tokenIndex
+						if !_rules[rulespOpt]() {
+							goto l1254
+						}
+						if buffer[position] != rune(',') {
+							goto l1254
+						}
+						position++
+						if !_rules[rulespOpt]() {
+							goto l1254
+						}
+						if !_rules[ruleExpression]() {
+							goto l1254
+						}
+						goto l1253
+					l1254:
+						position, tokenIndex = position1254, tokenIndex1254
+					}
+					if !_rules[rulespOpt]() {
+						goto l1250
+					}
+					if buffer[position] != rune(')') {
+						goto l1250
+					}
+					position++
+					add(rulePegText, position1252)
+				}
+				if !_rules[ruleAction78]() {
+					goto l1250
 				}
-				add(ruleArrayExpr, position1194)
+				add(ruleInList, position1251)
 			}
 			return true
-		l1193:
-			position, tokenIndex = position1193, tokenIndex1193
+		l1250:
+			position, tokenIndex = position1250, tokenIndex1250
 			return false
 		},
-		/* 95 MapExpr <- <(<('{' spOpt (KeyValuePair (spOpt ',' spOpt KeyValuePair)*)? spOpt '}')> Action74)> */
+		/* 101 MapExpr <- <(<('{' spOpt (KeyValuePair (spOpt ',' spOpt KeyValuePair)*)? spOpt '}')> Action79)> */
 		func() bool {
-			position1202, tokenIndex1202 := position, tokenIndex
+			position1255, tokenIndex1255 := position, tokenIndex
 			{
-				position1203 := position
+				position1256 := position
 				{
-					position1204 := position
+					position1257 := position
 					if buffer[position] != rune('{') {
-						goto l1202
+						goto l1255
 					}
 					position++
 					if !_rules[rulespOpt]() {
-						goto l1202
+						goto l1255
 					}
 					{
-						position1205, tokenIndex1205 := position, tokenIndex
+						position1258, tokenIndex1258 := position, tokenIndex
 						if !_rules[ruleKeyValuePair]() {
-							goto l1205
+							goto l1258
 						}
-					l1207:
+					l1260:
 						{
-							position1208, tokenIndex1208 := position, tokenIndex
+							position1261, tokenIndex1261 := position, tokenIndex
 							if !_rules[rulespOpt]() {
-								goto l1208
+								goto l1261
 							}
 							if buffer[position] != rune(',') {
-								goto l1208
+								goto l1261
 							}
 							position++
 							if !_rules[rulespOpt]() {
-								goto l1208
+								goto l1261
 							}
 							if !_rules[ruleKeyValuePair]() {
-								goto l1208
+								goto l1261
 							}
-							goto l1207
-						l1208:
-							position, tokenIndex = position1208, tokenIndex1208
+							goto l1260
+						l1261:
+							position, tokenIndex = position1261, tokenIndex1261
 						}
-						goto l1206
-					l1205:
-						position, tokenIndex = position1205, tokenIndex1205
+						goto l1259
+					l1258:
+						position, tokenIndex = position1258, tokenIndex1258
 					}
-				l1206:
+				l1259:
 					if !_rules[rulespOpt]() {
-						goto l1202
+						goto l1255
 					}
 					if buffer[position] != rune('}') {
-						goto l1202
+						goto l1255
 					}
 					position++
-					add(rulePegText, position1204)
+					add(rulePegText, position1257)
 				}
-				if !_rules[ruleAction74]() {
-					goto l1202
+				if !_rules[ruleAction79]() {
+					goto l1255
 				}
-				add(ruleMapExpr, position1203)
+				add(ruleMapExpr, position1256)
 			}
 			return true
-		l1202:
-			position, tokenIndex = position1202, tokenIndex1202
+		l1255:
+			position, tokenIndex = position1255, tokenIndex1255
 			return false
 		},
-		/* 96 KeyValuePair <- <(<(StringLiteral spOpt ':' spOpt ExpressionOrWildcard)> Action75)> */
+		/* 102 KeyValuePair <- <(<(StringLiteral spOpt ':' spOpt ExpressionOrWildcard)> Action80)> */
 		func() bool {
-			position1209, tokenIndex1209 := position, tokenIndex
+			position1262, tokenIndex1262 := position, tokenIndex
 			{
-				position1210 := position
+				position1263 := position
 				{
-					position1211 := position
+					position1264 := position
 					if !_rules[ruleStringLiteral]() {
-						goto l1209
+						goto l1262
 					}
 					if !_rules[rulespOpt]() {
-						goto l1209
+						goto l1262
 					}
 					if buffer[position] != rune(':') {
-						goto l1209
+						goto l1262
 					}
 					position++
 					if !_rules[rulespOpt]() {
-						goto l1209
+						goto l1262
 					}
 					if !_rules[ruleExpressionOrWildcard]() {
-						goto l1209
+						goto l1262
 					}
-					add(rulePegText, position1211)
+					add(rulePegText, position1264)
 				}
-				if !_rules[ruleAction75]() {
-					goto l1209
+				if !_rules[ruleAction80]() {
+					goto l1262
 				}
-				add(ruleKeyValuePair, position1210)
+				add(ruleKeyValuePair, position1263)
 			}
 			return true
-		l1209:
-			position, tokenIndex = position1209, tokenIndex1209
+		l1262:
+			position, tokenIndex = position1262, tokenIndex1262
 			return false
 		},
-		/* 97 Case <- <(ConditionCase / ExpressionCase)> */
+		/* 103 Case <- <(ConditionCase / ExpressionCase)> */
 		func() bool {
-			position1212, tokenIndex1212 := position, tokenIndex
+			position1265, tokenIndex1265 := position, tokenIndex
 			{
-				position1213 := position
+				position1266 := position
 				{
-					position1214, tokenIndex1214 := position, tokenIndex
+					position1267, tokenIndex1267 := position, tokenIndex
 					if !_rules[ruleConditionCase]() {
-						goto l1215
+						goto l1268
 					}
-					goto l1214
-				l1215:
-					position, tokenIndex = position1214, tokenIndex1214
+					goto l1267
+				l1268:
+					position, tokenIndex = position1267, tokenIndex1267
 					if !_rules[ruleExpressionCase]() {
-						goto l1212
+						goto l1265
 					}
 				}
-			l1214:
-				add(ruleCase, position1213)
+			l1267:
+				add(ruleCase, position1266)
 			}
 			return true
-		l1212:
-			position, tokenIndex = position1212, tokenIndex1212
+		l1265:
+			position, tokenIndex = position1265, tokenIndex1265
 			return false
 		},
-		/* 98 ConditionCase <- <(('c' / 'C') ('a' / 'A') ('s' / 'S') ('e' / 'E') <((sp WhenThenPair)+ (sp (('e' / 'E') ('l' / 'L') ('s' / 'S') ('e' / 'E')) sp Expression)? sp (('e' / 'E') ('n' / 'N') ('d' / 'D')))> Action76)> */
+		/* 104 ConditionCase <- <(('c' / 'C') ('a' / 'A') ('s' / 'S') ('e' / 'E') <((sp WhenThenPair)+ (sp (('e' / 'E') ('l' / 'L') ('s' / 'S') ('e' / 'E')) sp Expression)? sp (('e' / 'E') ('n' / 'N') ('d' / 'D')))> Action81)> */
 		func() bool {
-			position1216, tokenIndex1216 := position, tokenIndex
+			position1269, tokenIndex1269 := position, tokenIndex
 			{
-				position1217 := position
+				position1270 := position
 				{
-					position1218, tokenIndex1218 := position, tokenIndex
+					position1271, tokenIndex1271 := position, tokenIndex
 					if buffer[position] != rune('c') {
-						goto l1219
+						goto l1272
 					}
 					position++
-					goto l1218
-				l1219:
-					position, tokenIndex = position1218, tokenIndex1218
+					goto l1271
+				l1272:
+					position, tokenIndex = position1271, tokenIndex1271
 					if buffer[position] != rune('C') {
-						goto l1216
+						goto l1269
 					}
 					position++
 				}
-			l1218:
+			l1271:
 				{
-					position1220, tokenIndex1220 := position, tokenIndex
+					position1273, tokenIndex1273 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l1221
+						goto l1274
 					}
 					position++
-					goto l1220
-				l1221:
-					position, tokenIndex = position1220, tokenIndex1220
+					goto l1273
+				l1274:
+					position, tokenIndex = position1273, tokenIndex1273
 					if buffer[position] != rune('A') {
-						goto l1216
+						goto l1269
 					}
 					position++
 				}
-			l1220:
+			l1273:
 				{
-					position1222, tokenIndex1222 := position, tokenIndex
+					position1275, tokenIndex1275 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l1223
+						goto l1276
 					}
 					position++
-					goto l1222
-				l1223:
-					position, tokenIndex = position1222, tokenIndex1222
+					goto l1275
+				l1276:
+					position, tokenIndex = position1275, tokenIndex1275
 					if buffer[position] != rune('S') {
-						goto l1216
+						goto l1269
 					}
 					position++
 				}
-			l1222:
+			l1275:
 				{
-					position1224, tokenIndex1224 := position, tokenIndex
+					position1277, tokenIndex1277 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l1225
+						goto l1278
 					}
 					position++
-					goto l1224
-				l1225:
-					position, tokenIndex = position1224, tokenIndex1224
+					goto l1277
+				l1278:
+					position, tokenIndex = position1277, tokenIndex1277
 					if buffer[position] != rune('E') {
-						goto l1216
+						goto l1269
 					}
 					position++
 				}
-			l1224:
+			l1277:
 				{
-					position1226 := position
+					position1279 := position
 					if !_rules[rulesp]() {
-						goto l1216
+						goto l1269
 					}
 					if !_rules[ruleWhenThenPair]() {
-						goto l1216
+						goto l1269
 					}
-				l1227:
+				l1280:
 					{
-						position1228, tokenIndex1228 := position, tokenIndex
+						position1281, tokenIndex1281 := position, tokenIndex
 						if !_rules[rulesp]() {
-							goto l1228
+							goto l1281
 						}
 						if !_rules[ruleWhenThenPair]() {
-							goto l1228
+							goto l1281
 						}
-						goto l1227
-					l1228:
-						position, tokenIndex = position1228, tokenIndex1228
+						goto l1280
+					l1281:
+						position, tokenIndex = position1281, tokenIndex1281
 					}
 					{
-						position1229, tokenIndex1229 := position, tokenIndex
+						position1282, tokenIndex1282 := position, tokenIndex
 						if !_rules[rulesp]() {
-							goto l1229
+							goto l1282
 						}
 						{
-							position1231, tokenIndex1231 := position, tokenIndex
+							position1284, tokenIndex1284 := position, tokenIndex
 							if buffer[position] != rune('e') {
-								goto l1232
+								goto l1285
 							}
 							position++
-							goto l1231
-						l1232:
-							position, tokenIndex = position1231, tokenIndex1231
+							goto l1284
+						l1285:
+							position, tokenIndex = position1284, tokenIndex1284
 							if buffer[position] != rune('E') {
-								goto l1229
+								goto l1282
 							}
 							position++
 						}
-					l1231:
+					l1284:
 						{
-							position1233, tokenIndex1233 := position, tokenIndex
+							position1286, tokenIndex1286 := position, tokenIndex
 							if buffer[position] != rune('l') {
-								goto l1234
+								goto l1287
 							}
 							position++
-							goto l1233
-						l1234:
-							position, tokenIndex = position1233, tokenIndex1233
+							goto l1286
+						l1287:
+							position, tokenIndex = position1286, tokenIndex1286
 							if buffer[position] != rune('L') {
-								goto l1229
+								goto l1282
 							}
 							position++
 						}
-					l1233:
+					l1286:
 						{
-							position1235, tokenIndex1235 := position, tokenIndex
+							position1288, tokenIndex1288 := position, tokenIndex
 							if buffer[position] != rune('s') {
-								goto l1236
+								goto l1289
 							}
 							position++
-							goto l1235
-						l1236:
-							position, tokenIndex = position1235, tokenIndex1235
+							goto l1288
+						l1289:
+							position, tokenIndex = position1288, tokenIndex1288
 							if buffer[position] != rune('S') {
-								goto l1229
+								goto l1282
 							}
 							position++
 						}
-					l1235:
+					l1288:
 						{
-							position1237, tokenIndex1237 := position, tokenIndex
+							position1290, tokenIndex1290 := position, tokenIndex
 							if buffer[position] != rune('e') {
-								goto l1238
+								goto l1291
 							}
 							position++
-							goto l1237
-						l1238:
-							position, tokenIndex = position1237, tokenIndex1237
+							goto l1290
+						l1291:
+							position, tokenIndex = position1290, tokenIndex1290
 							if buffer[position] != rune('E') {
-								goto l1229
+								goto l1282
 							}
 							position++
 						}
-					l1237:
+					l1290:
 						if !_rules[rulesp]() {
-							goto l1229
+							goto l1282
 						}
 						if !_rules[ruleExpression]() {
-							goto l1229
+							goto l1282
 						}
-						goto l1230
-					l1229:
-						position, tokenIndex = position1229, tokenIndex1229
+						goto l1283
+					l1282:
+						position, tokenIndex = position1282, tokenIndex1282
 					}
-				l1230:
+				l1283:
 					if !_rules[rulesp]() {
-						goto l1216
+						goto l1269
 					}
 					{
-						position1239, tokenIndex1239 := position, tokenIndex
+						position1292, tokenIndex1292 := position, tokenIndex
 						if buffer[position] != rune('e') {
-							goto l1240
+							goto l1293
 						}
 						position++
-						goto l1239
-					l1240:
-						position, tokenIndex = position1239, tokenIndex1239
+						goto l1292
+					l1293:
+						position, tokenIndex = position1292, tokenIndex1292
 						if buffer[position] != rune('E') {
-							goto l1216
+							goto l1269
 						}
 						position++
 					}
-				l1239:
+				l1292:
 					{
-						position1241, tokenIndex1241 := position, tokenIndex
+						position1294, tokenIndex1294 := position, tokenIndex
 						if buffer[position] != rune('n') {
-							goto l1242
+							goto l1295
 						}
 						position++
-						goto l1241
-					l1242:
-						position, tokenIndex = position1241, tokenIndex1241
+						goto l1294
+					l1295:
+						position, tokenIndex = position1294, tokenIndex1294
 						if buffer[position] != rune('N') {
-							goto l1216
+							goto l1269
 						}
 						position++
 					}
-				l1241:
+				l1294:
 					{
-						position1243, tokenIndex1243 := position, tokenIndex
+						position1296, tokenIndex1296 := position, tokenIndex
 						if buffer[position] != rune('d') {
-							goto l1244
+							goto l1297
 						}
 						position++
-						goto l1243
-					l1244:
-						position, tokenIndex = position1243, tokenIndex1243
+						goto l1296
+					l1297:
+						position, tokenIndex = position1296, tokenIndex1296
 						if buffer[position] != rune('D') {
-							goto l1216
+							goto l1269
 						}
 						position++
 					}
-				l1243:
-					add(rulePegText, position1226)
+				l1296:
+					add(rulePegText, position1279)
 				}
-				if !_rules[ruleAction76]() {
-					goto l1216
+				if !_rules[ruleAction81]() {
+					goto l1269
 				}
-				add(ruleConditionCase, position1217)
+				add(ruleConditionCase, position1270)
 			}
 			return true
-		l1216:
-			position, tokenIndex = position1216, tokenIndex1216
+		l1269:
+			position, tokenIndex = position1269, tokenIndex1269
 			return false
 		},
-		/* 99 ExpressionCase <- <(('c' / 'C') ('a' / 'A') ('s' / 'S') ('e' / 'E') sp Expression <((sp WhenThenPair)+ (sp (('e' / 'E') ('l' / 'L') ('s' / 'S') ('e' / 'E')) sp Expression)? sp (('e' / 'E') ('n' / 'N') ('d' / 'D')))> Action77)> */
+		/* 105 ExpressionCase <- <(('c' / 'C') ('a' / 'A') ('s' / 'S') ('e' / 'E') sp Expression <((sp WhenThenPair)+ (sp (('e' / 'E') ('l' / 'L') ('s' / 'S') ('e' / 'E')) sp Expression)? sp (('e' / 'E') ('n' / 'N') ('d' / 'D')))> Action82)> */
 		func() bool {
-			position1245, tokenIndex1245 := position, tokenIndex
+			position1298, tokenIndex1298 := position, tokenIndex
 			{
-				position1246 := position
+				position1299 := position
 				{
-					position1247, tokenIndex1247 := position, tokenIndex
+					position1300, tokenIndex1300 := position, tokenIndex
 					if buffer[position] != rune('c') {
-						goto l1248
+						goto l1301
 					}
 					position++
-					goto l1247
-				l1248:
-					position, tokenIndex = position1247, tokenIndex1247
+					goto l1300
+				l1301:
+					position, tokenIndex = position1300, tokenIndex1300
 					if buffer[position] != rune('C') {
-						goto l1245
+						goto l1298
 					}
 					position++
 				}
-			l1247:
+			l1300:
 				{
-					position1249, tokenIndex1249 := position, tokenIndex
+					position1302, tokenIndex1302 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l1250
+						goto l1303
 					}
 					position++
-					goto l1249
-				l1250:
-					position, tokenIndex = position1249, tokenIndex1249
+					goto l1302
+				l1303:
+					position, tokenIndex = position1302, tokenIndex1302
 					if buffer[position] != rune('A') {
-						goto l1245
+						goto l1298
 					}
 					position++
 				}
-			l1249:
+			l1302:
 				{
-					position1251, tokenIndex1251 := position, tokenIndex
+					position1304, tokenIndex1304 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l1252
+						goto l1305
 					}
 					position++
-					goto l1251
-				l1252:
-					position, tokenIndex = position1251, tokenIndex1251
+					goto l1304
+				l1305:
+					position, tokenIndex = position1304, tokenIndex1304
 					if buffer[position] != rune('S') {
-						goto l1245
+						goto l1298
 					}
 					position++
 				}
-			l1251:
+			l1304:
 				{
-					position1253, tokenIndex1253 := position, tokenIndex
+					position1306, tokenIndex1306 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l1254
+						goto l1307
 					}
 					position++
-					goto l1253
-				l1254:
-					position, tokenIndex = position1253, tokenIndex1253
+					goto l1306
+				l1307:
+					position, tokenIndex = position1306, tokenIndex1306
 					if buffer[position] != rune('E') {
-						goto l1245
+						goto l1298
 					}
 					position++
 				}
-			l1253:
+			l1306:
 				if !_rules[rulesp]() {
-					goto l1245
+					goto l1298
 				}
 				if !_rules[ruleExpression]() {
-					goto l1245
+					goto l1298
 				}
 				{
-					position1255 := position
+					position1308 := position
 					if !_rules[rulesp]() {
-						goto l1245
+						goto l1298
 					}
 					if !_rules[ruleWhenThenPair]() {
-						goto l1245
+						goto l1298
 					}
-				l1256:
+				l1309:
 					{
-						position1257, tokenIndex1257 := position, tokenIndex
+						position1310, tokenIndex1310 := position, tokenIndex
 						if !_rules[rulesp]() {
-							goto l1257
+							goto l1310
 						}
 						if !_rules[ruleWhenThenPair]() {
-							goto l1257
+							goto l1310
 						}
-						goto l1256
-					l1257:
-						position, tokenIndex = position1257, tokenIndex1257
+						goto l1309
+					l1310:
+						position, tokenIndex = position1310, tokenIndex1310
 					}
 					{
-						position1258, tokenIndex1258 := position, tokenIndex
+						position1311, tokenIndex1311 := position, tokenIndex
 						if !_rules[rulesp]() {
-							goto l1258
+							goto l1311
 						}
 						{
-							position1260, tokenIndex1260 := position, tokenIndex
+							position1313, tokenIndex1313 := position, tokenIndex
 							if buffer[position] != rune('e') {
-								goto l1261
+								goto l1314
 							}
 							position++
-							goto l1260
-						l1261:
-							position, tokenIndex = position1260, tokenIndex1260
+							goto l1313
+						l1314:
+							position, tokenIndex = position1313, tokenIndex1313
 							if buffer[position] != rune('E') {
-								goto l1258
+								goto l1311
 							}
 							position++
 						}
-					l1260:
+					l1313:
 						{
-							position1262, tokenIndex1262 := position, tokenIndex
+							position1315, tokenIndex1315 := position, tokenIndex
 							if buffer[position] != rune('l') {
-								goto l1263
+								goto l1316
 							}
 							position++
-							goto l1262
-						l1263:
-							position, tokenIndex = position1262, tokenIndex1262
+							goto l1315
+						l1316:
+							position, tokenIndex = position1315, tokenIndex1315
 							if buffer[position] != rune('L') {
-								goto l1258
+								goto l1311
 							}
 							position++
 						}
-					l1262:
+					l1315:
 						{
-							position1264, tokenIndex1264 := position, tokenIndex
+							position1317, tokenIndex1317 := position, tokenIndex
 							if buffer[position] != rune('s') {
-								goto l1265
+								goto l1318
 							}
 							position++
-							goto l1264
-						l1265:
-							position, tokenIndex = position1264, tokenIndex1264
+							goto l1317
+						l1318:
+							position, tokenIndex = position1317, tokenIndex1317
 							if buffer[position] != rune('S') {
-								goto l1258
+								goto l1311
 							}
 							position++
 						}
-					l1264:
+					l1317:
 						{
-							position1266, tokenIndex1266 := position, tokenIndex
+							position1319, tokenIndex1319 := position, tokenIndex
 							if buffer[position] != rune('e') {
-								goto l1267
+								goto l1320
 							}
 							position++
-							goto l1266
-						l1267:
-							position, tokenIndex = position1266, tokenIndex1266
+							goto l1319
+						l1320:
+							position, tokenIndex = position1319, tokenIndex1319
 							if buffer[position] != rune('E') {
-								goto l1258
+								goto l1311
 							}
 							position++
 						}
-					l1266:
+					l1319:
 						if !_rules[rulesp]() {
-							goto l1258
+							goto l1311
 						}
 						if !_rules[ruleExpression]() {
-							goto l1258
+							goto l1311
 						}
-						goto l1259
-					l1258:
-						position, tokenIndex = position1258, tokenIndex1258
+						goto l1312
+					l1311:
+						position, tokenIndex = position1311, tokenIndex1311
 					}
-				l1259:
+				l1312:
 					if !_rules[rulesp]() {
-						goto l1245
+						goto l1298
 					}
 					{
-						position1268, tokenIndex1268 := position, tokenIndex
+						position1321, tokenIndex1321 := position, tokenIndex
 						if buffer[position] != rune('e') {
-							goto l1269
+							goto l1322
 						}
 						position++
-						goto l1268
-					l1269:
-						position, tokenIndex = position1268, tokenIndex1268
+						goto l1321
+					l1322:
+						position, tokenIndex = position1321, tokenIndex1321
 						if buffer[position] != rune('E') {
-							goto l1245
+							goto l1298
 						}
 						position++
 					}
-				l1268:
+				l1321:
 					{
-						position1270, tokenIndex1270 := position, tokenIndex
+						position1323, tokenIndex1323 := position, tokenIndex
 						if buffer[position] != rune('n') {
-							goto l1271
+							goto l1324
 						}
 						position++
-						goto l1270
-					l1271:
-						position, tokenIndex = position1270, tokenIndex1270
+						goto l1323
+					l1324:
+						position, tokenIndex = position1323, tokenIndex1323
 						if buffer[position] != rune('N') {
-							goto l1245
+							goto l1298
 						}
 						position++
 					}
-				l1270:
+				l1323:
 					{
-						position1272, tokenIndex1272 := position, tokenIndex
+						position1325, tokenIndex1325 := position, tokenIndex
 						if buffer[position] != rune('d') {
-							goto l1273
+							goto l1326
 						}
 						position++
-						goto l1272
-					l1273:
-						position, tokenIndex = position1272, tokenIndex1272
+						goto l1325
+					l1326:
+						position, tokenIndex = position1325, tokenIndex1325
 						if buffer[position] != rune('D') {
-							goto l1245
+							goto l1298
 						}
 						position++
 					}
-				l1272:
-					add(rulePegText, position1255)
+				l1325:
+					add(rulePegText, position1308)
 				}
-				if !_rules[ruleAction77]() {
-					goto l1245
+				if !_rules[ruleAction82]() {
+					goto l1298
 				}
-				add(ruleExpressionCase, position1246)
+				add(ruleExpressionCase, position1299)
 			}
 			return true
-		l1245:
-			position, tokenIndex = position1245, tokenIndex1245
+		l1298:
+			position, tokenIndex = position1298, tokenIndex1298
 			return false
 		},
-		/* 100 WhenThenPair <- <(('w' / 'W') ('h' / 'H') ('e' / 'E') ('n' / 'N') sp Expression sp (('t' / 'T') ('h' / 'H') ('e' / 'E') ('n' / 'N')) sp ExpressionOrWildcard Action78)> */
+		/* 106 WhenThenPair <- <(('w' / 'W') ('h' / 'H') ('e' / 'E') ('n' / 'N') sp Expression sp (('t' / 'T') ('h' / 'H') ('e' / 'E') ('n' / 'N')) sp ExpressionOrWildcard Action83)> */
 		func() bool {
-			position1274, tokenIndex1274 := position, tokenIndex
+			position1327, tokenIndex1327 := position, tokenIndex
 			{
-				position1275 := position
+				position1328 := position
 				{
-					position1276, tokenIndex1276 := position, tokenIndex
+					position1329, tokenIndex1329 := position, tokenIndex
 					if buffer[position] != rune('w') {
-						goto l1277
+						goto l1330
 					}
 					position++
-					goto l1276
-				l1277:
-					position, tokenIndex = position1276, tokenIndex1276
+					goto l1329
+				l1330:
+					position, tokenIndex = position1329, tokenIndex1329
 					if buffer[position] != rune('W') {
-						goto l1274
+						goto l1327
 					}
 					position++
 				}
-			l1276:
+			l1329:
 				{
-					position1278, tokenIndex1278 := position, tokenIndex
+					position1331, tokenIndex1331 := position, tokenIndex
 					if buffer[position] != rune('h') {
-						goto l1279
+						goto l1332
 					}
 					position++
-					goto l1278
-				l1279:
-					position, tokenIndex = position1278, tokenIndex1278
+					goto l1331
+				l1332:
+					position, tokenIndex = position1331, tokenIndex1331
 					if buffer[position] != rune('H') {
-						goto l1274
+						goto l1327
 					}
 					position++
 				}
-			l1278:
+			l1331:
 				{
-					position1280, tokenIndex1280 := position, tokenIndex
+					position1333, tokenIndex1333 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l1281
+						goto l1334
 					}
 					position++
-					goto l1280
-				l1281:
-					position, tokenIndex = position1280, tokenIndex1280
+					goto l1333
+				l1334:
+					position, tokenIndex = position1333, tokenIndex1333
 					if buffer[position] != rune('E') {
-						goto l1274
+						goto l1327
 					}
 					position++
 				}
-			l1280:
+			l1333:
 				{
-					position1282, tokenIndex1282 := position, tokenIndex
+					position1335, tokenIndex1335 := position, tokenIndex
 					if buffer[position] != rune('n') {
-						goto l1283
+						goto l1336
 					}
 					position++
-					goto l1282
-				l1283:
-					position, tokenIndex = position1282, tokenIndex1282
+					goto l1335
+				l1336:
+					position, tokenIndex = position1335, tokenIndex1335
 					if buffer[position] != rune('N') {
-						goto l1274
+						goto l1327
 					}
 					position++
 				}
-			l1282:
+			l1335:
 				if !_rules[rulesp]() {
-					goto l1274
+					goto l1327
 				}
 				if !_rules[ruleExpression]() {
-					goto l1274
+					goto l1327
 				}
 				if !_rules[rulesp]() {
-					goto l1274
+					goto l1327
 				}
 				{
-					position1284, tokenIndex1284 := position, tokenIndex
+					position1337, tokenIndex1337 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l1285
+						goto l1338
 					}
 					position++
-					goto l1284
-				l1285:
-					position, tokenIndex = position1284, tokenIndex1284
+					goto l1337
+				l1338:
+					position, tokenIndex = position1337, tokenIndex1337
 					if buffer[position] != rune('T') {
-						goto l1274
+						goto l1327
 					}
 					position++
 				}
-			l1284:
+			l1337:
 				{
-					position1286, tokenIndex1286 := position, tokenIndex
+					position1339, tokenIndex1339 := position, tokenIndex
 					if buffer[position] != rune('h') {
-						goto l1287
+						goto l1340
 					}
 					position++
-					goto l1286
-				l1287:
-					position, tokenIndex = position1286, tokenIndex1286
+					goto l1339
+				l1340:
+					position, tokenIndex = position1339, tokenIndex1339
 					if buffer[position] != rune('H') {
-						goto l1274
+						goto l1327
 					}
 					position++
 				}
-			l1286:
+			l1339:
 				{
-					position1288, tokenIndex1288 := position, tokenIndex
+					position1341, tokenIndex1341 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l1289
+						goto l1342
 					}
 					position++
-					goto l1288
-				l1289:
-					position, tokenIndex = position1288, tokenIndex1288
+					goto l1341
+				l1342:
+					position, tokenIndex = position1341, tokenIndex1341
 					if buffer[position] != rune('E') {
-						goto l1274
+						goto l1327
 					}
 					position++
 				}
-			l1288:
+			l1341:
 				{
-					position1290, tokenIndex1290 := position, tokenIndex
+					position1343, tokenIndex1343 := position, tokenIndex
 					if buffer[position] != rune('n') {
-						goto l1291
+						goto l1344
 					}
 					position++
-					goto l1290
-				l1291:
-					position, tokenIndex = position1290, tokenIndex1290
+					goto l1343
+				l1344:
+					position, tokenIndex = position1343, tokenIndex1343
 					if buffer[position] != rune('N') {
-						goto l1274
+						goto l1327
 					}
 					position++
 				}
-			l1290:
+			l1343:
 				if !_rules[rulesp]() {
-					goto l1274
+					goto l1327
 				}
 				if !_rules[ruleExpressionOrWildcard]() {
-					goto l1274
+					goto l1327
 				}
-				if !_rules[ruleAction78]() {
-					goto l1274
+				if !_rules[ruleAction83]() {
+					goto l1327
 				}
-				add(ruleWhenThenPair, position1275)
+				add(ruleWhenThenPair, position1328)
 			}
 			return true
-		l1274:
-			position, tokenIndex = position1274, tokenIndex1274
+		l1327:
+			position, tokenIndex = position1327, tokenIndex1327
 			return false
 		},
-		/* 101 Literal <- <(FloatLiteral / NumericLiteral / StringLiteral)> */
+		/* 107 Literal <- <(FloatLiteral / NumericLiteral / StringLiteral)> */
 		func() bool {
-			position1292, tokenIndex1292 := position, tokenIndex
+			position1345, tokenIndex1345 := position, tokenIndex
 			{
-				position1293 := position
+				position1346 := position
 				{
-					position1294, tokenIndex1294 := position, tokenIndex
+					position1347, tokenIndex1347 := position, tokenIndex
 					if !_rules[ruleFloatLiteral]() {
-						goto l1295
+						goto l1348
 					}
-					goto l1294
-				l1295:
-					position, tokenIndex = position1294, tokenIndex1294
+					goto l1347
+				l1348:
+					position, tokenIndex = position1347, tokenIndex1347
 					if !_rules[ruleNumericLiteral]() {
-						goto l1296
+						goto l1349
 					}
-					goto l1294
-				l1296:
-					position, tokenIndex = position1294, tokenIndex1294
+					goto l1347
+				l1349:
+					position, tokenIndex = position1347, tokenIndex1347
 					if !_rules[ruleStringLiteral]() {
-						goto l1292
+						goto l1345
 					}
 				}
-			l1294:
-				add(ruleLiteral, position1293)
+			l1347:
+				add(ruleLiteral, position1346)
 			}
 			return true
-		l1292:
-			position, tokenIndex = position1292, tokenIndex1292
+		l1345:
+			position, tokenIndex = position1345, tokenIndex1345
 			return false
 		},
-		/* 102 ComparisonOp <- <(Equal / NotEqual / LessOrEqual / Less / GreaterOrEqual / Greater / NotEqual)> */
+		/* 108 ComparisonOp <- <(Equal / NotEqual / LessOrEqual / Less / GreaterOrEqual / Greater / NotEqual)> */
 		func() bool {
-			position1297, tokenIndex1297 := position, tokenIndex
+			position1350, tokenIndex1350 := position, tokenIndex
 			{
-				position1298 := position
+				position1351 := position
 				{
-					position1299, tokenIndex1299 := position, tokenIndex
+					position1352, tokenIndex1352 := position, tokenIndex
 					if !_rules[ruleEqual]() {
-						goto l1300
+						goto l1353
 					}
-					goto l1299
-				l1300:
-					position, tokenIndex = position1299, tokenIndex1299
+					goto l1352
+				l1353:
+					position, tokenIndex = position1352, tokenIndex1352
 					if !_rules[ruleNotEqual]() {
-						goto l1301
+						goto l1354
 					}
-					goto l1299
-				l1301:
-					position, tokenIndex = position1299, tokenIndex1299
+					goto l1352
+				l1354:
+					position, tokenIndex = position1352, tokenIndex1352
 					if !_rules[ruleLessOrEqual]() {
-						goto l1302
+						goto l1355
 					}
-					goto l1299
-				l1302:
-					position, tokenIndex = position1299, tokenIndex1299
+					goto l1352
+				l1355:
+					position, tokenIndex = position1352, tokenIndex1352
 					if !_rules[ruleLess]() {
-						goto l1303
+						goto l1356
 					}
-					goto l1299
-				l1303:
-					position, tokenIndex = position1299, tokenIndex1299
+					goto l1352
+				l1356:
+					position, tokenIndex = position1352, tokenIndex1352
 					if !_rules[ruleGreaterOrEqual]() {
-						goto l1304
+						goto l1357
 					}
-					goto l1299
-				l1304:
-					position, tokenIndex = position1299, tokenIndex1299
+					goto l1352
+				l1357:
+					position, tokenIndex = position1352, tokenIndex1352
 					if !_rules[ruleGreater]() {
-						goto l1305
+						goto l1358
 					}
-					goto l1299
-				l1305:
-					position, tokenIndex = position1299, tokenIndex1299
+					goto l1352
+				l1358:
+					position, tokenIndex = position1352, tokenIndex1352
 					if !_rules[ruleNotEqual]() {
-						goto l1297
+						goto l1350
 					}
 				}
-			l1299:
-				add(ruleComparisonOp, position1298)
+			l1352:
+				add(ruleComparisonOp, position1351)
 			}
 			return true
-		l1297:
-			position, tokenIndex = position1297, tokenIndex1297
+		l1350:
+			position, tokenIndex = position1350, tokenIndex1350
 			return false
 		},
-		/* 103 OtherOp <- <Concat> */
+		/* 109 InOp <- <(NotIn / In)> */
 		func() bool {
-			position1306, tokenIndex1306 := position, tokenIndex
+			position1359, tokenIndex1359 := position, tokenIndex
 			{
-				position1307 := position
-				if !_rules[ruleConcat]() {
-					goto l1306
+				position1360 := position
+				{
+					position1361, tokenIndex1361 := position, tokenIndex
+					if !_rules[ruleNotIn]() {
+						goto l1362
+					}
+					goto l1361
+				l1362:
+					position, tokenIndex = position1361, tokenIndex1361
+					if !_rules[ruleIn]() {
+						goto l1359
+					}
 				}
-				add(ruleOtherOp, position1307)
+			l1361:
+				add(ruleInOp, position1360)
 			}
 			return true
-		l1306:
-			position, tokenIndex = position1306, tokenIndex1306
+		l1359:
+			position, tokenIndex = position1359, tokenIndex1359
 			return false
 		},
-		/* 104 IsOp <- <(IsNot / Is)> */
+		/* 110 OtherOp <- <(Concat / NotRegex / Regex)> */
 		func() bool {
-			position1308, tokenIndex1308 := position, tokenIndex
+			position1363, tokenIndex1363 := position, tokenIndex
 			{
-				position1309 := position
+				position1364 := position
 				{
-					position1310, tokenIndex1310 := position, tokenIndex
-					if !_rules[ruleIsNot]() {
-						goto l1311
+					position1365, tokenIndex1365 := position, tokenIndex
+					if !_rules[ruleConcat]() {
+						goto l1366
 					}
-					goto l1310
-				l1311:
-					position, tokenIndex = position1310, tokenIndex1310
-					if !_rules[ruleIs]() {
-						goto l1308
+					goto l1365
+				l1366:
+					position, tokenIndex = position1365, tokenIndex1365
+					if !_rules[ruleNotRegex]() {
+						goto l1367
+					}
+					goto l1365
+				l1367:
+					position, tokenIndex = position1365, tokenIndex1365
+					if !_rules[ruleRegex]() {
+						goto l1363
 					}
 				}
-			l1310:
-				add(ruleIsOp, position1309)
+			l1365:
+				add(ruleOtherOp, position1364)
 			}
 			return true
-		l1308:
-			position, tokenIndex = position1308, tokenIndex1308
+		l1363:
+			position, tokenIndex = position1363, tokenIndex1363
 			return false
 		},
-		/* 105 PlusMinusOp <- <(Plus / Minus)> */
+		/* 111 IsOp <- <(IsNot / Is)> */
 		func() bool {
-			position1312, tokenIndex1312 := position, tokenIndex
+			position1368, tokenIndex1368 := position, tokenIndex
 			{
-				position1313 := position
+				position1369 := position
 				{
-					position1314, tokenIndex1314 := position, tokenIndex
-					if !_rules[rulePlus]() {
-						goto l1315
+					position1370, tokenIndex1370 := position, tokenIndex
+					if !_rules[ruleIsNot]() {
+						goto l1371
 					}
-					goto l1314
-				l1315:
-					position, tokenIndex = position1314, tokenIndex1314
-					if !_rules[ruleMinus]() {
-						goto l1312
+					goto l1370
+				l1371:
+					position, tokenIndex = position1370, tokenIndex1370
+					if !_rules[ruleIs]() {
+						goto l1368
 					}
 				}
-			l1314:
-				add(rulePlusMinusOp, position1313)
+			l1370:
+				add(ruleIsOp, position1369)
 			}
 			return true
-		l1312:
-			position, tokenIndex = position1312, tokenIndex1312
+		l1368:
+			position, tokenIndex = position1368, tokenIndex1368
 			return false
 		},
-		/* 106 MultDivOp <- <(Multiply / Divide / Modulo)> */
+		/* 112 DistinctFromOp <- <(IsNotDistinctFrom / IsDistinctFrom)> */
 		func() bool {
-			position1316, tokenIndex1316 := position, tokenIndex
+			position1372, tokenIndex1372 := position, tokenIndex
 			{
-				position1317 := position
+				position1373 := position
 				{
-					position1318, tokenIndex1318 := position, tokenIndex
-					if !_rules[ruleMultiply]() {
-						goto l1319
-					}
-					goto l1318
-				l1319:
-					position, tokenIndex = position1318, tokenIndex1318
-					if !_rules[ruleDivide]() {
-						goto l1320
+					position1374, tokenIndex1374 := position, tokenIndex
+					if !_rules[ruleIsNotDistinctFrom]() {
+						goto l1375
 					}
-					goto l1318
-				l1320:
-					position, tokenIndex = position1318, tokenIndex1318
-					if !_rules[ruleModulo]() {
-						goto l1316
+					goto l1374
+				l1375:
+					position, tokenIndex = position1374, tokenIndex1374
+					if !_rules[ruleIsDistinctFrom]() {
+						goto l1372
 					}
 				}
-			l1318:
-				add(ruleMultDivOp, position1317)
+			l1374:
+				add(ruleDistinctFromOp, position1373)
 			}
 			return true
-		l1316:
-			position, tokenIndex = position1316, tokenIndex1316
+		l1372:
+			position, tokenIndex = position1372, tokenIndex1372
 			return false
 		},
-		/* 107 Stream <- <(<ident> Action79)> */
+		/* 113 PlusMinusOp <- <(Plus / Minus)> */
 		func() bool {
-			position1321, tokenIndex1321 := position, tokenIndex
+			position1376, tokenIndex1376 := position, tokenIndex
 			{
-				position1322 := position
+				position1377 := position
 				{
-					position1323 := position
+					position1378, tokenIndex1378 := position, tokenIndex
+					if !_rules[rulePlus]() {
+						goto l1379
+					}
+					goto l1378
+				l1379:
+					position, tokenIndex = position1378, tokenIndex1378
+					if !_rules[ruleMinus]() {
+						goto l1376
+					}
+				}
+			l1378:
+				add(rulePlusMinusOp, position1377)
+			}
+			return true
+		l1376:
+			position, tokenIndex = position1376, tokenIndex1376
+			return false
+		},
+		/* 114 MultDivOp <- <(Multiply / Divide / Modulo)> */
+		func() bool {
+			position1380, tokenIndex1380 := position, tokenIndex
+			{
+				position1381 := position
+				{
+					position1382, tokenIndex1382 := position, tokenIndex
+					if !_rules[ruleMultiply]() {
+						goto l1383
+					}
+					goto l1382
+				l1383:
+					position, tokenIndex = position1382, tokenIndex1382
+					if !_rules[ruleDivide]() {
+						goto l1384
+					}
+					goto l1382
+				l1384:
+					position, tokenIndex = position1382, tokenIndex1382
+					if !_rules[ruleModulo]() {
+						goto l1380
+					}
+				}
+			l1382:
+				add(ruleMultDivOp, position1381)
+			}
+			return true
+		l1380:
+			position, tokenIndex = position1380, tokenIndex1380
+			return false
+		},
+		/* 115 Stream <- <(<ident> Action84)> */
+		func() bool {
+			position1385, tokenIndex1385 := position, tokenIndex
+			{
+				position1386 := position
+				{
+					position1387 := position
 					if !_rules[ruleident]() {
-						goto l1321
+						goto l1385
 					}
-					add(rulePegText, position1323)
+					add(rulePegText, position1387)
 				}
-				if !_rules[ruleAction79]() {
-					goto l1321
+				if !_rules[ruleAction84]() {
+					goto l1385
 				}
-				add(ruleStream, position1322)
+				add(ruleStream, position1386)
 			}
 			return true
-		l1321:
-			position, tokenIndex = position1321, tokenIndex1321
+		l1385:
+			position, tokenIndex = position1385, tokenIndex1385
 			return false
 		},
-		/* 108 RowMeta <- <RowTimestamp> */
+		/* 116 RowMeta <- <RowTimestamp> */
 		func() bool {
-			position1324, tokenIndex1324 := position, tokenIndex
+			position1388, tokenIndex1388 := position, tokenIndex
 			{
-				position1325 := position
+				position1389 := position
 				if !_rules[ruleRowTimestamp]() {
-					goto l1324
+					goto l1388
 				}
-				add(ruleRowMeta, position1325)
+				add(ruleRowMeta, position1389)
 			}
 			return true
-		l1324:
-			position, tokenIndex = position1324, tokenIndex1324
+		l1388:
+			position, tokenIndex = position1388, tokenIndex1388
 			return false
 		},
-		/* 109 RowTimestamp <- <(<((ident ':')? ('t' 's' '(' ')'))> Action80)> */
+		/* 117 RowTimestamp <- <(<((ident ':')? ('t' 's' '(' ')'))> Action85)> */
 		func() bool {
-			position1326, tokenIndex1326 := position, tokenIndex
+			position1390, tokenIndex1390 := position, tokenIndex
 			{
-				position1327 := position
+				position1391 := position
 				{
-					position1328 := position
+					position1392 := position
 					{
-						position1329, tokenIndex1329 := position, tokenIndex
+						position1393, tokenIndex1393 := position, tokenIndex
 						if !_rules[ruleident]() {
-							goto l1329
+							goto l1393
 						}
 						if buffer[position] != rune(':') {
-							goto l1329
+							goto l1393
 						}
 						position++
-						goto l1330
-					l1329:
-						position, tokenIndex = position1329, tokenIndex1329
+						goto l1394
+					l1393:
+						position, tokenIndex = position1393, tokenIndex1393
 					}
-				l1330:
+				l1394:
 					if buffer[position] != rune('t') {
-						goto l1326
+						goto l1390
 					}
 					position++
 					if buffer[position] != rune('s') {
-						goto l1326
+						goto l1390
 					}
 					position++
 					if buffer[position] != rune('(') {
-						goto l1326
+						goto l1390
 					}
 					position++
 					if buffer[position] != rune(')') {
-						goto l1326
+						goto l1390
 					}
 					position++
-					add(rulePegText, position1328)
+					add(rulePegText, position1392)
 				}
-				if !_rules[ruleAction80]() {
-					goto l1326
+				if !_rules[ruleAction85]() {
+					goto l1390
 				}
-				add(ruleRowTimestamp, position1327)
+				add(ruleRowTimestamp, position1391)
 			}
 			return true
-		l1326:
-			position, tokenIndex = position1326, tokenIndex1326
+		l1390:
+			position, tokenIndex = position1390, tokenIndex1390
 			return false
 		},
-		/* 110 RowValue <- <(<((ident ':' !':')? jsonGetPath)> Action81)> */
+		/* 118 RowValue <- <(<((ident ':' !':')? jsonGetPath)> Action86)> */
 		func() bool {
-			position1331, tokenIndex1331 := position, tokenIndex
+			position1395, tokenIndex1395 := position, tokenIndex
 			{
-				position1332 := position
+				position1396 := position
 				{
-					position1333 := position
+					position1397 := position
 					{
-						position1334, tokenIndex1334 := position, tokenIndex
+						position1398, tokenIndex1398 := position, tokenIndex
 						if !_rules[ruleident]() {
-							goto l1334
+							goto l1398
 						}
 						if buffer[position] != rune(':') {
-							goto l1334
+							goto l1398
 						}
 						position++
 						{
-							position1336, tokenIndex1336 := position, tokenIndex
+							position1400, tokenIndex1400 := position, tokenIndex
 							if buffer[position] != rune(':') {
-								goto l1336
+								goto l1400
 							}
 							position++
-							goto l1334
-						l1336:
-							position, tokenIndex = position1336, tokenIndex1336
+							goto l1398
+						l1400:
+							position, tokenIndex = position1400, tokenIndex1400
 						}
-						goto l1335
-					l1334:
-						position, tokenIndex = position1334, tokenIndex1334
+						goto l1399
+					l1398:
+						position, tokenIndex = position1398, tokenIndex1398
 					}
-				l1335:
+				l1399:
 					if !_rules[rulejsonGetPath]() {
-						goto l1331
+						goto l1395
 					}
-					add(rulePegText, position1333)
+					add(rulePegText, position1397)
 				}
-				if !_rules[ruleAction81]() {
-					goto l1331
+				if !_rules[ruleAction86]() {
+					goto l1395
+				}
+				add(ruleRowValue, position1396)
+			}
+			return true
+		l1395:
+			position, tokenIndex = position1395, tokenIndex1395
+			return false
+		},
+		/* 119 Param <- <(PositionalParam / NamedParam)> */
+		func() bool {
+			position1401, tokenIndex1401 := position, tokenIndex
+			{
+				position1402 := position
+				{
+					position1403, tokenIndex1403 := position, tokenIndex
+					if !_rules[rulePositionalParam]() {
+						goto l1404
+					}
+					goto l1403
+				l1404:
+					position, tokenIndex = position1403, tokenIndex1403
+					if !_rules[ruleNamedParam]() {
+						goto l1401
+					}
+				}
+			l1403:
+				add(ruleParam, position1402)
+			}
+			return true
+		l1401:
+			position, tokenIndex = position1401, tokenIndex1401
+			return false
+		},
+		/* 120 PositionalParam <- <(<'?'> Action87)> */
+		func() bool {
+			position1405, tokenIndex1405 := position, tokenIndex
+			{
+				position1406 := position
+				{
+					position1407 := position
+					if buffer[position] != rune('?') {
+						goto l1405
+					}
+					position++
+					add(rulePegText, position1407)
+				}
+				if !_rules[ruleAction87]() {
+					goto l1405
+				}
+				add(rulePositionalParam, position1406)
+			}
+			return true
+		l1405:
+			position, tokenIndex = position1405, tokenIndex1405
+			return false
+		},
+		/* 121 NamedParam <- <(<(':' ident)> Action88)> */
+		func() bool {
+			position1408, tokenIndex1408 := position, tokenIndex
+			{
+				position1409 := position
+				{
+					position1410 := position
+					if buffer[position] != rune(':') {
+						goto l1408
+					}
+					position++
+					if !_rules[ruleident]() {
+						goto l1408
+					}
+					add(rulePegText, position1410)
 				}
-				add(ruleRowValue, position1332)
+				if !_rules[ruleAction88]() {
+					goto l1408
+				}
+				add(ruleNamedParam, position1409)
 			}
 			return true
-		l1331:
-			position, tokenIndex = position1331, tokenIndex1331
+		l1408:
+			position, tokenIndex = position1408, tokenIndex1408
 			return false
 		},
-		/* 111 NumericLiteral <- <(<('-'? [0-9]+)> Action82)> */
+		/* 122 NumericLiteral <- <(<('-'? [0-9]+)> Action89)> */
 		func() bool {
-			position1337, tokenIndex1337 := position, tokenIndex
+			position1411, tokenIndex1411 := position, tokenIndex
 			{
-				position1338 := position
+				position1412 := position
 				{
-					position1339 := position
+					position1413 := position
 					{
-						position1340, tokenIndex1340 := position, tokenIndex
+						position1414, tokenIndex1414 := position, tokenIndex
 						if buffer[position] != rune('-') {
-							goto l1340
+							goto l1414
 						}
 						position++
-						goto l1341
-					l1340:
-						position, tokenIndex = position1340, tokenIndex1340
+						goto l1415
+					l1414:
+						position, tokenIndex = position1414, tokenIndex1414
 					}
-				l1341:
+				l1415:
 					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l1337
+						goto l1411
 					}
 					position++
-				l1342:
+				l1416:
 					{
-						position1343, tokenIndex1343 := position, tokenIndex
+						position1417, tokenIndex1417 := position, tokenIndex
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1343
+							goto l1417
 						}
 						position++
-						goto l1342
-					l1343:
-						position, tokenIndex = position1343, tokenIndex1343
+						goto l1416
+					l1417:
+						position, tokenIndex = position1417, tokenIndex1417
 					}
-					add(rulePegText, position1339)
+					add(rulePegText, position1413)
 				}
-				if !_rules[ruleAction82]() {
-					goto l1337
+				if !_rules[ruleAction89]() {
+					goto l1411
 				}
-				add(ruleNumericLiteral, position1338)
+				add(ruleNumericLiteral, position1412)
 			}
 			return true
-		l1337:
-			position, tokenIndex = position1337, tokenIndex1337
+		l1411:
+			position, tokenIndex = position1411, tokenIndex1411
 			return false
 		},
-		/* 112 NonNegativeNumericLiteral <- <(<[0-9]+> Action83)> */
+		/* 123 NonNegativeNumericLiteral <- <(<[0-9]+> Action90)> */
 		func() bool {
-			position1344, tokenIndex1344 := position, tokenIndex
+			position1418, tokenIndex1418 := position, tokenIndex
 			{
-				position1345 := position
+				position1419 := position
 				{
-					position1346 := position
+					position1420 := position
 					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l1344
+						goto l1418
 					}
 					position++
-				l1347:
+				l1421:
 					{
-						position1348, tokenIndex1348 := position, tokenIndex
+						position1422, tokenIndex1422 := position, tokenIndex
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1348
+							goto l1422
 						}
 						position++
-						goto l1347
-					l1348:
-						position, tokenIndex = position1348, tokenIndex1348
+						goto l1421
+					l1422:
+						position, tokenIndex = position1422, tokenIndex1422
 					}
-					add(rulePegText, position1346)
+					add(rulePegText, position1420)
 				}
-				if !_rules[ruleAction83]() {
-					goto l1344
+				if !_rules[ruleAction90]() {
+					goto l1418
 				}
-				add(ruleNonNegativeNumericLiteral, position1345)
+				add(ruleNonNegativeNumericLiteral, position1419)
 			}
 			return true
-		l1344:
-			position, tokenIndex = position1344, tokenIndex1344
+		l1418:
+			position, tokenIndex = position1418, tokenIndex1418
 			return false
 		},
-		/* 113 FloatLiteral <- <(<('-'? [0-9]+ '.' [0-9]+)> Action84)> */
+		/* 124 FloatLiteral <- <(<('-'? [0-9]+ '.' [0-9]+)> Action91)> */
 		func() bool {
-			position1349, tokenIndex1349 := position, tokenIndex
+			position1423, tokenIndex1423 := position, tokenIndex
 			{
-				position1350 := position
+				position1424 := position
 				{
-					position1351 := position
+					position1425 := position
 					{
-						position1352, tokenIndex1352 := position, tokenIndex
+						position1426, tokenIndex1426 := position, tokenIndex
 						if buffer[position] != rune('-') {
-							goto l1352
+							goto l1426
 						}
 						position++
-						goto l1353
-					l1352:
-						position, tokenIndex = position1352, tokenIndex1352
+						goto l1427
+					l1426:
+						position, tokenIndex = position1426, tokenIndex1426
 					}
-				l1353:
+				l1427:
 					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l1349
+						goto l1423
 					}
 					position++
-				l1354:
+				l1428:
 					{
-						position1355, tokenIndex1355 := position, tokenIndex
+						position1429, tokenIndex1429 := position, tokenIndex
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1355
+							goto l1429
 						}
 						position++
-						goto l1354
-					l1355:
-						position, tokenIndex = position1355, tokenIndex1355
+						goto l1428
+					l1429:
+						position, tokenIndex = position1429, tokenIndex1429
 					}
 					if buffer[position] != rune('.') {
-						goto l1349
+						goto l1423
 					}
 					position++
 					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l1349
+						goto l1423
 					}
 					position++
-				l1356:
+				l1430:
 					{
-						position1357, tokenIndex1357 := position, tokenIndex
+						position1431, tokenIndex1431 := position, tokenIndex
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1357
+							goto l1431
 						}
 						position++
-						goto l1356
-					l1357:
-						position, tokenIndex = position1357, tokenIndex1357
+						goto l1430
+					l1431:
+						position, tokenIndex = position1431, tokenIndex1431
 					}
-					add(rulePegText, position1351)
+					add(rulePegText, position1425)
 				}
-				if !_rules[ruleAction84]() {
-					goto l1349
+				if !_rules[ruleAction91]() {
+					goto l1423
 				}
-				add(ruleFloatLiteral, position1350)
+				add(ruleFloatLiteral, position1424)
 			}
 			return true
-		l1349:
-			position, tokenIndex = position1349, tokenIndex1349
+		l1423:
+			position, tokenIndex = position1423, tokenIndex1423
 			return false
 		},
-		/* 114 Function <- <(<ident> Action85)> */
+		/* 125 Function <- <(<ident> Action92)> */
 		func() bool {
-			position1358, tokenIndex1358 := position, tokenIndex
+			position1432, tokenIndex1432 := position, tokenIndex
 			{
-				position1359 := position
+				position1433 := position
 				{
-					position1360 := position
+					position1434 := position
 					if !_rules[ruleident]() {
-						goto l1358
+						goto l1432
 					}
-					add(rulePegText, position1360)
+					add(rulePegText, position1434)
 				}
-				if !_rules[ruleAction85]() {
-					goto l1358
+				if !_rules[ruleAction92]() {
+					goto l1432
 				}
-				add(ruleFunction, position1359)
+				add(ruleFunction, position1433)
 			}
 			return true
-		l1358:
-			position, tokenIndex = position1358, tokenIndex1358
+		l1432:
+			position, tokenIndex = position1432, tokenIndex1432
 			return false
 		},
-		/* 115 NullLiteral <- <(<(('n' / 'N') ('u' / 'U') ('l' / 'L') ('l' / 'L'))> Action86)> */
+		/* 126 NullLiteral <- <(<(('n' / 'N') ('u' / 'U') ('l' / 'L') ('l' / 'L'))> !([a-z] / [A-Z] / [0-9] / '_') Action93)> */
 		func() bool {
-			position1361, tokenIndex1361 := position, tokenIndex
+			position1435, tokenIndex1435 := position, tokenIndex
 			{
-				position1362 := position
+				position1436 := position
 				{
-					position1363 := position
+					position1437 := position
 					{
-						position1364, tokenIndex1364 := position, tokenIndex
+						position1438, tokenIndex1438 := position, tokenIndex
 						if buffer[position] != rune('n') {
-							goto l1365
+							goto l1439
 						}
 						position++
-						goto l1364
-					l1365:
-						position, tokenIndex = position1364, tokenIndex1364
+						goto l1438
+					l1439:
+						position, tokenIndex = position1438, tokenIndex1438
 						if buffer[position] != rune('N') {
-							goto l1361
+							goto l1435
 						}
 						position++
 					}
-				l1364:
+				l1438:
 					{
-						position1366, tokenIndex1366 := position, tokenIndex
+						position1440, tokenIndex1440 := position, tokenIndex
 						if buffer[position] != rune('u') {
-							goto l1367
+							goto l1441
 						}
 						position++
-						goto l1366
-					l1367:
-						position, tokenIndex = position1366, tokenIndex1366
+						goto l1440
+					l1441:
+						position, tokenIndex = position1440, tokenIndex1440
 						if buffer[position] != rune('U') {
-							goto l1361
+							goto l1435
 						}
 						position++
 					}
-				l1366:
+				l1440:
 					{
-						position1368, tokenIndex1368 := position, tokenIndex
+						position1442, tokenIndex1442 := position, tokenIndex
 						if buffer[position] != rune('l') {
-							goto l1369
+							goto l1443
 						}
 						position++
-						goto l1368
-					l1369:
-						position, tokenIndex = position1368, tokenIndex1368
+						goto l1442
+					l1443:
+						position, tokenIndex = position1442, tokenIndex1442
 						if buffer[position] != rune('L') {
-							goto l1361
+							goto l1435
 						}
 						position++
 					}
-				l1368:
+				l1442:
 					{
-						position1370, tokenIndex1370 := position, tokenIndex
+						position1444, tokenIndex1444 := position, tokenIndex
 						if buffer[position] != rune('l') {
-							goto l1371
+							goto l1445
 						}
 						position++
-						goto l1370
-					l1371:
-						position, tokenIndex = position1370, tokenIndex1370
+						goto l1444
+					l1445:
+						position, tokenIndex = position1444, tokenIndex1444
 						if buffer[position] != rune('L') {
-							goto l1361
+							goto l1435
 						}
 						position++
 					}
-				l1370:
-					add(rulePegText, position1363)
+				l1444:
+					add(rulePegText, position1437)
 				}
-				if !_rules[ruleAction86]() {
-					goto l1361
+				{
+					position1446, tokenIndex1446 := position, tokenIndex
+					{
+						position1447, tokenIndex1447 := position, tokenIndex
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l1448
+						}
+						position++
+						goto l1447
+					l1448:
+						position, tokenIndex = position1447, tokenIndex1447
+						if c := buffer[position]; c < rune('A') || c > rune('Z') {
+							goto l1449
+						}
+						position++
+						goto l1447
+					l1449:
+						position, tokenIndex = position1447, tokenIndex1447
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l1450
+						}
+						position++
+						goto l1447
+					l1450:
+						position, tokenIndex = position1447, tokenIndex1447
+						if buffer[position] != rune('_') {
+							goto l1446
+						}
+						position++
+					}
+				l1447:
+					goto l1435
+				l1446:
+					position, tokenIndex = position1446, tokenIndex1446
 				}
-				add(ruleNullLiteral, position1362)
+				if !_rules[ruleAction93]() {
+					goto l1435
+				}
+				add(ruleNullLiteral, position1436)
 			}
 			return true
-		l1361:
-			position, tokenIndex = position1361, tokenIndex1361
+		l1435:
+			position, tokenIndex = position1435, tokenIndex1435
 			return false
 		},
-		/* 116 Missing <- <(<(('m' / 'M') ('i' / 'I') ('s' / 'S') ('s' / 'S') ('i' / 'I') ('n' / 'N') ('g' / 'G'))> Action87)> */
+		/* 127 Missing <- <(<(('m' / 'M') ('i' / 'I') ('s' / 'S') ('s' / 'S') ('i' / 'I') ('n' / 'N') ('g' / 'G'))> Action94)> */
 		func() bool {
-			position1372, tokenIndex1372 := position, tokenIndex
+			position1451, tokenIndex1451 := position, tokenIndex
 			{
-				position1373 := position
+				position1452 := position
 				{
-					position1374 := position
+					position1453 := position
 					{
-						position1375, tokenIndex1375 := position, tokenIndex
+						position1454, tokenIndex1454 := position, tokenIndex
 						if buffer[position] != rune('m') {
-							goto l1376
+							goto l1455
 						}
 						position++
-						goto l1375
-					l1376:
-						position, tokenIndex = position1375, tokenIndex1375
+						goto l1454
+					l1455:
+						position, tokenIndex = position1454, tokenIndex1454
 						if buffer[position] != rune('M') {
-							goto l1372
+							goto l1451
 						}
 						position++
 					}
-				l1375:
+				l1454:
 					{
-						position1377, tokenIndex1377 := position, tokenIndex
+						position1456, tokenIndex1456 := position, tokenIndex
 						if buffer[position] != rune('i') {
-							goto l1378
+							goto l1457
 						}
 						position++
-						goto l1377
-					l1378:
-						position, tokenIndex = position1377, tokenIndex1377
+						goto l1456
+					l1457:
+						position, tokenIndex = position1456, tokenIndex1456
 						if buffer[position] != rune('I') {
-							goto l1372
+							goto l1451
 						}
 						position++
 					}
-				l1377:
+				l1456:
 					{
-						position1379, tokenIndex1379 := position, tokenIndex
+						position1458, tokenIndex1458 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l1380
+							goto l1459
 						}
 						position++
-						goto l1379
-					l1380:
-						position, tokenIndex = position1379, tokenIndex1379
+						goto l1458
+					l1459:
+						position, tokenIndex = position1458, tokenIndex1458
 						if buffer[position] != rune('S') {
-							goto l1372
+							goto l1451
 						}
 						position++
 					}
-				l1379:
+				l1458:
 					{
-						position1381, tokenIndex1381 := position, tokenIndex
+						position1460, tokenIndex1460 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l1382
+							goto l1461
 						}
 						position++
-						goto l1381
-					l1382:
-						position, tokenIndex = position1381, tokenIndex1381
+						goto l1460
+					l1461:
+						position, tokenIndex = position1460, tokenIndex1460
 						if buffer[position] != rune('S') {
-							goto l1372
+							goto l1451
 						}
 						position++
 					}
-				l1381:
+				l1460:
 					{
-						position1383, tokenIndex1383 := position, tokenIndex
+						position1462, tokenIndex1462 := position, tokenIndex
 						if buffer[position] != rune('i') {
-							goto l1384
+							goto l1463
 						}
 						position++
-						goto l1383
-					l1384:
-						position, tokenIndex = position1383, tokenIndex1383
+						goto l1462
+					l1463:
+						position, tokenIndex = position1462, tokenIndex1462
 						if buffer[position] != rune('I') {
-							goto l1372
+							goto l1451
 						}
 						position++
 					}
-				l1383:
+				l1462:
 					{
-						position1385, tokenIndex1385 := position, tokenIndex
+						position1464, tokenIndex1464 := position, tokenIndex
 						if buffer[position] != rune('n') {
-							goto l1386
+							goto l1465
 						}
 						position++
-						goto l1385
-					l1386:
-						position, tokenIndex = position1385, tokenIndex1385
+						goto l1464
+					l1465:
+						position, tokenIndex = position1464, tokenIndex1464
 						if buffer[position] != rune('N') {
-							goto l1372
+							goto l1451
 						}
 						position++
 					}
-				l1385:
+				l1464:
 					{
-						position1387, tokenIndex1387 := position, tokenIndex
+						position1466, tokenIndex1466 := position, tokenIndex
 						if buffer[position] != rune('g') {
-							goto l1388
+							goto l1467
 						}
 						position++
-						goto l1387
-					l1388:
-						position, tokenIndex = position1387, tokenIndex1387
+						goto l1466
+					l1467:
+						position, tokenIndex = position1466, tokenIndex1466
 						if buffer[position] != rune('G') {
-							goto l1372
+							goto l1451
 						}
 						position++
 					}
-				l1387:
-					add(rulePegText, position1374)
+				l1466:
+					add(rulePegText, position1453)
 				}
-				if !_rules[ruleAction87]() {
-					goto l1372
+				if !_rules[ruleAction94]() {
+					goto l1451
 				}
-				add(ruleMissing, position1373)
+				add(ruleMissing, position1452)
 			}
 			return true
-		l1372:
-			position, tokenIndex = position1372, tokenIndex1372
+		l1451:
+			position, tokenIndex = position1451, tokenIndex1451
 			return false
 		},
-		/* 117 BooleanLiteral <- <(TRUE / FALSE)> */
+		/* 128 BooleanLiteral <- <(TRUE / FALSE)> */
 		func() bool {
-			position1389, tokenIndex1389 := position, tokenIndex
+			position1468, tokenIndex1468 := position, tokenIndex
 			{
-				position1390 := position
+				position1469 := position
 				{
-					position1391, tokenIndex1391 := position, tokenIndex
+					position1470, tokenIndex1470 := position, tokenIndex
 					if !_rules[ruleTRUE]() {
-						goto l1392
+						goto l1471
 					}
-					goto l1391
-				l1392:
-					position, tokenIndex = position1391, tokenIndex1391
+					goto l1470
+				l1471:
+					position, tokenIndex = position1470, tokenIndex1470
 					if !_rules[ruleFALSE]() {
-						goto l1389
+						goto l1468
 					}
 				}
-			l1391:
-				add(ruleBooleanLiteral, position1390)
+			l1470:
+				add(ruleBooleanLiteral, position1469)
 			}
 			return true
-		l1389:
-			position, tokenIndex = position1389, tokenIndex1389
+		l1468:
+			position, tokenIndex = position1468, tokenIndex1468
 			return false
 		},
-		/* 118 TRUE <- <(<(('t' / 'T') ('r' / 'R') ('u' / 'U') ('e' / 'E'))> Action88)> */
+		/* 129 TRUE <- <(<(('t' / 'T') ('r' / 'R') ('u' / 'U') ('e' / 'E'))> Action95)> */
 		func() bool {
-			position1393, tokenIndex1393 := position, tokenIndex
+			position1472, tokenIndex1472 := position, tokenIndex
 			{
-				position1394 := position
+				position1473 := position
 				{
-					position1395 := position
+					position1474 := position
 					{
-						position1396, tokenIndex1396 := position, tokenIndex
+						position1475, tokenIndex1475 := position, tokenIndex
 						if buffer[position] != rune('t') {
-							goto l1397
+							goto l1476
 						}
 						position++
-						goto l1396
-					l1397:
-						position, tokenIndex = position1396, tokenIndex1396
+						goto l1475
+					l1476:
+						position, tokenIndex = position1475, tokenIndex1475
 						if buffer[position] != rune('T') {
-							goto l1393
+							goto l1472
 						}
 						position++
 					}
-				l1396:
+				l1475:
 					{
-						position1398, tokenIndex1398 := position, tokenIndex
+						position1477, tokenIndex1477 := position, tokenIndex
 						if buffer[position] != rune('r') {
-							goto l1399
+							goto l1478
 						}
 						position++
-						goto l1398
-					l1399:
-						position, tokenIndex = position1398, tokenIndex1398
+						goto l1477
+					l1478:
+						position, tokenIndex = position1477, tokenIndex1477
 						if buffer[position] != rune('R') {
-							goto l1393
+							goto l1472
 						}
 						position++
 					}
-				l1398:
+				l1477:
 					{
-						position1400, tokenIndex1400 := position, tokenIndex
+						position1479, tokenIndex1479 := position, tokenIndex
 						if buffer[position] != rune('u') {
-							goto l1401
+							goto l1480
 						}
 						position++
-						goto l1400
-					l1401:
-						position, tokenIndex = position1400, tokenIndex1400
+						goto l1479
+					l1480:
+						position, tokenIndex = position1479, tokenIndex1479
 						if buffer[position] != rune('U') {
-							goto l1393
+							goto l1472
 						}
 						position++
 					}
-				l1400:
+				l1479:
 					{
-						position1402, tokenIndex1402 := position, tokenIndex
+						position1481, tokenIndex1481 := position, tokenIndex
 						if buffer[position] != rune('e') {
-							goto l1403
+							goto l1482
 						}
 						position++
-						goto l1402
-					l1403:
-						position, tokenIndex = position1402, tokenIndex1402
+						goto l1481
+					l1482:
+						position, tokenIndex = position1481, tokenIndex1481
 						if buffer[position] != rune('E') {
-							goto l1393
+							goto l1472
 						}
 						position++
 					}
-				l1402:
-					add(rulePegText, position1395)
+				l1481:
+					add(rulePegText, position1474)
 				}
-				if !_rules[ruleAction88]() {
-					goto l1393
+				if !_rules[ruleAction95]() {
+					goto l1472
 				}
-				add(ruleTRUE, position1394)
+				add(ruleTRUE, position1473)
 			}
 			return true
-		l1393:
-			position, tokenIndex = position1393, tokenIndex1393
+		l1472:
+			position, tokenIndex = position1472, tokenIndex1472
 			return false
 		},
-		/* 119 FALSE <- <(<(('f' / 'F') ('a' / 'A') ('l' / 'L') ('s' / 'S') ('e' / 'E'))> Action89)> */
+		/* 130 FALSE <- <(<(('f' / 'F') ('a' / 'A') ('l' / 'L') ('s' / 'S') ('e' / 'E'))> Action96)> */
 		func() bool {
-			position1404, tokenIndex1404 := position, tokenIndex
+			position1483, tokenIndex1483 := position, tokenIndex
 			{
-				position1405 := position
+				position1484 := position
 				{
-					position1406 := position
+					position1485 := position
 					{
-						position1407, tokenIndex1407 := position, tokenIndex
+						position1486, tokenIndex1486 := position, tokenIndex
 						if buffer[position] != rune('f') {
-							goto l1408
+							goto l1487
 						}
 						position++
-						goto l1407
-					l1408:
-						position, tokenIndex = position1407, tokenIndex1407
+						goto l1486
+					l1487:
+						position, tokenIndex = position1486, tokenIndex1486
 						if buffer[position] != rune('F') {
-							goto l1404
+							goto l1483
 						}
 						position++
 					}
-				l1407:
+				l1486:
 					{
-						position1409, tokenIndex1409 := position, tokenIndex
+						position1488, tokenIndex1488 := position, tokenIndex
 						if buffer[position] != rune('a') {
-							goto l1410
+							goto l1489
 						}
 						position++
-						goto l1409
-					l1410:
-						position, tokenIndex = position1409, tokenIndex1409
+						goto l1488
+					l1489:
+						position, tokenIndex = position1488, tokenIndex1488
 						if buffer[position] != rune('A') {
-							goto l1404
+							goto l1483
 						}
 						position++
 					}
-				l1409:
+				l1488:
 					{
-						position1411, tokenIndex1411 := position, tokenIndex
+						position1490, tokenIndex1490 := position, tokenIndex
 						if buffer[position] != rune('l') {
-							goto l1412
+							goto l1491
 						}
 						position++
-						goto l1411
-					l1412:
-						position, tokenIndex = position1411, tokenIndex1411
+						goto l1490
+					l1491:
+						position, tokenIndex = position1490, tokenIndex1490
 						if buffer[position] != rune('L') {
-							goto l1404
+							goto l1483
 						}
 						position++
 					}
-				l1411:
+				l1490:
 					{
-						position1413, tokenIndex1413 := position, tokenIndex
+						position1492, tokenIndex1492 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l1414
+							goto l1493
 						}
 						position++
-						goto l1413
-					l1414:
-						position, tokenIndex = position1413, tokenIndex1413
+						goto l1492
+					l1493:
+						position, tokenIndex = position1492, tokenIndex1492
 						if buffer[position] != rune('S') {
-							goto l1404
+							goto l1483
 						}
 						position++
 					}
-				l1413:
+				l1492:
 					{
-						position1415, tokenIndex1415 := position, tokenIndex
+						position1494, tokenIndex1494 := position, tokenIndex
 						if buffer[position] != rune('e') {
-							goto l1416
+							goto l1495
 						}
 						position++
-						goto l1415
-					l1416:
-						position, tokenIndex = position1415, tokenIndex1415
+						goto l1494
+					l1495:
+						position, tokenIndex = position1494, tokenIndex1494
 						if buffer[position] != rune('E') {
-							goto l1404
+							goto l1483
 						}
 						position++
 					}
-				l1415:
-					add(rulePegText, position1406)
+				l1494:
+					add(rulePegText, position1485)
 				}
-				if !_rules[ruleAction89]() {
-					goto l1404
+				if !_rules[ruleAction96]() {
+					goto l1483
 				}
-				add(ruleFALSE, position1405)
+				add(ruleFALSE, position1484)
 			}
 			return true
-		l1404:
-			position, tokenIndex = position1404, tokenIndex1404
+		l1483:
+			position, tokenIndex = position1483, tokenIndex1483
 			return false
 		},
-		/* 120 Wildcard <- <(<((ident ':' !':')? '*')> Action90)> */
+		/* 131 Wildcard <- <(<((ident ':' !':')? '*')> Action97)> */
 		func() bool {
-			position1417, tokenIndex1417 := position, tokenIndex
+			position1496, tokenIndex1496 := position, tokenIndex
 			{
-				position1418 := position
+				position1497 := position
 				{
-					position1419 := position
+					position1498 := position
 					{
-						position1420, tokenIndex1420 := position, tokenIndex
+						position1499, tokenIndex1499 := position, tokenIndex
 						if !_rules[ruleident]() {
-							goto l1420
+							goto l1499
 						}
 						if buffer[position] != rune(':') {
-							goto l1420
+							goto l1499
 						}
 						position++
 						{
-							position1422, tokenIndex1422 := position, tokenIndex
+							position1501, tokenIndex1501 := position, tokenIndex
 							if buffer[position] != rune(':') {
-								goto l1422
+								goto l1501
 							}
 							position++
-							goto l1420
-						l1422:
-							position, tokenIndex = position1422, tokenIndex1422
+							goto l1499
+						l1501:
+							position, tokenIndex = position1501, tokenIndex1501
 						}
-						goto l1421
-					l1420:
-						position, tokenIndex = position1420, tokenIndex1420
+						goto l1500
+					l1499:
+						position, tokenIndex = position1499, tokenIndex1499
 					}
-				l1421:
+				l1500:
 					if buffer[position] != rune('*') {
-						goto l1417
+						goto l1496
 					}
 					position++
-					add(rulePegText, position1419)
+					add(rulePegText, position1498)
 				}
-				if !_rules[ruleAction90]() {
-					goto l1417
+				if !_rules[ruleAction97]() {
+					goto l1496
 				}
-				add(ruleWildcard, position1418)
+				add(ruleWildcard, position1497)
 			}
 			return true
-		l1417:
-			position, tokenIndex = position1417, tokenIndex1417
+		l1496:
+			position, tokenIndex = position1496, tokenIndex1496
 			return false
 		},
-		/* 121 StringLiteral <- <(<('"' (('"' '"') / (!'"' .))* '"')> Action91)> */
+		/* 132 StringLiteral <- <(QuotedStringLiteral / DollarQuotedStringLiteral)> */
 		func() bool {
-			position1423, tokenIndex1423 := position, tokenIndex
+			position1502, tokenIndex1502 := position, tokenIndex
 			{
-				position1424 := position
+				position1503 := position
 				{
-					position1425 := position
+					position1504, tokenIndex1504 := position, tokenIndex
+					if !_rules[ruleQuotedStringLiteral]() {
+						goto l1505
+					}
+					goto l1504
+				l1505:
+					position, tokenIndex = position1504, tokenIndex1504
+					if !_rules[ruleDollarQuotedStringLiteral]() {
+						goto l1502
+					}
+				}
+			l1504:
+				add(ruleStringLiteral, position1503)
+			}
+			return true
+		l1502:
+			position, tokenIndex = position1502, tokenIndex1502
+			return false
+		},
+		/* 133 QuotedStringLiteral <- <(<('"' (('"' '"') / (!'"' .))* '"')> Action98)> */
+		func() bool {
+			position1506, tokenIndex1506 := position, tokenIndex
+			{
+				position1507 := position
+				{
+					position1508 := position
 					if buffer[position] != rune('"') {
-						goto l1423
+						goto l1506
 					}
 					position++
-				l1426:
+				l1509:
 					{
-						position1427, tokenIndex1427 := position, tokenIndex
+						position1510, tokenIndex1510 := position, tokenIndex
 						{
-							position1428, tokenIndex1428 := position, tokenIndex
+							position1511, tokenIndex1511 := position, tokenIndex
 							if buffer[position] != rune('"') {
-								goto l1429
+								goto l1512
 							}
 							position++
 							if buffer[position] != rune('"') {
-								goto l1429
+								goto l1512
 							}
 							position++
-							goto l1428
-						l1429:
-							position, tokenIndex = position1428, tokenIndex1428
+							goto l1511
+						l1512:
+							position, tokenIndex = position1511, tokenIndex1511
 							{
-								position1430, tokenIndex1430 := position, tokenIndex
+								position1513, tokenIndex1513 := position, tokenIndex
 								if buffer[position] != rune('"') {
-									goto l1430
+									goto l1513
 								}
 								position++
-								goto l1427
-							l1430:
-								position, tokenIndex = position1430, tokenIndex1430
+								goto l1510
+							l1513:
+								position, tokenIndex = position1513, tokenIndex1513
 							}
 							if !matchDot() {
-								goto l1427
+								goto l1510
 							}
 						}
-					l1428:
-						goto l1426
-					l1427:
-						position, tokenIndex = position1427, tokenIndex1427
+					l1511:
+						goto l1509
+					l1510:
+						position, tokenIndex = position1510, tokenIndex1510
 					}
 					if buffer[position] != rune('"') {
-						goto l1423
+						goto l1506
 					}
 					position++
-					add(rulePegText, position1425)
+					add(rulePegText, position1508)
 				}
-				if !_rules[ruleAction91]() {
-					goto l1423
+				if !_rules[ruleAction98]() {
+					goto l1506
 				}
-				add(ruleStringLiteral, position1424)
+				add(ruleQuotedStringLiteral, position1507)
 			}
 			return true
-		l1423:
-			position, tokenIndex = position1423, tokenIndex1423
+		l1506:
+			position, tokenIndex = position1506, tokenIndex1506
 			return false
 		},
-		/* 122 ISTREAM <- <(<(('i' / 'I') ('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M'))> Action92)> */
+		/* 134 DollarQuotedStringLiteral <- <(<(dollarQuoteOpen (!dollarQuoteClose .)* dollarQuoteClose)> Action99)> */
 		func() bool {
-			position1431, tokenIndex1431 := position, tokenIndex
+			position1514, tokenIndex1514 := position, tokenIndex
 			{
-				position1432 := position
+				position1515 := position
 				{
-					position1433 := position
+					position1516 := position
+					if !_rules[ruledollarQuoteOpen]() {
+						goto l1514
+					}
+				l1517:
 					{
-						position1434, tokenIndex1434 := position, tokenIndex
-						if buffer[position] != rune('i') {
-							goto l1435
+						position1518, tokenIndex1518 := position, tokenIndex
+						{
+							position1519, tokenIndex1519 := position, tokenIndex
+							if !_rules[ruledollarQuoteClose]() {
+								goto l1519
+							}
+							goto l1518
+						l1519:
+							position, tokenIndex = position1519, tokenIndex1519
 						}
-						position++
-						goto l1434
-					l1435:
-						position, tokenIndex = position1434, tokenIndex1434
-						if buffer[position] != rune('I') {
-							goto l1431
+						if !matchDot() {
+							goto l1518
 						}
-						position++
+						goto l1517
+					l1518:
+						position, tokenIndex = position1518, tokenIndex1518
 					}
-				l1434:
+					if !_rules[ruledollarQuoteClose]() {
+						goto l1514
+					}
+					add(rulePegText, position1516)
+				}
+				if !_rules[ruleAction99]() {
+					goto l1514
+				}
+				add(ruleDollarQuotedStringLiteral, position1515)
+			}
+			return true
+		l1514:
+			position, tokenIndex = position1514, tokenIndex1514
+			return false
+		},
+		/* 135 IntervalLiteral <- <(<(('i' / 'I') ('n' / 'N') ('t' / 'T') ('e' / 'E') ('r' / 'R') ('v' / 'V') ('a' / 'A') ('l' / 'L') sp '"' spOpt '-'? [0-9]+ ('.' [0-9]+)? sp ([a-z] / [A-Z])+ spOpt '"')> Action100)> */
+		func() bool {
+			position1520, tokenIndex1520 := position, tokenIndex
+			{
+				position1521 := position
+				{
+					position1522 := position
 					{
-						position1436, tokenIndex1436 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l1437
+						position1523, tokenIndex1523 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l1524
 						}
 						position++
-						goto l1436
-					l1437:
-						position, tokenIndex = position1436, tokenIndex1436
-						if buffer[position] != rune('S') {
-							goto l1431
+						goto l1523
+					l1524:
+						position, tokenIndex = position1523, tokenIndex1523
+						if buffer[position] != rune('I') {
+							goto l1520
 						}
 						position++
 					}
-				l1436:
+				l1523:
 					{
-						position1438, tokenIndex1438 := position, tokenIndex
+						position1525, tokenIndex1525 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l1526
+						}
+						position++
+						goto l1525
+					l1526:
+						position, tokenIndex = position1525, tokenIndex1525
+						if buffer[position] != rune('N') {
+							goto l1520
+						}
+						position++
+					}
+				l1525:
+					{
+						position1527, tokenIndex1527 := position, tokenIndex
 						if buffer[position] != rune('t') {
-							goto l1439
+							goto l1528
 						}
 						position++
-						goto l1438
-					l1439:
-						position, tokenIndex = position1438, tokenIndex1438
+						goto l1527
+					l1528:
+						position, tokenIndex = position1527, tokenIndex1527
 						if buffer[position] != rune('T') {
-							goto l1431
+							goto l1520
 						}
 						position++
 					}
-				l1438:
+				l1527:
 					{
-						position1440, tokenIndex1440 := position, tokenIndex
+						position1529, tokenIndex1529 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l1530
+						}
+						position++
+						goto l1529
+					l1530:
+						position, tokenIndex = position1529, tokenIndex1529
+						if buffer[position] != rune('E') {
+							goto l1520
+						}
+						position++
+					}
+				l1529:
+					{
+						position1531, tokenIndex1531 := position, tokenIndex
 						if buffer[position] != rune('r') {
-							goto l1441
+							goto l1532
 						}
 						position++
-						goto l1440
-					l1441:
-						position, tokenIndex = position1440, tokenIndex1440
+						goto l1531
+					l1532:
+						position, tokenIndex = position1531, tokenIndex1531
 						if buffer[position] != rune('R') {
-							goto l1431
+							goto l1520
 						}
 						position++
 					}
-				l1440:
+				l1531:
 					{
-						position1442, tokenIndex1442 := position, tokenIndex
-						if buffer[position] != rune('e') {
-							goto l1443
+						position1533, tokenIndex1533 := position, tokenIndex
+						if buffer[position] != rune('v') {
+							goto l1534
 						}
 						position++
-						goto l1442
-					l1443:
-						position, tokenIndex = position1442, tokenIndex1442
-						if buffer[position] != rune('E') {
-							goto l1431
+						goto l1533
+					l1534:
+						position, tokenIndex = position1533, tokenIndex1533
+						if buffer[position] != rune('V') {
+							goto l1520
 						}
 						position++
 					}
-				l1442:
+				l1533:
 					{
-						position1444, tokenIndex1444 := position, tokenIndex
+						position1535, tokenIndex1535 := position, tokenIndex
 						if buffer[position] != rune('a') {
-							goto l1445
+							goto l1536
 						}
 						position++
-						goto l1444
-					l1445:
-						position, tokenIndex = position1444, tokenIndex1444
+						goto l1535
+					l1536:
+						position, tokenIndex = position1535, tokenIndex1535
 						if buffer[position] != rune('A') {
-							goto l1431
+							goto l1520
 						}
 						position++
 					}
-				l1444:
+				l1535:
 					{
-						position1446, tokenIndex1446 := position, tokenIndex
-						if buffer[position] != rune('m') {
-							goto l1447
+						position1537, tokenIndex1537 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l1538
 						}
 						position++
-						goto l1446
-					l1447:
-						position, tokenIndex = position1446, tokenIndex1446
-						if buffer[position] != rune('M') {
-							goto l1431
+						goto l1537
+					l1538:
+						position, tokenIndex = position1537, tokenIndex1537
+						if buffer[position] != rune('L') {
+							goto l1520
 						}
 						position++
 					}
-				l1446:
-					add(rulePegText, position1433)
+				l1537:
+					if !_rules[rulesp]() {
+						goto l1520
+					}
+					if buffer[position] != rune('"') {
+						goto l1520
+					}
+					position++
+					if !_rules[rulespOpt]() {
+						goto l1520
+					}
+					{
+						position1539, tokenIndex1539 := position, tokenIndex
+						if buffer[position] != rune('-') {
+							goto l1539
+						}
+						position++
+						goto l1540
+					l1539:
+						position, tokenIndex = position1539, tokenIndex1539
+					}
+				l1540:
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l1520
+					}
+					position++
+				l1541:
+					{
+						position1542, tokenIndex1542 := position, tokenIndex
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l1542
+						}
+						position++
+						goto l1541
+					l1542:
+						position, tokenIndex = position1542, tokenIndex1542
+					}
+					{
+						position1543, tokenIndex1543 := position, tokenIndex
+						if buffer[position] != rune('.') {
+							goto l1543
+						}
+						position++
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l1543
+						}
+						position++
+					l1545:
+						{
+							position1546, tokenIndex1546 := position, tokenIndex
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l1546
+							}
+							position++
+							goto l1545
+						l1546:
+							position, tokenIndex = position1546, tokenIndex1546
+						}
+						goto l1544
+					l1543:
+						position, tokenIndex = position1543, tokenIndex1543
+					}
+				l1544:
+					if !_rules[rulesp]() {
+						goto l1520
+					}
+					{
+						position1549, tokenIndex1549 := position, tokenIndex
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l1550
+						}
+						position++
+						goto l1549
+					l1550:
+						position, tokenIndex = position1549, tokenIndex1549
+						if c := buffer[position]; c < rune('A') || c > rune('Z') {
+							goto l1520
+						}
+						position++
+					}
+				l1549:
+				l1547:
+					{
+						position1548, tokenIndex1548 := position, tokenIndex
+						{
+							position1551, tokenIndex1551 := position, tokenIndex
+							if c := buffer[position]; c < rune('a') || c > rune('z') {
+								goto l1552
+							}
+							position++
+							goto l1551
+						l1552:
+							position, tokenIndex = position1551, tokenIndex1551
+							if c := buffer[position]; c < rune('A') || c > rune('Z') {
+								goto l1548
+							}
+							position++
+						}
+					l1551:
+						goto l1547
+					l1548:
+						position, tokenIndex = position1548, tokenIndex1548
+					}
+					if !_rules[rulespOpt]() {
+						goto l1520
+					}
+					if buffer[position] != rune('"') {
+						goto l1520
+					}
+					position++
+					add(rulePegText, position1522)
 				}
-				if !_rules[ruleAction92]() {
-					goto l1431
+				if !_rules[ruleAction100]() {
+					goto l1520
 				}
-				add(ruleISTREAM, position1432)
+				add(ruleIntervalLiteral, position1521)
 			}
 			return true
-		l1431:
-			position, tokenIndex = position1431, tokenIndex1431
+		l1520:
+			position, tokenIndex = position1520, tokenIndex1520
 			return false
 		},
-		/* 123 DSTREAM <- <(<(('d' / 'D') ('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M'))> Action93)> */
+		/* 136 ISTREAM <- <(<(('i' / 'I') ('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M'))> Action101)> */
 		func() bool {
-			position1448, tokenIndex1448 := position, tokenIndex
+			position1553, tokenIndex1553 := position, tokenIndex
 			{
-				position1449 := position
+				position1554 := position
 				{
-					position1450 := position
+					position1555 := position
 					{
-						position1451, tokenIndex1451 := position, tokenIndex
-						if buffer[position] != rune('d') {
-							goto l1452
+						position1556, tokenIndex1556 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l1557
 						}
 						position++
-						goto l1451
-					l1452:
-						position, tokenIndex = position1451, tokenIndex1451
-						if buffer[position] != rune('D') {
-							goto l1448
+						goto l1556
+					l1557:
+						position, tokenIndex = position1556, tokenIndex1556
+						if buffer[position] != rune('I') {
+							goto l1553
 						}
 						position++
 					}
-				l1451:
+				l1556:
 					{
-						position1453, tokenIndex1453 := position, tokenIndex
+						position1558, tokenIndex1558 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l1454
+							goto l1559
 						}
 						position++
-						goto l1453
-					l1454:
-						position, tokenIndex = position1453, tokenIndex1453
+						goto l1558
+					l1559:
+						position, tokenIndex = position1558, tokenIndex1558
 						if buffer[position] != rune('S') {
-							goto l1448
+							goto l1553
 						}
 						position++
 					}
-				l1453:
+				l1558:
 					{
-						position1455, tokenIndex1455 := position, tokenIndex
+						position1560, tokenIndex1560 := position, tokenIndex
 						if buffer[position] != rune('t') {
-							goto l1456
+							goto l1561
 						}
 						position++
-						goto l1455
-					l1456:
-						position, tokenIndex = position1455, tokenIndex1455
+						goto l1560
+					l1561:
+						position, tokenIndex = position1560, tokenIndex1560
 						if buffer[position] != rune('T') {
-							goto l1448
+							goto l1553
 						}
 						position++
 					}
-				l1455:
+				l1560:
 					{
-						position1457, tokenIndex1457 := position, tokenIndex
+						position1562, tokenIndex1562 := position, tokenIndex
 						if buffer[position] != rune('r') {
-							goto l1458
+							goto l1563
 						}
 						position++
-						goto l1457
-					l1458:
-						position, tokenIndex = position1457, tokenIndex1457
+						goto l1562
+					l1563:
+						position, tokenIndex = position1562, tokenIndex1562
 						if buffer[position] != rune('R') {
-							goto l1448
+							goto l1553
 						}
 						position++
 					}
-				l1457:
+				l1562:
 					{
-						position1459, tokenIndex1459 := position, tokenIndex
+						position1564, tokenIndex1564 := position, tokenIndex
 						if buffer[position] != rune('e') {
-							goto l1460
+							goto l1565
 						}
 						position++
-						goto l1459
-					l1460:
-						position, tokenIndex = position1459, tokenIndex1459
+						goto l1564
+					l1565:
+						position, tokenIndex = position1564, tokenIndex1564
 						if buffer[position] != rune('E') {
-							goto l1448
+							goto l1553
 						}
 						position++
 					}
-				l1459:
+				l1564:
 					{
-						position1461, tokenIndex1461 := position, tokenIndex
+						position1566, tokenIndex1566 := position, tokenIndex
 						if buffer[position] != rune('a') {
-							goto l1462
+							goto l1567
 						}
 						position++
-						goto l1461
-					l1462:
-						position, tokenIndex = position1461, tokenIndex1461
+						goto l1566
+					l1567:
+						position, tokenIndex = position1566, tokenIndex1566
 						if buffer[position] != rune('A') {
-							goto l1448
+							goto l1553
 						}
 						position++
 					}
-				l1461:
+				l1566:
 					{
-						position1463, tokenIndex1463 := position, tokenIndex
+						position1568, tokenIndex1568 := position, tokenIndex
 						if buffer[position] != rune('m') {
-							goto l1464
+							goto l1569
 						}
 						position++
-						goto l1463
-					l1464:
-						position, tokenIndex = position1463, tokenIndex1463
+						goto l1568
+					l1569:
+						position, tokenIndex = position1568, tokenIndex1568
 						if buffer[position] != rune('M') {
-							goto l1448
+							goto l1553
 						}
 						position++
 					}
-				l1463:
-					add(rulePegText, position1450)
+				l1568:
+					add(rulePegText, position1555)
 				}
-				if !_rules[ruleAction93]() {
-					goto l1448
+				if !_rules[ruleAction101]() {
+					goto l1553
 				}
-				add(ruleDSTREAM, position1449)
+				add(ruleISTREAM, position1554)
 			}
 			return true
-		l1448:
-			position, tokenIndex = position1448, tokenIndex1448
+		l1553:
+			position, tokenIndex = position1553, tokenIndex1553
 			return false
 		},
-		/* 124 RSTREAM <- <(<(('r' / 'R') ('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M'))> Action94)> */
+		/* 137 DSTREAM <- <(<(('d' / 'D') ('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M'))> Action102)> */
 		func() bool {
-			position1465, tokenIndex1465 := position, tokenIndex
+			position1570, tokenIndex1570 := position, tokenIndex
 			{
-				position1466 := position
+				position1571 := position
 				{
-					position1467 := position
+					position1572 := position
 					{
-						position1468, tokenIndex1468 := position, tokenIndex
-						if buffer[position] != rune('r') {
-							goto l1469
+						position1573, tokenIndex1573 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l1574
 						}
 						position++
-						goto l1468
-					l1469:
-						position, tokenIndex = position1468, tokenIndex1468
-						if buffer[position] != rune('R') {
-							goto l1465
+						goto l1573
+					l1574:
+						position, tokenIndex = position1573, tokenIndex1573
+						if buffer[position] != rune('D') {
+							goto l1570
 						}
 						position++
 					}
-				l1468:
+				l1573:
 					{
-						position1470, tokenIndex1470 := position, tokenIndex
+						position1575, tokenIndex1575 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l1471
+							goto l1576
 						}
 						position++
-						goto l1470
-					l1471:
-						position, tokenIndex = position1470, tokenIndex1470
+						goto l1575
+					l1576:
+						position, tokenIndex = position1575, tokenIndex1575
 						if buffer[position] != rune('S') {
-							goto l1465
+							goto l1570
 						}
 						position++
 					}
-				l1470:
+				l1575:
 					{
-						position1472, tokenIndex1472 := position, tokenIndex
+						position1577, tokenIndex1577 := position, tokenIndex
 						if buffer[position] != rune('t') {
-							goto l1473
+							goto l1578
 						}
 						position++
-						goto l1472
-					l1473:
-						position, tokenIndex = position1472, tokenIndex1472
+						goto l1577
+					l1578:
+						position, tokenIndex = position1577, tokenIndex1577
 						if buffer[position] != rune('T') {
-							goto l1465
+							goto l1570
 						}
 						position++
 					}
-				l1472:
+				l1577:
 					{
-						position1474, tokenIndex1474 := position, tokenIndex
+						position1579, tokenIndex1579 := position, tokenIndex
 						if buffer[position] != rune('r') {
-							goto l1475
+							goto l1580
 						}
 						position++
-						goto l1474
-					l1475:
-						position, tokenIndex = position1474, tokenIndex1474
+						goto l1579
+					l1580:
+						position, tokenIndex = position1579, tokenIndex1579
 						if buffer[position] != rune('R') {
-							goto l1465
+							goto l1570
 						}
 						position++
 					}
-				l1474:
+				l1579:
 					{
-						position1476, tokenIndex1476 := position, tokenIndex
+						position1581, tokenIndex1581 := position, tokenIndex
 						if buffer[position] != rune('e') {
-							goto l1477
+							goto l1582
 						}
 						position++
-						goto l1476
-					l1477:
-						position, tokenIndex = position1476, tokenIndex1476
+						goto l1581
+					l1582:
+						position, tokenIndex = position1581, tokenIndex1581
 						if buffer[position] != rune('E') {
-							goto l1465
+							goto l1570
 						}
 						position++
 					}
-				l1476:
+				l1581:
 					{
-						position1478, tokenIndex1478 := position, tokenIndex
+						position1583, tokenIndex1583 := position, tokenIndex
 						if buffer[position] != rune('a') {
-							goto l1479
+							goto l1584
 						}
 						position++
-						goto l1478
-					l1479:
-						position, tokenIndex = position1478, tokenIndex1478
+						goto l1583
+					l1584:
+						position, tokenIndex = position1583, tokenIndex1583
 						if buffer[position] != rune('A') {
-							goto l1465
+							goto l1570
 						}
 						position++
 					}
-				l1478:
+				l1583:
 					{
-						position1480, tokenIndex1480 := position, tokenIndex
+						position1585, tokenIndex1585 := position, tokenIndex
 						if buffer[position] != rune('m') {
-							goto l1481
+							goto l1586
 						}
 						position++
-						goto l1480
-					l1481:
-						position, tokenIndex = position1480, tokenIndex1480
+						goto l1585
+					l1586:
+						position, tokenIndex = position1585, tokenIndex1585
 						if buffer[position] != rune('M') {
-							goto l1465
+							goto l1570
 						}
 						position++
 					}
-				l1480:
-					add(rulePegText, position1467)
+				l1585:
+					add(rulePegText, position1572)
 				}
-				if !_rules[ruleAction94]() {
-					goto l1465
+				if !_rules[ruleAction102]() {
+					goto l1570
 				}
-				add(ruleRSTREAM, position1466)
+				add(ruleDSTREAM, position1571)
 			}
 			return true
-		l1465:
-			position, tokenIndex = position1465, tokenIndex1465
+		l1570:
+			position, tokenIndex = position1570, tokenIndex1570
 			return false
 		},
-		/* 125 TUPLES <- <(<(('t' / 'T') ('u' / 'U') ('p' / 'P') ('l' / 'L') ('e' / 'E') ('s' / 'S'))> Action95)> */
+		/* 138 RSTREAM <- <(<(('r' / 'R') ('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M'))> Action103)> */
 		func() bool {
-			position1482, tokenIndex1482 := position, tokenIndex
+			position1587, tokenIndex1587 := position, tokenIndex
 			{
-				position1483 := position
+				position1588 := position
 				{
-					position1484 := position
+					position1589 := position
 					{
-						position1485, tokenIndex1485 := position, tokenIndex
-						if buffer[position] != rune('t') {
-							goto l1486
+						position1590, tokenIndex1590 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l1591
 						}
 						position++
-						goto l1485
-					l1486:
-						position, tokenIndex = position1485, tokenIndex1485
-						if buffer[position] != rune('T') {
-							goto l1482
+						goto l1590
+					l1591:
+						position, tokenIndex = position1590, tokenIndex1590
+						if buffer[position] != rune('R') {
+							goto l1587
 						}
 						position++
 					}
-				l1485:
+				l1590:
 					{
-						position1487, tokenIndex1487 := position, tokenIndex
-						if buffer[position] != rune('u') {
-							goto l1488
-						}
-						position++
-						goto l1487
-					l1488:
-						position, tokenIndex = position1487, tokenIndex1487
+						position1592, tokenIndex1592 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l1593
+						}
+						position++
+						goto l1592
+					l1593:
+						position, tokenIndex = position1592, tokenIndex1592
+						if buffer[position] != rune('S') {
+							goto l1587
+						}
+						position++
+					}
+				l1592:
+					{
+						position1594, tokenIndex1594 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l1595
+						}
+						position++
+						goto l1594
+					l1595:
+						position, tokenIndex = position1594, tokenIndex1594
+						if buffer[position] != rune('T') {
+							goto l1587
+						}
+						position++
+					}
+				l1594:
+					{
+						position1596, tokenIndex1596 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l1597
+						}
+						position++
+						goto l1596
+					l1597:
+						position, tokenIndex = position1596, tokenIndex1596
+						if buffer[position] != rune('R') {
+							goto l1587
+						}
+						position++
+					}
+				l1596:
+					{
+						position1598, tokenIndex1598 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l1599
+						}
+						position++
+						goto l1598
+					l1599:
+						position, tokenIndex = position1598, tokenIndex1598
+						if buffer[position] != rune('E') {
+							goto l1587
+						}
+						position++
+					}
+				l1598:
+					{
+						position1600, tokenIndex1600 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l1601
+						}
+						position++
+						goto l1600
+					l1601:
+						position, tokenIndex = position1600, tokenIndex1600
+						if buffer[position] != rune('A') {
+							goto l1587
+						}
+						position++
+					}
+				l1600:
+					{
+						position1602, tokenIndex1602 := position, tokenIndex
+						if buffer[position] != rune('m') {
+							goto l1603
+						}
+						position++
+						goto l1602
+					l1603:
+						position, tokenIndex = position1602, tokenIndex1602
+						if buffer[position] != rune('M') {
+							goto l1587
+						}
+						position++
+					}
+				l1602:
+					add(rulePegText, position1589)
+				}
+				if !_rules[ruleAction103]() {
+					goto l1587
+				}
+				add(ruleRSTREAM, position1588)
+			}
+			return true
+		l1587:
+			position, tokenIndex = position1587, tokenIndex1587
+			return false
+		},
+		/* 139 TUPLES <- <(<(('t' / 'T') ('u' / 'U') ('p' / 'P') ('l' / 'L') ('e' / 'E') ('s' / 'S'))> Action104)> */
+		func() bool {
+			position1604, tokenIndex1604 := position, tokenIndex
+			{
+				position1605 := position
+				{
+					position1606 := position
+					{
+						position1607, tokenIndex1607 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l1608
+						}
+						position++
+						goto l1607
+					l1608:
+						position, tokenIndex = position1607, tokenIndex1607
+						if buffer[position] != rune('T') {
+							goto l1604
+						}
+						position++
+					}
+				l1607:
+					{
+						position1609, tokenIndex1609 := position, tokenIndex
+						if buffer[position] != rune('u') {
+							goto l1610
+						}
+						position++
+						goto l1609
+					l1610:
+						position, tokenIndex = position1609, tokenIndex1609
 						if buffer[position] != rune('U') {
-							goto l1482
+							goto l1604
 						}
 						position++
 					}
-				l1487:
+				l1609:
 					{
-						position1489, tokenIndex1489 := position, tokenIndex
+						position1611, tokenIndex1611 := position, tokenIndex
 						if buffer[position] != rune('p') {
-							goto l1490
+							goto l1612
 						}
 						position++
-						goto l1489
-					l1490:
-						position, tokenIndex = position1489, tokenIndex1489
+						goto l1611
+					l1612:
+						position, tokenIndex = position1611, tokenIndex1611
 						if buffer[position] != rune('P') {
-							goto l1482
+							goto l1604
 						}
 						position++
 					}
-				l1489:
+				l1611:
 					{
-						position1491, tokenIndex1491 := position, tokenIndex
+						position1613, tokenIndex1613 := position, tokenIndex
 						if buffer[position] != rune('l') {
-							goto l1492
+							goto l1614
 						}
 						position++
-						goto l1491
-					l1492:
-						position, tokenIndex = position1491, tokenIndex1491
+						goto l1613
+					l1614:
+						position, tokenIndex = position1613, tokenIndex1613
 						if buffer[position] != rune('L') {
-							goto l1482
+							goto l1604
 						}
 						position++
 					}
-				l1491:
+				l1613:
 					{
-						position1493, tokenIndex1493 := position, tokenIndex
+						position1615, tokenIndex1615 := position, tokenIndex
 						if buffer[position] != rune('e') {
-							goto l1494
+							goto l1616
 						}
 						position++
-						goto l1493
-					l1494:
-						position, tokenIndex = position1493, tokenIndex1493
+						goto l1615
+					l1616:
+						position, tokenIndex = position1615, tokenIndex1615
 						if buffer[position] != rune('E') {
-							goto l1482
+							goto l1604
 						}
 						position++
 					}
-				l1493:
+				l1615:
 					{
-						position1495, tokenIndex1495 := position, tokenIndex
+						position1617, tokenIndex1617 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l1496
+							goto l1618
 						}
 						position++
-						goto l1495
-					l1496:
-						position, tokenIndex = position1495, tokenIndex1495
+						goto l1617
+					l1618:
+						position, tokenIndex = position1617, tokenIndex1617
 						if buffer[position] != rune('S') {
-							goto l1482
+							goto l1604
 						}
 						position++
 					}
-				l1495:
-					add(rulePegText, position1484)
+				l1617:
+					add(rulePegText, position1606)
 				}
-				if !_rules[ruleAction95]() {
-					goto l1482
+				if !_rules[ruleAction104]() {
+					goto l1604
 				}
-				add(ruleTUPLES, position1483)
+				add(ruleTUPLES, position1605)
 			}
 			return true
-		l1482:
-			position, tokenIndex = position1482, tokenIndex1482
+		l1604:
+			position, tokenIndex = position1604, tokenIndex1604
 			return false
 		},
-		/* 126 SECONDS <- <(<(('s' / 'S') ('e' / 'E') ('c' / 'C') ('o' / 'O') ('n' / 'N') ('d' / 'D') ('s' / 'S'))> Action96)> */
+		/* 140 SECONDS <- <(<(('s' / 'S') ('e' / 'E') ('c' / 'C') ('o' / 'O') ('n' / 'N') ('d' / 'D') ('s' / 'S'))> Action105)> */
 		func() bool {
-			position1497, tokenIndex1497 := position, tokenIndex
+			position1619, tokenIndex1619 := position, tokenIndex
 			{
-				position1498 := position
+				position1620 := position
 				{
-					position1499 := position
+					position1621 := position
 					{
-						position1500, tokenIndex1500 := position, tokenIndex
+						position1622, tokenIndex1622 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l1501
+							goto l1623
 						}
 						position++
-						goto l1500
-					l1501:
-						position, tokenIndex = position1500, tokenIndex1500
+						goto l1622
+					l1623:
+						position, tokenIndex = position1622, tokenIndex1622
 						if buffer[position] != rune('S') {
-							goto l1497
+							goto l1619
 						}
 						position++
 					}
-				l1500:
+				l1622:
 					{
-						position1502, tokenIndex1502 := position, tokenIndex
+						position1624, tokenIndex1624 := position, tokenIndex
 						if buffer[position] != rune('e') {
-							goto l1503
+							goto l1625
 						}
 						position++
-						goto l1502
-					l1503:
-						position, tokenIndex = position1502, tokenIndex1502
+						goto l1624
+					l1625:
+						position, tokenIndex = position1624, tokenIndex1624
 						if buffer[position] != rune('E') {
-							goto l1497
+							goto l1619
 						}
 						position++
 					}
-				l1502:
+				l1624:
 					{
-						position1504, tokenIndex1504 := position, tokenIndex
+						position1626, tokenIndex1626 := position, tokenIndex
 						if buffer[position] != rune('c') {
-							goto l1505
+							goto l1627
 						}
 						position++
-						goto l1504
-					l1505:
-						position, tokenIndex = position1504, tokenIndex1504
+						goto l1626
+					l1627:
+						position, tokenIndex = position1626, tokenIndex1626
 						if buffer[position] != rune('C') {
-							goto l1497
+							goto l1619
 						}
 						position++
 					}
-				l1504:
+				l1626:
 					{
-						position1506, tokenIndex1506 := position, tokenIndex
+						position1628, tokenIndex1628 := position, tokenIndex
 						if buffer[position] != rune('o') {
-							goto l1507
+							goto l1629
 						}
 						position++
-						goto l1506
-					l1507:
-						position, tokenIndex = position1506, tokenIndex1506
+						goto l1628
+					l1629:
+						position, tokenIndex = position1628, tokenIndex1628
 						if buffer[position] != rune('O') {
-							goto l1497
+							goto l1619
 						}
 						position++
 					}
-				l1506:
+				l1628:
 					{
-						position1508, tokenIndex1508 := position, tokenIndex
+						position1630, tokenIndex1630 := position, tokenIndex
 						if buffer[position] != rune('n') {
-							goto l1509
+							goto l1631
 						}
 						position++
-						goto l1508
-					l1509:
-						position, tokenIndex = position1508, tokenIndex1508
+						goto l1630
+					l1631:
+						position, tokenIndex = position1630, tokenIndex1630
 						if buffer[position] != rune('N') {
-							goto l1497
+							goto l1619
 						}
 						position++
 					}
-				l1508:
+				l1630:
 					{
-						position1510, tokenIndex1510 := position, tokenIndex
+						position1632, tokenIndex1632 := position, tokenIndex
 						if buffer[position] != rune('d') {
-							goto l1511
+							goto l1633
 						}
 						position++
-						goto l1510
-					l1511:
-						position, tokenIndex = position1510, tokenIndex1510
+						goto l1632
+					l1633:
+						position, tokenIndex = position1632, tokenIndex1632
 						if buffer[position] != rune('D') {
-							goto l1497
+							goto l1619
 						}
 						position++
 					}
-				l1510:
+				l1632:
 					{
-						position1512, tokenIndex1512 := position, tokenIndex
+						position1634, tokenIndex1634 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l1513
+							goto l1635
 						}
 						position++
-						goto l1512
-					l1513:
-						position, tokenIndex = position1512, tokenIndex1512
+						goto l1634
+					l1635:
+						position, tokenIndex = position1634, tokenIndex1634
 						if buffer[position] != rune('S') {
-							goto l1497
+							goto l1619
 						}
 						position++
 					}
-				l1512:
-					add(rulePegText, position1499)
+				l1634:
+					add(rulePegText, position1621)
 				}
-				if !_rules[ruleAction96]() {
-					goto l1497
+				if !_rules[ruleAction105]() {
+					goto l1619
 				}
-				add(ruleSECONDS, position1498)
+				add(ruleSECONDS, position1620)
 			}
 			return true
-		l1497:
-			position, tokenIndex = position1497, tokenIndex1497
+		l1619:
+			position, tokenIndex = position1619, tokenIndex1619
 			return false
 		},
-		/* 127 MILLISECONDS <- <(<(('m' / 'M') ('i' / 'I') ('l' / 'L') ('l' / 'L') ('i' / 'I') ('s' / 'S') ('e' / 'E') ('c' / 'C') ('o' / 'O') ('n' / 'N') ('d' / 'D') ('s' / 'S'))> Action97)> */
+		/* 141 MILLISECONDS <- <(<(('m' / 'M') ('i' / 'I') ('l' / 'L') ('l' / 'L') ('i' / 'I') ('s' / 'S') ('e' / 'E') ('c' / 'C') ('o' / 'O') ('n' / 'N') ('d' / 'D') ('s' / 'S'))> Action106)> */
 		func() bool {
-			position1514, tokenIndex1514 := position, tokenIndex
+			position1636, tokenIndex1636 := position, tokenIndex
 			{
-				position1515 := position
+				position1637 := position
 				{
-					position1516 := position
+					position1638 := position
 					{
-						position1517, tokenIndex1517 := position, tokenIndex
+						position1639, tokenIndex1639 := position, tokenIndex
 						if buffer[position] != rune('m') {
-							goto l1518
+							goto l1640
 						}
 						position++
-						goto l1517
-					l1518:
-						position, tokenIndex = position1517, tokenIndex1517
+						goto l1639
+					l1640:
+						position, tokenIndex = position1639, tokenIndex1639
 						if buffer[position] != rune('M') {
-							goto l1514
+							goto l1636
 						}
 						position++
 					}
-				l1517:
+				l1639:
 					{
-						position1519, tokenIndex1519 := position, tokenIndex
+						position1641, tokenIndex1641 := position, tokenIndex
 						if buffer[position] != rune('i') {
-							goto l1520
+							goto l1642
 						}
 						position++
-						goto l1519
-					l1520:
-						position, tokenIndex = position1519, tokenIndex1519
+						goto l1641
+					l1642:
+						position, tokenIndex = position1641, tokenIndex1641
 						if buffer[position] != rune('I') {
-							goto l1514
+							goto l1636
 						}
 						position++
 					}
-				l1519:
+				l1641:
 					{
-						position1521, tokenIndex1521 := position, tokenIndex
+						position1643, tokenIndex1643 := position, tokenIndex
 						if buffer[position] != rune('l') {
-							goto l1522
+							goto l1644
 						}
 						position++
-						goto l1521
-					l1522:
-						position, tokenIndex = position1521, tokenIndex1521
+						goto l1643
+					l1644:
+						position, tokenIndex = position1643, tokenIndex1643
 						if buffer[position] != rune('L') {
-							goto l1514
+							goto l1636
 						}
 						position++
 					}
-				l1521:
+				l1643:
 					{
-						position1523, tokenIndex1523 := position, tokenIndex
+						position1645, tokenIndex1645 := position, tokenIndex
 						if buffer[position] != rune('l') {
-							goto l1524
+							goto l1646
 						}
 						position++
-						goto l1523
-					l1524:
-						position, tokenIndex = position1523, tokenIndex1523
+						goto l1645
+					l1646:
+						position, tokenIndex = position1645, tokenIndex1645
 						if buffer[position] != rune('L') {
-							goto l1514
+							goto l1636
 						}
 						position++
 					}
-				l1523:
+				l1645:
 					{
-						position1525, tokenIndex1525 := position, tokenIndex
+						position1647, tokenIndex1647 := position, tokenIndex
 						if buffer[position] != rune('i') {
-							goto l1526
+							goto l1648
 						}
 						position++
-						goto l1525
-					l1526:
-						position, tokenIndex = position1525, tokenIndex1525
+						goto l1647
+					l1648:
+						position, tokenIndex = position1647, tokenIndex1647
 						if buffer[position] != rune('I') {
-							goto l1514
+							goto l1636
 						}
 						position++
 					}
-				l1525:
+				l1647:
 					{
-						position1527, tokenIndex1527 := position, tokenIndex
+						position1649, tokenIndex1649 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l1528
+							goto l1650
 						}
 						position++
-						goto l1527
-					l1528:
-						position, tokenIndex = position1527, tokenIndex1527
+						goto l1649
+					l1650:
+						position, tokenIndex = position1649, tokenIndex1649
 						if buffer[position] != rune('S') {
-							goto l1514
+							goto l1636
 						}
 						position++
 					}
-				l1527:
+				l1649:
 					{
-						position1529, tokenIndex1529 := position, tokenIndex
+						position1651, tokenIndex1651 := position, tokenIndex
 						if buffer[position] != rune('e') {
-							goto l1530
+							goto l1652
 						}
 						position++
-						goto l1529
-					l1530:
-						position, tokenIndex = position1529, tokenIndex1529
+						goto l1651
+					l1652:
+						position, tokenIndex = position1651, tokenIndex1651
 						if buffer[position] != rune('E') {
-							goto l1514
+							goto l1636
 						}
 						position++
 					}
-				l1529:
+				l1651:
 					{
-						position1531, tokenIndex1531 := position, tokenIndex
+						position1653, tokenIndex1653 := position, tokenIndex
 						if buffer[position] != rune('c') {
-							goto l1532
+							goto l1654
 						}
 						position++
-						goto l1531
-					l1532:
-						position, tokenIndex = position1531, tokenIndex1531
+						goto l1653
+					l1654:
+						position, tokenIndex = position1653, tokenIndex1653
 						if buffer[position] != rune('C') {
-							goto l1514
+							goto l1636
 						}
 						position++
 					}
-				l1531:
+				l1653:
 					{
-						position1533, tokenIndex1533 := position, tokenIndex
+						position1655, tokenIndex1655 := position, tokenIndex
 						if buffer[position] != rune('o') {
-							goto l1534
+							goto l1656
 						}
 						position++
-						goto l1533
-					l1534:
-						position, tokenIndex = position1533, tokenIndex1533
+						goto l1655
+					l1656:
+						position, tokenIndex = position1655, tokenIndex1655
 						if buffer[position] != rune('O') {
-							goto l1514
+							goto l1636
 						}
 						position++
 					}
-				l1533:
+				l1655:
 					{
-						position1535, tokenIndex1535 := position, tokenIndex
+						position1657, tokenIndex1657 := position, tokenIndex
 						if buffer[position] != rune('n') {
-							goto l1536
+							goto l1658
 						}
 						position++
-						goto l1535
-					l1536:
-						position, tokenIndex = position1535, tokenIndex1535
+						goto l1657
+					l1658:
+						position, tokenIndex = position1657, tokenIndex1657
 						if buffer[position] != rune('N') {
-							goto l1514
+							goto l1636
 						}
 						position++
 					}
-				l1535:
+				l1657:
 					{
-						position1537, tokenIndex1537 := position, tokenIndex
+						position1659, tokenIndex1659 := position, tokenIndex
 						if buffer[position] != rune('d') {
-							goto l1538
+							goto l1660
 						}
 						position++
-						goto l1537
-					l1538:
-						position, tokenIndex = position1537, tokenIndex1537
+						goto l1659
+					l1660:
+						position, tokenIndex = position1659, tokenIndex1659
 						if buffer[position] != rune('D') {
-							goto l1514
+							goto l1636
 						}
 						position++
 					}
-				l1537:
+				l1659:
 					{
-						position1539, tokenIndex1539 := position, tokenIndex
+						position1661, tokenIndex1661 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l1540
+							goto l1662
 						}
 						position++
-						goto l1539
-					l1540:
-						position, tokenIndex = position1539, tokenIndex1539
+						goto l1661
+					l1662:
+						position, tokenIndex = position1661, tokenIndex1661
 						if buffer[position] != rune('S') {
-							goto l1514
+							goto l1636
 						}
 						position++
 					}
-				l1539:
-					add(rulePegText, position1516)
+				l1661:
+					add(rulePegText, position1638)
 				}
-				if !_rules[ruleAction97]() {
-					goto l1514
+				if !_rules[ruleAction106]() {
+					goto l1636
 				}
-				add(ruleMILLISECONDS, position1515)
+				add(ruleMILLISECONDS, position1637)
 			}
 			return true
-		l1514:
-			position, tokenIndex = position1514, tokenIndex1514
+		l1636:
+			position, tokenIndex = position1636, tokenIndex1636
 			return false
 		},
-		/* 128 Wait <- <(<(('w' / 'W') ('a' / 'A') ('i' / 'I') ('t' / 'T'))> Action98)> */
+		/* 142 Wait <- <(<(('w' / 'W') ('a' / 'A') ('i' / 'I') ('t' / 'T'))> Action107)> */
 		func() bool {
-			position1541, tokenIndex1541 := position, tokenIndex
+			position1663, tokenIndex1663 := position, tokenIndex
 			{
-				position1542 := position
+				position1664 := position
 				{
-					position1543 := position
+					position1665 := position
 					{
-						position1544, tokenIndex1544 := position, tokenIndex
+						position1666, tokenIndex1666 := position, tokenIndex
 						if buffer[position] != rune('w') {
-							goto l1545
+							goto l1667
 						}
 						position++
-						goto l1544
-					l1545:
-						position, tokenIndex = position1544, tokenIndex1544
+						goto l1666
+					l1667:
+						position, tokenIndex = position1666, tokenIndex1666
 						if buffer[position] != rune('W') {
-							goto l1541
+							goto l1663
 						}
 						position++
 					}
-				l1544:
+				l1666:
 					{
-						position1546, tokenIndex1546 := position, tokenIndex
+						position1668, tokenIndex1668 := position, tokenIndex
 						if buffer[position] != rune('a') {
-							goto l1547
+							goto l1669
 						}
 						position++
-						goto l1546
-					l1547:
-						position, tokenIndex = position1546, tokenIndex1546
+						goto l1668
+					l1669:
+						position, tokenIndex = position1668, tokenIndex1668
 						if buffer[position] != rune('A') {
-							goto l1541
+							goto l1663
 						}
 						position++
 					}
-				l1546:
+				l1668:
 					{
-						position1548, tokenIndex1548 := position, tokenIndex
+						position1670, tokenIndex1670 := position, tokenIndex
 						if buffer[position] != rune('i') {
-							goto l1549
+							goto l1671
 						}
 						position++
-						goto l1548
-					l1549:
-						position, tokenIndex = position1548, tokenIndex1548
+						goto l1670
+					l1671:
+						position, tokenIndex = position1670, tokenIndex1670
 						if buffer[position] != rune('I') {
-							goto l1541
+							goto l1663
 						}
 						position++
 					}
-				l1548:
+				l1670:
 					{
-						position1550, tokenIndex1550 := position, tokenIndex
+						position1672, tokenIndex1672 := position, tokenIndex
 						if buffer[position] != rune('t') {
-							goto l1551
+							goto l1673
 						}
 						position++
-						goto l1550
-					l1551:
-						position, tokenIndex = position1550, tokenIndex1550
+						goto l1672
+					l1673:
+						position, tokenIndex = position1672, tokenIndex1672
 						if buffer[position] != rune('T') {
-							goto l1541
+							goto l1663
 						}
 						position++
 					}
-				l1550:
-					add(rulePegText, position1543)
+				l1672:
+					add(rulePegText, position1665)
 				}
-				if !_rules[ruleAction98]() {
-					goto l1541
+				if !_rules[ruleAction107]() {
+					goto l1663
 				}
-				add(ruleWait, position1542)
+				add(ruleWait, position1664)
 			}
 			return true
-		l1541:
-			position, tokenIndex = position1541, tokenIndex1541
+		l1663:
+			position, tokenIndex = position1663, tokenIndex1663
 			return false
 		},
-		/* 129 DropOldest <- <(<(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('o' / 'O') ('l' / 'L') ('d' / 'D') ('e' / 'E') ('s' / 'S') ('t' / 'T')))> Action99)> */
+		/* 143 DropOldest <- <(<(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('o' / 'O') ('l' / 'L') ('d' / 'D') ('e' / 'E') ('s' / 'S') ('t' / 'T')))> Action108)> */
 		func() bool {
-			position1552, tokenIndex1552 := position, tokenIndex
+			position1674, tokenIndex1674 := position, tokenIndex
 			{
-				position1553 := position
+				position1675 := position
 				{
-					position1554 := position
+					position1676 := position
 					{
-						position1555, tokenIndex1555 := position, tokenIndex
+						position1677, tokenIndex1677 := position, tokenIndex
 						if buffer[position] != rune('d') {
-							goto l1556
+							goto l1678
 						}
 						position++
-						goto l1555
-					l1556:
-						position, tokenIndex = position1555, tokenIndex1555
+						goto l1677
+					l1678:
+						position, tokenIndex = position1677, tokenIndex1677
 						if buffer[position] != rune('D') {
-							goto l1552
+							goto l1674
 						}
 						position++
 					}
-				l1555:
+				l1677:
 					{
-						position1557, tokenIndex1557 := position, tokenIndex
+						position1679, tokenIndex1679 := position, tokenIndex
 						if buffer[position] != rune('r') {
-							goto l1558
+							goto l1680
 						}
 						position++
-						goto l1557
-					l1558:
-						position, tokenIndex = position1557, tokenIndex1557
+						goto l1679
+					l1680:
+						position, tokenIndex = position1679, tokenIndex1679
 						if buffer[position] != rune('R') {
-							goto l1552
+							goto l1674
 						}
 						position++
 					}
-				l1557:
+				l1679:
 					{
-						position1559, tokenIndex1559 := position, tokenIndex
+						position1681, tokenIndex1681 := position, tokenIndex
 						if buffer[position] != rune('o') {
-							goto l1560
+							goto l1682
 						}
 						position++
-						goto l1559
-					l1560:
-						position, tokenIndex = position1559, tokenIndex1559
+						goto l1681
+					l1682:
+						position, tokenIndex = position1681, tokenIndex1681
 						if buffer[position] != rune('O') {
-							goto l1552
+							goto l1674
 						}
 						position++
 					}
-				l1559:
+				l1681:
 					{
-						position1561, tokenIndex1561 := position, tokenIndex
+						position1683, tokenIndex1683 := position, tokenIndex
 						if buffer[position] != rune('p') {
-							goto l1562
+							goto l1684
 						}
 						position++
-						goto l1561
-					l1562:
-						position, tokenIndex = position1561, tokenIndex1561
+						goto l1683
+					l1684:
+						position, tokenIndex = position1683, tokenIndex1683
 						if buffer[position] != rune('P') {
-							goto l1552
+							goto l1674
 						}
 						position++
 					}
-				l1561:
+				l1683:
 					if !_rules[rulesp]() {
-						goto l1552
+						goto l1674
 					}
 					{
-						position1563, tokenIndex1563 := position, tokenIndex
+						position1685, tokenIndex1685 := position, tokenIndex
 						if buffer[position] != rune('o') {
-							goto l1564
+							goto l1686
 						}
 						position++
-						goto l1563
-					l1564:
-						position, tokenIndex = position1563, tokenIndex1563
-						if buffer[position] != rune('O') {
-							goto l1552
+						goto l1685
+					l1686:
+						position, tokenIndex = position1685, tokenIndex1685
+						if buffer[position] != rune('O') {
+							goto l1674
 						}
 						position++
 					}
-				l1563:
+				l1685:
 					{
-						position1565, tokenIndex1565 := position, tokenIndex
+						position1687, tokenIndex1687 := position, tokenIndex
 						if buffer[position] != rune('l') {
-							goto l1566
+							goto l1688
 						}
 						position++
-						goto l1565
-					l1566:
-						position, tokenIndex = position1565, tokenIndex1565
+						goto l1687
+					l1688:
+						position, tokenIndex = position1687, tokenIndex1687
 						if buffer[position] != rune('L') {
-							goto l1552
+							goto l1674
 						}
 						position++
 					}
-				l1565:
+				l1687:
 					{
-						position1567, tokenIndex1567 := position, tokenIndex
+						position1689, tokenIndex1689 := position, tokenIndex
 						if buffer[position] != rune('d') {
-							goto l1568
+							goto l1690
 						}
 						position++
-						goto l1567
-					l1568:
-						position, tokenIndex = position1567, tokenIndex1567
+						goto l1689
+					l1690:
+						position, tokenIndex = position1689, tokenIndex1689
 						if buffer[position] != rune('D') {
-							goto l1552
+							goto l1674
 						}
 						position++
 					}
-				l1567:
+				l1689:
 					{
-						position1569, tokenIndex1569 := position, tokenIndex
+						position1691, tokenIndex1691 := position, tokenIndex
 						if buffer[position] != rune('e') {
-							goto l1570
+							goto l1692
 						}
 						position++
-						goto l1569
-					l1570:
-						position, tokenIndex = position1569, tokenIndex1569
+						goto l1691
+					l1692:
+						position, tokenIndex = position1691, tokenIndex1691
 						if buffer[position] != rune('E') {
-							goto l1552
+							goto l1674
 						}
 						position++
 					}
-				l1569:
+				l1691:
 					{
-						position1571, tokenIndex1571 := position, tokenIndex
+						position1693, tokenIndex1693 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l1572
+							goto l1694
 						}
 						position++
-						goto l1571
-					l1572:
-						position, tokenIndex = position1571, tokenIndex1571
+						goto l1693
+					l1694:
+						position, tokenIndex = position1693, tokenIndex1693
 						if buffer[position] != rune('S') {
-							goto l1552
+							goto l1674
 						}
 						position++
 					}
-				l1571:
+				l1693:
 					{
-						position1573, tokenIndex1573 := position, tokenIndex
+						position1695, tokenIndex1695 := position, tokenIndex
 						if buffer[position] != rune('t') {
-							goto l1574
+							goto l1696
 						}
 						position++
-						goto l1573
-					l1574:
-						position, tokenIndex = position1573, tokenIndex1573
+						goto l1695
+					l1696:
+						position, tokenIndex = position1695, tokenIndex1695
 						if buffer[position] != rune('T') {
-							goto l1552
+							goto l1674
 						}
 						position++
 					}
-				l1573:
-					add(rulePegText, position1554)
+				l1695:
+					add(rulePegText, position1676)
 				}
-				if !_rules[ruleAction99]() {
-					goto l1552
+				if !_rules[ruleAction108]() {
+					goto l1674
 				}
-				add(ruleDropOldest, position1553)
+				add(ruleDropOldest, position1675)
 			}
 			return true
-		l1552:
-			position, tokenIndex = position1552, tokenIndex1552
+		l1674:
+			position, tokenIndex = position1674, tokenIndex1674
 			return false
 		},
-		/* 130 DropNewest <- <(<(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('n' / 'N') ('e' / 'E') ('w' / 'W') ('e' / 'E') ('s' / 'S') ('t' / 'T')))> Action100)> */
+		/* 144 DropNewest <- <(<(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('n' / 'N') ('e' / 'E') ('w' / 'W') ('e' / 'E') ('s' / 'S') ('t' / 'T')))> Action109)> */
 		func() bool {
-			position1575, tokenIndex1575 := position, tokenIndex
+			position1697, tokenIndex1697 := position, tokenIndex
 			{
-				position1576 := position
+				position1698 := position
 				{
-					position1577 := position
+					position1699 := position
 					{
-						position1578, tokenIndex1578 := position, tokenIndex
+						position1700, tokenIndex1700 := position, tokenIndex
 						if buffer[position] != rune('d') {
-							goto l1579
+							goto l1701
 						}
 						position++
-						goto l1578
-					l1579:
-						position, tokenIndex = position1578, tokenIndex1578
+						goto l1700
+					l1701:
+						position, tokenIndex = position1700, tokenIndex1700
 						if buffer[position] != rune('D') {
-							goto l1575
+							goto l1697
 						}
 						position++
 					}
-				l1578:
+				l1700:
 					{
-						position1580, tokenIndex1580 := position, tokenIndex
+						position1702, tokenIndex1702 := position, tokenIndex
 						if buffer[position] != rune('r') {
-							goto l1581
+							goto l1703
 						}
 						position++
-						goto l1580
-					l1581:
-						position, tokenIndex = position1580, tokenIndex1580
+						goto l1702
+					l1703:
+						position, tokenIndex = position1702, tokenIndex1702
 						if buffer[position] != rune('R') {
-							goto l1575
+							goto l1697
 						}
 						position++
 					}
-				l1580:
+				l1702:
 					{
-						position1582, tokenIndex1582 := position, tokenIndex
+						position1704, tokenIndex1704 := position, tokenIndex
 						if buffer[position] != rune('o') {
-							goto l1583
+							goto l1705
 						}
 						position++
-						goto l1582
-					l1583:
-						position, tokenIndex = position1582, tokenIndex1582
+						goto l1704
+					l1705:
+						position, tokenIndex = position1704, tokenIndex1704
 						if buffer[position] != rune('O') {
-							goto l1575
+							goto l1697
 						}
 						position++
 					}
-				l1582:
+				l1704:
 					{
-						position1584, tokenIndex1584 := position, tokenIndex
+						position1706, tokenIndex1706 := position, tokenIndex
 						if buffer[position] != rune('p') {
-							goto l1585
+							goto l1707
 						}
 						position++
-						goto l1584
-					l1585:
-						position, tokenIndex = position1584, tokenIndex1584
+						goto l1706
+					l1707:
+						position, tokenIndex = position1706, tokenIndex1706
 						if buffer[position] != rune('P') {
-							goto l1575
+							goto l1697
 						}
 						position++
 					}
-				l1584:
+				l1706:
 					if !_rules[rulesp]() {
-						goto l1575
+						goto l1697
 					}
 					{
-						position1586, tokenIndex1586 := position, tokenIndex
+						position1708, tokenIndex1708 := position, tokenIndex
 						if buffer[position] != rune('n') {
-							goto l1587
+							goto l1709
 						}
 						position++
-						goto l1586
-					l1587:
-						position, tokenIndex = position1586, tokenIndex1586
+						goto l1708
+					l1709:
+						position, tokenIndex = position1708, tokenIndex1708
 						if buffer[position] != rune('N') {
-							goto l1575
+							goto l1697
 						}
 						position++
 					}
-				l1586:
+				l1708:
 					{
-						position1588, tokenIndex1588 := position, tokenIndex
+						position1710, tokenIndex1710 := position, tokenIndex
 						if buffer[position] != rune('e') {
-							goto l1589
+							goto l1711
 						}
 						position++
-						goto l1588
-					l1589:
-						position, tokenIndex = position1588, tokenIndex1588
+						goto l1710
+					l1711:
+						position, tokenIndex = position1710, tokenIndex1710
 						if buffer[position] != rune('E') {
-							goto l1575
+							goto l1697
 						}
 						position++
 					}
-				l1588:
+				l1710:
 					{
-						position1590, tokenIndex1590 := position, tokenIndex
+						position1712, tokenIndex1712 := position, tokenIndex
 						if buffer[position] != rune('w') {
-							goto l1591
+							goto l1713
 						}
 						position++
-						goto l1590
-					l1591:
-						position, tokenIndex = position1590, tokenIndex1590
+						goto l1712
+					l1713:
+						position, tokenIndex = position1712, tokenIndex1712
 						if buffer[position] != rune('W') {
-							goto l1575
+							goto l1697
 						}
 						position++
 					}
-				l1590:
+				l1712:
 					{
-						position1592, tokenIndex1592 := position, tokenIndex
+						position1714, tokenIndex1714 := position, tokenIndex
 						if buffer[position] != rune('e') {
-							goto l1593
+							goto l1715
 						}
 						position++
-						goto l1592
-					l1593:
-						position, tokenIndex = position1592, tokenIndex1592
+						goto l1714
+					l1715:
+						position, tokenIndex = position1714, tokenIndex1714
 						if buffer[position] != rune('E') {
-							goto l1575
+							goto l1697
 						}
 						position++
 					}
-				l1592:
+				l1714:
 					{
-						position1594, tokenIndex1594 := position, tokenIndex
+						position1716, tokenIndex1716 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l1595
+							goto l1717
 						}
 						position++
-						goto l1594
-					l1595:
-						position, tokenIndex = position1594, tokenIndex1594
+						goto l1716
+					l1717:
+						position, tokenIndex = position1716, tokenIndex1716
 						if buffer[position] != rune('S') {
-							goto l1575
+							goto l1697
 						}
 						position++
 					}
-				l1594:
+				l1716:
 					{
-						position1596, tokenIndex1596 := position, tokenIndex
+						position1718, tokenIndex1718 := position, tokenIndex
 						if buffer[position] != rune('t') {
-							goto l1597
+							goto l1719
 						}
 						position++
-						goto l1596
-					l1597:
-						position, tokenIndex = position1596, tokenIndex1596
+						goto l1718
+					l1719:
+						position, tokenIndex = position1718, tokenIndex1718
 						if buffer[position] != rune('T') {
-							goto l1575
+							goto l1697
 						}
 						position++
 					}
-				l1596:
-					add(rulePegText, position1577)
+				l1718:
+					add(rulePegText, position1699)
 				}
-				if !_rules[ruleAction100]() {
-					goto l1575
+				if !_rules[ruleAction109]() {
+					goto l1697
 				}
-				add(ruleDropNewest, position1576)
+				add(ruleDropNewest, position1698)
 			}
 			return true
-		l1575:
-			position, tokenIndex = position1575, tokenIndex1575
+		l1697:
+			position, tokenIndex = position1697, tokenIndex1697
 			return false
 		},
-		/* 131 StreamIdentifier <- <(<ident> Action101)> */
+		/* 145 StreamIdentifier <- <(<ident> Action110)> */
 		func() bool {
-			position1598, tokenIndex1598 := position, tokenIndex
+			position1720, tokenIndex1720 := position, tokenIndex
 			{
-				position1599 := position
+				position1721 := position
 				{
-					position1600 := position
+					position1722 := position
 					if !_rules[ruleident]() {
-						goto l1598
+						goto l1720
 					}
-					add(rulePegText, position1600)
+					add(rulePegText, position1722)
 				}
-				if !_rules[ruleAction101]() {
-					goto l1598
+				if !_rules[ruleAction110]() {
+					goto l1720
 				}
-				add(ruleStreamIdentifier, position1599)
+				add(ruleStreamIdentifier, position1721)
 			}
 			return true
-		l1598:
-			position, tokenIndex = position1598, tokenIndex1598
+		l1720:
+			position, tokenIndex = position1720, tokenIndex1720
 			return false
 		},
-		/* 132 SourceSinkType <- <(<ident> Action102)> */
+		/* 146 SourceSinkType <- <(<ident> Action111)> */
 		func() bool {
-			position1601, tokenIndex1601 := position, tokenIndex
+			position1723, tokenIndex1723 := position, tokenIndex
 			{
-				position1602 := position
+				position1724 := position
 				{
-					position1603 := position
+					position1725 := position
 					if !_rules[ruleident]() {
-						goto l1601
+						goto l1723
 					}
-					add(rulePegText, position1603)
+					add(rulePegText, position1725)
 				}
-				if !_rules[ruleAction102]() {
-					goto l1601
+				if !_rules[ruleAction111]() {
+					goto l1723
 				}
-				add(ruleSourceSinkType, position1602)
+				add(ruleSourceSinkType, position1724)
 			}
 			return true
-		l1601:
-			position, tokenIndex = position1601, tokenIndex1601
+		l1723:
+			position, tokenIndex = position1723, tokenIndex1723
 			return false
 		},
-		/* 133 SourceSinkParamKey <- <(<ident> Action103)> */
+		/* 147 SourceSinkParamKey <- <(<ident> Action112)> */
 		func() bool {
-			position1604, tokenIndex1604 := position, tokenIndex
+			position1726, tokenIndex1726 := position, tokenIndex
 			{
-				position1605 := position
+				position1727 := position
 				{
-					position1606 := position
+					position1728 := position
 					if !_rules[ruleident]() {
-						goto l1604
+						goto l1726
 					}
-					add(rulePegText, position1606)
+					add(rulePegText, position1728)
 				}
-				if !_rules[ruleAction103]() {
-					goto l1604
+				if !_rules[ruleAction112]() {
+					goto l1726
 				}
-				add(ruleSourceSinkParamKey, position1605)
+				add(ruleSourceSinkParamKey, position1727)
 			}
 			return true
-		l1604:
-			position, tokenIndex = position1604, tokenIndex1604
+		l1726:
+			position, tokenIndex = position1726, tokenIndex1726
 			return false
 		},
-		/* 134 Paused <- <(<(('p' / 'P') ('a' / 'A') ('u' / 'U') ('s' / 'S') ('e' / 'E') ('d' / 'D'))> Action104)> */
+		/* 148 Paused <- <(<(('p' / 'P') ('a' / 'A') ('u' / 'U') ('s' / 'S') ('e' / 'E') ('d' / 'D'))> Action113)> */
 		func() bool {
-			position1607, tokenIndex1607 := position, tokenIndex
+			position1729, tokenIndex1729 := position, tokenIndex
 			{
-				position1608 := position
+				position1730 := position
 				{
-					position1609 := position
+					position1731 := position
 					{
-						position1610, tokenIndex1610 := position, tokenIndex
+						position1732, tokenIndex1732 := position, tokenIndex
 						if buffer[position] != rune('p') {
-							goto l1611
+							goto l1733
 						}
 						position++
-						goto l1610
-					l1611:
-						position, tokenIndex = position1610, tokenIndex1610
+						goto l1732
+					l1733:
+						position, tokenIndex = position1732, tokenIndex1732
 						if buffer[position] != rune('P') {
-							goto l1607
+							goto l1729
 						}
 						position++
 					}
-				l1610:
+				l1732:
 					{
-						position1612, tokenIndex1612 := position, tokenIndex
+						position1734, tokenIndex1734 := position, tokenIndex
 						if buffer[position] != rune('a') {
-							goto l1613
+							goto l1735
 						}
 						position++
-						goto l1612
-					l1613:
-						position, tokenIndex = position1612, tokenIndex1612
+						goto l1734
+					l1735:
+						position, tokenIndex = position1734, tokenIndex1734
 						if buffer[position] != rune('A') {
-							goto l1607
+							goto l1729
 						}
 						position++
 					}
-				l1612:
+				l1734:
 					{
-						position1614, tokenIndex1614 := position, tokenIndex
+						position1736, tokenIndex1736 := position, tokenIndex
 						if buffer[position] != rune('u') {
-							goto l1615
+							goto l1737
 						}
 						position++
-						goto l1614
-					l1615:
-						position, tokenIndex = position1614, tokenIndex1614
+						goto l1736
+					l1737:
+						position, tokenIndex = position1736, tokenIndex1736
 						if buffer[position] != rune('U') {
-							goto l1607
+							goto l1729
 						}
 						position++
 					}
-				l1614:
+				l1736:
 					{
-						position1616, tokenIndex1616 := position, tokenIndex
+						position1738, tokenIndex1738 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l1617
+							goto l1739
 						}
 						position++
-						goto l1616
-					l1617:
-						position, tokenIndex = position1616, tokenIndex1616
+						goto l1738
+					l1739:
+						position, tokenIndex = position1738, tokenIndex1738
 						if buffer[position] != rune('S') {
-							goto l1607
+							goto l1729
 						}
 						position++
 					}
-				l1616:
+				l1738:
 					{
-						position1618, tokenIndex1618 := position, tokenIndex
+						position1740, tokenIndex1740 := position, tokenIndex
 						if buffer[position] != rune('e') {
-							goto l1619
+							goto l1741
 						}
 						position++
-						goto l1618
-					l1619:
-						position, tokenIndex = position1618, tokenIndex1618
+						goto l1740
+					l1741:
+						position, tokenIndex = position1740, tokenIndex1740
 						if buffer[position] != rune('E') {
-							goto l1607
+							goto l1729
 						}
 						position++
 					}
-				l1618:
+				l1740:
 					{
-						position1620, tokenIndex1620 := position, tokenIndex
+						position1742, tokenIndex1742 := position, tokenIndex
 						if buffer[position] != rune('d') {
-							goto l1621
+							goto l1743
 						}
 						position++
-						goto l1620
-					l1621:
-						position, tokenIndex = position1620, tokenIndex1620
+						goto l1742
+					l1743:
+						position, tokenIndex = position1742, tokenIndex1742
 						if buffer[position] != rune('D') {
-							goto l1607
+							goto l1729
 						}
 						position++
 					}
-				l1620:
-					add(rulePegText, position1609)
+				l1742:
+					add(rulePegText, position1731)
 				}
-				if !_rules[ruleAction104]() {
-					goto l1607
+				if !_rules[ruleAction113]() {
+					goto l1729
 				}
-				add(rulePaused, position1608)
+				add(rulePaused, position1730)
 			}
 			return true
-		l1607:
-			position, tokenIndex = position1607, tokenIndex1607
+		l1729:
+			position, tokenIndex = position1729, tokenIndex1729
 			return false
 		},
-		/* 135 Unpaused <- <(<(('u' / 'U') ('n' / 'N') ('p' / 'P') ('a' / 'A') ('u' / 'U') ('s' / 'S') ('e' / 'E') ('d' / 'D'))> Action105)> */
+		/* 149 Unpaused <- <(<(('u' / 'U') ('n' / 'N') ('p' / 'P') ('a' / 'A') ('u' / 'U') ('s' / 'S') ('e' / 'E') ('d' / 'D'))> Action114)> */
 		func() bool {
-			position1622, tokenIndex1622 := position, tokenIndex
+			position1744, tokenIndex1744 := position, tokenIndex
 			{
-				position1623 := position
+				position1745 := position
 				{
-					position1624 := position
+					position1746 := position
 					{
-						position1625, tokenIndex1625 := position, tokenIndex
+						position1747, tokenIndex1747 := position, tokenIndex
 						if buffer[position] != rune('u') {
-							goto l1626
+							goto l1748
 						}
 						position++
-						goto l1625
-					l1626:
-						position, tokenIndex = position1625, tokenIndex1625
+						goto l1747
+					l1748:
+						position, tokenIndex = position1747, tokenIndex1747
 						if buffer[position] != rune('U') {
-							goto l1622
+							goto l1744
 						}
 						position++
 					}
-				l1625:
+				l1747:
 					{
-						position1627, tokenIndex1627 := position, tokenIndex
+						position1749, tokenIndex1749 := position, tokenIndex
 						if buffer[position] != rune('n') {
-							goto l1628
+							goto l1750
 						}
 						position++
-						goto l1627
-					l1628:
-						position, tokenIndex = position1627, tokenIndex1627
+						goto l1749
+					l1750:
+						position, tokenIndex = position1749, tokenIndex1749
 						if buffer[position] != rune('N') {
-							goto l1622
+							goto l1744
 						}
 						position++
 					}
-				l1627:
+				l1749:
 					{
-						position1629, tokenIndex1629 := position, tokenIndex
+						position1751, tokenIndex1751 := position, tokenIndex
 						if buffer[position] != rune('p') {
-							goto l1630
+							goto l1752
 						}
 						position++
-						goto l1629
-					l1630:
-						position, tokenIndex = position1629, tokenIndex1629
+						goto l1751
+					l1752:
+						position, tokenIndex = position1751, tokenIndex1751
 						if buffer[position] != rune('P') {
-							goto l1622
+							goto l1744
 						}
 						position++
 					}
-				l1629:
+				l1751:
 					{
-						position1631, tokenIndex1631 := position, tokenIndex
+						position1753, tokenIndex1753 := position, tokenIndex
 						if buffer[position] != rune('a') {
-							goto l1632
+							goto l1754
 						}
 						position++
-						goto l1631
-					l1632:
-						position, tokenIndex = position1631, tokenIndex1631
+						goto l1753
+					l1754:
+						position, tokenIndex = position1753, tokenIndex1753
 						if buffer[position] != rune('A') {
-							goto l1622
+							goto l1744
 						}
 						position++
 					}
-				l1631:
+				l1753:
 					{
-						position1633, tokenIndex1633 := position, tokenIndex
+						position1755, tokenIndex1755 := position, tokenIndex
 						if buffer[position] != rune('u') {
-							goto l1634
+							goto l1756
 						}
 						position++
-						goto l1633
-					l1634:
-						position, tokenIndex = position1633, tokenIndex1633
+						goto l1755
+					l1756:
+						position, tokenIndex = position1755, tokenIndex1755
 						if buffer[position] != rune('U') {
-							goto l1622
+							goto l1744
 						}
 						position++
 					}
-				l1633:
+				l1755:
 					{
-						position1635, tokenIndex1635 := position, tokenIndex
+						position1757, tokenIndex1757 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l1636
+							goto l1758
 						}
 						position++
-						goto l1635
-					l1636:
-						position, tokenIndex = position1635, tokenIndex1635
+						goto l1757
+					l1758:
+						position, tokenIndex = position1757, tokenIndex1757
 						if buffer[position] != rune('S') {
-							goto l1622
+							goto l1744
 						}
 						position++
 					}
-				l1635:
+				l1757:
 					{
-						position1637, tokenIndex1637 := position, tokenIndex
+						position1759, tokenIndex1759 := position, tokenIndex
 						if buffer[position] != rune('e') {
-							goto l1638
+							goto l1760
 						}
 						position++
-						goto l1637
-					l1638:
-						position, tokenIndex = position1637, tokenIndex1637
+						goto l1759
+					l1760:
+						position, tokenIndex = position1759, tokenIndex1759
 						if buffer[position] != rune('E') {
-							goto l1622
+							goto l1744
 						}
 						position++
 					}
-				l1637:
+				l1759:
 					{
-						position1639, tokenIndex1639 := position, tokenIndex
+						position1761, tokenIndex1761 := position, tokenIndex
 						if buffer[position] != rune('d') {
-							goto l1640
+							goto l1762
 						}
 						position++
-						goto l1639
-					l1640:
-						position, tokenIndex = position1639, tokenIndex1639
+						goto l1761
+					l1762:
+						position, tokenIndex = position1761, tokenIndex1761
 						if buffer[position] != rune('D') {
-							goto l1622
+							goto l1744
 						}
 						position++
 					}
-				l1639:
-					add(rulePegText, position1624)
+				l1761:
+					add(rulePegText, position1746)
 				}
-				if !_rules[ruleAction105]() {
-					goto l1622
+				if !_rules[ruleAction114]() {
+					goto l1744
 				}
-				add(ruleUnpaused, position1623)
+				add(ruleUnpaused, position1745)
 			}
 			return true
-		l1622:
-			position, tokenIndex = position1622, tokenIndex1622
+		l1744:
+			position, tokenIndex = position1744, tokenIndex1744
 			return false
 		},
-		/* 136 Ascending <- <(<(('a' / 'A') ('s' / 'S') ('c' / 'C'))> Action106)> */
+		/* 150 Ascending <- <(<(('a' / 'A') ('s' / 'S') ('c' / 'C'))> Action115)> */
 		func() bool {
-			position1641, tokenIndex1641 := position, tokenIndex
+			position1763, tokenIndex1763 := position, tokenIndex
 			{
-				position1642 := position
+				position1764 := position
 				{
-					position1643 := position
+					position1765 := position
 					{
-						position1644, tokenIndex1644 := position, tokenIndex
+						position1766, tokenIndex1766 := position, tokenIndex
 						if buffer[position] != rune('a') {
-							goto l1645
+							goto l1767
 						}
 						position++
-						goto l1644
-					l1645:
-						position, tokenIndex = position1644, tokenIndex1644
+						goto l1766
+					l1767:
+						position, tokenIndex = position1766, tokenIndex1766
 						if buffer[position] != rune('A') {
-							goto l1641
+							goto l1763
 						}
 						position++
 					}
-				l1644:
+				l1766:
 					{
-						position1646, tokenIndex1646 := position, tokenIndex
+						position1768, tokenIndex1768 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l1647
+							goto l1769
 						}
 						position++
-						goto l1646
-					l1647:
-						position, tokenIndex = position1646, tokenIndex1646
+						goto l1768
+					l1769:
+						position, tokenIndex = position1768, tokenIndex1768
 						if buffer[position] != rune('S') {
-							goto l1641
+							goto l1763
 						}
 						position++
 					}
-				l1646:
+				l1768:
 					{
-						position1648, tokenIndex1648 := position, tokenIndex
+						position1770, tokenIndex1770 := position, tokenIndex
 						if buffer[position] != rune('c') {
-							goto l1649
+							goto l1771
 						}
 						position++
-						goto l1648
-					l1649:
-						position, tokenIndex = position1648, tokenIndex1648
+						goto l1770
+					l1771:
+						position, tokenIndex = position1770, tokenIndex1770
 						if buffer[position] != rune('C') {
-							goto l1641
+							goto l1763
 						}
 						position++
 					}
-				l1648:
-					add(rulePegText, position1643)
+				l1770:
+					add(rulePegText, position1765)
 				}
-				if !_rules[ruleAction106]() {
-					goto l1641
+				if !_rules[ruleAction115]() {
+					goto l1763
 				}
-				add(ruleAscending, position1642)
+				add(ruleAscending, position1764)
 			}
 			return true
-		l1641:
-			position, tokenIndex = position1641, tokenIndex1641
+		l1763:
+			position, tokenIndex = position1763, tokenIndex1763
 			return false
 		},
-		/* 137 Descending <- <(<(('d' / 'D') ('e' / 'E') ('s' / 'S') ('c' / 'C'))> Action107)> */
+		/* 151 Descending <- <(<(('d' / 'D') ('e' / 'E') ('s' / 'S') ('c' / 'C'))> Action116)> */
 		func() bool {
-			position1650, tokenIndex1650 := position, tokenIndex
+			position1772, tokenIndex1772 := position, tokenIndex
 			{
-				position1651 := position
+				position1773 := position
 				{
-					position1652 := position
+					position1774 := position
 					{
-						position1653, tokenIndex1653 := position, tokenIndex
+						position1775, tokenIndex1775 := position, tokenIndex
 						if buffer[position] != rune('d') {
-							goto l1654
+							goto l1776
 						}
 						position++
-						goto l1653
-					l1654:
-						position, tokenIndex = position1653, tokenIndex1653
+						goto l1775
+					l1776:
+						position, tokenIndex = position1775, tokenIndex1775
 						if buffer[position] != rune('D') {
-							goto l1650
+							goto l1772
 						}
 						position++
 					}
-				l1653:
+				l1775:
 					{
-						position1655, tokenIndex1655 := position, tokenIndex
+						position1777, tokenIndex1777 := position, tokenIndex
 						if buffer[position] != rune('e') {
-							goto l1656
+							goto l1778
 						}
 						position++
-						goto l1655
-					l1656:
-						position, tokenIndex = position1655, tokenIndex1655
+						goto l1777
+					l1778:
+						position, tokenIndex = position1777, tokenIndex1777
 						if buffer[position] != rune('E') {
-							goto l1650
+							goto l1772
 						}
 						position++
 					}
-				l1655:
+				l1777:
 					{
-						position1657, tokenIndex1657 := position, tokenIndex
+						position1779, tokenIndex1779 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l1658
+							goto l1780
 						}
 						position++
-						goto l1657
-					l1658:
-						position, tokenIndex = position1657, tokenIndex1657
+						goto l1779
+					l1780:
+						position, tokenIndex = position1779, tokenIndex1779
 						if buffer[position] != rune('S') {
-							goto l1650
+							goto l1772
 						}
 						position++
 					}
-				l1657:
+				l1779:
 					{
-						position1659, tokenIndex1659 := position, tokenIndex
+						position1781, tokenIndex1781 := position, tokenIndex
 						if buffer[position] != rune('c') {
-							goto l1660
+							goto l1782
 						}
 						position++
-						goto l1659
-					l1660:
-						position, tokenIndex = position1659, tokenIndex1659
+						goto l1781
+					l1782:
+						position, tokenIndex = position1781, tokenIndex1781
 						if buffer[position] != rune('C') {
-							goto l1650
+							goto l1772
 						}
 						position++
 					}
-				l1659:
-					add(rulePegText, position1652)
+				l1781:
+					add(rulePegText, position1774)
 				}
-				if !_rules[ruleAction107]() {
-					goto l1650
+				if !_rules[ruleAction116]() {
+					goto l1772
 				}
-				add(ruleDescending, position1651)
+				add(ruleDescending, position1773)
 			}
 			return true
-		l1650:
-			position, tokenIndex = position1650, tokenIndex1650
+		l1772:
+			position, tokenIndex = position1772, tokenIndex1772
 			return false
 		},
-		/* 138 Type <- <(Bool / Int / Float / String / Blob / Timestamp / Array / Map)> */
+		/* 152 Type <- <(Bool / Int / Float / String / Blob / Timestamp / Array / Map)> */
 		func() bool {
-			position1661, tokenIndex1661 := position, tokenIndex
+			position1783, tokenIndex1783 := position, tokenIndex
 			{
-				position1662 := position
+				position1784 := position
 				{
-					position1663, tokenIndex1663 := position, tokenIndex
+					position1785, tokenIndex1785 := position, tokenIndex
 					if !_rules[ruleBool]() {
-						goto l1664
+						goto l1786
 					}
-					goto l1663
-				l1664:
-					position, tokenIndex = position1663, tokenIndex1663
+					goto l1785
+				l1786:
+					position, tokenIndex = position1785, tokenIndex1785
 					if !_rules[ruleInt]() {
-						goto l1665
+						goto l1787
 					}
-					goto l1663
-				l1665:
-					position, tokenIndex = position1663, tokenIndex1663
+					goto l1785
+				l1787:
+					position, tokenIndex = position1785, tokenIndex1785
 					if !_rules[ruleFloat]() {
-						goto l1666
+						goto l1788
 					}
-					goto l1663
-				l1666:
-					position, tokenIndex = position1663, tokenIndex1663
+					goto l1785
+				l1788:
+					position, tokenIndex = position1785, tokenIndex1785
 					if !_rules[ruleString]() {
-						goto l1667
+						goto l1789
 					}
-					goto l1663
-				l1667:
-					position, tokenIndex = position1663, tokenIndex1663
+					goto l1785
+				l1789:
+					position, tokenIndex = position1785, tokenIndex1785
 					if !_rules[ruleBlob]() {
-						goto l1668
+						goto l1790
 					}
-					goto l1663
-				l1668:
-					position, tokenIndex = position1663, tokenIndex1663
+					goto l1785
+				l1790:
+					position, tokenIndex = position1785, tokenIndex1785
 					if !_rules[ruleTimestamp]() {
-						goto l1669
+						goto l1791
 					}
-					goto l1663
-				l1669:
-					position, tokenIndex = position1663, tokenIndex1663
+					goto l1785
+				l1791:
+					position, tokenIndex = position1785, tokenIndex1785
 					if !_rules[ruleArray]() {
-						goto l1670
+						goto l1792
 					}
-					goto l1663
-				l1670:
-					position, tokenIndex = position1663, tokenIndex1663
+					goto l1785
+				l1792:
+					position, tokenIndex = position1785, tokenIndex1785
 					if !_rules[ruleMap]() {
-						goto l1661
+						goto l1783
 					}
 				}
-			l1663:
-				add(ruleType, position1662)
+			l1785:
+				add(ruleType, position1784)
 			}
 			return true
-		l1661:
-			position, tokenIndex = position1661, tokenIndex1661
+		l1783:
+			position, tokenIndex = position1783, tokenIndex1783
 			return false
 		},
-		/* 139 Bool <- <(<(('b' / 'B') ('o' / 'O') ('o' / 'O') ('l' / 'L'))> Action108)> */
+		/* 153 Bool <- <(<(('b' / 'B') ('o' / 'O') ('o' / 'O') ('l' / 'L'))> Action117)> */
 		func() bool {
-			position1671, tokenIndex1671 := position, tokenIndex
+			position1793, tokenIndex1793 := position, tokenIndex
 			{
-				position1672 := position
+				position1794 := position
 				{
-					position1673 := position
+					position1795 := position
 					{
-						position1674, tokenIndex1674 := position, tokenIndex
+						position1796, tokenIndex1796 := position, tokenIndex
 						if buffer[position] != rune('b') {
-							goto l1675
+							goto l1797
 						}
 						position++
-						goto l1674
-					l1675:
-						position, tokenIndex = position1674, tokenIndex1674
+						goto l1796
+					l1797:
+						position, tokenIndex = position1796, tokenIndex1796
 						if buffer[position] != rune('B') {
-							goto l1671
+							goto l1793
 						}
 						position++
 					}
-				l1674:
+				l1796:
 					{
-						position1676, tokenIndex1676 := position, tokenIndex
+						position1798, tokenIndex1798 := position, tokenIndex
 						if buffer[position] != rune('o') {
-							goto l1677
+							goto l1799
 						}
 						position++
-						goto l1676
-					l1677:
-						position, tokenIndex = position1676, tokenIndex1676
+						goto l1798
+					l1799:
+						position, tokenIndex = position1798, tokenIndex1798
 						if buffer[position] != rune('O') {
-							goto l1671
+							goto l1793
 						}
 						position++
 					}
-				l1676:
+				l1798:
 					{
-						position1678, tokenIndex1678 := position, tokenIndex
+						position1800, tokenIndex1800 := position, tokenIndex
 						if buffer[position] != rune('o') {
-							goto l1679
+							goto l1801
 						}
 						position++
-						goto l1678
-					l1679:
-						position, tokenIndex = position1678, tokenIndex1678
+						goto l1800
+					l1801:
+						position, tokenIndex = position1800, tokenIndex1800
 						if buffer[position] != rune('O') {
-							goto l1671
+							goto l1793
 						}
 						position++
 					}
-				l1678:
+				l1800:
 					{
-						position1680, tokenIndex1680 := position, tokenIndex
+						position1802, tokenIndex1802 := position, tokenIndex
 						if buffer[position] != rune('l') {
-							goto l1681
+							goto l1803
 						}
 						position++
-						goto l1680
-					l1681:
-						position, tokenIndex = position1680, tokenIndex1680
+						goto l1802
+					l1803:
+						position, tokenIndex = position1802, tokenIndex1802
 						if buffer[position] != rune('L') {
-							goto l1671
+							goto l1793
 						}
 						position++
 					}
-				l1680:
-					add(rulePegText, position1673)
+				l1802:
+					add(rulePegText, position1795)
 				}
-				if !_rules[ruleAction108]() {
-					goto l1671
+				if !_rules[ruleAction117]() {
+					goto l1793
 				}
-				add(ruleBool, position1672)
+				add(ruleBool, position1794)
 			}
 			return true
-		l1671:
-			position, tokenIndex = position1671, tokenIndex1671
+		l1793:
+			position, tokenIndex = position1793, tokenIndex1793
 			return false
 		},
-		/* 140 Int <- <(<(('i' / 'I') ('n' / 'N') ('t' / 'T'))> Action109)> */
+		/* 154 Int <- <(<(('i' / 'I') ('n' / 'N') ('t' / 'T'))> Action118)> */
 		func() bool {
-			position1682, tokenIndex1682 := position, tokenIndex
+			position1804, tokenIndex1804 := position, tokenIndex
 			{
-				position1683 := position
+				position1805 := position
 				{
-					position1684 := position
+					position1806 := position
 					{
-						position1685, tokenIndex1685 := position, tokenIndex
+						position1807, tokenIndex1807 := position, tokenIndex
 						if buffer[position] != rune('i') {
-							goto l1686
+							goto l1808
 						}
 						position++
-						goto l1685
-					l1686:
-						position, tokenIndex = position1685, tokenIndex1685
+						goto l1807
+					l1808:
+						position, tokenIndex = position1807, tokenIndex1807
 						if buffer[position] != rune('I') {
-							goto l1682
+							goto l1804
 						}
 						position++
 					}
-				l1685:
+				l1807:
 					{
-						position1687, tokenIndex1687 := position, tokenIndex
+						position1809, tokenIndex1809 := position, tokenIndex
 						if buffer[position] != rune('n') {
-							goto l1688
+							goto l1810
 						}
 						position++
-						goto l1687
-					l1688:
-						position, tokenIndex = position1687, tokenIndex1687
+						goto l1809
+					l1810:
+						position, tokenIndex = position1809, tokenIndex1809
 						if buffer[position] != rune('N') {
-							goto l1682
+							goto l1804
 						}
 						position++
 					}
-				l1687:
+				l1809:
 					{
-						position1689, tokenIndex1689 := position, tokenIndex
+						position1811, tokenIndex1811 := position, tokenIndex
 						if buffer[position] != rune('t') {
-							goto l1690
+							goto l1812
 						}
 						position++
-						goto l1689
-					l1690:
-						position, tokenIndex = position1689, tokenIndex1689
+						goto l1811
+					l1812:
+						position, tokenIndex = position1811, tokenIndex1811
 						if buffer[position] != rune('T') {
-							goto l1682
+							goto l1804
 						}
 						position++
 					}
-				l1689:
-					add(rulePegText, position1684)
+				l1811:
+					add(rulePegText, position1806)
 				}
-				if !_rules[ruleAction109]() {
-					goto l1682
+				if !_rules[ruleAction118]() {
+					goto l1804
 				}
-				add(ruleInt, position1683)
+				add(ruleInt, position1805)
 			}
 			return true
-		l1682:
-			position, tokenIndex = position1682, tokenIndex1682
+		l1804:
+			position, tokenIndex = position1804, tokenIndex1804
 			return false
 		},
-		/* 141 Float <- <(<(('f' / 'F') ('l' / 'L') ('o' / 'O') ('a' / 'A') ('t' / 'T'))> Action110)> */
+		/* 155 Float <- <(<(('f' / 'F') ('l' / 'L') ('o' / 'O') ('a' / 'A') ('t' / 'T'))> Action119)> */
 		func() bool {
-			position1691, tokenIndex1691 := position, tokenIndex
+			position1813, tokenIndex1813 := position, tokenIndex
 			{
-				position1692 := position
+				position1814 := position
 				{
-					position1693 := position
+					position1815 := position
 					{
-						position1694, tokenIndex1694 := position, tokenIndex
+						position1816, tokenIndex1816 := position, tokenIndex
 						if buffer[position] != rune('f') {
-							goto l1695
+							goto l1817
 						}
 						position++
-						goto l1694
-					l1695:
-						position, tokenIndex = position1694, tokenIndex1694
+						goto l1816
+					l1817:
+						position, tokenIndex = position1816, tokenIndex1816
 						if buffer[position] != rune('F') {
-							goto l1691
+							goto l1813
 						}
 						position++
 					}
-				l1694:
+				l1816:
 					{
-						position1696, tokenIndex1696 := position, tokenIndex
+						position1818, tokenIndex1818 := position, tokenIndex
 						if buffer[position] != rune('l') {
-							goto l1697
+							goto l1819
 						}
 						position++
-						goto l1696
-					l1697:
-						position, tokenIndex = position1696, tokenIndex1696
+						goto l1818
+					l1819:
+						position, tokenIndex = position1818, tokenIndex1818
 						if buffer[position] != rune('L') {
-							goto l1691
+							goto l1813
 						}
 						position++
 					}
-				l1696:
+				l1818:
 					{
-						position1698, tokenIndex1698 := position, tokenIndex
+						position1820, tokenIndex1820 := position, tokenIndex
 						if buffer[position] != rune('o') {
-							goto l1699
+							goto l1821
 						}
 						position++
-						goto l1698
-					l1699:
-						position, tokenIndex = position1698, tokenIndex1698
+						goto l1820
+					l1821:
+						position, tokenIndex = position1820, tokenIndex1820
 						if buffer[position] != rune('O') {
-							goto l1691
+							goto l1813
 						}
 						position++
 					}
-				l1698:
+				l1820:
 					{
-						position1700, tokenIndex1700 := position, tokenIndex
+						position1822, tokenIndex1822 := position, tokenIndex
 						if buffer[position] != rune('a') {
-							goto l1701
+							goto l1823
 						}
 						position++
-						goto l1700
-					l1701:
-						position, tokenIndex = position1700, tokenIndex1700
+						goto l1822
+					l1823:
+						position, tokenIndex = position1822, tokenIndex1822
 						if buffer[position] != rune('A') {
-							goto l1691
+							goto l1813
 						}
 						position++
 					}
-				l1700:
+				l1822:
 					{
-						position1702, tokenIndex1702 := position, tokenIndex
+						position1824, tokenIndex1824 := position, tokenIndex
 						if buffer[position] != rune('t') {
-							goto l1703
+							goto l1825
 						}
 						position++
-						goto l1702
-					l1703:
-						position, tokenIndex = position1702, tokenIndex1702
+						goto l1824
+					l1825:
+						position, tokenIndex = position1824, tokenIndex1824
 						if buffer[position] != rune('T') {
-							goto l1691
+							goto l1813
 						}
 						position++
 					}
-				l1702:
-					add(rulePegText, position1693)
+				l1824:
+					add(rulePegText, position1815)
 				}
-				if !_rules[ruleAction110]() {
-					goto l1691
+				if !_rules[ruleAction119]() {
+					goto l1813
 				}
-				add(ruleFloat, position1692)
+				add(ruleFloat, position1814)
 			}
 			return true
-		l1691:
-			position, tokenIndex = position1691, tokenIndex1691
+		l1813:
+			position, tokenIndex = position1813, tokenIndex1813
 			return false
 		},
-		/* 142 String <- <(<(('s' / 'S') ('t' / 'T') ('r' / 'R') ('i' / 'I') ('n' / 'N') ('g' / 'G'))> Action111)> */
+		/* 156 String <- <(<(('s' / 'S') ('t' / 'T') ('r' / 'R') ('i' / 'I') ('n' / 'N') ('g' / 'G'))> Action120)> */
 		func() bool {
-			position1704, tokenIndex1704 := position, tokenIndex
+			position1826, tokenIndex1826 := position, tokenIndex
 			{
-				position1705 := position
+				position1827 := position
 				{
-					position1706 := position
+					position1828 := position
 					{
-						position1707, tokenIndex1707 := position, tokenIndex
+						position1829, tokenIndex1829 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l1708
+							goto l1830
 						}
 						position++
-						goto l1707
-					l1708:
-						position, tokenIndex = position1707, tokenIndex1707
+						goto l1829
+					l1830:
+						position, tokenIndex = position1829, tokenIndex1829
 						if buffer[position] != rune('S') {
-							goto l1704
+							goto l1826
 						}
 						position++
 					}
-				l1707:
+				l1829:
 					{
-						position1709, tokenIndex1709 := position, tokenIndex
+						position1831, tokenIndex1831 := position, tokenIndex
 						if buffer[position] != rune('t') {
-							goto l1710
+							goto l1832
 						}
 						position++
-						goto l1709
-					l1710:
-						position, tokenIndex = position1709, tokenIndex1709
+						goto l1831
+					l1832:
+						position, tokenIndex = position1831, tokenIndex1831
 						if buffer[position] != rune('T') {
-							goto l1704
+							goto l1826
 						}
 						position++
 					}
-				l1709:
+				l1831:
 					{
-						position1711, tokenIndex1711 := position, tokenIndex
+						position1833, tokenIndex1833 := position, tokenIndex
 						if buffer[position] != rune('r') {
-							goto l1712
+							goto l1834
 						}
 						position++
-						goto l1711
-					l1712:
-						position, tokenIndex = position1711, tokenIndex1711
+						goto l1833
+					l1834:
+						position, tokenIndex = position1833, tokenIndex1833
 						if buffer[position] != rune('R') {
-							goto l1704
+							goto l1826
 						}
 						position++
 					}
-				l1711:
+				l1833:
 					{
-						position1713, tokenIndex1713 := position, tokenIndex
+						position1835, tokenIndex1835 := position, tokenIndex
 						if buffer[position] != rune('i') {
-							goto l1714
+							goto l1836
 						}
 						position++
-						goto l1713
-					l1714:
-						position, tokenIndex = position1713, tokenIndex1713
+						goto l1835
+					l1836:
+						position, tokenIndex = position1835, tokenIndex1835
 						if buffer[position] != rune('I') {
-							goto l1704
+							goto l1826
 						}
 						position++
 					}
-				l1713:
+				l1835:
 					{
-						position1715, tokenIndex1715 := position, tokenIndex
+						position1837, tokenIndex1837 := position, tokenIndex
 						if buffer[position] != rune('n') {
-							goto l1716
+							goto l1838
 						}
 						position++
-						goto l1715
-					l1716:
-						position, tokenIndex = position1715, tokenIndex1715
+						goto l1837
+					l1838:
+						position, tokenIndex = position1837, tokenIndex1837
 						if buffer[position] != rune('N') {
-							goto l1704
+							goto l1826
 						}
 						position++
 					}
-				l1715:
+				l1837:
 					{
-						position1717, tokenIndex1717 := position, tokenIndex
+						position1839, tokenIndex1839 := position, tokenIndex
 						if buffer[position] != rune('g') {
-							goto l1718
+							goto l1840
 						}
 						position++
-						goto l1717
-					l1718:
-						position, tokenIndex = position1717, tokenIndex1717
+						goto l1839
+					l1840:
+						position, tokenIndex = position1839, tokenIndex1839
 						if buffer[position] != rune('G') {
-							goto l1704
+							goto l1826
 						}
 						position++
 					}
-				l1717:
-					add(rulePegText, position1706)
+				l1839:
+					add(rulePegText, position1828)
 				}
-				if !_rules[ruleAction111]() {
-					goto l1704
+				if !_rules[ruleAction120]() {
+					goto l1826
 				}
-				add(ruleString, position1705)
+				add(ruleString, position1827)
 			}
 			return true
-		l1704:
-			position, tokenIndex = position1704, tokenIndex1704
+		l1826:
+			position, tokenIndex = position1826, tokenIndex1826
 			return false
 		},
-		/* 143 Blob <- <(<(('b' / 'B') ('l' / 'L') ('o' / 'O') ('b' / 'B'))> Action112)> */
+		/* 157 Blob <- <(<(('b' / 'B') ('l' / 'L') ('o' / 'O') ('b' / 'B'))> Action121)> */
 		func() bool {
-			position1719, tokenIndex1719 := position, tokenIndex
+			position1841, tokenIndex1841 := position, tokenIndex
 			{
-				position1720 := position
+				position1842 := position
 				{
-					position1721 := position
+					position1843 := position
 					{
-						position1722, tokenIndex1722 := position, tokenIndex
+						position1844, tokenIndex1844 := position, tokenIndex
 						if buffer[position] != rune('b') {
-							goto l1723
+							goto l1845
 						}
 						position++
-						goto l1722
-					l1723:
-						position, tokenIndex = position1722, tokenIndex1722
+						goto l1844
+					l1845:
+						position, tokenIndex = position1844, tokenIndex1844
 						if buffer[position] != rune('B') {
-							goto l1719
+							goto l1841
 						}
 						position++
 					}
-				l1722:
+				l1844:
 					{
-						position1724, tokenIndex1724 := position, tokenIndex
+						position1846, tokenIndex1846 := position, tokenIndex
 						if buffer[position] != rune('l') {
-							goto l1725
+							goto l1847
 						}
 						position++
-						goto l1724
-					l1725:
-						position, tokenIndex = position1724, tokenIndex1724
+						goto l1846
+					l1847:
+						position, tokenIndex = position1846, tokenIndex1846
 						if buffer[position] != rune('L') {
-							goto l1719
+							goto l1841
 						}
 						position++
 					}
-				l1724:
+				l1846:
 					{
-						position1726, tokenIndex1726 := position, tokenIndex
+						position1848, tokenIndex1848 := position, tokenIndex
 						if buffer[position] != rune('o') {
-							goto l1727
+							goto l1849
 						}
 						position++
-						goto l1726
-					l1727:
-						position, tokenIndex = position1726, tokenIndex1726
+						goto l1848
+					l1849:
+						position, tokenIndex = position1848, tokenIndex1848
 						if buffer[position] != rune('O') {
-							goto l1719
+							goto l1841
 						}
 						position++
 					}
-				l1726:
+				l1848:
 					{
-						position1728, tokenIndex1728 := position, tokenIndex
+						position1850, tokenIndex1850 := position, tokenIndex
 						if buffer[position] != rune('b') {
-							goto l1729
+							goto l1851
 						}
 						position++
-						goto l1728
-					l1729:
-						position, tokenIndex = position1728, tokenIndex1728
+						goto l1850
+					l1851:
+						position, tokenIndex = position1850, tokenIndex1850
 						if buffer[position] != rune('B') {
-							goto l1719
+							goto l1841
 						}
 						position++
 					}
-				l1728:
-					add(rulePegText, position1721)
+				l1850:
+					add(rulePegText, position1843)
 				}
-				if !_rules[ruleAction112]() {
-					goto l1719
+				if !_rules[ruleAction121]() {
+					goto l1841
 				}
-				add(ruleBlob, position1720)
+				add(ruleBlob, position1842)
 			}
 			return true
-		l1719:
-			position, tokenIndex = position1719, tokenIndex1719
+		l1841:
+			position, tokenIndex = position1841, tokenIndex1841
 			return false
 		},
-		/* 144 Timestamp <- <(<(('t' / 'T') ('i' / 'I') ('m' / 'M') ('e' / 'E') ('s' / 'S') ('t' / 'T') ('a' / 'A') ('m' / 'M') ('p' / 'P'))> Action113)> */
+		/* 158 Timestamp <- <(<(('t' / 'T') ('i' / 'I') ('m' / 'M') ('e' / 'E') ('s' / 'S') ('t' / 'T') ('a' / 'A') ('m' / 'M') ('p' / 'P'))> Action122)> */
 		func() bool {
-			position1730, tokenIndex1730 := position, tokenIndex
+			position1852, tokenIndex1852 := position, tokenIndex
 			{
-				position1731 := position
+				position1853 := position
 				{
-					position1732 := position
+					position1854 := position
 					{
-						position1733, tokenIndex1733 := position, tokenIndex
+						position1855, tokenIndex1855 := position, tokenIndex
 						if buffer[position] != rune('t') {
-							goto l1734
+							goto l1856
 						}
 						position++
-						goto l1733
-					l1734:
-						position, tokenIndex = position1733, tokenIndex1733
+						goto l1855
+					l1856:
+						position, tokenIndex = position1855, tokenIndex1855
 						if buffer[position] != rune('T') {
-							goto l1730
+							goto l1852
 						}
 						position++
 					}
-				l1733:
+				l1855:
 					{
-						position1735, tokenIndex1735 := position, tokenIndex
+						position1857, tokenIndex1857 := position, tokenIndex
 						if buffer[position] != rune('i') {
-							goto l1736
+							goto l1858
 						}
 						position++
-						goto l1735
-					l1736:
-						position, tokenIndex = position1735, tokenIndex1735
+						goto l1857
+					l1858:
+						position, tokenIndex = position1857, tokenIndex1857
 						if buffer[position] != rune('I') {
-							goto l1730
+							goto l1852
 						}
 						position++
 					}
-				l1735:
+				l1857:
 					{
-						position1737, tokenIndex1737 := position, tokenIndex
+						position1859, tokenIndex1859 := position, tokenIndex
 						if buffer[position] != rune('m') {
-							goto l1738
+							goto l1860
 						}
 						position++
-						goto l1737
-					l1738:
-						position, tokenIndex = position1737, tokenIndex1737
+						goto l1859
+					l1860:
+						position, tokenIndex = position1859, tokenIndex1859
 						if buffer[position] != rune('M') {
-							goto l1730
+							goto l1852
 						}
 						position++
 					}
-				l1737:
+				l1859:
 					{
-						position1739, tokenIndex1739 := position, tokenIndex
+						position1861, tokenIndex1861 := position, tokenIndex
 						if buffer[position] != rune('e') {
-							goto l1740
+							goto l1862
 						}
 						position++
-						goto l1739
-					l1740:
-						position, tokenIndex = position1739, tokenIndex1739
+						goto l1861
+					l1862:
+						position, tokenIndex = position1861, tokenIndex1861
 						if buffer[position] != rune('E') {
-							goto l1730
+							goto l1852
 						}
 						position++
 					}
-				l1739:
+				l1861:
 					{
-						position1741, tokenIndex1741 := position, tokenIndex
+						position1863, tokenIndex1863 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l1742
+							goto l1864
 						}
 						position++
-						goto l1741
-					l1742:
-						position, tokenIndex = position1741, tokenIndex1741
+						goto l1863
+					l1864:
+						position, tokenIndex = position1863, tokenIndex1863
 						if buffer[position] != rune('S') {
-							goto l1730
+							goto l1852
 						}
 						position++
 					}
-				l1741:
+				l1863:
 					{
-						position1743, tokenIndex1743 := position, tokenIndex
+						position1865, tokenIndex1865 := position, tokenIndex
 						if buffer[position] != rune('t') {
-							goto l1744
+							goto l1866
 						}
 						position++
-						goto l1743
-					l1744:
-						position, tokenIndex = position1743, tokenIndex1743
+						goto l1865
+					l1866:
+						position, tokenIndex = position1865, tokenIndex1865
 						if buffer[position] != rune('T') {
-							goto l1730
+							goto l1852
 						}
 						position++
 					}
-				l1743:
+				l1865:
 					{
-						position1745, tokenIndex1745 := position, tokenIndex
+						position1867, tokenIndex1867 := position, tokenIndex
 						if buffer[position] != rune('a') {
-							goto l1746
+							goto l1868
 						}
 						position++
-						goto l1745
-					l1746:
-						position, tokenIndex = position1745, tokenIndex1745
+						goto l1867
+					l1868:
+						position, tokenIndex = position1867, tokenIndex1867
 						if buffer[position] != rune('A') {
-							goto l1730
+							goto l1852
 						}
 						position++
 					}
-				l1745:
+				l1867:
 					{
-						position1747, tokenIndex1747 := position, tokenIndex
+						position1869, tokenIndex1869 := position, tokenIndex
 						if buffer[position] != rune('m') {
-							goto l1748
+							goto l1870
 						}
 						position++
-						goto l1747
-					l1748:
-						position, tokenIndex = position1747, tokenIndex1747
+						goto l1869
+					l1870:
+						position, tokenIndex = position1869, tokenIndex1869
 						if buffer[position] != rune('M') {
-							goto l1730
+							goto l1852
 						}
 						position++
 					}
-				l1747:
+				l1869:
 					{
-						position1749, tokenIndex1749 := position, tokenIndex
+						position1871, tokenIndex1871 := position, tokenIndex
 						if buffer[position] != rune('p') {
-							goto l1750
+							goto l1872
 						}
 						position++
-						goto l1749
-					l1750:
-						position, tokenIndex = position1749, tokenIndex1749
+						goto l1871
+					l1872:
+						position, tokenIndex = position1871, tokenIndex1871
 						if buffer[position] != rune('P') {
-							goto l1730
+							goto l1852
 						}
 						position++
 					}
-				l1749:
-					add(rulePegText, position1732)
+				l1871:
+					add(rulePegText, position1854)
 				}
-				if !_rules[ruleAction113]() {
-					goto l1730
+				if !_rules[ruleAction122]() {
+					goto l1852
 				}
-				add(ruleTimestamp, position1731)
+				add(ruleTimestamp, position1853)
 			}
 			return true
-		l1730:
-			position, tokenIndex = position1730, tokenIndex1730
+		l1852:
+			position, tokenIndex = position1852, tokenIndex1852
 			return false
 		},
-		/* 145 Array <- <(<(('a' / 'A') ('r' / 'R') ('r' / 'R') ('a' / 'A') ('y' / 'Y'))> Action114)> */
+		/* 159 Array <- <(<(('a' / 'A') ('r' / 'R') ('r' / 'R') ('a' / 'A') ('y' / 'Y'))> Action123)> */
 		func() bool {
-			position1751, tokenIndex1751 := position, tokenIndex
+			position1873, tokenIndex1873 := position, tokenIndex
 			{
-				position1752 := position
+				position1874 := position
 				{
-					position1753 := position
+					position1875 := position
 					{
-						position1754, tokenIndex1754 := position, tokenIndex
+						position1876, tokenIndex1876 := position, tokenIndex
 						if buffer[position] != rune('a') {
-							goto l1755
+							goto l1877
 						}
 						position++
-						goto l1754
-					l1755:
-						position, tokenIndex = position1754, tokenIndex1754
+						goto l1876
+					l1877:
+						position, tokenIndex = position1876, tokenIndex1876
 						if buffer[position] != rune('A') {
-							goto l1751
+							goto l1873
 						}
 						position++
 					}
-				l1754:
+				l1876:
 					{
-						position1756, tokenIndex1756 := position, tokenIndex
+						position1878, tokenIndex1878 := position, tokenIndex
 						if buffer[position] != rune('r') {
-							goto l1757
+							goto l1879
 						}
 						position++
-						goto l1756
-					l1757:
-						position, tokenIndex = position1756, tokenIndex1756
+						goto l1878
+					l1879:
+						position, tokenIndex = position1878, tokenIndex1878
 						if buffer[position] != rune('R') {
-							goto l1751
+							goto l1873
 						}
 						position++
 					}
-				l1756:
+				l1878:
 					{
-						position1758, tokenIndex1758 := position, tokenIndex
+						position1880, tokenIndex1880 := position, tokenIndex
 						if buffer[position] != rune('r') {
-							goto l1759
+							goto l1881
 						}
 						position++
-						goto l1758
-					l1759:
-						position, tokenIndex = position1758, tokenIndex1758
+						goto l1880
+					l1881:
+						position, tokenIndex = position1880, tokenIndex1880
 						if buffer[position] != rune('R') {
-							goto l1751
+							goto l1873
 						}
 						position++
 					}
-				l1758:
+				l1880:
 					{
-						position1760, tokenIndex1760 := position, tokenIndex
+						position1882, tokenIndex1882 := position, tokenIndex
 						if buffer[position] != rune('a') {
-							goto l1761
+							goto l1883
 						}
 						position++
-						goto l1760
-					l1761:
-						position, tokenIndex = position1760, tokenIndex1760
+						goto l1882
+					l1883:
+						position, tokenIndex = position1882, tokenIndex1882
 						if buffer[position] != rune('A') {
-							goto l1751
+							goto l1873
 						}
 						position++
 					}
-				l1760:
+				l1882:
 					{
-						position1762, tokenIndex1762 := position, tokenIndex
+						position1884, tokenIndex1884 := position, tokenIndex
 						if buffer[position] != rune('y') {
-							goto l1763
+							goto l1885
 						}
 						position++
-						goto l1762
-					l1763:
-						position, tokenIndex = position1762, tokenIndex1762
+						goto l1884
+					l1885:
+						position, tokenIndex = position1884, tokenIndex1884
 						if buffer[position] != rune('Y') {
-							goto l1751
+							goto l1873
 						}
 						position++
 					}
-				l1762:
-					add(rulePegText, position1753)
+				l1884:
+					add(rulePegText, position1875)
 				}
-				if !_rules[ruleAction114]() {
-					goto l1751
+				if !_rules[ruleAction123]() {
+					goto l1873
 				}
-				add(ruleArray, position1752)
+				add(ruleArray, position1874)
 			}
 			return true
-		l1751:
-			position, tokenIndex = position1751, tokenIndex1751
+		l1873:
+			position, tokenIndex = position1873, tokenIndex1873
 			return false
 		},
-		/* 146 Map <- <(<(('m' / 'M') ('a' / 'A') ('p' / 'P'))> Action115)> */
+		/* 160 Map <- <(<(('m' / 'M') ('a' / 'A') ('p' / 'P'))> Action124)> */
 		func() bool {
-			position1764, tokenIndex1764 := position, tokenIndex
+			position1886, tokenIndex1886 := position, tokenIndex
 			{
-				position1765 := position
+				position1887 := position
 				{
-					position1766 := position
+					position1888 := position
 					{
-						position1767, tokenIndex1767 := position, tokenIndex
+						position1889, tokenIndex1889 := position, tokenIndex
 						if buffer[position] != rune('m') {
-							goto l1768
+							goto l1890
 						}
 						position++
-						goto l1767
-					l1768:
-						position, tokenIndex = position1767, tokenIndex1767
+						goto l1889
+					l1890:
+						position, tokenIndex = position1889, tokenIndex1889
 						if buffer[position] != rune('M') {
-							goto l1764
+							goto l1886
 						}
 						position++
 					}
-				l1767:
+				l1889:
 					{
-						position1769, tokenIndex1769 := position, tokenIndex
+						position1891, tokenIndex1891 := position, tokenIndex
 						if buffer[position] != rune('a') {
-							goto l1770
+							goto l1892
 						}
 						position++
-						goto l1769
-					l1770:
-						position, tokenIndex = position1769, tokenIndex1769
+						goto l1891
+					l1892:
+						position, tokenIndex = position1891, tokenIndex1891
 						if buffer[position] != rune('A') {
-							goto l1764
+							goto l1886
 						}
 						position++
 					}
-				l1769:
+				l1891:
 					{
-						position1771, tokenIndex1771 := position, tokenIndex
+						position1893, tokenIndex1893 := position, tokenIndex
 						if buffer[position] != rune('p') {
-							goto l1772
+							goto l1894
 						}
 						position++
-						goto l1771
-					l1772:
-						position, tokenIndex = position1771, tokenIndex1771
+						goto l1893
+					l1894:
+						position, tokenIndex = position1893, tokenIndex1893
 						if buffer[position] != rune('P') {
-							goto l1764
+							goto l1886
 						}
 						position++
 					}
-				l1771:
-					add(rulePegText, position1766)
+				l1893:
+					add(rulePegText, position1888)
 				}
-				if !_rules[ruleAction115]() {
-					goto l1764
+				if !_rules[ruleAction124]() {
+					goto l1886
 				}
-				add(ruleMap, position1765)
+				add(ruleMap, position1887)
 			}
 			return true
-		l1764:
-			position, tokenIndex = position1764, tokenIndex1764
+		l1886:
+			position, tokenIndex = position1886, tokenIndex1886
 			return false
 		},
-		/* 147 Or <- <(<(('o' / 'O') ('r' / 'R'))> Action116)> */
+		/* 161 Or <- <(<(('o' / 'O') ('r' / 'R'))> Action125)> */
 		func() bool {
-			position1773, tokenIndex1773 := position, tokenIndex
+			position1895, tokenIndex1895 := position, tokenIndex
 			{
-				position1774 := position
+				position1896 := position
 				{
-					position1775 := position
+					position1897 := position
 					{
-						position1776, tokenIndex1776 := position, tokenIndex
+						position1898, tokenIndex1898 := position, tokenIndex
 						if buffer[position] != rune('o') {
-							goto l1777
+							goto l1899
 						}
 						position++
-						goto l1776
-					l1777:
-						position, tokenIndex = position1776, tokenIndex1776
+						goto l1898
+					l1899:
+						position, tokenIndex = position1898, tokenIndex1898
 						if buffer[position] != rune('O') {
-							goto l1773
+							goto l1895
 						}
 						position++
 					}
-				l1776:
+				l1898:
 					{
-						position1778, tokenIndex1778 := position, tokenIndex
+						position1900, tokenIndex1900 := position, tokenIndex
 						if buffer[position] != rune('r') {
-							goto l1779
+							goto l1901
 						}
 						position++
-						goto l1778
-					l1779:
-						position, tokenIndex = position1778, tokenIndex1778
+						goto l1900
+					l1901:
+						position, tokenIndex = position1900, tokenIndex1900
 						if buffer[position] != rune('R') {
-							goto l1773
+							goto l1895
 						}
 						position++
 					}
-				l1778:
-					add(rulePegText, position1775)
+				l1900:
+					add(rulePegText, position1897)
 				}
-				if !_rules[ruleAction116]() {
-					goto l1773
+				if !_rules[ruleAction125]() {
+					goto l1895
 				}
-				add(ruleOr, position1774)
+				add(ruleOr, position1896)
 			}
 			return true
-		l1773:
-			position, tokenIndex = position1773, tokenIndex1773
+		l1895:
+			position, tokenIndex = position1895, tokenIndex1895
 			return false
 		},
-		/* 148 And <- <(<(('a' / 'A') ('n' / 'N') ('d' / 'D'))> Action117)> */
+		/* 162 And <- <(<(('a' / 'A') ('n' / 'N') ('d' / 'D'))> Action126)> */
 		func() bool {
-			position1780, tokenIndex1780 := position, tokenIndex
+			position1902, tokenIndex1902 := position, tokenIndex
 			{
-				position1781 := position
+				position1903 := position
 				{
-					position1782 := position
+					position1904 := position
 					{
-						position1783, tokenIndex1783 := position, tokenIndex
+						position1905, tokenIndex1905 := position, tokenIndex
 						if buffer[position] != rune('a') {
-							goto l1784
+							goto l1906
 						}
 						position++
-						goto l1783
-					l1784:
-						position, tokenIndex = position1783, tokenIndex1783
+						goto l1905
+					l1906:
+						position, tokenIndex = position1905, tokenIndex1905
 						if buffer[position] != rune('A') {
-							goto l1780
+							goto l1902
 						}
 						position++
 					}
-				l1783:
+				l1905:
 					{
-						position1785, tokenIndex1785 := position, tokenIndex
+						position1907, tokenIndex1907 := position, tokenIndex
 						if buffer[position] != rune('n') {
-							goto l1786
+							goto l1908
 						}
 						position++
-						goto l1785
-					l1786:
-						position, tokenIndex = position1785, tokenIndex1785
+						goto l1907
+					l1908:
+						position, tokenIndex = position1907, tokenIndex1907
 						if buffer[position] != rune('N') {
-							goto l1780
+							goto l1902
 						}
 						position++
 					}
-				l1785:
+				l1907:
 					{
-						position1787, tokenIndex1787 := position, tokenIndex
+						position1909, tokenIndex1909 := position, tokenIndex
 						if buffer[position] != rune('d') {
-							goto l1788
+							goto l1910
 						}
 						position++
-						goto l1787
-					l1788:
-						position, tokenIndex = position1787, tokenIndex1787
+						goto l1909
+					l1910:
+						position, tokenIndex = position1909, tokenIndex1909
 						if buffer[position] != rune('D') {
-							goto l1780
+							goto l1902
 						}
 						position++
 					}
-				l1787:
-					add(rulePegText, position1782)
+				l1909:
+					add(rulePegText, position1904)
 				}
-				if !_rules[ruleAction117]() {
-					goto l1780
+				if !_rules[ruleAction126]() {
+					goto l1902
 				}
-				add(ruleAnd, position1781)
+				add(ruleAnd, position1903)
 			}
 			return true
-		l1780:
-			position, tokenIndex = position1780, tokenIndex1780
+		l1902:
+			position, tokenIndex = position1902, tokenIndex1902
 			return false
 		},
-		/* 149 Not <- <(<(('n' / 'N') ('o' / 'O') ('t' / 'T'))> Action118)> */
+		/* 163 Not <- <(<(('n' / 'N') ('o' / 'O') ('t' / 'T'))> Action127)> */
 		func() bool {
-			position1789, tokenIndex1789 := position, tokenIndex
+			position1911, tokenIndex1911 := position, tokenIndex
 			{
-				position1790 := position
+				position1912 := position
 				{
-					position1791 := position
+					position1913 := position
 					{
-						position1792, tokenIndex1792 := position, tokenIndex
+						position1914, tokenIndex1914 := position, tokenIndex
 						if buffer[position] != rune('n') {
-							goto l1793
+							goto l1915
 						}
 						position++
-						goto l1792
-					l1793:
-						position, tokenIndex = position1792, tokenIndex1792
+						goto l1914
+					l1915:
+						position, tokenIndex = position1914, tokenIndex1914
 						if buffer[position] != rune('N') {
-							goto l1789
+							goto l1911
 						}
 						position++
 					}
-				l1792:
+				l1914:
 					{
-						position1794, tokenIndex1794 := position, tokenIndex
+						position1916, tokenIndex1916 := position, tokenIndex
 						if buffer[position] != rune('o') {
-							goto l1795
+							goto l1917
 						}
 						position++
-						goto l1794
-					l1795:
-						position, tokenIndex = position1794, tokenIndex1794
+						goto l1916
+					l1917:
+						position, tokenIndex = position1916, tokenIndex1916
 						if buffer[position] != rune('O') {
-							goto l1789
+							goto l1911
 						}
 						position++
 					}
-				l1794:
+				l1916:
 					{
-						position1796, tokenIndex1796 := position, tokenIndex
+						position1918, tokenIndex1918 := position, tokenIndex
 						if buffer[position] != rune('t') {
-							goto l1797
+							goto l1919
 						}
 						position++
-						goto l1796
-					l1797:
-						position, tokenIndex = position1796, tokenIndex1796
+						goto l1918
+					l1919:
+						position, tokenIndex = position1918, tokenIndex1918
 						if buffer[position] != rune('T') {
-							goto l1789
+							goto l1911
 						}
 						position++
 					}
-				l1796:
-					add(rulePegText, position1791)
+				l1918:
+					add(rulePegText, position1913)
 				}
-				if !_rules[ruleAction118]() {
-					goto l1789
+				if !_rules[ruleAction127]() {
+					goto l1911
 				}
-				add(ruleNot, position1790)
+				add(ruleNot, position1912)
 			}
 			return true
-		l1789:
-			position, tokenIndex = position1789, tokenIndex1789
+		l1911:
+			position, tokenIndex = position1911, tokenIndex1911
 			return false
 		},
-		/* 150 Equal <- <(<'='> Action119)> */
+		/* 164 Equal <- <(<'='> Action128)> */
 		func() bool {
-			position1798, tokenIndex1798 := position, tokenIndex
+			position1920, tokenIndex1920 := position, tokenIndex
 			{
-				position1799 := position
+				position1921 := position
 				{
-					position1800 := position
+					position1922 := position
 					if buffer[position] != rune('=') {
-						goto l1798
+						goto l1920
 					}
 					position++
-					add(rulePegText, position1800)
+					add(rulePegText, position1922)
 				}
-				if !_rules[ruleAction119]() {
-					goto l1798
+				if !_rules[ruleAction128]() {
+					goto l1920
 				}
-				add(ruleEqual, position1799)
+				add(ruleEqual, position1921)
 			}
 			return true
-		l1798:
-			position, tokenIndex = position1798, tokenIndex1798
+		l1920:
+			position, tokenIndex = position1920, tokenIndex1920
 			return false
 		},
-		/* 151 Less <- <(<'<'> Action120)> */
+		/* 165 Less <- <(<'<'> Action129)> */
 		func() bool {
-			position1801, tokenIndex1801 := position, tokenIndex
+			position1923, tokenIndex1923 := position, tokenIndex
 			{
-				position1802 := position
+				position1924 := position
 				{
-					position1803 := position
+					position1925 := position
 					if buffer[position] != rune('<') {
-						goto l1801
+						goto l1923
 					}
 					position++
-					add(rulePegText, position1803)
+					add(rulePegText, position1925)
 				}
-				if !_rules[ruleAction120]() {
-					goto l1801
+				if !_rules[ruleAction129]() {
+					goto l1923
 				}
-				add(ruleLess, position1802)
+				add(ruleLess, position1924)
 			}
 			return true
-		l1801:
-			position, tokenIndex = position1801, tokenIndex1801
+		l1923:
+			position, tokenIndex = position1923, tokenIndex1923
 			return false
 		},
-		/* 152 LessOrEqual <- <(<('<' '=')> Action121)> */
+		/* 166 LessOrEqual <- <(<('<' '=')> Action130)> */
 		func() bool {
-			position1804, tokenIndex1804 := position, tokenIndex
+			position1926, tokenIndex1926 := position, tokenIndex
 			{
-				position1805 := position
+				position1927 := position
 				{
-					position1806 := position
+					position1928 := position
 					if buffer[position] != rune('<') {
-						goto l1804
+						goto l1926
 					}
 					position++
 					if buffer[position] != rune('=') {
-						goto l1804
+						goto l1926
 					}
 					position++
-					add(rulePegText, position1806)
+					add(rulePegText, position1928)
 				}
-				if !_rules[ruleAction121]() {
-					goto l1804
+				if !_rules[ruleAction130]() {
+					goto l1926
 				}
-				add(ruleLessOrEqual, position1805)
+				add(ruleLessOrEqual, position1927)
 			}
 			return true
-		l1804:
-			position, tokenIndex = position1804, tokenIndex1804
+		l1926:
+			position, tokenIndex = position1926, tokenIndex1926
 			return false
 		},
-		/* 153 Greater <- <(<'>'> Action122)> */
+		/* 167 Greater <- <(<'>'> Action131)> */
 		func() bool {
-			position1807, tokenIndex1807 := position, tokenIndex
+			position1929, tokenIndex1929 := position, tokenIndex
 			{
-				position1808 := position
+				position1930 := position
 				{
-					position1809 := position
+					position1931 := position
 					if buffer[position] != rune('>') {
-						goto l1807
+						goto l1929
 					}
 					position++
-					add(rulePegText, position1809)
+					add(rulePegText, position1931)
 				}
-				if !_rules[ruleAction122]() {
-					goto l1807
+				if !_rules[ruleAction131]() {
+					goto l1929
 				}
-				add(ruleGreater, position1808)
+				add(ruleGreater, position1930)
 			}
 			return true
-		l1807:
-			position, tokenIndex = position1807, tokenIndex1807
+		l1929:
+			position, tokenIndex = position1929, tokenIndex1929
 			return false
 		},
-		/* 154 GreaterOrEqual <- <(<('>' '=')> Action123)> */
+		/* 168 GreaterOrEqual <- <(<('>' '=')> Action132)> */
 		func() bool {
-			position1810, tokenIndex1810 := position, tokenIndex
+			position1932, tokenIndex1932 := position, tokenIndex
 			{
-				position1811 := position
+				position1933 := position
 				{
-					position1812 := position
+					position1934 := position
 					if buffer[position] != rune('>') {
-						goto l1810
+						goto l1932
 					}
 					position++
 					if buffer[position] != rune('=') {
-						goto l1810
+						goto l1932
 					}
 					position++
-					add(rulePegText, position1812)
+					add(rulePegText, position1934)
 				}
-				if !_rules[ruleAction123]() {
-					goto l1810
+				if !_rules[ruleAction132]() {
+					goto l1932
 				}
-				add(ruleGreaterOrEqual, position1811)
+				add(ruleGreaterOrEqual, position1933)
 			}
 			return true
-		l1810:
-			position, tokenIndex = position1810, tokenIndex1810
+		l1932:
+			position, tokenIndex = position1932, tokenIndex1932
 			return false
 		},
-		/* 155 NotEqual <- <(<(('!' '=') / ('<' '>'))> Action124)> */
+		/* 169 NotEqual <- <(<(('!' '=') / ('<' '>'))> Action133)> */
 		func() bool {
-			position1813, tokenIndex1813 := position, tokenIndex
+			position1935, tokenIndex1935 := position, tokenIndex
 			{
-				position1814 := position
+				position1936 := position
 				{
-					position1815 := position
+					position1937 := position
 					{
-						position1816, tokenIndex1816 := position, tokenIndex
+						position1938, tokenIndex1938 := position, tokenIndex
 						if buffer[position] != rune('!') {
-							goto l1817
+							goto l1939
 						}
 						position++
 						if buffer[position] != rune('=') {
-							goto l1817
+							goto l1939
 						}
 						position++
-						goto l1816
-					l1817:
-						position, tokenIndex = position1816, tokenIndex1816
+						goto l1938
+					l1939:
+						position, tokenIndex = position1938, tokenIndex1938
 						if buffer[position] != rune('<') {
-							goto l1813
+							goto l1935
 						}
 						position++
 						if buffer[position] != rune('>') {
-							goto l1813
+							goto l1935
 						}
 						position++
 					}
-				l1816:
-					add(rulePegText, position1815)
+				l1938:
+					add(rulePegText, position1937)
 				}
-				if !_rules[ruleAction124]() {
-					goto l1813
+				if !_rules[ruleAction133]() {
+					goto l1935
 				}
-				add(ruleNotEqual, position1814)
+				add(ruleNotEqual, position1936)
 			}
 			return true
-		l1813:
-			position, tokenIndex = position1813, tokenIndex1813
+		l1935:
+			position, tokenIndex = position1935, tokenIndex1935
 			return false
 		},
-		/* 156 Concat <- <(<('|' '|')> Action125)> */
+		/* 170 In <- <(<(('i' / 'I') ('n' / 'N'))> Action134)> */
 		func() bool {
-			position1818, tokenIndex1818 := position, tokenIndex
+			position1940, tokenIndex1940 := position, tokenIndex
 			{
-				position1819 := position
+				position1941 := position
 				{
-					position1820 := position
-					if buffer[position] != rune('|') {
-						goto l1818
+					position1942 := position
+					{
+						position1943, tokenIndex1943 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l1944
+						}
+						position++
+						goto l1943
+					l1944:
+						position, tokenIndex = position1943, tokenIndex1943
+						if buffer[position] != rune('I') {
+							goto l1940
+						}
+						position++
 					}
-					position++
-					if buffer[position] != rune('|') {
-						goto l1818
+				l1943:
+					{
+						position1945, tokenIndex1945 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l1946
+						}
+						position++
+						goto l1945
+					l1946:
+						position, tokenIndex = position1945, tokenIndex1945
+						if buffer[position] != rune('N') {
+							goto l1940
+						}
+						position++
 					}
-					position++
-					add(rulePegText, position1820)
+				l1945:
+					add(rulePegText, position1942)
 				}
-				if !_rules[ruleAction125]() {
-					goto l1818
+				if !_rules[ruleAction134]() {
+					goto l1940
 				}
-				add(ruleConcat, position1819)
+				add(ruleIn, position1941)
 			}
 			return true
-		l1818:
-			position, tokenIndex = position1818, tokenIndex1818
+		l1940:
+			position, tokenIndex = position1940, tokenIndex1940
 			return false
 		},
-		/* 157 Is <- <(<(('i' / 'I') ('s' / 'S'))> Action126)> */
+		/* 171 NotIn <- <(<(('n' / 'N') ('o' / 'O') ('t' / 'T') sp (('i' / 'I') ('n' / 'N')))> Action135)> */
 		func() bool {
-			position1821, tokenIndex1821 := position, tokenIndex
+			position1947, tokenIndex1947 := position, tokenIndex
 			{
-				position1822 := position
+				position1948 := position
 				{
-					position1823 := position
+					position1949 := position
 					{
-						position1824, tokenIndex1824 := position, tokenIndex
+						position1950, tokenIndex1950 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l1951
+						}
+						position++
+						goto l1950
+					l1951:
+						position, tokenIndex = position1950, tokenIndex1950
+						if buffer[position] != rune('N') {
+							goto l1947
+						}
+						position++
+					}
+				l1950:
+					{
+						position1952, tokenIndex1952 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l1953
+						}
+						position++
+						goto l1952
+					l1953:
+						position, tokenIndex = position1952, tokenIndex1952
+						if buffer[position] != rune('O') {
+							goto l1947
+						}
+						position++
+					}
+				l1952:
+					{
+						position1954, tokenIndex1954 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l1955
+						}
+						position++
+						goto l1954
+					l1955:
+						position, tokenIndex = position1954, tokenIndex1954
+						if buffer[position] != rune('T') {
+							goto l1947
+						}
+						position++
+					}
+				l1954:
+					if !_rules[rulesp]() {
+						goto l1947
+					}
+					{
+						position1956, tokenIndex1956 := position, tokenIndex
 						if buffer[position] != rune('i') {
-							goto l1825
+							goto l1957
 						}
 						position++
-						goto l1824
-					l1825:
-						position, tokenIndex = position1824, tokenIndex1824
+						goto l1956
+					l1957:
+						position, tokenIndex = position1956, tokenIndex1956
 						if buffer[position] != rune('I') {
-							goto l1821
+							goto l1947
 						}
 						position++
 					}
-				l1824:
+				l1956:
 					{
-						position1826, tokenIndex1826 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l1827
+						position1958, tokenIndex1958 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l1959
 						}
 						position++
-						goto l1826
-					l1827:
-						position, tokenIndex = position1826, tokenIndex1826
-						if buffer[position] != rune('S') {
-							goto l1821
+						goto l1958
+					l1959:
+						position, tokenIndex = position1958, tokenIndex1958
+						if buffer[position] != rune('N') {
+							goto l1947
 						}
 						position++
 					}
-				l1826:
-					add(rulePegText, position1823)
+				l1958:
+					add(rulePegText, position1949)
 				}
-				if !_rules[ruleAction126]() {
-					goto l1821
+				if !_rules[ruleAction135]() {
+					goto l1947
 				}
-				add(ruleIs, position1822)
+				add(ruleNotIn, position1948)
 			}
 			return true
-		l1821:
-			position, tokenIndex = position1821, tokenIndex1821
+		l1947:
+			position, tokenIndex = position1947, tokenIndex1947
 			return false
 		},
-		/* 158 IsNot <- <(<(('i' / 'I') ('s' / 'S') sp (('n' / 'N') ('o' / 'O') ('t' / 'T')))> Action127)> */
+		/* 172 Concat <- <(<('|' '|')> Action136)> */
 		func() bool {
-			position1828, tokenIndex1828 := position, tokenIndex
+			position1960, tokenIndex1960 := position, tokenIndex
 			{
-				position1829 := position
+				position1961 := position
 				{
-					position1830 := position
-					{
-						position1831, tokenIndex1831 := position, tokenIndex
+					position1962 := position
+					if buffer[position] != rune('|') {
+						goto l1960
+					}
+					position++
+					if buffer[position] != rune('|') {
+						goto l1960
+					}
+					position++
+					add(rulePegText, position1962)
+				}
+				if !_rules[ruleAction136]() {
+					goto l1960
+				}
+				add(ruleConcat, position1961)
+			}
+			return true
+		l1960:
+			position, tokenIndex = position1960, tokenIndex1960
+			return false
+		},
+		/* 173 Regex <- <(<'~'> Action137)> */
+		func() bool {
+			position1963, tokenIndex1963 := position, tokenIndex
+			{
+				position1964 := position
+				{
+					position1965 := position
+					if buffer[position] != rune('~') {
+						goto l1963
+					}
+					position++
+					add(rulePegText, position1965)
+				}
+				if !_rules[ruleAction137]() {
+					goto l1963
+				}
+				add(ruleRegex, position1964)
+			}
+			return true
+		l1963:
+			position, tokenIndex = position1963, tokenIndex1963
+			return false
+		},
+		/* 174 NotRegex <- <(<('!' '~')> Action138)> */
+		func() bool {
+			position1966, tokenIndex1966 := position, tokenIndex
+			{
+				position1967 := position
+				{
+					position1968 := position
+					if buffer[position] != rune('!') {
+						goto l1966
+					}
+					position++
+					if buffer[position] != rune('~') {
+						goto l1966
+					}
+					position++
+					add(rulePegText, position1968)
+				}
+				if !_rules[ruleAction138]() {
+					goto l1966
+				}
+				add(ruleNotRegex, position1967)
+			}
+			return true
+		l1966:
+			position, tokenIndex = position1966, tokenIndex1966
+			return false
+		},
+		/* 175 Is <- <(<(('i' / 'I') ('s' / 'S'))> Action139)> */
+		func() bool {
+			position1969, tokenIndex1969 := position, tokenIndex
+			{
+				position1970 := position
+				{
+					position1971 := position
+					{
+						position1972, tokenIndex1972 := position, tokenIndex
 						if buffer[position] != rune('i') {
-							goto l1832
+							goto l1973
 						}
 						position++
-						goto l1831
-					l1832:
-						position, tokenIndex = position1831, tokenIndex1831
+						goto l1972
+					l1973:
+						position, tokenIndex = position1972, tokenIndex1972
+						if buffer[position] != rune('I') {
+							goto l1969
+						}
+						position++
+					}
+				l1972:
+					{
+						position1974, tokenIndex1974 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l1975
+						}
+						position++
+						goto l1974
+					l1975:
+						position, tokenIndex = position1974, tokenIndex1974
+						if buffer[position] != rune('S') {
+							goto l1969
+						}
+						position++
+					}
+				l1974:
+					add(rulePegText, position1971)
+				}
+				if !_rules[ruleAction139]() {
+					goto l1969
+				}
+				add(ruleIs, position1970)
+			}
+			return true
+		l1969:
+			position, tokenIndex = position1969, tokenIndex1969
+			return false
+		},
+		/* 176 IsNot <- <(<(('i' / 'I') ('s' / 'S') sp (('n' / 'N') ('o' / 'O') ('t' / 'T')))> Action140)> */
+		func() bool {
+			position1976, tokenIndex1976 := position, tokenIndex
+			{
+				position1977 := position
+				{
+					position1978 := position
+					{
+						position1979, tokenIndex1979 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l1980
+						}
+						position++
+						goto l1979
+					l1980:
+						position, tokenIndex = position1979, tokenIndex1979
 						if buffer[position] != rune('I') {
-							goto l1828
+							goto l1976
 						}
 						position++
 					}
-				l1831:
+				l1979:
 					{
-						position1833, tokenIndex1833 := position, tokenIndex
+						position1981, tokenIndex1981 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l1834
+							goto l1982
 						}
 						position++
-						goto l1833
-					l1834:
-						position, tokenIndex = position1833, tokenIndex1833
+						goto l1981
+					l1982:
+						position, tokenIndex = position1981, tokenIndex1981
+						if buffer[position] != rune('S') {
+							goto l1976
+						}
+						position++
+					}
+				l1981:
+					if !_rules[rulesp]() {
+						goto l1976
+					}
+					{
+						position1983, tokenIndex1983 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l1984
+						}
+						position++
+						goto l1983
+					l1984:
+						position, tokenIndex = position1983, tokenIndex1983
+						if buffer[position] != rune('N') {
+							goto l1976
+						}
+						position++
+					}
+				l1983:
+					{
+						position1985, tokenIndex1985 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l1986
+						}
+						position++
+						goto l1985
+					l1986:
+						position, tokenIndex = position1985, tokenIndex1985
+						if buffer[position] != rune('O') {
+							goto l1976
+						}
+						position++
+					}
+				l1985:
+					{
+						position1987, tokenIndex1987 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l1988
+						}
+						position++
+						goto l1987
+					l1988:
+						position, tokenIndex = position1987, tokenIndex1987
+						if buffer[position] != rune('T') {
+							goto l1976
+						}
+						position++
+					}
+				l1987:
+					add(rulePegText, position1978)
+				}
+				if !_rules[ruleAction140]() {
+					goto l1976
+				}
+				add(ruleIsNot, position1977)
+			}
+			return true
+		l1976:
+			position, tokenIndex = position1976, tokenIndex1976
+			return false
+		},
+		/* 177 IsDistinctFrom <- <(<(('i' / 'I') ('s' / 'S') sp (('d' / 'D') ('i' / 'I') ('s' / 'S') ('t' / 'T') ('i' / 'I') ('n' / 'N') ('c' / 'C') ('t' / 'T')) sp (('f' / 'F') ('r' / 'R') ('o' / 'O') ('m' / 'M')))> Action141)> */
+		func() bool {
+			position1989, tokenIndex1989 := position, tokenIndex
+			{
+				position1990 := position
+				{
+					position1991 := position
+					{
+						position1992, tokenIndex1992 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l1993
+						}
+						position++
+						goto l1992
+					l1993:
+						position, tokenIndex = position1992, tokenIndex1992
+						if buffer[position] != rune('I') {
+							goto l1989
+						}
+						position++
+					}
+				l1992:
+					{
+						position1994, tokenIndex1994 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l1995
+						}
+						position++
+						goto l1994
+					l1995:
+						position, tokenIndex = position1994, tokenIndex1994
+						if buffer[position] != rune('S') {
+							goto l1989
+						}
+						position++
+					}
+				l1994:
+					if !_rules[rulesp]() {
+						goto l1989
+					}
+					{
+						position1996, tokenIndex1996 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l1997
+						}
+						position++
+						goto l1996
+					l1997:
+						position, tokenIndex = position1996, tokenIndex1996
+						if buffer[position] != rune('D') {
+							goto l1989
+						}
+						position++
+					}
+				l1996:
+					{
+						position1998, tokenIndex1998 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l1999
+						}
+						position++
+						goto l1998
+					l1999:
+						position, tokenIndex = position1998, tokenIndex1998
+						if buffer[position] != rune('I') {
+							goto l1989
+						}
+						position++
+					}
+				l1998:
+					{
+						position2000, tokenIndex2000 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2001
+						}
+						position++
+						goto l2000
+					l2001:
+						position, tokenIndex = position2000, tokenIndex2000
 						if buffer[position] != rune('S') {
-							goto l1828
+							goto l1989
+						}
+						position++
+					}
+				l2000:
+					{
+						position2002, tokenIndex2002 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2003
+						}
+						position++
+						goto l2002
+					l2003:
+						position, tokenIndex = position2002, tokenIndex2002
+						if buffer[position] != rune('T') {
+							goto l1989
+						}
+						position++
+					}
+				l2002:
+					{
+						position2004, tokenIndex2004 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2005
+						}
+						position++
+						goto l2004
+					l2005:
+						position, tokenIndex = position2004, tokenIndex2004
+						if buffer[position] != rune('I') {
+							goto l1989
+						}
+						position++
+					}
+				l2004:
+					{
+						position2006, tokenIndex2006 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2007
+						}
+						position++
+						goto l2006
+					l2007:
+						position, tokenIndex = position2006, tokenIndex2006
+						if buffer[position] != rune('N') {
+							goto l1989
+						}
+						position++
+					}
+				l2006:
+					{
+						position2008, tokenIndex2008 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l2009
+						}
+						position++
+						goto l2008
+					l2009:
+						position, tokenIndex = position2008, tokenIndex2008
+						if buffer[position] != rune('C') {
+							goto l1989
+						}
+						position++
+					}
+				l2008:
+					{
+						position2010, tokenIndex2010 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2011
+						}
+						position++
+						goto l2010
+					l2011:
+						position, tokenIndex = position2010, tokenIndex2010
+						if buffer[position] != rune('T') {
+							goto l1989
+						}
+						position++
+					}
+				l2010:
+					if !_rules[rulesp]() {
+						goto l1989
+					}
+					{
+						position2012, tokenIndex2012 := position, tokenIndex
+						if buffer[position] != rune('f') {
+							goto l2013
+						}
+						position++
+						goto l2012
+					l2013:
+						position, tokenIndex = position2012, tokenIndex2012
+						if buffer[position] != rune('F') {
+							goto l1989
+						}
+						position++
+					}
+				l2012:
+					{
+						position2014, tokenIndex2014 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l2015
+						}
+						position++
+						goto l2014
+					l2015:
+						position, tokenIndex = position2014, tokenIndex2014
+						if buffer[position] != rune('R') {
+							goto l1989
+						}
+						position++
+					}
+				l2014:
+					{
+						position2016, tokenIndex2016 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2017
+						}
+						position++
+						goto l2016
+					l2017:
+						position, tokenIndex = position2016, tokenIndex2016
+						if buffer[position] != rune('O') {
+							goto l1989
+						}
+						position++
+					}
+				l2016:
+					{
+						position2018, tokenIndex2018 := position, tokenIndex
+						if buffer[position] != rune('m') {
+							goto l2019
+						}
+						position++
+						goto l2018
+					l2019:
+						position, tokenIndex = position2018, tokenIndex2018
+						if buffer[position] != rune('M') {
+							goto l1989
+						}
+						position++
+					}
+				l2018:
+					add(rulePegText, position1991)
+				}
+				if !_rules[ruleAction141]() {
+					goto l1989
+				}
+				add(ruleIsDistinctFrom, position1990)
+			}
+			return true
+		l1989:
+			position, tokenIndex = position1989, tokenIndex1989
+			return false
+		},
+		/* 178 IsNotDistinctFrom <- <(<(('i' / 'I') ('s' / 'S') sp (('n' / 'N') ('o' / 'O') ('t' / 'T')) sp (('d' / 'D') ('i' / 'I') ('s' / 'S') ('t' / 'T') ('i' / 'I') ('n' / 'N') ('c' / 'C') ('t' / 'T')) sp (('f' / 'F') ('r' / 'R') ('o' / 'O') ('m' / 'M')))> Action142)> */
+		func() bool {
+			position2020, tokenIndex2020 := position, tokenIndex
+			{
+				position2021 := position
+				{
+					position2022 := position
+					{
+						position2023, tokenIndex2023 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2024
+						}
+						position++
+						goto l2023
+					l2024:
+						position, tokenIndex = position2023, tokenIndex2023
+						if buffer[position] != rune('I') {
+							goto l2020
+						}
+						position++
+					}
+				l2023:
+					{
+						position2025, tokenIndex2025 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2026
+						}
+						position++
+						goto l2025
+					l2026:
+						position, tokenIndex = position2025, tokenIndex2025
+						if buffer[position] != rune('S') {
+							goto l2020
+						}
+						position++
+					}
+				l2025:
+					if !_rules[rulesp]() {
+						goto l2020
+					}
+					{
+						position2027, tokenIndex2027 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2028
+						}
+						position++
+						goto l2027
+					l2028:
+						position, tokenIndex = position2027, tokenIndex2027
+						if buffer[position] != rune('N') {
+							goto l2020
+						}
+						position++
+					}
+				l2027:
+					{
+						position2029, tokenIndex2029 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2030
+						}
+						position++
+						goto l2029
+					l2030:
+						position, tokenIndex = position2029, tokenIndex2029
+						if buffer[position] != rune('O') {
+							goto l2020
+						}
+						position++
+					}
+				l2029:
+					{
+						position2031, tokenIndex2031 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2032
+						}
+						position++
+						goto l2031
+					l2032:
+						position, tokenIndex = position2031, tokenIndex2031
+						if buffer[position] != rune('T') {
+							goto l2020
+						}
+						position++
+					}
+				l2031:
+					if !_rules[rulesp]() {
+						goto l2020
+					}
+					{
+						position2033, tokenIndex2033 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l2034
+						}
+						position++
+						goto l2033
+					l2034:
+						position, tokenIndex = position2033, tokenIndex2033
+						if buffer[position] != rune('D') {
+							goto l2020
+						}
+						position++
+					}
+				l2033:
+					{
+						position2035, tokenIndex2035 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2036
+						}
+						position++
+						goto l2035
+					l2036:
+						position, tokenIndex = position2035, tokenIndex2035
+						if buffer[position] != rune('I') {
+							goto l2020
+						}
+						position++
+					}
+				l2035:
+					{
+						position2037, tokenIndex2037 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2038
+						}
+						position++
+						goto l2037
+					l2038:
+						position, tokenIndex = position2037, tokenIndex2037
+						if buffer[position] != rune('S') {
+							goto l2020
+						}
+						position++
+					}
+				l2037:
+					{
+						position2039, tokenIndex2039 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2040
+						}
+						position++
+						goto l2039
+					l2040:
+						position, tokenIndex = position2039, tokenIndex2039
+						if buffer[position] != rune('T') {
+							goto l2020
+						}
+						position++
+					}
+				l2039:
+					{
+						position2041, tokenIndex2041 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2042
+						}
+						position++
+						goto l2041
+					l2042:
+						position, tokenIndex = position2041, tokenIndex2041
+						if buffer[position] != rune('I') {
+							goto l2020
+						}
+						position++
+					}
+				l2041:
+					{
+						position2043, tokenIndex2043 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2044
+						}
+						position++
+						goto l2043
+					l2044:
+						position, tokenIndex = position2043, tokenIndex2043
+						if buffer[position] != rune('N') {
+							goto l2020
+						}
+						position++
+					}
+				l2043:
+					{
+						position2045, tokenIndex2045 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l2046
+						}
+						position++
+						goto l2045
+					l2046:
+						position, tokenIndex = position2045, tokenIndex2045
+						if buffer[position] != rune('C') {
+							goto l2020
+						}
+						position++
+					}
+				l2045:
+					{
+						position2047, tokenIndex2047 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2048
+						}
+						position++
+						goto l2047
+					l2048:
+						position, tokenIndex = position2047, tokenIndex2047
+						if buffer[position] != rune('T') {
+							goto l2020
 						}
 						position++
 					}
-				l1833:
+				l2047:
 					if !_rules[rulesp]() {
-						goto l1828
+						goto l2020
 					}
 					{
-						position1835, tokenIndex1835 := position, tokenIndex
-						if buffer[position] != rune('n') {
-							goto l1836
+						position2049, tokenIndex2049 := position, tokenIndex
+						if buffer[position] != rune('f') {
+							goto l2050
 						}
 						position++
-						goto l1835
-					l1836:
-						position, tokenIndex = position1835, tokenIndex1835
-						if buffer[position] != rune('N') {
-							goto l1828
+						goto l2049
+					l2050:
+						position, tokenIndex = position2049, tokenIndex2049
+						if buffer[position] != rune('F') {
+							goto l2020
 						}
 						position++
 					}
-				l1835:
+				l2049:
 					{
-						position1837, tokenIndex1837 := position, tokenIndex
+						position2051, tokenIndex2051 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l2052
+						}
+						position++
+						goto l2051
+					l2052:
+						position, tokenIndex = position2051, tokenIndex2051
+						if buffer[position] != rune('R') {
+							goto l2020
+						}
+						position++
+					}
+				l2051:
+					{
+						position2053, tokenIndex2053 := position, tokenIndex
 						if buffer[position] != rune('o') {
-							goto l1838
+							goto l2054
 						}
 						position++
-						goto l1837
-					l1838:
-						position, tokenIndex = position1837, tokenIndex1837
+						goto l2053
+					l2054:
+						position, tokenIndex = position2053, tokenIndex2053
 						if buffer[position] != rune('O') {
-							goto l1828
+							goto l2020
 						}
 						position++
 					}
-				l1837:
+				l2053:
 					{
-						position1839, tokenIndex1839 := position, tokenIndex
-						if buffer[position] != rune('t') {
-							goto l1840
+						position2055, tokenIndex2055 := position, tokenIndex
+						if buffer[position] != rune('m') {
+							goto l2056
 						}
 						position++
-						goto l1839
-					l1840:
-						position, tokenIndex = position1839, tokenIndex1839
-						if buffer[position] != rune('T') {
-							goto l1828
+						goto l2055
+					l2056:
+						position, tokenIndex = position2055, tokenIndex2055
+						if buffer[position] != rune('M') {
+							goto l2020
 						}
 						position++
 					}
-				l1839:
-					add(rulePegText, position1830)
+				l2055:
+					add(rulePegText, position2022)
 				}
-				if !_rules[ruleAction127]() {
-					goto l1828
+				if !_rules[ruleAction142]() {
+					goto l2020
 				}
-				add(ruleIsNot, position1829)
+				add(ruleIsNotDistinctFrom, position2021)
 			}
 			return true
-		l1828:
-			position, tokenIndex = position1828, tokenIndex1828
+		l2020:
+			position, tokenIndex = position2020, tokenIndex2020
 			return false
 		},
-		/* 159 Plus <- <(<'+'> Action128)> */
+		/* 179 Plus <- <(<'+'> Action143)> */
 		func() bool {
-			position1841, tokenIndex1841 := position, tokenIndex
+			position2057, tokenIndex2057 := position, tokenIndex
 			{
-				position1842 := position
+				position2058 := position
 				{
-					position1843 := position
+					position2059 := position
 					if buffer[position] != rune('+') {
-						goto l1841
+						goto l2057
 					}
 					position++
-					add(rulePegText, position1843)
+					add(rulePegText, position2059)
 				}
-				if !_rules[ruleAction128]() {
-					goto l1841
+				if !_rules[ruleAction143]() {
+					goto l2057
 				}
-				add(rulePlus, position1842)
+				add(rulePlus, position2058)
 			}
 			return true
-		l1841:
-			position, tokenIndex = position1841, tokenIndex1841
+		l2057:
+			position, tokenIndex = position2057, tokenIndex2057
 			return false
 		},
-		/* 160 Minus <- <(<'-'> Action129)> */
+		/* 180 Minus <- <(<'-'> Action144)> */
 		func() bool {
-			position1844, tokenIndex1844 := position, tokenIndex
+			position2060, tokenIndex2060 := position, tokenIndex
 			{
-				position1845 := position
+				position2061 := position
 				{
-					position1846 := position
+					position2062 := position
 					if buffer[position] != rune('-') {
-						goto l1844
+						goto l2060
 					}
 					position++
-					add(rulePegText, position1846)
+					add(rulePegText, position2062)
 				}
-				if !_rules[ruleAction129]() {
-					goto l1844
+				if !_rules[ruleAction144]() {
+					goto l2060
 				}
-				add(ruleMinus, position1845)
+				add(ruleMinus, position2061)
 			}
 			return true
-		l1844:
-			position, tokenIndex = position1844, tokenIndex1844
+		l2060:
+			position, tokenIndex = position2060, tokenIndex2060
 			return false
 		},
-		/* 161 Multiply <- <(<'*'> Action130)> */
+		/* 181 Multiply <- <(<'*'> Action145)> */
 		func() bool {
-			position1847, tokenIndex1847 := position, tokenIndex
+			position2063, tokenIndex2063 := position, tokenIndex
 			{
-				position1848 := position
+				position2064 := position
 				{
-					position1849 := position
+					position2065 := position
 					if buffer[position] != rune('*') {
-						goto l1847
+						goto l2063
 					}
 					position++
-					add(rulePegText, position1849)
+					add(rulePegText, position2065)
 				}
-				if !_rules[ruleAction130]() {
-					goto l1847
+				if !_rules[ruleAction145]() {
+					goto l2063
 				}
-				add(ruleMultiply, position1848)
+				add(ruleMultiply, position2064)
 			}
 			return true
-		l1847:
-			position, tokenIndex = position1847, tokenIndex1847
+		l2063:
+			position, tokenIndex = position2063, tokenIndex2063
 			return false
 		},
-		/* 162 Divide <- <(<'/'> Action131)> */
+		/* 182 Divide <- <(<'/'> Action146)> */
 		func() bool {
-			position1850, tokenIndex1850 := position, tokenIndex
+			position2066, tokenIndex2066 := position, tokenIndex
 			{
-				position1851 := position
+				position2067 := position
 				{
-					position1852 := position
+					position2068 := position
 					if buffer[position] != rune('/') {
-						goto l1850
+						goto l2066
 					}
 					position++
-					add(rulePegText, position1852)
+					add(rulePegText, position2068)
 				}
-				if !_rules[ruleAction131]() {
-					goto l1850
+				if !_rules[ruleAction146]() {
+					goto l2066
 				}
-				add(ruleDivide, position1851)
+				add(ruleDivide, position2067)
 			}
 			return true
-		l1850:
-			position, tokenIndex = position1850, tokenIndex1850
+		l2066:
+			position, tokenIndex = position2066, tokenIndex2066
 			return false
 		},
-		/* 163 Modulo <- <(<'%'> Action132)> */
+		/* 183 Modulo <- <(<'%'> Action147)> */
 		func() bool {
-			position1853, tokenIndex1853 := position, tokenIndex
+			position2069, tokenIndex2069 := position, tokenIndex
 			{
-				position1854 := position
+				position2070 := position
 				{
-					position1855 := position
+					position2071 := position
 					if buffer[position] != rune('%') {
-						goto l1853
+						goto l2069
 					}
 					position++
-					add(rulePegText, position1855)
+					add(rulePegText, position2071)
 				}
-				if !_rules[ruleAction132]() {
-					goto l1853
+				if !_rules[ruleAction147]() {
+					goto l2069
 				}
-				add(ruleModulo, position1854)
+				add(ruleModulo, position2070)
 			}
 			return true
-		l1853:
-			position, tokenIndex = position1853, tokenIndex1853
+		l2069:
+			position, tokenIndex = position2069, tokenIndex2069
 			return false
 		},
-		/* 164 UnaryMinus <- <(<'-'> Action133)> */
+		/* 184 UnaryMinus <- <(<'-'> Action148)> */
 		func() bool {
-			position1856, tokenIndex1856 := position, tokenIndex
+			position2072, tokenIndex2072 := position, tokenIndex
 			{
-				position1857 := position
+				position2073 := position
 				{
-					position1858 := position
+					position2074 := position
 					if buffer[position] != rune('-') {
-						goto l1856
+						goto l2072
 					}
 					position++
-					add(rulePegText, position1858)
+					add(rulePegText, position2074)
 				}
-				if !_rules[ruleAction133]() {
-					goto l1856
+				if !_rules[ruleAction148]() {
+					goto l2072
 				}
-				add(ruleUnaryMinus, position1857)
+				add(ruleUnaryMinus, position2073)
 			}
 			return true
-		l1856:
-			position, tokenIndex = position1856, tokenIndex1856
+		l2072:
+			position, tokenIndex = position2072, tokenIndex2072
 			return false
 		},
-		/* 165 Identifier <- <(<ident> Action134)> */
+		/* 185 Identifier <- <(<ident> Action149)> */
 		func() bool {
-			position1859, tokenIndex1859 := position, tokenIndex
+			position2075, tokenIndex2075 := position, tokenIndex
 			{
-				position1860 := position
+				position2076 := position
 				{
-					position1861 := position
+					position2077 := position
 					if !_rules[ruleident]() {
-						goto l1859
+						goto l2075
 					}
-					add(rulePegText, position1861)
+					add(rulePegText, position2077)
 				}
-				if !_rules[ruleAction134]() {
-					goto l1859
+				if !_rules[ruleAction149]() {
+					goto l2075
 				}
-				add(ruleIdentifier, position1860)
+				add(ruleIdentifier, position2076)
 			}
 			return true
-		l1859:
-			position, tokenIndex = position1859, tokenIndex1859
+		l2075:
+			position, tokenIndex = position2075, tokenIndex2075
 			return false
 		},
-		/* 166 TargetIdentifier <- <(<('*' / jsonSetPath)> Action135)> */
+		/* 186 TargetIdentifier <- <(<('*' / jsonSetPath)> Action150)> */
 		func() bool {
-			position1862, tokenIndex1862 := position, tokenIndex
+			position2078, tokenIndex2078 := position, tokenIndex
 			{
-				position1863 := position
+				position2079 := position
 				{
-					position1864 := position
+					position2080 := position
 					{
-						position1865, tokenIndex1865 := position, tokenIndex
+						position2081, tokenIndex2081 := position, tokenIndex
 						if buffer[position] != rune('*') {
-							goto l1866
+							goto l2082
 						}
 						position++
-						goto l1865
-					l1866:
-						position, tokenIndex = position1865, tokenIndex1865
+						goto l2081
+					l2082:
+						position, tokenIndex = position2081, tokenIndex2081
 						if !_rules[rulejsonSetPath]() {
-							goto l1862
+							goto l2078
 						}
 					}
-				l1865:
-					add(rulePegText, position1864)
+				l2081:
+					add(rulePegText, position2080)
 				}
-				if !_rules[ruleAction135]() {
-					goto l1862
+				if !_rules[ruleAction150]() {
+					goto l2078
 				}
-				add(ruleTargetIdentifier, position1863)
+				add(ruleTargetIdentifier, position2079)
 			}
 			return true
-		l1862:
-			position, tokenIndex = position1862, tokenIndex1862
+		l2078:
+			position, tokenIndex = position2078, tokenIndex2078
 			return false
 		},
-		/* 167 ident <- <(([a-z] / [A-Z]) ([a-z] / [A-Z] / [0-9] / '_')*)> */
+		/* 187 ident <- <(([a-z] / [A-Z]) ([a-z] / [A-Z] / [0-9] / '_')*)> */
 		func() bool {
-			position1867, tokenIndex1867 := position, tokenIndex
+			position2083, tokenIndex2083 := position, tokenIndex
 			{
-				position1868 := position
+				position2084 := position
 				{
-					position1869, tokenIndex1869 := position, tokenIndex
+					position2085, tokenIndex2085 := position, tokenIndex
 					if c := buffer[position]; c < rune('a') || c > rune('z') {
-						goto l1870
+						goto l2086
 					}
 					position++
-					goto l1869
-				l1870:
-					position, tokenIndex = position1869, tokenIndex1869
+					goto l2085
+				l2086:
+					position, tokenIndex = position2085, tokenIndex2085
 					if c := buffer[position]; c < rune('A') || c > rune('Z') {
-						goto l1867
+						goto l2083
 					}
 					position++
 				}
-			l1869:
-			l1871:
+			l2085:
+			l2087:
 				{
-					position1872, tokenIndex1872 := position, tokenIndex
+					position2088, tokenIndex2088 := position, tokenIndex
 					{
-						position1873, tokenIndex1873 := position, tokenIndex
+						position2089, tokenIndex2089 := position, tokenIndex
 						if c := buffer[position]; c < rune('a') || c > rune('z') {
-							goto l1874
+							goto l2090
 						}
 						position++
-						goto l1873
-					l1874:
-						position, tokenIndex = position1873, tokenIndex1873
+						goto l2089
+					l2090:
+						position, tokenIndex = position2089, tokenIndex2089
 						if c := buffer[position]; c < rune('A') || c > rune('Z') {
-							goto l1875
+							goto l2091
 						}
 						position++
-						goto l1873
-					l1875:
-						position, tokenIndex = position1873, tokenIndex1873
+						goto l2089
+					l2091:
+						position, tokenIndex = position2089, tokenIndex2089
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1876
+							goto l2092
 						}
 						position++
-						goto l1873
-					l1876:
-						position, tokenIndex = position1873, tokenIndex1873
+						goto l2089
+					l2092:
+						position, tokenIndex = position2089, tokenIndex2089
 						if buffer[position] != rune('_') {
-							goto l1872
+							goto l2088
+						}
+						position++
+					}
+				l2089:
+					goto l2087
+				l2088:
+					position, tokenIndex = position2088, tokenIndex2088
+				}
+				add(ruleident, position2084)
+			}
+			return true
+		l2083:
+			position, tokenIndex = position2083, tokenIndex2083
+			return false
+		},
+		/* 188 dollarQuoteOpen <- <('$' dollarQuoteTag? '$' &{ p.setDollarQuoteTag(buffer, int(position)) })> */
+		func() bool {
+			position2093, tokenIndex2093 := position, tokenIndex
+			{
+				position2094 := position
+				if buffer[position] != rune('$') {
+					goto l2093
+				}
+				position++
+				{
+					position2095, tokenIndex2095 := position, tokenIndex
+					if !_rules[ruledollarQuoteTag]() {
+						goto l2095
+					}
+					goto l2096
+				l2095:
+					position, tokenIndex = position2095, tokenIndex2095
+				}
+			l2096:
+				if buffer[position] != rune('$') {
+					goto l2093
+				}
+				position++
+				if !(p.setDollarQuoteTag(buffer, int(position))) {
+					goto l2093
+				}
+				add(ruledollarQuoteOpen, position2094)
+			}
+			return true
+		l2093:
+			position, tokenIndex = position2093, tokenIndex2093
+			return false
+		},
+		/* 189 dollarQuoteClose <- <(&{ p.atDollarQuoteTag(buffer, int(position)) } '$' dollarQuoteTag? '$')> */
+		func() bool {
+			position2097, tokenIndex2097 := position, tokenIndex
+			{
+				position2098 := position
+				if !(p.atDollarQuoteTag(buffer, int(position))) {
+					goto l2097
+				}
+				if buffer[position] != rune('$') {
+					goto l2097
+				}
+				position++
+				{
+					position2099, tokenIndex2099 := position, tokenIndex
+					if !_rules[ruledollarQuoteTag]() {
+						goto l2099
+					}
+					goto l2100
+				l2099:
+					position, tokenIndex = position2099, tokenIndex2099
+				}
+			l2100:
+				if buffer[position] != rune('$') {
+					goto l2097
+				}
+				position++
+				add(ruledollarQuoteClose, position2098)
+			}
+			return true
+		l2097:
+			position, tokenIndex = position2097, tokenIndex2097
+			return false
+		},
+		/* 190 dollarQuoteTag <- <(([a-z] / [A-Z] / '_') ([a-z] / [A-Z] / [0-9] / '_')*)> */
+		func() bool {
+			position2101, tokenIndex2101 := position, tokenIndex
+			{
+				position2102 := position
+				{
+					position2103, tokenIndex2103 := position, tokenIndex
+					if c := buffer[position]; c < rune('a') || c > rune('z') {
+						goto l2104
+					}
+					position++
+					goto l2103
+				l2104:
+					position, tokenIndex = position2103, tokenIndex2103
+					if c := buffer[position]; c < rune('A') || c > rune('Z') {
+						goto l2105
+					}
+					position++
+					goto l2103
+				l2105:
+					position, tokenIndex = position2103, tokenIndex2103
+					if buffer[position] != rune('_') {
+						goto l2101
+					}
+					position++
+				}
+			l2103:
+			l2106:
+				{
+					position2107, tokenIndex2107 := position, tokenIndex
+					{
+						position2108, tokenIndex2108 := position, tokenIndex
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l2109
+						}
+						position++
+						goto l2108
+					l2109:
+						position, tokenIndex = position2108, tokenIndex2108
+						if c := buffer[position]; c < rune('A') || c > rune('Z') {
+							goto l2110
+						}
+						position++
+						goto l2108
+					l2110:
+						position, tokenIndex = position2108, tokenIndex2108
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l2111
+						}
+						position++
+						goto l2108
+					l2111:
+						position, tokenIndex = position2108, tokenIndex2108
+						if buffer[position] != rune('_') {
+							goto l2107
 						}
 						position++
 					}
-				l1873:
-					goto l1871
-				l1872:
-					position, tokenIndex = position1872, tokenIndex1872
+				l2108:
+					goto l2106
+				l2107:
+					position, tokenIndex = position2107, tokenIndex2107
 				}
-				add(ruleident, position1868)
+				add(ruledollarQuoteTag, position2102)
 			}
 			return true
-		l1867:
-			position, tokenIndex = position1867, tokenIndex1867
+		l2101:
+			position, tokenIndex = position2101, tokenIndex2101
 			return false
 		},
-		/* 168 jsonGetPath <- <(jsonPathHead jsonGetPathNonHead*)> */
+		/* 191 jsonGetPath <- <(jsonPathHead jsonGetPathNonHead*)> */
 		func() bool {
-			position1877, tokenIndex1877 := position, tokenIndex
+			position2112, tokenIndex2112 := position, tokenIndex
 			{
-				position1878 := position
+				position2113 := position
 				if !_rules[rulejsonPathHead]() {
-					goto l1877
+					goto l2112
 				}
-			l1879:
+			l2114:
 				{
-					position1880, tokenIndex1880 := position, tokenIndex
+					position2115, tokenIndex2115 := position, tokenIndex
 					if !_rules[rulejsonGetPathNonHead]() {
-						goto l1880
+						goto l2115
 					}
-					goto l1879
-				l1880:
-					position, tokenIndex = position1880, tokenIndex1880
+					goto l2114
+				l2115:
+					position, tokenIndex = position2115, tokenIndex2115
 				}
-				add(rulejsonGetPath, position1878)
+				add(rulejsonGetPath, position2113)
 			}
 			return true
-		l1877:
-			position, tokenIndex = position1877, tokenIndex1877
+		l2112:
+			position, tokenIndex = position2112, tokenIndex2112
 			return false
 		},
-		/* 169 jsonSetPath <- <(jsonPathHead jsonSetPathNonHead*)> */
+		/* 192 jsonSetPath <- <(jsonPathHead jsonSetPathNonHead*)> */
 		func() bool {
-			position1881, tokenIndex1881 := position, tokenIndex
+			position2116, tokenIndex2116 := position, tokenIndex
 			{
-				position1882 := position
+				position2117 := position
 				if !_rules[rulejsonPathHead]() {
-					goto l1881
+					goto l2116
 				}
-			l1883:
+			l2118:
 				{
-					position1884, tokenIndex1884 := position, tokenIndex
+					position2119, tokenIndex2119 := position, tokenIndex
 					if !_rules[rulejsonSetPathNonHead]() {
-						goto l1884
+						goto l2119
 					}
-					goto l1883
-				l1884:
-					position, tokenIndex = position1884, tokenIndex1884
+					goto l2118
+				l2119:
+					position, tokenIndex = position2119, tokenIndex2119
 				}
-				add(rulejsonSetPath, position1882)
+				add(rulejsonSetPath, position2117)
 			}
 			return true
-		l1881:
-			position, tokenIndex = position1881, tokenIndex1881
+		l2116:
+			position, tokenIndex = position2116, tokenIndex2116
 			return false
 		},
-		/* 170 jsonPathHead <- <(jsonMapAccessString / jsonMapAccessBracket)> */
+		/* 193 jsonPathHead <- <(jsonMapAccessString / jsonMapAccessBracket)> */
 		func() bool {
-			position1885, tokenIndex1885 := position, tokenIndex
+			position2120, tokenIndex2120 := position, tokenIndex
 			{
-				position1886 := position
+				position2121 := position
 				{
-					position1887, tokenIndex1887 := position, tokenIndex
+					position2122, tokenIndex2122 := position, tokenIndex
 					if !_rules[rulejsonMapAccessString]() {
-						goto l1888
+						goto l2123
 					}
-					goto l1887
-				l1888:
-					position, tokenIndex = position1887, tokenIndex1887
+					goto l2122
+				l2123:
+					position, tokenIndex = position2122, tokenIndex2122
 					if !_rules[rulejsonMapAccessBracket]() {
-						goto l1885
+						goto l2120
 					}
 				}
-			l1887:
-				add(rulejsonPathHead, position1886)
+			l2122:
+				add(rulejsonPathHead, position2121)
 			}
 			return true
-		l1885:
-			position, tokenIndex = position1885, tokenIndex1885
+		l2120:
+			position, tokenIndex = position2120, tokenIndex2120
 			return false
 		},
-		/* 171 jsonGetPathNonHead <- <(jsonMapMultipleLevel / jsonMapSingleLevel / jsonArrayFullSlice / jsonArrayPartialSlice / jsonArraySlice / jsonArrayAccess)> */
+		/* 194 jsonGetPathNonHead <- <(jsonMapMultipleLevel / jsonMapSingleLevel / jsonArrayFullSlice / jsonArrayPartialSlice / jsonArraySlice / jsonArrayAccess)> */
 		func() bool {
-			position1889, tokenIndex1889 := position, tokenIndex
+			position2124, tokenIndex2124 := position, tokenIndex
 			{
-				position1890 := position
+				position2125 := position
 				{
-					position1891, tokenIndex1891 := position, tokenIndex
+					position2126, tokenIndex2126 := position, tokenIndex
 					if !_rules[rulejsonMapMultipleLevel]() {
-						goto l1892
+						goto l2127
 					}
-					goto l1891
-				l1892:
-					position, tokenIndex = position1891, tokenIndex1891
+					goto l2126
+				l2127:
+					position, tokenIndex = position2126, tokenIndex2126
 					if !_rules[rulejsonMapSingleLevel]() {
-						goto l1893
+						goto l2128
 					}
-					goto l1891
-				l1893:
-					position, tokenIndex = position1891, tokenIndex1891
+					goto l2126
+				l2128:
+					position, tokenIndex = position2126, tokenIndex2126
 					if !_rules[rulejsonArrayFullSlice]() {
-						goto l1894
+						goto l2129
 					}
-					goto l1891
-				l1894:
-					position, tokenIndex = position1891, tokenIndex1891
+					goto l2126
+				l2129:
+					position, tokenIndex = position2126, tokenIndex2126
 					if !_rules[rulejsonArrayPartialSlice]() {
-						goto l1895
+						goto l2130
 					}
-					goto l1891
-				l1895:
-					position, tokenIndex = position1891, tokenIndex1891
+					goto l2126
+				l2130:
+					position, tokenIndex = position2126, tokenIndex2126
 					if !_rules[rulejsonArraySlice]() {
-						goto l1896
+						goto l2131
 					}
-					goto l1891
-				l1896:
-					position, tokenIndex = position1891, tokenIndex1891
+					goto l2126
+				l2131:
+					position, tokenIndex = position2126, tokenIndex2126
 					if !_rules[rulejsonArrayAccess]() {
-						goto l1889
+						goto l2124
 					}
 				}
-			l1891:
-				add(rulejsonGetPathNonHead, position1890)
+			l2126:
+				add(rulejsonGetPathNonHead, position2125)
 			}
 			return true
-		l1889:
-			position, tokenIndex = position1889, tokenIndex1889
+		l2124:
+			position, tokenIndex = position2124, tokenIndex2124
 			return false
 		},
-		/* 172 jsonSetPathNonHead <- <(jsonMapSingleLevel / jsonNonNegativeArrayAccess)> */
+		/* 195 jsonSetPathNonHead <- <(jsonMapSingleLevel / jsonNonNegativeArrayAccess)> */
 		func() bool {
-			position1897, tokenIndex1897 := position, tokenIndex
+			position2132, tokenIndex2132 := position, tokenIndex
 			{
-				position1898 := position
+				position2133 := position
 				{
-					position1899, tokenIndex1899 := position, tokenIndex
+					position2134, tokenIndex2134 := position, tokenIndex
 					if !_rules[rulejsonMapSingleLevel]() {
-						goto l1900
+						goto l2135
 					}
-					goto l1899
-				l1900:
-					position, tokenIndex = position1899, tokenIndex1899
+					goto l2134
+				l2135:
+					position, tokenIndex = position2134, tokenIndex2134
 					if !_rules[rulejsonNonNegativeArrayAccess]() {
-						goto l1897
+						goto l2132
 					}
 				}
-			l1899:
-				add(rulejsonSetPathNonHead, position1898)
+			l2134:
+				add(rulejsonSetPathNonHead, position2133)
 			}
 			return true
-		l1897:
-			position, tokenIndex = position1897, tokenIndex1897
+		l2132:
+			position, tokenIndex = position2132, tokenIndex2132
 			return false
 		},
-		/* 173 jsonMapSingleLevel <- <(('.' jsonMapAccessString) / jsonMapAccessBracket)> */
+		/* 196 jsonMapSingleLevel <- <(('.' jsonMapAccessString) / jsonMapAccessBracket)> */
 		func() bool {
-			position1901, tokenIndex1901 := position, tokenIndex
+			position2136, tokenIndex2136 := position, tokenIndex
 			{
-				position1902 := position
+				position2137 := position
 				{
-					position1903, tokenIndex1903 := position, tokenIndex
+					position2138, tokenIndex2138 := position, tokenIndex
 					if buffer[position] != rune('.') {
-						goto l1904
+						goto l2139
 					}
 					position++
 					if !_rules[rulejsonMapAccessString]() {
-						goto l1904
+						goto l2139
 					}
-					goto l1903
-				l1904:
-					position, tokenIndex = position1903, tokenIndex1903
+					goto l2138
+				l2139:
+					position, tokenIndex = position2138, tokenIndex2138
 					if !_rules[rulejsonMapAccessBracket]() {
-						goto l1901
+						goto l2136
 					}
 				}
-			l1903:
-				add(rulejsonMapSingleLevel, position1902)
+			l2138:
+				add(rulejsonMapSingleLevel, position2137)
 			}
 			return true
-		l1901:
-			position, tokenIndex = position1901, tokenIndex1901
+		l2136:
+			position, tokenIndex = position2136, tokenIndex2136
 			return false
 		},
-		/* 174 jsonMapMultipleLevel <- <('.' '.' (jsonMapAccessString / jsonMapAccessBracket))> */
+		/* 197 jsonMapMultipleLevel <- <('.' '.' (jsonMapAccessString / jsonMapAccessBracket))> */
 		func() bool {
-			position1905, tokenIndex1905 := position, tokenIndex
+			position2140, tokenIndex2140 := position, tokenIndex
 			{
-				position1906 := position
+				position2141 := position
 				if buffer[position] != rune('.') {
-					goto l1905
+					goto l2140
 				}
 				position++
 				if buffer[position] != rune('.') {
-					goto l1905
+					goto l2140
 				}
 				position++
 				{
-					position1907, tokenIndex1907 := position, tokenIndex
+					position2142, tokenIndex2142 := position, tokenIndex
 					if !_rules[rulejsonMapAccessString]() {
-						goto l1908
+						goto l2143
 					}
-					goto l1907
-				l1908:
-					position, tokenIndex = position1907, tokenIndex1907
+					goto l2142
+				l2143:
+					position, tokenIndex = position2142, tokenIndex2142
 					if !_rules[rulejsonMapAccessBracket]() {
-						goto l1905
+						goto l2140
 					}
 				}
-			l1907:
-				add(rulejsonMapMultipleLevel, position1906)
+			l2142:
+				add(rulejsonMapMultipleLevel, position2141)
 			}
 			return true
-		l1905:
-			position, tokenIndex = position1905, tokenIndex1905
+		l2140:
+			position, tokenIndex = position2140, tokenIndex2140
 			return false
 		},
-		/* 175 jsonMapAccessString <- <<(([a-z] / [A-Z]) ([a-z] / [A-Z] / [0-9] / '_')*)>> */
+		/* 198 jsonMapAccessString <- <<(([a-z] / [A-Z]) ([a-z] / [A-Z] / [0-9] / '_')*)>> */
 		func() bool {
-			position1909, tokenIndex1909 := position, tokenIndex
+			position2144, tokenIndex2144 := position, tokenIndex
 			{
-				position1910 := position
+				position2145 := position
 				{
-					position1911 := position
+					position2146 := position
 					{
-						position1912, tokenIndex1912 := position, tokenIndex
+						position2147, tokenIndex2147 := position, tokenIndex
 						if c := buffer[position]; c < rune('a') || c > rune('z') {
-							goto l1913
+							goto l2148
 						}
 						position++
-						goto l1912
-					l1913:
-						position, tokenIndex = position1912, tokenIndex1912
+						goto l2147
+					l2148:
+						position, tokenIndex = position2147, tokenIndex2147
 						if c := buffer[position]; c < rune('A') || c > rune('Z') {
-							goto l1909
+							goto l2144
 						}
 						position++
 					}
-				l1912:
-				l1914:
+				l2147:
+				l2149:
 					{
-						position1915, tokenIndex1915 := position, tokenIndex
+						position2150, tokenIndex2150 := position, tokenIndex
 						{
-							position1916, tokenIndex1916 := position, tokenIndex
+							position2151, tokenIndex2151 := position, tokenIndex
 							if c := buffer[position]; c < rune('a') || c > rune('z') {
-								goto l1917
+								goto l2152
 							}
 							position++
-							goto l1916
-						l1917:
-							position, tokenIndex = position1916, tokenIndex1916
+							goto l2151
+						l2152:
+							position, tokenIndex = position2151, tokenIndex2151
 							if c := buffer[position]; c < rune('A') || c > rune('Z') {
-								goto l1918
+								goto l2153
 							}
 							position++
-							goto l1916
-						l1918:
-							position, tokenIndex = position1916, tokenIndex1916
+							goto l2151
+						l2153:
+							position, tokenIndex = position2151, tokenIndex2151
 							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l1919
+								goto l2154
 							}
 							position++
-							goto l1916
-						l1919:
-							position, tokenIndex = position1916, tokenIndex1916
+							goto l2151
+						l2154:
+							position, tokenIndex = position2151, tokenIndex2151
 							if buffer[position] != rune('_') {
-								goto l1915
+								goto l2150
 							}
 							position++
 						}
-					l1916:
-						goto l1914
-					l1915:
-						position, tokenIndex = position1915, tokenIndex1915
+					l2151:
+						goto l2149
+					l2150:
+						position, tokenIndex = position2150, tokenIndex2150
 					}
-					add(rulePegText, position1911)
+					add(rulePegText, position2146)
 				}
-				add(rulejsonMapAccessString, position1910)
+				add(rulejsonMapAccessString, position2145)
 			}
 			return true
-		l1909:
-			position, tokenIndex = position1909, tokenIndex1909
+		l2144:
+			position, tokenIndex = position2144, tokenIndex2144
 			return false
 		},
-		/* 176 jsonMapAccessBracket <- <('[' doubleQuotedString ']')> */
+		/* 199 jsonMapAccessBracket <- <('[' doubleQuotedString ']')> */
 		func() bool {
-			position1920, tokenIndex1920 := position, tokenIndex
+			position2155, tokenIndex2155 := position, tokenIndex
 			{
-				position1921 := position
+				position2156 := position
 				if buffer[position] != rune('[') {
-					goto l1920
+					goto l2155
 				}
 				position++
 				if !_rules[ruledoubleQuotedString]() {
-					goto l1920
+					goto l2155
 				}
 				if buffer[position] != rune(']') {
-					goto l1920
+					goto l2155
 				}
 				position++
-				add(rulejsonMapAccessBracket, position1921)
+				add(rulejsonMapAccessBracket, position2156)
 			}
 			return true
-		l1920:
-			position, tokenIndex = position1920, tokenIndex1920
+		l2155:
+			position, tokenIndex = position2155, tokenIndex2155
 			return false
 		},
-		/* 177 doubleQuotedString <- <('"' <(('"' '"') / (!'"' .))*> '"')> */
+		/* 200 doubleQuotedString <- <('"' <(('"' '"') / (!'"' .))*> '"')> */
 		func() bool {
-			position1922, tokenIndex1922 := position, tokenIndex
+			position2157, tokenIndex2157 := position, tokenIndex
 			{
-				position1923 := position
+				position2158 := position
 				if buffer[position] != rune('"') {
-					goto l1922
+					goto l2157
 				}
 				position++
 				{
-					position1924 := position
-				l1925:
+					position2159 := position
+				l2160:
 					{
-						position1926, tokenIndex1926 := position, tokenIndex
+						position2161, tokenIndex2161 := position, tokenIndex
 						{
-							position1927, tokenIndex1927 := position, tokenIndex
+							position2162, tokenIndex2162 := position, tokenIndex
 							if buffer[position] != rune('"') {
-								goto l1928
+								goto l2163
 							}
 							position++
 							if buffer[position] != rune('"') {
-								goto l1928
+								goto l2163
 							}
 							position++
-							goto l1927
-						l1928:
-							position, tokenIndex = position1927, tokenIndex1927
+							goto l2162
+						l2163:
+							position, tokenIndex = position2162, tokenIndex2162
 							{
-								position1929, tokenIndex1929 := position, tokenIndex
+								position2164, tokenIndex2164 := position, tokenIndex
 								if buffer[position] != rune('"') {
-									goto l1929
+									goto l2164
 								}
 								position++
-								goto l1926
-							l1929:
-								position, tokenIndex = position1929, tokenIndex1929
+								goto l2161
+							l2164:
+								position, tokenIndex = position2164, tokenIndex2164
 							}
 							if !matchDot() {
-								goto l1926
+								goto l2161
 							}
 						}
-					l1927:
-						goto l1925
-					l1926:
-						position, tokenIndex = position1926, tokenIndex1926
+					l2162:
+						goto l2160
+					l2161:
+						position, tokenIndex = position2161, tokenIndex2161
 					}
-					add(rulePegText, position1924)
+					add(rulePegText, position2159)
 				}
 				if buffer[position] != rune('"') {
-					goto l1922
+					goto l2157
 				}
 				position++
-				add(ruledoubleQuotedString, position1923)
+				add(ruledoubleQuotedString, position2158)
 			}
 			return true
-		l1922:
-			position, tokenIndex = position1922, tokenIndex1922
+		l2157:
+			position, tokenIndex = position2157, tokenIndex2157
 			return false
 		},
-		/* 178 jsonArrayAccess <- <('[' <('-'? [0-9]+)> ']')> */
+		/* 201 jsonArrayAccess <- <('[' <('-'? [0-9]+)> ']')> */
 		func() bool {
-			position1930, tokenIndex1930 := position, tokenIndex
+			position2165, tokenIndex2165 := position, tokenIndex
 			{
-				position1931 := position
+				position2166 := position
 				if buffer[position] != rune('[') {
-					goto l1930
+					goto l2165
 				}
 				position++
 				{
-					position1932 := position
+					position2167 := position
 					{
-						position1933, tokenIndex1933 := position, tokenIndex
+						position2168, tokenIndex2168 := position, tokenIndex
 						if buffer[position] != rune('-') {
-							goto l1933
+							goto l2168
 						}
 						position++
-						goto l1934
-					l1933:
-						position, tokenIndex = position1933, tokenIndex1933
+						goto l2169
+					l2168:
+						position, tokenIndex = position2168, tokenIndex2168
 					}
-				l1934:
+				l2169:
 					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l1930
+						goto l2165
 					}
 					position++
-				l1935:
+				l2170:
 					{
-						position1936, tokenIndex1936 := position, tokenIndex
+						position2171, tokenIndex2171 := position, tokenIndex
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1936
+							goto l2171
 						}
 						position++
-						goto l1935
-					l1936:
-						position, tokenIndex = position1936, tokenIndex1936
+						goto l2170
+					l2171:
+						position, tokenIndex = position2171, tokenIndex2171
 					}
-					add(rulePegText, position1932)
+					add(rulePegText, position2167)
 				}
 				if buffer[position] != rune(']') {
-					goto l1930
+					goto l2165
 				}
 				position++
-				add(rulejsonArrayAccess, position1931)
+				add(rulejsonArrayAccess, position2166)
 			}
 			return true
-		l1930:
-			position, tokenIndex = position1930, tokenIndex1930
+		l2165:
+			position, tokenIndex = position2165, tokenIndex2165
 			return false
 		},
-		/* 179 jsonNonNegativeArrayAccess <- <('[' <[0-9]+> ']')> */
+		/* 202 jsonNonNegativeArrayAccess <- <('[' <[0-9]+> ']')> */
 		func() bool {
-			position1937, tokenIndex1937 := position, tokenIndex
+			position2172, tokenIndex2172 := position, tokenIndex
 			{
-				position1938 := position
+				position2173 := position
 				if buffer[position] != rune('[') {
-					goto l1937
+					goto l2172
 				}
 				position++
 				{
-					position1939 := position
+					position2174 := position
 					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l1937
+						goto l2172
 					}
 					position++
-				l1940:
+				l2175:
 					{
-						position1941, tokenIndex1941 := position, tokenIndex
+						position2176, tokenIndex2176 := position, tokenIndex
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1941
+							goto l2176
 						}
 						position++
-						goto l1940
-					l1941:
-						position, tokenIndex = position1941, tokenIndex1941
+						goto l2175
+					l2176:
+						position, tokenIndex = position2176, tokenIndex2176
 					}
-					add(rulePegText, position1939)
+					add(rulePegText, position2174)
 				}
 				if buffer[position] != rune(']') {
-					goto l1937
+					goto l2172
 				}
 				position++
-				add(rulejsonNonNegativeArrayAccess, position1938)
+				add(rulejsonNonNegativeArrayAccess, position2173)
 			}
 			return true
-		l1937:
-			position, tokenIndex = position1937, tokenIndex1937
+		l2172:
+			position, tokenIndex = position2172, tokenIndex2172
 			return false
 		},
-		/* 180 jsonArraySlice <- <('[' <('-'? [0-9]+ ':' '-'? [0-9]+ (':' '-'? [0-9]+)?)> ']')> */
+		/* 203 jsonArraySlice <- <('[' <('-'? [0-9]+ ':' '-'? [0-9]+ (':' '-'? [0-9]+)?)> ']')> */
 		func() bool {
-			position1942, tokenIndex1942 := position, tokenIndex
+			position2177, tokenIndex2177 := position, tokenIndex
 			{
-				position1943 := position
+				position2178 := position
 				if buffer[position] != rune('[') {
-					goto l1942
+					goto l2177
 				}
 				position++
 				{
-					position1944 := position
+					position2179 := position
 					{
-						position1945, tokenIndex1945 := position, tokenIndex
+						position2180, tokenIndex2180 := position, tokenIndex
 						if buffer[position] != rune('-') {
-							goto l1945
+							goto l2180
 						}
 						position++
-						goto l1946
-					l1945:
-						position, tokenIndex = position1945, tokenIndex1945
+						goto l2181
+					l2180:
+						position, tokenIndex = position2180, tokenIndex2180
 					}
-				l1946:
+				l2181:
 					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l1942
+						goto l2177
 					}
 					position++
-				l1947:
+				l2182:
 					{
-						position1948, tokenIndex1948 := position, tokenIndex
+						position2183, tokenIndex2183 := position, tokenIndex
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1948
+							goto l2183
 						}
 						position++
-						goto l1947
-					l1948:
-						position, tokenIndex = position1948, tokenIndex1948
+						goto l2182
+					l2183:
+						position, tokenIndex = position2183, tokenIndex2183
 					}
 					if buffer[position] != rune(':') {
-						goto l1942
+						goto l2177
 					}
 					position++
 					{
-						position1949, tokenIndex1949 := position, tokenIndex
+						position2184, tokenIndex2184 := position, tokenIndex
 						if buffer[position] != rune('-') {
-							goto l1949
+							goto l2184
 						}
 						position++
-						goto l1950
-					l1949:
-						position, tokenIndex = position1949, tokenIndex1949
+						goto l2185
+					l2184:
+						position, tokenIndex = position2184, tokenIndex2184
 					}
-				l1950:
+				l2185:
 					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l1942
+						goto l2177
 					}
 					position++
-				l1951:
+				l2186:
 					{
-						position1952, tokenIndex1952 := position, tokenIndex
+						position2187, tokenIndex2187 := position, tokenIndex
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1952
+							goto l2187
 						}
 						position++
-						goto l1951
-					l1952:
-						position, tokenIndex = position1952, tokenIndex1952
+						goto l2186
+					l2187:
+						position, tokenIndex = position2187, tokenIndex2187
 					}
 					{
-						position1953, tokenIndex1953 := position, tokenIndex
+						position2188, tokenIndex2188 := position, tokenIndex
 						if buffer[position] != rune(':') {
-							goto l1953
+							goto l2188
 						}
 						position++
 						{
-							position1955, tokenIndex1955 := position, tokenIndex
+							position2190, tokenIndex2190 := position, tokenIndex
 							if buffer[position] != rune('-') {
-								goto l1955
+								goto l2190
 							}
 							position++
-							goto l1956
-						l1955:
-							position, tokenIndex = position1955, tokenIndex1955
+							goto l2191
+						l2190:
+							position, tokenIndex = position2190, tokenIndex2190
 						}
-					l1956:
+					l2191:
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1953
+							goto l2188
 						}
 						position++
-					l1957:
+					l2192:
 						{
-							position1958, tokenIndex1958 := position, tokenIndex
+							position2193, tokenIndex2193 := position, tokenIndex
 							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l1958
+								goto l2193
 							}
 							position++
-							goto l1957
-						l1958:
-							position, tokenIndex = position1958, tokenIndex1958
+							goto l2192
+						l2193:
+							position, tokenIndex = position2193, tokenIndex2193
 						}
-						goto l1954
-					l1953:
-						position, tokenIndex = position1953, tokenIndex1953
+						goto l2189
+					l2188:
+						position, tokenIndex = position2188, tokenIndex2188
 					}
-				l1954:
-					add(rulePegText, position1944)
+				l2189:
+					add(rulePegText, position2179)
 				}
 				if buffer[position] != rune(']') {
-					goto l1942
+					goto l2177
 				}
 				position++
-				add(rulejsonArraySlice, position1943)
+				add(rulejsonArraySlice, position2178)
 			}
 			return true
-		l1942:
-			position, tokenIndex = position1942, tokenIndex1942
+		l2177:
+			position, tokenIndex = position2177, tokenIndex2177
 			return false
 		},
-		/* 181 jsonArrayPartialSlice <- <('[' <((':' '-'? [0-9]+) / ('-'? [0-9]+ ':'))> ']')> */
+		/* 204 jsonArrayPartialSlice <- <('[' <((':' '-'? [0-9]+) / ('-'? [0-9]+ ':'))> ']')> */
 		func() bool {
-			position1959, tokenIndex1959 := position, tokenIndex
+			position2194, tokenIndex2194 := position, tokenIndex
 			{
-				position1960 := position
+				position2195 := position
 				if buffer[position] != rune('[') {
-					goto l1959
+					goto l2194
 				}
 				position++
 				{
-					position1961 := position
+					position2196 := position
 					{
-						position1962, tokenIndex1962 := position, tokenIndex
+						position2197, tokenIndex2197 := position, tokenIndex
 						if buffer[position] != rune(':') {
-							goto l1963
+							goto l2198
 						}
 						position++
 						{
-							position1964, tokenIndex1964 := position, tokenIndex
+							position2199, tokenIndex2199 := position, tokenIndex
 							if buffer[position] != rune('-') {
-								goto l1964
+								goto l2199
 							}
 							position++
-							goto l1965
-						l1964:
-							position, tokenIndex = position1964, tokenIndex1964
+							goto l2200
+						l2199:
+							position, tokenIndex = position2199, tokenIndex2199
 						}
-					l1965:
+					l2200:
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1963
+							goto l2198
 						}
 						position++
-					l1966:
+					l2201:
 						{
-							position1967, tokenIndex1967 := position, tokenIndex
+							position2202, tokenIndex2202 := position, tokenIndex
 							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l1967
+								goto l2202
 							}
 							position++
-							goto l1966
-						l1967:
-							position, tokenIndex = position1967, tokenIndex1967
+							goto l2201
+						l2202:
+							position, tokenIndex = position2202, tokenIndex2202
 						}
-						goto l1962
-					l1963:
-						position, tokenIndex = position1962, tokenIndex1962
+						goto l2197
+					l2198:
+						position, tokenIndex = position2197, tokenIndex2197
 						{
-							position1968, tokenIndex1968 := position, tokenIndex
+							position2203, tokenIndex2203 := position, tokenIndex
 							if buffer[position] != rune('-') {
-								goto l1968
+								goto l2203
 							}
 							position++
-							goto l1969
-						l1968:
-							position, tokenIndex = position1968, tokenIndex1968
+							goto l2204
+						l2203:
+							position, tokenIndex = position2203, tokenIndex2203
 						}
-					l1969:
+					l2204:
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1959
+							goto l2194
 						}
 						position++
-					l1970:
+					l2205:
 						{
-							position1971, tokenIndex1971 := position, tokenIndex
+							position2206, tokenIndex2206 := position, tokenIndex
 							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l1971
+								goto l2206
 							}
 							position++
-							goto l1970
-						l1971:
-							position, tokenIndex = position1971, tokenIndex1971
+							goto l2205
+						l2206:
+							position, tokenIndex = position2206, tokenIndex2206
 						}
 						if buffer[position] != rune(':') {
-							goto l1959
+							goto l2194
 						}
 						position++
 					}
-				l1962:
-					add(rulePegText, position1961)
+				l2197:
+					add(rulePegText, position2196)
 				}
 				if buffer[position] != rune(']') {
-					goto l1959
+					goto l2194
 				}
 				position++
-				add(rulejsonArrayPartialSlice, position1960)
+				add(rulejsonArrayPartialSlice, position2195)
 			}
 			return true
-		l1959:
-			position, tokenIndex = position1959, tokenIndex1959
+		l2194:
+			position, tokenIndex = position2194, tokenIndex2194
 			return false
 		},
-		/* 182 jsonArrayFullSlice <- <('[' ':' ']')> */
+		/* 205 jsonArrayFullSlice <- <('[' ':' ']')> */
 		func() bool {
-			position1972, tokenIndex1972 := position, tokenIndex
+			position2207, tokenIndex2207 := position, tokenIndex
 			{
-				position1973 := position
+				position2208 := position
 				if buffer[position] != rune('[') {
-					goto l1972
+					goto l2207
 				}
 				position++
 				if buffer[position] != rune(':') {
-					goto l1972
+					goto l2207
 				}
 				position++
 				if buffer[position] != rune(']') {
-					goto l1972
+					goto l2207
 				}
 				position++
-				add(rulejsonArrayFullSlice, position1973)
+				add(rulejsonArrayFullSlice, position2208)
 			}
 			return true
-		l1972:
-			position, tokenIndex = position1972, tokenIndex1972
+		l2207:
+			position, tokenIndex = position2207, tokenIndex2207
 			return false
 		},
-		/* 183 spElem <- <(' ' / '\t' / '\n' / '\r' / comment / finalComment)> */
+		/* 206 spElem <- <(' ' / '\t' / '\n' / '\r' / comment / finalComment)> */
 		func() bool {
-			position1974, tokenIndex1974 := position, tokenIndex
+			position2209, tokenIndex2209 := position, tokenIndex
 			{
-				position1975 := position
+				position2210 := position
 				{
-					position1976, tokenIndex1976 := position, tokenIndex
+					position2211, tokenIndex2211 := position, tokenIndex
 					if buffer[position] != rune(' ') {
-						goto l1977
+						goto l2212
 					}
 					position++
-					goto l1976
-				l1977:
-					position, tokenIndex = position1976, tokenIndex1976
+					goto l2211
+				l2212:
+					position, tokenIndex = position2211, tokenIndex2211
 					if buffer[position] != rune('\t') {
-						goto l1978
+						goto l2213
 					}
 					position++
-					goto l1976
-				l1978:
-					position, tokenIndex = position1976, tokenIndex1976
+					goto l2211
+				l2213:
+					position, tokenIndex = position2211, tokenIndex2211
 					if buffer[position] != rune('\n') {
-						goto l1979
+						goto l2214
 					}
 					position++
-					goto l1976
-				l1979:
-					position, tokenIndex = position1976, tokenIndex1976
+					goto l2211
+				l2214:
+					position, tokenIndex = position2211, tokenIndex2211
 					if buffer[position] != rune('\r') {
-						goto l1980
+						goto l2215
 					}
 					position++
-					goto l1976
-				l1980:
-					position, tokenIndex = position1976, tokenIndex1976
+					goto l2211
+				l2215:
+					position, tokenIndex = position2211, tokenIndex2211
 					if !_rules[rulecomment]() {
-						goto l1981
+						goto l2216
 					}
-					goto l1976
-				l1981:
-					position, tokenIndex = position1976, tokenIndex1976
+					goto l2211
+				l2216:
+					position, tokenIndex = position2211, tokenIndex2211
 					if !_rules[rulefinalComment]() {
-						goto l1974
+						goto l2209
 					}
 				}
-			l1976:
-				add(rulespElem, position1975)
+			l2211:
+				add(rulespElem, position2210)
 			}
 			return true
-		l1974:
-			position, tokenIndex = position1974, tokenIndex1974
+		l2209:
+			position, tokenIndex = position2209, tokenIndex2209
 			return false
 		},
-		/* 184 sp <- <spElem+> */
+		/* 207 sp <- <spElem+> */
 		func() bool {
-			position1982, tokenIndex1982 := position, tokenIndex
+			position2217, tokenIndex2217 := position, tokenIndex
 			{
-				position1983 := position
+				position2218 := position
 				if !_rules[rulespElem]() {
-					goto l1982
+					goto l2217
 				}
-			l1984:
+			l2219:
 				{
-					position1985, tokenIndex1985 := position, tokenIndex
+					position2220, tokenIndex2220 := position, tokenIndex
 					if !_rules[rulespElem]() {
-						goto l1985
+						goto l2220
 					}
-					goto l1984
-				l1985:
-					position, tokenIndex = position1985, tokenIndex1985
+					goto l2219
+				l2220:
+					position, tokenIndex = position2220, tokenIndex2220
 				}
-				add(rulesp, position1983)
+				add(rulesp, position2218)
 			}
 			return true
-		l1982:
-			position, tokenIndex = position1982, tokenIndex1982
+		l2217:
+			position, tokenIndex = position2217, tokenIndex2217
 			return false
 		},
-		/* 185 spOpt <- <spElem*> */
+		/* 208 spOpt <- <spElem*> */
 		func() bool {
 			{
-				position1987 := position
-			l1988:
+				position2222 := position
+			l2223:
 				{
-					position1989, tokenIndex1989 := position, tokenIndex
+					position2224, tokenIndex2224 := position, tokenIndex
 					if !_rules[rulespElem]() {
-						goto l1989
+						goto l2224
 					}
-					goto l1988
-				l1989:
-					position, tokenIndex = position1989, tokenIndex1989
+					goto l2223
+				l2224:
+					position, tokenIndex = position2224, tokenIndex2224
 				}
-				add(rulespOpt, position1987)
+				add(rulespOpt, position2222)
 			}
 			return true
 		},
-		/* 186 comment <- <('-' '-' (!('\r' / '\n') .)* ('\r' / '\n'))> */
+		/* 209 comment <- <('-' '-' (!('\r' / '\n') .)* ('\r' / '\n'))> */
 		func() bool {
-			position1990, tokenIndex1990 := position, tokenIndex
+			position2225, tokenIndex2225 := position, tokenIndex
 			{
-				position1991 := position
+				position2226 := position
 				if buffer[position] != rune('-') {
-					goto l1990
+					goto l2225
 				}
 				position++
 				if buffer[position] != rune('-') {
-					goto l1990
+					goto l2225
 				}
 				position++
-			l1992:
+			l2227:
 				{
-					position1993, tokenIndex1993 := position, tokenIndex
+					position2228, tokenIndex2228 := position, tokenIndex
 					{
-						position1994, tokenIndex1994 := position, tokenIndex
+						position2229, tokenIndex2229 := position, tokenIndex
 						{
-							position1995, tokenIndex1995 := position, tokenIndex
+							position2230, tokenIndex2230 := position, tokenIndex
 							if buffer[position] != rune('\r') {
-								goto l1996
+								goto l2231
 							}
 							position++
-							goto l1995
-						l1996:
-							position, tokenIndex = position1995, tokenIndex1995
+							goto l2230
+						l2231:
+							position, tokenIndex = position2230, tokenIndex2230
 							if buffer[position] != rune('\n') {
-								goto l1994
+								goto l2229
 							}
 							position++
 						}
-					l1995:
-						goto l1993
-					l1994:
-						position, tokenIndex = position1994, tokenIndex1994
+					l2230:
+						goto l2228
+					l2229:
+						position, tokenIndex = position2229, tokenIndex2229
 					}
 					if !matchDot() {
-						goto l1993
+						goto l2228
 					}
-					goto l1992
-				l1993:
-					position, tokenIndex = position1993, tokenIndex1993
+					goto l2227
+				l2228:
+					position, tokenIndex = position2228, tokenIndex2228
 				}
 				{
-					position1997, tokenIndex1997 := position, tokenIndex
+					position2232, tokenIndex2232 := position, tokenIndex
 					if buffer[position] != rune('\r') {
-						goto l1998
+						goto l2233
 					}
 					position++
-					goto l1997
-				l1998:
-					position, tokenIndex = position1997, tokenIndex1997
+					goto l2232
+				l2233:
+					position, tokenIndex = position2232, tokenIndex2232
 					if buffer[position] != rune('\n') {
-						goto l1990
+						goto l2225
 					}
 					position++
 				}
-			l1997:
-				add(rulecomment, position1991)
+			l2232:
+				add(rulecomment, position2226)
 			}
 			return true
-		l1990:
-			position, tokenIndex = position1990, tokenIndex1990
+		l2225:
+			position, tokenIndex = position2225, tokenIndex2225
 			return false
 		},
-		/* 187 finalComment <- <('-' '-' (!('\r' / '\n') .)* !.)> */
+		/* 210 finalComment <- <('-' '-' (!('\r' / '\n') .)* !.)> */
 		func() bool {
-			position1999, tokenIndex1999 := position, tokenIndex
+			position2234, tokenIndex2234 := position, tokenIndex
 			{
-				position2000 := position
+				position2235 := position
 				if buffer[position] != rune('-') {
-					goto l1999
+					goto l2234
 				}
 				position++
 				if buffer[position] != rune('-') {
-					goto l1999
+					goto l2234
 				}
 				position++
-			l2001:
+			l2236:
 				{
-					position2002, tokenIndex2002 := position, tokenIndex
+					position2237, tokenIndex2237 := position, tokenIndex
 					{
-						position2003, tokenIndex2003 := position, tokenIndex
+						position2238, tokenIndex2238 := position, tokenIndex
 						{
-							position2004, tokenIndex2004 := position, tokenIndex
+							position2239, tokenIndex2239 := position, tokenIndex
 							if buffer[position] != rune('\r') {
-								goto l2005
+								goto l2240
 							}
 							position++
-							goto l2004
-						l2005:
-							position, tokenIndex = position2004, tokenIndex2004
+							goto l2239
+						l2240:
+							position, tokenIndex = position2239, tokenIndex2239
 							if buffer[position] != rune('\n') {
-								goto l2003
+								goto l2238
 							}
 							position++
 						}
-					l2004:
-						goto l2002
-					l2003:
-						position, tokenIndex = position2003, tokenIndex2003
+					l2239:
+						goto l2237
+					l2238:
+						position, tokenIndex = position2238, tokenIndex2238
 					}
 					if !matchDot() {
-						goto l2002
+						goto l2237
 					}
-					goto l2001
-				l2002:
-					position, tokenIndex = position2002, tokenIndex2002
+					goto l2236
+				l2237:
+					position, tokenIndex = position2237, tokenIndex2237
 				}
 				{
-					position2006, tokenIndex2006 := position, tokenIndex
+					position2241, tokenIndex2241 := position, tokenIndex
 					if !matchDot() {
-						goto l2006
+						goto l2241
 					}
-					goto l1999
-				l2006:
-					position, tokenIndex = position2006, tokenIndex2006
+					goto l2234
+				l2241:
+					position, tokenIndex = position2241, tokenIndex2241
 				}
-				add(rulefinalComment, position2000)
+				add(rulefinalComment, position2235)
 			}
 			return true
-		l1999:
-			position, tokenIndex = position1999, tokenIndex1999
+		l2234:
+			position, tokenIndex = position2234, tokenIndex2234
 			return false
 		},
 		nil,
-		/* 190 Action0 <- <{
+		/* 213 Action0 <- <{
 		    p.IncludeTrailingWhitespace(begin, end)
 		}> */
 		func() bool {
@@ -16904,7 +18838,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 191 Action1 <- <{
+		/* 214 Action1 <- <{
 		    p.IncludeTrailingWhitespace(begin, end)
 		}> */
 		func() bool {
@@ -16913,7 +18847,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 192 Action2 <- <{
+		/* 215 Action2 <- <{
 		    p.AssembleSelect()
 		}> */
 		func() bool {
@@ -16922,7 +18856,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 193 Action3 <- <{
+		/* 216 Action3 <- <{
 		    p.AssembleSelectUnion(begin, end)
 		}> */
 		func() bool {
@@ -16931,7 +18865,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 194 Action4 <- <{
+		/* 217 Action4 <- <{
 		    p.AssembleCreateStreamAsSelect()
 		}> */
 		func() bool {
@@ -16940,7 +18874,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 195 Action5 <- <{
+		/* 218 Action5 <- <{
 		    p.AssembleCreateStreamAsSelectUnion()
 		}> */
 		func() bool {
@@ -16949,7 +18883,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 196 Action6 <- <{
+		/* 219 Action6 <- <{
 		    p.AssembleCreateSource()
 		}> */
 		func() bool {
@@ -16958,7 +18892,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 197 Action7 <- <{
+		/* 220 Action7 <- <{
 		    p.AssembleCreateSink()
 		}> */
 		func() bool {
@@ -16967,7 +18901,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 198 Action8 <- <{
+		/* 221 Action8 <- <{
 		    p.AssembleCreateState()
 		}> */
 		func() bool {
@@ -16976,7 +18910,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 199 Action9 <- <{
+		/* 222 Action9 <- <{
 		    p.AssembleUpdateState()
 		}> */
 		func() bool {
@@ -16985,7 +18919,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 200 Action10 <- <{
+		/* 223 Action10 <- <{
 		    p.AssembleUpdateSource()
 		}> */
 		func() bool {
@@ -16994,7 +18928,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 201 Action11 <- <{
+		/* 224 Action11 <- <{
 		    p.AssembleUpdateSink()
 		}> */
 		func() bool {
@@ -17003,7 +18937,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 202 Action12 <- <{
+		/* 225 Action12 <- <{
 		    p.AssembleInsertIntoFrom()
 		}> */
 		func() bool {
@@ -17012,7 +18946,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 203 Action13 <- <{
+		/* 226 Action13 <- <{
 		    p.AssemblePauseSource()
 		}> */
 		func() bool {
@@ -17021,7 +18955,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 204 Action14 <- <{
+		/* 227 Action14 <- <{
 		    p.AssembleResumeSource()
 		}> */
 		func() bool {
@@ -17030,7 +18964,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 205 Action15 <- <{
+		/* 228 Action15 <- <{
 		    p.AssembleRewindSource()
 		}> */
 		func() bool {
@@ -17039,7 +18973,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 206 Action16 <- <{
+		/* 229 Action16 <- <{
 		    p.AssembleDropSource()
 		}> */
 		func() bool {
@@ -17048,7 +18982,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 207 Action17 <- <{
+		/* 230 Action17 <- <{
 		    p.AssembleDropStream()
 		}> */
 		func() bool {
@@ -17057,7 +18991,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 208 Action18 <- <{
+		/* 231 Action18 <- <{
 		    p.AssembleDropSink()
 		}> */
 		func() bool {
@@ -17066,7 +19000,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 209 Action19 <- <{
+		/* 232 Action19 <- <{
 		    p.AssembleDropState()
 		}> */
 		func() bool {
@@ -17075,7 +19009,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 210 Action20 <- <{
+		/* 233 Action20 <- <{
 		    p.AssembleLoadState()
 		}> */
 		func() bool {
@@ -17084,7 +19018,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 211 Action21 <- <{
+		/* 234 Action21 <- <{
 		    p.AssembleLoadStateOrCreate()
 		}> */
 		func() bool {
@@ -17093,7 +19027,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 212 Action22 <- <{
+		/* 235 Action22 <- <{
 		    p.AssembleSaveState()
 		}> */
 		func() bool {
@@ -17102,7 +19036,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 213 Action23 <- <{
+		/* 236 Action23 <- <{
 		    p.AssembleEval(begin, end)
 		}> */
 		func() bool {
@@ -17111,8 +19045,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 214 Action24 <- <{
-		    p.AssembleEmitter()
+		/* 237 Action24 <- <{
+		    p.AssembleExplain(begin, end)
 		}> */
 		func() bool {
 			{
@@ -17120,8 +19054,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 215 Action25 <- <{
-		    p.AssembleEmitterOptions(begin, end)
+		/* 238 Action25 <- <{
+		    p.AssembleEmitter()
 		}> */
 		func() bool {
 			{
@@ -17129,8 +19063,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 216 Action26 <- <{
-		    p.AssembleEmitterLimit()
+		/* 239 Action26 <- <{
+		    p.AssembleEmitterOptions(begin, end)
 		}> */
 		func() bool {
 			{
@@ -17138,8 +19072,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 217 Action27 <- <{
-		    p.AssembleEmitterSampling(CountBasedSampling, 1)
+		/* 240 Action27 <- <{
+		    p.AssembleEmitterLimit()
 		}> */
 		func() bool {
 			{
@@ -17147,8 +19081,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 218 Action28 <- <{
-		    p.AssembleEmitterSampling(RandomizedSampling, 1)
+		/* 241 Action28 <- <{
+		    p.AssembleEmitterSampling(CountBasedSampling, 1)
 		}> */
 		func() bool {
 			{
@@ -17156,8 +19090,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 219 Action29 <- <{
-		    p.AssembleEmitterSampling(TimeBasedSampling, 1)
+		/* 242 Action29 <- <{
+		    p.AssembleEmitterSampling(RandomizedSampling, 1)
 		}> */
 		func() bool {
 			{
@@ -17165,8 +19099,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 220 Action30 <- <{
-		    p.AssembleEmitterSampling(TimeBasedSampling, 0.001)
+		/* 243 Action30 <- <{
+		    p.AssembleEmitterSampling(TimeBasedSampling, 1)
 		}> */
 		func() bool {
 			{
@@ -17174,8 +19108,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 221 Action31 <- <{
-		    p.AssembleProjections(begin, end)
+		/* 244 Action31 <- <{
+		    p.AssembleEmitterSampling(TimeBasedSampling, 0.001)
 		}> */
 		func() bool {
 			{
@@ -17183,8 +19117,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 222 Action32 <- <{
-		    p.AssembleAlias()
+		/* 245 Action32 <- <{
+		    p.AssembleProjections(begin, end)
 		}> */
 		func() bool {
 			{
@@ -17192,956 +19126,1097 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 223 Action33 <- <{
+		/* 246 Action33 <- <{
+		    p.AssembleAlias()
+		}> */
+		func() bool {
+			{
+				add(ruleAction33, position)
+			}
+			return true
+		},
+		/* 247 Action34 <- <{
 		    // This is *always* executed, even if there is no
 		    // FROM clause present in the statement.
 		    p.AssembleWindowedFrom(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction33, position)
+				add(ruleAction34, position)
 			}
 			return true
 		},
-		/* 224 Action34 <- <{
+		/* 248 Action35 <- <{
 		    p.AssembleInterval()
 		}> */
 		func() bool {
 			{
-				add(ruleAction34, position)
+				add(ruleAction35, position)
 			}
 			return true
 		},
-		/* 225 Action35 <- <{
+		/* 249 Action36 <- <{
 		    p.AssembleInterval()
 		}> */
 		func() bool {
 			{
-				add(ruleAction35, position)
+				add(ruleAction36, position)
 			}
 			return true
 		},
-		/* 226 Action36 <- <{
+		/* 250 Action37 <- <{
 		    // This is *always* executed, even if there is no
 		    // WHERE clause present in the statement.
 		    p.AssembleFilter(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction36, position)
+				add(ruleAction37, position)
 			}
 			return true
 		},
-		/* 227 Action37 <- <{
+		/* 251 Action38 <- <{
 		    // This is *always* executed, even if there is no
 		    // GROUP BY clause present in the statement.
 		    p.AssembleGrouping(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction37, position)
+				add(ruleAction38, position)
 			}
 			return true
 		},
-		/* 228 Action38 <- <{
+		/* 252 Action39 <- <{
 		    // This is *always* executed, even if there is no
 		    // HAVING clause present in the statement.
 		    p.AssembleHaving(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction38, position)
+				add(ruleAction39, position)
 			}
 			return true
 		},
-		/* 229 Action39 <- <{
+		/* 253 Action40 <- <{
 		    p.EnsureAliasedStreamWindow()
 		}> */
 		func() bool {
 			{
-				add(ruleAction39, position)
+				add(ruleAction40, position)
 			}
 			return true
 		},
-		/* 230 Action40 <- <{
+		/* 254 Action41 <- <{
 		    p.AssembleAliasedStreamWindow()
 		}> */
 		func() bool {
 			{
-				add(ruleAction40, position)
+				add(ruleAction41, position)
 			}
 			return true
 		},
-		/* 231 Action41 <- <{
+		/* 255 Action42 <- <{
 		    p.AssembleStreamWindow()
 		}> */
 		func() bool {
 			{
-				add(ruleAction41, position)
+				add(ruleAction42, position)
 			}
 			return true
 		},
-		/* 232 Action42 <- <{
+		/* 256 Action43 <- <{
 		    p.AssembleUDSFFuncApp()
 		}> */
 		func() bool {
 			{
-				add(ruleAction42, position)
+				add(ruleAction43, position)
 			}
 			return true
 		},
-		/* 233 Action43 <- <{
+		/* 257 Action44 <- <{
 		    p.EnsureCapacitySpec(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction43, position)
+				add(ruleAction44, position)
 			}
 			return true
 		},
-		/* 234 Action44 <- <{
+		/* 258 Action45 <- <{
 		    p.EnsureSheddingSpec(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction44, position)
+				add(ruleAction45, position)
 			}
 			return true
 		},
-		/* 235 Action45 <- <{
+		/* 259 Action46 <- <{
 		    p.AssembleSourceSinkSpecs(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction45, position)
+				add(ruleAction46, position)
 			}
 			return true
 		},
-		/* 236 Action46 <- <{
+		/* 260 Action47 <- <{
 		    p.AssembleSourceSinkSpecs(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction46, position)
+				add(ruleAction47, position)
+			}
+			return true
+		},
+		/* 261 Action48 <- <{
+		    p.AssembleSourceSinkSpecsFromMap(begin, end)
+		}> */
+		func() bool {
+			{
+				add(ruleAction48, position)
 			}
 			return true
 		},
-		/* 237 Action47 <- <{
+		/* 262 Action49 <- <{
 		    p.AssembleSourceSinkSpecs(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction47, position)
+				add(ruleAction49, position)
 			}
 			return true
 		},
-		/* 238 Action48 <- <{
+		/* 263 Action50 <- <{
 		    p.EnsureIdentifier(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction48, position)
+				add(ruleAction50, position)
 			}
 			return true
 		},
-		/* 239 Action49 <- <{
+		/* 264 Action51 <- <{
 		    p.AssembleSourceSinkParam()
 		}> */
 		func() bool {
 			{
-				add(ruleAction49, position)
+				add(ruleAction51, position)
 			}
 			return true
 		},
-		/* 240 Action50 <- <{
+		/* 265 Action52 <- <{
 		    p.AssembleExpressions(begin, end)
 		    p.AssembleArray()
 		}> */
 		func() bool {
 			{
-				add(ruleAction50, position)
+				add(ruleAction52, position)
 			}
 			return true
 		},
-		/* 241 Action51 <- <{
+		/* 266 Action53 <- <{
 		    p.AssembleMap(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction51, position)
+				add(ruleAction53, position)
 			}
 			return true
 		},
-		/* 242 Action52 <- <{
+		/* 267 Action54 <- <{
 		    p.AssembleKeyValuePair()
 		}> */
 		func() bool {
 			{
-				add(ruleAction52, position)
+				add(ruleAction54, position)
 			}
 			return true
 		},
-		/* 243 Action53 <- <{
+		/* 268 Action55 <- <{
 		    p.EnsureKeywordPresent(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction53, position)
+				add(ruleAction55, position)
 			}
 			return true
 		},
-		/* 244 Action54 <- <{
+		/* 269 Action56 <- <{
 		    p.AssembleBinaryOperation(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction54, position)
+				add(ruleAction56, position)
 			}
 			return true
 		},
-		/* 245 Action55 <- <{
+		/* 270 Action57 <- <{
 		    p.AssembleBinaryOperation(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction55, position)
+				add(ruleAction57, position)
 			}
 			return true
 		},
-		/* 246 Action56 <- <{
+		/* 271 Action58 <- <{
 		    p.AssembleUnaryPrefixOperation(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction56, position)
+				add(ruleAction58, position)
 			}
 			return true
 		},
-		/* 247 Action57 <- <{
+		/* 272 Action59 <- <{
 		    p.AssembleBinaryOperation(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction57, position)
+				add(ruleAction59, position)
+			}
+			return true
+		},
+		/* 273 Action60 <- <{
+		    p.AssembleIn(begin, end)
+		}> */
+		func() bool {
+			{
+				add(ruleAction60, position)
 			}
 			return true
 		},
-		/* 248 Action58 <- <{
+		/* 274 Action61 <- <{
 		    p.AssembleBinaryOperation(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction58, position)
+				add(ruleAction61, position)
 			}
 			return true
 		},
-		/* 249 Action59 <- <{
+		/* 275 Action62 <- <{
 		    p.AssembleBinaryOperation(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction59, position)
+				add(ruleAction62, position)
 			}
 			return true
 		},
-		/* 250 Action60 <- <{
+		/* 276 Action63 <- <{
 		    p.AssembleBinaryOperation(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction60, position)
+				add(ruleAction63, position)
 			}
 			return true
 		},
-		/* 251 Action61 <- <{
+		/* 277 Action64 <- <{
 		    p.AssembleBinaryOperation(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction61, position)
+				add(ruleAction64, position)
 			}
 			return true
 		},
-		/* 252 Action62 <- <{
+		/* 278 Action65 <- <{
 		    p.AssembleUnaryPrefixOperation(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction62, position)
+				add(ruleAction65, position)
 			}
 			return true
 		},
-		/* 253 Action63 <- <{
+		/* 279 Action66 <- <{
 		    p.AssembleTypeCast(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction63, position)
+				add(ruleAction66, position)
 			}
 			return true
 		},
-		/* 254 Action64 <- <{
+		/* 280 Action67 <- <{
 		    p.AssembleTypeCast(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction64, position)
+				add(ruleAction67, position)
 			}
 			return true
 		},
-		/* 255 Action65 <- <{
+		/* 281 Action68 <- <{
 		    p.AssembleFuncAppSelector()
 		}> */
 		func() bool {
 			{
-				add(ruleAction65, position)
+				add(ruleAction68, position)
 			}
 			return true
 		},
-		/* 256 Action66 <- <{
-		    substr := string([]rune(buffer)[begin:end])
+		/* 282 Action69 <- <{
+		    substr := p.foldIdentifier(string([]rune(buffer)[begin:end]))
 		    p.PushComponent(begin, end, NewRaw(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction66, position)
+				add(ruleAction69, position)
 			}
 			return true
 		},
-		/* 257 Action67 <- <{
+		/* 283 Action70 <- <{
 		    p.AssembleFuncApp()
 		}> */
 		func() bool {
 			{
-				add(ruleAction67, position)
+				add(ruleAction70, position)
 			}
 			return true
 		},
-		/* 258 Action68 <- <{
+		/* 284 Action71 <- <{
 		    p.AssembleExpressions(begin, end)
 		    p.AssembleFuncApp()
 		}> */
 		func() bool {
 			{
-				add(ruleAction68, position)
+				add(ruleAction71, position)
 			}
 			return true
 		},
-		/* 259 Action69 <- <{
+		/* 285 Action72 <- <{
 		    p.AssembleExpressions(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction69, position)
+				add(ruleAction72, position)
 			}
 			return true
 		},
-		/* 260 Action70 <- <{
+		/* 286 Action73 <- <{
 		    p.AssembleExpressions(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction70, position)
+				add(ruleAction73, position)
 			}
 			return true
 		},
-		/* 261 Action71 <- <{
+		/* 287 Action74 <- <{
 		    p.AssembleSortedExpression()
 		}> */
 		func() bool {
 			{
-				add(ruleAction71, position)
+				add(ruleAction74, position)
 			}
 			return true
 		},
-		/* 262 Action72 <- <{
+		/* 288 Action75 <- <{
 		    p.EnsureKeywordPresent(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction72, position)
+				add(ruleAction75, position)
 			}
 			return true
 		},
-		/* 263 Action73 <- <{
+		/* 289 Action76 <- <{
 		    p.AssembleExpressions(begin, end)
 		    p.AssembleArray()
 		}> */
 		func() bool {
 			{
-				add(ruleAction73, position)
+				add(ruleAction76, position)
+			}
+			return true
+		},
+		/* 290 Action77 <- <{
+		    p.AssembleExpressions(begin, end)
+		    p.AssembleRow()
+		}> */
+		func() bool {
+			{
+				add(ruleAction77, position)
+			}
+			return true
+		},
+		/* 291 Action78 <- <{
+		    p.AssembleExpressions(begin, end)
+		    p.AssembleRow()
+		}> */
+		func() bool {
+			{
+				add(ruleAction78, position)
 			}
 			return true
 		},
-		/* 264 Action74 <- <{
+		/* 292 Action79 <- <{
 		    p.AssembleMap(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction74, position)
+				add(ruleAction79, position)
 			}
 			return true
 		},
-		/* 265 Action75 <- <{
+		/* 293 Action80 <- <{
 		    p.AssembleKeyValuePair()
 		}> */
 		func() bool {
 			{
-				add(ruleAction75, position)
+				add(ruleAction80, position)
 			}
 			return true
 		},
-		/* 266 Action76 <- <{
+		/* 294 Action81 <- <{
 		    p.AssembleConditionCase(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction76, position)
+				add(ruleAction81, position)
 			}
 			return true
 		},
-		/* 267 Action77 <- <{
+		/* 295 Action82 <- <{
 		    p.AssembleExpressionCase(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction77, position)
+				add(ruleAction82, position)
 			}
 			return true
 		},
-		/* 268 Action78 <- <{
+		/* 296 Action83 <- <{
 		    p.AssembleWhenThenPair()
 		}> */
 		func() bool {
 			{
-				add(ruleAction78, position)
+				add(ruleAction83, position)
 			}
 			return true
 		},
-		/* 269 Action79 <- <{
-		    substr := string([]rune(buffer)[begin:end])
+		/* 297 Action84 <- <{
+		    substr := p.foldIdentifier(string([]rune(buffer)[begin:end]))
 		    p.PushComponent(begin, end, NewStream(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction79, position)
+				add(ruleAction84, position)
 			}
 			return true
 		},
-		/* 270 Action80 <- <{
-		    substr := string([]rune(buffer)[begin:end])
+		/* 298 Action85 <- <{
+		    substr := p.foldIdentifier(string([]rune(buffer)[begin:end]))
 		    p.PushComponent(begin, end, NewRowMeta(substr, TimestampMeta))
 		}> */
 		func() bool {
 			{
-				add(ruleAction80, position)
+				add(ruleAction85, position)
 			}
 			return true
 		},
-		/* 271 Action81 <- <{
-		    substr := string([]rune(buffer)[begin:end])
+		/* 299 Action86 <- <{
+		    substr := p.foldIdentifier(string([]rune(buffer)[begin:end]))
 		    p.PushComponent(begin, end, NewRowValue(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction81, position)
+				add(ruleAction86, position)
+			}
+			return true
+		},
+		/* 300 Action87 <- <{
+		    p.numPositionalParams++
+		    p.PushComponent(begin, end, NewPositionalParam(p.numPositionalParams))
+		}> */
+		func() bool {
+			{
+				add(ruleAction87, position)
+			}
+			return true
+		},
+		/* 301 Action88 <- <{
+		    substr := string([]rune(buffer)[begin:end])
+		    p.PushComponent(begin, end, NewNamedParam(substr))
+		}> */
+		func() bool {
+			{
+				add(ruleAction88, position)
 			}
 			return true
 		},
-		/* 272 Action82 <- <{
+		/* 302 Action89 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, NewNumericLiteral(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction82, position)
+				add(ruleAction89, position)
 			}
 			return true
 		},
-		/* 273 Action83 <- <{
+		/* 303 Action90 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, NewNumericLiteral(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction83, position)
+				add(ruleAction90, position)
 			}
 			return true
 		},
-		/* 274 Action84 <- <{
+		/* 304 Action91 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, NewFloatLiteral(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction84, position)
+				add(ruleAction91, position)
 			}
 			return true
 		},
-		/* 275 Action85 <- <{
+		/* 305 Action92 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, FuncName(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction85, position)
+				add(ruleAction92, position)
 			}
 			return true
 		},
-		/* 276 Action86 <- <{
+		/* 306 Action93 <- <{
 		    p.PushComponent(begin, end, NewNullLiteral())
 		}> */
 		func() bool {
 			{
-				add(ruleAction86, position)
+				add(ruleAction93, position)
 			}
 			return true
 		},
-		/* 277 Action87 <- <{
+		/* 307 Action94 <- <{
 		    p.PushComponent(begin, end, NewMissing())
 		}> */
 		func() bool {
 			{
-				add(ruleAction87, position)
+				add(ruleAction94, position)
 			}
 			return true
 		},
-		/* 278 Action88 <- <{
+		/* 308 Action95 <- <{
 		    p.PushComponent(begin, end, NewBoolLiteral(true))
 		}> */
 		func() bool {
 			{
-				add(ruleAction88, position)
+				add(ruleAction95, position)
 			}
 			return true
 		},
-		/* 279 Action89 <- <{
+		/* 309 Action96 <- <{
 		    p.PushComponent(begin, end, NewBoolLiteral(false))
 		}> */
 		func() bool {
 			{
-				add(ruleAction89, position)
+				add(ruleAction96, position)
 			}
 			return true
 		},
-		/* 280 Action90 <- <{
-		    substr := string([]rune(buffer)[begin:end])
+		/* 310 Action97 <- <{
+		    substr := p.foldIdentifier(string([]rune(buffer)[begin:end]))
 		    p.PushComponent(begin, end, NewWildcard(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction90, position)
+				add(ruleAction97, position)
 			}
 			return true
 		},
-		/* 281 Action91 <- <{
+		/* 311 Action98 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, NewStringLiteral(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction91, position)
+				add(ruleAction98, position)
+			}
+			return true
+		},
+		/* 312 Action99 <- <{
+		    substr := string([]rune(buffer)[begin:end])
+		    p.PushComponent(begin, end, NewDollarQuotedStringLiteral(substr))
+		}> */
+		func() bool {
+			{
+				add(ruleAction99, position)
+			}
+			return true
+		},
+		/* 313 Action100 <- <{
+		    substr := string([]rune(buffer)[begin:end])
+		    p.PushComponent(begin, end, NewIntervalLiteral(substr))
+		}> */
+		func() bool {
+			{
+				add(ruleAction100, position)
 			}
 			return true
 		},
-		/* 282 Action92 <- <{
+		/* 314 Action101 <- <{
 		    p.PushComponent(begin, end, Istream)
 		}> */
 		func() bool {
 			{
-				add(ruleAction92, position)
+				add(ruleAction101, position)
 			}
 			return true
 		},
-		/* 283 Action93 <- <{
+		/* 315 Action102 <- <{
 		    p.PushComponent(begin, end, Dstream)
 		}> */
 		func() bool {
 			{
-				add(ruleAction93, position)
+				add(ruleAction102, position)
 			}
 			return true
 		},
-		/* 284 Action94 <- <{
+		/* 316 Action103 <- <{
 		    p.PushComponent(begin, end, Rstream)
 		}> */
 		func() bool {
 			{
-				add(ruleAction94, position)
+				add(ruleAction103, position)
 			}
 			return true
 		},
-		/* 285 Action95 <- <{
+		/* 317 Action104 <- <{
 		    p.PushComponent(begin, end, Tuples)
 		}> */
 		func() bool {
 			{
-				add(ruleAction95, position)
+				add(ruleAction104, position)
 			}
 			return true
 		},
-		/* 286 Action96 <- <{
+		/* 318 Action105 <- <{
 		    p.PushComponent(begin, end, Seconds)
 		}> */
 		func() bool {
 			{
-				add(ruleAction96, position)
+				add(ruleAction105, position)
 			}
 			return true
 		},
-		/* 287 Action97 <- <{
+		/* 319 Action106 <- <{
 		    p.PushComponent(begin, end, Milliseconds)
 		}> */
 		func() bool {
 			{
-				add(ruleAction97, position)
+				add(ruleAction106, position)
 			}
 			return true
 		},
-		/* 288 Action98 <- <{
+		/* 320 Action107 <- <{
 		    p.PushComponent(begin, end, Wait)
 		}> */
 		func() bool {
 			{
-				add(ruleAction98, position)
+				add(ruleAction107, position)
 			}
 			return true
 		},
-		/* 289 Action99 <- <{
+		/* 321 Action108 <- <{
 		    p.PushComponent(begin, end, DropOldest)
 		}> */
 		func() bool {
 			{
-				add(ruleAction99, position)
+				add(ruleAction108, position)
 			}
 			return true
 		},
-		/* 290 Action100 <- <{
+		/* 322 Action109 <- <{
 		    p.PushComponent(begin, end, DropNewest)
 		}> */
 		func() bool {
 			{
-				add(ruleAction100, position)
+				add(ruleAction109, position)
 			}
 			return true
 		},
-		/* 291 Action101 <- <{
-		    substr := string([]rune(buffer)[begin:end])
+		/* 323 Action110 <- <{
+		    substr := p.foldIdentifier(string([]rune(buffer)[begin:end]))
 		    p.PushComponent(begin, end, StreamIdentifier(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction101, position)
+				add(ruleAction110, position)
 			}
 			return true
 		},
-		/* 292 Action102 <- <{
+		/* 324 Action111 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, SourceSinkType(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction102, position)
+				add(ruleAction111, position)
 			}
 			return true
 		},
-		/* 293 Action103 <- <{
+		/* 325 Action112 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, SourceSinkParamKey(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction103, position)
+				add(ruleAction112, position)
 			}
 			return true
 		},
-		/* 294 Action104 <- <{
+		/* 326 Action113 <- <{
 		    p.PushComponent(begin, end, Yes)
 		}> */
 		func() bool {
 			{
-				add(ruleAction104, position)
+				add(ruleAction113, position)
 			}
 			return true
 		},
-		/* 295 Action105 <- <{
+		/* 327 Action114 <- <{
 		    p.PushComponent(begin, end, No)
 		}> */
 		func() bool {
 			{
-				add(ruleAction105, position)
+				add(ruleAction114, position)
 			}
 			return true
 		},
-		/* 296 Action106 <- <{
+		/* 328 Action115 <- <{
 		    p.PushComponent(begin, end, Yes)
 		}> */
 		func() bool {
 			{
-				add(ruleAction106, position)
+				add(ruleAction115, position)
 			}
 			return true
 		},
-		/* 297 Action107 <- <{
+		/* 329 Action116 <- <{
 		    p.PushComponent(begin, end, No)
 		}> */
 		func() bool {
 			{
-				add(ruleAction107, position)
+				add(ruleAction116, position)
 			}
 			return true
 		},
-		/* 298 Action108 <- <{
+		/* 330 Action117 <- <{
 		    p.PushComponent(begin, end, Bool)
 		}> */
 		func() bool {
 			{
-				add(ruleAction108, position)
+				add(ruleAction117, position)
 			}
 			return true
 		},
-		/* 299 Action109 <- <{
+		/* 331 Action118 <- <{
 		    p.PushComponent(begin, end, Int)
 		}> */
 		func() bool {
 			{
-				add(ruleAction109, position)
+				add(ruleAction118, position)
 			}
 			return true
 		},
-		/* 300 Action110 <- <{
+		/* 332 Action119 <- <{
 		    p.PushComponent(begin, end, Float)
 		}> */
 		func() bool {
 			{
-				add(ruleAction110, position)
+				add(ruleAction119, position)
 			}
 			return true
 		},
-		/* 301 Action111 <- <{
+		/* 333 Action120 <- <{
 		    p.PushComponent(begin, end, String)
 		}> */
 		func() bool {
 			{
-				add(ruleAction111, position)
+				add(ruleAction120, position)
 			}
 			return true
 		},
-		/* 302 Action112 <- <{
+		/* 334 Action121 <- <{
 		    p.PushComponent(begin, end, Blob)
 		}> */
 		func() bool {
 			{
-				add(ruleAction112, position)
+				add(ruleAction121, position)
 			}
 			return true
 		},
-		/* 303 Action113 <- <{
+		/* 335 Action122 <- <{
 		    p.PushComponent(begin, end, Timestamp)
 		}> */
 		func() bool {
 			{
-				add(ruleAction113, position)
+				add(ruleAction122, position)
 			}
 			return true
 		},
-		/* 304 Action114 <- <{
+		/* 336 Action123 <- <{
 		    p.PushComponent(begin, end, Array)
 		}> */
 		func() bool {
 			{
-				add(ruleAction114, position)
+				add(ruleAction123, position)
 			}
 			return true
 		},
-		/* 305 Action115 <- <{
+		/* 337 Action124 <- <{
 		    p.PushComponent(begin, end, Map)
 		}> */
 		func() bool {
 			{
-				add(ruleAction115, position)
+				add(ruleAction124, position)
 			}
 			return true
 		},
-		/* 306 Action116 <- <{
+		/* 338 Action125 <- <{
 		    p.PushComponent(begin, end, Or)
 		}> */
 		func() bool {
 			{
-				add(ruleAction116, position)
+				add(ruleAction125, position)
 			}
 			return true
 		},
-		/* 307 Action117 <- <{
+		/* 339 Action126 <- <{
 		    p.PushComponent(begin, end, And)
 		}> */
 		func() bool {
 			{
-				add(ruleAction117, position)
+				add(ruleAction126, position)
 			}
 			return true
 		},
-		/* 308 Action118 <- <{
+		/* 340 Action127 <- <{
 		    p.PushComponent(begin, end, Not)
 		}> */
 		func() bool {
 			{
-				add(ruleAction118, position)
+				add(ruleAction127, position)
 			}
 			return true
 		},
-		/* 309 Action119 <- <{
+		/* 341 Action128 <- <{
 		    p.PushComponent(begin, end, Equal)
 		}> */
 		func() bool {
 			{
-				add(ruleAction119, position)
+				add(ruleAction128, position)
 			}
 			return true
 		},
-		/* 310 Action120 <- <{
+		/* 342 Action129 <- <{
 		    p.PushComponent(begin, end, Less)
 		}> */
 		func() bool {
 			{
-				add(ruleAction120, position)
+				add(ruleAction129, position)
 			}
 			return true
 		},
-		/* 311 Action121 <- <{
+		/* 343 Action130 <- <{
 		    p.PushComponent(begin, end, LessOrEqual)
 		}> */
 		func() bool {
 			{
-				add(ruleAction121, position)
+				add(ruleAction130, position)
 			}
 			return true
 		},
-		/* 312 Action122 <- <{
+		/* 344 Action131 <- <{
 		    p.PushComponent(begin, end, Greater)
 		}> */
 		func() bool {
 			{
-				add(ruleAction122, position)
+				add(ruleAction131, position)
 			}
 			return true
 		},
-		/* 313 Action123 <- <{
+		/* 345 Action132 <- <{
 		    p.PushComponent(begin, end, GreaterOrEqual)
 		}> */
 		func() bool {
 			{
-				add(ruleAction123, position)
+				add(ruleAction132, position)
 			}
 			return true
 		},
-		/* 314 Action124 <- <{
+		/* 346 Action133 <- <{
 		    p.PushComponent(begin, end, NotEqual)
 		}> */
 		func() bool {
 			{
-				add(ruleAction124, position)
+				add(ruleAction133, position)
+			}
+			return true
+		},
+		/* 347 Action134 <- <{
+		    p.PushComponent(begin, end, In)
+		}> */
+		func() bool {
+			{
+				add(ruleAction134, position)
+			}
+			return true
+		},
+		/* 348 Action135 <- <{
+		    p.PushComponent(begin, end, NotIn)
+		}> */
+		func() bool {
+			{
+				add(ruleAction135, position)
 			}
 			return true
 		},
-		/* 315 Action125 <- <{
+		/* 349 Action136 <- <{
 		    p.PushComponent(begin, end, Concat)
 		}> */
 		func() bool {
 			{
-				add(ruleAction125, position)
+				add(ruleAction136, position)
+			}
+			return true
+		},
+		/* 350 Action137 <- <{
+		    p.PushComponent(begin, end, Regex)
+		}> */
+		func() bool {
+			{
+				add(ruleAction137, position)
+			}
+			return true
+		},
+		/* 351 Action138 <- <{
+		    p.PushComponent(begin, end, NotRegex)
+		}> */
+		func() bool {
+			{
+				add(ruleAction138, position)
 			}
 			return true
 		},
-		/* 316 Action126 <- <{
+		/* 352 Action139 <- <{
 		    p.PushComponent(begin, end, Is)
 		}> */
 		func() bool {
 			{
-				add(ruleAction126, position)
+				add(ruleAction139, position)
 			}
 			return true
 		},
-		/* 317 Action127 <- <{
+		/* 353 Action140 <- <{
 		    p.PushComponent(begin, end, IsNot)
 		}> */
 		func() bool {
 			{
-				add(ruleAction127, position)
+				add(ruleAction140, position)
+			}
+			return true
+		},
+		/* 354 Action141 <- <{
+		    p.PushComponent(begin, end, IsDistinctFrom)
+		}> */
+		func() bool {
+			{
+				add(ruleAction141, position)
 			}
 			return true
 		},
-		/* 318 Action128 <- <{
+		/* 355 Action142 <- <{
+		    p.PushComponent(begin, end, IsNotDistinctFrom)
+		}> */
+		func() bool {
+			{
+				add(ruleAction142, position)
+			}
+			return true
+		},
+		/* 356 Action143 <- <{
 		    p.PushComponent(begin, end, Plus)
 		}> */
 		func() bool {
 			{
-				add(ruleAction128, position)
+				add(ruleAction143, position)
 			}
 			return true
 		},
-		/* 319 Action129 <- <{
+		/* 357 Action144 <- <{
 		    p.PushComponent(begin, end, Minus)
 		}> */
 		func() bool {
 			{
-				add(ruleAction129, position)
+				add(ruleAction144, position)
 			}
 			return true
 		},
-		/* 320 Action130 <- <{
+		/* 358 Action145 <- <{
 		    p.PushComponent(begin, end, Multiply)
 		}> */
 		func() bool {
 			{
-				add(ruleAction130, position)
+				add(ruleAction145, position)
 			}
 			return true
 		},
-		/* 321 Action131 <- <{
+		/* 359 Action146 <- <{
 		    p.PushComponent(begin, end, Divide)
 		}> */
 		func() bool {
 			{
-				add(ruleAction131, position)
+				add(ruleAction146, position)
 			}
 			return true
 		},
-		/* 322 Action132 <- <{
+		/* 360 Action147 <- <{
 		    p.PushComponent(begin, end, Modulo)
 		}> */
 		func() bool {
 			{
-				add(ruleAction132, position)
+				add(ruleAction147, position)
 			}
 			return true
 		},
-		/* 323 Action133 <- <{
+		/* 361 Action148 <- <{
 		    p.PushComponent(begin, end, UnaryMinus)
 		}> */
 		func() bool {
 			{
-				add(ruleAction133, position)
+				add(ruleAction148, position)
 			}
 			return true
 		},
-		/* 324 Action134 <- <{
-		    substr := string([]rune(buffer)[begin:end])
+		/* 362 Action149 <- <{
+		    substr := p.foldIdentifier(string([]rune(buffer)[begin:end]))
 		    p.PushComponent(begin, end, Identifier(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction134, position)
+				add(ruleAction149, position)
 			}
 			return true
 		},
-		/* 325 Action135 <- <{
-		    substr := string([]rune(buffer)[begin:end])
+		/* 363 Action150 <- <{
+		    substr := p.foldIdentifier(string([]rune(buffer)[begin:end]))
 		    p.PushComponent(begin, end, Identifier(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction135, position)
+				add(ruleAction150, position)
 			}
 			return true
 		},
@@ -0,0 +1,115 @@
+package parser
+
+// ReferencedColumns returns the distinct column references used in
+// the given statement (in the SELECT, FROM, WHERE, GROUP BY and HAVING
+// clauses of a SELECT statement, or in the expression of an EVAL
+// statement, including those wrapped by EXPLAIN) in the order of their
+// first appearance. References that differ in their relation, such as
+// `a:x` and `b:x`, are considered different columns. Statements without
+// expressions return nil.
+func ReferencedColumns(stmt Statement) []RowValue {
+	c := columnCollector{seen: map[RowValue]bool{}}
+	switch s := stmt.(type) {
+	case SelectStmt:
+		c.addSelect(s)
+	case SelectUnionStmt:
+		for _, sel := range s.Selects {
+			c.addSelect(sel)
+		}
+	case CreateStreamAsSelectStmt:
+		c.addSelect(s.Select)
+	case CreateStreamAsSelectUnionStmt:
+		for _, sel := range s.Selects {
+			c.addSelect(sel)
+		}
+	case EvalStmt:
+		c.add(s.Expr)
+	case ExplainStmt:
+		return ReferencedColumns(s.Stmt)
+	}
+	return c.cols
+}
+
+// columnCollector walks expressions and remembers each RowValue
+// found in them once.
+type columnCollector struct {
+	cols []RowValue
+	seen map[RowValue]bool
+}
+
+func (c *columnCollector) addSelect(s SelectStmt) {
+	c.addAll(s.Projections)
+	for _, rel := range s.Relations {
+		c.addAll(rel.Params)
+	}
+	if s.Filter != nil {
+		c.add(s.Filter)
+	}
+	c.addAll(s.GroupList)
+	if s.Having != nil {
+		c.add(s.Having)
+	}
+}
+
+func (c *columnCollector) addAll(exprs []Expression) {
+	for _, e := range exprs {
+		c.add(e)
+	}
+}
+
+func (c *columnCollector) add(e Expression) {
+	switch obj := e.(type) {
+	case RowValue:
+		if !c.seen[obj] {
+			c.seen[obj] = true
+			c.cols = append(c.cols, obj)
+		}
+	case AliasAST:
+		c.add(obj.Expr)
+	case BinaryOpAST:
+		c.add(obj.Left)
+		c.add(obj.Right)
+	case UnaryOpAST:
+		c.add(obj.Expr)
+	case TypeCastAST:
+		c.add(obj.Expr)
+	case FuncAppAST:
+		c.addFuncApp(obj)
+	case FuncAppSelectorAST:
+		c.addFuncApp(obj.FuncAppAST)
+	case SortedExpressionAST:
+		c.add(obj.Expr)
+	case ArrayAST:
+		c.addAll(obj.Expressions)
+	case RowAST:
+		c.addAll(obj.Expressions)
+	case MapAST:
+		for _, pair := range obj.Entries {
+			c.add(pair.Value)
+		}
+	case ConditionCaseAST:
+		c.addConditionCase(obj)
+	case ExpressionCaseAST:
+		c.add(obj.Expr)
+		c.addConditionCase(obj.ConditionCaseAST)
+	}
+	// all other expressions (literals, wildcards, meta information)
+	// do not reference columns
+}
+
+func (c *columnCollector) addFuncApp(f FuncAppAST) {
+	c.addAll(f.Expressions)
+	for _, o := range f.Ordering {
+		c.add(o.Expr)
+	}
+}
+
+func (c *columnCollector) addConditionCase(cc ConditionCaseAST) {
+	for _, pair := range cc.Checks {
+		c.add(pair.When)
+		c.add(pair.Then)
+	}
+	if cc.Else != nil {
+		c.add(cc.Else)
+	}
+}
@@ -0,0 +1,59 @@
+package parser
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestReferencedColumns(t *testing.T) {
+	Convey("Given a BQL parser", t, func() {
+		p := New()
+
+		Convey("When parsing a statement referencing columns in multiple clauses", func() {
+			stmt, _, err := p.ParseStmt(`CREATE STREAM out AS SELECT ISTREAM a:x, b:y AS y,
+				f(a:z ORDER BY b:w DESC), CASE a:x WHEN 1 THEN [b:v] ELSE {"k": a:u} END
+				FROM s [RANGE 1 TUPLES] AS a, udsf(b:t, 2) [RANGE 1 TUPLES] AS b
+				WHERE a:x > 1 AND b:y IS NOT NULL AND x IN (1, b:y)
+				GROUP BY a:x, b:y, a:z, b:w HAVING count(a:q) > 0`)
+			So(err, ShouldBeNil)
+
+			Convey("Then ReferencedColumns should return the distinct references", func() {
+				So(ReferencedColumns(stmt.(Statement)), ShouldResemble, []RowValue{
+					{"a", "x"}, {"b", "y"}, {"a", "z"}, {"b", "w"}, {"b", "v"}, {"a", "u"},
+					{"b", "t"}, {"", "x"}, {"a", "q"},
+				})
+			})
+		})
+
+		Convey("When parsing a statement referencing nested fields", func() {
+			stmt, _, err := p.ParseStmt(`SELECT ISTREAM a.b, a.b, a.c, a.b::int, ts() FROM s [RANGE 1 TUPLES]`)
+			So(err, ShouldBeNil)
+
+			Convey("Then each path should be returned once", func() {
+				So(ReferencedColumns(stmt.(Statement)), ShouldResemble, []RowValue{
+					{"", "a.b"}, {"", "a.c"},
+				})
+			})
+		})
+
+		Convey("When parsing an EVAL statement", func() {
+			stmt, _, err := p.ParseStmt(`EVAL a + b ON {"a": 1, "b": 2}`)
+			So(err, ShouldBeNil)
+
+			Convey("Then ReferencedColumns should return the columns of the expression", func() {
+				So(ReferencedColumns(stmt.(Statement)), ShouldResemble, []RowValue{
+					{"", "a"}, {"", "b"},
+				})
+			})
+		})
+
+		Convey("When parsing a statement without expressions", func() {
+			stmt, _, err := p.ParseStmt(`CREATE SOURCE s TYPE t WITH a=1`)
+			So(err, ShouldBeNil)
+
+			Convey("Then ReferencedColumns should return nothing", func() {
+				So(ReferencedColumns(stmt.(Statement)), ShouldBeNil)
+			})
+		})
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	. "github.com/smartystreets/goconvey/convey"
 	"testing"
+	"time"
 )
 
 func TestExpressionParser(t *testing.T) {
@@ -116,10 +117,10 @@ func TestExpressionParser(t *testing.T) {
 		"CASE WHEN true THEN 3 END":          {[]Expression{ConditionCaseAST{[]WhenThenPairAST{{BoolLiteral{true}, NumericLiteral{3}}}, nil}}, "CASE WHEN TRUE THEN 3 END"},
 		"CASE WHEN false THEN 3 ELSE 6 END":  {[]Expression{ConditionCaseAST{[]WhenThenPairAST{{BoolLiteral{false}, NumericLiteral{3}}}, NumericLiteral{6}}}, "CASE WHEN FALSE THEN 3 ELSE 6 END"},
 		// NumericLiteral
-		"2":    {[]Expression{NumericLiteral{2}}, "2"},
-		"-2":   {[]Expression{UnaryOpAST{UnaryMinus, NumericLiteral{2}}}, "-2"},
-		"- -2": {[]Expression{UnaryOpAST{UnaryMinus, NumericLiteral{-2}}}, "- -2"}, // like PostgreSQL
-		"999999999999999999999999999": {nil, ""}, // int64 overflow
+		"2":                           {[]Expression{NumericLiteral{2}}, "2"},
+		"-2":                          {[]Expression{UnaryOpAST{UnaryMinus, NumericLiteral{2}}}, "-2"},
+		"- -2":                        {[]Expression{UnaryOpAST{UnaryMinus, NumericLiteral{-2}}}, "- -2"}, // like PostgreSQL
+		"999999999999999999999999999": {nil, ""},                                                          // int64 overflow
 		// FloatLiteral
 		"1.2":   {[]Expression{FloatLiteral{1.2}}, "1.2"},
 		"-3.14": {[]Expression{UnaryOpAST{UnaryMinus, FloatLiteral{3.14}}}, "-3.14"},
@@ -130,6 +131,58 @@ func TestExpressionParser(t *testing.T) {
 		`"Peter""s"`: {[]Expression{StringLiteral{`Peter"s`}}, `"Peter""s"`},
 		`"Peter's"`:  {[]Expression{StringLiteral{`Peter's`}}, `"Peter's"`},
 		`"日本語"`:      {[]Expression{StringLiteral{"日本語"}}, `"日本語"`},
+		// Dollar-quoted StringLiteral
+		`$$bql$$`:           {[]Expression{StringLiteral{"bql"}}, `"bql"`},
+		`$$$$`:              {[]Expression{StringLiteral{""}}, `""`},
+		`$$Peter's$$`:       {[]Expression{StringLiteral{`Peter's`}}, `"Peter's"`},
+		`$$Peter"s$$`:       {[]Expression{StringLiteral{`Peter"s`}}, `"Peter""s"`},
+		`$$日本語$$`:           {[]Expression{StringLiteral{"日本語"}}, `"日本語"`},
+		`$t$a $$ b$t$`:      {[]Expression{StringLiteral{"a $$ b"}}, `"a $$ b"`},
+		`$t$a $u$ b$t$`:     {[]Expression{StringLiteral{"a $u$ b"}}, `"a $u$ b"`},
+		`$t_1$a$T_1$b$t_1$`: {[]Expression{StringLiteral{"a$T_1$b"}}, `"a$T_1$b"`},
+		`$t${"a": ['b', "c"]}$t$`: {[]Expression{StringLiteral{`{"a": ['b', "c"]}`}},
+			`"{""a"": ['b', ""c""]}"`},
+		`$$a$$ || $$b$$`: {[]Expression{BinaryOpAST{Concat, StringLiteral{"a"}, StringLiteral{"b"}}}, `"a" || "b"`},
+		`$t$bql$u$`:      {nil, ""},
+		`$$bql`:          {nil, ""},
+		`$1$bql$1$`:      {nil, ""},
+		// IntervalLiteral
+		`INTERVAL "90 seconds"`:        {[]Expression{IntervalLiteral{90 * time.Second}}, `INTERVAL "90 seconds"`},
+		`interval "1 DAY"`:             {[]Expression{IntervalLiteral{24 * time.Hour}}, `INTERVAL "1 day"`},
+		`INTERVAL " 1.5 hours "`:       {[]Expression{IntervalLiteral{90 * time.Minute}}, `INTERVAL "90 minutes"`},
+		`INTERVAL "-2 minute"`:         {[]Expression{IntervalLiteral{-2 * time.Minute}}, `INTERVAL "-2 minutes"`},
+		`INTERVAL "1500 millisecond"`:  {[]Expression{IntervalLiteral{1500 * time.Millisecond}}, `INTERVAL "1500 milliseconds"`},
+		`INTERVAL "0.0000001 seconds"`: {[]Expression{IntervalLiteral{100 * time.Nanosecond}}, `INTERVAL "0.0000001 seconds"`},
+		`INTERVAL "1 hour" AS h`:       {[]Expression{AliasAST{IntervalLiteral{time.Hour}, "h"}}, `INTERVAL "1 hour" AS h`},
+		`INTERVAL "3 fortnights"`:      {nil, ""},
+		`INTERVAL "3"`:                 {nil, ""},
+		`INTERVAL "seconds"`:           {nil, ""},
+		// RowAST
+		"(a, 1)": {[]Expression{RowAST{ExpressionsAST{[]Expression{RowValue{"", "a"}, NumericLiteral{1}}}}}, "(a, 1)"},
+		"( a ,b , c )": {[]Expression{RowAST{ExpressionsAST{[]Expression{RowValue{"", "a"},
+			RowValue{"", "b"}, RowValue{"", "c"}}}}}, "(a, b, c)"},
+		"(a,)": {nil, ""},
+		// In
+		"a IN (1, 2)": {[]Expression{BinaryOpAST{In, RowValue{"", "a"},
+			RowAST{ExpressionsAST{[]Expression{NumericLiteral{1}, NumericLiteral{2}}}}}}, "a IN (1, 2)"},
+		"a in(1)": {[]Expression{BinaryOpAST{In, RowValue{"", "a"},
+			RowAST{ExpressionsAST{[]Expression{NumericLiteral{1}}}}}}, "a IN (1)"},
+		"a NOT IN (b)": {[]Expression{BinaryOpAST{NotIn, RowValue{"", "a"},
+			RowAST{ExpressionsAST{[]Expression{RowValue{"", "b"}}}}}}, "a NOT IN (b)"},
+		"(a, b) IN ((1, 2))": {[]Expression{BinaryOpAST{In,
+			RowAST{ExpressionsAST{[]Expression{RowValue{"", "a"}, RowValue{"", "b"}}}},
+			RowAST{ExpressionsAST{[]Expression{
+				RowAST{ExpressionsAST{[]Expression{NumericLiteral{1}, NumericLiteral{2}}}}}}}}},
+			"(a, b) IN ((1, 2))"},
+		"a + 1 IN (2) = TRUE": {[]Expression{BinaryOpAST{Equal,
+			BinaryOpAST{In, BinaryOpAST{Plus, RowValue{"", "a"}, NumericLiteral{1}},
+				RowAST{ExpressionsAST{[]Expression{NumericLiteral{2}}}}},
+			BoolLiteral{true}}}, "a + 1 IN (2) = TRUE"},
+		"a IN ()":            {nil, ""},
+		"a IN 1":             {nil, ""},
+		"a IN ((1, 2))":      {nil, ""},
+		"(a, b) IN (1, 2)":   {nil, ""},
+		"(a, b) IN ((1), 2)": {nil, ""},
 		// Alias
 		"1.2 AS x": {[]Expression{AliasAST{FloatLiteral{1.2}, "x"}}, "1.2 AS x"},
 		"b AS *":   {[]Expression{AliasAST{RowValue{"", "b"}, "*"}}, "b AS *"},
@@ -150,6 +203,9 @@ func TestExpressionParser(t *testing.T) {
 		"a <> 2": {[]Expression{BinaryOpAST{NotEqual, RowValue{"", "a"}, NumericLiteral{2}}}, "a != 2"},
 		// Other operators
 		"a || 2": {[]Expression{BinaryOpAST{Concat, RowValue{"", "a"}, NumericLiteral{2}}}, "a || 2"},
+		// Regular expression operators
+		`a ~ "^x"`:  {[]Expression{BinaryOpAST{Regex, RowValue{"", "a"}, StringLiteral{"^x"}}}, `a ~ "^x"`},
+		`a !~ "^x"`: {[]Expression{BinaryOpAST{NotRegex, RowValue{"", "a"}, StringLiteral{"^x"}}}, `a !~ "^x"`},
 		// IS Expressions
 		"a IS NULL":        {[]Expression{BinaryOpAST{Is, RowValue{"", "a"}, NullLiteral{}}}, "a IS NULL"},
 		"a IS NOT NULL":    {[]Expression{BinaryOpAST{IsNot, RowValue{"", "a"}, NullLiteral{}}}, "a IS NOT NULL"},
@@ -157,6 +213,15 @@ func TestExpressionParser(t *testing.T) {
 		"a IS MISSING":     {[]Expression{BinaryOpAST{Is, RowValue{"", "a"}, Missing{}}}, "a IS MISSING"},
 		"a IS NOT MISSING": {[]Expression{BinaryOpAST{IsNot, RowValue{"", "a"}, Missing{}}}, "a IS NOT MISSING"},
 		"a + 2 IS MISSING": {nil, ""}, // this is invalid, as opposed to IS NULL
+		"a IS DISTINCT FROM b": {[]Expression{BinaryOpAST{IsDistinctFrom, RowValue{"", "a"}, RowValue{"", "b"}}},
+			"a IS DISTINCT FROM b"},
+		"a IS NOT DISTINCT FROM NULL": {[]Expression{BinaryOpAST{IsNotDistinctFrom, RowValue{"", "a"}, NullLiteral{}}},
+			"a IS NOT DISTINCT FROM NULL"},
+		"a + 1 is not distinct from b * 2": {[]Expression{BinaryOpAST{IsNotDistinctFrom,
+			BinaryOpAST{Plus, RowValue{"", "a"}, NumericLiteral{1}},
+			BinaryOpAST{Multiply, RowValue{"", "b"}, NumericLiteral{2}}}},
+			"a + 1 IS NOT DISTINCT FROM b * 2"},
+		"a IS DISTINCT FROM b IS NULL": {nil, ""}, // IS cannot be chained
 		// Plus/Minus Terms
 		"a + 2": {[]Expression{BinaryOpAST{Plus, RowValue{"", "a"}, NumericLiteral{2}}}, "a + 2"},
 		"a - 2": {[]Expression{BinaryOpAST{Minus, RowValue{"", "a"}, NumericLiteral{2}}}, "a - 2"},
@@ -193,12 +258,24 @@ func TestExpressionParser(t *testing.T) {
 		"2 + a IS NOT NULL": {[]Expression{BinaryOpAST{IsNot,
 			BinaryOpAST{Plus, NumericLiteral{2}, RowValue{"", "a"}},
 			NullLiteral{}}}, "2 + a IS NOT NULL"},
+		"a IS DISTINCT FROM b AND c": {[]Expression{BinaryOpAST{And,
+			BinaryOpAST{IsDistinctFrom, RowValue{"", "a"}, RowValue{"", "b"}},
+			RowValue{"", "c"}}}, "a IS DISTINCT FROM b AND c"},
+		"a = b IS NOT DISTINCT FROM c": {[]Expression{BinaryOpAST{Equal,
+			RowValue{"", "a"},
+			BinaryOpAST{IsNotDistinctFrom, RowValue{"", "b"}, RowValue{"", "c"}}}},
+			"a = b IS NOT DISTINCT FROM c"},
 		"2 || a IS NULL": {[]Expression{BinaryOpAST{Concat,
 			NumericLiteral{2},
 			BinaryOpAST{Is, RowValue{"", "a"}, NullLiteral{}}}}, "2 || a IS NULL"},
 		"2 || a = 4": {[]Expression{BinaryOpAST{Equal,
 			BinaryOpAST{Concat, NumericLiteral{2}, RowValue{"", "a"}},
 			NumericLiteral{4}}}, "2 || a = 4"},
+		`a || b ~ "x" = true`: {[]Expression{BinaryOpAST{Equal,
+			BinaryOpAST{Regex,
+				BinaryOpAST{Concat, RowValue{"", "a"}, RowValue{"", "b"}},
+				StringLiteral{"x"}},
+			BoolLiteral{true}}}, `(a || b) ~ "x" = TRUE`},
 		"-2.1::INT": {[]Expression{UnaryOpAST{UnaryMinus,
 			TypeCastAST{FloatLiteral{2.1}, Int}}}, "-CAST(2.1 AS INT)"},
 		/// Left-Associativity
@@ -0,0 +1,69 @@
+package parser
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestFoldIdentifiers(t *testing.T) {
+	stmt := `SELECT RSTREAM MyCol, S:Nested.Key, A["Quoted""Key"].X AS OutCol FROM MyStream [RANGE 1 TUPLES] AS S`
+
+	Convey("Given a parser folding identifiers", t, func() {
+		p := New()
+		p.FoldIdentifiers = true
+
+		Convey("When parsing a statement having mixed-case identifiers", func() {
+			res, _, err := p.ParseStmt(stmt)
+			So(err, ShouldBeNil)
+			So(res, ShouldHaveSameTypeAs, SelectStmt{})
+			s := res.(SelectStmt)
+
+			Convey("Then unquoted columns should be lowercased", func() {
+				So(s.Projections[0], ShouldResemble, RowValue{"", "mycol"})
+				So(s.Projections[1], ShouldResemble, RowValue{"s", "nested.key"})
+			})
+
+			Convey("Then quoted parts of columns should be preserved", func() {
+				So(s.Projections[2], ShouldHaveSameTypeAs, AliasAST{})
+				a := s.Projections[2].(AliasAST)
+				So(a.Expr, ShouldResemble, RowValue{"", `a["Quoted""Key"].x`})
+				So(a.Alias, ShouldEqual, "outcol")
+			})
+
+			Convey("Then streams and their aliases should be lowercased", func() {
+				So(len(s.Relations), ShouldEqual, 1)
+				So(s.Relations[0].Name, ShouldEqual, "mystream")
+				So(s.Relations[0].Alias, ShouldEqual, "s")
+			})
+		})
+
+		Convey("When parsing a statement having a string literal", func() {
+			res, _, err := p.ParseStmt(`SELECT RSTREAM "MixedCase" AS Col FROM S [RANGE 1 TUPLES]`)
+			So(err, ShouldBeNil)
+			s := res.(SelectStmt)
+
+			Convey("Then the literal should be kept as it is", func() {
+				a := s.Projections[0].(AliasAST)
+				So(a.Expr, ShouldResemble, StringLiteral{"MixedCase"})
+				So(a.Alias, ShouldEqual, "col")
+			})
+		})
+	})
+
+	Convey("Given a parser not folding identifiers", t, func() {
+		p := New()
+
+		Convey("When parsing a statement having mixed-case identifiers", func() {
+			res, _, err := p.ParseStmt(stmt)
+			So(err, ShouldBeNil)
+			s := res.(SelectStmt)
+
+			Convey("Then identifiers should be preserved", func() {
+				So(s.Projections[0], ShouldResemble, RowValue{"", "MyCol"})
+				So(s.Projections[1], ShouldResemble, RowValue{"S", "Nested.Key"})
+				So(s.Relations[0].Name, ShouldEqual, "MyStream")
+				So(s.Relations[0].Alias, ShouldEqual, "S")
+			})
+		})
+	})
+}
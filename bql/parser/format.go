@@ -0,0 +1,211 @@
+package parser
+
+import (
+	"strings"
+)
+
+// KeywordCase is the case of keywords in a statement formatted by Format.
+type KeywordCase int
+
+const (
+	// UpperCaseKeywords writes keywords in upper case, e.g. SELECT.
+	UpperCaseKeywords KeywordCase = iota
+
+	// LowerCaseKeywords writes keywords in lower case, e.g. select.
+	LowerCaseKeywords
+)
+
+// FormatOptions has options of Format.
+type FormatOptions struct {
+	// Indent is the string used for a level of indentation. The default is
+	// two spaces.
+	Indent string
+
+	// KeywordCase is the case of keywords. The default is UpperCaseKeywords.
+	KeywordCase KeywordCase
+}
+
+// Format returns a string representation of stmt laid out for humans. Unlike
+// String, which returns a statement in a single line, Format puts each
+// clause of a SELECT statement on its own line, each projection and each
+// relation on an indented line, each operand of a top-level AND or OR chain
+// in WHERE and HAVING clauses on an indented line, and each parameter of
+// WITH and SET clauses on an indented line. A statement wrapped by EXPLAIN
+// is formatted in the same way. Statements without such parts are formatted
+// in a single line as String does.
+//
+// The result is stable, i.e. formatting a statement parsed from a formatted
+// string results in the same string, and it's parsed into a statement equal
+// to stmt.
+func Format(stmt Statement, opts FormatOptions) string {
+	f := &formatter{indent: opts.Indent}
+	if f.indent == "" {
+		f.indent = "  "
+	}
+	s := f.statement(stmt)
+	if opts.KeywordCase == LowerCaseKeywords {
+		s = lowerKeywords(s)
+	}
+	return s
+}
+
+type formatter struct {
+	indent string
+}
+
+func (f *formatter) statement(stmt Statement) string {
+	switch s := stmt.(type) {
+	case SelectStmt:
+		return f.selectStmt(s)
+	case SelectUnionStmt:
+		return f.selectUnion(s)
+	case CreateStreamAsSelectStmt:
+		return "CREATE STREAM " + string(s.Name) + " AS\n" + f.selectStmt(s.Select)
+	case CreateStreamAsSelectUnionStmt:
+		return "CREATE STREAM " + string(s.Name) + " AS\n" + f.selectUnion(s.SelectUnionStmt)
+	case CreateSourceStmt:
+		specs := s.SourceSinkSpecsAST
+		s.SourceSinkSpecsAST = SourceSinkSpecsAST{}
+		return f.withSpecs(s.String(), "WITH", specs)
+	case CreateSinkStmt:
+		specs := s.SourceSinkSpecsAST
+		s.SourceSinkSpecsAST = SourceSinkSpecsAST{}
+		return f.withSpecs(s.String(), "WITH", specs)
+	case CreateStateStmt:
+		specs := s.SourceSinkSpecsAST
+		s.SourceSinkSpecsAST = SourceSinkSpecsAST{}
+		return f.withSpecs(s.String(), "WITH", specs)
+	case UpdateStateStmt:
+		specs := s.SourceSinkSpecsAST
+		s.SourceSinkSpecsAST = SourceSinkSpecsAST{}
+		return f.withSpecs(s.String(), "SET", specs)
+	case UpdateSourceStmt:
+		specs := s.SourceSinkSpecsAST
+		s.SourceSinkSpecsAST = SourceSinkSpecsAST{}
+		return f.withSpecs(s.String(), "SET", specs)
+	case UpdateSinkStmt:
+		specs := s.SourceSinkSpecsAST
+		s.SourceSinkSpecsAST = SourceSinkSpecsAST{}
+		return f.withSpecs(s.String(), "SET", specs)
+	case ExplainStmt:
+		return "EXPLAIN " + f.statement(s.Stmt)
+	}
+	return stmt.String()
+}
+
+func (f *formatter) selectStmt(s SelectStmt) string {
+	lines := []string{"SELECT " + s.EmitterAST.string()}
+	prjs := make([]string, len(s.Projections))
+	for i, e := range s.Projections {
+		prjs[i] = e.String()
+	}
+	lines = append(lines, f.items(prjs)...)
+
+	if len(s.Relations) > 0 {
+		lines = append(lines, "FROM")
+		rels := make([]string, len(s.Relations))
+		for i, r := range s.Relations {
+			rels[i] = r.string()
+		}
+		lines = append(lines, f.items(rels)...)
+	}
+	if s.Filter != nil {
+		lines = append(lines, "WHERE "+f.condition(s.Filter))
+	}
+	if len(s.GroupList) > 0 {
+		lines = append(lines, s.GroupingAST.string())
+	}
+	if s.Having != nil {
+		lines = append(lines, "HAVING "+f.condition(s.Having))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (f *formatter) selectUnion(s SelectUnionStmt) string {
+	sels := make([]string, len(s.Selects))
+	for i, sel := range s.Selects {
+		sels[i] = f.selectStmt(sel)
+	}
+	return strings.Join(sels, "\nUNION ALL\n")
+}
+
+// withSpecs appends the parameters of a WITH or SET clause to stmt, which
+// is the string representation of the statement without the parameters.
+func (f *formatter) withSpecs(stmt, keyword string, specs SourceSinkSpecsAST) string {
+	if len(specs.Params) == 0 {
+		return stmt
+	}
+	ps := make([]string, len(specs.Params))
+	for i, p := range specs.Params {
+		ps[i] = p.string()
+	}
+	return stmt + " " + keyword + "\n" + strings.Join(f.items(ps), "\n")
+}
+
+// items indents ss and separates them with commas.
+func (f *formatter) items(ss []string) []string {
+	res := make([]string, len(ss))
+	for i, s := range ss {
+		res[i] = f.indent + s
+		if i != len(ss)-1 {
+			res[i] += ","
+		}
+	}
+	return res
+}
+
+// condition formats e putting each operand of a top-level chain of AND or
+// OR, such as `a AND b AND c`, on its own line. Only the left-associative
+// part of the chain is split so that the result is parsed into the same
+// AST.
+func (f *formatter) condition(e Expression) string {
+	b, ok := e.(BinaryOpAST)
+	if !ok || (b.Op != And && b.Op != Or) {
+		return e.String()
+	}
+	op := b.Op
+
+	var rights []Expression
+	for {
+		b, ok := e.(BinaryOpAST)
+		if !ok || b.Op != op {
+			break
+		}
+		rights = append(rights, b.Right)
+		e = b.Left
+	}
+
+	enclose := func(e Expression) string {
+		if b, ok := e.(BinaryOpAST); ok && !b.Op.hasHigherPrecedenceThan(op) {
+			return "(" + e.String() + ")"
+		}
+		return e.String()
+	}
+	str := enclose(e)
+	for i := len(rights) - 1; i >= 0; i-- {
+		str += "\n" + f.indent + op.String() + " " + enclose(rights[i])
+	}
+	return str
+}
+
+// lowerKeywords lowercases keywords in a statement. Identifiers, string
+// literals, and other tokens are kept as they are. Because BQL allows
+// identifiers spelled like keywords, only words in upper case, as written
+// by String, are considered keywords.
+func lowerKeywords(s string) string {
+	t := &Tokenizer{IncludeSpaces: true, IncludeComments: true}
+	tokens, err := t.Tokenize(s)
+	if err != nil {
+		// this doesn't happen because s was generated from a valid AST
+		return s
+	}
+	res := make([]string, len(tokens))
+	for i, tk := range tokens {
+		if tk.Kind == KeywordToken && tk.Text == strings.ToUpper(tk.Text) {
+			res[i] = strings.ToLower(tk.Text)
+		} else {
+			res[i] = tk.Text
+		}
+	}
+	return strings.Join(res, "")
+}
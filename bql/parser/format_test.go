@@ -0,0 +1,111 @@
+package parser
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestFormat(t *testing.T) {
+	Convey("Given a parser", t, func() {
+		p := New()
+		parse := func(s string) Statement {
+			stmt, _, err := p.ParseStmt(s)
+			So(err, ShouldBeNil)
+			return stmt.(Statement)
+		}
+
+		Convey("When formatting a multi-clause SELECT statement", func() {
+			stmt := parse(`select rstream  a, b+1 as c,count(*)  from s [range 2 seconds] as x, t [range 1 tuples]
+				where a > 1 and (b < 2 or c = 3) and x:d = "and" group by a, b having count(*)>1`)
+			s := Format(stmt, FormatOptions{})
+
+			Convey("Then each clause should be laid out on its own lines", func() {
+				So(s, ShouldEqual, `SELECT RSTREAM
+  a,
+  b + 1 AS c,
+  count(*)
+FROM
+  s [RANGE 2 SECONDS] AS x,
+  t [RANGE 1 TUPLES]
+WHERE a > 1
+  AND (b < 2 OR c = 3)
+  AND x:d = "and"
+GROUP BY a, b
+HAVING count(*) > 1`)
+			})
+
+			Convey("Then it should be parsed into the same statement", func() {
+				So(parse(s), ShouldResemble, stmt)
+			})
+
+			Convey("Then formatting it again should result in the same string", func() {
+				So(Format(parse(s), FormatOptions{}), ShouldEqual, s)
+			})
+		})
+
+		Convey("When formatting a statement with options", func() {
+			stmt := parse(`CREATE STREAM out AS SELECT ISTREAM * FROM s [RANGE 1 TUPLES] WHERE a OR b
+				UNION ALL SELECT ISTREAM * FROM t [RANGE 1 TUPLES]`)
+			s := Format(stmt, FormatOptions{Indent: "    ", KeywordCase: LowerCaseKeywords})
+
+			Convey("Then the options should be applied", func() {
+				So(s, ShouldEqual, `create stream out as
+select istream
+    *
+from
+    s [range 1 tuples]
+where a
+    or b
+union all
+select istream
+    *
+from
+    t [range 1 tuples]`)
+			})
+
+			Convey("Then it should be parsed into the same statement", func() {
+				So(parse(s), ShouldResemble, stmt)
+			})
+		})
+
+		Convey("When formatting a statement having parameters", func() {
+			stmt := parse(`CREATE PAUSED SOURCE src TYPE fluentd WITH bind = "0.0.0.0:24224", tag_field="tag"`)
+			s := Format(stmt, FormatOptions{})
+
+			Convey("Then each parameter should be on its own line", func() {
+				So(s, ShouldEqual, `CREATE PAUSED SOURCE src TYPE fluentd WITH
+  bind="0.0.0.0:24224",
+  tag_field="tag"`)
+				So(parse(s), ShouldResemble, stmt)
+			})
+		})
+
+		Convey("When formatting an EXPLAIN statement", func() {
+			stmt := parse(`explain select istream a, b from s [range 1 tuples] where a and b`)
+			s := Format(stmt, FormatOptions{})
+
+			Convey("Then the wrapped statement should be formatted", func() {
+				So(s, ShouldEqual, `EXPLAIN SELECT ISTREAM
+  a,
+  b
+FROM
+  s [RANGE 1 TUPLES]
+WHERE a
+  AND b`)
+				So(parse(s), ShouldResemble, stmt)
+			})
+
+			Convey("Then EXPLAIN should be written in lower case with the option", func() {
+				So(Format(stmt, FormatOptions{KeywordCase: LowerCaseKeywords}), ShouldStartWith, "explain select istream\n")
+			})
+		})
+
+		Convey("When formatting a statement without clauses", func() {
+			stmt := parse(`drop  source src`)
+
+			Convey("Then it should be formatted in a single line", func() {
+				So(Format(stmt, FormatOptions{}), ShouldEqual, "DROP SOURCE src")
+			})
+		})
+	})
+}
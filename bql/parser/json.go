@@ -0,0 +1,488 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// ASTToJSON serializes a parsed statement to JSON so that it can be used by
+// tools written in other languages.
+//
+// A node held by an Expression, a Statement, or an emitter option is a JSON
+// object having a "type" field with the name of the node type without the
+// "AST" suffix, such as "BinaryOp" for BinaryOpAST, and a field for each
+// field of the node. Fields of embedded structs are written as fields of the
+// node itself. Enumerations such as Operator are written as their string
+// representation, e.g. "op" of `a + 1` is "+", except for UnaryMinus which is
+// written as "UNARY MINUS" to tell it from Minus. A data.Value is written as
+// an object having "type" and "value" fields, e.g. {"type":"int","value":1}.
+//
+// The statement can be restored by ASTFromJSON.
+func ASTToJSON(stmt Statement) ([]byte, error) {
+	if stmt == nil {
+		return nil, fmt.Errorf("the statement is nil")
+	}
+	if !astStatementTypes[reflect.TypeOf(stmt)] {
+		return nil, fmt.Errorf("unsupported statement type: %T", stmt)
+	}
+	v := reflect.ValueOf(&stmt).Elem()
+	js, err := encodeASTNode(v)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(js)
+}
+
+// ASTToMap converts a parsed statement to a data.Map having the same
+// structure as the JSON object written by ASTToJSON.
+func ASTToMap(stmt Statement) (data.Map, error) {
+	js, err := ASTToJSON(stmt)
+	if err != nil {
+		return nil, err
+	}
+	v, err := data.NewJSONDecoder(bytes.NewReader(js), nil).Decode()
+	if err != nil {
+		return nil, err
+	}
+	return data.AsMap(v)
+}
+
+// Plan returns the wrapped statement converted to a data.Map by ASTToMap.
+func (s ExplainStmt) Plan() (data.Map, error) {
+	return ASTToMap(s.Stmt)
+}
+
+// ASTFromJSON restores a statement serialized by ASTToJSON.
+func ASTFromJSON(b []byte) (Statement, error) {
+	var stmt Statement
+	v, err := decodeASTNode(json.RawMessage(b), reflect.TypeOf(&stmt).Elem())
+	if err != nil {
+		return nil, err
+	}
+	if v.IsNil() {
+		return nil, fmt.Errorf("the statement is null")
+	}
+	stmt = v.Interface().(Statement)
+	if !astStatementTypes[reflect.TypeOf(stmt)] {
+		return nil, fmt.Errorf("%T is not a statement", stmt)
+	}
+	return stmt, nil
+}
+
+var (
+	astNodeTypes      = map[string]reflect.Type{}
+	astNodeNames      = map[reflect.Type]string{}
+	astStatementTypes = map[reflect.Type]bool{}
+	astEnumNames      = map[reflect.Type][]string{}
+
+	dataValueType = reflect.TypeOf((*data.Value)(nil)).Elem()
+)
+
+func init() {
+	stmts := []Statement{
+		SelectStmt{}, SelectUnionStmt{}, CreateStreamAsSelectStmt{},
+		CreateStreamAsSelectUnionStmt{}, CreateSourceStmt{}, CreateSinkStmt{},
+		CreateStateStmt{}, UpdateStateStmt{}, UpdateSourceStmt{},
+		UpdateSinkStmt{}, InsertIntoFromStmt{}, PauseSourceStmt{},
+		ResumeSourceStmt{}, RewindSourceStmt{}, DropSourceStmt{},
+		DropStreamStmt{}, DropSinkStmt{}, DropStateStmt{}, LoadStateStmt{},
+		LoadStateOrCreateStmt{}, SaveStateStmt{}, EvalStmt{}, ExplainStmt{},
+	}
+	for _, s := range stmts {
+		t := reflect.TypeOf(s)
+		registerASTNode(t)
+		astStatementTypes[t] = true
+	}
+
+	nodes := []interface{}{
+		// expressions
+		AliasAST{}, BinaryOpAST{}, UnaryOpAST{}, TypeCastAST{}, FuncAppAST{},
+		FuncAppSelectorAST{}, SortedExpressionAST{}, ArrayAST{}, RowAST{},
+		MapAST{}, Wildcard{}, RowValue{}, ConditionCaseAST{},
+		ExpressionCaseAST{}, RowMeta{}, NumericLiteral{}, FloatLiteral{},
+		NullLiteral{}, Missing{}, BoolLiteral{}, StringLiteral{}, ParamAST{},
+		IntervalLiteral{},
+
+		// emitter options
+		EmitterLimit{}, EmitterSampling{},
+	}
+	for _, n := range nodes {
+		registerASTNode(reflect.TypeOf(n))
+	}
+
+	registerASTEnum(Emitter(0), int(Rstream))
+	registerASTEnum(EmitterSamplingType(0), int(TimeBasedSampling))
+	registerASTEnum(StreamType(0), int(UDSFStream))
+	registerASTEnum(IntervalUnit(0), int(Milliseconds))
+	registerASTEnum(MetaInformation(0), int(NowMeta))
+	registerASTEnum(BinaryKeyword(0), int(No))
+	registerASTEnum(SheddingOption(0), int(DropNewest))
+	registerASTEnum(Type(0), int(Map))
+	registerASTEnum(Operator(0), int(UnaryMinus))
+	// Minus and UnaryMinus have the same string representation
+	astEnumNames[reflect.TypeOf(UnaryMinus)][UnaryMinus] = "UNARY MINUS"
+}
+
+func registerASTNode(t reflect.Type) {
+	name := strings.TrimSuffix(t.Name(), "AST")
+	astNodeTypes[name] = t
+	astNodeNames[t] = name
+}
+
+// registerASTEnum registers the string representations of the values of
+// the enumeration type of zero from 0 to max.
+func registerASTEnum(zero fmt.Stringer, max int) {
+	t := reflect.TypeOf(zero)
+	names := make([]string, max+1)
+	for i := range names {
+		v := reflect.New(t).Elem()
+		v.SetInt(int64(i))
+		names[i] = v.Interface().(fmt.Stringer).String()
+	}
+	astEnumNames[t] = names
+}
+
+// encodeASTNode converts v to a value which can be serialized by
+// json.Marshal.
+func encodeASTNode(v reflect.Value) (interface{}, error) {
+	t := v.Type()
+	if t == dataValueType {
+		if v.IsNil() {
+			return nil, nil
+		}
+		return encodeDataValue(v.Interface().(data.Value))
+	}
+	if names, ok := astEnumNames[t]; ok {
+		i := v.Int()
+		if i < 0 || i >= int64(len(names)) {
+			return nil, fmt.Errorf("invalid %v: %v", t.Name(), i)
+		}
+		return names[i], nil
+	}
+
+	switch t.Kind() {
+	case reflect.Interface:
+		if v.IsNil() {
+			return nil, nil
+		}
+		e := v.Elem()
+		name, ok := astNodeNames[e.Type()]
+		if !ok {
+			return nil, fmt.Errorf("unsupported node type: %v", e.Type())
+		}
+		m, err := encodeASTStruct(e)
+		if err != nil {
+			return nil, err
+		}
+		m["type"] = name
+		return m, nil
+
+	case reflect.Struct:
+		return encodeASTStruct(v)
+
+	case reflect.Ptr:
+		if v.IsNil() {
+			return nil, nil
+		}
+		return encodeASTNode(v.Elem())
+
+	case reflect.Slice:
+		if v.IsNil() {
+			return nil, nil
+		}
+		a := make([]interface{}, v.Len())
+		for i := range a {
+			e, err := encodeASTNode(v.Index(i))
+			if err != nil {
+				return nil, err
+			}
+			a[i] = e
+		}
+		return a, nil
+
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int(), nil
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), nil
+	case reflect.Bool:
+		return v.Bool(), nil
+	}
+	return nil, fmt.Errorf("unsupported field type: %v", t)
+}
+
+func encodeASTStruct(v reflect.Value) (map[string]interface{}, error) {
+	t := v.Type()
+	m := map[string]interface{}{}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.Anonymous && f.Type.Kind() == reflect.Struct {
+			sub, err := encodeASTStruct(v.Field(i))
+			if err != nil {
+				return nil, err
+			}
+			for k, e := range sub {
+				m[k] = e
+			}
+			continue
+		}
+		e, err := encodeASTNode(v.Field(i))
+		if err != nil {
+			return nil, fmt.Errorf("%v.%v: %v", t.Name(), f.Name, err)
+		}
+		m[f.Name] = e
+	}
+	return m, nil
+}
+
+func encodeDataValue(v data.Value) (interface{}, error) {
+	var val interface{}
+	switch v.Type() {
+	case data.TypeNull:
+		return map[string]interface{}{"type": v.Type().String()}, nil
+	case data.TypeArray:
+		a, _ := data.AsArray(v)
+		vs := make([]interface{}, len(a))
+		for i, e := range a {
+			ev, err := encodeDataValue(e)
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = ev
+		}
+		val = vs
+	case data.TypeMap:
+		m, _ := data.AsMap(v)
+		vs := make(map[string]interface{}, len(m))
+		for k, e := range m {
+			ev, err := encodeDataValue(e)
+			if err != nil {
+				return nil, err
+			}
+			vs[k] = ev
+		}
+		val = vs
+	case data.TypeTimestamp:
+		ts, _ := data.AsTimestamp(v)
+		val = ts.Format(time.RFC3339Nano)
+	case data.TypeBlob:
+		// []byte is encoded in base64 by encoding/json
+		val, _ = data.AsBlob(v)
+	default:
+		val = v
+	}
+	return map[string]interface{}{
+		"type":  v.Type().String(),
+		"value": val,
+	}, nil
+}
+
+// decodeASTNode creates a value of the type t from js.
+func decodeASTNode(js json.RawMessage, t reflect.Type) (reflect.Value, error) {
+	v := reflect.New(t).Elem()
+	if len(js) == 0 || string(js) == "null" {
+		return v, nil
+	}
+	if t == dataValueType {
+		dv, err := decodeDataValue(js)
+		if err != nil {
+			return v, err
+		}
+		v.Set(reflect.ValueOf(&dv).Elem())
+		return v, nil
+	}
+	if names, ok := astEnumNames[t]; ok {
+		var s string
+		if err := json.Unmarshal(js, &s); err != nil {
+			return v, fmt.Errorf("invalid %v: %s", t.Name(), js)
+		}
+		for i, n := range names {
+			if n == s {
+				v.SetInt(int64(i))
+				return v, nil
+			}
+		}
+		return v, fmt.Errorf("unknown %v: %v", t.Name(), s)
+	}
+
+	switch t.Kind() {
+	case reflect.Interface:
+		var m map[string]json.RawMessage
+		if err := json.Unmarshal(js, &m); err != nil {
+			return v, fmt.Errorf("a node must be an object: %v", err)
+		}
+		var name string
+		if err := json.Unmarshal(m["type"], &name); err != nil || name == "" {
+			return v, fmt.Errorf("a node must have the type")
+		}
+		nt, ok := astNodeTypes[name]
+		if !ok {
+			return v, fmt.Errorf("unknown node type: %v", name)
+		}
+		if !nt.Implements(t) {
+			return v, fmt.Errorf("%v cannot be used as %v", name, t)
+		}
+		e := reflect.New(nt).Elem()
+		if err := decodeASTStruct(m, e); err != nil {
+			return v, err
+		}
+		v.Set(e)
+
+	case reflect.Struct:
+		var m map[string]json.RawMessage
+		if err := json.Unmarshal(js, &m); err != nil {
+			return v, fmt.Errorf("%v must be an object: %v", t.Name(), err)
+		}
+		if err := decodeASTStruct(m, v); err != nil {
+			return v, err
+		}
+
+	case reflect.Ptr:
+		e, err := decodeASTNode(js, t.Elem())
+		if err != nil {
+			return v, err
+		}
+		p := reflect.New(t.Elem())
+		p.Elem().Set(e)
+		v.Set(p)
+
+	case reflect.Slice:
+		var a []json.RawMessage
+		if err := json.Unmarshal(js, &a); err != nil {
+			return v, fmt.Errorf("%v must be an array: %v", t, err)
+		}
+		s := reflect.MakeSlice(t, len(a), len(a))
+		for i, e := range a {
+			ev, err := decodeASTNode(e, t.Elem())
+			if err != nil {
+				return v, err
+			}
+			s.Index(i).Set(ev)
+		}
+		v.Set(s)
+
+	case reflect.String, reflect.Bool, reflect.Float32, reflect.Float64,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if err := json.Unmarshal(js, v.Addr().Interface()); err != nil {
+			return v, err
+		}
+
+	default:
+		return v, fmt.Errorf("unsupported field type: %v", t)
+	}
+	return v, nil
+}
+
+func decodeASTStruct(m map[string]json.RawMessage, v reflect.Value) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.Anonymous && f.Type.Kind() == reflect.Struct {
+			if err := decodeASTStruct(m, v.Field(i)); err != nil {
+				return err
+			}
+			continue
+		}
+		js, ok := m[f.Name]
+		if !ok {
+			continue
+		}
+		e, err := decodeASTNode(js, f.Type)
+		if err != nil {
+			return fmt.Errorf("%v.%v: %v", t.Name(), f.Name, err)
+		}
+		v.Field(i).Set(e)
+	}
+	return nil
+}
+
+func decodeDataValue(js json.RawMessage) (data.Value, error) {
+	var tv struct {
+		Type  string          `json:"type"`
+		Value json.RawMessage `json:"value"`
+	}
+	if err := json.Unmarshal(js, &tv); err != nil {
+		return nil, fmt.Errorf("a value must be an object: %v", err)
+	}
+
+	switch tv.Type {
+	case "null":
+		return data.Null{}, nil
+	case "bool":
+		var b bool
+		if err := json.Unmarshal(tv.Value, &b); err != nil {
+			return nil, err
+		}
+		return data.Bool(b), nil
+	case "int":
+		var i int64
+		if err := json.Unmarshal(tv.Value, &i); err != nil {
+			return nil, err
+		}
+		return data.Int(i), nil
+	case "float":
+		var f float64
+		if err := json.Unmarshal(tv.Value, &f); err != nil {
+			return nil, err
+		}
+		return data.Float(f), nil
+	case "string":
+		var s string
+		if err := json.Unmarshal(tv.Value, &s); err != nil {
+			return nil, err
+		}
+		return data.String(s), nil
+	case "blob":
+		var b []byte
+		if err := json.Unmarshal(tv.Value, &b); err != nil {
+			return nil, err
+		}
+		return data.Blob(b), nil
+	case "timestamp":
+		var s string
+		if err := json.Unmarshal(tv.Value, &s); err != nil {
+			return nil, err
+		}
+		ts, err := time.Parse(time.RFC3339Nano, s)
+		if err != nil {
+			return nil, err
+		}
+		return data.Timestamp(ts), nil
+	case "array":
+		var a []json.RawMessage
+		if err := json.Unmarshal(tv.Value, &a); err != nil {
+			return nil, err
+		}
+		arr := make(data.Array, len(a))
+		for i, e := range a {
+			ev, err := decodeDataValue(e)
+			if err != nil {
+				return nil, err
+			}
+			arr[i] = ev
+		}
+		return arr, nil
+	case "map":
+		var m map[string]json.RawMessage
+		if err := json.Unmarshal(tv.Value, &m); err != nil {
+			return nil, err
+		}
+		dm := make(data.Map, len(m))
+		for k, e := range m {
+			ev, err := decodeDataValue(e)
+			if err != nil {
+				return nil, err
+			}
+			dm[k] = ev
+		}
+		return dm, nil
+	}
+	return nil, fmt.Errorf("unknown value type: %v", tv.Type)
+}
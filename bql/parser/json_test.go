@@ -0,0 +1,116 @@
+package parser
+
+import (
+	"encoding/json"
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestASTJSON(t *testing.T) {
+	stmts := []string{
+		`SELECT RSTREAM [EVERY 2-ND TUPLE LIMIT 3] s:a + 2 * -f(b, c)::string AS x, ` +
+			`CASE WHEN a < 1.5 THEN [1, "x", *] ELSE {"k": s:ts()} END, ` +
+			`count(a ORDER BY b DESC), g(a).f[0], :p, ? FROM ` +
+			`s [RANGE 5 SECONDS, BUFFER SIZE 7, DROP OLDEST IF FULL], ` +
+			`udsf("x", 1) [RANGE 2 TUPLES] AS u ` +
+			`WHERE NOT a IS NULL AND b IS NOT MISSING GROUP BY s:a, u:b HAVING count(*) > 1`,
+		`CREATE STREAM t AS SELECT ISTREAM a FROM s [RANGE 1 TUPLES] ` +
+			`UNION ALL SELECT ISTREAM a FROM u [RANGE 1 TUPLES]`,
+		`CREATE PAUSED SOURCE src TYPE dummy WITH i=1, f=2.5, s="str", ` +
+			`b=true, d=INTERVAL "2 seconds", a=[1, [2.0]], m={"k": {"l": "v"}}`,
+		`LOAD STATE st TYPE t TAG tg SET a=1 OR CREATE IF NOT SAVED WITH b=2`,
+		`EVAL a || "x" ON {"a": "y"}`,
+		`DROP STREAM s`,
+		`EXPLAIN SELECT RSTREAM a FROM s [RANGE 1 TUPLES]`,
+	}
+
+	for _, s := range stmts {
+		s := s
+
+		Convey("Given the statement "+s, t, func() {
+			p := New()
+			res, _, err := p.ParseStmt(s)
+			So(err, ShouldBeNil)
+			stmt := res.(Statement)
+
+			Convey("When serializing it to JSON", func() {
+				js, err := ASTToJSON(stmt)
+				So(err, ShouldBeNil)
+
+				Convey("Then it should be restored to an equal statement", func() {
+					restored, err := ASTFromJSON(js)
+					So(err, ShouldBeNil)
+					So(restored, ShouldResemble, stmt)
+					So(restored.String(), ShouldEqual, stmt.String())
+				})
+			})
+		})
+	}
+
+	Convey("Given a binary operation", t, func() {
+		stmt := EvalStmt{
+			Expr: BinaryOpAST{Plus, RowValue{"", "a"}, NumericLiteral{1}},
+		}
+
+		Convey("When serializing it to JSON", func() {
+			js, err := ASTToJSON(stmt)
+			So(err, ShouldBeNil)
+
+			Convey("Then nodes should have their types", func() {
+				var m map[string]interface{}
+				So(json.Unmarshal(js, &m), ShouldBeNil)
+				So(m, ShouldResemble, map[string]interface{}{
+					"type": "EvalStmt",
+					"Expr": map[string]interface{}{
+						"type": "BinaryOp",
+						"Op":   "+",
+						"Left": map[string]interface{}{
+							"type":     "RowValue",
+							"Relation": "",
+							"Column":   "a",
+						},
+						"Right": map[string]interface{}{
+							"type":  "NumericLiteral",
+							"Value": float64(1),
+						},
+					},
+					"Input": nil,
+				})
+			})
+		})
+	})
+
+	Convey("Given a unary minus and a binary minus", t, func() {
+		stmt := EvalStmt{
+			Expr: BinaryOpAST{Minus, UnaryOpAST{UnaryMinus, RowValue{"", "a"}}, NumericLiteral{1}},
+		}
+
+		Convey("Then they should be distinguished after the round trip", func() {
+			js, err := ASTToJSON(stmt)
+			So(err, ShouldBeNil)
+			restored, err := ASTFromJSON(js)
+			So(err, ShouldBeNil)
+			So(restored, ShouldResemble, stmt)
+		})
+	})
+
+	Convey("Given invalid JSON", t, func() {
+		cases := map[string]string{
+			"an unknown node type":       `{"type":"Foo"}`,
+			"an expression as statement": `{"type":"RowValue","Column":"a"}`,
+			"a statement as expression":  `{"type":"EvalStmt","Expr":{"type":"DropStreamStmt"}}`,
+			"an unknown operator":        `{"type":"EvalStmt","Expr":{"type":"UnaryOp","Op":"?"}}`,
+			"a node without the type":    `{"Column":"a"}`,
+			"null":                       `null`,
+			"broken JSON":                `{"type":`,
+		}
+		for name, js := range cases {
+			name, js := name, js
+
+			Convey("Then ASTFromJSON should fail with "+name, func() {
+				_, err := ASTFromJSON([]byte(js))
+				So(err, ShouldNotBeNil)
+			})
+		}
+	})
+}
@@ -0,0 +1,195 @@
+package parser
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+const (
+	// DefaultMaxStatementBytes is the maximum length of a statement used
+	// when bqlParser.MaxStatementBytes is 0.
+	DefaultMaxStatementBytes = 1 << 20
+
+	// DefaultMaxExpressionDepth is the maximum nesting level of expressions
+	// used when bqlParser.MaxExpressionDepth is 0. Since each operator of a
+	// chain such as "a OR b OR c" adds a level to the AST, a chain of more
+	// than 256 terms exceeds this limit even without brackets.
+	DefaultMaxExpressionDepth = 256
+)
+
+// expressionDepthError is returned when a statement is nested more deeply
+// than the limit, both by the check before parsing and by the one done while
+// assembling the AST.
+type expressionDepthError struct {
+	maxDepth int
+}
+
+func (e *expressionDepthError) Error() string {
+	return fmt.Sprintf("the expression is nested too deeply (the maximum depth is %v)", e.maxDepth)
+}
+
+// effectiveLimit returns the limit to be used for the configured value v.
+// 0 means the default and a negative value means no limit, for which -1 is
+// returned.
+func effectiveLimit(v, def int) int {
+	if v == 0 {
+		return def
+	}
+	if v < 0 {
+		return -1
+	}
+	return v
+}
+
+// checkStatementLimits scans the first statement in s with the tokenizer
+// and returns an error when it's longer than maxBytes or when brackets are
+// nested more deeply than maxDepth. Because the generated parser recurses
+// for every bracket, this has to be done before parsing. Only the first
+// maxBytes+1 bytes of s are decoded, and the scan stops at the first
+// semicolon or at the first limit exceeded, so its cost doesn't depend on
+// the rest of s. Errors of the tokenizer are left to the parser, which
+// reports them with a better message.
+func checkStatementLimits(s string, maxBytes, maxDepth int) error {
+	if maxBytes < 0 && maxDepth < 0 {
+		return nil
+	}
+	lengthErr := fmt.Errorf("the statement is longer than the maximum length (%v bytes)", maxBytes)
+
+	// A token ending before the end of buf is the same as the one in s since
+	// the tokenizer only looks a few runes ahead. A token reaching the end of
+	// buf is longer than maxBytes in s if buf is truncated.
+	buf, truncated := statementPrefix(s, maxBytes)
+	bytes, depth := 0, 0
+	for pos := 0; pos < len(buf); {
+		kind, end, err := nextToken(buf, pos)
+		if err != nil {
+			if truncated && literalReachesEnd(buf, pos) {
+				return lengthErr
+			}
+			return nil
+		}
+		for _, r := range buf[pos:end] {
+			bytes += utf8.RuneLen(r)
+		}
+		if maxBytes >= 0 && bytes > maxBytes {
+			return lengthErr
+		}
+		if kind == PunctuationToken {
+			switch buf[pos] {
+			case '(', '[', '{':
+				depth++
+				if maxDepth >= 0 && depth > maxDepth {
+					return &expressionDepthError{maxDepth}
+				}
+			case ')', ']', '}':
+				if depth > 0 {
+					depth--
+				}
+			case ';':
+				return nil
+			}
+		}
+		pos = end
+	}
+	return nil
+}
+
+// statementPrefix decodes the first maxBytes+1 bytes of s, extended to the
+// end of the rune containing the last byte, or the whole s when maxBytes is
+// negative. It also returns true when the result doesn't cover the whole s.
+func statementPrefix(s string, maxBytes int) ([]rune, bool) {
+	n := maxBytes + 1
+	if maxBytes < 0 || n >= len(s) {
+		return []rune(s), false
+	}
+	for n < len(s) && !utf8.RuneStart(s[n]) {
+		n++
+	}
+	return []rune(s[:n]), n < len(s)
+}
+
+// literalReachesEnd returns true when nextToken failed because the string
+// literal starting at buf[pos] isn't terminated before the end of buf.
+func literalReachesEnd(buf []rune, pos int) bool {
+	switch buf[pos] {
+	case '"':
+		return true
+	case '$':
+		tagEnd := pos + 1
+		for tagEnd < len(buf) && isBQLIdentRune(buf[tagEnd]) {
+			tagEnd++
+		}
+		return tagEnd == len(buf) || (buf[tagEnd] == '$' && !isBQLDigit(buf[pos+1]))
+	}
+	return false
+}
+
+// exceedsExpressionDepth returns true when e is nested more deeply than
+// maxDepth. It only visits the first maxDepth+1 levels of e.
+func exceedsExpressionDepth(e Expression, maxDepth int) bool {
+	if maxDepth < 0 {
+		return false
+	}
+	if maxDepth == 0 {
+		return true
+	}
+	sub := func(es ...Expression) bool {
+		for _, e := range es {
+			if e != nil && exceedsExpressionDepth(e, maxDepth-1) {
+				return true
+			}
+		}
+		return false
+	}
+	funcApp := func(fa FuncAppAST) bool {
+		if sub(fa.Expressions...) {
+			return true
+		}
+		for _, o := range fa.Ordering {
+			if sub(o.Expr) {
+				return true
+			}
+		}
+		return false
+	}
+	conditionCase := func(c ConditionCaseAST) bool {
+		for _, pair := range c.Checks {
+			if sub(pair.When, pair.Then) {
+				return true
+			}
+		}
+		return sub(c.Else)
+	}
+
+	switch obj := e.(type) {
+	case BinaryOpAST:
+		return sub(obj.Left, obj.Right)
+	case AliasAST:
+		return sub(obj.Expr)
+	case UnaryOpAST:
+		return sub(obj.Expr)
+	case TypeCastAST:
+		return sub(obj.Expr)
+	case FuncAppAST:
+		return funcApp(obj)
+	case FuncAppSelectorAST:
+		return funcApp(obj.FuncAppAST)
+	case SortedExpressionAST:
+		return sub(obj.Expr)
+	case ArrayAST:
+		return sub(obj.Expressions...)
+	case RowAST:
+		return sub(obj.Expressions...)
+	case MapAST:
+		for _, pair := range obj.Entries {
+			if sub(pair.Value) {
+				return true
+			}
+		}
+	case ConditionCaseAST:
+		return conditionCase(obj)
+	case ExpressionCaseAST:
+		return sub(obj.Expr) || conditionCase(obj.ConditionCaseAST)
+	}
+	return false
+}
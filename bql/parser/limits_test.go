@@ -0,0 +1,163 @@
+package parser
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"strings"
+	"testing"
+)
+
+func TestParserLimits(t *testing.T) {
+	nested := func(n int) string {
+		return "EVAL " + strings.Repeat("(", n) + "1" + strings.Repeat(")", n)
+	}
+
+	Convey("Given a parser with the default limits", t, func() {
+		p := New()
+
+		Convey("When parsing a statement longer than the limit", func() {
+			stmt := `EVAL "` + strings.Repeat("a", DefaultMaxStatementBytes) + `"`
+			_, _, err := p.ParseStmt(stmt)
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "longer than the maximum length")
+			})
+		})
+
+		Convey("When parsing a million nested parentheses", func() {
+			_, _, err := p.ParseStmt(nested(1000000))
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "nested too deeply")
+			})
+		})
+
+		Convey("When parsing a long chain of binary operations", func() {
+			_, _, err := p.ParseStmt("EVAL 1" + strings.Repeat("+1", DefaultMaxExpressionDepth))
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "nested too deeply")
+
+				Convey("And the error should be the same as the one of nested brackets", func() {
+					_, _, bracketErr := p.ParseStmt(nested(DefaultMaxExpressionDepth + 1))
+					So(bracketErr, ShouldNotBeNil)
+					So(err, ShouldResemble, bracketErr)
+				})
+			})
+		})
+
+		Convey("When parsing a chain of binary operations within the limit", func() {
+			_, _, err := p.ParseStmt("EVAL a" + strings.Repeat(" OR a", DefaultMaxExpressionDepth-1))
+
+			Convey("Then it should succeed", func() {
+				So(err, ShouldBeNil)
+			})
+		})
+
+		Convey("When parsing a statement within the limits", func() {
+			res, _, err := p.ParseStmt(nested(DefaultMaxExpressionDepth - 1))
+
+			Convey("Then it should succeed", func() {
+				So(err, ShouldBeNil)
+				So(res, ShouldResemble, EvalStmt{Expr: NumericLiteral{1}})
+			})
+		})
+
+		Convey("When parsing multiple statements shorter than the limit", func() {
+			stmt := `EVAL "` + strings.Repeat("a", DefaultMaxStatementBytes/2) + `";`
+			res, err := p.ParseStmts(strings.Repeat(stmt, 3))
+
+			Convey("Then the limit should apply to each statement", func() {
+				So(err, ShouldBeNil)
+				So(len(res), ShouldEqual, 3)
+			})
+		})
+	})
+
+	Convey("Given a parser with custom limits", t, func() {
+		p := New()
+		p.MaxStatementBytes = 20
+		p.MaxExpressionDepth = 3
+
+		Convey("When parsing statements exceeding them", func() {
+			_, _, err1 := p.ParseStmt("EVAL 1 + 2 + 3 + 4 + 5 + 6")
+			_, _, err2 := p.ParseStmt(nested(4))
+			_, _, err3 := p.ParseStmt("EVAL 1+2+3+4")
+
+			Convey("Then they should fail", func() {
+				So(err1, ShouldNotBeNil)
+				So(err1.Error(), ShouldContainSubstring, "(20 bytes)")
+				So(err2, ShouldNotBeNil)
+				So(err2.Error(), ShouldContainSubstring, "the maximum depth is 3")
+				So(err3, ShouldNotBeNil)
+				So(err3.Error(), ShouldEqual, "the expression is nested too deeply (the maximum depth is 3)")
+				So(err3, ShouldResemble, err2)
+			})
+		})
+
+		Convey("When parsing a statement within them", func() {
+			_, _, err := p.ParseStmt("EVAL [1+2]")
+
+			Convey("Then it should succeed", func() {
+				So(err, ShouldBeNil)
+			})
+		})
+	})
+
+	Convey("Given a parser without limits", t, func() {
+		p := New()
+		p.MaxStatementBytes = -1
+		p.MaxExpressionDepth = -1
+
+		Convey("When parsing a deeply nested statement", func() {
+			res, _, err := p.ParseStmt(nested(DefaultMaxExpressionDepth + 1))
+
+			Convey("Then it should succeed", func() {
+				So(err, ShouldBeNil)
+				So(res, ShouldResemble, EvalStmt{Expr: NumericLiteral{1}})
+			})
+		})
+	})
+}
+
+func TestCheckStatementLimits(t *testing.T) {
+	Convey("Given an input much longer than the length limit", t, func() {
+		rest := strings.Repeat("x", 1000)
+
+		cases := []struct {
+			title string
+			stmt  string
+			ok    bool
+		}{
+			{"a short first statement", "EVAL 1;", true},
+			{"a first statement as long as the limit", "EVAL 12345678901234;", true},
+			{"a first statement longer than the limit", "EVAL 123456789012345;", false},
+			{"a number continuing beyond the limit", "EVAL 12345678901234.5;", false},
+			{"a string literal continuing beyond the limit", `EVAL "` + strings.Repeat("a", 100) + `";`, false},
+			{"a dollar-quoted string continuing beyond the limit", "EVAL $t$" + strings.Repeat("a", 100) + "$t$;", false},
+			{"a multibyte rune across the limit", `EVAL "日本語日本語日本語"`, false},
+			{"an invalid character in the first statement", "EVAL @;", true},
+			{"no semicolon", "EVAL 1 ", false},
+		}
+
+		for _, c := range cases {
+			c := c
+			Convey("When checking "+c.title, func() {
+				err := checkStatementLimits(c.stmt+rest, 20, -1)
+
+				if c.ok {
+					Convey("Then it should pass", func() {
+						So(err, ShouldBeNil)
+					})
+				} else {
+					Convey("Then it should fail", func() {
+						So(err, ShouldNotBeNil)
+						So(err.Error(), ShouldContainSubstring, "(20 bytes)")
+					})
+				}
+			})
+		}
+	})
+}
@@ -0,0 +1,68 @@
+package parser
+
+import (
+	"sort"
+)
+
+// Normalize returns a canonical string representation of the given
+// statement, so that statements which only differ in whitespace, the case
+// of keywords, the formatting of literals, or the order of operands of
+// commutative operators have the same representation. It's meant to be used
+// as a key of a cache, e.g., of execution plans.
+//
+// Operands of a chain of AND (or OR) operators, such as `c AND a AND b`,
+// are sorted by their representation and both operands of = and != are
+// sorted in the same way. Operands of other operators, including
+// mathematically commutative ones like + and *, are never reordered
+// because changing the order of evaluation can change the result, e.g., of
+// floating point arithmetic or string concatenation.
+func Normalize(stmt Statement) string {
+	return rewriteStmt(stmt, normalizeExpr).String()
+}
+
+// normalizeExpr sorts the operands of e when it's a commutative operation.
+// The operands of e must already be normalized.
+func normalizeExpr(e Expression) Expression {
+	b, ok := e.(BinaryOpAST)
+	if !ok {
+		return e
+	}
+
+	switch b.Op {
+	case And, Or:
+		operands := flattenBinaryOp(b.Op, b, nil)
+		sortExprs(operands)
+		res := operands[0]
+		for _, o := range operands[1:] {
+			res = BinaryOpAST{b.Op, res, o}
+		}
+		return res
+
+	case Equal, NotEqual:
+		operands := []Expression{b.Left, b.Right}
+		sortExprs(operands)
+		return BinaryOpAST{b.Op, operands[0], operands[1]}
+	}
+	return e
+}
+
+// flattenBinaryOp appends the operands of a chain of the operator op in e,
+// e.g., a, b, and c of `(a AND b) AND c`, to operands.
+func flattenBinaryOp(op Operator, e Expression, operands []Expression) []Expression {
+	if b, ok := e.(BinaryOpAST); ok && b.Op == op {
+		operands = flattenBinaryOp(op, b.Left, operands)
+		return flattenBinaryOp(op, b.Right, operands)
+	}
+	return append(operands, e)
+}
+
+// sortExprs sorts exprs by their string representations.
+func sortExprs(exprs []Expression) {
+	sort.Stable(exprsByString(exprs))
+}
+
+type exprsByString []Expression
+
+func (es exprsByString) Len() int           { return len(es) }
+func (es exprsByString) Less(i, j int) bool { return es[i].String() < es[j].String() }
+func (es exprsByString) Swap(i, j int)      { es[i], es[j] = es[j], es[i] }
@@ -0,0 +1,109 @@
+package parser
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	p := New()
+	normalize := func(s string) string {
+		stmt, _, err := p.ParseStmt(s)
+		So(err, ShouldBeNil)
+		return Normalize(stmt.(Statement))
+	}
+
+	Convey("Given a BQL parser", t, func() {
+		Convey("When normalizing statements only differing in the order of AND operands", func() {
+			a := normalize("SELECT RSTREAM * FROM s [RANGE 1 TUPLES] WHERE a AND b")
+			b := normalize("SELECT RSTREAM * FROM s [RANGE 1 TUPLES] WHERE b AND a")
+
+			Convey("Then they should be the same", func() {
+				So(a, ShouldEqual, b)
+			})
+		})
+
+		Convey("When normalizing statements only differing in the order of operands of -", func() {
+			a := normalize("SELECT RSTREAM a - b FROM s [RANGE 1 TUPLES]")
+			b := normalize("SELECT RSTREAM b - a FROM s [RANGE 1 TUPLES]")
+
+			Convey("Then they should be different", func() {
+				So(a, ShouldNotEqual, b)
+			})
+		})
+
+		Convey("When normalizing statements only differing in the order of operands of +", func() {
+			a := normalize("SELECT RSTREAM a + b FROM s [RANGE 1 TUPLES]")
+			b := normalize("SELECT RSTREAM b + a FROM s [RANGE 1 TUPLES]")
+
+			Convey("Then they should be different", func() {
+				So(a, ShouldNotEqual, b)
+			})
+		})
+
+		Convey("When normalizing statements having nested AND and OR operators", func() {
+			a := normalize("SELECT RSTREAM * FROM s [RANGE 1 TUPLES] WHERE c OR (a AND b) OR d = 1")
+			b := normalize("SELECT RSTREAM * FROM s [RANGE 1 TUPLES] WHERE 1 = d OR (c OR (b AND a))")
+
+			Convey("Then they should be the same", func() {
+				So(a, ShouldEqual, b)
+				So(a, ShouldEqual, "SELECT RSTREAM * FROM s [RANGE 1 TUPLES] WHERE (1 = d OR (a AND b)) OR c")
+			})
+		})
+
+		Convey("When normalizing statements only differing in the order of AND and OR", func() {
+			a := normalize("SELECT RSTREAM * FROM s [RANGE 1 TUPLES] WHERE (a AND b) OR c")
+			b := normalize("SELECT RSTREAM * FROM s [RANGE 1 TUPLES] WHERE a AND (b OR c)")
+
+			Convey("Then they should be different", func() {
+				So(a, ShouldNotEqual, b)
+			})
+		})
+
+		Convey("When normalizing statements differing in whitespace, case, and literals", func() {
+			a := normalize("select   rstream a,1.50 AS x\nfrom s [range 1 tuples]  where a<>2")
+			b := normalize("SELECT RSTREAM a, 1.5 AS x FROM s [RANGE 1 TUPLES] WHERE 2 != a")
+
+			Convey("Then they should be the same", func() {
+				So(a, ShouldEqual, b)
+			})
+		})
+
+		Convey("When normalizing statements having AND in various clauses", func() {
+			a := normalize(`CREATE STREAM t AS SELECT ISTREAM f(b AND a) FROM s [RANGE 1 TUPLES]
+				GROUP BY x HAVING count(*) > 1 AND max(y) < 2`)
+			b := normalize(`CREATE STREAM t AS SELECT ISTREAM f(a AND b) FROM s [RANGE 1 TUPLES]
+				GROUP BY x HAVING max(y) < 2 AND count(*) > 1`)
+
+			Convey("Then they should be the same", func() {
+				So(a, ShouldEqual, b)
+			})
+		})
+
+		Convey("When normalizing EVAL statements", func() {
+			a := normalize(`EVAL x OR y ON {"x": true, "y": a AND b}`)
+			b := normalize(`EVAL y OR x ON {"x": true, "y": b AND a}`)
+
+			Convey("Then they should be the same", func() {
+				So(a, ShouldEqual, b)
+			})
+		})
+
+		Convey("When normalizing a statement", func() {
+			stmt, _, err := p.ParseStmt("SELECT RSTREAM * FROM s [RANGE 1 TUPLES] WHERE b AND a")
+			So(err, ShouldBeNil)
+			orig := stmt.(Statement).String()
+			Normalize(stmt.(Statement))
+
+			Convey("Then the statement should not be modified", func() {
+				So(stmt.(Statement).String(), ShouldEqual, orig)
+			})
+		})
+
+		Convey("When normalizing a statement without expressions", func() {
+			Convey("Then it should be the same as the string representation", func() {
+				So(normalize("drop  source s"), ShouldEqual, "DROP SOURCE s")
+			})
+		})
+	})
+}
@@ -12,6 +12,27 @@ import (
 
 type bqlParser struct {
 	b bqlPeg
+
+	// FoldIdentifiers makes the parser lowercase unquoted identifiers such
+	// as stream names, aliases, and columns, so that `SELECT A FROM S` and
+	// `SELECT a FROM s` result in the same statement. Quoted parts of
+	// columns such as `["Key"]` are kept as they are.
+	FoldIdentifiers bool
+
+	// MaxStatementBytes is the maximum length of a statement in bytes. A
+	// longer statement results in an error before it's parsed. 0 means
+	// DefaultMaxStatementBytes and a negative value means no limit.
+	MaxStatementBytes int
+
+	// MaxExpressionDepth is the maximum nesting level of brackets and
+	// expressions in a statement. A statement nested more deeply results
+	// in an error instead of exhausting the stack of the parser or of
+	// functions processing the AST later. Each operator in a chain of
+	// binary operations such as "a OR b OR c" counts as a level because
+	// the chain is nested in the AST, so a statement having a long chain
+	// may need a larger limit. 0 means DefaultMaxExpressionDepth and a
+	// negative value means no limit.
+	MaxExpressionDepth int
 }
 
 func New() *bqlParser {
@@ -22,12 +43,27 @@ func (p *bqlParser) ParseStmt(s string) (result interface{}, rest string, err er
 	// catch any parser errors
 	defer func() {
 		if r := recover(); r != nil {
+			if e, ok := r.(*expressionDepthError); ok {
+				// report it in the same way as the check before parsing
+				err = e
+				return
+			}
 			err = fmt.Errorf("Error in BQL parser: %v", r)
 		}
 	}()
+	maxBytes := effectiveLimit(p.MaxStatementBytes, DefaultMaxStatementBytes)
+	maxDepth := effectiveLimit(p.MaxExpressionDepth, DefaultMaxExpressionDepth)
+	if err := checkStatementLimits(s, maxBytes, maxDepth); err != nil {
+		return nil, "", err
+	}
 	// parse the statement
 	b := p.b
 	b.Buffer = s
+	b.foldIdentifiers = p.FoldIdentifiers
+	b.maxExpressionDepth = 0
+	if maxDepth > 0 {
+		b.maxExpressionDepth = maxDepth
+	}
 	b.Init()
 	if err := b.Parse(); err != nil {
 		return nil, "", err
@@ -84,6 +120,55 @@ func (b *bqlPeg) Parse(rule ...int) error {
 	return nil
 }
 
+// setDollarQuoteTag remembers the tag of the dollar quote delimiter
+// that ends right before pos (e.g., "tag" for "$tag$") so that the
+// closing delimiter can be checked against it. It always returns true
+// so that it can be used as a predicate in the grammar.
+func (b *bqlPegBackend) setDollarQuoteTag(buffer []rune, pos int) bool {
+	// buffer[pos-1] is the closing '$' of the delimiter and the tag
+	// cannot contain a '$', so look for the opening one
+	start := pos - 2
+	for start >= 0 && buffer[start] != '$' {
+		start--
+	}
+	b.dollarQuoteTag = string(buffer[start+1 : pos-1])
+	return true
+}
+
+// atDollarQuoteTag checks whether the input at pos starts with a dollar
+// quote delimiter that has the tag remembered by setDollarQuoteTag.
+func (b *bqlPegBackend) atDollarQuoteTag(buffer []rune, pos int) bool {
+	delim := []rune("$" + b.dollarQuoteTag + "$")
+	if pos+len(delim) > len(buffer) {
+		return false
+	}
+	for i, r := range delim {
+		if buffer[pos+i] != r {
+			return false
+		}
+	}
+	return true
+}
+
+// foldIdentifier lowercases s when the parser folds identifiers. Parts of s
+// enclosed in double quotes, such as the key of `a["Key"]`, are kept as they
+// are. An escaped quote `""` in such a part closes and reopens the quotes,
+// so it doesn't need to be handled separately.
+func (b *bqlPegBackend) foldIdentifier(s string) string {
+	if !b.foldIdentifiers {
+		return s
+	}
+	quoted := false
+	return strings.Map(func(r rune) rune {
+		if r == '"' {
+			quoted = !quoted
+		} else if !quoted {
+			return unicode.ToLower(r)
+		}
+		return r
+	}, s)
+}
+
 type bqlParseError struct {
 	*parseError
 }
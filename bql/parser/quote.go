@@ -0,0 +1,41 @@
+package parser
+
+import (
+	"strings"
+)
+
+// QuoteIdentifier returns name as it should be written in a BQL expression
+// referring to a field of a tuple. A name that is a valid identifier and
+// isn't a keyword is returned as it is. Other names, e.g., "select" or
+// "my-field", are double-quoted and enclosed in brackets like ["select"],
+// which is the form BQL accepts for any field name. Stream, source, sink,
+// and state names cannot be quoted in BQL, so the result is only valid as
+// a field name.
+func QuoteIdentifier(name string) string {
+	if isPlainIdentifier(name) {
+		return name
+	}
+	return "[" + QuoteLiteral(name) + "]"
+}
+
+// QuoteLiteral returns s as a double-quoted BQL string literal. Double
+// quotes in s are escaped by doubling them.
+func QuoteLiteral(s string) string {
+	return `"` + strings.Replace(s, `"`, `""`, -1) + `"`
+}
+
+// isPlainIdentifier checks whether name can be written without quoting.
+func isPlainIdentifier(name string) bool {
+	if name == "" || bqlKeywords[strings.ToUpper(name)] {
+		return false
+	}
+	for i, r := range name {
+		if i == 0 && !isBQLLetter(r) {
+			return false
+		}
+		if !isBQLIdentRune(r) {
+			return false
+		}
+	}
+	return true
+}
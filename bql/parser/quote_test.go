@@ -0,0 +1,71 @@
+package parser
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestQuoteIdentifier(t *testing.T) {
+	p := New()
+
+	Convey("Given a plain identifier", t, func() {
+		Convey("Then it shouldn't be quoted", func() {
+			So(QuoteIdentifier("col_1"), ShouldEqual, "col_1")
+			So(QuoteIdentifier("Col"), ShouldEqual, "Col")
+		})
+	})
+
+	Convey("Given a reserved word", t, func() {
+		Convey("Then it should be quoted regardless of its case", func() {
+			So(QuoteIdentifier("select"), ShouldEqual, `["select"]`)
+			So(QuoteIdentifier("FROM"), ShouldEqual, `["FROM"]`)
+			So(QuoteIdentifier("explain"), ShouldEqual, `["explain"]`)
+		})
+
+		Convey("Then the quoted name should be parsed as a field name", func() {
+			stmt, _, err := p.ParseStmt("SELECT ISTREAM " + QuoteIdentifier("select") +
+				" FROM s [RANGE 1 TUPLES]")
+			So(err, ShouldBeNil)
+			So(stmt.(SelectStmt).Projections, ShouldResemble, []Expression{RowValue{"", `["select"]`}})
+		})
+
+		Convey("Then a quoted EXPLAIN should be parsed as a field name", func() {
+			stmt, _, err := p.ParseStmt("SELECT ISTREAM " + QuoteIdentifier("explain") +
+				" FROM s [RANGE 1 TUPLES]")
+			So(err, ShouldBeNil)
+			So(stmt.(SelectStmt).Projections, ShouldResemble, []Expression{RowValue{"", `["explain"]`}})
+		})
+	})
+
+	Convey("Given names having special characters", t, func() {
+		Convey("Then they should be quoted", func() {
+			So(QuoteIdentifier(""), ShouldEqual, `[""]`)
+			So(QuoteIdentifier("1st"), ShouldEqual, `["1st"]`)
+			So(QuoteIdentifier("my-field"), ShouldEqual, `["my-field"]`)
+			So(QuoteIdentifier(`a"b`), ShouldEqual, `["a""b"]`)
+		})
+	})
+}
+
+func TestQuoteLiteral(t *testing.T) {
+	Convey("Given a string with embedded quotes", t, func() {
+		s := `say "hello"; bye`
+
+		Convey("Then the quotes should be escaped", func() {
+			So(QuoteLiteral(s), ShouldEqual, `"say ""hello""; bye"`)
+		})
+
+		Convey("Then the literal should be parsed as the original string", func() {
+			stmt, _, err := New().ParseStmt("EVAL " + QuoteLiteral(s))
+			So(err, ShouldBeNil)
+			So(stmt.(EvalStmt).Expr, ShouldResemble, StringLiteral{s})
+		})
+	})
+
+	Convey("Given an empty string", t, func() {
+		Convey("Then it should be an empty literal", func() {
+			So(QuoteLiteral(""), ShouldEqual, `""`)
+		})
+	})
+}
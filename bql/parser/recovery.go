@@ -0,0 +1,264 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseError is a syntax error found by ParseStmtsWithRecovery. Pos is the
+// offset (counted in runes) in the parsed string where the parser gave up,
+// Line and Symbol are the 1-based line number and the position in that line.
+type ParseError struct {
+	Pos     int
+	Line    int
+	Symbol  int
+	Message string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("line %v, symbol %v: %v", e.Line, e.Symbol, e.Message)
+}
+
+// ErrorNode takes the place of a statement that could not be parsed in the
+// result of ParseStmtsWithRecovery. Begin and End are the offsets of the
+// statement in the parsed string.
+type ErrorNode struct {
+	Begin int
+	End   int
+	Text  string
+}
+
+func (e ErrorNode) String() string {
+	return e.Text
+}
+
+// syncPlaceholders maps keywords starting a clause of a SELECT statement
+// to a valid replacement of the clause's content. When a clause has a
+// syntax error, its content is replaced with the placeholder so that the
+// parser can continue looking for errors in the following clauses.
+var syncPlaceholders = map[string]string{
+	"ISTREAM": "1",
+	"DSTREAM": "1",
+	"RSTREAM": "1",
+	"FROM":    "s [RANGE 1 TUPLES]",
+	"WHERE":   "1",
+	"BY":      "1",
+	"HAVING":  "1",
+}
+
+// clauseBoundaries contains keywords that end the content of a clause.
+var clauseBoundaries = map[string]bool{
+	"SELECT": true,
+	"UNION":  true,
+	"GROUP":  true,
+}
+
+// ParseStmtsWithRecovery parses all statements in s like ParseStmts, but
+// doesn't stop at the first syntax error. Instead, it collects the errors
+// and continues with the next synchronization point: the next statement
+// (separated by a semicolon) or, in a SELECT statement, the next clause
+// (e.g., WHERE). Statements having errors are returned as ErrorNodes. This
+// mode is meant to be used by tools like editors that want to report as
+// many errors as possible at once; it's best effort and might not find all
+// errors.
+func (p *bqlParser) ParseStmtsWithRecovery(s string) ([]interface{}, []*ParseError) {
+	buf := []rune(s)
+	tokens := scanTokens(buf)
+
+	var (
+		results []interface{}
+		errs    []*ParseError
+	)
+	start := 0 // index of the first token of the current statement
+	for i := 0; i <= len(tokens); i++ {
+		if i < len(tokens) && !(tokens[i].Kind == PunctuationToken && tokens[i].Text == ";") {
+			continue
+		}
+		stmtTokens := tokens[start:i]
+		start = i + 1
+		if len(stmtTokens) == 0 {
+			continue
+		}
+
+		begin, end := stmtTokens[0].Begin, stmtTokens[len(stmtTokens)-1].End
+		stmt, stmtErrs := p.parseStmtWithRecovery(buf, begin, end, stmtTokens)
+		if len(stmtErrs) > 0 {
+			errs = append(errs, stmtErrs...)
+			results = append(results, ErrorNode{begin, end, string(buf[begin:end])})
+			continue
+		}
+		results = append(results, stmt)
+	}
+	return results, errs
+}
+
+// parseStmtWithRecovery parses buf[begin:end] as a single statement. When it
+// has syntax errors, the content of the clause containing the error is
+// replaced with a placeholder and the statement is parsed again until no
+// more errors are found or the parser cannot make progress.
+func (p *bqlParser) parseStmtWithRecovery(buf []rune, begin, end int, tokens []Token) (interface{}, []*ParseError) {
+	// probe is the statement being parsed and origPos maps each position in
+	// probe to the position in buf
+	probe := append([]rune{}, buf[begin:end]...)
+	origPos := make([]int, len(probe)+1)
+	for i := range origPos {
+		origPos[i] = begin + i
+	}
+
+	var errs []*ParseError
+	for done := begin; ; {
+		stmt, _, err := p.ParseStmt(string(probe))
+		if err == nil {
+			return stmt, errs
+		}
+
+		pErr, ok := err.(*bqlParseError)
+		if !ok {
+			// an error reported by an action, e.g., an invalid value,
+			// doesn't have a position
+			return nil, append(errs, newParseError(buf, begin, err.Error()))
+		}
+		pos := int(pErr.max.end)
+		if pos > len(probe) {
+			pos = len(probe)
+		}
+		pos = origPos[pos]
+		// the parser might stop right after consuming whitespace
+		for pos < end && isBQLSpace(buf[pos]) {
+			pos++
+		}
+		if len(errs) > 0 && (pos < done || pos <= errs[len(errs)-1].Pos) {
+			// the error occurred before the previous error was resolved,
+			// so the placeholder didn't help
+			return nil, errs
+		}
+		errs = append(errs, newParseError(buf, pos, fmt.Sprintf("syntax error near %q", snippetAt(buf, pos))))
+
+		// find the clause containing the error
+		segBegin, segEnd, placeholder := findClause(tokens, pos, end)
+		if placeholder == "" {
+			return nil, errs
+		}
+		done = segEnd
+
+		// replace the content of the clause in probe
+		pb, pe := -1, len(probe)
+		for i, o := range origPos[:len(probe)] {
+			if pb < 0 && o >= segBegin {
+				pb = i
+			}
+			if o >= segEnd {
+				pe = i
+				break
+			}
+		}
+		if pb < 0 {
+			return nil, errs
+		}
+		replacement := []rune(" " + placeholder + " ")
+		newProbe := append(append(append([]rune{}, probe[:pb]...), replacement...), probe[pe:]...)
+		newOrig := append([]int{}, origPos[:pb]...)
+		for range replacement {
+			newOrig = append(newOrig, segBegin)
+		}
+		newOrig = append(newOrig, origPos[pe:]...)
+		probe, origPos = newProbe, newOrig
+	}
+}
+
+// findClause returns the range of the content of the SELECT clause
+// containing pos and the placeholder for it. The placeholder is empty when
+// pos isn't in the content of a clause that can be replaced.
+func findClause(tokens []Token, pos, stmtEnd int) (int, int, string) {
+	depth := 0
+	keyword := -1 // index of the last clause keyword before pos
+	for i, t := range tokens {
+		if t.Begin >= pos {
+			break
+		}
+		switch t.Text {
+		case "(", "[", "{":
+			depth++
+		case ")", "]", "}":
+			depth--
+		}
+		if depth == 0 && t.Kind == KeywordToken {
+			k := strings.ToUpper(t.Text)
+			if syncPlaceholders[k] != "" || clauseBoundaries[k] {
+				keyword = i
+			}
+		}
+	}
+	if keyword < 0 {
+		return 0, 0, ""
+	}
+	placeholder := syncPlaceholders[strings.ToUpper(tokens[keyword].Text)]
+	if placeholder == "" {
+		return 0, 0, ""
+	}
+
+	// the clause ends at the next clause keyword at the same depth
+	segEnd := stmtEnd
+	depth = 0
+	for _, t := range tokens[keyword+1:] {
+		switch t.Text {
+		case "(", "[", "{":
+			depth++
+		case ")", "]", "}":
+			depth--
+		}
+		if depth == 0 && t.Kind == KeywordToken && t.Begin >= pos {
+			k := strings.ToUpper(t.Text)
+			if (syncPlaceholders[k] != "" && k != "BY") || clauseBoundaries[k] {
+				segEnd = t.Begin
+				break
+			}
+		}
+	}
+	return tokens[keyword].End, segEnd, placeholder
+}
+
+// scanTokens splits buf into tokens like Tokenize, but skips characters
+// that cannot start a token instead of failing. Whitespace and comments
+// are omitted.
+func scanTokens(buf []rune) []Token {
+	var tokens []Token
+	for pos := 0; pos < len(buf); {
+		kind, end, err := nextToken(buf, pos)
+		if err != nil {
+			pos++
+			continue
+		}
+		if kind != SpaceToken && kind != CommentToken {
+			tokens = append(tokens, Token{kind, string(buf[pos:end]), pos, end})
+		}
+		pos = end
+	}
+	return tokens
+}
+
+func newParseError(buf []rune, pos int, msg string) *ParseError {
+	line, symbol := 1, 1
+	for _, r := range buf[:pos] {
+		if r == '\n' {
+			line, symbol = line+1, 1
+		} else {
+			symbol++
+		}
+	}
+	return &ParseError{
+		Pos:     pos,
+		Line:    line,
+		Symbol:  symbol,
+		Message: msg,
+	}
+}
+
+// snippetAt returns a short excerpt of buf starting at pos.
+func snippetAt(buf []rune, pos int) string {
+	end := pos
+	for end < len(buf) && end < pos+20 && buf[end] != '\n' {
+		end++
+	}
+	return string(buf[pos:end])
+}
@@ -0,0 +1,63 @@
+package parser
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestParseStmtsWithRecovery(t *testing.T) {
+	Convey("Given a BQL parser", t, func() {
+		p := New()
+
+		Convey("When parsing a statement with two syntax errors in different clauses", func() {
+			s := "SELECT ISTREAM a, , b FROM s [RANGE 1 TUPLES]\nWHERE x = = 1"
+			results, errs := p.ParseStmtsWithRecovery(s)
+
+			Convey("Then both errors should be reported with their positions", func() {
+				So(len(errs), ShouldEqual, 2)
+				So(errs[0].Pos, ShouldEqual, 18)
+				So(errs[0].Line, ShouldEqual, 1)
+				So(errs[0].Symbol, ShouldEqual, 19)
+				So(errs[1].Pos, ShouldEqual, 56)
+				So(errs[1].Line, ShouldEqual, 2)
+				So(errs[1].Symbol, ShouldEqual, 11)
+				So(errs[1].Error(), ShouldEqual, `line 2, symbol 11: syntax error near "= 1"`)
+			})
+
+			Convey("Then the statement should be replaced with an error node", func() {
+				So(results, ShouldResemble, []interface{}{
+					ErrorNode{0, len(s), s},
+				})
+			})
+		})
+
+		Convey("When parsing multiple statements some of which have errors", func() {
+			s := `SELECT ISTREAM a FROM s [RANGE 1 TUPLES] WHERE ;
+				CREATE SOURCE s TYPR t;
+				DROP SOURCE s;`
+			results, errs := p.ParseStmtsWithRecovery(s)
+
+			Convey("Then parsing should continue with the next statement", func() {
+				So(len(errs), ShouldEqual, 2)
+				So(errs[0].Line, ShouldEqual, 1)
+				So(errs[1].Line, ShouldEqual, 2)
+				So(len(results), ShouldEqual, 3)
+				So(results[0], ShouldHaveSameTypeAs, ErrorNode{})
+				So(results[1], ShouldHaveSameTypeAs, ErrorNode{})
+				So(results[2], ShouldResemble, DropSourceStmt{StreamIdentifier("s")})
+			})
+		})
+
+		Convey("When parsing valid statements", func() {
+			results, errs := p.ParseStmtsWithRecovery(`SELECT ISTREAM a FROM s [RANGE 1 TUPLES]; -- comment
+				DROP SOURCE s`)
+
+			Convey("Then the result should be the same as ParseStmts", func() {
+				So(errs, ShouldBeNil)
+				expected, err := p.ParseStmts(`SELECT ISTREAM a FROM s [RANGE 1 TUPLES]; DROP SOURCE s`)
+				So(err, ShouldBeNil)
+				So(results, ShouldResemble, expected)
+			})
+		})
+	})
+}
@@ -0,0 +1,153 @@
+package parser
+
+// rewriteStmt returns a copy of stmt in which all expressions are rewritten
+// by rewriteExpr with f. Statements without expressions are returned as they
+// are. stmt itself isn't modified.
+func rewriteStmt(stmt Statement, f func(Expression) Expression) Statement {
+	return rewriteBottomUp(stmt, f).(Statement)
+}
+
+// rewriteExpr returns a copy of e in which subexpressions are rewritten
+// bottom-up: f is applied to the subexpressions of a node first and then to
+// the node having the rewritten subexpressions. e itself isn't modified.
+func rewriteExpr(e Expression, f func(Expression) Expression) Expression {
+	return rewriteBottomUp(e, f).(Expression)
+}
+
+func rewriteBottomUp(node interface{}, f func(Expression) Expression) interface{} {
+	node = rewriteChildren(node, func(n interface{}) interface{} {
+		return rewriteBottomUp(n, f)
+	})
+	if e, ok := node.(Expression); ok {
+		return f(e)
+	}
+	return node
+}
+
+// Rewrite returns a copy of node in which nodes are replaced by fn. node is
+// a Statement or an Expression. Rewrite walks the AST depth-first from node
+// and calls fn with each node. When fn returns a new node and true, the node
+// is replaced with it and its children aren't visited. Otherwise, Rewrite
+// visits the children of the node. node itself isn't modified.
+//
+// fn must return a node which can be placed where the original node was,
+// e.g. an Expression for an operand of a BinaryOpAST or a SelectStmt for
+// the SELECT part of a CreateStreamAsSelectStmt. Rewrite panics otherwise.
+//
+// For example, the following code renames the column "a" to "b":
+//
+//	Rewrite(stmt, func(n interface{}) (interface{}, bool) {
+//		if r, ok := n.(RowValue); ok && r.Column == "a" {
+//			r.Column = "b"
+//			return r, true
+//		}
+//		return nil, false
+//	})
+func Rewrite(node interface{}, fn func(interface{}) (interface{}, bool)) interface{} {
+	if n, ok := fn(node); ok {
+		return n
+	}
+	return rewriteChildren(node, func(n interface{}) interface{} {
+		return Rewrite(n, fn)
+	})
+}
+
+// rewriteChildren returns a copy of node whose child nodes are replaced by
+// the results of rw. node itself isn't modified.
+func rewriteChildren(node interface{}, rw func(interface{}) interface{}) interface{} {
+	expr := func(e Expression) Expression {
+		if e == nil {
+			return nil
+		}
+		return rw(e).(Expression)
+	}
+	exprs := func(es []Expression) []Expression {
+		if es == nil {
+			return nil
+		}
+		res := make([]Expression, len(es))
+		for i, e := range es {
+			res[i] = expr(e)
+		}
+		return res
+	}
+
+	switch n := node.(type) {
+	case SelectStmt:
+		n.Projections = exprs(n.Projections)
+		rels := make([]AliasedStreamWindowAST, len(n.Relations))
+		for i, r := range n.Relations {
+			r.Params = exprs(r.Params)
+			rels[i] = r
+		}
+		n.Relations = rels
+		n.Filter = expr(n.Filter)
+		n.GroupList = exprs(n.GroupList)
+		n.Having = expr(n.Having)
+		return n
+	case SelectUnionStmt:
+		sels := make([]SelectStmt, len(n.Selects))
+		for i, sel := range n.Selects {
+			sels[i] = rw(sel).(SelectStmt)
+		}
+		return SelectUnionStmt{sels}
+	case CreateStreamAsSelectStmt:
+		n.Select = rw(n.Select).(SelectStmt)
+		return n
+	case CreateStreamAsSelectUnionStmt:
+		n.SelectUnionStmt = rw(n.SelectUnionStmt).(SelectUnionStmt)
+		return n
+	case EvalStmt:
+		n.Expr = expr(n.Expr)
+		if n.Input != nil {
+			m := rw(*n.Input).(MapAST)
+			n.Input = &m
+		}
+		return n
+	case ExplainStmt:
+		n.Stmt = rw(n.Stmt).(Statement)
+		return n
+
+	case BinaryOpAST:
+		return BinaryOpAST{n.Op, expr(n.Left), expr(n.Right)}
+	case AliasAST:
+		return AliasAST{expr(n.Expr), n.Alias}
+	case UnaryOpAST:
+		return UnaryOpAST{n.Op, expr(n.Expr)}
+	case TypeCastAST:
+		return TypeCastAST{expr(n.Expr), n.Target}
+	case FuncAppAST:
+		var ordering []SortedExpressionAST
+		if n.Ordering != nil {
+			ordering = make([]SortedExpressionAST, len(n.Ordering))
+			for i, o := range n.Ordering {
+				ordering[i] = rw(o).(SortedExpressionAST)
+			}
+		}
+		return FuncAppAST{n.Function, ExpressionsAST{exprs(n.Expressions)}, ordering}
+	case FuncAppSelectorAST:
+		return FuncAppSelectorAST{rw(n.FuncAppAST).(FuncAppAST), n.Selector}
+	case SortedExpressionAST:
+		return SortedExpressionAST{expr(n.Expr), n.Ascending}
+	case ArrayAST:
+		return ArrayAST{ExpressionsAST{exprs(n.Expressions)}}
+	case RowAST:
+		return RowAST{ExpressionsAST{exprs(n.Expressions)}}
+	case MapAST:
+		entries := make([]KeyValuePairAST, len(n.Entries))
+		for i, pair := range n.Entries {
+			entries[i] = KeyValuePairAST{pair.Key, expr(pair.Value)}
+		}
+		return MapAST{entries}
+	case ConditionCaseAST:
+		checks := make([]WhenThenPairAST, len(n.Checks))
+		for i, pair := range n.Checks {
+			checks[i] = WhenThenPairAST{expr(pair.When), expr(pair.Then)}
+		}
+		return ConditionCaseAST{Checks: checks, Else: expr(n.Else)}
+	case ExpressionCaseAST:
+		return ExpressionCaseAST{expr(n.Expr), rw(n.ConditionCaseAST).(ConditionCaseAST)}
+	}
+	// other statements and expressions don't have child nodes
+	return node
+}
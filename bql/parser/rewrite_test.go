@@ -0,0 +1,116 @@
+package parser
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestRewrite(t *testing.T) {
+	renameA := func(n interface{}) (interface{}, bool) {
+		if r, ok := n.(RowValue); ok && r.Column == "a" {
+			r.Column = "b"
+			return r, true
+		}
+		return nil, false
+	}
+
+	Convey("Given a nested expression referring to a column multiple times", t, func() {
+		p := New()
+		stmt := `EVAL CASE WHEN a > 1 THEN f(a, [a + 1, {"k": -a}] ORDER BY a DESC)[0] ELSE a::string END`
+		s, _, err := p.ParseStmt(stmt)
+		So(err, ShouldBeNil)
+		e := s.(EvalStmt).Expr
+
+		Convey("When rewriting the column", func() {
+			res := Rewrite(e, renameA)
+
+			Convey("Then all occurrences should be replaced", func() {
+				So(res.(Expression).String(), ShouldEqual,
+					`CASE WHEN b > 1 THEN f(b, [b + 1, {"k":-b}] ORDER BY b DESC)[0] ELSE b::STRING END`)
+			})
+
+			Convey("Then the original expression should not be modified", func() {
+				So(e.String(), ShouldContainSubstring, "WHEN a > 1")
+			})
+		})
+	})
+
+	Convey("Given a SELECT statement", t, func() {
+		p := New()
+		s, _, err := p.ParseStmt(`CREATE STREAM s AS SELECT ISTREAM a, c FROM x [RANGE 1 TUPLES] WHERE a = 1 AND c = 2 GROUP BY a HAVING count(a) > 1`)
+		So(err, ShouldBeNil)
+
+		Convey("When rewriting the column", func() {
+			res := Rewrite(s, renameA)
+
+			Convey("Then all clauses should be rewritten", func() {
+				So(res.(Statement).String(), ShouldEqual,
+					"CREATE STREAM s AS SELECT ISTREAM b, c FROM x [RANGE 1 TUPLES] WHERE b = 1 AND c = 2 GROUP BY b HAVING count(b) > 1")
+			})
+		})
+
+		Convey("When replacing a node having children", func() {
+			res := Rewrite(s, func(n interface{}) (interface{}, bool) {
+				if b, ok := n.(BinaryOpAST); ok && b.Op == And {
+					return BoolLiteral{true}, true
+				}
+				if _, ok := n.(RowValue); ok {
+					// this isn't called for columns in the replaced node
+					return RowValue{"", "z"}, true
+				}
+				return nil, false
+			})
+
+			Convey("Then the children should not be visited", func() {
+				So(res.(Statement).String(), ShouldEqual,
+					"CREATE STREAM s AS SELECT ISTREAM z, z FROM x [RANGE 1 TUPLES] WHERE TRUE GROUP BY z HAVING count(z) > 1")
+			})
+		})
+
+		Convey("When replacing a node with one of a wrong type", func() {
+			fn := func(n interface{}) (interface{}, bool) {
+				if _, ok := n.(SelectStmt); ok {
+					return BoolLiteral{true}, true
+				}
+				return nil, false
+			}
+
+			Convey("Then it should panic", func() {
+				So(func() { Rewrite(s, fn) }, ShouldPanic)
+			})
+		})
+	})
+}
+
+func TestRewriteStmt(t *testing.T) {
+	Convey("Given a statement having expressions in nested nodes", t, func() {
+		p := New()
+		stmt, _, err := p.ParseStmt("SELECT ISTREAM udaf(a ORDER BY a).x, CASE a WHEN a THEN 1 END " +
+			"FROM s [RANGE 1 TUPLES] WHERE a")
+		So(err, ShouldBeNil)
+
+		Convey("When rewriting it bottom-up", func() {
+			var visited []string
+			res := rewriteStmt(stmt.(Statement), func(e Expression) Expression {
+				visited = append(visited, e.String())
+				if r, ok := e.(RowValue); ok && r.Column == "a" {
+					r.Column = "b"
+					return r
+				}
+				return e
+			})
+
+			Convey("Then all expressions should be rewritten", func() {
+				So(res.String(), ShouldEqual, "SELECT ISTREAM udaf(b ORDER BY b).x, CASE b WHEN b THEN 1 END "+
+					"FROM s [RANGE 1 TUPLES] WHERE b")
+			})
+
+			Convey("Then parents should be visited with rewritten subexpressions", func() {
+				So(visited[0], ShouldEqual, "a")
+				So(visited, ShouldContain, "udaf(b ORDER BY b)")
+				So(visited, ShouldContain, "udaf(b ORDER BY b).x")
+				So(visited, ShouldContain, "CASE b WHEN b THEN 1 END")
+			})
+		})
+	})
+}
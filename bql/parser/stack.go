@@ -2,6 +2,7 @@ package parser
 
 import (
 	"fmt"
+	"regexp"
 
 	"gopkg.in/sensorbee/sensorbee.v0/data"
 )
@@ -11,6 +12,10 @@ import (
 type parseStack struct {
 	top  *stackElement
 	size int
+
+	// maxExpressionDepth is the maximum nesting level of expressions
+	// assembled on the stack. 0 means no limit.
+	maxExpressionDepth int
 }
 
 // stackElement is a stack-internal data structure that is used
@@ -530,6 +535,21 @@ func (ps *parseStack) AssembleEval(begin, end int) {
 	ps.Push(&se)
 }
 
+// AssembleExplain takes the topmost element from the stack, assuming it
+// is a statement wrapped by EXPLAIN, and replaces it by a single
+// ExplainStmt element.
+//
+//  Statement
+//   =>
+//  ExplainStmt{Statement}
+func (ps *parseStack) AssembleExplain(begin, end int) {
+	_stmt := ps.Pop()
+	stmt := _stmt.comp.(Statement)
+
+	se := ParsedComponent{begin, end, ExplainStmt{stmt}}
+	ps.Push(&se)
+}
+
 /* Projections/Columns */
 
 // AssembleEmitter takes the topmost elements from the stack, assuming
@@ -916,6 +936,32 @@ func (ps *parseStack) AssembleSourceSinkSpecs(begin int, end int) {
 	}
 }
 
+// AssembleSourceSinkSpecsFromMap takes the topmost element from the
+// stack, assuming it is a map literal of a SET clause in an UPDATE STATE
+// statement, and replaces it by a SourceSinkSpecsAST having each entry
+// of the map as a parameter. Keys of the map must be valid parameter
+// keys so that the statement can be written as `SET key=value`.
+//
+//  MapAST
+//   =>
+//  SourceSinkSpecsAST{[]SourceSinkParamAST}
+func (ps *parseStack) AssembleSourceSinkSpecsFromMap(begin int, end int) {
+	_m := ps.Pop()
+	m := _m.comp.(MapAST)
+
+	params := make([]SourceSinkParamAST, len(m.Entries))
+	for i, e := range m.Entries {
+		if !paramKeyRegexp.MatchString(e.Key) {
+			panic(fmt.Sprintf("%v cannot be used as a key of a parameter", QuoteLiteral(e.Key)))
+		}
+		params[i] = SourceSinkParamAST{SourceSinkParamKey(e.Key), paramLiteralToValue(e.Value)}
+	}
+	ps.PushComponent(begin, end, SourceSinkSpecsAST{params})
+}
+
+// paramKeyRegexp matches strings which are valid as a SourceSinkParamKey.
+var paramKeyRegexp = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_]*$`)
+
 // AssembleSourceSinkParam takes the topmost elements from the
 // stack, assuming they are part of a WITH clause in a CREATE SOURCE
 // statement and replaces them by a single SourceSinkParamAST element.
@@ -927,43 +973,45 @@ func (ps *parseStack) AssembleSourceSinkSpecs(begin int, end int) {
 func (ps *parseStack) AssembleSourceSinkParam() {
 	_value, _key := ps.pop2()
 
-	var toValue func(obj interface{}) data.Value
-	toValue = func(obj interface{}) data.Value {
-		var value data.Value
-		switch lit := obj.(type) {
-		default:
-			panic(fmt.Sprintf("cannot deal with a %T here", lit))
-		case StringLiteral:
-			value = data.String(lit.Value)
-		case BoolLiteral:
-			value = data.Bool(lit.Value)
-		case NumericLiteral:
-			value = data.Int(lit.Value)
-		case FloatLiteral:
-			value = data.Float(lit.Value)
-		case ArrayAST:
-			arr := make(data.Array, len(lit.Expressions))
-			for i, item := range lit.Expressions {
-				arr[i] = toValue(item)
-			}
-			value = arr
-		case MapAST:
-			m := data.Map{}
-			for _, item := range lit.Entries {
-				m[item.Key] = toValue(item.Value)
-			}
-			value = m
-		}
-		return value
-	}
-
-	value := toValue(_value.comp)
+	value := paramLiteralToValue(_value.comp)
 	key := _key.comp.(SourceSinkParamKey)
 
 	ss := SourceSinkParamAST{key, value}
 	ps.PushComponent(_key.begin, _value.end, ss)
 }
 
+// paramLiteralToValue converts a literal in a parameter to a data.Value.
+func paramLiteralToValue(obj interface{}) data.Value {
+	var value data.Value
+	switch lit := obj.(type) {
+	default:
+		panic(fmt.Sprintf("cannot deal with a %T here", lit))
+	case StringLiteral:
+		value = data.String(lit.Value)
+	case BoolLiteral:
+		value = data.Bool(lit.Value)
+	case NumericLiteral:
+		value = data.Int(lit.Value)
+	case FloatLiteral:
+		value = data.Float(lit.Value)
+	case IntervalLiteral:
+		value = data.Float(lit.Value.Seconds())
+	case ArrayAST:
+		arr := make(data.Array, len(lit.Expressions))
+		for i, item := range lit.Expressions {
+			arr[i] = paramLiteralToValue(item)
+		}
+		value = arr
+	case MapAST:
+		m := data.Map{}
+		for _, item := range lit.Entries {
+			m[item.Key] = paramLiteralToValue(item.Value)
+		}
+		value = m
+	}
+	return value
+}
+
 // EnsureIdentifier makes sure that the top element of the stack
 // is an Identifier element. If there was no Identifier on top,
 // an empty Identifier is pushed there. Note that since regular
@@ -1034,7 +1082,9 @@ func (ps *parseStack) AssembleBinaryOperation(begin int, end int) {
 	} else if len(elems) == 3 {
 		op := elems[1].(Operator)
 		// connect left and right with the given operator
-		ps.PushComponent(begin, end, BinaryOpAST{op, elems[0].(Expression), elems[2].(Expression)})
+		e := BinaryOpAST{op, elems[0].(Expression), elems[2].(Expression)}
+		ps.ensureExpressionDepth(e)
+		ps.PushComponent(begin, end, e)
 	} else if len(elems) > 3 {
 		op := elems[1].(Operator)
 		// left-associativity: process three leftmost items,
@@ -1043,12 +1093,68 @@ func (ps *parseStack) AssembleBinaryOperation(begin int, end int) {
 		for i := 4; i < len(elems); i += 2 {
 			leftmost = BinaryOpAST{elems[i-1].(Operator), leftmost, elems[i].(Expression)}
 		}
+		ps.ensureExpressionDepth(leftmost)
 		ps.PushComponent(begin, end, leftmost)
 	} else {
 		panic(fmt.Sprintf("cannot turn %+v into a binary operation", elems))
 	}
 }
 
+// ensureExpressionDepth panics with an expressionDepthError when e is nested
+// more deeply than the limit. Brackets are already limited before parsing,
+// but a chain of binary operations such as 1+1+...+1 is assembled into a
+// deep AST without them.
+func (ps *parseStack) ensureExpressionDepth(e Expression) {
+	if ps.maxExpressionDepth > 0 && exceedsExpressionDepth(e, ps.maxExpressionDepth) {
+		panic(&expressionDepthError{ps.maxExpressionDepth})
+	}
+}
+
+// AssembleIn takes the elements from the stack that correspond to the
+// input[begin:end] string and, if there is an IN or NOT IN operator,
+// combines them into a BinaryOpAST. The right hand side is a RowAST
+// holding the list of candidates, and each of them must have the same
+// number of elements as the left hand side, where an expression that
+// is not a RowAST counts as one element.
+//
+//  Any
+//   =>
+//  Any
+// or
+//  Any
+//  Operator
+//  RowAST
+//   =>
+//  BinaryOpAST{Operator, Any, RowAST}
+func (ps *parseStack) AssembleIn(begin int, end int) {
+	elems := ps.collectElements(begin, end)
+	if len(elems) == 1 {
+		// there is no IN operation, push back the single element
+		ps.PushComponent(begin, end, elems[0])
+		return
+	} else if len(elems) != 3 {
+		panic(fmt.Sprintf("cannot turn %+v into an IN operation", elems))
+	}
+
+	left := elems[0].(Expression)
+	op := elems[1].(Operator)
+	candidates := elems[2].(RowAST)
+
+	arity := func(e Expression) int {
+		if row, ok := e.(RowAST); ok {
+			return len(row.Expressions)
+		}
+		return 1
+	}
+	n := arity(left)
+	for _, c := range candidates.Expressions {
+		if m := arity(c); m != n {
+			panic(fmt.Sprintf("%s has %d elements, but %s has %d", left, n, c, m))
+		}
+	}
+	ps.PushComponent(begin, end, BinaryOpAST{op, left, candidates})
+}
+
 // AssembleUnaryPrefixOperation takes the two elements from the stack that
 // correspond to the input[begin:end] string and adds the given
 // unary operator. If there is just one element, push it back unmodified.
@@ -1179,9 +1285,27 @@ func (ps *parseStack) AssembleArray() {
 	ps.Push(&se)
 }
 
+// AssembleRow takes the topmost elements from the stack, assuming
+// they are components of a row expression, and replaces them
+// by a single RowAST element.
+//
+//  ExpressionsAST
+//   =>
+//  RowAST{ExpressionsAST}
+func (ps *parseStack) AssembleRow() {
+	// pop the components from the stack in reverse order
+	_exprs := ps.Pop()
+
+	exprs := _exprs.comp.(ExpressionsAST)
+
+	se := ParsedComponent{_exprs.begin, _exprs.end, RowAST{exprs}}
+	ps.Push(&se)
+}
+
 // AssembleMap takes the elements from the stack that
 // correspond to the input[begin:end] string and wraps a
-// MapAST struct around them.
+// MapAST struct around them. It panics when the same key
+// appears more than once.
 //
 //  KeyValuePairAST
 //  KeyValuePairAST
@@ -1191,8 +1315,13 @@ func (ps *parseStack) AssembleArray() {
 func (ps *parseStack) AssembleMap(begin int, end int) {
 	elems := ps.collectElements(begin, end)
 	pairs := make([]KeyValuePairAST, len(elems))
+	keys := make(map[string]bool, len(elems))
 	for i := range elems {
 		pairs[i] = elems[i].(KeyValuePairAST)
+		if keys[pairs[i].Key] {
+			panic(fmt.Sprintf("duplicate key in a map literal: %v", QuoteLiteral(pairs[i].Key)))
+		}
+		keys[pairs[i].Key] = true
 	}
 	// push the grouped list back
 	ps.PushComponent(begin, end, MapAST{pairs})
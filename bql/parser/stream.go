@@ -0,0 +1,232 @@
+package parser
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+)
+
+// StmtReader reads BQL statements one by one from an io.Reader. Only the
+// text of the statement being read is kept in memory, so it can be used
+// to process large scripts or scripts sent over a network connection
+// while they're still being written.
+type StmtReader struct {
+	// MaxStatementBytes is the maximum length of a statement in bytes, which
+	// is checked while the statement is being read so that an input without
+	// semicolons isn't read into memory entirely. It's also used by the
+	// parser. 0 means DefaultMaxStatementBytes and a negative value means no
+	// limit.
+	MaxStatementBytes int
+
+	r       *bufio.Reader
+	p       *bqlParser
+	pending []Statement
+	err     error
+	started bool // true after the first statement is read
+
+	// state of the statement splitter
+	buf      []rune
+	state    splitState
+	lastDash bool
+	tagBegin int
+	delim    []rune
+}
+
+type splitState int
+
+const (
+	splitNormal splitState = iota
+	splitString
+	splitStringQuote // a double quote in a string, might be escaped
+	splitComment
+	splitDollarTag // reading the tag of a dollar quote delimiter
+	splitDollarQuote
+)
+
+// NewStmtReader creates a new StmtReader reading statements from r.
+func NewStmtReader(r io.Reader) *StmtReader {
+	return &StmtReader{
+		r: bufio.NewReader(r),
+		p: New(),
+	}
+}
+
+// Next returns the next statement. It returns io.EOF when all statements
+// have been read. Statements are separated by semicolons, and Next returns
+// a statement as soon as its semicolon is read. The last statement doesn't
+// need to have a semicolon, and extra semicolons after a statement are
+// ignored as ParseStmts does. Once Next returns an error, it always returns
+// the same error.
+func (s *StmtReader) Next() (Statement, error) {
+	for len(s.pending) == 0 {
+		if s.err != nil {
+			return nil, s.err
+		}
+		text, err := s.readStmt()
+		if err != nil && err != io.EOF {
+			s.err = err
+			return nil, err
+		}
+		if len(scanTokens([]rune(text))) == 0 {
+			// there are only spaces and comments
+			if err == io.EOF {
+				s.err = io.EOF
+				continue
+			}
+			if s.started {
+				// like ParseStmts, stray semicolons after a statement are
+				// skipped
+				continue
+			}
+			s.err = fmt.Errorf("empty statement before the semicolon: %q", text)
+			return nil, s.err
+		}
+		if err := s.parse(text); err != nil {
+			s.err = err
+			return nil, err
+		}
+		s.started = true
+		s.err = err
+	}
+	stmt := s.pending[0]
+	s.pending = s.pending[1:]
+	return stmt, nil
+}
+
+func (s *StmtReader) parse(text string) error {
+	s.p.MaxStatementBytes = s.MaxStatementBytes
+	results, err := s.p.ParseStmts(text)
+	if err != nil {
+		return err
+	}
+	for _, r := range results {
+		stmt, ok := r.(Statement)
+		if !ok {
+			return fmt.Errorf("the parser returned %T which isn't a statement", r)
+		}
+		s.pending = append(s.pending, stmt)
+	}
+	return nil
+}
+
+// readStmt reads runes until it finds a semicolon which isn't in a string
+// literal or a comment. It returns the text before the semicolon. It
+// returns io.EOF with the remaining text when the reader has no more data.
+// It returns an error when the statement including the semicolon is longer
+// than MaxStatementBytes.
+func (s *StmtReader) readStmt() (string, error) {
+	s.buf = s.buf[:0]
+	s.state = splitNormal
+	s.lastDash = false
+	maxBytes := effectiveLimit(s.MaxStatementBytes, DefaultMaxStatementBytes)
+	bytes := 0
+	for {
+		r, size, err := s.r.ReadRune()
+		if err != nil {
+			return string(s.buf), err
+		}
+		bytes += size
+		if maxBytes >= 0 && bytes > maxBytes {
+			return "", fmt.Errorf("the statement is longer than the maximum length (%v bytes)", maxBytes)
+		}
+		s.buf = append(s.buf, r)
+		if s.feed(r) {
+			return string(s.buf[:len(s.buf)-1]), nil
+		}
+	}
+}
+
+// feed updates the state of the splitter with r, which has already been
+// appended to buf. It returns true when r is a semicolon ending a
+// statement.
+func (s *StmtReader) feed(r rune) bool {
+	switch s.state {
+	case splitNormal:
+		dash := s.lastDash
+		s.lastDash = false
+		switch r {
+		case ';':
+			return true
+		case '"':
+			s.state = splitString
+		case '$':
+			s.state = splitDollarTag
+			s.tagBegin = len(s.buf) - 1
+		case '-':
+			if dash {
+				s.state = splitComment
+			} else {
+				s.lastDash = true
+			}
+		}
+
+	case splitString:
+		if r == '"' {
+			s.state = splitStringQuote
+		}
+
+	case splitStringQuote:
+		if r == '"' {
+			// an escaped double quote
+			s.state = splitString
+			return false
+		}
+		s.state = splitNormal
+		return s.feed(r)
+
+	case splitComment:
+		if r == '\r' || r == '\n' {
+			s.state = splitNormal
+		}
+
+	case splitDollarTag:
+		if isBQLIdentRune(r) {
+			return false
+		}
+		tag := s.buf[s.tagBegin+1 : len(s.buf)-1]
+		if r == '$' && (len(tag) == 0 || !isBQLDigit(tag[0])) {
+			s.state = splitDollarQuote
+			s.delim = append(s.delim[:0], s.buf[s.tagBegin:]...)
+			s.tagBegin = len(s.buf)
+			return false
+		}
+		// not a delimiter
+		s.state = splitNormal
+		return s.feed(r)
+
+	case splitDollarQuote:
+		if len(s.buf)-s.tagBegin >= len(s.delim) && hasRuneSuffix(s.buf, s.delim) {
+			s.state = splitNormal
+		}
+	}
+	return false
+}
+
+func hasRuneSuffix(buf, suffix []rune) bool {
+	offset := len(buf) - len(suffix)
+	for i, r := range suffix {
+		if buf[offset+i] != r {
+			return false
+		}
+	}
+	return true
+}
+
+// ParseReader parses all statements read from r. Unlike ParseStmts, it
+// doesn't need the whole input as a string; statements are read and
+// parsed one by one. Use StmtReader to process each statement as soon as
+// it's read.
+func ParseReader(r io.Reader) ([]Statement, error) {
+	sr := NewStmtReader(r)
+	stmts := []Statement{}
+	for {
+		stmt, err := sr.Next()
+		if err == io.EOF {
+			return stmts, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		stmts = append(stmts, stmt)
+	}
+}
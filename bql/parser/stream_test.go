@@ -0,0 +1,172 @@
+package parser
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+func TestStmtReader(t *testing.T) {
+	Convey("Given a pipe delivering statements in chunks", t, func() {
+		pr, pw := io.Pipe()
+		defer pr.Close()
+		sr := NewStmtReader(pr)
+
+		chunks := []string{
+			"SELECT ISTREAM a FROM s [RANGE 1 TUPLES]",
+			" WHERE b = \"x;\"\"y\"; CREATE SOURCE src TYPE dummy",
+			" WITH x = $$a;b$$;\n-- comment; not a statement\nEVAL 1 + ",
+			"2",
+		}
+		// the writer waits until the reader has got a statement before
+		// writing the next chunk, so reading all data first would block
+		proceed := make(chan bool)
+		go func() {
+			defer pw.Close()
+			for i, c := range chunks {
+				if i >= 2 && !<-proceed {
+					return
+				}
+				io.WriteString(pw, c)
+			}
+		}()
+		defer close(proceed)
+
+		Convey("When reading statements from it", func() {
+			Convey("Then each statement should be parsed as soon as it's complete", func() {
+				stmt, err := sr.Next()
+				So(err, ShouldBeNil)
+				So(stmt, ShouldHaveSameTypeAs, SelectStmt{})
+				So(stmt.String(), ShouldEqual, `SELECT ISTREAM a FROM s [RANGE 1 TUPLES] WHERE b = "x;""y"`)
+
+				proceed <- true
+				stmt, err = sr.Next()
+				So(err, ShouldBeNil)
+				So(stmt, ShouldHaveSameTypeAs, CreateSourceStmt{})
+				src := stmt.(CreateSourceStmt)
+				So(src.Params[0].Value, ShouldResemble, data.String("a;b"))
+
+				proceed <- true
+				stmt, err = sr.Next()
+				So(err, ShouldBeNil)
+				So(stmt, ShouldHaveSameTypeAs, EvalStmt{})
+
+				_, err = sr.Next()
+				So(err, ShouldEqual, io.EOF)
+			})
+		})
+	})
+}
+
+func TestParseReader(t *testing.T) {
+	Convey("Given a reader having multiple statements", t, func() {
+		r := strings.NewReader(`-- a script
+			CREATE STREAM s AS SELECT ISTREAM * FROM src [RANGE 1 TUPLES];
+			SELECT RSTREAM a FROM s [RANGE 2 SECONDS];
+			-- trailing comment
+		`)
+
+		Convey("When parsing it", func() {
+			stmts, err := ParseReader(r)
+
+			Convey("Then it should return the same statements as ParseStmts", func() {
+				So(err, ShouldBeNil)
+				So(len(stmts), ShouldEqual, 2)
+				So(stmts[0], ShouldHaveSameTypeAs, CreateStreamAsSelectStmt{})
+				So(stmts[1], ShouldHaveSameTypeAs, SelectStmt{})
+			})
+		})
+	})
+
+	Convey("Given an endless reader without semicolons", t, func() {
+		r := &endlessReader{}
+		sr := NewStmtReader(r)
+
+		Convey("When reading a statement from it", func() {
+			_, err := sr.Next()
+
+			Convey("Then it should fail without reading the whole input", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "longer than the maximum length")
+				So(r.n, ShouldBeLessThan, 2*DefaultMaxStatementBytes)
+			})
+
+			Convey("Then Next should keep returning the error", func() {
+				_, err2 := sr.Next()
+				So(err2, ShouldEqual, err)
+			})
+		})
+	})
+
+	Convey("Given a StmtReader with a custom length limit", t, func() {
+		sr := NewStmtReader(strings.NewReader("EVAL 123; EVAL 12345;"))
+		sr.MaxStatementBytes = 9
+
+		Convey("When reading statements from it", func() {
+			Convey("Then only statements within the limit should be read", func() {
+				stmt, err := sr.Next()
+				So(err, ShouldBeNil)
+				So(stmt, ShouldResemble, EvalStmt{Expr: NumericLiteral{123}})
+
+				_, err = sr.Next()
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "(9 bytes)")
+			})
+		})
+	})
+
+	Convey("Given an empty reader", t, func() {
+		Convey("Then ParseReader should return no statements", func() {
+			stmts, err := ParseReader(strings.NewReader("  \n-- nothing\n"))
+			So(err, ShouldBeNil)
+			So(stmts, ShouldBeEmpty)
+		})
+	})
+
+	Convey("Given a reader having stray semicolons between statements", t, func() {
+		Convey("Then ParseReader should skip them like ParseStmts", func() {
+			for _, s := range []string{"EVAL 1; ; EVAL 2", "EVAL 1;;EVAL 2", "EVAL 1;\n;\nEVAL 2;"} {
+				stmts, err := ParseReader(strings.NewReader(s))
+				So(err, ShouldBeNil)
+				So(stmts, ShouldResemble, []Statement{
+					EvalStmt{Expr: NumericLiteral{1}},
+					EvalStmt{Expr: NumericLiteral{2}},
+				})
+
+				expected, err := New().ParseStmts(s)
+				So(err, ShouldBeNil)
+				So(len(stmts), ShouldEqual, len(expected))
+			}
+		})
+	})
+
+	Convey("Given a reader starting with an empty statement", t, func() {
+		Convey("Then ParseReader should fail like ParseStmts", func() {
+			_, err := ParseReader(strings.NewReader("; EVAL 1"))
+			So(err, ShouldNotBeNil)
+		})
+	})
+
+	Convey("Given a reader having an invalid statement", t, func() {
+		Convey("Then ParseReader should fail", func() {
+			_, err := ParseReader(strings.NewReader("EVAL 1; SELECT ISTREAM a FROM; EVAL 2"))
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
+// endlessReader returns 'a' forever and counts the bytes read.
+type endlessReader struct {
+	n int
+}
+
+func (r *endlessReader) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = 'a'
+	}
+	r.n += len(p)
+	return len(p), nil
+}
@@ -0,0 +1,52 @@
+package parser
+
+// SourceStreams returns the distinct names of the streams the given
+// statement reads from in the order of their first appearance. The FROM
+// clauses of all SELECT statements in a UNION are taken into account.
+// Streams created by UDSFs in a FROM clause aren't included because the
+// streams a UDSF reads from are only known when it's created. For an
+// EXPLAIN statement, the streams of the wrapped statement are returned.
+// Statements not reading from streams return nil.
+func SourceStreams(stmt Statement) []string {
+	c := streamCollector{seen: map[string]bool{}}
+	switch s := stmt.(type) {
+	case SelectStmt:
+		c.addSelect(s)
+	case SelectUnionStmt:
+		for _, sel := range s.Selects {
+			c.addSelect(sel)
+		}
+	case CreateStreamAsSelectStmt:
+		c.addSelect(s.Select)
+	case CreateStreamAsSelectUnionStmt:
+		for _, sel := range s.Selects {
+			c.addSelect(sel)
+		}
+	case InsertIntoFromStmt:
+		c.add(string(s.Input))
+	case ExplainStmt:
+		return SourceStreams(s.Stmt)
+	}
+	return c.names
+}
+
+// streamCollector remembers each stream name given to add once.
+type streamCollector struct {
+	names []string
+	seen  map[string]bool
+}
+
+func (c *streamCollector) addSelect(s SelectStmt) {
+	for _, rel := range s.Relations {
+		if rel.Type == ActualStream {
+			c.add(rel.Name)
+		}
+	}
+}
+
+func (c *streamCollector) add(name string) {
+	if !c.seen[name] {
+		c.seen[name] = true
+		c.names = append(c.names, name)
+	}
+}
@@ -0,0 +1,87 @@
+package parser
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestSourceStreams(t *testing.T) {
+	Convey("Given a BQL parser", t, func() {
+		p := New()
+		parse := func(s string) Statement {
+			stmt, _, err := p.ParseStmt(s)
+			So(err, ShouldBeNil)
+			return stmt.(Statement)
+		}
+
+		Convey("When parsing a SELECT statement having a single source", func() {
+			stmt := parse(`SELECT ISTREAM * FROM s [RANGE 1 TUPLES]`)
+
+			Convey("Then SourceStreams should return the source", func() {
+				So(SourceStreams(stmt), ShouldResemble, []string{"s"})
+			})
+		})
+
+		Convey("When parsing a two-way join", func() {
+			stmt := parse(`CREATE STREAM j AS SELECT ISTREAM a:x, b:y
+				FROM s1 [RANGE 1 TUPLES] AS a, s2 [RANGE 2 SECONDS] AS b
+				WHERE a:id = b:id`)
+
+			Convey("Then SourceStreams should return both sources", func() {
+				So(SourceStreams(stmt), ShouldResemble, []string{"s1", "s2"})
+			})
+		})
+
+		Convey("When parsing a self join", func() {
+			stmt := parse(`SELECT ISTREAM a:x FROM s [RANGE 1 TUPLES] AS a, s [RANGE 2 TUPLES] AS b`)
+
+			Convey("Then the source should be returned once", func() {
+				So(SourceStreams(stmt), ShouldResemble, []string{"s"})
+			})
+		})
+
+		Convey("When parsing a UNION of SELECT statements", func() {
+			stmt := parse(`CREATE STREAM u AS
+				SELECT ISTREAM x FROM s1 [RANGE 1 TUPLES]
+				UNION ALL SELECT ISTREAM x FROM s2 [RANGE 1 TUPLES], s1 [RANGE 1 TUPLES] AS c
+				UNION ALL SELECT ISTREAM x FROM s3 [RANGE 1 TUPLES]`)
+
+			Convey("Then SourceStreams should return the sources of all SELECTs", func() {
+				So(SourceStreams(stmt), ShouldResemble, []string{"s1", "s2", "s3"})
+			})
+		})
+
+		Convey("When parsing a statement reading from a UDSF", func() {
+			stmt := parse(`SELECT ISTREAM a:x FROM s [RANGE 1 TUPLES] AS a,
+				my_udsf("t", 2) [RANGE 1 TUPLES] AS b`)
+
+			Convey("Then the UDSF shouldn't be returned", func() {
+				So(SourceStreams(stmt), ShouldResemble, []string{"s"})
+			})
+		})
+
+		Convey("When parsing an INSERT INTO statement", func() {
+			stmt := parse(`INSERT INTO snk FROM s`)
+
+			Convey("Then SourceStreams should return the input", func() {
+				So(SourceStreams(stmt), ShouldResemble, []string{"s"})
+			})
+		})
+
+		Convey("When parsing an EXPLAIN statement", func() {
+			stmt := parse(`EXPLAIN SELECT ISTREAM a:x FROM s1 [RANGE 1 TUPLES] AS a, s2 [RANGE 1 TUPLES] AS b`)
+
+			Convey("Then SourceStreams should return the sources of the wrapped statement", func() {
+				So(SourceStreams(stmt), ShouldResemble, []string{"s1", "s2"})
+			})
+		})
+
+		Convey("When parsing a statement not reading from streams", func() {
+			stmt := parse(`CREATE SOURCE s TYPE t WITH a=1`)
+
+			Convey("Then SourceStreams should return nothing", func() {
+				So(SourceStreams(stmt), ShouldBeNil)
+			})
+		})
+	})
+}
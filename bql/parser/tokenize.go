@@ -0,0 +1,240 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+)
+
+// TokenKind is the kind of a Token returned from Tokenize.
+type TokenKind int
+
+const (
+	// KeywordToken is a reserved word such as SELECT or NULL.
+	KeywordToken TokenKind = iota
+	// IdentifierToken is a name of a stream, a function, a column etc.
+	IdentifierToken
+	// NumericToken is an integer or a float literal without sign.
+	NumericToken
+	// StringToken is a quoted or dollar-quoted string literal.
+	StringToken
+	// OperatorToken is an operator such as +, <=, ||, or ::. The : and
+	// . in column references and JSON paths, and the ? and : of
+	// placeholders are also operator tokens.
+	OperatorToken
+	// PunctuationToken is one of ( ) [ ] { } , and ;.
+	PunctuationToken
+	// CommentToken is a comment starting with -- and not including the
+	// line break that ends it.
+	CommentToken
+	// SpaceToken is a sequence of whitespace characters.
+	SpaceToken
+)
+
+func (k TokenKind) String() string {
+	switch k {
+	case KeywordToken:
+		return "Keyword"
+	case IdentifierToken:
+		return "Identifier"
+	case NumericToken:
+		return "Numeric"
+	case StringToken:
+		return "String"
+	case OperatorToken:
+		return "Operator"
+	case PunctuationToken:
+		return "Punctuation"
+	case CommentToken:
+		return "Comment"
+	case SpaceToken:
+		return "Space"
+	}
+	return "Unknown"
+}
+
+// Token is a lexical unit of a BQL string. Begin and End are the offsets
+// (counted in runes, like the positions used by the parser) of the first
+// rune of the token and the rune right after it, respectively.
+type Token struct {
+	Kind  TokenKind
+	Text  string
+	Begin int
+	End   int
+}
+
+// bqlKeywords contains the words that are keywords in some part of the
+// grammar. The context dependent words of count-based sampling (ST, ND,
+// RD, TH) are not included.
+var bqlKeywords = map[string]bool{}
+
+func init() {
+	for _, k := range strings.Fields(`ALL AND AS ASC BUFFER BY CASE CAST
+		CREATE DESC DISTINCT DROP DSTREAM ELSE END EVAL EVERY EXISTS EXPLAIN FALSE FROM FULL
+		GROUP HAVING IF IN INSERT INTERVAL INTO IS ISTREAM LIMIT LOAD
+		MILLISECONDS MISSING NEWEST NOT NULL OLDEST ON OR ORDER PAUSE PAUSED
+		RANGE RESUME REWIND RSTREAM SAMPLE SAVE SECONDS SELECT SET SINK SIZE
+		SOURCE STATE STREAM TAG THEN TRUE TUPLE TUPLES TYPE UNION UNPAUSED
+		UPDATE WAIT WHEN WHERE WITH`) {
+		bqlKeywords[k] = true
+	}
+}
+
+// bqlOperators contains operators ordered so that longer ones come
+// before their prefixes.
+var bqlOperators = []string{
+	"::", "||", "<=", ">=", "!=", "<>", "!~", "..",
+	"=", "<", ">", "+", "-", "*", "/", "%", "~", ":", ".", "?",
+}
+
+// Tokenizer splits a BQL string into tokens. It is meant to be used by
+// tools such as editors and linters that need tokens rather than an
+// AST, so it doesn't check whether the tokens form a valid statement.
+type Tokenizer struct {
+	// IncludeSpaces, if set to true, adds SpaceTokens to the result.
+	IncludeSpaces bool
+
+	// IncludeComments, if set to true, adds CommentTokens to the result.
+	IncludeComments bool
+}
+
+// Tokenize splits s into tokens omitting whitespace and comments. See
+// Tokenizer for details.
+func Tokenize(s string) ([]Token, error) {
+	return (&Tokenizer{}).Tokenize(s)
+}
+
+// Tokenize splits s into tokens. It returns an error when s contains a
+// character that cannot start a token or an unterminated string literal.
+func (t *Tokenizer) Tokenize(s string) ([]Token, error) {
+	buf := []rune(s)
+	tokens := []Token{}
+	for pos := 0; pos < len(buf); {
+		kind, end, err := nextToken(buf, pos)
+		if err != nil {
+			return nil, err
+		}
+		if (kind != SpaceToken || t.IncludeSpaces) &&
+			(kind != CommentToken || t.IncludeComments) {
+			tokens = append(tokens, Token{
+				Kind:  kind,
+				Text:  string(buf[pos:end]),
+				Begin: pos,
+				End:   end,
+			})
+		}
+		pos = end
+	}
+	return tokens, nil
+}
+
+// nextToken returns the kind of the token starting at pos and the offset
+// right after it.
+func nextToken(buf []rune, pos int) (TokenKind, int, error) {
+	r := buf[pos]
+	switch {
+	case isBQLSpace(r):
+		end := pos + 1
+		for end < len(buf) && isBQLSpace(buf[end]) {
+			end++
+		}
+		return SpaceToken, end, nil
+
+	case r == '-' && hasPrefixAt(buf, pos, "--"):
+		end := pos + 2
+		for end < len(buf) && buf[end] != '\r' && buf[end] != '\n' {
+			end++
+		}
+		return CommentToken, end, nil
+
+	case r == '"':
+		end := pos + 1
+		for end < len(buf) {
+			if buf[end] == '"' {
+				if !hasPrefixAt(buf, end, `""`) {
+					return StringToken, end + 1, nil
+				}
+				end++ // escaped double quote
+			}
+			end++
+		}
+		return 0, 0, fmt.Errorf("unterminated string literal at offset %v", pos)
+
+	case r == '$':
+		tagEnd := pos + 1
+		for tagEnd < len(buf) && isBQLIdentRune(buf[tagEnd]) {
+			tagEnd++
+		}
+		if tagEnd < len(buf) && buf[tagEnd] == '$' && !isBQLDigit(buf[pos+1]) {
+			delim := string(buf[pos : tagEnd+1])
+			for end := tagEnd + 1; end < len(buf); end++ {
+				if hasPrefixAt(buf, end, delim) {
+					return StringToken, end + len(delim), nil
+				}
+			}
+			return 0, 0, fmt.Errorf("unterminated string literal at offset %v", pos)
+		}
+
+	case isBQLDigit(r):
+		end := pos + 1
+		for end < len(buf) && isBQLDigit(buf[end]) {
+			end++
+		}
+		if end+1 < len(buf) && buf[end] == '.' && isBQLDigit(buf[end+1]) {
+			end++
+			for end < len(buf) && isBQLDigit(buf[end]) {
+				end++
+			}
+		}
+		return NumericToken, end, nil
+
+	case isBQLLetter(r):
+		end := pos + 1
+		for end < len(buf) && isBQLIdentRune(buf[end]) {
+			end++
+		}
+		if bqlKeywords[strings.ToUpper(string(buf[pos:end]))] {
+			return KeywordToken, end, nil
+		}
+		return IdentifierToken, end, nil
+
+	case strings.ContainsRune("()[]{},;", r):
+		return PunctuationToken, pos + 1, nil
+	}
+
+	for _, op := range bqlOperators {
+		if hasPrefixAt(buf, pos, op) {
+			return OperatorToken, pos + len(op), nil
+		}
+	}
+	return 0, 0, fmt.Errorf("unexpected character %q at offset %v", r, pos)
+}
+
+func isBQLSpace(r rune) bool {
+	return r == ' ' || r == '\t' || r == '\n' || r == '\r'
+}
+
+func isBQLLetter(r rune) bool {
+	return ('a' <= r && r <= 'z') || ('A' <= r && r <= 'Z')
+}
+
+func isBQLDigit(r rune) bool {
+	return '0' <= r && r <= '9'
+}
+
+func isBQLIdentRune(r rune) bool {
+	return isBQLLetter(r) || isBQLDigit(r) || r == '_'
+}
+
+// hasPrefixAt checks whether buf[pos:] starts with prefix, which must
+// only consist of ASCII characters.
+func hasPrefixAt(buf []rune, pos int, prefix string) bool {
+	if pos+len(prefix) > len(buf) {
+		return false
+	}
+	for i, c := range []byte(prefix) {
+		if buf[pos+i] != rune(c) {
+			return false
+		}
+	}
+	return true
+}
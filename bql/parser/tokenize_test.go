@@ -0,0 +1,169 @@
+package parser
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestTokenize(t *testing.T) {
+	Convey("Given a small SELECT statement", t, func() {
+		s := `SELECT ISTREAM a:x, f(b) AS y FROM s [RANGE 2.5 SECONDS] WHERE x >= -1 -- note
+AND name = "日本""語"`
+
+		Convey("When tokenizing it", func() {
+			tokens, err := Tokenize(s)
+			So(err, ShouldBeNil)
+
+			Convey("Then it should return the tokens with their offsets", func() {
+				So(tokens, ShouldResemble, []Token{
+					{KeywordToken, "SELECT", 0, 6},
+					{KeywordToken, "ISTREAM", 7, 14},
+					{IdentifierToken, "a", 15, 16},
+					{OperatorToken, ":", 16, 17},
+					{IdentifierToken, "x", 17, 18},
+					{PunctuationToken, ",", 18, 19},
+					{IdentifierToken, "f", 20, 21},
+					{PunctuationToken, "(", 21, 22},
+					{IdentifierToken, "b", 22, 23},
+					{PunctuationToken, ")", 23, 24},
+					{KeywordToken, "AS", 25, 27},
+					{IdentifierToken, "y", 28, 29},
+					{KeywordToken, "FROM", 30, 34},
+					{IdentifierToken, "s", 35, 36},
+					{PunctuationToken, "[", 37, 38},
+					{KeywordToken, "RANGE", 38, 43},
+					{NumericToken, "2.5", 44, 47},
+					{KeywordToken, "SECONDS", 48, 55},
+					{PunctuationToken, "]", 55, 56},
+					{KeywordToken, "WHERE", 57, 62},
+					{IdentifierToken, "x", 63, 64},
+					{OperatorToken, ">=", 65, 67},
+					{OperatorToken, "-", 68, 69},
+					{NumericToken, "1", 69, 70},
+					{KeywordToken, "AND", 79, 82},
+					{IdentifierToken, "name", 83, 87},
+					{OperatorToken, "=", 88, 89},
+					{StringToken, `"日本""語"`, 90, 97},
+				})
+			})
+
+			Convey("Then the offsets should point to the text of the tokens", func() {
+				rs := []rune(s)
+				for _, t := range tokens {
+					So(string(rs[t.Begin:t.End]), ShouldEqual, t.Text)
+				}
+			})
+		})
+
+		Convey("When tokenizing it including whitespace and comments", func() {
+			tokens, err := (&Tokenizer{IncludeSpaces: true, IncludeComments: true}).Tokenize(s)
+			So(err, ShouldBeNil)
+
+			Convey("Then the tokens should cover the whole string", func() {
+				str := ""
+				for _, t := range tokens {
+					str += t.Text
+				}
+				So(str, ShouldEqual, s)
+			})
+
+			Convey("Then the comment should be a separate token", func() {
+				i := 0
+				for tokens[i].Kind != CommentToken {
+					i++
+				}
+				So(tokens[i], ShouldResemble, Token{CommentToken, "-- note", 71, 78})
+				So(tokens[i+1], ShouldResemble, Token{SpaceToken, "\n", 78, 79})
+			})
+		})
+	})
+
+	Convey("Given statements with other tokens", t, func() {
+		Convey("When tokenizing keywords in lower case", func() {
+			tokens, err := Tokenize("select null is not missing")
+			So(err, ShouldBeNil)
+
+			Convey("Then they should be keywords", func() {
+				for _, t := range tokens {
+					So(t.Kind, ShouldEqual, KeywordToken)
+				}
+			})
+		})
+
+		Convey("When tokenizing an EXPLAIN statement", func() {
+			tokens, err := Tokenize("EXPLAIN SELECT a")
+			So(err, ShouldBeNil)
+
+			Convey("Then EXPLAIN should be a keyword", func() {
+				So(tokens, ShouldResemble, []Token{
+					{KeywordToken, "EXPLAIN", 0, 7},
+					{KeywordToken, "SELECT", 8, 14},
+					{IdentifierToken, "a", 15, 16},
+				})
+			})
+		})
+
+		Convey("When tokenizing operators and JSON paths", func() {
+			tokens, err := Tokenize(`a.b[0]..c::int || "x" <> 2`)
+			So(err, ShouldBeNil)
+
+			Convey("Then the longest operators should be used", func() {
+				texts := []string{}
+				for _, t := range tokens {
+					texts = append(texts, t.Text)
+				}
+				So(texts, ShouldResemble, []string{
+					"a", ".", "b", "[", "0", "]", "..", "c", "::", "int", "||", `"x"`, "<>", "2",
+				})
+			})
+		})
+
+		Convey("When tokenizing placeholders", func() {
+			tokens, err := Tokenize(`x = ? AND y = :name`)
+			So(err, ShouldBeNil)
+
+			Convey("Then they should be operators followed by names", func() {
+				So(tokens, ShouldResemble, []Token{
+					{IdentifierToken, "x", 0, 1},
+					{OperatorToken, "=", 2, 3},
+					{OperatorToken, "?", 4, 5},
+					{KeywordToken, "AND", 6, 9},
+					{IdentifierToken, "y", 10, 11},
+					{OperatorToken, "=", 12, 13},
+					{OperatorToken, ":", 14, 15},
+					{IdentifierToken, "name", 15, 19},
+				})
+			})
+		})
+
+		Convey("When tokenizing a dollar-quoted string", func() {
+			tokens, err := Tokenize(`$tag$it's $$ "quoted"$tag$ $$x$$`)
+			So(err, ShouldBeNil)
+
+			Convey("Then it should be a single token", func() {
+				So(tokens, ShouldResemble, []Token{
+					{StringToken, `$tag$it's $$ "quoted"$tag$`, 0, 26},
+					{StringToken, `$$x$$`, 27, 32},
+				})
+			})
+		})
+
+		Convey("When tokenizing an unterminated string", func() {
+			_, err := Tokenize(`SELECT "abc`)
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "offset 7")
+			})
+		})
+
+		Convey("When tokenizing an unknown character", func() {
+			_, err := Tokenize(`SELECT a @ b`)
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "offset 9")
+			})
+		})
+	})
+}
@@ -1,7 +1,9 @@
 package builtin
 
 import (
+	"fmt"
 	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
 	"gopkg.in/sensorbee/sensorbee.v0/data"
 )
 
@@ -22,3 +24,26 @@ var coalesceFunc udf.UDF = &variadicFunc{
 		return data.Null{}, nil
 	},
 }
+
+// nullifFunc returns NULL if both arguments are equal (as defined by
+// data.Equal) and the first argument otherwise.
+//
+// It can be used in BQL as `nullif`.
+//
+//  Input: 2 * Any
+//  Return Type: same as the first argument, or NULL
+var nullifFunc udf.UDF = &nullifFuncTmpl{}
+
+type nullifFuncTmpl struct {
+	twoParamFunc
+}
+
+func (f *nullifFuncTmpl) Call(ctx *core.Context, args ...data.Value) (data.Value, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf("function takes exactly two arguments")
+	}
+	if data.Equal(args[0], args[1]) {
+		return data.Null{}, nil
+	}
+	return args[0], nil
+}
@@ -62,3 +62,57 @@ func TestVariadicGeneralFuncs(t *testing.T) {
 		})
 	}
 }
+
+func TestBinaryGeneralFuncs(t *testing.T) {
+	udfBinaryTestCases := []udfBinaryTestCase{
+		{"nullif", nullifFunc, []udfBinaryTestCaseInput{
+			{data.Int(1), data.Int(1), data.Null{}},
+			{data.Int(1), data.Float(1.0), data.Null{}},
+			{data.String("a"), data.String("a"), data.Null{}},
+			{data.Int(1), data.Int(2), data.Int(1)},
+			{data.String("a"), data.String("b"), data.String("a")},
+			{data.String(""), data.Null{}, data.String("")},
+			{data.Null{}, data.Int(1), data.Null{}},
+			{data.Array{data.Int(1)}, data.Array{data.Int(1)}, data.Null{}},
+			{data.Map{"a": data.Int(1)}, data.Map{"a": data.Int(2)}, data.Map{"a": data.Int(1)}},
+		}},
+	}
+
+	for _, testCase := range udfBinaryTestCases {
+		f := testCase.f
+		allInputs := testCase.inputs
+
+		Convey(fmt.Sprintf("Given the %s function", testCase.name), t, func() {
+			for _, tc := range allInputs {
+				tc := tc
+
+				Convey(fmt.Sprintf("When evaluating it on %s (%T) and %s (%T)",
+					tc.input1, tc.input1, tc.input2, tc.input2), func() {
+					val, err := f.Call(nil, tc.input1, tc.input2)
+
+					Convey(fmt.Sprintf("Then the result should be %s", tc.expected), func() {
+						So(err, ShouldBeNil)
+						So(val, ShouldResemble, tc.expected)
+					})
+				})
+			}
+
+			Convey("Then it should only accept two arguments", func() {
+				So(f.Accept(1), ShouldBeFalse)
+				So(f.Accept(2), ShouldBeTrue)
+				So(f.Accept(3), ShouldBeFalse)
+			})
+
+			Convey("Then it should equal the one in the default registry", func() {
+				regFun, err := udf.CopyGlobalUDFRegistry(nil).Lookup(testCase.name, 2)
+				So(err, ShouldBeNil)
+				So(regFun, ShouldHaveSameTypeAs, f)
+			})
+
+			Convey("Then the default registry should reject a wrong number of arguments", func() {
+				_, err := udf.CopyGlobalUDFRegistry(nil).Lookup(testCase.name, 3)
+				So(err, ShouldNotBeNil)
+			})
+		})
+	}
+}
@@ -82,4 +82,5 @@ func init() {
 	udf.RegisterGlobalUDF("blob_to_raw_string", udf.MustConvertGeneric(blobToRawString))
 	// other functions
 	udf.RegisterGlobalUDF("coalesce", coalesceFunc)
+	udf.RegisterGlobalUDF("nullif", nullifFunc)
 }
@@ -177,7 +177,7 @@ func (fr *defaultFunctionRegistry) Register(name string, f UDF) error {
 	// words, so we need to add exceptions for them
 	switch lowerName {
 	case "count", "avg", "max", "min", "sum",
-		"coalesce", "lower", "upper", "octet_length",
+		"coalesce", "nullif", "lower", "upper", "octet_length",
 		"substring":
 		// skip check
 	default:
@@ -75,6 +75,54 @@ type UDSStorageWriter interface {
 	Abort() error
 }
 
+// MigrateUDSStorage copies all states saved in from, including all of their
+// tags, to to. It can be used to switch a storage to another one without
+// losing states, e.g., from the in-memory storage to the filesystem storage.
+// A destination such as the filesystem storage is expected to validate its
+// parameters (e.g. the target directory) when it's created. States already
+// existing in to are overwritten when they have the same topology name,
+// state name, and tag. When an error occurs, states copied so far remain in
+// to.
+func MigrateUDSStorage(from, to UDSStorage) error {
+	ts, err := from.ListTopologies()
+	if err != nil {
+		return fmt.Errorf("cannot list topologies: %v", err)
+	}
+	for _, topology := range ts {
+		states, err := from.List(topology)
+		if err != nil {
+			return fmt.Errorf("cannot list states in topology '%v': %v", topology, err)
+		}
+		for state, tags := range states {
+			for _, tag := range tags {
+				if err := copyUDSState(from, to, topology, state, tag); err != nil {
+					return fmt.Errorf("cannot migrate the state '%v' having the tag '%v' in topology '%v': %v",
+						state, tag, topology, err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func copyUDSState(from, to UDSStorage, topology, state, tag string) error {
+	r, err := from.Load(topology, state, tag)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	w, err := to.Save(topology, state, tag)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(w, r); err != nil {
+		w.Abort()
+		return err
+	}
+	return w.Commit()
+}
+
 type inMemoryUDSStorage struct {
 	m          sync.RWMutex
 	topologies map[string]*topologyUDSStorage
@@ -207,3 +207,40 @@ func TestInMemoryUDSStorage(t *testing.T) {
 		})
 	})
 }
+
+func TestMigrateUDSStorage(t *testing.T) {
+	Convey("Given two in memory UDSStorages", t, func() {
+		from := NewInMemoryUDSStorage()
+		to := NewInMemoryUDSStorage()
+		for _, state := range []string{"state1", "state2", "state3"} {
+			w, err := from.Save("test_topology", state, "")
+			So(err, ShouldBeNil)
+			_, err = io.WriteString(w, state+"_data")
+			So(err, ShouldBeNil)
+			So(w.Commit(), ShouldBeNil)
+		}
+
+		Convey("When migrating states from one to the other", func() {
+			So(MigrateUDSStorage(from, to), ShouldBeNil)
+
+			Convey("Then the destination should have all states", func() {
+				st, err := to.List("test_topology")
+				So(err, ShouldBeNil)
+				So(len(st), ShouldEqual, 3)
+				for _, state := range []string{"state1", "state2", "state3"} {
+					r, err := to.Load("test_topology", state, "")
+					So(err, ShouldBeNil)
+					data, err := ioutil.ReadAll(r)
+					So(err, ShouldBeNil)
+					So(string(data), ShouldEqual, state+"_data")
+				}
+			})
+
+			Convey("Then the source should still have all states", func() {
+				st, err := from.List("test_topology")
+				So(err, ShouldBeNil)
+				So(len(st), ShouldEqual, 3)
+			})
+		})
+	})
+}
@@ -0,0 +1,120 @@
+package client
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"sync"
+)
+
+// ErrNotModified is returned from Requester.Do and Requester.DoWithRequest
+// with the Response cached by WithETagCache when the server responds with
+// 304 Not Modified.
+var ErrNotModified = errors.New("the resource isn't modified")
+
+// WithETagCache makes a Requester remember the body of a response to a GET
+// request having an ETag header and send the ETag in If-None-Match header of
+// the subsequent GET requests to the same URL. When the server responds
+// with 304 Not Modified, Do returns ErrNotModified with a Response having
+// the remembered body, so a client polling an endpoint can reuse the value
+// it already has:
+//
+//	res, err := r.Do(client.Get, "/topologies/t", nil)
+//	if err != nil && err != client.ErrNotModified {
+//		return err
+//	}
+//	// res has the latest body in either case
+//
+// A request which already has If-None-Match header is sent as it is. Stream
+// responses aren't cached. Bodies are kept for each URL until the Requester
+// is discarded, so this option should only be used for a limited set of
+// endpoints.
+func WithETagCache() RequesterOption {
+	return func(r *Requester) {
+		r.etags = &etagCache{
+			entries: map[string]*etagEntry{},
+		}
+	}
+}
+
+// etagCache is a thread-safe cache of responses having ETags.
+type etagCache struct {
+	m       sync.RWMutex
+	entries map[string]*etagEntry
+}
+
+type etagEntry struct {
+	etag   string
+	header http.Header
+	body   []byte
+}
+
+func (c *etagCache) get(url string) *etagEntry {
+	c.m.RLock()
+	defer c.m.RUnlock()
+	return c.entries[url]
+}
+
+func (c *etagCache) set(url string, e *etagEntry) {
+	c.m.Lock()
+	defer c.m.Unlock()
+	c.entries[url] = e
+}
+
+// prepareConditionalRequest adds If-None-Match header to req when the
+// Requester has a cached response for it. It returns the cache entry used,
+// which is nil when req isn't a conditional request.
+func (r *Requester) prepareConditionalRequest(req *http.Request) *etagEntry {
+	if r.etags == nil || req.Method != "GET" || req.Header.Get("If-None-Match") != "" {
+		return nil
+	}
+	e := r.etags.get(req.URL.String())
+	if e == nil {
+		return nil
+	}
+	req.Header.Set("If-None-Match", e.etag)
+	return e
+}
+
+// handleConditionalResponse returns the cached response when the server
+// responded with 304 Not Modified to a conditional request. Otherwise, it
+// caches res when it has an ETag.
+func (r *Requester) handleConditionalResponse(req *http.Request, res *Response, cached *etagEntry) (*Response, error) {
+	if r.etags == nil || req.Method != "GET" {
+		return res, nil
+	}
+
+	if res.Raw.StatusCode == http.StatusNotModified && cached != nil {
+		res.Raw.Body.Close()
+		raw := *res.Raw
+		raw.Header = http.Header{}
+		for k, v := range cached.header {
+			raw.Header[k] = v
+		}
+		for k, v := range res.Raw.Header {
+			raw.Header[k] = v
+		}
+		raw.Body = ioutil.NopCloser(bytes.NewReader(cached.body))
+		return &Response{Raw: &raw}, ErrNotModified
+	}
+
+	etag := res.Raw.Header.Get("ETag")
+	if etag == "" || res.Raw.StatusCode != http.StatusOK || res.IsStream() {
+		return res, nil
+	}
+	body, err := res.Body()
+	if err != nil {
+		return nil, err
+	}
+	r.etags.set(req.URL.String(), &etagEntry{
+		etag:   etag,
+		header: res.Raw.Header,
+		body:   body,
+	})
+	// Body has consumed the original body, so the response is recreated
+	// with the cached one so that the caller can read it.
+	raw := *res.Raw
+	raw.Body = ioutil.NopCloser(bytes.NewReader(body))
+	return &Response{Raw: &raw}, nil
+}
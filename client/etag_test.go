@@ -0,0 +1,126 @@
+package client
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+// etagServer responds with 304 when If-None-Match has the current ETag and
+// records If-None-Match header of each request.
+type etagServer struct {
+	m           sync.Mutex
+	etag        string
+	body        string
+	ifNoneMatch []string
+}
+
+func (s *etagServer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	inm := req.Header.Get("If-None-Match")
+	s.ifNoneMatch = append(s.ifNoneMatch, inm)
+	w.Header().Set("ETag", s.etag)
+	if inm == s.etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write([]byte(s.body))
+}
+
+func TestRequesterETagCache(t *testing.T) {
+	Convey("Given a server supporting ETags and a requester with the ETag cache", t, func() {
+		es := &etagServer{etag: `"v1"`, body: `{"value":1}`}
+		s := httptest.NewServer(es)
+		Reset(s.Close)
+		r, err := NewRequester(s.URL, "v1", WithETagCache())
+		So(err, ShouldBeNil)
+
+		Convey("When getting a resource", func() {
+			res, err := r.Do(Get, "/topologies/t", nil)
+			So(err, ShouldBeNil)
+			defer res.Close()
+
+			Convey("Then the body should be readable", func() {
+				v := struct{ Value int }{}
+				So(res.ReadJSON(&v), ShouldBeNil)
+				So(v.Value, ShouldEqual, 1)
+			})
+
+			Convey("Then it should be sent without If-None-Match", func() {
+				So(es.ifNoneMatch, ShouldResemble, []string{""})
+			})
+
+			Convey("And getting it again", func() {
+				res, err := r.Do(Get, "/topologies/t", nil)
+				So(err, ShouldEqual, ErrNotModified)
+				defer res.Close()
+
+				Convey("Then it should be sent with If-None-Match", func() {
+					So(es.ifNoneMatch, ShouldResemble, []string{"", `"v1"`})
+				})
+
+				Convey("Then the response should have the cached body", func() {
+					So(res.Raw.StatusCode, ShouldEqual, http.StatusNotModified)
+					So(res.IsError(), ShouldBeFalse)
+					v := struct{ Value int }{}
+					So(res.ReadJSON(&v), ShouldBeNil)
+					So(v.Value, ShouldEqual, 1)
+				})
+			})
+
+			Convey("And getting it again after it's modified", func() {
+				es.etag = `"v2"`
+				es.body = `{"value":2}`
+				res, err := r.Do(Get, "/topologies/t", nil)
+				So(err, ShouldBeNil)
+				defer res.Close()
+
+				Convey("Then the response should have the new body", func() {
+					v := struct{ Value int }{}
+					So(res.ReadJSON(&v), ShouldBeNil)
+					So(v.Value, ShouldEqual, 2)
+				})
+
+				Convey("Then the new ETag should be sent next time", func() {
+					_, err := r.Do(Get, "/topologies/t", nil)
+					So(err, ShouldEqual, ErrNotModified)
+					So(es.ifNoneMatch, ShouldResemble, []string{"", `"v1"`, `"v2"`})
+				})
+			})
+
+			Convey("And getting another resource", func() {
+				res, err := r.Do(Get, "/topologies/u", nil)
+				So(err, ShouldBeNil)
+				defer res.Close()
+
+				Convey("Then it should be sent without If-None-Match", func() {
+					So(es.ifNoneMatch, ShouldResemble, []string{"", ""})
+				})
+			})
+		})
+	})
+
+	Convey("Given a server supporting ETags and a requester without the ETag cache", t, func() {
+		es := &etagServer{etag: `"v1"`, body: `{"value":1}`}
+		s := httptest.NewServer(es)
+		Reset(s.Close)
+		r, err := NewRequester(s.URL, "v1")
+		So(err, ShouldBeNil)
+
+		Convey("When getting a resource twice", func() {
+			for i := 0; i < 2; i++ {
+				res, err := r.Do(Get, "/topologies/t", nil)
+				So(err, ShouldBeNil)
+				res.Close()
+			}
+
+			Convey("Then If-None-Match should not be sent", func() {
+				So(es.ifNoneMatch, ShouldResemble, []string{"", ""})
+			})
+		})
+	})
+}
@@ -0,0 +1,88 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// WithLogReconnect makes TailLogs of a Requester reconnect to the server
+// after waiting for interval when the connection is closed or cannot be
+// established. Error responses from the server aren't retried.
+func WithLogReconnect(interval time.Duration) RequesterOption {
+	return func(r *Requester) {
+		r.logReconnect = true
+		r.logReconnectInterval = interval
+	}
+}
+
+// TailLogs streams log entries written by the server from /logs and calls
+// handler with each entry, which has "time", "level", and "msg" fields in
+// addition to the fields of the log, until ctx is canceled or the server
+// closes the connection. Only entries written after the connection is
+// established are sent. When the Requester has WithLogReconnect option, it
+// reconnects instead of returning when the connection is lost. TailLogs
+// returns ctx.Err() when ctx is canceled.
+func (r *Requester) TailLogs(ctx context.Context, handler func(entry data.Map)) error {
+	for {
+		retryable, err := r.tailLogs(ctx, handler)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if !r.logReconnect || !retryable {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(r.logReconnectInterval):
+		}
+	}
+}
+
+// tailLogs reads log entries until the stream ends. It returns whether
+// connecting again might succeed with the error.
+func (r *Requester) tailLogs(ctx context.Context, handler func(entry data.Map)) (bool, error) {
+	req, err := r.NewRequest(Get, "/logs", nil)
+	if err != nil {
+		return false, err
+	}
+	res, err := r.DoWithRequest(req.WithContext(ctx))
+	if err != nil {
+		return true, err
+	}
+	defer res.Close()
+
+	if res.IsError() {
+		e, err := res.Error()
+		if err != nil {
+			return false, fmt.Errorf("the server returned an error response (status %v): %v", res.Raw.StatusCode, err)
+		}
+		return false, fmt.Errorf("the server returned an error: %v", e.Message)
+	}
+
+	ch, err := res.ReadStreamJSON()
+	if err != nil {
+		return false, err
+	}
+
+	for js := range ch {
+		if ctx.Err() != nil {
+			// entries which have already been read are discarded
+			return false, ctx.Err()
+		}
+		m, ok := js.(map[string]interface{})
+		if !ok {
+			return false, fmt.Errorf("a log entry must be a JSON object: %v", js)
+		}
+		entry, err := data.NewMap(m)
+		if err != nil {
+			return false, fmt.Errorf("cannot convert a log entry: %v", err)
+		}
+		handler(entry)
+	}
+	return true, res.StreamError()
+}
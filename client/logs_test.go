@@ -0,0 +1,149 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/textproto"
+	"sync"
+	"testing"
+	"time"
+)
+
+// logServer sends entries log entries to each request and ends the stream.
+// When block is true, it doesn't end the stream but waits until the client
+// disconnects instead.
+type logServer struct {
+	m        sync.Mutex
+	entries  int
+	block    bool
+	requests int
+}
+
+func (s *logServer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	s.m.Lock()
+	s.requests++
+	n := s.requests
+	s.m.Unlock()
+	mw := multipart.NewWriter(w)
+	w.Header().Set("Content-Type", fmt.Sprintf(`multipart/mixed; boundary="%v"`, mw.Boundary()))
+	w.WriteHeader(http.StatusOK)
+	w.(http.Flusher).Flush()
+
+	header := textproto.MIMEHeader{}
+	header.Add("Content-Type", "application/json")
+	for i := 0; i < s.entries; i++ {
+		// without Content-Length, the client cannot know the end of the
+		// part until the next boundary arrives
+		js := fmt.Sprintf(`{"level":"info","msg":"log %v","conn":%v}`, i, n)
+		header.Set("Content-Length", fmt.Sprint(len(js)))
+		part, err := mw.CreatePart(header)
+		if err != nil {
+			return
+		}
+		io.WriteString(part, js)
+		w.(http.Flusher).Flush()
+	}
+	if s.block {
+		<-req.Context().Done()
+		return
+	}
+	mw.Close()
+}
+
+func TestRequesterTailLogs(t *testing.T) {
+	Convey("Given a server emitting a few log entries then closing", t, func() {
+		ls := &logServer{entries: 3}
+		s := httptest.NewServer(ls)
+		Reset(s.Close)
+
+		Convey("When tailing logs", func() {
+			r, err := NewRequester(s.URL, "v1")
+			So(err, ShouldBeNil)
+			var entries []data.Map
+			err = r.TailLogs(context.Background(), func(e data.Map) {
+				entries = append(entries, e)
+			})
+
+			Convey("Then the handler should receive all entries", func() {
+				So(err, ShouldBeNil)
+				So(len(entries), ShouldEqual, 3)
+				So(entries[0], ShouldResemble, data.Map{
+					"level": data.String("info"),
+					"msg":   data.String("log 0"),
+					"conn":  data.Float(1),
+				})
+				So(entries[2]["msg"], ShouldEqual, data.String("log 2"))
+			})
+		})
+
+		Convey("When tailing logs with reconnection", func() {
+			r, err := NewRequester(s.URL, "v1", WithLogReconnect(time.Millisecond))
+			So(err, ShouldBeNil)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			var entries []data.Map
+			err = r.TailLogs(ctx, func(e data.Map) {
+				entries = append(entries, e)
+				if len(entries) == 7 {
+					cancel()
+				}
+			})
+
+			Convey("Then it should reconnect until the context is canceled", func() {
+				So(err, ShouldEqual, context.Canceled)
+				So(len(entries), ShouldEqual, 7)
+				So(entries[6]["conn"], ShouldEqual, data.Float(3))
+			})
+		})
+	})
+
+	Convey("Given a server streaming logs without closing", t, func() {
+		ls := &logServer{entries: 2, block: true}
+		s := httptest.NewServer(ls)
+		Reset(s.Close)
+		r, err := NewRequester(s.URL, "v1")
+		So(err, ShouldBeNil)
+
+		Convey("When canceling the context while tailing logs", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			n := 0
+			err := r.TailLogs(ctx, func(e data.Map) {
+				n++
+				if n == 2 {
+					cancel()
+				}
+			})
+
+			Convey("Then it should return the error of the context", func() {
+				So(err, ShouldEqual, context.Canceled)
+				So(n, ShouldEqual, 2)
+			})
+		})
+	})
+
+	Convey("Given a server without the logs endpoint", t, func() {
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusNotFound)
+			io.WriteString(w, `{"error":{"code":"E0000","message":"not found"}}`)
+		}))
+		Reset(s.Close)
+
+		Convey("When tailing logs with reconnection", func() {
+			r, err := NewRequester(s.URL, "v1", WithLogReconnect(time.Millisecond))
+			So(err, ShouldBeNil)
+			err = r.TailLogs(context.Background(), func(data.Map) {})
+
+			Convey("Then it should fail without retrying", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "not found")
+			})
+		})
+	})
+}
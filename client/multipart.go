@@ -0,0 +1,66 @@
+package client
+
+import (
+	"io"
+	"mime/multipart"
+	"net/http"
+	"path"
+	"sort"
+)
+
+// PostMultipart sends a POST request having a multipart/form-data body
+// consisting of fields and files. A key of files is used as both the name of
+// the form field and the filename of the part. Files are streamed to the
+// server while the request is being sent, so they aren't buffered in
+// memory. The caller has to close the body of the response.
+func (r *Requester) PostMultipart(apiPath string, fields map[string]string, files map[string]io.Reader) (*Response, error) {
+	pr, pw := io.Pipe()
+	mw := multipart.NewWriter(pw)
+
+	go func() {
+		pw.CloseWithError(writeMultipart(mw, fields, files))
+	}()
+
+	req, err := http.NewRequest("POST", r.url+path.Join(r.prefix, apiPath), pr)
+	if err != nil {
+		pr.Close()
+		return nil, err
+	}
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+	res, err := r.DoWithRequest(req)
+
+	// make sure that the goroutine writing the body finishes even if the
+	// request failed before the body was fully sent
+	pr.Close()
+	return res, err
+}
+
+func writeMultipart(mw *multipart.Writer, fields map[string]string, files map[string]io.Reader) error {
+	// parts are written in a deterministic order
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := mw.WriteField(name, fields[name]); err != nil {
+			return err
+		}
+	}
+
+	names = names[:0]
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		w, err := mw.CreateFormFile(name, name)
+		if err != nil {
+			return err
+		}
+		if _, err := io.Copy(w, files[name]); err != nil {
+			return err
+		}
+	}
+	return mw.Close()
+}
@@ -0,0 +1,62 @@
+package client
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestPostMultipart(t *testing.T) {
+	Convey("Given a server receiving multipart requests", t, func() {
+		var (
+			path     string
+			fields   map[string][]string
+			filename string
+			content  string
+		)
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			path = req.URL.Path
+			if err := req.ParseMultipartForm(1 << 20); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			fields = req.MultipartForm.Value
+			if fhs := req.MultipartForm.File["script"]; len(fhs) > 0 {
+				filename = fhs[0].Filename
+				f, err := fhs[0].Open()
+				if err == nil {
+					b, _ := ioutil.ReadAll(f)
+					f.Close()
+					content = string(b)
+				}
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{}`))
+		}))
+		Reset(s.Close)
+		r, err := NewRequester(s.URL, "v1")
+		So(err, ShouldBeNil)
+
+		Convey("When uploading a file and a text field", func() {
+			res, err := r.PostMultipart("/topologies/test/files", map[string]string{
+				"name": "my_script",
+			}, map[string]io.Reader{
+				"script": strings.NewReader("SELECT RSTREAM * FROM s [RANGE 1 TUPLES];"),
+			})
+			So(err, ShouldBeNil)
+			defer res.Close()
+
+			Convey("Then the server should receive both parts", func() {
+				So(res.Raw.StatusCode, ShouldEqual, http.StatusOK)
+				So(path, ShouldEqual, "/api/v1/topologies/test/files")
+				So(fields["name"], ShouldResemble, []string{"my_script"})
+				So(filename, ShouldEqual, "script")
+				So(content, ShouldEqual, "SELECT RSTREAM * FROM s [RANGE 1 TUPLES];")
+			})
+		})
+	})
+}
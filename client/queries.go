@@ -0,0 +1,73 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+// QueryIDHeader is the name of the header having the ID of a SELECT query
+// streaming its results. The ID can be passed to Requester.StopQuery.
+const QueryIDHeader = "SensorBee-Query-ID"
+
+// QueryID returns the ID of the SELECT query which is sending the response
+// as a stream. It returns an empty string when the response doesn't have
+// the ID, e.g. when it's a response of another statement or the server
+// doesn't support stopping queries.
+func (r *Response) QueryID() string {
+	return r.Raw.Header.Get(QueryIDHeader)
+}
+
+// StreamQuery issues a SELECT statement to the topology and returns the
+// response streaming its results, which can be read by
+// Response.ReadStreamJSON, and the ID of the query. The query keeps running on
+// the server until the response is closed or StopQuery is called with the
+// ID. The caller must close the response.
+func (r *Requester) StreamQuery(topology, query string) (*Response, string, error) {
+	res, err := r.Do(Post, queriesPath(topology), map[string]interface{}{
+		"queries": query,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	if res.IsError() {
+		defer res.Close()
+		return nil, "", errorResponse(res)
+	}
+	if !res.IsStream() {
+		res.Close()
+		return nil, "", errors.New("the query didn't return a stream")
+	}
+	return res, res.QueryID(), nil
+}
+
+// StopQuery stops the SELECT query having queryID on the topology. The server
+// finishes the stream of the query, so the channel returned from
+// Response.ReadStreamJSON will be closed.
+func (r *Requester) StopQuery(topology, queryID string) error {
+	if queryID == "" {
+		return errors.New("the query ID is empty")
+	}
+	res, err := r.Do(Delete, queriesPath(topology)+"/"+url.PathEscape(queryID), nil)
+	if err != nil {
+		return err
+	}
+	defer res.Close()
+	if res.IsError() {
+		return errorResponse(res)
+	}
+	return nil
+}
+
+func queriesPath(topology string) string {
+	return "/topologies/" + url.PathEscape(topology) + "/queries"
+}
+
+// errorResponse converts an error response to an error.
+func errorResponse(res *Response) error {
+	e, err := res.Error()
+	if err != nil {
+		return fmt.Errorf("the server returned an error response (status %v): %v", res.Raw.StatusCode, err)
+	}
+	return fmt.Errorf("the server returned an error: %v", e.Message)
+}
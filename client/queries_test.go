@@ -0,0 +1,145 @@
+package client
+
+import (
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/textproto"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// queryServer streams a tuple for a SELECT query and keeps the stream open
+// until the query is stopped by a DELETE request.
+type queryServer struct {
+	m       sync.Mutex
+	stopped chan struct{}
+	stops   []string
+}
+
+const testQueryID = "0123456789abcdef"
+
+func (s *queryServer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	switch {
+	case req.Method == "POST" && req.URL.Path == "/api/v1/topologies/t/queries":
+		mw := multipart.NewWriter(w)
+		w.Header().Set("Content-Type", fmt.Sprintf(`multipart/mixed; boundary="%v"`, mw.Boundary()))
+		w.Header().Set(QueryIDHeader, testQueryID)
+		w.WriteHeader(http.StatusOK)
+
+		header := textproto.MIMEHeader{}
+		header.Add("Content-Type", "application/json")
+		js := `{"a":1}`
+		header.Set("Content-Length", fmt.Sprint(len(js)))
+		part, err := mw.CreatePart(header)
+		if err != nil {
+			return
+		}
+		io.WriteString(part, js)
+		w.(http.Flusher).Flush()
+
+		select {
+		case <-s.stopped:
+			mw.Close()
+		case <-req.Context().Done():
+		}
+
+	case req.Method == "DELETE" && strings.HasPrefix(req.URL.Path, "/api/v1/topologies/t/queries/"):
+		id := strings.TrimPrefix(req.URL.Path, "/api/v1/topologies/t/queries/")
+		s.m.Lock()
+		defer s.m.Unlock()
+		s.stops = append(s.stops, id)
+		w.Header().Set("Content-Type", "application/json")
+		if id != testQueryID {
+			w.WriteHeader(http.StatusNotFound)
+			io.WriteString(w, `{"error":{"code":"E0001","message":"The query was not found"}}`)
+			return
+		}
+		close(s.stopped)
+		io.WriteString(w, `{}`)
+
+	default:
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		io.WriteString(w, `{"error":{"code":"E0000","message":"bad request"}}`)
+	}
+}
+
+func TestRequesterStopQuery(t *testing.T) {
+	Convey("Given a server streaming a query until it's stopped", t, func() {
+		qs := &queryServer{stopped: make(chan struct{})}
+		s := httptest.NewServer(qs)
+		Reset(s.Close)
+		r, err := NewRequester(s.URL, "v1")
+		So(err, ShouldBeNil)
+
+		Convey("When starting a query", func() {
+			res, id, err := r.StreamQuery("t", "SELECT RSTREAM * FROM s [RANGE 1 TUPLES];")
+			So(err, ShouldBeNil)
+			defer res.Close()
+			ch, err := res.ReadStreamJSON()
+			So(err, ShouldBeNil)
+
+			Convey("Then it should return the query ID", func() {
+				So(id, ShouldEqual, testQueryID)
+				So(res.QueryID(), ShouldEqual, testQueryID)
+			})
+
+			Convey("Then it should stream results", func() {
+				So(<-ch, ShouldResemble, map[string]interface{}{"a": 1.0})
+			})
+
+			Convey("And stopping it", func() {
+				<-ch
+				So(r.StopQuery("t", id), ShouldBeNil)
+
+				Convey("Then the server should receive the stop request", func() {
+					qs.m.Lock()
+					defer qs.m.Unlock()
+					So(qs.stops, ShouldResemble, []string{testQueryID})
+				})
+
+				Convey("Then the stream should end", func() {
+					select {
+					case _, ok := <-ch:
+						So(ok, ShouldBeFalse)
+					case <-time.After(5 * time.Second):
+						So("the stream wasn't closed", ShouldBeEmpty)
+					}
+				})
+			})
+		})
+
+		Convey("When stopping a query which isn't running", func() {
+			err := r.StopQuery("t", "unknown")
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "The query was not found")
+			})
+		})
+
+		Convey("When stopping a query without an ID", func() {
+			err := r.StopQuery("t", "")
+
+			Convey("Then it should fail without sending a request", func() {
+				So(err, ShouldNotBeNil)
+				So(qs.stops, ShouldBeEmpty)
+			})
+		})
+
+		Convey("When starting a query on a missing topology", func() {
+			_, _, err := r.StreamQuery("u", "SELECT RSTREAM * FROM s [RANGE 1 TUPLES];")
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "bad request")
+			})
+		})
+	})
+}
@@ -0,0 +1,69 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// tokenBucket is a thread-safe token bucket rate limiter.
+type tokenBucket struct {
+	m        sync.Mutex
+	interval time.Duration // time to add a token
+	burst    float64
+	tokens   float64 // can be negative when requests are waiting
+	last     time.Time
+}
+
+func newTokenBucket(rps float64, burst int) *tokenBucket {
+	if burst < 1 {
+		burst = 1
+	}
+	return &tokenBucket{
+		interval: time.Duration(float64(time.Second) / rps),
+		burst:    float64(burst),
+		tokens:   float64(burst),
+		last:     time.Now(),
+	}
+}
+
+// wait blocks until a token is available or ctx is done. A token reserved
+// by a canceled call is given back to the bucket.
+func (b *tokenBucket) wait(ctx context.Context) error {
+	d := b.reserve()
+	if d <= 0 {
+		return nil
+	}
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		b.m.Lock()
+		b.tokens++
+		b.m.Unlock()
+		return ctx.Err()
+	}
+}
+
+// reserve takes a token and returns the duration until it becomes
+// available.
+func (b *tokenBucket) reserve() time.Duration {
+	b.m.Lock()
+	defer b.m.Unlock()
+
+	now := time.Now()
+	b.tokens += float64(now.Sub(b.last)) / float64(b.interval)
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+	b.last = now
+
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens * float64(b.interval))
+}
@@ -0,0 +1,59 @@
+package client
+
+import (
+	"context"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/server/testutil"
+	"testing"
+	"time"
+)
+
+func TestRequesterWithRateLimit(t *testing.T) {
+	s := testutil.NewServer()
+	defer s.Close()
+
+	Convey("Given a requester with a rate limit", t, func() {
+		r, err := NewRequesterWithClient(s.URL(), "v1", s.HTTPClient(), WithRateLimit(50, 5))
+		So(err, ShouldBeNil)
+
+		Convey("When issuing a burst of requests", func() {
+			begin := time.Now()
+			for i := 0; i < 15; i++ {
+				res, err := r.Do(Get, "/runtime_status", nil)
+				So(err, ShouldBeNil)
+				res.Close()
+			}
+			elapsed := time.Now().Sub(begin)
+
+			Convey("Then the rate should stay near the limit", func() {
+				// 5 requests are sent immediately and the other 10 requests
+				// wait for 1/50 seconds each.
+				So(elapsed, ShouldBeGreaterThanOrEqualTo, 180*time.Millisecond)
+				So(elapsed, ShouldBeLessThan, 2*time.Second)
+			})
+		})
+	})
+
+	Convey("Given a requester with a low rate limit", t, func() {
+		r, err := NewRequesterWithClient(s.URL(), "v1", s.HTTPClient(), WithRateLimit(0.1, 1))
+		So(err, ShouldBeNil)
+		res, err := r.Do(Get, "/runtime_status", nil)
+		So(err, ShouldBeNil)
+		res.Close()
+
+		Convey("When the context of a waiting request is canceled", func() {
+			req, err := r.NewRequest(Get, "/runtime_status", nil)
+			So(err, ShouldBeNil)
+			ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+
+			begin := time.Now()
+			_, err = r.DoWithRequest(req.WithContext(ctx))
+
+			Convey("Then it should fail without waiting for a token", func() {
+				So(err, ShouldEqual, context.DeadlineExceeded)
+				So(time.Now().Sub(begin), ShouldBeLessThan, time.Second)
+			})
+		})
+	})
+}
@@ -5,27 +5,71 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"net/http"
 	"net/url"
 	"path"
 	"strings"
+	"time"
 )
 
 // Requester sends raw HTTP requests to the server. Requester doesn't have
-// a state, so it can be used concurrently.
+// a state except for the rate limiter and the ETag cache, which are
+// thread-safe, so it can be used concurrently.
 type Requester struct {
-	cli    *http.Client
-	url    string
-	prefix string
+	cli     *http.Client
+	url     string
+	prefix  string
+	logger  RequestLogger
+	limiter *tokenBucket
+	etags   *etagCache
+
+	idempotencyKey bool
+	maxRetries     int
+	retryInterval  time.Duration
+
+	logReconnect         bool
+	logReconnectInterval time.Duration
+}
+
+// RequestLogger is called after each HTTP request sent by a Requester. resp
+// is nil when err isn't nil. The body of resp is replaced with an empty one
+// so that the logger cannot consume it. elapsed is the time taken until the
+// header of the response was received.
+type RequestLogger func(req *http.Request, resp *http.Response, err error, elapsed time.Duration)
+
+// RequesterOption is an optional parameter of a Requester.
+type RequesterOption func(r *Requester)
+
+// WithLogger sets a RequestLogger observing requests sent by a Requester.
+func WithLogger(l RequestLogger) RequesterOption {
+	return func(r *Requester) {
+		r.logger = l
+	}
+}
+
+// WithRateLimit limits the rate of requests sent by a Requester to rps
+// requests per second on average while allowing bursts of up to burst
+// requests. A request blocks until it's allowed to be sent or its context
+// is canceled. When rps is 0 or less, the rate isn't limited. When burst is
+// less than 1, it's treated as 1.
+func WithRateLimit(rps float64, burst int) RequesterOption {
+	return func(r *Requester) {
+		if rps <= 0 {
+			r.limiter = nil
+			return
+		}
+		r.limiter = newTokenBucket(rps, burst)
+	}
 }
 
 // NewRequester creates a new requester
-func NewRequester(url, version string) (*Requester, error) {
-	return NewRequesterWithClient(url, version, http.DefaultClient)
+func NewRequester(url, version string, opts ...RequesterOption) (*Requester, error) {
+	return NewRequesterWithClient(url, version, http.DefaultClient, opts...)
 }
 
 // NewRequesterWithClient creates a new requester with a custom HTTP client.
-func NewRequesterWithClient(url, version string, cli *http.Client) (*Requester, error) {
+func NewRequesterWithClient(url, version string, cli *http.Client, opts ...RequesterOption) (*Requester, error) {
 	if err := ValidateURL(url); err != nil {
 		return nil, err
 	}
@@ -37,11 +81,15 @@ func NewRequesterWithClient(url, version string, cli *http.Client) (*Requester,
 		url += "/"
 	}
 
-	return &Requester{
+	r := &Requester{
 		cli:    cli,
 		url:    url,
 		prefix: "api/" + version,
-	}, nil
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r, nil
 }
 
 // Do sends a JSON request to server. The caller has to close the body of
@@ -76,15 +124,65 @@ func (r *Requester) NewRequest(method Method, apiPath string, bodyJSON interface
 	return req, nil
 }
 
-// DoWithRequest sends a custom HTTP request to server.
+// DoWithRequest sends a custom HTTP request to server. When the Requester
+// has WithIdempotencyKey option, an Idempotency-Key header might be added to
+// req. When the Requester has WithRetry option, req might be sent multiple
+// times. When the Requester has WithETagCache option, If-None-Match header
+// might be added to req and ErrNotModified might be returned with a cached
+// response.
 func (r *Requester) DoWithRequest(req *http.Request) (*Response, error) {
+	if r.idempotencyKey && (req.Method == "POST" || req.Method == "PUT") &&
+		req.Header.Get(IdempotencyKeyHeader) == "" {
+		key, err := newIdempotencyKey()
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set(IdempotencyKeyHeader, key)
+	}
+	cached := r.prepareConditionalRequest(req)
+
+	for attempt := 0; ; attempt++ {
+		res, err := r.send(req)
+		if attempt >= r.maxRetries || !r.shouldRetry(req, res, err) {
+			if err != nil {
+				return nil, err
+			}
+			return r.handleConditionalResponse(req, &Response{
+				Raw: res,
+			}, cached)
+		}
+		if res != nil {
+			res.Body.Close()
+		}
+		if err := r.prepareRetry(req); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (r *Requester) send(req *http.Request) (*http.Response, error) {
+	if r.limiter != nil {
+		if err := r.limiter.wait(req.Context()); err != nil {
+			return nil, err
+		}
+	}
+
+	start := time.Now()
 	res, err := r.cli.Do(req)
-	if err != nil {
-		return nil, err
+	if r.logger != nil {
+		r.log(req, res, err, time.Now().Sub(start))
+	}
+	return res, err
+}
+
+func (r *Requester) log(req *http.Request, res *http.Response, err error, elapsed time.Duration) {
+	if res != nil {
+		// pass a shallow copy so that the logger cannot read the body
+		c := *res
+		c.Body = ioutil.NopCloser(bytes.NewReader(nil))
+		res = &c
 	}
-	return &Response{
-		Raw: res,
-	}, nil
+	r.logger(req, res, err, elapsed)
 }
 
 // ValidateURL validates if the given URL is valid for the SensorBee API server.
@@ -0,0 +1,73 @@
+package client
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/server/testutil"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRequesterWithLogger(t *testing.T) {
+	s := testutil.NewServer()
+	defer s.Close()
+
+	Convey("Given a requester with a logger", t, func() {
+		type logEntry struct {
+			method  string
+			path    string
+			status  int
+			err     error
+			elapsed time.Duration
+		}
+		var logs []logEntry
+		r, err := NewRequesterWithClient(s.URL(), "v1", s.HTTPClient(),
+			WithLogger(func(req *http.Request, res *http.Response, err error, elapsed time.Duration) {
+				e := logEntry{
+					method:  req.Method,
+					path:    req.URL.Path,
+					err:     err,
+					elapsed: elapsed,
+				}
+				if res != nil {
+					e.status = res.StatusCode
+					// this must not consume the body of the actual response
+					ioutil.ReadAll(res.Body)
+				}
+				logs = append(logs, e)
+			}))
+		So(err, ShouldBeNil)
+
+		Convey("When sending a request", func() {
+			res, js, err := do(r, Get, "/runtime_status", nil)
+			So(err, ShouldBeNil)
+			So(res.Raw.StatusCode, ShouldEqual, http.StatusOK)
+
+			Convey("Then the logger should be called with the method and the status", func() {
+				So(len(logs), ShouldEqual, 1)
+				So(logs[0].method, ShouldEqual, "GET")
+				So(logs[0].path, ShouldEndWith, "/api/v1/runtime_status")
+				So(logs[0].status, ShouldEqual, http.StatusOK)
+				So(logs[0].err, ShouldBeNil)
+				So(logs[0].elapsed, ShouldBeGreaterThan, 0)
+			})
+
+			Convey("Then the body of the response should still be readable", func() {
+				So(js, ShouldContainKey, "num_goroutine")
+			})
+		})
+
+		Convey("When sending a request resulting in an error response", func() {
+			res, err := r.Do(Get, "/topologies/no_such_topology", nil)
+			So(err, ShouldBeNil)
+			defer res.Close()
+
+			Convey("Then the logger should be called with the error status", func() {
+				So(len(logs), ShouldEqual, 1)
+				So(logs[0].method, ShouldEqual, "GET")
+				So(logs[0].status, ShouldEqual, http.StatusNotFound)
+			})
+		})
+	})
+}
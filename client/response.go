@@ -99,8 +99,13 @@ func (r *Response) Body() ([]byte, error) {
 	return r.bodyCache, r.readErr
 }
 
-// IsError returns true when the response is an error.
+// IsError returns true when the response is an error. 304 Not Modified,
+// which is returned for a conditional request sent with WithETagCache
+// option, isn't considered as an error.
 func (r *Response) IsError() bool {
+	if r.Raw.StatusCode == http.StatusNotModified {
+		return false
+	}
 	return r.Raw.StatusCode < 200 || 300 <= r.Raw.StatusCode
 }
 
@@ -0,0 +1,93 @@
+package client
+
+import (
+	"crypto/rand"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// IdempotencyKeyHeader is the name of the header having the idempotency key
+// of a request. A server supporting the header can deduplicate requests
+// having the same key.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// WithIdempotencyKey adds an Idempotency-Key header having a random UUID to
+// POST and PUT requests sent by a Requester. A request which already has the
+// header keeps its own key, so a user-supplied key can be set by creating
+// the request with NewRequest and sending it with DoWithRequest. Retries of
+// a request by WithRetry reuse the same key.
+func WithIdempotencyKey() RequesterOption {
+	return func(r *Requester) {
+		r.idempotencyKey = true
+	}
+}
+
+// WithRetry makes a Requester retry a request up to maxRetries times when
+// it fails due to a network error or the server responds with 502, 503, or
+// 504. A retry is sent after waiting for interval. POST requests are only
+// retried when they have an Idempotency-Key header because retrying them
+// could otherwise have duplicate effects.
+func WithRetry(maxRetries int, interval time.Duration) RequesterOption {
+	return func(r *Requester) {
+		if maxRetries < 0 {
+			maxRetries = 0
+		}
+		r.maxRetries = maxRetries
+		r.retryInterval = interval
+	}
+}
+
+func (r *Requester) shouldRetry(req *http.Request, res *http.Response, err error) bool {
+	if req.Method == "POST" && req.Header.Get(IdempotencyKeyHeader) == "" {
+		return false
+	}
+	if req.Body != nil && req.GetBody == nil {
+		// the body cannot be sent again
+		return false
+	}
+	if req.Context().Err() != nil {
+		return false
+	}
+	if err != nil {
+		return true
+	}
+	switch res.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// prepareRetry waits for the retry interval and rewinds the body of req.
+func (r *Requester) prepareRetry(req *http.Request) error {
+	if r.retryInterval > 0 {
+		t := time.NewTimer(r.retryInterval)
+		defer t.Stop()
+		select {
+		case <-t.C:
+		case <-req.Context().Done():
+			return req.Context().Err()
+		}
+	}
+
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return fmt.Errorf("cannot rewind the body of the request: %v", err)
+		}
+		req.Body = body
+	}
+	return nil
+}
+
+// newIdempotencyKey generates a random (version 4) UUID.
+func newIdempotencyKey() (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", fmt.Errorf("cannot generate an idempotency key: %v", err)
+	}
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:]), nil
+}
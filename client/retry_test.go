@@ -0,0 +1,123 @@
+package client
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// flakyServer responds with 503 to the first failures requests and records
+// the idempotency key and the body of each request.
+type flakyServer struct {
+	m        sync.Mutex
+	failures int
+	keys     []string
+	bodies   []string
+}
+
+func (s *flakyServer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	body, _ := ioutil.ReadAll(req.Body)
+	s.keys = append(s.keys, req.Header.Get(IdempotencyKeyHeader))
+	s.bodies = append(s.bodies, string(body))
+	if len(s.keys) <= s.failures {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write([]byte(`{}`))
+}
+
+func TestRequesterIdempotencyKey(t *testing.T) {
+	Convey("Given a server failing twice and a requester with retries and idempotency keys", t, func() {
+		fs := &flakyServer{failures: 2}
+		s := httptest.NewServer(fs)
+		Reset(s.Close)
+		r, err := NewRequester(s.URL, "v1", WithIdempotencyKey(), WithRetry(3, time.Millisecond))
+		So(err, ShouldBeNil)
+
+		Convey("When posting a request", func() {
+			res, err := r.Do(Post, "/topologies", map[string]interface{}{"name": "test"})
+			So(err, ShouldBeNil)
+			defer res.Close()
+
+			Convey("Then it should succeed after retries", func() {
+				So(res.Raw.StatusCode, ShouldEqual, http.StatusOK)
+				So(len(fs.keys), ShouldEqual, 3)
+			})
+
+			Convey("Then all retries should have the same key", func() {
+				So(fs.keys[0], ShouldNotBeBlank)
+				So(fs.keys[1], ShouldEqual, fs.keys[0])
+				So(fs.keys[2], ShouldEqual, fs.keys[0])
+			})
+
+			Convey("Then all retries should have the same body", func() {
+				So(fs.bodies[0], ShouldEqual, `{"name":"test"}`)
+				So(fs.bodies[1], ShouldEqual, fs.bodies[0])
+				So(fs.bodies[2], ShouldEqual, fs.bodies[0])
+			})
+		})
+
+		Convey("When posting two logical requests", func() {
+			fs.failures = 0
+			for i := 0; i < 2; i++ {
+				res, err := r.Do(Post, "/topologies", nil)
+				So(err, ShouldBeNil)
+				res.Close()
+			}
+
+			Convey("Then they should have different keys", func() {
+				So(len(fs.keys), ShouldEqual, 2)
+				So(fs.keys[0], ShouldNotEqual, fs.keys[1])
+			})
+		})
+
+		Convey("When posting a request having a user-supplied key", func() {
+			req, err := r.NewRequest(Post, "/topologies", nil)
+			So(err, ShouldBeNil)
+			req.Header.Set(IdempotencyKeyHeader, "my-key")
+			res, err := r.DoWithRequest(req)
+			So(err, ShouldBeNil)
+			defer res.Close()
+
+			Convey("Then the key should be used for all retries", func() {
+				So(fs.keys, ShouldResemble, []string{"my-key", "my-key", "my-key"})
+			})
+		})
+
+		Convey("When getting a resource", func() {
+			res, err := r.Do(Get, "/topologies", nil)
+			So(err, ShouldBeNil)
+			defer res.Close()
+
+			Convey("Then it should be retried without a key", func() {
+				So(fs.keys, ShouldResemble, []string{"", "", ""})
+			})
+		})
+	})
+
+	Convey("Given a server failing twice and a requester with retries only", t, func() {
+		fs := &flakyServer{failures: 2}
+		s := httptest.NewServer(fs)
+		Reset(s.Close)
+		r, err := NewRequester(s.URL, "v1", WithRetry(3, time.Millisecond))
+		So(err, ShouldBeNil)
+
+		Convey("When posting a request", func() {
+			res, err := r.Do(Post, "/topologies", nil)
+			So(err, ShouldBeNil)
+			defer res.Close()
+
+			Convey("Then it should not be retried", func() {
+				So(res.Raw.StatusCode, ShouldEqual, http.StatusServiceUnavailable)
+				So(len(fs.keys), ShouldEqual, 1)
+			})
+		})
+	})
+}
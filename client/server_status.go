@@ -0,0 +1,108 @@
+package client
+
+import (
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"time"
+)
+
+const (
+	waitForStatusInitialInterval = 10 * time.Millisecond
+	waitForStatusMaxInterval     = time.Second
+)
+
+// WaitForStatus polls /runtime_status until pred returns true for the
+// status or the timeout elapses. The interval between polls starts from
+// 10ms and is doubled after each poll up to 1s. Errors occurring while
+// polling, such as a connection refused by a server which hasn't started
+// yet, don't stop polling. WaitForStatus returns an error when the timeout
+// elapses, and the error includes the last error that occurred if any.
+func (r *Requester) WaitForStatus(pred func(data.Map) bool, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	interval := waitForStatusInitialInterval
+	var lastErr error
+	for {
+		status, err := r.runtimeStatusMap()
+		if err == nil {
+			if pred(status) {
+				return nil
+			}
+			lastErr = nil
+		} else {
+			lastErr = err
+		}
+
+		rest := deadline.Sub(time.Now())
+		if rest <= 0 {
+			if lastErr != nil {
+				return fmt.Errorf("timed out waiting for the status: %v", lastErr)
+			}
+			return fmt.Errorf("timed out waiting for the status")
+		}
+		if interval > rest {
+			interval = rest
+		}
+		time.Sleep(interval)
+
+		interval *= 2
+		if interval > waitForStatusMaxInterval {
+			interval = waitForStatusMaxInterval
+		}
+	}
+}
+
+// RuntimeStatus has the runtime status of a SensorBee server returned from
+// /runtime_status. WorkingDirectory, Hostname, and User are empty when the
+// server cannot obtain them on its environment.
+type RuntimeStatus struct {
+	NumGoroutine     int    `bql:"num_goroutine"`
+	NumCgoCall       int64  `bql:"num_cgo_call"`
+	GOMAXPROCS       int    `bql:"gomaxprocs"`
+	GOROOT           string `bql:"goroot"`
+	NumCPU           int    `bql:"num_cpu"`
+	GoVersion        string `bql:"goversion"`
+	PID              int    `bql:"pid"`
+	WorkingDirectory string `bql:"working_directory"`
+	Hostname         string `bql:"hostname"`
+	User             string `bql:"user"`
+}
+
+// runtimeStatusDecoder ignores fields which RuntimeStatus doesn't have so
+// that a client doesn't break when a newer server adds fields to the status.
+var runtimeStatusDecoder = data.NewDecoder(&data.DecoderConfig{})
+
+// RuntimeStatus returns the runtime status of the server. Fields which
+// RuntimeStatus doesn't have are ignored.
+func (r *Requester) RuntimeStatus() (*RuntimeStatus, error) {
+	m, err := r.runtimeStatusMap()
+	if err != nil {
+		return nil, err
+	}
+	status := &RuntimeStatus{}
+	if err := runtimeStatusDecoder.Decode(m, status); err != nil {
+		return nil, fmt.Errorf("cannot decode the runtime status: %v", err)
+	}
+	return status, nil
+}
+
+func (r *Requester) runtimeStatusMap() (data.Map, error) {
+	res, err := r.Do(Get, "/runtime_status", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	if res.IsError() {
+		e, err := res.Error()
+		if err != nil {
+			return nil, fmt.Errorf("the server returned an error response (status %v): %v", res.Raw.StatusCode, err)
+		}
+		return nil, fmt.Errorf("the server returned an error: %v", e.Message)
+	}
+
+	var js map[string]interface{}
+	if err := res.ReadJSON(&js); err != nil {
+		return nil, err
+	}
+	return data.NewMap(js)
+}
@@ -3,12 +3,16 @@ package client
 import (
 	"encoding/json"
 	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
 	"gopkg.in/sensorbee/sensorbee.v0/server/testutil"
+	"io"
 	"net/http"
+	"net/http/httptest"
 	"os"
 	"os/user"
 	"runtime"
 	"testing"
+	"time"
 )
 
 func TestServerStatus(t *testing.T) {
@@ -47,6 +51,99 @@ func TestServerStatus(t *testing.T) {
 	})
 }
 
+func TestRequesterRuntimeStatus(t *testing.T) {
+	s := testutil.NewServer()
+	defer s.Close()
+	r := newTestRequester(s)
+
+	Convey("Given an API server", t, func() {
+		Convey("When getting the runtime status as a struct", func() {
+			st, err := r.RuntimeStatus()
+			So(err, ShouldBeNil)
+
+			Convey("Then it should have the server's values", func() {
+				So(st.NumGoroutine, ShouldBeGreaterThan, 0)
+				So(st.NumCgoCall, ShouldBeGreaterThanOrEqualTo, 0)
+				So(st.GOMAXPROCS, ShouldEqual, runtime.GOMAXPROCS(0))
+				So(st.GOROOT, ShouldEqual, runtime.GOROOT())
+				So(st.NumCPU, ShouldEqual, runtime.NumCPU())
+				So(st.GoVersion, ShouldEqual, runtime.Version())
+				So(st.PID, ShouldEqual, os.Getpid())
+
+				dir, err := os.Getwd()
+				So(err, ShouldBeNil)
+				So(st.WorkingDirectory, ShouldEqual, dir)
+
+				host, err := os.Hostname()
+				So(err, ShouldBeNil)
+				So(st.Hostname, ShouldEqual, host)
+
+				user, err := user.Current()
+				So(err, ShouldBeNil)
+				So(st.User, ShouldEqual, user.Username)
+			})
+		})
+	})
+}
+
+func TestRequesterRuntimeStatusUnknownField(t *testing.T) {
+	Convey("Given a server returning a status with an unknown field", t, func() {
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			io.WriteString(w, `{"num_goroutine":10,"pid":123,"hostname":"h","new_field":{"a":1}}`)
+		}))
+		Reset(s.Close)
+		r, err := NewRequester(s.URL, "v1")
+		So(err, ShouldBeNil)
+
+		Convey("When getting the runtime status as a struct", func() {
+			st, err := r.RuntimeStatus()
+
+			Convey("Then it should ignore the unknown field", func() {
+				So(err, ShouldBeNil)
+				So(st.NumGoroutine, ShouldEqual, 10)
+				So(st.PID, ShouldEqual, 123)
+				So(st.Hostname, ShouldEqual, "h")
+			})
+		})
+	})
+}
+
+func TestWaitForStatus(t *testing.T) {
+	s := testutil.NewServer()
+	defer s.Close()
+	r := newTestRequester(s)
+
+	Convey("Given an API server", t, func() {
+		Convey("When waiting for a condition which holds on the second poll", func() {
+			polls := 0
+			err := r.WaitForStatus(func(m data.Map) bool {
+				polls++
+				_, ok := m["num_goroutine"]
+				return ok && polls >= 2
+			}, 5*time.Second)
+
+			Convey("Then it should succeed after polling twice", func() {
+				So(err, ShouldBeNil)
+				So(polls, ShouldEqual, 2)
+			})
+		})
+
+		Convey("When waiting for a condition which never holds", func() {
+			begin := time.Now()
+			err := r.WaitForStatus(func(m data.Map) bool {
+				return false
+			}, 100*time.Millisecond)
+
+			Convey("Then it should time out", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "timed out")
+				So(time.Now().Sub(begin), ShouldBeGreaterThanOrEqualTo, 100*time.Millisecond)
+			})
+		})
+	})
+}
+
 func jsonNumberToInt64(n interface{}) int64 {
 	ret, err := n.(json.Number).Int64()
 	if err != nil {
@@ -9,8 +9,6 @@ import (
 	"gopkg.in/sensorbee/sensorbee.v0/server"
 	"gopkg.in/sensorbee/sensorbee.v0/server/config"
 	"gopkg.in/urfave/cli.v1"
-	"gopkg.in/yaml.v2"
-	"io/ioutil"
 	"net"
 	"net/http"
 )
@@ -28,7 +26,7 @@ func SetUp() cli.Command {
 		cli.StringFlag{
 			Name:   "config, c",
 			Value:  "",
-			Usage:  "file path of a config file in YAML format",
+			Usage:  "file path of a config file in YAML or TOML (.toml) format",
 			EnvVar: "SENSORBEE_CONFIG",
 		},
 	}
@@ -40,23 +38,9 @@ func Run(c *cli.Context) error {
 	err := func() error {
 		var conf *config.Config
 		if c.IsSet("config") {
-			p := c.String("config")
-			in, err := ioutil.ReadFile(p)
+			c, err := config.LoadFile(c.String("config"))
 			if err != nil {
-				return fmt.Errorf("Cannot read the config file %v: %v", p, err)
-			}
-
-			var yml map[string]interface{}
-			if err := yaml.Unmarshal(in, &yml); err != nil {
-				return fmt.Errorf("Cannot parse the config file %v: %v", p, err)
-			}
-			m, err := data.NewMap(yml)
-			if err != nil {
-				return fmt.Errorf("The config file %v has invalid values: %v", p, err)
-			}
-			c, err := config.New(m)
-			if err != nil {
-				return fmt.Errorf("Cannot apply the cnofig file %v: %v", p, err)
+				return fmt.Errorf("Cannot load the config: %v", err)
 			}
 			conf = c
 
@@ -131,16 +131,17 @@ func sendBQLQueries(requester *client.Requester, queries string) {
 		return
 	}
 
-	// check if we have a JSON body that contains a "result" field;
-	// if so, print it.
+	// check if we have a JSON body that contains a "result" field,
+	// or a "plan" field of an EXPLAIN statement; if so, print it.
 	// NB. We should also display some more status information that
 	// is reported by most statements.
 	var data map[string]interface{}
 	err = res.ReadJSON(&data)
 	if err == nil {
-		result, ok := data["result"]
-		if ok {
+		if result, ok := data["result"]; ok {
 			printJSONResult(result)
+		} else if plan, ok := data["plan"]; ok {
+			printJSONResult(plan)
 		}
 	}
 
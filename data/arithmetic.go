@@ -0,0 +1,171 @@
+package data
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+var (
+	// ErrIntegerOverflow is returned by the arithmetic functions when the
+	// result of an operation on two Ints doesn't fit into an Int.
+	ErrIntegerOverflow = errors.New("integer overflow")
+
+	// ErrDivisionByZero is returned by Div when an Int is divided by
+	// the Int 0.
+	ErrDivisionByZero = errors.New("division by zero")
+)
+
+// Add returns the sum of two numeric values. The result is an Int when
+// both operands are Ints and a Float when either of them is a Float.
+// When one of the operands is Null, the result is Null. Adding two Ints
+// whose sum doesn't fit into an Int results in ErrIntegerOverflow.
+func Add(a, b Value) (Value, error) {
+	return numericOp(a, b, "add", func(l, r int64) (int64, error) {
+		s := l + r
+		if (s > l) != (r > 0) {
+			return 0, ErrIntegerOverflow
+		}
+		return s, nil
+	}, func(l, r float64) float64 {
+		return l + r
+	})
+}
+
+// Sub returns the difference a - b of two numeric values. The types of
+// the result and errors are the same as Add's.
+func Sub(a, b Value) (Value, error) {
+	return numericOp(a, b, "subtract", func(l, r int64) (int64, error) {
+		d := l - r
+		if (d < l) != (r > 0) {
+			return 0, ErrIntegerOverflow
+		}
+		return d, nil
+	}, func(l, r float64) float64 {
+		return l - r
+	})
+}
+
+// Mul returns the product of two numeric values. The types of the result
+// and errors are the same as Add's.
+func Mul(a, b Value) (Value, error) {
+	return numericOp(a, b, "multiply", func(l, r int64) (int64, error) {
+		if l == 0 || r == 0 {
+			return 0, nil
+		}
+		p := l * r
+		if (l == -1 && r == math.MinInt64) || (r == -1 && l == math.MinInt64) || p/r != l {
+			return 0, ErrIntegerOverflow
+		}
+		return p, nil
+	}, func(l, r float64) float64 {
+		return l * r
+	})
+}
+
+// Div returns the quotient a / b of two numeric values. When both
+// operands are Ints, an integer division truncating toward zero is
+// performed (e.g., 7 / 2 = 3 and -7 / 2 = -3) and dividing by 0 results
+// in ErrDivisionByZero. When either of them is a Float, a floating point
+// division is performed and dividing by 0 results in +Inf, -Inf, or NaN.
+// Dividing the minimum value of Int by -1 results in ErrIntegerOverflow.
+func Div(a, b Value) (Value, error) {
+	return numericOp(a, b, "divide", func(l, r int64) (int64, error) {
+		if r == 0 {
+			return 0, ErrDivisionByZero
+		}
+		if l == math.MinInt64 && r == -1 {
+			return 0, ErrIntegerOverflow
+		}
+		return l / r, nil
+	}, func(l, r float64) float64 {
+		return l / r
+	})
+}
+
+// AddInterval returns the Timestamp ts moved by d, which can be negative.
+// When ts is Null, the result is Null. Values of other types result in an
+// error.
+func AddInterval(ts Value, d time.Duration) (Value, error) {
+	switch ts.Type() {
+	case TypeNull:
+		return Null{}, nil
+	case TypeTimestamp:
+		t, _ := ts.asTimestamp()
+		return Timestamp(t.Add(d)), nil
+	}
+	return nil, fmt.Errorf("cannot add an interval to %s", ts.Type())
+}
+
+// TimestampDiff returns the duration a - b of two Timestamps. The result is
+// saturated to the maximum (or minimum) time.Duration when it doesn't fit,
+// which happens when a and b are about 292 years apart. Values other than
+// Timestamps, including Null, result in an error.
+func TimestampDiff(a, b Value) (time.Duration, error) {
+	lType := a.Type()
+	rType := b.Type()
+	if lType != TypeTimestamp || rType != TypeTimestamp {
+		return 0, fmt.Errorf("cannot compute the difference between %s and %s", lType, rType)
+	}
+	l, _ := a.asTimestamp()
+	r, _ := b.asTimestamp()
+	return l.Sub(r), nil
+}
+
+// PromoteNumeric converts two operands of an arithmetic operation to the
+// type of its result. The rules are as follows:
+//
+//  * Int and Int: both operands are kept as Ints and the result is an Int
+//  * Int and Float, Float and Int, Float and Float: both operands are
+//    converted to Floats, possibly losing precision of an Int, and the
+//    result is a Float
+//  * Null and any type, any type and Null: both operands are Null and the
+//    result is Null
+//  * other: (error)
+//
+// Add, Sub, Mul, and Div follow these rules.
+func PromoteNumeric(a, b Value) (Value, Value, TypeID, error) {
+	lType := a.Type()
+	rType := b.Type()
+	switch {
+	case lType == TypeNull || rType == TypeNull:
+		return Null{}, Null{}, TypeNull, nil
+
+	case lType == TypeInt && rType == TypeInt:
+		return a, b, TypeInt, nil
+
+	case (lType == TypeInt || lType == TypeFloat) && (rType == TypeInt || rType == TypeFloat):
+		l, _ := ToFloat(a)
+		r, _ := ToFloat(b)
+		return Float(l), Float(r), TypeFloat, nil
+	}
+	return nil, nil, typeUnknown, fmt.Errorf("cannot promote %s and %s to a numeric type", lType, rType)
+}
+
+// numericOp applies intOp or floatOp to a and b depending on the result type
+// given by PromoteNumeric.
+func numericOp(a, b Value, verb string, intOp func(int64, int64) (int64, error),
+	floatOp func(float64, float64) float64) (Value, error) {
+	l, r, t, err := PromoteNumeric(a, b)
+	if err != nil {
+		return nil, fmt.Errorf("cannot %s %s and %s", verb, a.Type(), b.Type())
+	}
+
+	switch t {
+	case TypeNull:
+		return Null{}, nil
+
+	case TypeInt:
+		li, _ := l.asInt()
+		ri, _ := r.asInt()
+		res, err := intOp(li, ri)
+		if err != nil {
+			return nil, err
+		}
+		return Int(res), nil
+	}
+	lf, _ := l.asFloat()
+	rf, _ := r.asFloat()
+	return Float(floatOp(lf, rf)), nil
+}
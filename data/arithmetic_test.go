@@ -0,0 +1,257 @@
+package data
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestArithmetic(t *testing.T) {
+	Convey("Given two Ints", t, func() {
+		a, b := Int(7), Int(2)
+
+		Convey("Then the results should be Ints", func() {
+			for _, tc := range []struct {
+				op       func(Value, Value) (Value, error)
+				expected Value
+			}{
+				{Add, Int(9)},
+				{Sub, Int(5)},
+				{Mul, Int(14)},
+				{Div, Int(3)},
+			} {
+				v, err := tc.op(a, b)
+				So(err, ShouldBeNil)
+				So(v, ShouldResemble, tc.expected)
+			}
+		})
+
+		Convey("Then Div should truncate toward zero", func() {
+			v, err := Div(Int(-7), b)
+			So(err, ShouldBeNil)
+			So(v, ShouldResemble, Int(-3))
+		})
+
+		Convey("Then dividing by zero should fail", func() {
+			_, err := Div(a, Int(0))
+			So(err, ShouldEqual, ErrDivisionByZero)
+		})
+	})
+
+	Convey("Given an Int and a Float", t, func() {
+		a, b := Int(7), Float(2)
+
+		Convey("Then the results should be Floats", func() {
+			for _, tc := range []struct {
+				op       func(Value, Value) (Value, error)
+				expected Value
+			}{
+				{Add, Float(9)},
+				{Sub, Float(5)},
+				{Mul, Float(14)},
+				{Div, Float(3.5)},
+			} {
+				v, err := tc.op(a, b)
+				So(err, ShouldBeNil)
+				So(v, ShouldResemble, tc.expected)
+
+				v, err = tc.op(b, a)
+				So(err, ShouldBeNil)
+				So(v.Type(), ShouldEqual, TypeFloat)
+			}
+		})
+
+		Convey("Then dividing by zero should follow IEEE 754", func() {
+			v, err := Div(a, Float(0))
+			So(err, ShouldBeNil)
+			So(math.IsInf(float64(v.(Float)), 1), ShouldBeTrue)
+
+			v, err = Div(Float(0), Int(0))
+			So(err, ShouldBeNil)
+			So(math.IsNaN(float64(v.(Float))), ShouldBeTrue)
+		})
+	})
+
+	Convey("Given Ints whose results overflow", t, func() {
+		Convey("Then the operations should fail", func() {
+			for _, tc := range []struct {
+				op   func(Value, Value) (Value, error)
+				a, b Value
+			}{
+				{Add, Int(math.MaxInt64), Int(1)},
+				{Add, Int(math.MinInt64), Int(-1)},
+				{Sub, Int(math.MinInt64), Int(1)},
+				{Sub, Int(0), Int(math.MinInt64)},
+				{Mul, Int(math.MaxInt64), Int(2)},
+				{Mul, Int(math.MinInt64), Int(-1)},
+				{Mul, Int(-1), Int(math.MinInt64)},
+				{Div, Int(math.MinInt64), Int(-1)},
+			} {
+				_, err := tc.op(tc.a, tc.b)
+				So(err, ShouldEqual, ErrIntegerOverflow)
+			}
+		})
+
+		Convey("Then results at the boundaries should succeed", func() {
+			v, err := Add(Int(math.MaxInt64-1), Int(1))
+			So(err, ShouldBeNil)
+			So(v, ShouldResemble, Int(math.MaxInt64))
+
+			v, err = Sub(Int(math.MinInt64+1), Int(1))
+			So(err, ShouldBeNil)
+			So(v, ShouldResemble, Int(math.MinInt64))
+
+			v, err = Mul(Int(math.MinInt64/2), Int(2))
+			So(err, ShouldBeNil)
+			So(v, ShouldResemble, Int(math.MinInt64))
+		})
+	})
+
+	Convey("Given a Null operand", t, func() {
+		Convey("Then all operations should return Null", func() {
+			for _, op := range []func(Value, Value) (Value, error){Add, Sub, Mul, Div} {
+				v, err := op(Null{}, Int(1))
+				So(err, ShouldBeNil)
+				So(v, ShouldResemble, Null{})
+
+				v, err = op(Float(1), Null{})
+				So(err, ShouldBeNil)
+				So(v, ShouldResemble, Null{})
+
+				v, err = op(Null{}, String("a"))
+				So(err, ShouldBeNil)
+				So(v, ShouldResemble, Null{})
+			}
+		})
+	})
+
+	Convey("Given non-numeric operands", t, func() {
+		Convey("Then all operations should fail", func() {
+			for _, op := range []func(Value, Value) (Value, error){Add, Sub, Mul, Div} {
+				_, err := op(String("1"), Int(1))
+				So(err, ShouldNotBeNil)
+
+				_, err = op(Int(1), Bool(true))
+				So(err, ShouldNotBeNil)
+			}
+		})
+	})
+}
+
+func TestTimestampArithmetic(t *testing.T) {
+	Convey("Given a Timestamp", t, func() {
+		base := time.Date(2015, time.April, 10, 10, 23, 0, 0, time.UTC)
+		ts := Timestamp(base)
+
+		Convey("When adding a duration to it", func() {
+			v, err := AddInterval(ts, 90*time.Minute)
+
+			Convey("Then it should be moved forward", func() {
+				So(err, ShouldBeNil)
+				So(v, ShouldResemble, Timestamp(base.Add(90*time.Minute)))
+			})
+		})
+
+		Convey("When adding a negative duration to it", func() {
+			v, err := AddInterval(ts, -time.Second)
+
+			Convey("Then it should be moved backward", func() {
+				So(err, ShouldBeNil)
+				So(v, ShouldResemble, Timestamp(base.Add(-time.Second)))
+			})
+		})
+
+		Convey("When computing the difference from a later Timestamp", func() {
+			later := Timestamp(base.Add(36 * time.Hour))
+
+			Convey("Then it should be the elapsed duration", func() {
+				d, err := TimestampDiff(later, ts)
+				So(err, ShouldBeNil)
+				So(d, ShouldEqual, 36*time.Hour)
+
+				d, err = TimestampDiff(ts, later)
+				So(err, ShouldBeNil)
+				So(d, ShouldEqual, -36*time.Hour)
+			})
+		})
+
+		Convey("When computing the difference from a non-Timestamp", func() {
+			Convey("Then it should fail", func() {
+				_, err := TimestampDiff(ts, Int(1))
+				So(err, ShouldNotBeNil)
+
+				_, err = TimestampDiff(String("2015-04-10T10:23:00Z"), ts)
+				So(err, ShouldNotBeNil)
+
+				_, err = TimestampDiff(ts, Null{})
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+
+	Convey("Given a Null operand", t, func() {
+		Convey("Then AddInterval should return Null", func() {
+			v, err := AddInterval(Null{}, time.Second)
+			So(err, ShouldBeNil)
+			So(v, ShouldResemble, Null{})
+		})
+	})
+
+	Convey("Given a non-Timestamp operand", t, func() {
+		Convey("Then AddInterval should fail", func() {
+			for _, v := range []Value{Int(1), Float(1), String("a"), Map{}} {
+				_, err := AddInterval(v, time.Second)
+				So(err, ShouldNotBeNil)
+			}
+		})
+	})
+}
+
+func TestPromoteNumeric(t *testing.T) {
+	Convey("Given numeric operands", t, func() {
+		cases := []struct {
+			title string
+			a, b  Value
+			l, r  Value
+			t     TypeID
+		}{
+			{"two Ints", Int(3), Int(-2), Int(3), Int(-2), TypeInt},
+			{"an Int and a Float", Int(3), Float(0.5), Float(3), Float(0.5), TypeFloat},
+			{"a Float and an Int", Float(0.5), Int(3), Float(0.5), Float(3), TypeFloat},
+			{"two Floats", Float(1.5), Float(2), Float(1.5), Float(2), TypeFloat},
+			{"a Null and an Int", Null{}, Int(1), Null{}, Null{}, TypeNull},
+			{"a String and a Null", String("a"), Null{}, Null{}, Null{}, TypeNull},
+		}
+
+		for _, c := range cases {
+			c := c
+
+			Convey("When promoting "+c.title, func() {
+				l, r, typ, err := PromoteNumeric(c.a, c.b)
+
+				Convey("Then they should be converted to the result type", func() {
+					So(err, ShouldBeNil)
+					So(l, ShouldResemble, c.l)
+					So(r, ShouldResemble, c.r)
+					So(typ, ShouldEqual, c.t)
+				})
+			})
+		}
+	})
+
+	Convey("Given non-numeric operands", t, func() {
+		cases := [][]Value{
+			{Int(1), String("1")},
+			{Bool(true), Float(1)},
+			{Array{Int(1)}, Int(1)},
+		}
+
+		Convey("Then PromoteNumeric should fail", func() {
+			for _, c := range cases {
+				_, _, _, err := PromoteNumeric(c[0], c[1])
+				So(err, ShouldNotBeNil)
+			}
+		})
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"time"
 )
 
@@ -89,6 +90,87 @@ func (a Array) Copy() Array {
 	return out
 }
 
+// Len returns the number of elements in the Array.
+func (a Array) Len() int {
+	return len(a)
+}
+
+// Map returns a new Array containing the results of applying fn to each
+// element of the Array. It stops and returns the error when fn fails.
+func (a Array) Map(fn func(Value) (Value, error)) (Array, error) {
+	out := make(Array, len(a))
+	for i, v := range a {
+		r, err := fn(v)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = r
+	}
+	return out, nil
+}
+
+// Filter returns a new Array containing the elements of the Array for which
+// fn returns true. The elements are not copied. It stops and returns the
+// error when fn fails.
+func (a Array) Filter(fn func(Value) (bool, error)) (Array, error) {
+	// the result is allocated only once by assuming the worst case
+	out := make(Array, 0, len(a))
+	for _, v := range a {
+		ok, err := fn(v)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			out = append(out, v)
+		}
+	}
+	return out, nil
+}
+
+// Sort returns a new Array having the elements of the Array sorted by less,
+// which reports whether i must be placed before j. The sort is stable, i.e.,
+// equal elements keep their original order. The elements are not copied.
+func (a Array) Sort(less func(i, j Value) bool) Array {
+	out := make(Array, len(a))
+	copy(out, a)
+	sort.SliceStable(out, func(i, j int) bool {
+		return less(out[i], out[j])
+	})
+	return out
+}
+
+// SortAsc returns a new Array having the elements of the Array sorted in
+// ascending order defined by Compare. The sort is stable. It returns an
+// error when the Array has elements that Compare cannot order, e.g., a
+// String and an Int, NaN, or Maps. Use Sort with CompareWeak to sort such
+// an Array.
+func (a Array) SortAsc() (Array, error) {
+	return a.sortByCompare(1)
+}
+
+// SortDesc works like SortAsc but sorts the elements in descending order.
+// Equal elements keep their original order.
+func (a Array) SortDesc() (Array, error) {
+	return a.sortByCompare(-1)
+}
+
+// sortByCompare sorts the Array by Compare multiplied by sign.
+func (a Array) sortByCompare(sign int) (Array, error) {
+	out := a.Sort(func(i, j Value) bool {
+		c, _ := Compare(i, j)
+		return c*sign < 0
+	})
+	// the elements can be ordered only when all adjacent elements can be
+	// compared: Nulls are placed at one end and the only other values
+	// comparable with different types are numbers
+	for i := 1; i < len(out); i++ {
+		if _, err := Compare(out[i-1], out[i]); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
 // Get returns value(s) from an array as addressed by the given path expression.
 // See Map.Get for details.
 func (a Array) Get(path Path) (Value, error) {
@@ -2,7 +2,9 @@ package data
 
 import (
 	"encoding/json"
+	"errors"
 	. "github.com/smartystreets/goconvey/convey"
+	"math"
 	"testing"
 	"time"
 )
@@ -55,3 +57,186 @@ func TestArrayMarshalJSON(t *testing.T) {
 		})
 	})
 }
+
+func TestArrayMapFilter(t *testing.T) {
+	Convey("Given an Array of Ints", t, func() {
+		a := Array{Int(1), Int(2), Int(3)}
+
+		Convey("When mapping it to doubled Ints", func() {
+			res, err := a.Map(func(v Value) (Value, error) {
+				i, err := AsInt(v)
+				if err != nil {
+					return nil, err
+				}
+				return Int(i * 2), nil
+			})
+
+			Convey("Then it should return the doubled Ints", func() {
+				So(err, ShouldBeNil)
+				So(res, ShouldResemble, Array{Int(2), Int(4), Int(6)})
+			})
+
+			Convey("Then the original Array should not be modified", func() {
+				So(a, ShouldResemble, Array{Int(1), Int(2), Int(3)})
+			})
+		})
+
+		Convey("When the callback of Map fails", func() {
+			calls := 0
+			res, err := a.Map(func(v Value) (Value, error) {
+				calls++
+				if Equal(v, Int(2)) {
+					return nil, errors.New("map failure")
+				}
+				return v, nil
+			})
+
+			Convey("Then it should stop and return the error", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "map failure")
+				So(res, ShouldBeNil)
+				So(calls, ShouldEqual, 2)
+			})
+		})
+	})
+
+	Convey("Given an Array containing NULLs", t, func() {
+		a := Array{Null{}, Int(1), Null{}, String("a"), Null{}}
+
+		Convey("When filtering out NULLs", func() {
+			res, err := a.Filter(func(v Value) (bool, error) {
+				return v.Type() != TypeNull, nil
+			})
+
+			Convey("Then it should only return the other elements", func() {
+				So(err, ShouldBeNil)
+				So(res, ShouldResemble, Array{Int(1), String("a")})
+			})
+		})
+
+		Convey("When the callback of Filter fails", func() {
+			res, err := a.Filter(func(v Value) (bool, error) {
+				if v.Type() == TypeString {
+					return false, errors.New("filter failure")
+				}
+				return true, nil
+			})
+
+			Convey("Then it should return the error", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "filter failure")
+				So(res, ShouldBeNil)
+			})
+		})
+	})
+
+	Convey("Given an empty Array", t, func() {
+		a := Array{}
+
+		Convey("Then Map and Filter should return an empty Array", func() {
+			res, err := a.Map(func(v Value) (Value, error) {
+				return v, nil
+			})
+			So(err, ShouldBeNil)
+			So(res, ShouldBeEmpty)
+
+			res, err = a.Filter(func(v Value) (bool, error) {
+				return true, nil
+			})
+			So(err, ShouldBeNil)
+			So(res, ShouldBeEmpty)
+		})
+	})
+}
+
+func TestArraySort(t *testing.T) {
+	Convey("Given an Array of numbers", t, func() {
+		a := Array{Int(3), Float(1.5), Int(-2), Null{}, Int(10), Float(2)}
+
+		Convey("When sorting it in ascending order", func() {
+			res, err := a.SortAsc()
+
+			Convey("Then it should be sorted numerically with Null first", func() {
+				So(err, ShouldBeNil)
+				So(res, ShouldResemble, Array{Null{}, Int(-2), Float(1.5), Float(2), Int(3), Int(10)})
+			})
+
+			Convey("Then the original Array should not be modified", func() {
+				So(a, ShouldResemble, Array{Int(3), Float(1.5), Int(-2), Null{}, Int(10), Float(2)})
+			})
+		})
+	})
+
+	Convey("Given an Array of Strings", t, func() {
+		a := Array{String("b"), String("c"), String("a"), String("ab")}
+
+		Convey("When sorting it in descending order", func() {
+			res, err := a.SortDesc()
+
+			Convey("Then it should be sorted lexically in reverse", func() {
+				So(err, ShouldBeNil)
+				So(res, ShouldResemble, Array{String("c"), String("b"), String("ab"), String("a")})
+			})
+		})
+	})
+
+	Convey("Given an Array having equal elements", t, func() {
+		a := Array{
+			Map{"k": Int(2), "id": String("a")},
+			Map{"k": Int(1), "id": String("b")},
+			Map{"k": Int(2), "id": String("c")},
+			Map{"k": Int(1), "id": String("d")},
+			Map{"k": Int(2), "id": String("e")},
+		}
+		key := MustCompilePath("k")
+
+		Convey("When sorting it with a custom comparator", func() {
+			res := a.Sort(func(i, j Value) bool {
+				ki, _ := i.(Map).Get(key)
+				kj, _ := j.(Map).Get(key)
+				return CompareWeak(ki, kj) < 0
+			})
+
+			Convey("Then equal elements should keep their order", func() {
+				ids := []string{}
+				for _, v := range res {
+					ids = append(ids, string(v.(Map)["id"].(String)))
+				}
+				So(ids, ShouldResemble, []string{"b", "d", "a", "c", "e"})
+			})
+		})
+
+		Convey("When sorting Ints and Floats having the same value", func() {
+			res, err := Array{Float(1), Int(1), Int(0), Float(1)}.SortDesc()
+
+			Convey("Then they should keep their order", func() {
+				So(err, ShouldBeNil)
+				So(res, ShouldResemble, Array{Float(1), Int(1), Float(1), Int(0)})
+			})
+		})
+	})
+
+	Convey("Given an Array of incomparable values", t, func() {
+		Convey("Then SortAsc and SortDesc should fail", func() {
+			for _, a := range []Array{
+				{Int(1), String("a")},
+				{String("a"), Null{}, Int(1), String("b")},
+				{Float(1), Float(math.NaN())},
+				{Map{}, Map{}},
+			} {
+				_, err := a.SortAsc()
+				So(err, ShouldNotBeNil)
+				_, err = a.SortDesc()
+				So(err, ShouldNotBeNil)
+			}
+		})
+	})
+
+	Convey("Given an empty Array", t, func() {
+		Convey("Then SortAsc should return an empty Array", func() {
+			res, err := Array{}.SortAsc()
+			So(err, ShouldBeNil)
+			So(res, ShouldBeEmpty)
+		})
+	})
+}
@@ -1,6 +1,8 @@
 package data
 
 import (
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"time"
@@ -65,3 +67,34 @@ func (b Blob) String() string {
 	}
 	return string(bytes)
 }
+
+// Hex returns the lowercase hexadecimal representation of a Blob.
+func (b Blob) Hex() string {
+	return hex.EncodeToString(b)
+}
+
+// Base64 returns the base64 representation of a Blob with the standard
+// encoding defined in RFC 4648, which is also used by ToString.
+func (b Blob) Base64() string {
+	return base64.StdEncoding.EncodeToString(b)
+}
+
+// BlobFromHex decodes a hexadecimal string to a Blob. Both lowercase and
+// uppercase letters are accepted.
+func BlobFromHex(s string) (Blob, error) {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid hex string: %v", err)
+	}
+	return Blob(b), nil
+}
+
+// BlobFromBase64 decodes a string in the standard base64 encoding, which is
+// also accepted by ToBlob, to a Blob.
+func BlobFromBase64(s string) (Blob, error) {
+	b, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid base64 string: %v", err)
+	}
+	return Blob(b), nil
+}
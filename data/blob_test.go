@@ -0,0 +1,73 @@
+package data
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestBlobEncoding(t *testing.T) {
+	Convey("Given a Blob", t, func() {
+		b := Blob{0x00, 0x01, 0xab, 0xff, 's', 'b'}
+
+		Convey("When converting it to hex", func() {
+			s := b.Hex()
+
+			Convey("Then it should be lowercase hex digits", func() {
+				So(s, ShouldEqual, "0001abff7362")
+			})
+
+			Convey("Then it should be decoded to the same Blob", func() {
+				d, err := BlobFromHex(s)
+				So(err, ShouldBeNil)
+				So(d, ShouldResemble, b)
+			})
+		})
+
+		Convey("When converting it to base64", func() {
+			s := b.Base64()
+
+			Convey("Then it should be the same as ToString", func() {
+				str, err := ToString(b)
+				So(err, ShouldBeNil)
+				So(s, ShouldEqual, str)
+			})
+
+			Convey("Then it should be decoded to the same Blob", func() {
+				d, err := BlobFromBase64(s)
+				So(err, ShouldBeNil)
+				So(d, ShouldResemble, b)
+			})
+		})
+	})
+
+	Convey("Given an empty Blob", t, func() {
+		Convey("Then it should be encoded to empty strings", func() {
+			So(Blob{}.Hex(), ShouldBeBlank)
+			So(Blob{}.Base64(), ShouldBeBlank)
+		})
+	})
+
+	Convey("Given uppercase hex digits", t, func() {
+		Convey("Then they should be decoded", func() {
+			d, err := BlobFromHex("ABFF")
+			So(err, ShouldBeNil)
+			So(d, ShouldResemble, Blob{0xab, 0xff})
+		})
+	})
+
+	Convey("Given invalid input", t, func() {
+		Convey("Then BlobFromHex should fail", func() {
+			for _, s := range []string{"abc", "zz", "0x01"} {
+				_, err := BlobFromHex(s)
+				So(err, ShouldNotBeNil)
+			}
+		})
+
+		Convey("Then BlobFromBase64 should fail", func() {
+			for _, s := range []string{"abc", "a$==", "YWJj\n==="} {
+				_, err := BlobFromBase64(s)
+				So(err, ShouldNotBeNil)
+			}
+		})
+	})
+}
@@ -0,0 +1,80 @@
+package data
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/go-multierror"
+)
+
+// Coerce returns a new Map in which each field listed in schema is
+// converted to the type declared for it with To* functions such as ToInt.
+// Fields not listed in schema and fields listed but missing in m are left
+// as they are, and Null is kept as Null regardless of the declared type.
+// Array and Map fields aren't converted from other types. m itself isn't
+// modified.
+//
+// All fields that cannot be converted are reported together in the returned
+// error, and the Map is nil in that case.
+func Coerce(m Map, schema map[string]TypeID) (Map, error) {
+	res := make(Map, len(m))
+	for k, v := range m {
+		res[k] = v
+	}
+
+	keys := make([]string, 0, len(schema))
+	for k := range schema {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var errs *multierror.Error
+	for _, k := range keys {
+		v, ok := m[k]
+		if !ok {
+			continue
+		}
+		c, err := coerceValue(v, schema[k])
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("%v: %v", k, err))
+			continue
+		}
+		res[k] = c
+	}
+	if errs != nil {
+		return nil, errs
+	}
+	return res, nil
+}
+
+func coerceValue(v Value, t TypeID) (Value, error) {
+	if v.Type() == TypeNull || v.Type() == t {
+		return v, nil
+	}
+
+	switch t {
+	case TypeBool:
+		b, err := ToBool(v)
+		return Bool(b), err
+	case TypeInt:
+		i, err := ToInt(v)
+		return Int(i), err
+	case TypeFloat:
+		f, err := ToFloat(v)
+		return Float(f), err
+	case TypeString:
+		s, err := ToString(v)
+		return String(s), err
+	case TypeBlob:
+		b, err := ToBlob(v)
+		return Blob(b), err
+	case TypeTimestamp:
+		ts, err := ToTimestamp(v)
+		return Timestamp(ts), err
+	case TypeArray:
+		return AsArray(v)
+	case TypeMap:
+		return AsMap(v)
+	}
+	return nil, fmt.Errorf("cannot coerce %v to %v", v.Type(), t)
+}
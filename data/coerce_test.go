@@ -0,0 +1,63 @@
+package data
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestCoerce(t *testing.T) {
+	Convey("Given a Map having string values", t, func() {
+		m := Map{
+			"int":     String("10"),
+			"float":   String("1.5"),
+			"bool":    String("true"),
+			"null":    Null{},
+			"other":   String("20"),
+			"already": Int(3),
+		}
+		schema := map[string]TypeID{
+			"int":     TypeInt,
+			"float":   TypeFloat,
+			"bool":    TypeBool,
+			"null":    TypeInt,
+			"already": TypeFloat,
+			"missing": TypeString,
+		}
+
+		Convey("When coercing it to a schema", func() {
+			res, err := Coerce(m, schema)
+
+			Convey("Then listed fields should have the declared types", func() {
+				So(err, ShouldBeNil)
+				So(res["int"], ShouldEqual, Int(10))
+				So(res["float"], ShouldEqual, Float(1.5))
+				So(res["bool"], ShouldEqual, True)
+				So(res["already"], ShouldEqual, Float(3))
+			})
+
+			Convey("Then Null and unlisted fields should be kept", func() {
+				So(res["null"], ShouldEqual, Null{})
+				So(res["other"], ShouldEqual, String("20"))
+				So(len(res), ShouldEqual, len(m))
+			})
+
+			Convey("Then the original Map should not be modified", func() {
+				So(m["int"], ShouldEqual, String("10"))
+			})
+		})
+
+		Convey("When some fields cannot be coerced", func() {
+			m["int"] = String("ten")
+			m["float"] = Array{}
+			_, err := Coerce(m, schema)
+
+			Convey("Then it should report all of them", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "int: ")
+				So(err.Error(), ShouldContainSubstring, "float: ")
+				So(err.Error(), ShouldNotContainSubstring, "bool: ")
+			})
+		})
+	})
+}
@@ -0,0 +1,190 @@
+package data
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+)
+
+// Compare returns -1, 0, or 1 when v1 is less than, equal to, or greater
+// than v2, respectively. It is meant to be used for ordering values, e.g.,
+// in ORDER BY clauses or range windows. The rules are as follows:
+//   - Null is less than any other value and equal to Null
+//   - Bool: false < true
+//   - Int/Float: usual numeric comparison; Ints and Floats can also be
+//     compared with each other
+//   - String: lexical comparison of the UTF-8 bytes
+//   - Blob: lexical comparison of the bytes
+//   - Timestamp: chronological order
+//   - Array: element-wise comparison; when one array is a prefix of the
+//     other, the shorter one is less
+//
+// Compare returns an error when the values have different (non-numeric)
+// types, when one of them is NaN, or when they are Maps, which cannot be
+// ordered. Use CompareWeak when any pair of values needs to be ordered.
+func Compare(v1 Value, v2 Value) (int, error) {
+	return compare(v1, v2, false)
+}
+
+// CompareWeak works like Compare, but never fails. Values of different
+// types are ordered by their type in the same way as Less does:
+//
+//	Null < Bool < Int/Float < String < Blob < Timestamp < Array < Map
+//
+// NaN is equal to NaN and less than any other number, and Maps are
+// ordered first by their length and then by their hash values.
+func CompareWeak(v1 Value, v2 Value) int {
+	c, _ := compare(v1, v2, true)
+	return c
+}
+
+func compare(v1 Value, v2 Value, weak bool) (int, error) {
+	lType := v1.Type()
+	rType := v2.Type()
+	lNum := lType == TypeInt || lType == TypeFloat
+	rNum := rType == TypeInt || rType == TypeFloat
+
+	switch {
+	case lType == TypeNull && rType == TypeNull:
+		return 0, nil
+	case lType == TypeNull:
+		return -1, nil
+	case rType == TypeNull:
+		return 1, nil
+	case lNum && rNum:
+		return compareNumbers(v1, v2, weak)
+	case lType != rType:
+		if !weak {
+			return 0, fmt.Errorf("cannot compare %s and %s", lType, rType)
+		}
+		return compareInts(int64(lType), int64(rType)), nil
+	}
+
+	switch lType {
+	case TypeBool:
+		lhs, _ := v1.asBool()
+		rhs, _ := v2.asBool()
+		switch {
+		case lhs == rhs:
+			return 0, nil
+		case rhs:
+			return -1, nil
+		default:
+			return 1, nil
+		}
+
+	case TypeString:
+		lhs, _ := v1.asString()
+		rhs, _ := v2.asString()
+		switch {
+		case lhs < rhs:
+			return -1, nil
+		case lhs > rhs:
+			return 1, nil
+		default:
+			return 0, nil
+		}
+
+	case TypeBlob:
+		lhs, _ := v1.asBlob()
+		rhs, _ := v2.asBlob()
+		return bytes.Compare(lhs, rhs), nil
+
+	case TypeTimestamp:
+		lhs, _ := v1.asTimestamp()
+		rhs, _ := v2.asTimestamp()
+		switch {
+		case lhs.Before(rhs):
+			return -1, nil
+		case lhs.After(rhs):
+			return 1, nil
+		default:
+			return 0, nil
+		}
+
+	case TypeArray:
+		lhs, _ := v1.asArray()
+		rhs, _ := v2.asArray()
+		for i := 0; i < len(lhs) && i < len(rhs); i++ {
+			c, err := compare(lhs[i], rhs[i], weak)
+			if err != nil {
+				return 0, fmt.Errorf("cannot compare arrays at index %d: %v", i, err)
+			}
+			if c != 0 {
+				return c, nil
+			}
+		}
+		return compareInts(int64(len(lhs)), int64(len(rhs))), nil
+
+	case TypeMap:
+		if !weak {
+			return 0, fmt.Errorf("cannot compare %s and %s", lType, rType)
+		}
+		lhs, _ := v1.asMap()
+		rhs, _ := v2.asMap()
+		if len(lhs) != len(rhs) {
+			return compareInts(int64(len(lhs)), int64(len(rhs))), nil
+		}
+		if Equal(v1, v2) {
+			return 0, nil
+		}
+		lh, rh := Hash(v1), Hash(v2)
+		switch {
+		case lh < rh:
+			return -1, nil
+		case lh > rh:
+			return 1, nil
+		default:
+			return 0, nil
+		}
+	}
+	// no such case, though
+	return 0, fmt.Errorf("cannot compare %s and %s", lType, rType)
+}
+
+// compareNumbers compares two values each of which is an Int or a Float.
+// Two Ints are compared as integers so that large values don't lose
+// precision.
+func compareNumbers(v1 Value, v2 Value, weak bool) (int, error) {
+	if v1.Type() == TypeInt && v2.Type() == TypeInt {
+		lhs, _ := v1.asInt()
+		rhs, _ := v2.asInt()
+		return compareInts(lhs, rhs), nil
+	}
+
+	lhs, _ := ToFloat(v1)
+	rhs, _ := ToFloat(v2)
+	lNaN, rNaN := math.IsNaN(lhs), math.IsNaN(rhs)
+	if lNaN || rNaN {
+		if !weak {
+			return 0, fmt.Errorf("cannot compare NaN")
+		}
+		switch {
+		case lNaN && rNaN:
+			return 0, nil
+		case lNaN:
+			return -1, nil
+		default:
+			return 1, nil
+		}
+	}
+	switch {
+	case lhs < rhs:
+		return -1, nil
+	case lhs > rhs:
+		return 1, nil
+	default:
+		return 0, nil
+	}
+}
+
+func compareInts(lhs, rhs int64) int {
+	switch {
+	case lhs < rhs:
+		return -1
+	case lhs > rhs:
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,101 @@
+package data
+
+import (
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestCompare(t *testing.T) {
+	now := time.Now()
+	later := now.Add(time.Second)
+
+	testCases := []struct {
+		l Value
+		r Value
+		c int
+	}{
+		{Null{}, Null{}, 0},
+		{Null{}, Int(1), -1},
+		{String("a"), Null{}, 1},
+		{Bool(false), Bool(true), -1},
+		{Bool(true), Bool(true), 0},
+		{Int(1), Int(2), -1},
+		{Int(math.MaxInt64), Int(math.MaxInt64 - 1), 1},
+		{Int(1), Float(1.0), 0},
+		{Int(1), Float(1.5), -1},
+		{Float(-0.5), Int(-1), 1},
+		{Float(2.5), Float(2.5), 0},
+		{String("abc"), String("abd"), -1},
+		{String("ab"), String("abc"), -1},
+		{String("b"), String("abc"), 1},
+		{String("日本"), String("日本"), 0},
+		{Blob("ab"), Blob("b"), -1},
+		{Timestamp(now), Timestamp(later), -1},
+		{Timestamp(later), Timestamp(now), 1},
+		{Timestamp(now), Timestamp(now), 0},
+		{Array{Int(1), Int(2)}, Array{Int(1), Float(2.5)}, -1},
+		{Array{Int(1), Int(2)}, Array{Int(1)}, 1},
+		{Array{}, Array{}, 0},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		Convey(fmt.Sprintf("When comparing %#v and %#v", tc.l, tc.r), t, func() {
+			Convey("Then Compare should return the correct result", func() {
+				c, err := Compare(tc.l, tc.r)
+				So(err, ShouldBeNil)
+				So(c, ShouldEqual, tc.c)
+			})
+
+			Convey("Then swapping the arguments should negate the result", func() {
+				c, err := Compare(tc.r, tc.l)
+				So(err, ShouldBeNil)
+				So(c, ShouldEqual, -tc.c)
+			})
+
+			Convey("Then CompareWeak should return the same result", func() {
+				So(CompareWeak(tc.l, tc.r), ShouldEqual, tc.c)
+			})
+		})
+	}
+
+	incomparable := []struct {
+		l Value
+		r Value
+	}{
+		{Int(1), String("1")},
+		{Bool(true), Int(1)},
+		{String("a"), Blob("a")},
+		{Timestamp(now), Float(1.0)},
+		{Map{"a": Int(1)}, Map{"a": Int(1)}},
+		{Array{Int(1)}, Array{String("a")}},
+		{Float(math.NaN()), Int(1)},
+	}
+
+	for _, tc := range incomparable {
+		tc := tc
+		Convey(fmt.Sprintf("When comparing incomparable values %#v and %#v", tc.l, tc.r), t, func() {
+			Convey("Then Compare should fail", func() {
+				_, err := Compare(tc.l, tc.r)
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("Then CompareWeak should still order them", func() {
+				So(CompareWeak(tc.l, tc.r), ShouldEqual, -CompareWeak(tc.r, tc.l))
+			})
+		})
+	}
+
+	Convey("When comparing mixed types with CompareWeak", t, func() {
+		Convey("Then values should be ordered by their types", func() {
+			So(CompareWeak(Bool(true), Int(0)), ShouldEqual, -1)
+			So(CompareWeak(String("a"), Int(100)), ShouldEqual, 1)
+			So(CompareWeak(Map{}, Array{}), ShouldEqual, 1)
+			So(CompareWeak(Float(math.NaN()), Int(-100)), ShouldEqual, -1)
+			So(CompareWeak(Float(math.NaN()), Float(math.NaN())), ShouldEqual, 0)
+		})
+	})
+}
@@ -0,0 +1,156 @@
+package data
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// CSVRowEncoder converts Maps to rows of CSV records which can be written
+// by encoding/csv.Writer.
+//
+// Each column is a JSON Path such as "a.b" or "a[0]" and the value at the
+// path is converted to a string by ToString, which means Null becomes an
+// empty string, a Timestamp is formatted in RFC3339, and a Blob is encoded
+// in base64.
+type CSVRowEncoder struct {
+	// StringifyNonScalars makes the encoder write an Array or a Map found
+	// at a column as JSON. When it's false, the encoder returns an error for
+	// such a value.
+	StringifyNonScalars bool
+
+	// ErrorOnMissing makes the encoder return an error when a column isn't
+	// found in a Map. When it's false, a missing column is written as an
+	// empty string in the same way as Null.
+	ErrorOnMissing bool
+}
+
+// Encode extracts the columns from m and returns them as a CSV row. The
+// length of the row is always the same as the number of columns.
+func (e *CSVRowEncoder) Encode(m Map, columns []string) ([]string, error) {
+	row := make([]string, len(columns))
+	for i, c := range columns {
+		p, err := CompilePath(c)
+		if err != nil {
+			return nil, fmt.Errorf("invalid column '%v': %v", c, err)
+		}
+		v, err := m.Get(p)
+		if err != nil {
+			if e.ErrorOnMissing {
+				return nil, fmt.Errorf("column '%v' was not found: %v", c, err)
+			}
+			continue
+		}
+
+		switch v.Type() {
+		case TypeArray, TypeMap:
+			if !e.StringifyNonScalars {
+				return nil, fmt.Errorf("column '%v' has a non-scalar value of type %v", c, v.Type())
+			}
+		}
+		s, err := ToString(v)
+		if err != nil {
+			return nil, fmt.Errorf("cannot convert column '%v' to a string: %v", c, err)
+		}
+		row[i] = s
+	}
+	return row, nil
+}
+
+// ToCSVRow extracts the columns from m and returns them as a CSV row with
+// the default CSVRowEncoder. It returns an error when a column has an Array
+// or a Map, and writes an empty string for a missing column.
+func ToCSVRow(m Map, columns []string) ([]string, error) {
+	return (&CSVRowEncoder{}).Encode(m, columns)
+}
+
+// FromCSVRecord creates a Map from a CSV record having the columns in header.
+// Each field is converted to the type given in types for its column, and
+// a column not in types becomes a String. An empty field of a column having
+// a type other than TypeString becomes Null. Conversions are strict, so
+// "1.5" in a TypeInt column is an error:
+//
+//  * TypeBool: parsed by strconv.ParseBool
+//  * TypeInt: parsed by strconv.ParseInt with base 10
+//  * TypeFloat: parsed by strconv.ParseFloat
+//  * TypeBlob: decoded from base64
+//  * TypeTimestamp: parsed as RFC3339 as done by ToTimestamp
+//  * TypeArray, TypeMap: parsed as JSON
+//
+// It returns an error when the record doesn't have the same number of fields
+// as the header or when a field cannot be converted.
+func FromCSVRecord(header, record []string, types map[string]TypeID) (Map, error) {
+	if len(header) != len(record) {
+		return nil, fmt.Errorf("the record has %v fields but the header has %v columns",
+			len(record), len(header))
+	}
+	m := make(Map, len(header))
+	for i, c := range header {
+		t, ok := types[c]
+		if !ok {
+			t = TypeString
+		}
+		v, err := csvFieldToValue(record[i], t)
+		if err != nil {
+			return nil, fmt.Errorf("cannot convert column '%v': %v", c, err)
+		}
+		m[c] = v
+	}
+	return m, nil
+}
+
+func csvFieldToValue(s string, t TypeID) (Value, error) {
+	if s == "" && t != TypeString {
+		return Null{}, nil
+	}
+	switch t {
+	case TypeNull:
+		return nil, fmt.Errorf("%q is not null", s)
+	case TypeBool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a bool", s)
+		}
+		return Bool(b), nil
+	case TypeInt:
+		i, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not an int", s)
+		}
+		return Int(i), nil
+	case TypeFloat:
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a float", s)
+		}
+		return Float(f), nil
+	case TypeString:
+		return String(s), nil
+	case TypeBlob:
+		b, err := base64.StdEncoding.DecodeString(s)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a base64 encoded blob", s)
+		}
+		return Blob(b), nil
+	case TypeTimestamp:
+		ts, err := ToTimestamp(String(s))
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a timestamp", s)
+		}
+		return Timestamp(ts), nil
+	case TypeArray:
+		var a Array
+		if err := json.Unmarshal([]byte(s), &a); err != nil {
+			return nil, fmt.Errorf("%q is not a JSON array", s)
+		}
+		return a, nil
+	case TypeMap:
+		var m Map
+		if err := json.Unmarshal([]byte(s), &m); err != nil {
+			return nil, fmt.Errorf("%q is not a JSON object", s)
+		}
+		return m, nil
+	}
+	return nil, fmt.Errorf("unsupported type: %v", t)
+}
@@ -0,0 +1,149 @@
+package data
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+	"time"
+)
+
+func TestToCSVRow(t *testing.T) {
+	Convey("Given a nested Map having values of various types", t, func() {
+		now := time.Date(2015, time.April, 10, 10, 23, 45, 123000000, time.UTC)
+		m := Map{
+			"id":   Int(1),
+			"name": String(`a "quoted", name`),
+			"ok":   Bool(true),
+			"null": Null{},
+			"sensor": Map{
+				"temp": Float(21.5),
+				"ts":   Timestamp(now),
+				"raw":  Blob("sb"),
+				"tags": Array{String("x"), String("y")},
+			},
+		}
+
+		Convey("When converting scalar columns to a row", func() {
+			row, err := ToCSVRow(m, []string{"id", "name", "ok", "null",
+				"sensor.temp", "sensor.ts", "sensor.raw", "sensor.tags[1]"})
+
+			Convey("Then each value should be converted to a string", func() {
+				So(err, ShouldBeNil)
+				So(row, ShouldResemble, []string{"1", `a "quoted", name`, "true", "",
+					"21.5", "2015-04-10T10:23:45.123Z", "c2I=", "y"})
+			})
+		})
+
+		Convey("When converting a non-scalar column", func() {
+			columns := []string{"id", "sensor.tags"}
+
+			Convey("Then ToCSVRow should fail", func() {
+				_, err := ToCSVRow(m, columns)
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("Then an encoder stringifying non-scalars should write JSON", func() {
+				e := &CSVRowEncoder{StringifyNonScalars: true}
+				row, err := e.Encode(m, columns)
+				So(err, ShouldBeNil)
+				So(row, ShouldResemble, []string{"1", `["x","y"]`})
+			})
+		})
+
+		Convey("When converting a missing column", func() {
+			columns := []string{"id", "sensor.humidity", "name.first"}
+
+			Convey("Then ToCSVRow should write an empty string", func() {
+				row, err := ToCSVRow(m, columns)
+				So(err, ShouldBeNil)
+				So(row, ShouldResemble, []string{"1", "", ""})
+			})
+
+			Convey("Then an encoder requiring columns should fail", func() {
+				e := &CSVRowEncoder{ErrorOnMissing: true}
+				_, err := e.Encode(m, columns)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "sensor.humidity")
+			})
+		})
+
+		Convey("When converting an invalid column", func() {
+			_, err := ToCSVRow(m, []string{"id", "a..["})
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestFromCSVRecord(t *testing.T) {
+	Convey("Given a header and type hints", t, func() {
+		header := []string{"id", "temp", "name", "ok", "ts", "tags"}
+		types := map[string]TypeID{
+			"id":   TypeInt,
+			"temp": TypeFloat,
+			"ok":   TypeBool,
+			"ts":   TypeTimestamp,
+			"tags": TypeArray,
+		}
+
+		Convey("When decoding a valid record", func() {
+			m, err := FromCSVRecord(header, []string{"12", "21.5", "007", "true",
+				"2015-04-10T10:23:45Z", `["x",1]`}, types)
+
+			Convey("Then each column should have the hinted type", func() {
+				So(err, ShouldBeNil)
+				So(m, ShouldResemble, Map{
+					"id":   Int(12),
+					"temp": Float(21.5),
+					"name": String("007"),
+					"ok":   Bool(true),
+					"ts":   Timestamp(time.Date(2015, time.April, 10, 10, 23, 45, 0, time.UTC)),
+					"tags": Array{String("x"), Int(1)},
+				})
+			})
+		})
+
+		Convey("When decoding a record having empty fields", func() {
+			m, err := FromCSVRecord(header, []string{"", "", "", "", "", ""}, types)
+
+			Convey("Then typed columns should be null", func() {
+				So(err, ShouldBeNil)
+				So(m, ShouldResemble, Map{
+					"id":   Null{},
+					"temp": Null{},
+					"name": String(""),
+					"ok":   Null{},
+					"ts":   Null{},
+					"tags": Null{},
+				})
+			})
+		})
+
+		Convey("When decoding a record having a malformed number", func() {
+			_, err := FromCSVRecord(header, []string{"12", "hot", "a", "true", "", ""}, types)
+
+			Convey("Then it should fail with the column name", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "temp")
+			})
+		})
+
+		Convey("When decoding a float in an int column", func() {
+			_, err := FromCSVRecord(header, []string{"1.5", "1", "a", "true", "", ""}, types)
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "id")
+			})
+		})
+
+		Convey("When decoding a record having too few fields", func() {
+			_, err := FromCSVRecord(header, []string{"1"}, types)
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
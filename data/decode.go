@@ -1,14 +1,14 @@
 package data
 
 import (
-	"bytes"
 	"errors"
 	"fmt"
 	"reflect"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
-	"github.com/fatih/camelcase"
 	multierror "github.com/hashicorp/go-multierror"
 )
 
@@ -21,8 +21,11 @@ import (
 //	* float32, float64
 //	* string
 //	* data.Value (used like interface{})
+//	* interface (with discriminator option)
 //	* map, data.Map
 //	* slice, data.Array
+//	* []interface{} (each element is a data.Value)
+//	* array (the length of a source Array must match the length of the array)
 //	* struct, embedded struct
 //	* Blob
 //	* time.Time, Timestamp (see ToTimestamp to know supported values)
@@ -32,8 +35,7 @@ import (
 //		* string: Go's duration format (e.g. "6s" => 6 * time.Second)
 //	* pointer of these types
 //
-// A regular array is not supported yet. User defined time.Time-compatible
-// types cannot be used.
+// User defined time.Time-compatible types cannot be used.
 //
 // By default, the name of a field is converted to snake_case. For example,
 //
@@ -44,7 +46,8 @@ import (
 //	}
 //
 // these fields are looked up by "param_1", "another_param_1", and
-// "another_param_3", respectively. Names are case-sensitive.
+// "another_param_3", respectively. Names are case-sensitive. The conversion
+// can be changed by DecoderConfig.FieldNameFunc.
 //
 // A field has a custom name by specifying a tag:
 //
@@ -86,10 +89,55 @@ import (
 // when it has an integer value. Similarly, an int can always be casted to
 // a float implicitly.
 //
+// A time.Time or Timestamp field decoded from an integer or a float, which
+// is the number of seconds elapsed since the Unix epoch by default, can have
+// a different resolution with epoch option:
+//
+//	struct {
+//		Param1 time.Time `bql:"ts,epoch=ms"`
+//	}
+//
+// The unit can be s, ms, us, or ns. Values of other types, such as strings,
+// are decoded in the same way as a field without the option. epoch option
+// cannot be used for fields of other types.
+//
+// A field of an interface type can be decoded from a Map whose concrete type
+// is chosen by a string value in the Map, like the configuration of a UDS
+// storage, with discriminator option:
+//
+//	struct {
+//		Storage Storage `bql:"storage,discriminator=type"`
+//	}
+//
+// The concrete types are registered to DecoderConfig.Variants by
+// DecoderConfig.RegisterVariant. When the types FSStorage and InMemoryStorage
+// are registered as "fs" and "in_memory" for Storage, respectively, a Map
+// {"type": "fs", "dir": "/x"} is decoded into a FSStorage and {"type":
+// "in_memory"} is decoded into an InMemoryStorage. The discriminator is
+// removed from the Map before the Map is decoded into the concrete type.
+//
+// Values of numeric fields can be validated with min and max options, and
+// values of string fields with oneof option, which has candidates separated
+// by "|":
+//
+//	struct {
+//		Port int    `bql:"port,min=1,max=65535"`
+//		Mode string `bql:"mode,oneof=fast|safe"`
+//	}
+//
+// Both bounds of min and max are inclusive. The options are checked after
+// the field is decoded, so a missing field isn't validated. time.Duration
+// fields cannot have min or max option.
+//
 // A field may have multiple options at once.
 type Decoder struct {
 	config *DecoderConfig
 
+	// plans caches fieldPlans of struct types so that tags are only parsed
+	// once for each type.
+	plansMutex sync.RWMutex
+	plans      map[reflect.Type][]fieldPlan
+
 	// This Decoder is strongly inspired by github.com/mitchellh/mapstructure.
 	// The decoder may be going to support "json" compatible tags in structs.
 }
@@ -108,9 +156,74 @@ type DecoderConfig struct {
 	// is "bql".
 	TagName string
 
+	// MaxDepth is the maximum nesting level of Maps and Arrays that Decoder
+	// processes. Decoder returns an error instead of decoding values nested
+	// more deeply so that untrusted input cannot exhaust the stack through
+	// recursive struct definitions. The default is 64.
+	MaxDepth int
+
+	// FieldNameFunc converts the name of a struct field to the key in a Map
+	// when the field doesn't have an explicit name in its tag. The default
+	// converts the name to snake_case (e.g. "MyField" to "my_field").
+	FieldNameFunc func(fieldName string) string
+
+	// Variants has concrete types of interface fields having discriminator
+	// option. The key of the outer map is the interface type and the key of
+	// the inner map is the value of the discriminator. Use RegisterVariant to
+	// add a type.
+	Variants map[reflect.Type]map[string]reflect.Type
+
+	// NullStrategy controls how Null is decoded to a field other than
+	// data.Value. With NullIsError, the default, Null is decoded like other
+	// values: it's an error for strictly typed fields and results in the
+	// zero value for weakly typed fields. With NullIsDefault, a field keeps
+	// its value when Null is given, so a default set before decoding is
+	// used.
+	NullStrategy NullStrategy
+
+	// SingleValueAsSlice allows a value other than an Array to be decoded to
+	// a slice field as a slice having the value as its only element, so that
+	// a field can be given either a single value or a list of values like
+	// "a" and ["a", "b"]. Null isn't wrapped and is decoded as usual.
+	SingleValueAsSlice bool
+
+	// StringToNumber allows a String to be decoded to integer and float
+	// fields by parsing it as ToInt and ToFloat do, even when the fields
+	// aren't weakly typed. It's useful when all values are given as strings,
+	// e.g. from environment variables or command line flags. Other
+	// conversions are as strict as usual.
+	StringToNumber bool
+
 	// TODO: case-insensitive matching flag
 }
 
+// RegisterVariant registers the type of variant as the concrete type used
+// for decoding a field of an interface type having discriminator option when
+// the discriminator is typeName. union must be a nil pointer to the interface
+// type, e.g. (*Storage)(nil). variant must implement the interface.
+func (c *DecoderConfig) RegisterVariant(union interface{}, typeName string, variant interface{}) error {
+	ut := reflect.TypeOf(union)
+	if ut == nil || ut.Kind() != reflect.Ptr || ut.Elem().Kind() != reflect.Interface {
+		return errors.New("union must be a pointer to an interface type")
+	}
+	ut = ut.Elem()
+	vt := reflect.TypeOf(variant)
+	if vt == nil || !vt.Implements(ut) {
+		return fmt.Errorf("%v doesn't implement %v", vt, ut)
+	}
+	if c.Variants == nil {
+		c.Variants = map[reflect.Type]map[string]reflect.Type{}
+	}
+	if c.Variants[ut] == nil {
+		c.Variants[ut] = map[string]reflect.Type{}
+	}
+	if _, ok := c.Variants[ut][typeName]; ok {
+		return fmt.Errorf("%v is already registered for %v", typeName, ut)
+	}
+	c.Variants[ut][typeName] = vt
+	return nil
+}
+
 // DecoderMetadata tracks field names that are used or not used for decoding.
 type DecoderMetadata struct {
 	// Keys contains keys in a Map that are processed.
@@ -120,7 +233,75 @@ type DecoderMetadata struct {
 	Unused []string
 }
 
-// NewDecoder creates a new Decoder with the given config.
+// DecodeError is an error returned from Decoder for a value which cannot be
+// decoded. When there are multiple problems, Decode returns a
+// *multierror.Error having a DecodeError for each of them. Its message has
+// the path of the value as a prefix, e.g. "nested.size: ...".
+type DecodeError struct {
+	// Path is the path of the value in the Map passed to Decoder, such as
+	// "nested.size" or `tags["a b"]`. It's empty for errors on the Map
+	// itself.
+	Path string
+
+	// Expected describes the type of values the field accepts, such as
+	// "a list". It's only set when the value has a wrong type.
+	Expected string
+
+	// Value is the value which cannot be decoded. It's nil when the value is
+	// missing or when the error is on the Map itself.
+	Value Value
+
+	// Missing is true when the value is required but missing.
+	Missing bool
+
+	// Err describes the problem.
+	Err error
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("%v: %v", e.Path, e.Err)
+}
+
+// newDecodeError creates a DecodeError of the value at the path.
+func newDecodeError(path string, v Value, format string, args ...interface{}) error {
+	return &DecodeError{
+		Path:  path,
+		Value: v,
+		Err:   fmt.Errorf(format, args...),
+	}
+}
+
+// newConversionDecodeError creates a DecodeError from an error returned from
+// As* or To* functions. expected is only set when the conversion failed
+// because of the type of v.
+func newConversionDecodeError(path, expected string, v Value, err error) error {
+	e := &DecodeError{
+		Path:  path,
+		Value: v,
+		Err:   err,
+	}
+	if _, ok := err.(*conversionError); ok {
+		e.Expected = expected
+	}
+	return e
+}
+
+// newMissingDecodeError creates a DecodeError of a required value missing at
+// the path.
+func newMissingDecodeError(path string) error {
+	return &DecodeError{
+		Path:    path,
+		Missing: true,
+		Err:     errors.New("required but missing"),
+	}
+}
+
+const defaultDecoderMaxDepth = 64
+
+// NewDecoder creates a new Decoder with the given config. A Decoder can be
+// used concurrently as long as the config doesn't have Metadata. Because the
+// Decoder caches information obtained from the definitions of structs,
+// TagName and FieldNameFunc of the config must not be changed afterwards.
 func NewDecoder(c *DecoderConfig) *Decoder {
 	if c == nil {
 		c = &DecoderConfig{}
@@ -128,6 +309,12 @@ func NewDecoder(c *DecoderConfig) *Decoder {
 	if c.TagName == "" {
 		c.TagName = "bql"
 	}
+	if c.MaxDepth <= 0 {
+		c.MaxDepth = defaultDecoderMaxDepth
+	}
+	if c.FieldNameFunc == nil {
+		c.FieldNameFunc = SnakeCase
+	}
 	return &Decoder{
 		config: c,
 	}
@@ -135,7 +322,21 @@ func NewDecoder(c *DecoderConfig) *Decoder {
 
 // Decode decodes a Map into a struct. The argument must be a pointer to a
 // struct.
-func (d *Decoder) Decode(m Map, v interface{}) (err error) {
+func (d *Decoder) Decode(m Map, v interface{}) error {
+	return d.decodeRoot(m, v, false)
+}
+
+// DecodePartial decodes a Map into a struct like Decode, but only updates
+// fields whose keys are in the Map. Fields whose keys are absent keep their
+// current values and required option isn't checked, so a struct having the
+// current state can be updated with a Map having only changed parameters.
+// Nested structs, including ones pointed by non-nil pointers, are updated in
+// the same way. Other values such as slices and maps are replaced as a whole.
+func (d *Decoder) DecodePartial(m Map, v interface{}) error {
+	return d.decodeRoot(m, v, true)
+}
+
+func (d *Decoder) decodeRoot(m Map, v interface{}, partial bool) (err error) {
 	defer func() {
 		// Because this function heavily depends on reflect and it has many
 		// chance to panic, this function catches it converts it to an error.
@@ -154,10 +355,20 @@ func (d *Decoder) Decode(m Map, v interface{}) (err error) {
 	if s.Kind() != reflect.Struct {
 		return errors.New("result must be pointer to a struct")
 	}
-	return d.decodeStruct("", m, s)
+	return d.decodeStruct("", m, s, 0, partial)
 }
 
-func (d *Decoder) decode(prefix string, src Value, dst reflect.Value, weaklyTyped bool) error {
+// decode decodes src into dst. depth is the nesting level of src in the
+// Map passed to Decode.
+func (d *Decoder) decode(prefix string, src Value, dst reflect.Value, weaklyTyped bool, depth int, partial bool) error {
+	if depth > d.config.MaxDepth {
+		return newDecodeError(prefix, src, "the value is nested too deeply (the maximum depth is %v)", d.config.MaxDepth)
+	}
+	if d.config.NullStrategy == NullIsDefault && src.Type() == TypeNull &&
+		dst.Kind() != reflect.Interface {
+		return nil
+	}
+
 	switch dst.Kind() {
 	case reflect.Bool:
 		return d.decodeBool(prefix, src, dst, weaklyTyped)
@@ -175,20 +386,27 @@ func (d *Decoder) decode(prefix string, src Value, dst reflect.Value, weaklyType
 
 	case reflect.Interface: // Only Value is supported
 		if dst.Type() != reflect.TypeOf(func(Value) {}).In(0) {
-			return fmt.Errorf("%v: interface{} other than data.Value is not supported", prefix)
+			return newDecodeError(prefix, src, "interface{} other than data.Value is not supported")
 		}
 		return d.decodeValue(prefix, src, dst)
 
 	case reflect.Map:
-		return d.decodeMap(prefix, src, dst, weaklyTyped)
+		return d.decodeMap(prefix, src, dst, weaklyTyped, depth)
 
 	case reflect.Slice:
-		return d.decodeSlice(prefix, src, dst, weaklyTyped)
+		return d.decodeSlice(prefix, src, dst, weaklyTyped, depth)
+
+	case reflect.Array:
+		return d.decodeArray(prefix, src, dst, weaklyTyped, depth)
 
 	case reflect.Struct:
-		return d.decodeStruct(prefix, src, dst)
+		return d.decodeStruct(prefix, src, dst, depth, partial)
 
 	case reflect.Ptr:
+		if partial && !dst.IsNil() && dst.Type().Elem().Kind() == reflect.Struct {
+			return d.decode(prefix, src, dst.Elem(), weaklyTyped, depth, partial)
+		}
+
 		// To decode a value to dst, dst must be addressable. However,
 		// reflect.ValueOf or reflect.Zero may return non-addressable values
 		// especially when value is of primitive types. The following
@@ -196,13 +414,18 @@ func (d *Decoder) decode(prefix string, src Value, dst reflect.Value, weaklyType
 		// a pointer that points to a non-nil addressable value. Then,
 		// reflect.Indirect returns an element pointed by the pointer.
 		v := reflect.New(dst.Type().Elem())
-		if err := d.decode(prefix, src, reflect.Indirect(v), weaklyTyped); err != nil {
+		if err := d.decode(prefix, src, reflect.Indirect(v), weaklyTyped, depth, partial); err != nil {
 			return err
 		}
 		dst.Set(v)
 		return nil
+
+	case reflect.Chan, reflect.Func, reflect.Complex64, reflect.Complex128:
+		return newDecodeError(prefix, src, "decoder doesn't support the type: %v (%v cannot be decoded; "+
+			"use bool, integers, floats, string, slices, arrays, maps, structs, pointers, or data.Value instead)",
+			dst.Type(), dst.Kind())
 	}
-	return fmt.Errorf("%v: decoder doesn't support the type: %v", prefix, dst.Kind())
+	return newDecodeError(prefix, src, "decoder doesn't support the type: %v (kind: %v)", dst.Type(), dst.Kind())
 }
 
 func (d *Decoder) decodeBool(prefix string, src Value, dst reflect.Value, weaklyTyped bool) error {
@@ -216,7 +439,7 @@ func (d *Decoder) decodeBool(prefix string, src Value, dst reflect.Value, weakly
 		b, err = AsBool(src)
 	}
 	if err != nil {
-		return fmt.Errorf("%v: %v", prefix, err)
+		return newConversionDecodeError(prefix, "a bool", src, err)
 	}
 	dst.SetBool(b)
 	return nil
@@ -232,7 +455,7 @@ func (d *Decoder) decodeInt(prefix string, src Value, dst reflect.Value, weaklyT
 		err error
 	)
 
-	if weaklyTyped {
+	if weaklyTyped || (d.config.StringToNumber && src.Type() == TypeString) {
 		i, err = ToInt(src)
 	} else {
 		i, err = AsInt(src)
@@ -247,7 +470,7 @@ func (d *Decoder) decodeInt(prefix string, src Value, dst reflect.Value, weaklyT
 		}
 	}
 	if err != nil {
-		return fmt.Errorf("%v: %v", prefix, err)
+		return newConversionDecodeError(prefix, "an integer", src, err)
 	}
 	dst.SetInt(i)
 	return nil
@@ -258,7 +481,7 @@ func (d *Decoder) decodeDuration(prefix string, src Value, dst reflect.Value) er
 	// value is always weaklytyped.
 	dur, err := ToDuration(src)
 	if err != nil {
-		return fmt.Errorf("%v: %v", prefix, err)
+		return newConversionDecodeError(prefix, "a duration", src, err)
 	}
 	dst.Set(reflect.ValueOf(dur))
 	return nil
@@ -269,7 +492,7 @@ func (d *Decoder) decodeFloat(prefix string, src Value, dst reflect.Value, weakl
 		f   float64
 		err error
 	)
-	if weaklyTyped {
+	if weaklyTyped || (d.config.StringToNumber && src.Type() == TypeString) {
 		f, err = ToFloat(src)
 	} else {
 		f, err = AsFloat(src)
@@ -280,7 +503,7 @@ func (d *Decoder) decodeFloat(prefix string, src Value, dst reflect.Value, weakl
 		}
 	}
 	if err != nil {
-		return fmt.Errorf("%v: %v", prefix, err)
+		return newConversionDecodeError(prefix, "a float", src, err)
 	}
 	dst.SetFloat(f)
 	return nil
@@ -297,7 +520,7 @@ func (d *Decoder) decodeString(prefix string, src Value, dst reflect.Value, weak
 		s, err = AsString(src)
 	}
 	if err != nil {
-		return fmt.Errorf("%v: %v", prefix, err)
+		return newConversionDecodeError(prefix, "a string", src, err)
 	}
 	dst.SetString(s)
 	return nil
@@ -308,15 +531,28 @@ func (d *Decoder) decodeValue(prefix string, src Value, dst reflect.Value) error
 	return nil
 }
 
-func (d *Decoder) decodeMap(prefix string, src Value, dst reflect.Value, weaklyTyped bool) error {
+// decodeInterface decodes src into an element of []interface{}. The Value is
+// stored as it is, so the element has the original type such as Int or Map
+// rather than Go's native type.
+func (d *Decoder) decodeInterface(prefix string, src Value, dst reflect.Value) error {
+	dst.Set(reflect.ValueOf(src))
+	return nil
+}
+
+func (d *Decoder) decodeMap(prefix string, src Value, dst reflect.Value, weaklyTyped bool, depth int) error {
 	if src.Type() != TypeMap {
-		return fmt.Errorf("%v: cannot decode to a map: %v", prefix, src.Type())
+		return &DecodeError{
+			Path:     prefix,
+			Expected: "a map",
+			Value:    src,
+			Err:      fmt.Errorf("cannot decode to a map: %v", src.Type()),
+		}
 	}
 	m, _ := AsMap(src)
 
 	t := dst.Type()
 	if k := t.Key().Kind(); k != reflect.String {
-		return fmt.Errorf("%v: key must be string: %v", prefix, k)
+		return newDecodeError(prefix, src, "key must be string: %v", k)
 	}
 	valueType := t.Elem()
 
@@ -324,7 +560,7 @@ func (d *Decoder) decodeMap(prefix string, src Value, dst reflect.Value, weaklyT
 	res := reflect.MakeMap(t)
 	for k, e := range m {
 		v := reflect.Indirect(reflect.New(valueType))
-		if err := d.decode(fmt.Sprintf(`%v["%v"]`, prefix, k), e, v, weaklyTyped); err != nil {
+		if err := d.decode(fmt.Sprintf(`%v["%v"]`, prefix, k), e, v, weaklyTyped, depth+1, false); err != nil {
 			errs = multierror.Append(errs, err)
 			continue
 		}
@@ -337,13 +573,33 @@ func (d *Decoder) decodeMap(prefix string, src Value, dst reflect.Value, weaklyT
 	return errs
 }
 
-func (d *Decoder) decodeSlice(prefix string, src Value, dst reflect.Value, weaklyTyped bool) error {
+func (d *Decoder) decodeSlice(prefix string, src Value, dst reflect.Value, weaklyTyped bool, depth int) error {
 	if dst.Type().Elem().Kind() == reflect.Uint8 {
 		return d.decodeBlob(prefix, src, dst, weaklyTyped)
 	}
 
+	decodeElem := func(prefix string, src Value, dst reflect.Value) error {
+		return d.decode(prefix, src, dst, weaklyTyped, depth+1, false)
+	}
+	if elem := dst.Type().Elem(); elem.Kind() == reflect.Interface && elem.NumMethod() == 0 {
+		decodeElem = d.decodeInterface
+	}
+
 	if src.Type() != TypeArray {
-		return fmt.Errorf("%v: cannot decode to an array: %v", prefix, src.Type())
+		if !d.config.SingleValueAsSlice || src.Type() == TypeNull {
+			return &DecodeError{
+				Path:     prefix,
+				Expected: "a list",
+				Value:    src,
+				Err:      fmt.Errorf("cannot decode to an array: %v", src.Type()),
+			}
+		}
+		res := reflect.MakeSlice(dst.Type(), 1, 1)
+		if err := decodeElem(prefix, src, res.Index(0)); err != nil {
+			return err
+		}
+		dst.Set(res)
+		return nil
 	}
 	a, _ := AsArray(src)
 
@@ -351,7 +607,37 @@ func (d *Decoder) decodeSlice(prefix string, src Value, dst reflect.Value, weakl
 	res := reflect.MakeSlice(dst.Type(), len(a), len(a))
 	for i, e := range a {
 		v := res.Index(i)
-		if err := d.decode(fmt.Sprintf("%v[%v]", prefix, i), e, v, weaklyTyped); err != nil {
+		if err := decodeElem(fmt.Sprintf("%v[%v]", prefix, i), e, v); err != nil {
+			errs = multierror.Append(errs, err)
+			continue
+		}
+	}
+	dst.Set(res)
+	if errs == nil {
+		return nil // DO NOT return errs even if it's nil
+	}
+	return errs
+}
+
+func (d *Decoder) decodeArray(prefix string, src Value, dst reflect.Value, weaklyTyped bool, depth int) error {
+	if src.Type() != TypeArray {
+		return &DecodeError{
+				Path:     prefix,
+				Expected: "a list",
+				Value:    src,
+				Err:      fmt.Errorf("cannot decode to an array: %v", src.Type()),
+			}
+	}
+	a, _ := AsArray(src)
+	if len(a) != dst.Len() {
+		return newDecodeError(prefix, src, "the array must have %v elements: %v", dst.Len(), len(a))
+	}
+
+	var errs *multierror.Error
+	res := reflect.Indirect(reflect.New(dst.Type()))
+	for i, e := range a {
+		v := res.Index(i)
+		if err := d.decode(fmt.Sprintf("%v[%v]", prefix, i), e, v, weaklyTyped, depth+1, false); err != nil {
 			errs = multierror.Append(errs, err)
 			continue
 		}
@@ -374,13 +660,13 @@ func (d *Decoder) decodeBlob(prefix string, src Value, dst reflect.Value, weakly
 		b, err = AsBlob(src)
 	}
 	if err != nil {
-		return fmt.Errorf("%v: %v", prefix, err)
+		return newConversionDecodeError(prefix, "a blob", src, err)
 	}
 	dst.Set(reflect.ValueOf(b))
 	return nil
 }
 
-func (d *Decoder) decodeStruct(prefix string, src Value, dst reflect.Value) error {
+func (d *Decoder) decodeStruct(prefix string, src Value, dst reflect.Value, depth int, partial bool) error {
 	if dst.Type().ConvertibleTo(reflect.TypeOf(time.Time{})) {
 		if prefix == "" {
 			// time.Time or Timestamp is passed directly to Decode. They have
@@ -392,7 +678,12 @@ func (d *Decoder) decodeStruct(prefix string, src Value, dst reflect.Value) erro
 
 	m, err := AsMap(src)
 	if err != nil {
-		return fmt.Errorf("%v: struct can only be decoded from a map", prefix)
+		return &DecodeError{
+			Path:     prefix,
+			Expected: "a map",
+			Value:    src,
+			Err:      errors.New("struct can only be decoded from a map"),
+		}
 	}
 
 	errPrefix := prefix + "."
@@ -409,7 +700,7 @@ func (d *Decoder) decodeStruct(prefix string, src Value, dst reflect.Value) erro
 	}
 
 	// Accumulate all error informations to help users debug BQL.
-	errs := d.iterateField(errPrefix, m, unused, dst)
+	errs := d.iterateField(errPrefix, m, unused, dst, depth, partial)
 	if d.config.ErrorUnused && len(unused) > 0 {
 		keys := make([]string, len(unused))
 		i := 0
@@ -421,7 +712,7 @@ func (d *Decoder) decodeStruct(prefix string, src Value, dst reflect.Value) erro
 			d.config.Metadata.Unused = append(d.config.Metadata.Unused, keys...)
 		}
 
-		errs = multierror.Append(errs, fmt.Errorf("%v: unused keys: %v", prefix, strings.Join(keys, ", ")))
+		errs = multierror.Append(errs, newDecodeError(prefix, nil, "unused keys: %v", strings.Join(keys, ", ")))
 	}
 	if errs == nil {
 		// To avoid nil != nil problem due to type mismatch, this function has
@@ -433,7 +724,7 @@ func (d *Decoder) decodeStruct(prefix string, src Value, dst reflect.Value) erro
 	return errs
 }
 
-func (d *Decoder) iterateField(prefix string, m Map, unused map[string]struct{}, dst reflect.Value) *multierror.Error {
+func (d *Decoder) iterateField(prefix string, m Map, unused map[string]struct{}, dst reflect.Value, depth int, partial bool) *multierror.Error {
 	// FIXME: iterateField decodes the same value multiple times if a struct and
 	// its embedded struct have the same field names.
 
@@ -441,82 +732,381 @@ func (d *Decoder) iterateField(prefix string, m Map, unused map[string]struct{},
 	var errs *multierror.Error
 
 	if dst.Type().ConvertibleTo(reflect.TypeOf(time.Time{})) {
-		errs = multierror.Append(errs, fmt.Errorf("%v: time.Time and data.Timestamp cannot be embedded", prefix))
+		errs = multierror.Append(errs, newDecodeError(prefix, nil, "time.Time and data.Timestamp cannot be embedded"))
 		return errs
 	}
 
-	t := dst.Type()
+	for _, fp := range d.fieldPlans(dst.Type()) {
+		switch fp.embedded {
+		case embeddedStruct:
+			if err := d.iterateField(prefix, m, unused, dst.Field(fp.index), depth, partial); err != nil {
+				errs = multierror.Append(errs, err)
+			}
+			continue
+
+		case embeddedPtr:
+			f := dst.Field(fp.index)
+			if partial && !f.IsNil() {
+				if err := d.iterateField(prefix, m, unused, f.Elem(), depth, partial); err != nil {
+					errs = multierror.Append(errs, err)
+				}
+				continue
+			}
+			v := reflect.New(fp.typ.Elem())
+			if err := d.iterateField(prefix, m, unused, reflect.Indirect(v), depth, partial); err != nil {
+				errs = multierror.Append(errs, err)
+				continue
+			}
+			dst.Field(fp.index).Set(v)
+			continue
+		}
+		for _, e := range fp.errs {
+			errs = multierror.Append(errs, e(prefix))
+		}
+
+		name := fp.name
+		if d.config.ErrorUnused {
+			delete(unused, name)
+		}
+		src, ok := m[name]
+		if !ok {
+			if fp.required && !partial {
+				errs = multierror.Append(errs, newMissingDecodeError(prefix+name))
+			}
+			continue
+		}
+		if d.config.Metadata != nil {
+			d.config.Metadata.Keys = append(d.config.Metadata.Keys, name)
+		}
+
+		if fp.epochUnit != 0 {
+			src = epochToTimestamp(src, fp.epochUnit)
+		}
+		if fp.discriminator != "" {
+			if err := d.decodeVariant(prefix+name, src, dst.Field(fp.index), fp.discriminator, depth+1); err != nil {
+				errs = multierror.Append(errs, err)
+			}
+			continue
+		}
+		if err := d.decode(prefix+name, src, dst.Field(fp.index), fp.weaklyTyped, depth+1, partial); err != nil {
+			errs = multierror.Append(errs, err)
+			continue
+		}
+		if err := fp.validate(dst.Field(fp.index)); err != nil {
+			errs = multierror.Append(errs, &DecodeError{Path: prefix + name, Value: src, Err: err})
+		}
+	}
+	return errs
+}
+
+type embeddedKind int
+
+const (
+	notEmbedded embeddedKind = iota
+	embeddedStruct
+	embeddedPtr
+)
+
+// fieldPlan has information of a struct field obtained from its definition
+// and its tag. Because it doesn't depend on the value being decoded, it's
+// computed only once for each struct type and cached in Decoder.
+type fieldPlan struct {
+	index    int
+	typ      reflect.Type
+	embedded embeddedKind
+
+	name          string
+	required      bool
+	weaklyTyped   bool
+	epochUnit     int64 // the number of units per second
+	discriminator string
+
+	// validation options, min and max are nil when not specified
+	min   *float64
+	max   *float64
+	oneOf []string
+
+	// errs has errors in the definition of the field. They are reported
+	// every time the field is decoded with the prefix of the field.
+	errs []func(prefix string) error
+}
+
+// fieldPlans returns the fieldPlans of all fields of the struct type t.
+func (d *Decoder) fieldPlans(t reflect.Type) []fieldPlan {
+	d.plansMutex.RLock()
+	ps, ok := d.plans[t]
+	d.plansMutex.RUnlock()
+	if ok {
+		return ps
+	}
+
+	ps = d.newFieldPlans(t)
+	d.plansMutex.Lock()
+	defer d.plansMutex.Unlock()
+	if d.plans == nil {
+		d.plans = map[reflect.Type][]fieldPlan{}
+	}
+	d.plans[t] = ps
+	return ps
+}
+
+func (d *Decoder) newFieldPlans(t reflect.Type) []fieldPlan {
+	ps := make([]fieldPlan, 0, t.NumField())
 	for i, n := 0, t.NumField(); i < n; i++ {
 		f := t.Field(i)
+		fp := fieldPlan{
+			index: i,
+			typ:   f.Type,
+		}
 		if f.Anonymous { // process embedded field
 			if f.Type.Kind() == reflect.Struct {
-				if err := d.iterateField(prefix, m, unused, dst.Field(i)); err != nil {
-					errs = multierror.Append(errs, err)
-				}
+				fp.embedded = embeddedStruct
+				ps = append(ps, fp)
 				continue
 
 			} else if f.Type.Kind() == reflect.Ptr && f.Type.Elem().Kind() == reflect.Struct {
-				v := reflect.New(f.Type.Elem())
-				if err := d.iterateField(prefix, m, unused, reflect.Indirect(v)); err != nil {
-					errs = multierror.Append(errs, err)
-					continue
-				}
-				dst.Field(i).Set(v)
+				fp.embedded = embeddedPtr
+				ps = append(ps, fp)
 				continue
 			}
-			errs = multierror.Append(errs, fmt.Errorf("%v: unsupported embedded field: %v", prefix, f.Name))
+			fp.addError(func(prefix string) error {
+				return newDecodeError(prefix, nil, "unsupported embedded field: %v", f.Name)
+			})
 		}
 
 		opts := strings.Split(f.Tag.Get(d.config.TagName), ",")
 
 		// parse options
-		var (
-			required    bool
-			weaklyTyped bool
-		)
 		for ti, opt := range opts {
 			if ti == 0 { // skip name
 				continue
 			}
 			switch opt {
 			case "required":
-				required = true
+				fp.required = true
 			case "weaklytyped":
-				weaklyTyped = true
+				fp.weaklyTyped = true
 			default:
 				var err error
 				if opt == "" {
-					err = errors.New("empty option name is not allowed")
+					fp.addError(func(string) error {
+						return errors.New("empty option name is not allowed")
+					})
+					continue
+				} else if strings.HasPrefix(opt, "epoch=") {
+					fp.epochUnit, err = parseEpochOption(f, opt[len("epoch="):])
+				} else if strings.HasPrefix(opt, "min=") {
+					fp.min, err = parseBoundOption(f, opt[len("min="):])
+				} else if strings.HasPrefix(opt, "max=") {
+					fp.max, err = parseBoundOption(f, opt[len("max="):])
+				} else if strings.HasPrefix(opt, "oneof=") {
+					fp.oneOf, err = parseOneOfOption(f, opt[len("oneof="):])
+				} else if strings.HasPrefix(opt, "discriminator=") {
+					fp.discriminator = opt[len("discriminator="):]
+					if fp.discriminator == "" {
+						err = errors.New("discriminator option requires a key")
+					} else if f.Type.Kind() != reflect.Interface {
+						err = errors.New("discriminator option can only be used for interface fields")
+						fp.discriminator = ""
+					}
 				} else {
-					err = fmt.Errorf("%v%v: an undefined option: %v", prefix, f.Name, opt)
+					err = fmt.Errorf("an undefined option: %v", opt)
+				}
+				if err != nil {
+					fp.addError(func(prefix string) error {
+						return &DecodeError{Path: prefix + f.Name, Err: err}
+					})
 				}
-				errs = multierror.Append(errs, err)
 			}
 		}
 
-		name := strings.TrimSpace(opts[0])
-		if name == "" {
-			name = toSnakeCase(f.Name)
+		if fp.min != nil && fp.max != nil && *fp.min > *fp.max {
+			fp.addError(func(prefix string) error {
+				return newDecodeError(prefix+f.Name, nil, "min option must not be greater than max option")
+			})
 		}
-		if d.config.ErrorUnused {
-			delete(unused, name)
+
+		fp.name = strings.TrimSpace(opts[0])
+		if fp.name == "" {
+			fp.name = d.config.FieldNameFunc(f.Name)
 		}
-		src, ok := m[name]
-		if !ok {
-			if required {
-				errs = multierror.Append(errs, fmt.Errorf("%v%v: required but missing", prefix, name))
+		ps = append(ps, fp)
+	}
+	return ps
+}
+
+func (fp *fieldPlan) addError(e func(prefix string) error) {
+	fp.errs = append(fp.errs, e)
+}
+
+// validate checks the decoded value of the field against its min, max, and
+// oneof options.
+func (fp *fieldPlan) validate(v reflect.Value) error {
+	if fp.min == nil && fp.max == nil && fp.oneOf == nil {
+		return nil
+	}
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+
+	if fp.oneOf != nil {
+		s := v.String()
+		for _, c := range fp.oneOf {
+			if s == c {
+				return nil
 			}
-			continue
 		}
-		if d.config.Metadata != nil {
-			d.config.Metadata.Keys = append(d.config.Metadata.Keys, name)
+		return fmt.Errorf("%q is not one of %v", s, strings.Join(fp.oneOf, ", "))
+	}
+
+	var n float64
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n = float64(v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n = float64(v.Uint())
+	default:
+		n = v.Float()
+	}
+	if fp.min != nil && n < *fp.min {
+		return fmt.Errorf("%v is less than the minimum %v", v.Interface(), *fp.min)
+	}
+	if fp.max != nil && n > *fp.max {
+		return fmt.Errorf("%v is greater than the maximum %v", v.Interface(), *fp.max)
+	}
+	return nil
+}
+
+// underlyingType returns the type pointed by t when t is a pointer.
+func underlyingType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t
+}
+
+// parseBoundOption parses the value of min or max option of the field f.
+func parseBoundOption(f reflect.StructField, bound string) (*float64, error) {
+	numeric := false
+	switch t := underlyingType(f.Type); t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		numeric = t != reflect.TypeOf(time.Duration(0))
+	}
+	if !numeric {
+		return nil, errors.New("min and max options can only be used for numeric fields")
+	}
+	b, err := strconv.ParseFloat(bound, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid bound: %v", bound)
+	}
+	return &b, nil
+}
+
+// parseOneOfOption parses the value of oneof option of the field f.
+func parseOneOfOption(f reflect.StructField, candidates string) ([]string, error) {
+	if underlyingType(f.Type).Kind() != reflect.String {
+		return nil, errors.New("oneof option can only be used for string fields")
+	}
+	if candidates == "" {
+		return nil, errors.New("oneof option requires candidates")
+	}
+	return strings.Split(candidates, "|"), nil
+}
+
+// decodeVariant decodes src into dst, which is an interface, by allocating
+// the concrete type registered for the value of the key in src.
+func (d *Decoder) decodeVariant(prefix string, src Value, dst reflect.Value, key string, depth int) error {
+	if depth > d.config.MaxDepth {
+		return newDecodeError(prefix, src, "the value is nested too deeply (the maximum depth is %v)", d.config.MaxDepth)
+	}
+
+	m, err := AsMap(src)
+	if err != nil {
+		return &DecodeError{
+			Path:     prefix,
+			Expected: "a map",
+			Value:    src,
+			Err:      errors.New("a variant can only be decoded from a map"),
 		}
+	}
+	v, ok := m[key]
+	if !ok {
+		return newMissingDecodeError(prefix + "." + key)
+	}
+	typeName, err := AsString(v)
+	if err != nil {
+		return newConversionDecodeError(prefix+"."+key, "a string", v, err)
+	}
+	vt, ok := d.config.Variants[dst.Type()][typeName]
+	if !ok {
+		return newDecodeError(prefix+"."+key, v, "an unknown type: %v", typeName)
+	}
+	if d.config.Metadata != nil {
+		d.config.Metadata.Keys = append(d.config.Metadata.Keys, key)
+	}
 
-		if err := d.decode(prefix+name, src, dst.Field(i), weaklyTyped); err != nil {
-			errs = multierror.Append(errs, err)
+	body := make(Map, len(m))
+	for k, v := range m {
+		if k != key {
+			body[k] = v
 		}
 	}
-	return errs
+	res := reflect.New(vt)
+	if err := d.decode(prefix, body, reflect.Indirect(res), false, depth, false); err != nil {
+		return err
+	}
+	dst.Set(reflect.Indirect(res))
+	return nil
+}
+
+// parseEpochOption parses the value of epoch option of the field f and
+// returns the number of units per second.
+func parseEpochOption(f reflect.StructField, unit string) (int64, error) {
+	t := f.Type
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if !t.ConvertibleTo(reflect.TypeOf(time.Time{})) {
+		return 0, fmt.Errorf("epoch option can only be used for time.Time and data.Timestamp, not %v", f.Type)
+	}
+
+	switch unit {
+	case "s":
+		return 1, nil
+	case "ms":
+		return 1e3, nil
+	case "us":
+		return 1e6, nil
+	case "ns":
+		return 1e9, nil
+	}
+	return 0, fmt.Errorf("an undefined epoch unit: '%v' (must be s, ms, us, or ns)", unit)
+}
+
+// epochToTimestamp converts an Int or a Float having the number of units
+// elapsed since the Unix epoch to a Timestamp. Values of other types are
+// returned as they are.
+func epochToTimestamp(v Value, perSec int64) Value {
+	switch v.Type() {
+	case TypeInt:
+		i, _ := v.asInt()
+		return Timestamp(time.Unix(i/perSec, i%perSec*(1e9/perSec)))
+	case TypeFloat:
+		f, _ := v.asFloat()
+		sec := f / float64(perSec)
+		if sec < MinConvFloat64 || sec > MaxConvFloat64 {
+			// decodeTimestamp reports the error
+			return Float(sec)
+		}
+		integralPart := int64(sec)
+		return Timestamp(time.Unix(integralPart, int64(1e9*(sec-float64(integralPart)))))
+	}
+	return v
 }
 
 func (d *Decoder) decodeTimestamp(prefix string, src Value, dst reflect.Value) error {
@@ -526,7 +1116,7 @@ func (d *Decoder) decodeTimestamp(prefix string, src Value, dst reflect.Value) e
 
 	t, err := ToTimestamp(src)
 	if err != nil {
-		return fmt.Errorf("%v: %v", prefix, err)
+		return newConversionDecodeError(prefix, "a timestamp", src, err)
 	}
 	switch dst.Interface().(type) {
 	case time.Time:
@@ -534,27 +1124,19 @@ func (d *Decoder) decodeTimestamp(prefix string, src Value, dst reflect.Value) e
 	case Timestamp:
 		dst.Set(reflect.ValueOf(Timestamp(t)))
 	default:
-		return fmt.Errorf("%v: only time.Time and data.Timestamp can be used for decoding a timestamp", prefix)
+		return newDecodeError(prefix, src, "only time.Time and data.Timestamp can be used for decoding a timestamp")
 	}
 	return nil
 }
 
-func toSnakeCase(name string) string {
-	words := camelcase.Split(name)
-	buf := bytes.NewBuffer(nil)
-	for i, w := range words {
-		if i > 0 {
-			buf.WriteString("_")
-		}
-		buf.WriteString(strings.ToLower(w))
-	}
-	return string(buf.Bytes())
-}
+// defaultDecoder is shared by all calls of Decode so that they can share
+// the cache of struct definitions.
+var defaultDecoder = NewDecoder(&DecoderConfig{
+	ErrorUnused: true,
+})
 
 // Decode decodes a Map into a struct. The argument must be a pointer to a
 // struct.
 func Decode(m Map, v interface{}) error {
-	return NewDecoder(&DecoderConfig{
-		ErrorUnused: true,
-	}).Decode(m, v)
+	return defaultDecoder.Decode(m, v)
 }
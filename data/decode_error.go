@@ -0,0 +1,98 @@
+package data
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hashicorp/go-multierror"
+)
+
+// decodeErrValueMaxLength is the maximum number of characters of a value
+// shown in a message formatted by FormatDecodeError.
+const decodeErrValueMaxLength = 32
+
+// decodeErrTypeDescriptions has descriptions of the types of values shown in
+// messages formatted by FormatDecodeError.
+var decodeErrTypeDescriptions = map[TypeID]string{
+	TypeBool:      "a bool",
+	TypeInt:       "an integer",
+	TypeFloat:     "a float",
+	TypeString:    "a string",
+	TypeBlob:      "a blob",
+	TypeTimestamp: "a timestamp",
+	TypeArray:     "a list",
+	TypeMap:       "a map",
+}
+
+// FormatDecodeError converts an error returned from Decoder.Decode into a
+// message for BQL users such as "parameter 'items' expected a list but got a
+// string 'a'". When err has multiple errors, each of them is formatted on its
+// own line.
+//
+// Errors other than DecodeError are formatted as they are.
+func FormatDecodeError(err error) string {
+	if err == nil {
+		return ""
+	}
+	var lines []string
+	for _, e := range flattenDecodeError(err) {
+		lines = append(lines, formatDecodeErrorMessage(e))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func flattenDecodeError(err error) []error {
+	me, ok := err.(*multierror.Error)
+	if !ok {
+		return []error{err}
+	}
+	var errs []error
+	for _, e := range me.Errors {
+		errs = append(errs, flattenDecodeError(e)...)
+	}
+	return errs
+}
+
+func formatDecodeErrorMessage(err error) string {
+	e, ok := err.(*DecodeError)
+	if !ok {
+		return err.Error()
+	}
+	switch {
+	case e.Path == "":
+		// Errors on the root Map, such as unused keys, don't have a path.
+		return e.Err.Error()
+	case e.Missing:
+		return "parameter '" + e.Path + "' is required but missing"
+	case e.Expected != "":
+		msg := "parameter '" + e.Path + "' expected " + e.Expected
+		if e.Value != nil {
+			msg += " but got " + describeDecodedValue(e.Value)
+		}
+		return msg
+	}
+	return "parameter '" + e.Path + "': " + e.Err.Error()
+}
+
+// describeDecodedValue returns a description of a value like "a string 'a'".
+// Long values are truncated.
+func describeDecodedValue(v Value) string {
+	var s string
+	switch v.Type() {
+	case TypeNull:
+		return "null"
+	case TypeString:
+		s, _ = AsString(v)
+	default:
+		s = v.String()
+	}
+	if utf8.RuneCountInString(s) > decodeErrValueMaxLength {
+		s = string([]rune(s)[:decodeErrValueMaxLength]) + "..."
+	}
+
+	t := decodeErrTypeDescriptions[v.Type()]
+	if t == "" {
+		t = "a value"
+	}
+	return t + " '" + s + "'"
+}
@@ -0,0 +1,153 @@
+package data
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestFormatDecodeError(t *testing.T) {
+	Convey("Given a decoder and a struct having required parameters", t, func() {
+		d := NewDecoder(nil)
+		type nested struct {
+			Size int `bql:",required"`
+		}
+		type params struct {
+			Items  []string `bql:",required"`
+			Nested nested
+			Tags   map[string]string
+		}
+
+		Convey("When decoding a string to a slice", func() {
+			m := Map{"items": String("a,b,c")}
+			err := d.Decode(m, &params{})
+			So(err, ShouldNotBeNil)
+
+			Convey("Then the message should contain the actual value", func() {
+				So(FormatDecodeError(err), ShouldEqual,
+					"parameter 'items' expected a list but got a string 'a,b,c'")
+			})
+		})
+
+		Convey("When decoding a wrong value in a nested struct", func() {
+			m := Map{
+				"items":  Array{String("a")},
+				"nested": Map{"size": String(strings.Repeat("x", 40))},
+			}
+			err := d.Decode(m, &params{})
+			So(err, ShouldNotBeNil)
+
+			Convey("Then the message should have the path and a truncated value", func() {
+				So(FormatDecodeError(err), ShouldEqual,
+					"parameter 'nested.size' expected an integer but got a string '"+strings.Repeat("x", 32)+"...'")
+			})
+		})
+
+		Convey("When decoding a wrong value in a map", func() {
+			m := Map{
+				"items": Array{String("a")},
+				"tags":  Map{"key": Int(1)},
+			}
+			err := d.Decode(m, &params{})
+			So(err, ShouldNotBeNil)
+
+			Convey("Then the message should have the key in the path", func() {
+				So(FormatDecodeError(err), ShouldEqual,
+					`parameter 'tags["key"]' expected a string but got an integer '1'`)
+			})
+		})
+
+		Convey("When decoding a wrong value in a map with a key having spaces", func() {
+			m := Map{
+				"items": Array{String("a")},
+				"tags":  Map{"a b": Int(1)},
+			}
+			err := d.Decode(m, &params{})
+			So(err, ShouldNotBeNil)
+
+			Convey("Then the message should have the whole key in the path", func() {
+				So(FormatDecodeError(err), ShouldEqual,
+					`parameter 'tags["a b"]' expected a string but got an integer '1'`)
+			})
+		})
+
+		Convey("When required parameters are missing", func() {
+			m := Map{"nested": Map{}}
+			err := d.Decode(m, &params{})
+			So(err, ShouldNotBeNil)
+
+			Convey("Then the message should have a line for each parameter", func() {
+				So(FormatDecodeError(err), ShouldEqual, strings.Join([]string{
+					"parameter 'items' is required but missing",
+					"parameter 'nested.size' is required but missing",
+				}, "\n"))
+			})
+		})
+
+		Convey("When a validation fails", func() {
+			type minParams struct {
+				N int `bql:",min=1"`
+			}
+			m := Map{"n": Int(0)}
+			err := d.Decode(m, &minParams{})
+			So(err, ShouldNotBeNil)
+
+			Convey("Then the message should keep the original description", func() {
+				So(FormatDecodeError(err), ShouldEqual,
+					"parameter 'n': 0 is less than the minimum 1")
+			})
+		})
+	})
+
+	Convey("Given a decoder and a struct having an array of a fixed length", t, func() {
+		d := NewDecoder(nil)
+		type params struct {
+			Pairs map[string][2]int
+		}
+
+		Convey("When decoding an array having a wrong length to a key having spaces", func() {
+			m := Map{"pairs": Map{"a b": Array{Int(1)}}}
+			err := d.Decode(m, &params{})
+			So(err, ShouldNotBeNil)
+
+			Convey("Then the message should keep the original description with the path", func() {
+				So(FormatDecodeError(err), ShouldEqual,
+					`parameter 'pairs["a b"]': the array must have 2 elements: 1`)
+			})
+
+			Convey("Then the error should be a DecodeError having the path and the value", func() {
+				errs := flattenDecodeError(err)
+				So(errs, ShouldHaveLength, 1)
+				e, ok := errs[0].(*DecodeError)
+				So(ok, ShouldBeTrue)
+				So(e.Path, ShouldEqual, `pairs["a b"]`)
+				So(e.Value, ShouldResemble, Array{Int(1)})
+				So(e.Expected, ShouldBeEmpty)
+				So(e.Missing, ShouldBeFalse)
+			})
+		})
+
+		Convey("When decoding a string to the array", func() {
+			m := Map{"pairs": Map{"a b": String("1,2")}}
+			err := d.Decode(m, &params{})
+			So(err, ShouldNotBeNil)
+
+			Convey("Then the error should have the expected type", func() {
+				errs := flattenDecodeError(err)
+				So(errs, ShouldHaveLength, 1)
+				e, ok := errs[0].(*DecodeError)
+				So(ok, ShouldBeTrue)
+				So(e.Expected, ShouldEqual, "a list")
+				So(e.Value, ShouldEqual, String("1,2"))
+				So(e.Error(), ShouldEqual, `pairs["a b"]: cannot decode to an array: string`)
+			})
+		})
+	})
+
+	Convey("Given a nil error", t, func() {
+		Convey("Then it should be formatted to an empty string", func() {
+			So(FormatDecodeError(nil), ShouldBeEmpty)
+		})
+	})
+}
@@ -3,6 +3,7 @@ package data
 import (
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -206,7 +207,7 @@ func TestDecoder(t *testing.T) {
 		Convey("When decoding a struct from a non-map value", func() {
 			err := d.decodeStruct("", Int(1), reflect.Indirect(reflect.ValueOf(&struct {
 				I int
-			}{})))
+			}{})), 0, false)
 
 			Convey("Then it should fail", func() {
 				So(err, ShouldNotBeNil)
@@ -613,6 +614,28 @@ func TestDecodeUnsupportedType(t *testing.T) {
 			So(Decode(Map{"v": Int(1)}, &struct{ V chan int }{}), ShouldNotBeNil)
 		})
 
+		Convey("Non supported kinds should fail with the field name and the kind", func() {
+			err := Decode(Map{"events": Int(1)}, &struct{ Events chan int }{})
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "events: decoder doesn't support the type: chan int (chan cannot be decoded; use ")
+
+			err = Decode(Map{"cb": Int(1)}, &struct{ CB func() }{})
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "cb: decoder doesn't support the type: func() (func cannot be decoded; use ")
+
+			err = Decode(Map{"c": Int(1)}, &struct{ C complex128 }{})
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "c: decoder doesn't support the type: complex128 (complex128 cannot be decoded; use ")
+		})
+
+		Convey("Non supported kinds in nested fields should fail with the path", func() {
+			err := Decode(Map{"a": Map{"b": Array{Int(1)}}}, &struct {
+				A struct{ B []chan int }
+			}{})
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "a.b[0]: decoder doesn't support the type: chan int")
+		})
+
 		Convey("Decoding a non-boolean value to bool should fail", func() {
 			So(Decode(Map{"v": String("1")}, &struct{ V bool }{}), ShouldNotBeNil)
 		})
@@ -685,6 +708,32 @@ func TestDecodeUnsupportedType(t *testing.T) {
 			}{}), ShouldNotBeNil)
 		})
 
+		Convey("Decoding an array value to a fixed-length array should succeed", func() {
+			v := &struct {
+				V [3]float64
+				W [2]string `bql:",weaklytyped"`
+			}{}
+			So(Decode(Map{
+				"v": Array{Float(1.5), Int(2), Float(-3)},
+				"w": Array{Int(1), String("a")},
+			}, v), ShouldBeNil)
+			So(v.V, ShouldResemble, [3]float64{1.5, 2, -3})
+			So(v.W, ShouldResemble, [2]string{"1", "a"})
+		})
+
+		Convey("Decoding an array value having a different length to a fixed-length array should fail", func() {
+			So(Decode(Map{"v": Array{Int(1), Int(2)}}, &struct{ V [3]int }{}), ShouldNotBeNil)
+			So(Decode(Map{"v": Array{Int(1), Int(2), Int(3), Int(4)}}, &struct{ V [3]int }{}), ShouldNotBeNil)
+		})
+
+		Convey("Decoding a non-array value to a fixed-length array should fail", func() {
+			So(Decode(Map{"v": Blob("abc")}, &struct{ V [3]byte }{}), ShouldNotBeNil)
+		})
+
+		Convey("Decoding an array value having incompatible value type to a fixed-length array should fail", func() {
+			So(Decode(Map{"v": Array{Int(1), String("2")}}, &struct{ V [2]int }{}), ShouldNotBeNil)
+		})
+
 		Convey("Decoding a non-blob value to blob should fail", func() {
 			So(Decode(Map{"v": String("1")}, &struct{ V []byte }{}), ShouldNotBeNil)
 		})
@@ -734,16 +783,971 @@ func TestDecodeUnsupportedType(t *testing.T) {
 	})
 }
 
-func TestToSnakeCase(t *testing.T) {
-	Convey("toSnakeCase should transform camelcase to snake case", t, func() {
-		cases := [][]string{
-			{"Test", "test"},
-			{"ParseBQL", "parse_bql"},
-			{"B2B", "b_2_b"},
-		}
+type decodeTestNode struct {
+	Child    *decodeTestNode
+	Children []decodeTestNode
+}
+
+func nestedDecodeTestMap(depth int) Map {
+	m := Map{}
+	for i := 0; i < depth; i++ {
+		m = Map{"child": m}
+	}
+	return m
+}
+
+func TestDecoderMaxDepth(t *testing.T) {
+	Convey("Given a decoder with the default config", t, func() {
+		d := NewDecoder(nil)
+
+		Convey("When decoding a map nested within the limit", func() {
+			n := &decodeTestNode{}
+			err := d.Decode(nestedDecodeTestMap(63), n)
+
+			Convey("Then it should succeed", func() {
+				So(err, ShouldBeNil)
+				depth := 0
+				for c := n.Child; c != nil; c = c.Child {
+					depth++
+				}
+				So(depth, ShouldEqual, 63)
+			})
+		})
+
+		Convey("When decoding a map nested past the limit", func() {
+			err := d.Decode(nestedDecodeTestMap(1000), &decodeTestNode{})
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "nested too deeply")
+			})
+		})
+
+		Convey("When decoding arrays nested past the limit", func() {
+			m := Map{}
+			for i := 0; i < 100; i++ {
+				m = Map{"children": Array{m}}
+			}
+			err := d.Decode(m, &decodeTestNode{})
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "nested too deeply")
+			})
+		})
+	})
+
+	Convey("Given a decoder with a custom MaxDepth", t, func() {
+		d := NewDecoder(&DecoderConfig{MaxDepth: 3})
+
+		Convey("When decoding a map nested within the limit", func() {
+			Convey("Then it should succeed", func() {
+				So(d.Decode(nestedDecodeTestMap(3), &decodeTestNode{}), ShouldBeNil)
+			})
+		})
+
+		Convey("When decoding a map nested past the limit", func() {
+			Convey("Then it should fail", func() {
+				So(d.Decode(nestedDecodeTestMap(4), &decodeTestNode{}), ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestDecodeEpoch(t *testing.T) {
+	Convey("Given structs having timestamps with epoch option", t, func() {
+		s := &struct {
+			Sec   time.Time  `bql:"sec,epoch=s"`
+			Milli time.Time  `bql:"milli,epoch=ms"`
+			Micro Timestamp  `bql:"micro,epoch=us"`
+			Nano  *time.Time `bql:"nano,epoch=ns"`
+		}{}
+		expected := time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+		Convey("When decoding integers having different resolutions", func() {
+			So(Decode(Map{
+				"sec":   Int(1609459200),
+				"milli": Int(1609459200000),
+				"micro": Int(1609459200000000),
+				"nano":  Int(1609459200000000000),
+			}, s), ShouldBeNil)
+
+			Convey("Then they should be decoded into the same instant", func() {
+				So(s.Sec.Equal(expected), ShouldBeTrue)
+				So(s.Milli.Equal(expected), ShouldBeTrue)
+				So(time.Time(s.Micro).Equal(expected), ShouldBeTrue)
+				So(s.Nano.Equal(expected), ShouldBeTrue)
+			})
+		})
+
+		Convey("When decoding an integer having a subsecond part", func() {
+			So(Decode(Map{"milli": Int(1609459200123)}, s), ShouldBeNil)
+
+			Convey("Then it should keep the subsecond part", func() {
+				So(s.Milli.Equal(expected.Add(123*time.Millisecond)), ShouldBeTrue)
+			})
+		})
+
+		Convey("When decoding a float", func() {
+			So(Decode(Map{"milli": Float(1609459200500)}, s), ShouldBeNil)
+
+			Convey("Then it should be decoded with the resolution", func() {
+				So(s.Milli.Equal(expected.Add(500*time.Millisecond)), ShouldBeTrue)
+			})
+		})
+
+		Convey("When decoding a string", func() {
+			So(Decode(Map{"milli": String("2021-01-01T00:00:00Z")}, s), ShouldBeNil)
+
+			Convey("Then it should be parsed as usual", func() {
+				So(s.Milli.Equal(expected), ShouldBeTrue)
+			})
+		})
+
+		Convey("When decoding a negative integer", func() {
+			So(Decode(Map{"milli": Int(-1500)}, s), ShouldBeNil)
+
+			Convey("Then it should be before the epoch", func() {
+				So(s.Milli.Equal(time.Unix(0, 0).Add(-1500*time.Millisecond)), ShouldBeTrue)
+			})
+		})
+	})
+
+	Convey("Given structs having invalid epoch options", t, func() {
+		Convey("When the unit is undefined", func() {
+			err := Decode(Map{"ts": Int(1)}, &struct {
+				TS time.Time `bql:"ts,epoch=min"`
+			}{})
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "an undefined epoch unit")
+			})
+		})
+
+		Convey("When the field isn't a timestamp", func() {
+			err := Decode(Map{"ts": Int(1)}, &struct {
+				TS int64 `bql:"ts,epoch=ms"`
+			}{})
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "epoch option can only be used for time.Time and data.Timestamp")
+			})
+		})
+	})
+}
+
+func TestDecodeValidation(t *testing.T) {
+	type S struct {
+		Port  int      `bql:"port,min=1,max=65535"`
+		Ratio *float64 `bql:"ratio,min=0,max=1"`
+		Mode  string   `bql:"mode,oneof=fast|safe"`
+	}
+
+	Convey("Given a struct having validation options", t, func() {
+		Convey("When decoding values in range", func() {
+			s := &S{}
+			err := Decode(Map{
+				"port":  Int(65535),
+				"ratio": Float(0),
+				"mode":  String("safe"),
+			}, s)
+
+			Convey("Then it should succeed", func() {
+				So(err, ShouldBeNil)
+				So(s.Port, ShouldEqual, 65535)
+				So(*s.Ratio, ShouldEqual, 0)
+				So(s.Mode, ShouldEqual, "safe")
+			})
+		})
+
+		Convey("When decoding a map missing validated fields", func() {
+			err := Decode(Map{}, &S{})
+
+			Convey("Then it should succeed", func() {
+				So(err, ShouldBeNil)
+			})
+		})
 
-		for _, c := range cases {
-			So(toSnakeCase(c[0]), ShouldEqual, c[1])
+		Convey("When decoding values out of range", func() {
+			err := Decode(Map{
+				"port":  Int(0),
+				"ratio": Float(1.5),
+			}, &S{})
+
+			Convey("Then it should fail with descriptive errors", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "port: 0 is less than the minimum 1")
+				So(err.Error(), ShouldContainSubstring, "ratio: 1.5 is greater than the maximum 1")
+			})
+		})
+
+		Convey("When decoding a value which isn't one of the candidates", func() {
+			err := Decode(Map{"mode": String("slow")}, &S{})
+
+			Convey("Then it should fail with a descriptive error", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, `mode: "slow" is not one of fast, safe`)
+			})
+		})
+	})
+
+	Convey("Given structs having invalid validation options", t, func() {
+		Convey("When decoding into them", func() {
+			Convey("Then it should fail", func() {
+				So(Decode(Map{}, &struct {
+					D time.Duration `bql:",min=1"`
+				}{}), ShouldNotBeNil)
+				So(Decode(Map{}, &struct {
+					S string `bql:",max=1"`
+				}{}), ShouldNotBeNil)
+				So(Decode(Map{}, &struct {
+					I int `bql:",min=x"`
+				}{}), ShouldNotBeNil)
+				So(Decode(Map{}, &struct {
+					I int `bql:",min=2,max=1"`
+				}{}), ShouldNotBeNil)
+				So(Decode(Map{}, &struct {
+					I int `bql:",oneof=1|2"`
+				}{}), ShouldNotBeNil)
+				So(Decode(Map{}, &struct {
+					S string `bql:",oneof="`
+				}{}), ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestDecoderNullStrategy(t *testing.T) {
+	type S struct {
+		I int
+		S string `bql:",weaklytyped"`
+		D time.Duration
+		V Value
+		P *int
+	}
+	src := Map{
+		"i": Null{},
+		"s": Null{},
+		"d": Null{},
+		"v": Null{},
+		"p": Null{},
+	}
+
+	Convey("Given a decoder with NullIsDefault", t, func() {
+		d := NewDecoder(&DecoderConfig{NullStrategy: NullIsDefault})
+
+		Convey("When decoding Nulls to a struct having defaults", func() {
+			p := 1
+			s := &S{I: 10, S: "def", D: time.Second, P: &p}
+			err := d.Decode(src, s)
+
+			Convey("Then the defaults should be kept", func() {
+				So(err, ShouldBeNil)
+				So(s.I, ShouldEqual, 10)
+				So(s.S, ShouldEqual, "def")
+				So(s.D, ShouldEqual, time.Second)
+				So(s.P, ShouldEqual, &p)
+			})
+
+			Convey("Then a Value field should receive Null", func() {
+				So(s.V, ShouldResemble, Null{})
+			})
+		})
+
+		Convey("When decoding non-Null values", func() {
+			s := &S{I: 10}
+			err := d.Decode(Map{"i": Int(2), "s": Int(3)}, s)
+
+			Convey("Then they should be decoded", func() {
+				So(err, ShouldBeNil)
+				So(s.I, ShouldEqual, 2)
+				So(s.S, ShouldEqual, "3")
+			})
+		})
+	})
+
+	Convey("Given a decoder with the default NullStrategy", t, func() {
+		d := NewDecoder(nil)
+
+		Convey("When decoding Null to a strictly typed field", func() {
+			err := d.Decode(Map{"i": Null{}}, &S{I: 10})
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When decoding Null to a weakly typed field", func() {
+			s := &S{S: "def"}
+			err := d.Decode(Map{"s": Null{}}, s)
+
+			Convey("Then it should be converted to the zero value", func() {
+				So(err, ShouldBeNil)
+				So(s.S, ShouldEqual, "")
+			})
+		})
+	})
+}
+
+func TestDecodeSliceOfValues(t *testing.T) {
+	Convey("Given a heterogeneous array", t, func() {
+		m := Map{
+			"a": Array{Int(1), String("a"), True, Null{}, Map{"b": Float(1.5)}},
 		}
+
+		Convey("When decoding it into []interface{}", func() {
+			s := struct {
+				A []interface{}
+			}{}
+			So(Decode(m, &s), ShouldBeNil)
+
+			Convey("Then each element should be the original Value", func() {
+				So(s.A, ShouldResemble, []interface{}{Int(1), String("a"), True, Null{}, Map{"b": Float(1.5)}})
+			})
+		})
+
+		Convey("When decoding it into []Value", func() {
+			s := struct {
+				A []Value
+			}{}
+			So(Decode(m, &s), ShouldBeNil)
+
+			Convey("Then each element should be the original Value", func() {
+				So(s.A, ShouldResemble, []Value{Int(1), String("a"), True, Null{}, Map{"b": Float(1.5)}})
+			})
+		})
+
+		Convey("When decoding it into nested slices of interface{}", func() {
+			s := struct {
+				A [][]interface{}
+			}{}
+			err := Decode(Map{"a": Array{Array{Int(1), String("a")}, Array{}}}, &s)
+
+			Convey("Then it should succeed", func() {
+				So(err, ShouldBeNil)
+				So(s.A, ShouldResemble, [][]interface{}{{Int(1), String("a")}, {}})
+			})
+		})
+
+		Convey("When decoding a single value into []interface{} with SingleValueAsSlice", func() {
+			s := struct {
+				A []interface{}
+			}{}
+			d := NewDecoder(&DecoderConfig{SingleValueAsSlice: true})
+			So(d.Decode(Map{"a": String("a")}, &s), ShouldBeNil)
+
+			Convey("Then it should be wrapped in a slice", func() {
+				So(s.A, ShouldResemble, []interface{}{String("a")})
+			})
+		})
+
+		Convey("When decoding it into a slice of a non-empty interface", func() {
+			s := struct {
+				A []fmt.Stringer
+			}{}
+			err := Decode(m, &s)
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
 	})
 }
+
+func TestDecoderStringToNumber(t *testing.T) {
+	type S struct {
+		Int     int
+		Int8    int8
+		Float   float64
+		Ptr     *int
+		Ints    []int
+		Str     string
+		Bool    bool
+		Timeout time.Duration
+	}
+
+	Convey("Given a decoder with StringToNumber", t, func() {
+		d := NewDecoder(&DecoderConfig{StringToNumber: true})
+
+		Convey("When decoding numeric strings to numeric fields", func() {
+			s := &S{}
+			err := d.Decode(Map{
+				"int":     String("42"),
+				"int_8":   String("-8"),
+				"float":   String("1.5"),
+				"ptr":     String("7"),
+				"ints":    Array{String("1"), Int(2)},
+				"timeout": String("5s"),
+			}, s)
+
+			Convey("Then they should be parsed", func() {
+				So(err, ShouldBeNil)
+				So(s.Int, ShouldEqual, 42)
+				So(s.Int8, ShouldEqual, -8)
+				So(s.Float, ShouldEqual, 1.5)
+				So(*s.Ptr, ShouldEqual, 7)
+				So(s.Ints, ShouldResemble, []int{1, 2})
+				So(s.Timeout, ShouldEqual, 5*time.Second)
+			})
+		})
+
+		Convey("When decoding a non-numeric string to an int field", func() {
+			err := d.Decode(Map{"int": String("a")}, &S{})
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When decoding a float string to an int field", func() {
+			err := d.Decode(Map{"int": String("1.5")}, &S{})
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When decoding values requiring other conversions", func() {
+			err1 := d.Decode(Map{"str": Int(1)}, &S{})
+			err2 := d.Decode(Map{"bool": String("true")}, &S{})
+			err3 := d.Decode(Map{"int": True}, &S{})
+
+			Convey("Then they should still be strict", func() {
+				So(err1, ShouldNotBeNil)
+				So(err2, ShouldNotBeNil)
+				So(err3, ShouldNotBeNil)
+			})
+		})
+	})
+
+	Convey("Given a decoder without StringToNumber", t, func() {
+		d := NewDecoder(nil)
+
+		Convey("When decoding a numeric string to an int field", func() {
+			err := d.Decode(Map{"int": String("42")}, &S{})
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When decoding a numeric string to a float field", func() {
+			err := d.Decode(Map{"float": String("1.5")}, &S{})
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestDecoderSingleValueAsSlice(t *testing.T) {
+	type S struct {
+		Strs []string
+		Ints []int `bql:",weaklytyped"`
+		Maps []map[string]Value
+	}
+
+	Convey("Given a decoder with SingleValueAsSlice", t, func() {
+		d := NewDecoder(&DecoderConfig{SingleValueAsSlice: true})
+
+		Convey("When decoding single values to slices", func() {
+			s := &S{}
+			err := d.Decode(Map{
+				"strs": String("a"),
+				"ints": String("1"),
+				"maps": Map{"a": Int(1)},
+			}, s)
+
+			Convey("Then they should become one-element slices", func() {
+				So(err, ShouldBeNil)
+				So(s.Strs, ShouldResemble, []string{"a"})
+				So(s.Ints, ShouldResemble, []int{1})
+				So(s.Maps, ShouldResemble, []map[string]Value{{"a": Int(1)}})
+			})
+		})
+
+		Convey("When decoding arrays to slices", func() {
+			s := &S{}
+			err := d.Decode(Map{"strs": Array{String("a"), String("b")}}, s)
+
+			Convey("Then they should be decoded normally", func() {
+				So(err, ShouldBeNil)
+				So(s.Strs, ShouldResemble, []string{"a", "b"})
+			})
+		})
+
+		Convey("When decoding a single value of a wrong type", func() {
+			err := d.Decode(Map{"strs": Int(1)}, &S{})
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "strs: ")
+			})
+		})
+
+		Convey("When decoding Null to a slice", func() {
+			err := d.Decode(Map{"strs": Null{}}, &S{})
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+
+	Convey("Given a decoder with the default config", t, func() {
+		d := NewDecoder(nil)
+
+		Convey("When decoding a single value to a slice", func() {
+			err := d.Decode(Map{"strs": String("a")}, &S{})
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestDecoderFieldNameFunc(t *testing.T) {
+	type S struct {
+		MyField   int
+		HTTPProxy string
+		Tagged    int `bql:"explicit"`
+	}
+
+	Convey("Given a decoder with an identity FieldNameFunc", t, func() {
+		d := NewDecoder(&DecoderConfig{
+			ErrorUnused:   true,
+			FieldNameFunc: func(n string) string { return n },
+		})
+
+		Convey("When decoding a map having exact field names", func() {
+			s := &S{}
+			err := d.Decode(Map{
+				"MyField":   Int(1),
+				"HTTPProxy": String("proxy"),
+				"explicit":  Int(2),
+			}, s)
+
+			Convey("Then it should succeed", func() {
+				So(err, ShouldBeNil)
+				So(s.MyField, ShouldEqual, 1)
+				So(s.HTTPProxy, ShouldEqual, "proxy")
+				So(s.Tagged, ShouldEqual, 2)
+			})
+		})
+
+		Convey("When decoding a map having snake_case keys", func() {
+			err := d.Decode(Map{"my_field": Int(1)}, &S{})
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+
+	Convey("Given a decoder with a kebab-case FieldNameFunc", t, func() {
+		d := NewDecoder(&DecoderConfig{
+			ErrorUnused: true,
+			FieldNameFunc: func(n string) string {
+				return strings.Replace(SnakeCase(n), "_", "-", -1)
+			},
+		})
+
+		Convey("When decoding a map having kebab-case keys", func() {
+			s := &S{}
+			err := d.Decode(Map{
+				"my-field":   Int(1),
+				"http-proxy": String("proxy"),
+				"explicit":   Int(2),
+			}, s)
+
+			Convey("Then it should succeed", func() {
+				So(err, ShouldBeNil)
+				So(s.MyField, ShouldEqual, 1)
+				So(s.HTTPProxy, ShouldEqual, "proxy")
+				So(s.Tagged, ShouldEqual, 2)
+			})
+		})
+	})
+}
+
+type decodeTestStorage interface {
+	storageType() string
+}
+
+type decodeTestFSStorage struct {
+	Dir string `bql:",required"`
+}
+
+func (s *decodeTestFSStorage) storageType() string {
+	return "fs"
+}
+
+type decodeTestInMemoryStorage struct {
+}
+
+func (s decodeTestInMemoryStorage) storageType() string {
+	return "in_memory"
+}
+
+func TestDecodeDiscriminatedUnion(t *testing.T) {
+	type Config struct {
+		Storage decodeTestStorage `bql:"storage,discriminator=type"`
+	}
+
+	Convey("Given a decoder having variants of a storage", t, func() {
+		c := &DecoderConfig{ErrorUnused: true}
+		So(c.RegisterVariant((*decodeTestStorage)(nil), "fs", &decodeTestFSStorage{}), ShouldBeNil)
+		So(c.RegisterVariant((*decodeTestStorage)(nil), "in_memory", decodeTestInMemoryStorage{}), ShouldBeNil)
+		d := NewDecoder(c)
+
+		Convey("When decoding a map having fs type", func() {
+			conf := &Config{}
+			err := d.Decode(Map{
+				"storage": Map{
+					"type": String("fs"),
+					"dir":  String("/x"),
+				},
+			}, conf)
+
+			Convey("Then it should be decoded into the fs variant", func() {
+				So(err, ShouldBeNil)
+				So(conf.Storage, ShouldResemble, &decodeTestFSStorage{Dir: "/x"})
+			})
+		})
+
+		Convey("When decoding a map having in_memory type", func() {
+			conf := &Config{}
+			err := d.Decode(Map{
+				"storage": Map{
+					"type": String("in_memory"),
+				},
+			}, conf)
+
+			Convey("Then it should be decoded into the in_memory variant", func() {
+				So(err, ShouldBeNil)
+				So(conf.Storage, ShouldResemble, decodeTestInMemoryStorage{})
+			})
+		})
+
+		Convey("When decoding a map having an unknown type", func() {
+			err := d.Decode(Map{
+				"storage": Map{
+					"type": String("mysql"),
+				},
+			}, &Config{})
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "storage.type: an unknown type: mysql")
+			})
+		})
+
+		Convey("When decoding a map without the discriminator", func() {
+			err := d.Decode(Map{
+				"storage": Map{
+					"dir": String("/x"),
+				},
+			}, &Config{})
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "storage.type: required but missing")
+			})
+		})
+
+		Convey("When decoding a map having a key undefined in the variant", func() {
+			err := d.Decode(Map{
+				"storage": Map{
+					"type": String("in_memory"),
+					"dir":  String("/x"),
+				},
+			}, &Config{})
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "unused keys: storage.dir")
+			})
+		})
+
+		Convey("When decoding a variant missing a required field", func() {
+			err := d.Decode(Map{
+				"storage": Map{
+					"type": String("fs"),
+				},
+			}, &Config{})
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "storage.dir: required but missing")
+			})
+		})
+	})
+
+	Convey("Given a DecoderConfig", t, func() {
+		c := &DecoderConfig{}
+
+		Convey("When registering a type not implementing the interface", func() {
+			err := c.RegisterVariant((*decodeTestStorage)(nil), "fs", decodeTestFSStorage{})
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When registering with a non-interface union", func() {
+			err := c.RegisterVariant(decodeTestInMemoryStorage{}, "in_memory", decodeTestInMemoryStorage{})
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When registering the same type name twice", func() {
+			So(c.RegisterVariant((*decodeTestStorage)(nil), "fs", &decodeTestFSStorage{}), ShouldBeNil)
+			err := c.RegisterVariant((*decodeTestStorage)(nil), "fs", &decodeTestFSStorage{})
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+
+	Convey("Given a struct having discriminator option on a non-interface field", t, func() {
+		s := &struct {
+			Storage decodeTestFSStorage `bql:",discriminator=type"`
+		}{}
+
+		Convey("When decoding a map into it", func() {
+			err := Decode(Map{"storage": Map{"type": String("fs"), "dir": String("/x")}}, s)
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "interface fields")
+			})
+		})
+	})
+}
+
+func TestDecoderDecodePartial(t *testing.T) {
+	type child struct {
+		X int
+		Y string
+	}
+	type S struct {
+		I int `bql:",required"`
+		F float64
+		S string `bql:",required"`
+		B bool
+		D time.Duration
+		C *child
+	}
+
+	Convey("Given a decoder and a populated struct", t, func() {
+		d := NewDecoder(&DecoderConfig{ErrorUnused: true})
+		c := &child{X: 1, Y: "a"}
+		s := &S{I: 1, F: 2.5, S: "str", B: true, D: time.Second, C: c}
+
+		Convey("When partially decoding a map having two of the fields", func() {
+			err := d.DecodePartial(Map{
+				"f": Float(3.5),
+				"b": False,
+			}, s)
+
+			Convey("Then only the two fields should be updated", func() {
+				So(err, ShouldBeNil)
+				So(s.I, ShouldEqual, 1)
+				So(s.F, ShouldEqual, 3.5)
+				So(s.S, ShouldEqual, "str")
+				So(s.B, ShouldBeFalse)
+				So(s.D, ShouldEqual, time.Second)
+				So(s.C, ShouldEqual, c)
+				So(*s.C, ShouldResemble, child{X: 1, Y: "a"})
+			})
+		})
+
+		Convey("When partially decoding a field of a nested struct", func() {
+			err := d.DecodePartial(Map{
+				"c": Map{"y": String("b")},
+			}, s)
+
+			Convey("Then the nested struct should be updated in place", func() {
+				So(err, ShouldBeNil)
+				So(s.C, ShouldEqual, c)
+				So(*s.C, ShouldResemble, child{X: 1, Y: "b"})
+			})
+		})
+
+		Convey("When partially decoding an invalid value", func() {
+			err := d.DecodePartial(Map{"i": String("a")}, s)
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When partially decoding an unknown key", func() {
+			err := d.DecodePartial(Map{"unknown": Int(1)}, s)
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When decoding the same map with Decode", func() {
+			err := d.Decode(Map{"f": Float(3.5)}, s)
+
+			Convey("Then it should fail due to missing required fields", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+type DecodeTestPlanEmbedded struct {
+	E int `bql:"e,required"`
+}
+
+type decodeTestPlan struct {
+	DecodeTestPlanEmbedded
+	*DecodeTestPlanEmbeddedPtr
+	Name     string  `bql:",required"`
+	Ratio    float64 `bql:"rate,weaklytyped"`
+	Time     time.Time
+	Children []decodeTestPlanChild
+	Params   map[string]int
+}
+
+type DecodeTestPlanEmbeddedPtr struct {
+	P string
+}
+
+type decodeTestPlanChild struct {
+	ID    int
+	Label string `bql:"label"`
+}
+
+func decodeTestPlanMap() Map {
+	return Map{
+		"e":    Int(1),
+		"p":    String("ptr"),
+		"name": String("plan"),
+		"rate": String("0.5"),
+		"time": Int(1),
+		"params": Map{
+			"a": Int(1),
+		},
+		"children": Array{
+			Map{"id": Int(1), "label": String("one")},
+			Map{"id": Int(2), "label": String("two")},
+		},
+	}
+}
+
+func TestDecoderFieldPlanCache(t *testing.T) {
+	Convey("Given a decoder which hasn't decoded any struct", t, func() {
+		d := NewDecoder(&DecoderConfig{ErrorUnused: true})
+
+		Convey("When decoding the same struct type twice", func() {
+			uncached := &decodeTestPlan{}
+			So(d.Decode(decodeTestPlanMap(), uncached), ShouldBeNil)
+			cached := &decodeTestPlan{}
+			So(d.Decode(decodeTestPlanMap(), cached), ShouldBeNil)
+
+			Convey("Then both results should be the same", func() {
+				So(cached, ShouldResemble, uncached)
+				So(cached.E, ShouldEqual, 1)
+				So(cached.P, ShouldEqual, "ptr")
+				So(cached.Ratio, ShouldEqual, 0.5)
+				So(cached.Children[1].Label, ShouldEqual, "two")
+			})
+
+			Convey("Then the result should be the same as one of another decoder", func() {
+				other := &decodeTestPlan{}
+				So(NewDecoder(&DecoderConfig{ErrorUnused: true}).Decode(decodeTestPlanMap(), other), ShouldBeNil)
+				So(cached, ShouldResemble, other)
+			})
+		})
+
+		Convey("When decoding invalid maps twice", func() {
+			m := decodeTestPlanMap()
+			delete(m, "name")
+			m["children"].(Array)[0].(Map)["unknown"] = Int(1)
+
+			err1 := d.Decode(m, &decodeTestPlan{})
+			err2 := d.Decode(m, &decodeTestPlan{})
+
+			Convey("Then both should report the same errors", func() {
+				So(err1, ShouldNotBeNil)
+				So(err2, ShouldNotBeNil)
+				So(err2.Error(), ShouldEqual, err1.Error())
+				So(err1.Error(), ShouldContainSubstring, "name: required but missing")
+				So(err1.Error(), ShouldContainSubstring, "children[0]: unused keys: children[0].unknown")
+			})
+		})
+
+		Convey("When decoding a struct having invalid options twice", func() {
+			s := &struct {
+				Child struct {
+					I int `bql:",nosuchoption"`
+				}
+			}{}
+			err1 := d.Decode(Map{"child": Map{"i": Int(1)}}, s)
+			err2 := d.Decode(Map{"child": Map{"i": Int(1)}}, s)
+
+			Convey("Then both should report the same errors", func() {
+				So(err1, ShouldNotBeNil)
+				So(err1.Error(), ShouldContainSubstring, "child.I: an undefined option: nosuchoption")
+				So(err2.Error(), ShouldEqual, err1.Error())
+			})
+		})
+
+		Convey("When decoding concurrently", func() {
+			const n = 8
+			results := make(chan *decodeTestPlan, n)
+			errs := make(chan error, n)
+			for i := 0; i < n; i++ {
+				go func() {
+					s := &decodeTestPlan{}
+					errs <- d.Decode(decodeTestPlanMap(), s)
+					results <- s
+				}()
+			}
+
+			Convey("Then all results should be the same", func() {
+				expected := &decodeTestPlan{}
+				So(Decode(decodeTestPlanMap(), expected), ShouldBeNil)
+				for i := 0; i < n; i++ {
+					So(<-errs, ShouldBeNil)
+					So(<-results, ShouldResemble, expected)
+				}
+			})
+		})
+	})
+}
+
+func BenchmarkDecode(b *testing.B) {
+	m := decodeTestPlanMap()
+	d := NewDecoder(&DecoderConfig{ErrorUnused: true})
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := d.Decode(m, &decodeTestPlan{}); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDecodeUncached(b *testing.B) {
+	m := decodeTestPlanMap()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		// a new Decoder doesn't have the cache of the struct
+		d := NewDecoder(&DecoderConfig{ErrorUnused: true})
+		if err := d.Decode(m, &decodeTestPlan{}); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package data
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// Dedup returns a new slice having the values of vs without duplicates.
+// Values are considered duplicates in the same way as ValueSet, so Int(2)
+// and Float(2.0) are the same value. The first occurrence of each value is
+// kept and the order of the values is preserved. Values are not copied.
+func Dedup(vs []Value) []Value {
+	return NewValueSet(vs...).Slice()
+}
+
+// Deduper detects duplicates in a stream of Values. A Value is a duplicate
+// when an equal Value was given within the TTL. The TTL of a Value starts
+// when it's seen for the first time and isn't extended by its duplicates,
+// so a Value is reported again at most once per TTL. Values whose TTL has
+// expired are evicted so that the memory usage only depends on the number
+// of distinct Values seen within the TTL. It's safe for concurrent use.
+//
+// Values are stored as they are, so Maps and Arrays passed to a Deduper
+// must not be modified.
+type Deduper struct {
+	m       sync.Mutex
+	ttl     time.Duration
+	now     func() time.Time
+	entries *list.List // elements are *deduperEntry, oldest first
+	buckets map[HashValue][]*list.Element
+}
+
+type deduperEntry struct {
+	hash    HashValue
+	value   Value
+	expires time.Time
+}
+
+// NewDeduper creates a new Deduper remembering Values for ttl.
+func NewDeduper(ttl time.Duration) *Deduper {
+	return &Deduper{
+		ttl:     ttl,
+		now:     time.Now,
+		entries: list.New(),
+		buckets: map[HashValue][]*list.Element{},
+	}
+}
+
+// Seen reports whether an equal Value was given to Seen within the TTL. When
+// it returns false, v is remembered for the TTL.
+func (d *Deduper) Seen(v Value) bool {
+	d.m.Lock()
+	defer d.m.Unlock()
+	now := d.now()
+	d.evict(now)
+
+	h := Hash(v)
+	for _, e := range d.buckets[h] {
+		if Equal(e.Value.(*deduperEntry).value, v) {
+			return true
+		}
+	}
+	e := d.entries.PushBack(&deduperEntry{
+		hash:    h,
+		value:   v,
+		expires: now.Add(d.ttl),
+	})
+	d.buckets[h] = append(d.buckets[h], e)
+	return false
+}
+
+// Len returns the number of Values remembered by the Deduper, including
+// the ones whose TTL has expired but which haven't been evicted yet.
+func (d *Deduper) Len() int {
+	d.m.Lock()
+	defer d.m.Unlock()
+	return d.entries.Len()
+}
+
+// evict removes entries expired at now. Since all entries have the same
+// TTL, they expire in the order they're added.
+func (d *Deduper) evict(now time.Time) {
+	for {
+		front := d.entries.Front()
+		if front == nil {
+			return
+		}
+		entry := front.Value.(*deduperEntry)
+		if now.Before(entry.expires) {
+			return
+		}
+
+		b := d.buckets[entry.hash]
+		for i, e := range b {
+			if e == front {
+				b = append(b[:i], b[i+1:]...)
+				break
+			}
+		}
+		if len(b) == 0 {
+			delete(d.buckets, entry.hash)
+		} else {
+			d.buckets[entry.hash] = b
+		}
+		d.entries.Remove(front)
+	}
+}
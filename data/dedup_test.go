@@ -0,0 +1,105 @@
+package data
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestDedup(t *testing.T) {
+	Convey("Given a slice having duplicates", t, func() {
+		vs := []Value{
+			Map{"a": Int(1)},
+			Int(2),
+			String("x"),
+			Map{"a": Float(1.0)},
+			Float(2.0),
+			Null{},
+			String("x"),
+			Map{"a": Int(2)},
+			Null{},
+		}
+
+		Convey("When deduplicating it", func() {
+			res := Dedup(vs)
+
+			Convey("Then it should keep the first occurrences in order", func() {
+				So(res, ShouldResemble, []Value{
+					Map{"a": Int(1)},
+					Int(2),
+					String("x"),
+					Null{},
+					Map{"a": Int(2)},
+				})
+			})
+
+			Convey("Then the original slice should not be modified", func() {
+				So(len(vs), ShouldEqual, 9)
+				So(vs[3], ShouldResemble, Map{"a": Float(1.0)})
+			})
+		})
+	})
+
+	Convey("Given an empty slice", t, func() {
+		Convey("Then Dedup should return an empty slice", func() {
+			So(Dedup(nil), ShouldBeEmpty)
+		})
+	})
+}
+
+func TestDeduper(t *testing.T) {
+	Convey("Given a Deduper with a TTL", t, func() {
+		d := NewDeduper(10 * time.Second)
+		now := time.Date(2015, time.April, 10, 10, 23, 0, 0, time.UTC)
+		d.now = func() time.Time {
+			return now
+		}
+
+		Convey("When giving values for the first time", func() {
+			Convey("Then they shouldn't be seen", func() {
+				So(d.Seen(Map{"id": Int(1)}), ShouldBeFalse)
+				So(d.Seen(Map{"id": Int(2)}), ShouldBeFalse)
+				So(d.Len(), ShouldEqual, 2)
+			})
+		})
+
+		Convey("When giving equal values within the TTL", func() {
+			So(d.Seen(Map{"id": Int(1)}), ShouldBeFalse)
+			now = now.Add(9 * time.Second)
+
+			Convey("Then they should be seen", func() {
+				So(d.Seen(Map{"id": Float(1)}), ShouldBeTrue)
+				So(d.Seen(Map{"id": Int(1)}), ShouldBeTrue)
+				So(d.Len(), ShouldEqual, 1)
+			})
+		})
+
+		Convey("When the TTL of values expires", func() {
+			So(d.Seen(Int(1)), ShouldBeFalse)
+			now = now.Add(5 * time.Second)
+			So(d.Seen(Int(2)), ShouldBeFalse)
+			So(d.Seen(Int(1)), ShouldBeTrue) // doesn't extend the TTL
+			now = now.Add(5 * time.Second)
+
+			Convey("Then the old keys should be evicted", func() {
+				So(d.Seen(Int(3)), ShouldBeFalse)
+				So(d.Len(), ShouldEqual, 2)
+			})
+
+			Convey("Then expired values shouldn't be seen", func() {
+				So(d.Seen(Int(1)), ShouldBeFalse)
+				So(d.Seen(Int(2)), ShouldBeTrue)
+			})
+
+			Convey("And the TTL of the remaining values expires", func() {
+				now = now.Add(5 * time.Second)
+
+				Convey("Then all values should be evicted", func() {
+					So(d.Seen(Int(2)), ShouldBeFalse)
+					So(d.Len(), ShouldEqual, 1)
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,154 @@
+package data
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// DeltaEntry is a difference at a path reported by Diff. Path can be
+// compiled by CompilePath to get the value from the Map passed to Diff.
+// Old is nil for an added value and New is nil for a removed value.
+type DeltaEntry struct {
+	Path string
+	Old  Value
+	New  Value
+}
+
+// Delta is a structured difference between two Maps returned by Diff. Each
+// slice is sorted by Path.
+type Delta struct {
+	// Added has values which only exist in the new Map.
+	Added []DeltaEntry
+
+	// Removed has values which only exist in the old Map.
+	Removed []DeltaEntry
+
+	// Changed has values which exist in both Maps but are different.
+	Changed []DeltaEntry
+}
+
+// IsEmpty returns true when the Delta has no difference.
+func (d *Delta) IsEmpty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// ToMap converts the Delta to a Map having "added", "removed", and "changed"
+// keys. Each of them is a Map from paths to values. While "added" has new
+// values and "removed" has old values, "changed" has Maps having "old" and
+// "new" keys.
+func (d *Delta) ToMap() Map {
+	added := make(Map, len(d.Added))
+	for _, e := range d.Added {
+		added[e.Path] = e.New
+	}
+	removed := make(Map, len(d.Removed))
+	for _, e := range d.Removed {
+		removed[e.Path] = e.Old
+	}
+	changed := make(Map, len(d.Changed))
+	for _, e := range d.Changed {
+		changed[e.Path] = Map{
+			"old": e.Old,
+			"new": e.New,
+		}
+	}
+	return Map{
+		"added":   added,
+		"removed": removed,
+		"changed": changed,
+	}
+}
+
+// Diff reports differences between two Maps. Nested Maps are compared
+// recursively and so are Arrays, whose elements are compared by index: when
+// the new Array is longer, the extra elements are reported as added, and
+// vice versa. Other values are considered changed when they have different
+// types or aren't equal according to Equal, so Int(2) and Float(2) are
+// different. Both old and new must be Maps.
+func Diff(old, new Value) (Delta, error) {
+	o, err := AsMap(old)
+	if err != nil {
+		return Delta{}, fmt.Errorf("the old value must be a map: %v", err)
+	}
+	n, err := AsMap(new)
+	if err != nil {
+		return Delta{}, fmt.Errorf("the new value must be a map: %v", err)
+	}
+
+	d := Delta{}
+	diffMap(&d, "", o, n)
+	for _, es := range [][]DeltaEntry{d.Added, d.Removed, d.Changed} {
+		sort.Sort(deltaEntries(es))
+	}
+	return d, nil
+}
+
+func diffMap(d *Delta, prefix string, o, n Map) {
+	for k, ov := range o {
+		path := prefix + deltaPathKey(prefix, k)
+		nv, ok := n[k]
+		if !ok {
+			d.Removed = append(d.Removed, DeltaEntry{Path: path, Old: ov})
+			continue
+		}
+		diffValue(d, path, ov, nv)
+	}
+	for k, nv := range n {
+		if _, ok := o[k]; !ok {
+			d.Added = append(d.Added, DeltaEntry{Path: prefix + deltaPathKey(prefix, k), New: nv})
+		}
+	}
+}
+
+func diffValue(d *Delta, path string, o, n Value) {
+	if o.Type() != n.Type() {
+		d.Changed = append(d.Changed, DeltaEntry{Path: path, Old: o, New: n})
+		return
+	}
+
+	switch ov := o.(type) {
+	case Map:
+		diffMap(d, path, ov, n.(Map))
+
+	case Array:
+		na := n.(Array)
+		for i := 0; i < len(ov) || i < len(na); i++ {
+			p := fmt.Sprintf("%v[%v]", path, i)
+			switch {
+			case i >= len(na):
+				d.Removed = append(d.Removed, DeltaEntry{Path: p, Old: ov[i]})
+			case i >= len(ov):
+				d.Added = append(d.Added, DeltaEntry{Path: p, New: na[i]})
+			default:
+				diffValue(d, p, ov[i], na[i])
+			}
+		}
+
+	default:
+		if !Equal(o, n) {
+			d.Changed = append(d.Changed, DeltaEntry{Path: path, Old: o, New: n})
+		}
+	}
+}
+
+var deltaPathIdentifier = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_]*$`)
+
+// deltaPathKey returns the part of a path accessing the key k of a Map at
+// prefix.
+func deltaPathKey(prefix, k string) string {
+	if !deltaPathIdentifier.MatchString(k) {
+		return fmt.Sprintf(`["%v"]`, strings.Replace(k, `"`, `""`, -1))
+	}
+	if prefix == "" {
+		return k
+	}
+	return "." + k
+}
+
+type deltaEntries []DeltaEntry
+
+func (es deltaEntries) Len() int           { return len(es) }
+func (es deltaEntries) Less(i, j int) bool { return es[i].Path < es[j].Path }
+func (es deltaEntries) Swap(i, j int)      { es[i], es[j] = es[j], es[i] }
@@ -0,0 +1,143 @@
+package data
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestDiff(t *testing.T) {
+	Convey("Given two nested maps", t, func() {
+		old := Map{
+			"id": Int(1),
+			"sensor": Map{
+				"name":  String("temp"),
+				"value": Float(21.5),
+				"unit":  String("C"),
+			},
+			"tags":  Array{String("a"), String("b")},
+			"count": Int(2),
+		}
+		new := Map{
+			"id": Int(1),
+			"sensor": Map{
+				"name":     String("temp"),
+				"value":    Float(22.0),
+				"location": String("room 1"),
+			},
+			"tags":      Array{String("a"), String("c"), String("d")},
+			"count":     Float(2),
+			"new field": Null{},
+		}
+
+		Convey("When diffing them", func() {
+			d, err := Diff(old, new)
+			So(err, ShouldBeNil)
+
+			Convey("Then added paths should be reported", func() {
+				So(d.Added, ShouldResemble, []DeltaEntry{
+					{Path: `["new field"]`, New: Null{}},
+					{Path: "sensor.location", New: String("room 1")},
+					{Path: "tags[2]", New: String("d")},
+				})
+			})
+
+			Convey("Then removed paths should be reported", func() {
+				So(d.Removed, ShouldResemble, []DeltaEntry{
+					{Path: "sensor.unit", Old: String("C")},
+				})
+			})
+
+			Convey("Then changed paths should be reported", func() {
+				So(d.Changed, ShouldResemble, []DeltaEntry{
+					{Path: "count", Old: Int(2), New: Float(2)},
+					{Path: "sensor.value", Old: Float(21.5), New: Float(22.0)},
+					{Path: "tags[1]", Old: String("b"), New: String("c")},
+				})
+			})
+
+			Convey("Then the paths should point to the values", func() {
+				for _, e := range d.Added {
+					v, err := new.Get(MustCompilePath(e.Path))
+					So(err, ShouldBeNil)
+					So(v, ShouldResemble, e.New)
+				}
+				for _, e := range d.Removed {
+					v, err := old.Get(MustCompilePath(e.Path))
+					So(err, ShouldBeNil)
+					So(v, ShouldResemble, e.Old)
+				}
+			})
+
+			Convey("Then it should be converted to a map", func() {
+				So(d.IsEmpty(), ShouldBeFalse)
+				So(d.ToMap(), ShouldResemble, Map{
+					"added": Map{
+						`["new field"]`:   Null{},
+						"sensor.location": String("room 1"),
+						"tags[2]":         String("d"),
+					},
+					"removed": Map{
+						"sensor.unit": String("C"),
+					},
+					"changed": Map{
+						"count":        Map{"old": Int(2), "new": Float(2)},
+						"sensor.value": Map{"old": Float(21.5), "new": Float(22.0)},
+						"tags[1]":      Map{"old": String("b"), "new": String("c")},
+					},
+				})
+			})
+		})
+
+		Convey("When diffing them in the reverse order", func() {
+			d, err := Diff(new, old)
+			So(err, ShouldBeNil)
+
+			Convey("Then added and removed paths should be swapped", func() {
+				So(d.Removed, ShouldResemble, []DeltaEntry{
+					{Path: `["new field"]`, Old: Null{}},
+					{Path: "sensor.location", Old: String("room 1")},
+					{Path: "tags[2]", Old: String("d")},
+				})
+				So(d.Added, ShouldResemble, []DeltaEntry{
+					{Path: "sensor.unit", New: String("C")},
+				})
+			})
+		})
+
+		Convey("When diffing a map with itself", func() {
+			d, err := Diff(old, old.Copy())
+			So(err, ShouldBeNil)
+
+			Convey("Then the delta should be empty", func() {
+				So(d.IsEmpty(), ShouldBeTrue)
+			})
+		})
+	})
+
+	Convey("Given maps having a value whose type changed to a map", t, func() {
+		old := Map{"a": Int(1)}
+		new := Map{"a": Map{"b": Int(1)}}
+
+		Convey("When diffing them", func() {
+			d, err := Diff(old, new)
+			So(err, ShouldBeNil)
+
+			Convey("Then the value should be reported as changed", func() {
+				So(d.Changed, ShouldResemble, []DeltaEntry{
+					{Path: "a", Old: Int(1), New: Map{"b": Int(1)}},
+				})
+			})
+		})
+	})
+
+	Convey("Given a value which isn't a map", t, func() {
+		Convey("When diffing it", func() {
+			_, err := Diff(Int(1), Map{})
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
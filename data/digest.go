@@ -0,0 +1,46 @@
+package data
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/json"
+	"fmt"
+	"hash"
+)
+
+// Digest computes the cryptographic digest of a Value with the given
+// algorithm. Supported algorithms are "md5", "sha1", "sha256", and "sha512".
+//
+// The Value is serialized to JSON before being hashed. Since keys of a Map
+// are sorted in the serialization, equal Maps result in the same digest
+// regardless of the order in which they're built. Because the digest is
+// computed over the JSON representation, Values having the same JSON
+// representation such as Int(1) and Float(1.0), or a Blob and a String
+// containing its base64 encoding, also have the same digest.
+func Digest(v Value, algo string) (Blob, error) {
+	var h hash.Hash
+	switch algo {
+	case "md5":
+		h = md5.New()
+	case "sha1":
+		h = sha1.New()
+	case "sha256":
+		h = sha256.New()
+	case "sha512":
+		h = sha512.New()
+	default:
+		return nil, fmt.Errorf("unsupported digest algorithm: %v", algo)
+	}
+
+	if v == nil {
+		v = Null{}
+	}
+	js, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("cannot serialize %v for the digest: %v", v.Type(), err)
+	}
+	h.Write(js)
+	return Blob(h.Sum(nil)), nil
+}
@@ -0,0 +1,80 @@
+package data
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestDigest(t *testing.T) {
+	Convey("Given equal Maps built in different orders", t, func() {
+		m1 := Map{}
+		m1["a"] = Int(1)
+		m1["b"] = Array{String("x"), Map{"c": Bool(true), "d": Null{}}}
+		m1["e"] = Float(2.5)
+		m2 := Map{}
+		m2["e"] = Float(2.5)
+		m2["b"] = Array{String("x"), Map{"d": Null{}, "c": Bool(true)}}
+		m2["a"] = Int(1)
+
+		for _, algo := range []string{"md5", "sha1", "sha256", "sha512"} {
+			algo := algo
+			Convey("When computing their "+algo+" digests", func() {
+				d1, err := Digest(m1, algo)
+				So(err, ShouldBeNil)
+				d2, err := Digest(m2, algo)
+				So(err, ShouldBeNil)
+
+				Convey("Then they should be the same", func() {
+					So(d1, ShouldResemble, d2)
+				})
+
+				Convey("Then it should be deterministic", func() {
+					d3, err := Digest(m1, algo)
+					So(err, ShouldBeNil)
+					So(d3, ShouldResemble, d1)
+				})
+			})
+		}
+	})
+
+	Convey("Given a String", t, func() {
+		Convey("Then its digest should be the digest of its JSON", func() {
+			d, err := Digest(String("abc"), "md5")
+			So(err, ShouldBeNil)
+			// md5 of `"abc"`
+			So(d.Hex(), ShouldEqual, "ebd9f4c7b06cb0aaf5d13d80e49d8b90")
+		})
+	})
+
+	Convey("Given different Values", t, func() {
+		vs := []Value{
+			Null{},
+			Bool(true),
+			Int(1),
+			Float(1.5),
+			String("1"),
+			Array{Int(1)},
+			Map{"a": Int(1)},
+			Map{"a": Int(2)},
+			Map{"b": Int(1)},
+		}
+
+		Convey("Then their sha256 digests should be distinct", func() {
+			seen := map[string]Value{}
+			for _, v := range vs {
+				d, err := Digest(v, "sha256")
+				So(err, ShouldBeNil)
+				_, ok := seen[d.Hex()]
+				So(ok, ShouldBeFalse)
+				seen[d.Hex()] = v
+			}
+		})
+	})
+
+	Convey("Given an unsupported algorithm", t, func() {
+		Convey("Then Digest should fail", func() {
+			_, err := Digest(Int(1), "crc32")
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
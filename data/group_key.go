@@ -0,0 +1,105 @@
+package data
+
+import (
+	"bytes"
+	"math"
+	"strconv"
+)
+
+// GroupKey encodes Values into a string which can be used as a key of a Go
+// map to group tuples. Keys of tuples of Values are identical when the Values
+// are equal according to Equal, and distinct otherwise. Therefore, Int(2)
+// and Float(2.0) have the same key while Int(1) and String("1") have
+// different keys. Unlike Equal, NaNs have the same key so that they are
+// grouped together. Each Value is encoded with its type and length, so
+// GroupKey(String("a"), String("b")) isn't equal to GroupKey(String("ab")).
+func GroupKey(vs ...Value) string {
+	b := bytes.NewBuffer(nil)
+	for _, v := range vs {
+		writeGroupKey(b, v)
+	}
+	return b.String()
+}
+
+func writeGroupKey(b *bytes.Buffer, v Value) {
+	switch v.Type() {
+	case TypeNull:
+		b.WriteByte('n')
+
+	case TypeBool:
+		if x, _ := v.asBool(); x {
+			b.WriteString("b1")
+		} else {
+			b.WriteString("b0")
+		}
+
+	case TypeInt:
+		i, _ := v.asInt()
+		writeGroupKeyInt(b, i)
+
+	case TypeFloat:
+		f, _ := v.asFloat()
+		// a Float having an integer value is encoded as an Int to be
+		// consistent with Equal
+		if f == math.Trunc(f) && f >= MinConvFloat64 && f < MaxConvFloat64 {
+			writeGroupKeyInt(b, int64(f))
+			return
+		}
+		b.WriteByte('f')
+		if math.IsNaN(f) {
+			b.WriteString("NaN")
+		} else {
+			b.WriteString(strconv.FormatFloat(f, 'g', -1, 64))
+		}
+		b.WriteByte(';')
+
+	case TypeString:
+		s, _ := v.asString()
+		b.WriteByte('s')
+		writeGroupKeyBytes(b, []byte(s))
+
+	case TypeBlob:
+		bl, _ := v.asBlob()
+		b.WriteByte('B')
+		writeGroupKeyBytes(b, bl)
+
+	case TypeTimestamp:
+		t, _ := v.asTimestamp()
+		b.WriteByte('T')
+		b.WriteString(strconv.FormatInt(t.Unix(), 10))
+		b.WriteByte('.')
+		b.WriteString(strconv.Itoa(t.Nanosecond()))
+		b.WriteByte(';')
+
+	case TypeArray:
+		a, _ := v.asArray()
+		b.WriteByte('a')
+		b.WriteString(strconv.Itoa(len(a)))
+		b.WriteByte(':')
+		for _, e := range a {
+			writeGroupKey(b, e)
+		}
+
+	case TypeMap:
+		m, _ := v.asMap()
+		b.WriteByte('m')
+		b.WriteString(strconv.Itoa(len(m)))
+		b.WriteByte(':')
+		for _, k := range m.SortedKeys() {
+			writeGroupKeyBytes(b, []byte(k))
+			writeGroupKey(b, m[k])
+		}
+	}
+}
+
+func writeGroupKeyInt(b *bytes.Buffer, i int64) {
+	b.WriteByte('i')
+	b.WriteString(strconv.FormatInt(i, 10))
+	b.WriteByte(';')
+}
+
+func writeGroupKeyBytes(b *bytes.Buffer, s []byte) {
+	b.WriteString(strconv.Itoa(len(s)))
+	b.WriteByte(':')
+	b.Write(s)
+}
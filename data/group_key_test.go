@@ -0,0 +1,59 @@
+package data
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestGroupKey(t *testing.T) {
+	now := time.Now()
+
+	Convey("Given equal tuples of Values", t, func() {
+		cases := [][2][]Value{
+			{{}, {}},
+			{{Null{}}, {Null{}}},
+			{{Int(1), String("a")}, {Int(1), String("a")}},
+			{{Int(2)}, {Float(2.0)}},
+			{{Float(2.5), Bool(true)}, {Float(2.5), Bool(true)}},
+			{{Blob("hoge")}, {Blob("hoge")}},
+			{{Timestamp(now)}, {Timestamp(now.In(time.UTC))}},
+			{{Array{Int(1), Float(2)}}, {Array{Float(1), Int(2)}}},
+			{{Map{"a": Int(1), "b": Null{}}}, {Map{"b": Null{}, "a": Float(1)}}},
+			{{Float(math.NaN())}, {Float(math.NaN())}},
+		}
+
+		Convey("Then their keys should be equal", func() {
+			for _, c := range cases {
+				So(GroupKey(c[0]...), ShouldEqual, GroupKey(c[1]...))
+			}
+		})
+	})
+
+	Convey("Given distinct tuples of Values", t, func() {
+		cases := [][2][]Value{
+			{{Int(1)}, {String("1")}},
+			{{Int(1)}, {Bool(true)}},
+			{{Int(0)}, {Null{}}},
+			{{String("")}, {Null{}}},
+			{{String("a")}, {Blob("a")}},
+			{{Int(1)}, {Int(2)}},
+			{{Float(1.5)}, {Float(1.25)}},
+			{{String("a"), String("b")}, {String("ab")}},
+			{{String("a"), String("b")}, {String("b"), String("a")}},
+			{{Array{Int(1), Int(2)}}, {Int(1), Int(2)}},
+			{{Array{String("a")}, String("b")}, {Array{String("a"), String("b")}}},
+			{{Map{"a": Int(1)}}, {Map{"a": String("1")}}},
+			{{Map{"a": Int(1)}}, {Array{String("a"), Int(1)}}},
+			{{Timestamp(now)}, {Timestamp(now.Add(time.Nanosecond))}},
+			{{Int(1)}, {Int(1), Null{}}},
+		}
+
+		Convey("Then their keys should be distinct", func() {
+			for _, c := range cases {
+				So(GroupKey(c[0]...), ShouldNotEqual, GroupKey(c[1]...))
+			}
+		})
+	})
+}
@@ -0,0 +1,99 @@
+package data
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// InterpolateOptions has options of InterpolateWithOptions.
+type InterpolateOptions struct {
+	// KeepMissing makes a token whose path doesn't exist in the Map be left
+	// in the result as it is, e.g. "{no.such.field}", instead of making
+	// interpolation fail.
+	KeepMissing bool
+}
+
+// Interpolate replaces each token in template with the value in m addressed
+// by the path written in the token. A token is a path surrounded by braces,
+// e.g. "{user.name}" or "{items[0]}". The path has the same syntax as Map.Get
+// accepts. Values are converted to strings by ToString, so a String is
+// inserted without quotes and Null is inserted as an empty string.
+//
+// "{{" and "}}" are written as literal "{" and "}", respectively. An unclosed
+// brace or an unmatched "}" is an error. It's also an error when a path in a
+// token is invalid or doesn't exist in m. Use InterpolateWithOptions to keep
+// tokens of missing paths as literals.
+//
+//	Interpolate("{{{host}}} is {status.level}", Map{
+//		"host":   String("a"),
+//		"status": Map{"level": String("down")},
+//	}) // -> "{a} is down"
+func Interpolate(template string, m Map) (string, error) {
+	return InterpolateWithOptions(template, m, InterpolateOptions{})
+}
+
+// InterpolateWithOptions is Interpolate having options.
+func InterpolateWithOptions(template string, m Map, opts InterpolateOptions) (string, error) {
+	b := bytes.NewBuffer(nil)
+	for i := 0; i < len(template); {
+		c := template[i]
+		switch c {
+		case '{':
+			if strings.HasPrefix(template[i:], "{{") {
+				b.WriteByte('{')
+				i += 2
+				continue
+			}
+			end := strings.IndexByte(template[i+1:], '}')
+			if end < 0 {
+				return "", fmt.Errorf("unclosed '{' at position %v", i)
+			}
+			token := template[i : i+end+2]
+			s, err := interpolateToken(token[1:len(token)-1], m, opts)
+			if err != nil {
+				return "", fmt.Errorf("cannot interpolate %v at position %v: %v", token, i, err)
+			}
+			if s == nil {
+				b.WriteString(token)
+			} else {
+				b.WriteString(*s)
+			}
+			i += len(token)
+
+		case '}':
+			if !strings.HasPrefix(template[i:], "}}") {
+				return "", fmt.Errorf("unmatched '}' at position %v", i)
+			}
+			b.WriteByte('}')
+			i += 2
+
+		default:
+			b.WriteByte(c)
+			i++
+		}
+	}
+	return b.String(), nil
+}
+
+// interpolateToken returns the string representation of the value addressed
+// by path. It returns nil without an error when the value is missing and
+// opts.KeepMissing is true.
+func interpolateToken(path string, m Map, opts InterpolateOptions) (*string, error) {
+	p, err := CompilePath(strings.TrimSpace(path))
+	if err != nil {
+		return nil, err
+	}
+	v, err := m.Get(p)
+	if err != nil {
+		if opts.KeepMissing {
+			return nil, nil
+		}
+		return nil, err
+	}
+	s, err := ToString(v)
+	if err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
@@ -0,0 +1,86 @@
+package data
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestInterpolate(t *testing.T) {
+	Convey("Given a Map having nested values", t, func() {
+		m := Map{
+			"host": String("web-1"),
+			"cpu":  Float(92.5),
+			"status": Map{
+				"level": String("critical"),
+				"codes": Array{Int(500), Int(503)},
+			},
+			"note": Null{},
+		}
+
+		Convey("When interpolating a template having nested paths", func() {
+			s, err := Interpolate("{host}: {status.level} ({cpu}%, code={status.codes[1]})", m)
+
+			Convey("Then the tokens should be replaced", func() {
+				So(err, ShouldBeNil)
+				So(s, ShouldEqual, "web-1: critical (92.5%, code=503)")
+			})
+		})
+
+		Convey("When interpolating a template having bracket paths and Null", func() {
+			s, err := Interpolate(`{["status"]["level"]}[{note}]`, m)
+
+			Convey("Then Null should be an empty string", func() {
+				So(err, ShouldBeNil)
+				So(s, ShouldEqual, "critical[]")
+			})
+		})
+
+		Convey("When interpolating a template having a Map", func() {
+			s, err := Interpolate("{status.codes}", m)
+
+			Convey("Then it should be written as JSON", func() {
+				So(err, ShouldBeNil)
+				So(s, ShouldEqual, "[500,503]")
+			})
+		})
+
+		Convey("When interpolating a template having escaped braces", func() {
+			s, err := Interpolate("{{host}} is {{{host}}}, }}", m)
+
+			Convey("Then they should be written literally", func() {
+				So(err, ShouldBeNil)
+				So(s, ShouldEqual, "{host} is {web-1}, }")
+			})
+		})
+
+		Convey("When interpolating a template having a missing token", func() {
+			_, err := Interpolate("{host} {status.message}", m)
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldStartWith, "cannot interpolate {status.message} at position 7")
+			})
+		})
+
+		Convey("When interpolating a missing token with KeepMissing", func() {
+			s, err := InterpolateWithOptions("{host} {status.message}", m, InterpolateOptions{
+				KeepMissing: true,
+			})
+
+			Convey("Then it should be kept as it is", func() {
+				So(err, ShouldBeNil)
+				So(s, ShouldEqual, "web-1 {status.message}")
+			})
+		})
+
+		Convey("When interpolating invalid templates", func() {
+			for _, tmpl := range []string{"{host", "host}", "{}", "{a[x]}"} {
+				_, err := InterpolateWithOptions(tmpl, m, InterpolateOptions{KeepMissing: true})
+
+				Convey("Then it should fail: "+tmpl, func() {
+					So(err, ShouldNotBeNil)
+				})
+			}
+		})
+	})
+}
@@ -0,0 +1,165 @@
+package data
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+// ParseISO8601Duration parses a duration in the ISO8601 format such as
+// "PT1H30M" or "P1DT0.5S". A duration consists of "P", date components,
+// and time components following "T":
+//
+//	* W: weeks, which are 7 days
+//	* D: days, which are 24 hours
+//	* H: hours (time component)
+//	* M: minutes (time component)
+//	* S: seconds (time component)
+//
+// Years and months (Y and M in date components) are rejected because their
+// length depends on the date to which the duration is added. Only the last
+// component can have a fraction, e.g. "PT1.5H", and both '.' and ',' are
+// accepted as the decimal mark. A leading '-' negates the duration.
+func ParseISO8601Duration(s string) (time.Duration, error) {
+	orig := s
+	neg := false
+	if strings.HasPrefix(s, "-") {
+		neg = true
+		s = s[1:]
+	}
+	if !strings.HasPrefix(s, "P") {
+		return 0, fmt.Errorf("invalid ISO8601 duration %q: it must start with 'P'", orig)
+	}
+	s = s[1:]
+	if s == "" || s == "T" {
+		return 0, fmt.Errorf("invalid ISO8601 duration %q: it doesn't have any component", orig)
+	}
+
+	var d time.Duration
+	inTime := false
+	lastUnit := byte(0)
+	hasFraction := false
+	for s != "" {
+		if s[0] == 'T' {
+			if inTime {
+				return 0, fmt.Errorf("invalid ISO8601 duration %q: 'T' appears more than once", orig)
+			}
+			inTime = true
+			lastUnit = 0
+			s = s[1:]
+			if s == "" {
+				return 0, fmt.Errorf("invalid ISO8601 duration %q: 'T' isn't followed by any component", orig)
+			}
+			continue
+		}
+		if hasFraction {
+			return 0, fmt.Errorf("invalid ISO8601 duration %q: only the last component can have a fraction", orig)
+		}
+
+		i := 0
+		for i < len(s) && ('0' <= s[i] && s[i] <= '9' || s[i] == '.' || s[i] == ',') {
+			i++
+		}
+		if i == 0 || i == len(s) {
+			return 0, fmt.Errorf("invalid ISO8601 duration %q: a component must be a number followed by a unit", orig)
+		}
+		num, unitChar := s[:i], s[i]
+		s = s[i+1:]
+
+		unit, order, err := iso8601DurationUnit(unitChar, inTime)
+		if err != nil {
+			return 0, fmt.Errorf("invalid ISO8601 duration %q: %v", orig, err)
+		}
+		if lastUnit != 0 && order <= lastUnit {
+			return 0, fmt.Errorf("invalid ISO8601 duration %q: components are out of order", orig)
+		}
+		lastUnit = order
+
+		v, frac, err := iso8601DurationValue(num, unit)
+		if err != nil {
+			return 0, fmt.Errorf("invalid ISO8601 duration %q: %v", orig, err)
+		}
+		hasFraction = frac
+		if d > math.MaxInt64-v {
+			return 0, fmt.Errorf("invalid ISO8601 duration %q: it's out of range", orig)
+		}
+		d += v
+	}
+
+	if neg {
+		d = -d
+	}
+	return d, nil
+}
+
+// iso8601DurationUnit returns the length of the unit and its order in a
+// duration. Orders are only compared within date or time components.
+func iso8601DurationUnit(c byte, inTime bool) (time.Duration, byte, error) {
+	if inTime {
+		switch c {
+		case 'H':
+			return time.Hour, 1, nil
+		case 'M':
+			return time.Minute, 2, nil
+		case 'S':
+			return time.Second, 3, nil
+		}
+		return 0, 0, fmt.Errorf("unknown time unit '%c'", c)
+	}
+
+	switch c {
+	case 'Y':
+		return 0, 0, errors.New("years aren't supported since their length isn't fixed")
+	case 'M':
+		return 0, 0, errors.New("months aren't supported since their length isn't fixed")
+	case 'W':
+		return 7 * 24 * time.Hour, 1, nil
+	case 'D':
+		return 24 * time.Hour, 2, nil
+	}
+	return 0, 0, fmt.Errorf("unknown date unit '%c'", c)
+}
+
+// iso8601DurationValue converts a number of a component to time.Duration.
+// It also returns true when the number has a fraction.
+func iso8601DurationValue(num string, unit time.Duration) (time.Duration, bool, error) {
+	intPart, fracPart := num, ""
+	frac := false
+	if i := strings.IndexAny(num, ".,"); i >= 0 {
+		intPart, fracPart = num[:i], num[i+1:]
+		frac = true
+		if intPart == "" || fracPart == "" || strings.ContainsAny(fracPart, ".,") {
+			return 0, false, fmt.Errorf("invalid number %q", num)
+		}
+	}
+
+	var d time.Duration
+	for _, c := range intPart {
+		n := time.Duration(c - '0')
+		if d > (math.MaxInt64-n)/10 {
+			return 0, false, fmt.Errorf("%q is out of range", num)
+		}
+		d = d*10 + n
+	}
+	if d > math.MaxInt64/unit {
+		return 0, false, fmt.Errorf("%q is out of range", num)
+	}
+	d *= unit
+
+	// digits beyond nanoseconds are truncated
+	var f time.Duration
+	scale := unit
+	for _, c := range fracPart {
+		scale /= 10
+		if scale == 0 {
+			break
+		}
+		f += time.Duration(c-'0') * scale
+	}
+	if d > math.MaxInt64-f {
+		return 0, false, fmt.Errorf("%q is out of range", num)
+	}
+	return d + f, frac, nil
+}
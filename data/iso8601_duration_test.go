@@ -0,0 +1,80 @@
+package data
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+	"time"
+)
+
+func TestParseISO8601Duration(t *testing.T) {
+	Convey("Given valid ISO8601 durations", t, func() {
+		cases := []struct {
+			s   string
+			exp time.Duration
+		}{
+			{"PT1H30M", 90 * time.Minute},
+			{"PT0.5S", 500 * time.Millisecond},
+			{"PT0,5S", 500 * time.Millisecond},
+			{"PT1.5H", 90 * time.Minute},
+			{"PT36H", 36 * time.Hour},
+			{"P1D", 24 * time.Hour},
+			{"P2W", 14 * 24 * time.Hour},
+			{"P1DT2H3M4S", 26*time.Hour + 3*time.Minute + 4*time.Second},
+			{"PT0S", 0},
+			{"PT0.000000001S", time.Nanosecond},
+			{"PT0.0000000019S", time.Nanosecond},
+			{"-PT1M", -time.Minute},
+		}
+
+		for _, c := range cases {
+			c := c
+			Convey("When parsing "+c.s, func() {
+				d, err := ParseISO8601Duration(c.s)
+
+				Convey("Then it should be "+c.exp.String(), func() {
+					So(err, ShouldBeNil)
+					So(d, ShouldEqual, c.exp)
+				})
+			})
+		}
+	})
+
+	Convey("Given invalid ISO8601 durations", t, func() {
+		cases := []string{
+			"",
+			"1H",
+			"P",
+			"PT",
+			"P1Y",
+			"P1M",
+			"P1Y2M3D",
+			"P1H",
+			"PT1D",
+			"P1DT",
+			"PT1M1H",
+			"PT1H1H",
+			"P1DT1HT1M",
+			"PT1.5H30M",
+			"PT.5S",
+			"PT1.S",
+			"PT1.2.3S",
+			"PTH",
+			"PT1",
+			"PT-1S",
+			"PT1h",
+			"P9999999999999999999D",
+			"P999999999999W",
+		}
+
+		for _, c := range cases {
+			c := c
+			Convey("When parsing "+c, func() {
+				_, err := ParseISO8601Duration(c)
+
+				Convey("Then it should fail", func() {
+					So(err, ShouldNotBeNil)
+				})
+			})
+		}
+	})
+}
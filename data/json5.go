@@ -0,0 +1,186 @@
+package data
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// ParseJSON5 parses a relaxed JSON document, which is convenient to write
+// config files by hand. In addition to the standard JSON, it accepts:
+//
+//  * line comments starting with "//" and block comments "/* ... */"
+//  * trailing commas in arrays and objects, e.g. [1, 2,] or {"a": 1,}
+//  * unquoted keys consisting of letters, digits, '_' and '$', e.g. {a: 1}
+//
+// Other extensions of JSON5 such as single-quoted strings or hexadecimal
+// numbers aren't supported. The document must have exactly one value,
+// which is usually a Map for config files. Values are converted in the same
+// way as JSONDecoder does.
+func ParseJSON5(b []byte) (Value, error) {
+	js, err := json5ToJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	dec := NewJSONDecoder(bytes.NewReader(js), nil)
+	v, err := dec.Decode()
+	if err != nil {
+		if err == io.EOF {
+			return nil, errors.New("the document doesn't have a value")
+		}
+		return nil, err
+	}
+	if _, err := dec.Decode(); err != io.EOF {
+		return nil, errors.New("the document has more than one value")
+	}
+	return v, nil
+}
+
+// json5ToJSON converts a relaxed JSON document to a standard JSON document.
+// Comments are replaced with whitespace so that the JSON decoder reports
+// errors at the same lines.
+func json5ToJSON(b []byte) ([]byte, error) {
+	out := bytes.NewBuffer(make([]byte, 0, len(b)))
+	for i := 0; i < len(b); {
+		c := b[i]
+		switch {
+		case c == '"':
+			end := skipJSONString(b, i)
+			out.Write(b[i:end])
+			i = end
+
+		case c == '/':
+			end, err := skipJSON5Comment(b, i)
+			if err != nil {
+				return nil, err
+			}
+			if end == i {
+				// not a comment; let the JSON decoder report an error
+				out.WriteByte(c)
+				i++
+				continue
+			}
+			writeCommentSpace(out, b[i:end])
+			i = end
+
+		case c == ',':
+			next, err := skipJSON5Space(b, i+1)
+			if err != nil {
+				return nil, err
+			}
+			if next < len(b) && (b[next] == ']' || b[next] == '}') {
+				// trailing comma
+				out.WriteByte(' ')
+			} else {
+				out.WriteByte(c)
+			}
+			i++
+
+		case isJSON5IdentStart(c):
+			end := i + 1
+			for end < len(b) && isJSON5IdentPart(b[end]) {
+				end++
+			}
+			next, err := skipJSON5Space(b, end)
+			if err != nil {
+				return nil, err
+			}
+			if next < len(b) && b[next] == ':' {
+				// unquoted key
+				out.WriteByte('"')
+				out.Write(b[i:end])
+				out.WriteByte('"')
+			} else {
+				// true, false, null, or an invalid value
+				out.Write(b[i:end])
+			}
+			i = end
+
+		default:
+			out.WriteByte(c)
+			i++
+		}
+	}
+	return out.Bytes(), nil
+}
+
+// skipJSONString returns the position next to the end of the string
+// starting at b[i]. It returns len(b) when the string isn't closed.
+func skipJSONString(b []byte, i int) int {
+	for i++; i < len(b); i++ {
+		switch b[i] {
+		case '\\':
+			i++
+		case '"':
+			return i + 1
+		}
+	}
+	return len(b)
+}
+
+// skipJSON5Comment returns the position next to the end of the comment
+// starting at b[i]. It returns i when b[i] doesn't start a comment.
+func skipJSON5Comment(b []byte, i int) (int, error) {
+	if i+1 >= len(b) {
+		return i, nil
+	}
+	switch b[i+1] {
+	case '/':
+		end := bytes.IndexByte(b[i:], '\n')
+		if end < 0 {
+			return len(b), nil
+		}
+		return i + end, nil
+	case '*':
+		end := bytes.Index(b[i+2:], []byte("*/"))
+		if end < 0 {
+			return 0, fmt.Errorf("unclosed comment at offset %v", i)
+		}
+		return i + 2 + end + 2, nil
+	}
+	return i, nil
+}
+
+// skipJSON5Space returns the position of the first character which is
+// neither whitespace nor a part of a comment at or after b[i].
+func skipJSON5Space(b []byte, i int) (int, error) {
+	for i < len(b) {
+		switch b[i] {
+		case ' ', '\t', '\r', '\n':
+			i++
+		case '/':
+			end, err := skipJSON5Comment(b, i)
+			if err != nil {
+				return 0, err
+			}
+			if end == i {
+				return i, nil
+			}
+			i = end
+		default:
+			return i, nil
+		}
+	}
+	return i, nil
+}
+
+// writeCommentSpace writes whitespace in place of a comment keeping its
+// newlines.
+func writeCommentSpace(out *bytes.Buffer, comment []byte) {
+	for _, c := range comment {
+		if c == '\n' {
+			out.WriteByte('\n')
+		} else {
+			out.WriteByte(' ')
+		}
+	}
+}
+
+func isJSON5IdentStart(c byte) bool {
+	return c == '_' || c == '$' || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z')
+}
+
+func isJSON5IdentPart(c byte) bool {
+	return isJSON5IdentStart(c) || ('0' <= c && c <= '9')
+}
@@ -0,0 +1,89 @@
+package data
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestParseJSON5(t *testing.T) {
+	Convey("Given a JSON5 document having comments and trailing commas", t, func() {
+		doc := `// the server config
+{
+	network: {
+		listen_on: ":15601", // the default port
+	},
+	/* topologies created on startup
+	   (this can be empty) */
+	"topologies": {
+		t1: {bql_file: "t1.bql"},
+	},
+	"logging": {
+		"target": "//not/a/comment", /* neither is "/*" */
+		min_log_level: "info",
+		log_dropped_tuples: true,
+	},
+	$weights: [1, 2.5, 1e3, null, false,],
+	"empty": [ /* nothing */ ],
+}
+`
+
+		Convey("When parsing it", func() {
+			v, err := ParseJSON5([]byte(doc))
+
+			Convey("Then it should be the correct Map", func() {
+				So(err, ShouldBeNil)
+				So(v, ShouldResemble, Map{
+					"network": Map{
+						"listen_on": String(":15601"),
+					},
+					"topologies": Map{
+						"t1": Map{"bql_file": String("t1.bql")},
+					},
+					"logging": Map{
+						"target":             String("//not/a/comment"),
+						"min_log_level":      String("info"),
+						"log_dropped_tuples": True,
+					},
+					"$weights": Array{Int(1), Float(2.5), Float(1000), Null{}, False},
+					"empty":    Array{},
+				})
+			})
+		})
+	})
+
+	Convey("Given a standard JSON document", t, func() {
+		doc := `{"a": [1, {"b": "c,]"}], "d\"e": "f"}`
+
+		Convey("When parsing it", func() {
+			v, err := ParseJSON5([]byte(doc))
+
+			Convey("Then it should be parsed as JSON", func() {
+				So(err, ShouldBeNil)
+				So(v, ShouldResemble, Map{
+					"a":    Array{Int(1), Map{"b": String("c,]")}},
+					"d\"e": String("f"),
+				})
+			})
+		})
+	})
+
+	Convey("Given invalid documents", t, func() {
+		for _, doc := range []string{
+			``,
+			`// only a comment`,
+			`{"a": 1 /* unclosed`,
+			`{"a": 1}{"b": 2}`,
+			`{"a": 1,,}`,
+			`{a b: 1}`,
+			`{"a": 'b'}`,
+		} {
+			Convey("When parsing "+doc, func() {
+				_, err := ParseJSON5([]byte(doc))
+
+				Convey("Then it should fail", func() {
+					So(err, ShouldNotBeNil)
+				})
+			})
+		}
+	})
+}
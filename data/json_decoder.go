@@ -0,0 +1,209 @@
+package data
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+const defaultJSONDecoderMaxDepth = 64
+
+// JSONDecoderConfig is used to configure the behavior of JSONDecoder.
+type JSONDecoderConfig struct {
+	// MaxDepth is the maximum nesting level of arrays and objects in a
+	// value. The top-level array or object has the depth of 1. The default
+	// is 64.
+	MaxDepth int
+
+	// MaxBytes is the maximum number of bytes a single value may consist
+	// of, including whitespace preceding it. When it's 0 or less, the size
+	// of a value isn't limited.
+	MaxBytes int64
+}
+
+// JSONDecoder reads JSON values from a stream and converts them to Values
+// without going through interface{}. It's meant to be used for decoding
+// untrusted input: a value exceeding the limits given in the config is
+// rejected as soon as the limit is reached, before the value is fully read
+// or materialized.
+//
+// Numbers are decoded as Ints when they can be parsed as an int64 and as
+// Floats otherwise. Once Decode returns an error other than io.EOF, the
+// decoder cannot be used anymore.
+type JSONDecoder struct {
+	config JSONDecoderConfig
+	r      *jsonLimitReader
+	dec    *json.Decoder
+}
+
+// NewJSONDecoder creates a new JSONDecoder reading from r. When c is nil,
+// the default config is used.
+func NewJSONDecoder(r io.Reader, c *JSONDecoderConfig) *JSONDecoder {
+	config := JSONDecoderConfig{}
+	if c != nil {
+		config = *c
+	}
+	if config.MaxDepth <= 0 {
+		config.MaxDepth = defaultJSONDecoderMaxDepth
+	}
+
+	lr := &jsonLimitReader{r: r, limit: -1}
+	dec := json.NewDecoder(lr)
+	dec.UseNumber()
+	return &JSONDecoder{
+		config: config,
+		r:      lr,
+		dec:    dec,
+	}
+}
+
+// errJSONTooLarge is returned from jsonLimitReader when the value being
+// decoded exceeds MaxBytes.
+var errJSONTooLarge = errors.New("too large")
+
+// jsonLimitReader counts the number of bytes read from the underlying
+// reader and refuses to read beyond the limit.
+type jsonLimitReader struct {
+	r     io.Reader
+	read  int64
+	limit int64 // negative if unlimited
+}
+
+func (l *jsonLimitReader) Read(p []byte) (int, error) {
+	if l.limit >= 0 {
+		if l.read >= l.limit {
+			return 0, errJSONTooLarge
+		}
+		if rest := l.limit - l.read; int64(len(p)) > rest {
+			p = p[:rest]
+		}
+	}
+	n, err := l.r.Read(p)
+	l.read += int64(n)
+	return n, err
+}
+
+// Decode reads the next JSON value from the stream. It returns io.EOF when
+// the stream has no more values.
+func (d *JSONDecoder) Decode() (Value, error) {
+	var start int64
+	if d.config.MaxBytes > 0 {
+		// The decoder might have read the beginning of this value while
+		// reading the previous one.
+		start = d.r.read - int64(bufferedLen(d.dec))
+
+		// Allow one more byte than MaxBytes so that the decoder can find
+		// the end of a number having exactly MaxBytes bytes.
+		d.r.limit = start + d.config.MaxBytes + 1
+	}
+
+	v, err := d.decodeValue(1)
+	if err != nil {
+		if err == errJSONTooLarge {
+			return nil, d.tooLargeError()
+		}
+		return nil, err
+	}
+
+	if d.config.MaxBytes > 0 {
+		if d.r.read-int64(bufferedLen(d.dec))-start > d.config.MaxBytes {
+			return nil, d.tooLargeError()
+		}
+		d.r.limit = -1
+	}
+	return v, nil
+}
+
+func (d *JSONDecoder) tooLargeError() error {
+	return fmt.Errorf("the value is larger than the maximum size of %v bytes", d.config.MaxBytes)
+}
+
+func bufferedLen(dec *json.Decoder) int {
+	// Buffered returns a *bytes.Reader
+	if l, ok := dec.Buffered().(interface {
+		Len() int
+	}); ok {
+		return l.Len()
+	}
+	return 0
+}
+
+func (d *JSONDecoder) decodeValue(depth int) (Value, error) {
+	t, err := d.dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	return d.tokenToValue(t, depth)
+}
+
+func (d *JSONDecoder) tokenToValue(t json.Token, depth int) (Value, error) {
+	switch v := t.(type) {
+	case json.Delim:
+		if depth > d.config.MaxDepth {
+			return nil, fmt.Errorf("the value is nested too deeply (the maximum depth is %v)", d.config.MaxDepth)
+		}
+		switch v {
+		case '[':
+			return d.decodeArray(depth)
+		case '{':
+			return d.decodeMap(depth)
+		}
+		// json.Decoder never returns ']' or '}' here
+		return nil, fmt.Errorf("unexpected delimiter: %v", v)
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return Int(i), nil
+		}
+		f, err := v.Float64()
+		if err != nil {
+			return nil, err
+		}
+		return Float(f), nil
+	case string:
+		return String(v), nil
+	case bool:
+		return Bool(v), nil
+	case nil:
+		return Null{}, nil
+	}
+	return nil, fmt.Errorf("unsupported JSON token: %v", t)
+}
+
+func (d *JSONDecoder) decodeArray(depth int) (Value, error) {
+	a := Array{}
+	for d.dec.More() {
+		v, err := d.decodeValue(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		a = append(a, v)
+	}
+	if _, err := d.dec.Token(); err != nil { // ']'
+		return nil, err
+	}
+	return a, nil
+}
+
+func (d *JSONDecoder) decodeMap(depth int) (Value, error) {
+	m := Map{}
+	for d.dec.More() {
+		t, err := d.dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		k, ok := t.(string)
+		if !ok {
+			return nil, fmt.Errorf("a key of a map must be a string: %v", t)
+		}
+		v, err := d.decodeValue(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		m[k] = v
+	}
+	if _, err := d.dec.Token(); err != nil { // '}'
+		return nil, err
+	}
+	return m, nil
+}
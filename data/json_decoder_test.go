@@ -0,0 +1,138 @@
+package data
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestJSONDecoder(t *testing.T) {
+	Convey("Given a JSONDecoder with the default config reading a stream", t, func() {
+		d := NewJSONDecoder(strings.NewReader(`{"a": [1, 2.5, "s", true, null], "b": {}}
+			[] 3 "x"`), nil)
+
+		Convey("When decoding all values", func() {
+			var vs []Value
+			var err error
+			for {
+				var v Value
+				if v, err = d.Decode(); err != nil {
+					break
+				}
+				vs = append(vs, v)
+			}
+
+			Convey("Then it should return them in order and end with io.EOF", func() {
+				So(err, ShouldEqual, io.EOF)
+				So(vs, ShouldResemble, []Value{
+					Map{
+						"a": Array{Int(1), Float(2.5), String("s"), Bool(true), Null{}},
+						"b": Map{},
+					},
+					Array{},
+					Int(3),
+					String("x"),
+				})
+			})
+		})
+	})
+
+	Convey("Given a JSONDecoder with the default config", t, func() {
+		Convey("When decoding a value nested within the limit", func() {
+			d := NewJSONDecoder(strings.NewReader(strings.Repeat("[", 64)+strings.Repeat("]", 64)), nil)
+			_, err := d.Decode()
+
+			Convey("Then it should succeed", func() {
+				So(err, ShouldBeNil)
+			})
+		})
+
+		Convey("When decoding a value nested past the limit", func() {
+			d := NewJSONDecoder(strings.NewReader(strings.Repeat(`{"a":[`, 10000)), nil)
+			_, err := d.Decode()
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "nested too deeply")
+			})
+		})
+
+		Convey("When decoding malformed JSON", func() {
+			d := NewJSONDecoder(strings.NewReader(`{"a": 1,}`), nil)
+			_, err := d.Decode()
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+
+	Convey("Given a JSONDecoder with custom limits", t, func() {
+		config := &JSONDecoderConfig{
+			MaxDepth: 2,
+			MaxBytes: 10,
+		}
+
+		Convey("When decoding values within the limits", func() {
+			d := NewJSONDecoder(strings.NewReader(`[[1, 2]] 123456789 "1234567"`), config)
+
+			Convey("Then it should succeed", func() {
+				v, err := d.Decode()
+				So(err, ShouldBeNil)
+				So(v, ShouldResemble, Array{Array{Int(1), Int(2)}})
+
+				v, err = d.Decode()
+				So(err, ShouldBeNil)
+				So(v, ShouldResemble, Int(123456789))
+
+				v, err = d.Decode()
+				So(err, ShouldBeNil)
+				So(v, ShouldResemble, String("1234567"))
+			})
+		})
+
+		Convey("When decoding a value nested past MaxDepth", func() {
+			d := NewJSONDecoder(strings.NewReader(`[[[1]]]`), config)
+			_, err := d.Decode()
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "nested too deeply")
+			})
+		})
+
+		Convey("When decoding a value larger than MaxBytes", func() {
+			d := NewJSONDecoder(strings.NewReader(`"`+strings.Repeat("a", 1<<20)+`"`), config)
+			_, err := d.Decode()
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "larger than the maximum size")
+			})
+		})
+
+		Convey("When decoding a number one byte longer than MaxBytes", func() {
+			d := NewJSONDecoder(strings.NewReader(`12345678901`), config)
+			_, err := d.Decode()
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "larger than the maximum size")
+			})
+		})
+
+		Convey("When a large value follows a small one", func() {
+			d := NewJSONDecoder(strings.NewReader(`1 [1, 2, 3, 4, 5, 6]`), config)
+
+			Convey("Then only the large one should fail", func() {
+				v, err := d.Decode()
+				So(err, ShouldBeNil)
+				So(v, ShouldResemble, Int(1))
+
+				_, err = d.Decode()
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
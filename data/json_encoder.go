@@ -0,0 +1,91 @@
+package data
+
+import (
+	"encoding/json"
+	"io"
+	"math"
+)
+
+// NonFiniteFloatEncoding specifies how JSONEncoder writes Floats which
+// cannot be represented in JSON, namely NaN, +Inf, and -Inf.
+type NonFiniteFloatEncoding int
+
+const (
+	// NonFiniteFloatAsNull writes NaN and Inf as null. This is the same as
+	// Float.MarshalJSON and the default of JSONEncoder.
+	NonFiniteFloatAsNull NonFiniteFloatEncoding = iota
+
+	// NonFiniteFloatAsString writes NaN, +Inf, and -Inf as strings "NaN",
+	// "Infinity", and "-Infinity", respectively. Those strings are converted
+	// back to Floats by ToFloat.
+	NonFiniteFloatAsString
+)
+
+// JSONEncoderConfig is used to configure the behavior of JSONEncoder.
+type JSONEncoderConfig struct {
+	// NonFiniteFloat specifies how NaN and Inf are written. The default is
+	// NonFiniteFloatAsNull.
+	NonFiniteFloat NonFiniteFloatEncoding
+}
+
+// JSONEncoder writes Values to a stream as JSON. Each value is followed by
+// a newline as done by json.Encoder.
+type JSONEncoder struct {
+	config JSONEncoderConfig
+	enc    *json.Encoder
+}
+
+// NewJSONEncoder creates a new JSONEncoder writing to w. When c is nil, the
+// default config is used.
+func NewJSONEncoder(w io.Writer, c *JSONEncoderConfig) *JSONEncoder {
+	config := JSONEncoderConfig{}
+	if c != nil {
+		config = *c
+	}
+	return &JSONEncoder{
+		config: config,
+		enc:    json.NewEncoder(w),
+	}
+}
+
+// Encode writes v to the stream.
+func (e *JSONEncoder) Encode(v Value) error {
+	if e.config.NonFiniteFloat == NonFiniteFloatAsNull {
+		// Float.MarshalJSON already writes null
+		return e.enc.Encode(v)
+	}
+	return e.enc.Encode(e.replaceNonFiniteFloats(v))
+}
+
+// replaceNonFiniteFloats returns a value that can be marshaled by
+// encoding/json in which non-finite Floats are replaced with strings.
+// Values other than Maps, Arrays, and Floats are returned as they are.
+func (e *JSONEncoder) replaceNonFiniteFloats(v Value) interface{} {
+	switch v.Type() {
+	case TypeFloat:
+		f, _ := v.asFloat()
+		switch {
+		case math.IsNaN(f):
+			return "NaN"
+		case math.IsInf(f, 1):
+			return "Infinity"
+		case math.IsInf(f, -1):
+			return "-Infinity"
+		}
+	case TypeArray:
+		a, _ := v.asArray()
+		res := make([]interface{}, len(a))
+		for i, elem := range a {
+			res[i] = e.replaceNonFiniteFloats(elem)
+		}
+		return res
+	case TypeMap:
+		m, _ := v.asMap()
+		res := make(map[string]interface{}, len(m))
+		for k, elem := range m {
+			res[k] = e.replaceNonFiniteFloats(elem)
+		}
+		return res
+	}
+	return v
+}
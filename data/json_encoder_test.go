@@ -0,0 +1,103 @@
+package data
+
+import (
+	"bytes"
+	. "github.com/smartystreets/goconvey/convey"
+	"math"
+	"testing"
+)
+
+func TestJSONEncoder(t *testing.T) {
+	Convey("Given a Map having non-finite Floats", t, func() {
+		m := Map{
+			"nan":  Float(math.NaN()),
+			"inf":  Float(math.Inf(1)),
+			"ninf": Float(math.Inf(-1)),
+			"f":    Float(1.5),
+			"a":    Array{Float(math.NaN()), Int(1), String("x")},
+			"m":    Map{"inf": Float(math.Inf(1))},
+		}
+
+		Convey("When encoding it with the default config", func() {
+			buf := bytes.NewBuffer(nil)
+			So(NewJSONEncoder(buf, nil).Encode(m), ShouldBeNil)
+
+			Convey("Then NaN and Inf should be null", func() {
+				So(buf.String(), ShouldEqual, `{"a":[null,1,"x"],"f":1.5,"inf":null,"m":{"inf":null},"nan":null,"ninf":null}`+"\n")
+			})
+
+			Convey("Then it should be the same as Map.String", func() {
+				So(buf.String(), ShouldEqual, m.String()+"\n")
+			})
+		})
+
+		Convey("When encoding it with NaN and Inf as strings", func() {
+			buf := bytes.NewBuffer(nil)
+			e := NewJSONEncoder(buf, &JSONEncoderConfig{NonFiniteFloat: NonFiniteFloatAsString})
+			So(e.Encode(m), ShouldBeNil)
+
+			Convey("Then NaN and Inf should be strings", func() {
+				So(buf.String(), ShouldEqual, `{"a":["NaN",1,"x"],"f":1.5,"inf":"Infinity","m":{"inf":"Infinity"},"nan":"NaN","ninf":"-Infinity"}`+"\n")
+			})
+
+			Convey("Then they should be converted back by ToFloat", func() {
+				d := NewJSONDecoder(buf, nil)
+				v, err := d.Decode()
+				So(err, ShouldBeNil)
+				dm, err := AsMap(v)
+				So(err, ShouldBeNil)
+
+				f, err := ToFloat(dm["nan"])
+				So(err, ShouldBeNil)
+				So(math.IsNaN(f), ShouldBeTrue)
+				f, err = ToFloat(dm["inf"])
+				So(err, ShouldBeNil)
+				So(math.IsInf(f, 1), ShouldBeTrue)
+				f, err = ToFloat(dm["ninf"])
+				So(err, ShouldBeNil)
+				So(math.IsInf(f, -1), ShouldBeTrue)
+			})
+		})
+
+		Convey("When encoding a single NaN with NaN as a string", func() {
+			buf := bytes.NewBuffer(nil)
+			e := NewJSONEncoder(buf, &JSONEncoderConfig{NonFiniteFloat: NonFiniteFloatAsString})
+			So(e.Encode(Float(math.NaN())), ShouldBeNil)
+
+			Convey("Then it should be a string", func() {
+				So(buf.String(), ShouldEqual, `"NaN"`+"\n")
+			})
+		})
+	})
+}
+
+func TestWeakNonFiniteFloats(t *testing.T) {
+	Convey("Given strings representing non-finite floats", t, func() {
+		Convey("Then ToFloat should parse them", func() {
+			for _, s := range []string{"Infinity", "infinity", "+Inf", "inf"} {
+				f, err := ToFloat(String(s))
+				So(err, ShouldBeNil)
+				So(math.IsInf(f, 1), ShouldBeTrue)
+			}
+			f, err := ToFloat(String("-Infinity"))
+			So(err, ShouldBeNil)
+			So(math.IsInf(f, -1), ShouldBeTrue)
+			f, err = ToFloat(String("NaN"))
+			So(err, ShouldBeNil)
+			So(math.IsNaN(f), ShouldBeTrue)
+		})
+
+		Convey("Then a weakly typed field should be decoded from them", func() {
+			s := struct {
+				F float64 `bql:",weaklytyped"`
+			}{}
+			So(Decode(Map{"f": String("Infinity")}, &s), ShouldBeNil)
+			So(math.IsInf(s.F, 1), ShouldBeTrue)
+		})
+
+		Convey("Then AsFloat should reject them", func() {
+			_, err := AsFloat(String("Infinity"))
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
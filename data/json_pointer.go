@@ -0,0 +1,82 @@
+package data
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ResolvePointer returns the Value in v referenced by the JSON Pointer ptr
+// as defined in RFC 6901, e.g. "/a/b/0". The empty pointer refers to v
+// itself. In a reference token, "~1" stands for "/" and "~0" stands for
+// "~". An error is returned when ptr is malformed or doesn't refer to an
+// existing element.
+func ResolvePointer(v Value, ptr string) (Value, error) {
+	if ptr == "" {
+		return v, nil
+	}
+	if ptr[0] != '/' {
+		return nil, fmt.Errorf("JSON Pointer '%s' must start with '/'", ptr)
+	}
+
+	cur := v
+	for _, tok := range strings.Split(ptr[1:], "/") {
+		key, err := unescapePointerToken(tok)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON Pointer '%s': %v", ptr, err)
+		}
+
+		switch c := cur.(type) {
+		case Map:
+			e, ok := c[key]
+			if !ok {
+				return nil, fmt.Errorf("key '%s' was not found in map", key)
+			}
+			cur = e
+
+		case Array:
+			idx, err := parsePointerIndex(key)
+			if err != nil {
+				return nil, err
+			}
+			if idx >= len(c) {
+				return nil, fmt.Errorf("out of range access: %d (length %d)", idx, len(c))
+			}
+			cur = c[idx]
+
+		default:
+			return nil, fmt.Errorf("cannot access a %T using key \"%s\"", cur, key)
+		}
+	}
+	return cur, nil
+}
+
+// unescapePointerToken replaces "~1" with "/" and "~0" with "~" in a
+// reference token. "~1" has to be replaced first so that "~01" becomes
+// "~1" and not "/".
+func unescapePointerToken(tok string) (string, error) {
+	if !strings.Contains(tok, "~") {
+		return tok, nil
+	}
+	for i := 0; i < len(tok); i++ {
+		if tok[i] == '~' && (i+1 == len(tok) || (tok[i+1] != '0' && tok[i+1] != '1')) {
+			return "", fmt.Errorf("'~' must be followed by '0' or '1' in \"%s\"", tok)
+		}
+	}
+	return strings.Replace(strings.Replace(tok, "~1", "/", -1), "~0", "~", -1), nil
+}
+
+// parsePointerIndex parses a reference token used to access an array. RFC
+// 6901 doesn't allow leading zeros.
+func parsePointerIndex(tok string) (int, error) {
+	if tok == "" || (len(tok) > 1 && tok[0] == '0') || strings.IndexFunc(tok, func(r rune) bool {
+		return r < '0' || r > '9'
+	}) >= 0 {
+		return 0, fmt.Errorf("cannot access an array using \"%s\"", tok)
+	}
+	idx, err := strconv.Atoi(tok)
+	if err != nil {
+		return 0, fmt.Errorf("cannot access an array using \"%s\": %v", tok, err)
+	}
+	return idx, nil
+}
@@ -0,0 +1,71 @@
+package data
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestResolvePointer(t *testing.T) {
+	Convey("Given a nested Map", t, func() {
+		v := Map{
+			"a": Map{
+				"b": Array{Int(1), Map{"c": String("d")}},
+			},
+			"x/y": Int(2),
+			"m~n": Int(3),
+			"~1":  Int(4),
+			"":    Int(5),
+		}
+
+		Convey("When resolving valid pointers", func() {
+			Convey("Then it should return the referenced values", func() {
+				for ptr, expected := range map[string]Value{
+					"":         v,
+					"/a":       v["a"],
+					"/a/b":     Array{Int(1), Map{"c": String("d")}},
+					"/a/b/0":   Int(1),
+					"/a/b/1/c": String("d"),
+					"/":        Int(5),
+				} {
+					res, err := ResolvePointer(v, ptr)
+					So(err, ShouldBeNil)
+					So(res, ShouldResemble, expected)
+				}
+			})
+		})
+
+		Convey("When resolving pointers with escaped characters", func() {
+			Convey("Then they should be unescaped", func() {
+				for ptr, expected := range map[string]Value{
+					"/x~1y": Int(2),
+					"/m~0n": Int(3),
+					"/~01":  Int(4),
+				} {
+					res, err := ResolvePointer(v, ptr)
+					So(err, ShouldBeNil)
+					So(res, ShouldResemble, expected)
+				}
+			})
+		})
+
+		Convey("When resolving invalid pointers", func() {
+			Convey("Then it should fail", func() {
+				for _, ptr := range []string{
+					"a",        // not starting with '/'
+					"/b",       // missing key
+					"/a/c",     // missing key
+					"/a/b/2",   // out of range
+					"/a/b/-",   // past the end
+					"/a/b/01",  // leading zero
+					"/a/b/x",   // not an index
+					"/a/b/0/c", // not a container
+					"/m~2n",    // invalid escape
+					"/m~",      // invalid escape
+				} {
+					_, err := ResolvePointer(v, ptr)
+					So(err, ShouldNotBeNil)
+				}
+			})
+		})
+	})
+}
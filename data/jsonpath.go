@@ -20,6 +20,10 @@ const (
 // return the value stored at the location specified by
 // the Path.
 type Path interface {
+	// Eval returns the value located at this Path in the given
+	// Value, which is usually a Map or an Array.
+	Eval(v Value) (Value, error)
+
 	evaluate(Value) (Value, error)
 	set(Map, Value) error
 }
@@ -71,6 +75,12 @@ func CompilePath(s string) (p Path, err error) {
 	return j, nil
 }
 
+// Eval returns the entry of a map or an array located at the JSON Path
+// represented by this jsonPeg instance.
+func (j *jsonPeg) Eval(v Value) (Value, error) {
+	return j.evaluate(v)
+}
+
 // evaluate returns the entry of a map or an array located at the JSON Path
 // represented by this jsonPeg instance.
 func (j *jsonPeg) evaluate(v Value) (Value, error) {
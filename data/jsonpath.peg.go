@@ -397,42 +397,66 @@ func (p *jsonPeg) Init() {
 
 	_rules = [...]func() bool{
 		nil,
-		/* 0 jsonPath <- <((jsonPathHead / jsonArraySlices) jsonPathNonHead* !.)> */
+		/* 0 jsonPath <- <((('$' jsonPathNonHead+) / ((jsonPathHead / jsonArraySlices) jsonPathNonHead*)) !.)> */
 		func() bool {
 			position0, tokenIndex0 := position, tokenIndex
 			{
 				position1 := position
 				{
 					position2, tokenIndex2 := position, tokenIndex
-					if !_rules[rulejsonPathHead]() {
+					if buffer[position] != rune('$') {
 						goto l3
 					}
+					position++
+					if !_rules[rulejsonPathNonHead]() {
+						goto l3
+					}
+				l4:
+					{
+						position5, tokenIndex5 := position, tokenIndex
+						if !_rules[rulejsonPathNonHead]() {
+							goto l5
+						}
+						goto l4
+					l5:
+						position, tokenIndex = position5, tokenIndex5
+					}
 					goto l2
 				l3:
 					position, tokenIndex = position2, tokenIndex2
-					if !_rules[rulejsonArraySlices]() {
-						goto l0
+					{
+						position6, tokenIndex6 := position, tokenIndex
+						if !_rules[rulejsonPathHead]() {
+							goto l7
+						}
+						goto l6
+					l7:
+						position, tokenIndex = position6, tokenIndex6
+						if !_rules[rulejsonArraySlices]() {
+							goto l0
+						}
 					}
-				}
-			l2:
-			l4:
-				{
-					position5, tokenIndex5 := position, tokenIndex
-					if !_rules[rulejsonPathNonHead]() {
-						goto l5
+				l6:
+				l8:
+					{
+						position9, tokenIndex9 := position, tokenIndex
+						if !_rules[rulejsonPathNonHead]() {
+							goto l9
+						}
+						goto l8
+					l9:
+						position, tokenIndex = position9, tokenIndex9
 					}
-					goto l4
-				l5:
-					position, tokenIndex = position5, tokenIndex5
 				}
+			l2:
 				{
-					position6, tokenIndex6 := position, tokenIndex
+					position10, tokenIndex10 := position, tokenIndex
 					if !matchDot() {
-						goto l6
+						goto l10
 					}
 					goto l0
-				l6:
-					position, tokenIndex = position6, tokenIndex6
+				l10:
+					position, tokenIndex = position10, tokenIndex10
 				}
 				add(rulejsonPath, position1)
 			}
@@ -443,705 +467,705 @@ func (p *jsonPeg) Init() {
 		},
 		/* 1 jsonPathHead <- <((jsonMapAccessString / jsonMapAccessBracket) Action0)> */
 		func() bool {
-			position7, tokenIndex7 := position, tokenIndex
+			position11, tokenIndex11 := position, tokenIndex
 			{
-				position8 := position
+				position12 := position
 				{
-					position9, tokenIndex9 := position, tokenIndex
+					position13, tokenIndex13 := position, tokenIndex
 					if !_rules[rulejsonMapAccessString]() {
-						goto l10
+						goto l14
 					}
-					goto l9
-				l10:
-					position, tokenIndex = position9, tokenIndex9
+					goto l13
+				l14:
+					position, tokenIndex = position13, tokenIndex13
 					if !_rules[rulejsonMapAccessBracket]() {
-						goto l7
+						goto l11
 					}
 				}
-			l9:
+			l13:
 				if !_rules[ruleAction0]() {
-					goto l7
+					goto l11
 				}
-				add(rulejsonPathHead, position8)
+				add(rulejsonPathHead, position12)
 			}
 			return true
-		l7:
-			position, tokenIndex = position7, tokenIndex7
+		l11:
+			position, tokenIndex = position11, tokenIndex11
 			return false
 		},
 		/* 2 jsonPathNonHead <- <(jsonMapMultipleLevel / jsonMapSingleLevel / jsonArrayFullSlice / jsonArrayPartialSlice / jsonArraySlice / jsonArrayAccess)> */
 		func() bool {
-			position11, tokenIndex11 := position, tokenIndex
+			position15, tokenIndex15 := position, tokenIndex
 			{
-				position12 := position
+				position16 := position
 				{
-					position13, tokenIndex13 := position, tokenIndex
+					position17, tokenIndex17 := position, tokenIndex
 					if !_rules[rulejsonMapMultipleLevel]() {
-						goto l14
+						goto l18
 					}
-					goto l13
-				l14:
-					position, tokenIndex = position13, tokenIndex13
+					goto l17
+				l18:
+					position, tokenIndex = position17, tokenIndex17
 					if !_rules[rulejsonMapSingleLevel]() {
-						goto l15
+						goto l19
 					}
-					goto l13
-				l15:
-					position, tokenIndex = position13, tokenIndex13
+					goto l17
+				l19:
+					position, tokenIndex = position17, tokenIndex17
 					if !_rules[rulejsonArrayFullSlice]() {
-						goto l16
+						goto l20
 					}
-					goto l13
-				l16:
-					position, tokenIndex = position13, tokenIndex13
+					goto l17
+				l20:
+					position, tokenIndex = position17, tokenIndex17
 					if !_rules[rulejsonArrayPartialSlice]() {
-						goto l17
+						goto l21
 					}
-					goto l13
-				l17:
-					position, tokenIndex = position13, tokenIndex13
+					goto l17
+				l21:
+					position, tokenIndex = position17, tokenIndex17
 					if !_rules[rulejsonArraySlice]() {
-						goto l18
+						goto l22
 					}
-					goto l13
-				l18:
-					position, tokenIndex = position13, tokenIndex13
+					goto l17
+				l22:
+					position, tokenIndex = position17, tokenIndex17
 					if !_rules[rulejsonArrayAccess]() {
-						goto l11
+						goto l15
 					}
 				}
-			l13:
-				add(rulejsonPathNonHead, position12)
+			l17:
+				add(rulejsonPathNonHead, position16)
 			}
 			return true
-		l11:
-			position, tokenIndex = position11, tokenIndex11
+		l15:
+			position, tokenIndex = position15, tokenIndex15
 			return false
 		},
 		/* 3 jsonMapSingleLevel <- <((('.' jsonMapAccessString) / jsonMapAccessBracket) Action1)> */
 		func() bool {
-			position19, tokenIndex19 := position, tokenIndex
+			position23, tokenIndex23 := position, tokenIndex
 			{
-				position20 := position
+				position24 := position
 				{
-					position21, tokenIndex21 := position, tokenIndex
+					position25, tokenIndex25 := position, tokenIndex
 					if buffer[position] != rune('.') {
-						goto l22
+						goto l26
 					}
 					position++
 					if !_rules[rulejsonMapAccessString]() {
-						goto l22
+						goto l26
 					}
-					goto l21
-				l22:
-					position, tokenIndex = position21, tokenIndex21
+					goto l25
+				l26:
+					position, tokenIndex = position25, tokenIndex25
 					if !_rules[rulejsonMapAccessBracket]() {
-						goto l19
+						goto l23
 					}
 				}
-			l21:
+			l25:
 				if !_rules[ruleAction1]() {
-					goto l19
+					goto l23
 				}
-				add(rulejsonMapSingleLevel, position20)
+				add(rulejsonMapSingleLevel, position24)
 			}
 			return true
-		l19:
-			position, tokenIndex = position19, tokenIndex19
+		l23:
+			position, tokenIndex = position23, tokenIndex23
 			return false
 		},
 		/* 4 jsonMapMultipleLevel <- <('.' '.' (jsonMapAccessString / jsonMapAccessBracket) Action2)> */
 		func() bool {
-			position23, tokenIndex23 := position, tokenIndex
+			position27, tokenIndex27 := position, tokenIndex
 			{
-				position24 := position
+				position28 := position
 				if buffer[position] != rune('.') {
-					goto l23
+					goto l27
 				}
 				position++
 				if buffer[position] != rune('.') {
-					goto l23
+					goto l27
 				}
 				position++
 				{
-					position25, tokenIndex25 := position, tokenIndex
+					position29, tokenIndex29 := position, tokenIndex
 					if !_rules[rulejsonMapAccessString]() {
-						goto l26
+						goto l30
 					}
-					goto l25
-				l26:
-					position, tokenIndex = position25, tokenIndex25
+					goto l29
+				l30:
+					position, tokenIndex = position29, tokenIndex29
 					if !_rules[rulejsonMapAccessBracket]() {
-						goto l23
+						goto l27
 					}
 				}
-			l25:
+			l29:
 				if !_rules[ruleAction2]() {
-					goto l23
+					goto l27
 				}
-				add(rulejsonMapMultipleLevel, position24)
+				add(rulejsonMapMultipleLevel, position28)
 			}
 			return true
-		l23:
-			position, tokenIndex = position23, tokenIndex23
+		l27:
+			position, tokenIndex = position27, tokenIndex27
 			return false
 		},
 		/* 5 jsonMapAccessString <- <(<(([a-z] / [A-Z]) ([a-z] / [A-Z] / [0-9] / '_')*)> Action3)> */
 		func() bool {
-			position27, tokenIndex27 := position, tokenIndex
+			position31, tokenIndex31 := position, tokenIndex
 			{
-				position28 := position
+				position32 := position
 				{
-					position29 := position
+					position33 := position
 					{
-						position30, tokenIndex30 := position, tokenIndex
+						position34, tokenIndex34 := position, tokenIndex
 						if c := buffer[position]; c < rune('a') || c > rune('z') {
-							goto l31
+							goto l35
 						}
 						position++
-						goto l30
-					l31:
-						position, tokenIndex = position30, tokenIndex30
+						goto l34
+					l35:
+						position, tokenIndex = position34, tokenIndex34
 						if c := buffer[position]; c < rune('A') || c > rune('Z') {
-							goto l27
+							goto l31
 						}
 						position++
 					}
-				l30:
-				l32:
+				l34:
+				l36:
 					{
-						position33, tokenIndex33 := position, tokenIndex
+						position37, tokenIndex37 := position, tokenIndex
 						{
-							position34, tokenIndex34 := position, tokenIndex
+							position38, tokenIndex38 := position, tokenIndex
 							if c := buffer[position]; c < rune('a') || c > rune('z') {
-								goto l35
+								goto l39
 							}
 							position++
-							goto l34
-						l35:
-							position, tokenIndex = position34, tokenIndex34
+							goto l38
+						l39:
+							position, tokenIndex = position38, tokenIndex38
 							if c := buffer[position]; c < rune('A') || c > rune('Z') {
-								goto l36
+								goto l40
 							}
 							position++
-							goto l34
-						l36:
-							position, tokenIndex = position34, tokenIndex34
+							goto l38
+						l40:
+							position, tokenIndex = position38, tokenIndex38
 							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l37
+								goto l41
 							}
 							position++
-							goto l34
-						l37:
-							position, tokenIndex = position34, tokenIndex34
+							goto l38
+						l41:
+							position, tokenIndex = position38, tokenIndex38
 							if buffer[position] != rune('_') {
-								goto l33
+								goto l37
 							}
 							position++
 						}
-					l34:
-						goto l32
-					l33:
-						position, tokenIndex = position33, tokenIndex33
+					l38:
+						goto l36
+					l37:
+						position, tokenIndex = position37, tokenIndex37
 					}
-					add(rulePegText, position29)
+					add(rulePegText, position33)
 				}
 				if !_rules[ruleAction3]() {
-					goto l27
+					goto l31
 				}
-				add(rulejsonMapAccessString, position28)
+				add(rulejsonMapAccessString, position32)
 			}
 			return true
-		l27:
-			position, tokenIndex = position27, tokenIndex27
+		l31:
+			position, tokenIndex = position31, tokenIndex31
 			return false
 		},
 		/* 6 jsonMapAccessBracket <- <('[' (singleQuotedString / doubleQuotedString) ']')> */
 		func() bool {
-			position38, tokenIndex38 := position, tokenIndex
+			position42, tokenIndex42 := position, tokenIndex
 			{
-				position39 := position
+				position43 := position
 				if buffer[position] != rune('[') {
-					goto l38
+					goto l42
 				}
 				position++
 				{
-					position40, tokenIndex40 := position, tokenIndex
+					position44, tokenIndex44 := position, tokenIndex
 					if !_rules[rulesingleQuotedString]() {
-						goto l41
+						goto l45
 					}
-					goto l40
-				l41:
-					position, tokenIndex = position40, tokenIndex40
+					goto l44
+				l45:
+					position, tokenIndex = position44, tokenIndex44
 					if !_rules[ruledoubleQuotedString]() {
-						goto l38
+						goto l42
 					}
 				}
-			l40:
+			l44:
 				if buffer[position] != rune(']') {
-					goto l38
+					goto l42
 				}
 				position++
-				add(rulejsonMapAccessBracket, position39)
+				add(rulejsonMapAccessBracket, position43)
 			}
 			return true
-		l38:
-			position, tokenIndex = position38, tokenIndex38
+		l42:
+			position, tokenIndex = position42, tokenIndex42
 			return false
 		},
 		/* 7 singleQuotedString <- <('\'' <(('\'' '\'') / (!'\'' .))*> '\'' Action4)> */
 		func() bool {
-			position42, tokenIndex42 := position, tokenIndex
+			position46, tokenIndex46 := position, tokenIndex
 			{
-				position43 := position
+				position47 := position
 				if buffer[position] != rune('\'') {
-					goto l42
+					goto l46
 				}
 				position++
 				{
-					position44 := position
-				l45:
+					position48 := position
+				l49:
 					{
-						position46, tokenIndex46 := position, tokenIndex
+						position50, tokenIndex50 := position, tokenIndex
 						{
-							position47, tokenIndex47 := position, tokenIndex
+							position51, tokenIndex51 := position, tokenIndex
 							if buffer[position] != rune('\'') {
-								goto l48
+								goto l52
 							}
 							position++
 							if buffer[position] != rune('\'') {
-								goto l48
+								goto l52
 							}
 							position++
-							goto l47
-						l48:
-							position, tokenIndex = position47, tokenIndex47
+							goto l51
+						l52:
+							position, tokenIndex = position51, tokenIndex51
 							{
-								position49, tokenIndex49 := position, tokenIndex
+								position53, tokenIndex53 := position, tokenIndex
 								if buffer[position] != rune('\'') {
-									goto l49
+									goto l53
 								}
 								position++
-								goto l46
-							l49:
-								position, tokenIndex = position49, tokenIndex49
+								goto l50
+							l53:
+								position, tokenIndex = position53, tokenIndex53
 							}
 							if !matchDot() {
-								goto l46
+								goto l50
 							}
 						}
-					l47:
-						goto l45
-					l46:
-						position, tokenIndex = position46, tokenIndex46
+					l51:
+						goto l49
+					l50:
+						position, tokenIndex = position50, tokenIndex50
 					}
-					add(rulePegText, position44)
+					add(rulePegText, position48)
 				}
 				if buffer[position] != rune('\'') {
-					goto l42
+					goto l46
 				}
 				position++
 				if !_rules[ruleAction4]() {
-					goto l42
+					goto l46
 				}
-				add(rulesingleQuotedString, position43)
+				add(rulesingleQuotedString, position47)
 			}
 			return true
-		l42:
-			position, tokenIndex = position42, tokenIndex42
+		l46:
+			position, tokenIndex = position46, tokenIndex46
 			return false
 		},
 		/* 8 doubleQuotedString <- <('"' <(('"' '"') / (!'"' .))*> '"' Action5)> */
 		func() bool {
-			position50, tokenIndex50 := position, tokenIndex
+			position54, tokenIndex54 := position, tokenIndex
 			{
-				position51 := position
+				position55 := position
 				if buffer[position] != rune('"') {
-					goto l50
+					goto l54
 				}
 				position++
 				{
-					position52 := position
-				l53:
+					position56 := position
+				l57:
 					{
-						position54, tokenIndex54 := position, tokenIndex
+						position58, tokenIndex58 := position, tokenIndex
 						{
-							position55, tokenIndex55 := position, tokenIndex
+							position59, tokenIndex59 := position, tokenIndex
 							if buffer[position] != rune('"') {
-								goto l56
+								goto l60
 							}
 							position++
 							if buffer[position] != rune('"') {
-								goto l56
+								goto l60
 							}
 							position++
-							goto l55
-						l56:
-							position, tokenIndex = position55, tokenIndex55
+							goto l59
+						l60:
+							position, tokenIndex = position59, tokenIndex59
 							{
-								position57, tokenIndex57 := position, tokenIndex
+								position61, tokenIndex61 := position, tokenIndex
 								if buffer[position] != rune('"') {
-									goto l57
+									goto l61
 								}
 								position++
-								goto l54
-							l57:
-								position, tokenIndex = position57, tokenIndex57
+								goto l58
+							l61:
+								position, tokenIndex = position61, tokenIndex61
 							}
 							if !matchDot() {
-								goto l54
+								goto l58
 							}
 						}
-					l55:
-						goto l53
-					l54:
-						position, tokenIndex = position54, tokenIndex54
+					l59:
+						goto l57
+					l58:
+						position, tokenIndex = position58, tokenIndex58
 					}
-					add(rulePegText, position52)
+					add(rulePegText, position56)
 				}
 				if buffer[position] != rune('"') {
-					goto l50
+					goto l54
 				}
 				position++
 				if !_rules[ruleAction5]() {
-					goto l50
+					goto l54
 				}
-				add(ruledoubleQuotedString, position51)
+				add(ruledoubleQuotedString, position55)
 			}
 			return true
-		l50:
-			position, tokenIndex = position50, tokenIndex50
+		l54:
+			position, tokenIndex = position54, tokenIndex54
 			return false
 		},
 		/* 9 jsonArraySlices <- <(jsonArrayAccess / jsonArraySlice / jsonArrayPartialSlice / jsonArrayFullSlice)> */
 		func() bool {
-			position58, tokenIndex58 := position, tokenIndex
+			position62, tokenIndex62 := position, tokenIndex
 			{
-				position59 := position
+				position63 := position
 				{
-					position60, tokenIndex60 := position, tokenIndex
+					position64, tokenIndex64 := position, tokenIndex
 					if !_rules[rulejsonArrayAccess]() {
-						goto l61
+						goto l65
 					}
-					goto l60
-				l61:
-					position, tokenIndex = position60, tokenIndex60
+					goto l64
+				l65:
+					position, tokenIndex = position64, tokenIndex64
 					if !_rules[rulejsonArraySlice]() {
-						goto l62
+						goto l66
 					}
-					goto l60
-				l62:
-					position, tokenIndex = position60, tokenIndex60
+					goto l64
+				l66:
+					position, tokenIndex = position64, tokenIndex64
 					if !_rules[rulejsonArrayPartialSlice]() {
-						goto l63
+						goto l67
 					}
-					goto l60
-				l63:
-					position, tokenIndex = position60, tokenIndex60
+					goto l64
+				l67:
+					position, tokenIndex = position64, tokenIndex64
 					if !_rules[rulejsonArrayFullSlice]() {
-						goto l58
+						goto l62
 					}
 				}
-			l60:
-				add(rulejsonArraySlices, position59)
+			l64:
+				add(rulejsonArraySlices, position63)
 			}
 			return true
-		l58:
-			position, tokenIndex = position58, tokenIndex58
+		l62:
+			position, tokenIndex = position62, tokenIndex62
 			return false
 		},
 		/* 10 jsonArrayAccess <- <('[' <('-'? [0-9]+)> ']' Action6)> */
 		func() bool {
-			position64, tokenIndex64 := position, tokenIndex
+			position68, tokenIndex68 := position, tokenIndex
 			{
-				position65 := position
+				position69 := position
 				if buffer[position] != rune('[') {
-					goto l64
+					goto l68
 				}
 				position++
 				{
-					position66 := position
+					position70 := position
 					{
-						position67, tokenIndex67 := position, tokenIndex
+						position71, tokenIndex71 := position, tokenIndex
 						if buffer[position] != rune('-') {
-							goto l67
+							goto l71
 						}
 						position++
-						goto l68
-					l67:
-						position, tokenIndex = position67, tokenIndex67
+						goto l72
+					l71:
+						position, tokenIndex = position71, tokenIndex71
 					}
-				l68:
+				l72:
 					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l64
+						goto l68
 					}
 					position++
-				l69:
+				l73:
 					{
-						position70, tokenIndex70 := position, tokenIndex
+						position74, tokenIndex74 := position, tokenIndex
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l70
+							goto l74
 						}
 						position++
-						goto l69
-					l70:
-						position, tokenIndex = position70, tokenIndex70
+						goto l73
+					l74:
+						position, tokenIndex = position74, tokenIndex74
 					}
-					add(rulePegText, position66)
+					add(rulePegText, position70)
 				}
 				if buffer[position] != rune(']') {
-					goto l64
+					goto l68
 				}
 				position++
 				if !_rules[ruleAction6]() {
-					goto l64
+					goto l68
 				}
-				add(rulejsonArrayAccess, position65)
+				add(rulejsonArrayAccess, position69)
 			}
 			return true
-		l64:
-			position, tokenIndex = position64, tokenIndex64
+		l68:
+			position, tokenIndex = position68, tokenIndex68
 			return false
 		},
 		/* 11 jsonArraySlice <- <('[' <('-'? [0-9]+ ':' '-'? [0-9]+ (':' '-'? [0-9]+)?)> ']' Action7)> */
 		func() bool {
-			position71, tokenIndex71 := position, tokenIndex
+			position75, tokenIndex75 := position, tokenIndex
 			{
-				position72 := position
+				position76 := position
 				if buffer[position] != rune('[') {
-					goto l71
+					goto l75
 				}
 				position++
 				{
-					position73 := position
+					position77 := position
 					{
-						position74, tokenIndex74 := position, tokenIndex
+						position78, tokenIndex78 := position, tokenIndex
 						if buffer[position] != rune('-') {
-							goto l74
+							goto l78
 						}
 						position++
-						goto l75
-					l74:
-						position, tokenIndex = position74, tokenIndex74
+						goto l79
+					l78:
+						position, tokenIndex = position78, tokenIndex78
 					}
-				l75:
+				l79:
 					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l71
+						goto l75
 					}
 					position++
-				l76:
+				l80:
 					{
-						position77, tokenIndex77 := position, tokenIndex
+						position81, tokenIndex81 := position, tokenIndex
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l77
+							goto l81
 						}
 						position++
-						goto l76
-					l77:
-						position, tokenIndex = position77, tokenIndex77
+						goto l80
+					l81:
+						position, tokenIndex = position81, tokenIndex81
 					}
 					if buffer[position] != rune(':') {
-						goto l71
+						goto l75
 					}
 					position++
 					{
-						position78, tokenIndex78 := position, tokenIndex
+						position82, tokenIndex82 := position, tokenIndex
 						if buffer[position] != rune('-') {
-							goto l78
+							goto l82
 						}
 						position++
-						goto l79
-					l78:
-						position, tokenIndex = position78, tokenIndex78
+						goto l83
+					l82:
+						position, tokenIndex = position82, tokenIndex82
 					}
-				l79:
+				l83:
 					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l71
+						goto l75
 					}
 					position++
-				l80:
+				l84:
 					{
-						position81, tokenIndex81 := position, tokenIndex
+						position85, tokenIndex85 := position, tokenIndex
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l81
+							goto l85
 						}
 						position++
-						goto l80
-					l81:
-						position, tokenIndex = position81, tokenIndex81
+						goto l84
+					l85:
+						position, tokenIndex = position85, tokenIndex85
 					}
 					{
-						position82, tokenIndex82 := position, tokenIndex
+						position86, tokenIndex86 := position, tokenIndex
 						if buffer[position] != rune(':') {
-							goto l82
+							goto l86
 						}
 						position++
 						{
-							position84, tokenIndex84 := position, tokenIndex
+							position88, tokenIndex88 := position, tokenIndex
 							if buffer[position] != rune('-') {
-								goto l84
+								goto l88
 							}
 							position++
-							goto l85
-						l84:
-							position, tokenIndex = position84, tokenIndex84
+							goto l89
+						l88:
+							position, tokenIndex = position88, tokenIndex88
 						}
-					l85:
+					l89:
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l82
+							goto l86
 						}
 						position++
-					l86:
+					l90:
 						{
-							position87, tokenIndex87 := position, tokenIndex
+							position91, tokenIndex91 := position, tokenIndex
 							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l87
+								goto l91
 							}
 							position++
-							goto l86
-						l87:
-							position, tokenIndex = position87, tokenIndex87
+							goto l90
+						l91:
+							position, tokenIndex = position91, tokenIndex91
 						}
-						goto l83
-					l82:
-						position, tokenIndex = position82, tokenIndex82
+						goto l87
+					l86:
+						position, tokenIndex = position86, tokenIndex86
 					}
-				l83:
-					add(rulePegText, position73)
+				l87:
+					add(rulePegText, position77)
 				}
 				if buffer[position] != rune(']') {
-					goto l71
+					goto l75
 				}
 				position++
 				if !_rules[ruleAction7]() {
-					goto l71
+					goto l75
 				}
-				add(rulejsonArraySlice, position72)
+				add(rulejsonArraySlice, position76)
 			}
 			return true
-		l71:
-			position, tokenIndex = position71, tokenIndex71
+		l75:
+			position, tokenIndex = position75, tokenIndex75
 			return false
 		},
 		/* 12 jsonArrayPartialSlice <- <('[' <((':' '-'? [0-9]+) / ('-'? [0-9]+ ':'))> ']' Action8)> */
 		func() bool {
-			position88, tokenIndex88 := position, tokenIndex
+			position92, tokenIndex92 := position, tokenIndex
 			{
-				position89 := position
+				position93 := position
 				if buffer[position] != rune('[') {
-					goto l88
+					goto l92
 				}
 				position++
 				{
-					position90 := position
+					position94 := position
 					{
-						position91, tokenIndex91 := position, tokenIndex
+						position95, tokenIndex95 := position, tokenIndex
 						if buffer[position] != rune(':') {
-							goto l92
+							goto l96
 						}
 						position++
 						{
-							position93, tokenIndex93 := position, tokenIndex
+							position97, tokenIndex97 := position, tokenIndex
 							if buffer[position] != rune('-') {
-								goto l93
+								goto l97
 							}
 							position++
-							goto l94
-						l93:
-							position, tokenIndex = position93, tokenIndex93
+							goto l98
+						l97:
+							position, tokenIndex = position97, tokenIndex97
 						}
-					l94:
+					l98:
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l92
+							goto l96
 						}
 						position++
-					l95:
+					l99:
 						{
-							position96, tokenIndex96 := position, tokenIndex
+							position100, tokenIndex100 := position, tokenIndex
 							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l96
+								goto l100
 							}
 							position++
-							goto l95
-						l96:
-							position, tokenIndex = position96, tokenIndex96
+							goto l99
+						l100:
+							position, tokenIndex = position100, tokenIndex100
 						}
-						goto l91
-					l92:
-						position, tokenIndex = position91, tokenIndex91
+						goto l95
+					l96:
+						position, tokenIndex = position95, tokenIndex95
 						{
-							position97, tokenIndex97 := position, tokenIndex
+							position101, tokenIndex101 := position, tokenIndex
 							if buffer[position] != rune('-') {
-								goto l97
+								goto l101
 							}
 							position++
-							goto l98
-						l97:
-							position, tokenIndex = position97, tokenIndex97
+							goto l102
+						l101:
+							position, tokenIndex = position101, tokenIndex101
 						}
-					l98:
+					l102:
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l88
+							goto l92
 						}
 						position++
-					l99:
+					l103:
 						{
-							position100, tokenIndex100 := position, tokenIndex
+							position104, tokenIndex104 := position, tokenIndex
 							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l100
+								goto l104
 							}
 							position++
-							goto l99
-						l100:
-							position, tokenIndex = position100, tokenIndex100
+							goto l103
+						l104:
+							position, tokenIndex = position104, tokenIndex104
 						}
 						if buffer[position] != rune(':') {
-							goto l88
+							goto l92
 						}
 						position++
 					}
-				l91:
-					add(rulePegText, position90)
+				l95:
+					add(rulePegText, position94)
 				}
 				if buffer[position] != rune(']') {
-					goto l88
+					goto l92
 				}
 				position++
 				if !_rules[ruleAction8]() {
-					goto l88
+					goto l92
 				}
-				add(rulejsonArrayPartialSlice, position89)
+				add(rulejsonArrayPartialSlice, position93)
 			}
 			return true
-		l88:
-			position, tokenIndex = position88, tokenIndex88
+		l92:
+			position, tokenIndex = position92, tokenIndex92
 			return false
 		},
 		/* 13 jsonArrayFullSlice <- <('[' ':' ']' Action9)> */
 		func() bool {
-			position101, tokenIndex101 := position, tokenIndex
+			position105, tokenIndex105 := position, tokenIndex
 			{
-				position102 := position
+				position106 := position
 				if buffer[position] != rune('[') {
-					goto l101
+					goto l105
 				}
 				position++
 				if buffer[position] != rune(':') {
-					goto l101
+					goto l105
 				}
 				position++
 				if buffer[position] != rune(']') {
-					goto l101
+					goto l105
 				}
 				position++
 				if !_rules[ruleAction9]() {
-					goto l101
+					goto l105
 				}
-				add(rulejsonArrayFullSlice, position102)
+				add(rulejsonArrayFullSlice, position106)
 			}
 			return true
-		l101:
-			position, tokenIndex = position101, tokenIndex101
+		l105:
+			position, tokenIndex = position105, tokenIndex105
 			return false
 		},
 		/* 15 Action0 <- <{
@@ -0,0 +1,88 @@
+package data
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestCompiledPathEval(t *testing.T) {
+	v := Map{
+		"a": Map{
+			"b": Array{
+				Map{"key": String("x"), "n": Int(1)},
+				Map{"key": String("y"), "n": Int(2)},
+			},
+		},
+		"c": Int(3),
+	}
+
+	Convey("Given a nested Map", t, func() {
+		examples := map[string]Value{
+			"$.a.b[0]['key']":       String("x"),
+			`$["a"]["b"][1]["key"]`: String("y"),
+			"$.a.b[-1].n":           Int(2),
+			"$.a.b[:].key":          Array{String("x"), String("y")},
+			"$..n":                  Array{Int(1), Int(2)},
+			"$.c":                   Int(3),
+			"a.b[0]['key']":         String("x"),
+		}
+
+		for input, expected := range examples {
+			input, expected := input, expected
+
+			Convey(fmt.Sprintf("When compiling %s", input), func() {
+				path, err := CompilePath(input)
+				So(err, ShouldBeNil)
+
+				Convey("Then it can be evaluated repeatedly", func() {
+					for i := 0; i < 3; i++ {
+						actual, err := path.Eval(v)
+						So(err, ShouldBeNil)
+						So(actual, ShouldResemble, expected)
+					}
+				})
+			})
+		}
+
+		Convey("When evaluating a path to a missing key", func() {
+			path, err := CompilePath("$.a.x")
+			So(err, ShouldBeNil)
+
+			Convey("Then Eval should fail", func() {
+				_, err := path.Eval(v)
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+
+	Convey("Given a nested Array", t, func() {
+		a := Array{v, Int(4)}
+
+		Convey("When evaluating a path starting with an index", func() {
+			path, err := CompilePath("$[0].a.b[1]['key']")
+			So(err, ShouldBeNil)
+
+			Convey("Then the element should be returned", func() {
+				actual, err := path.Eval(a)
+				So(err, ShouldBeNil)
+				So(actual, ShouldResemble, String("y"))
+			})
+		})
+	})
+
+	Convey("Given malformed paths", t, func() {
+		for _, input := range []string{"$", "$a", "$.", "$.a[", "$.a['b'", "a.$.b", "$$.a"} {
+			input := input
+
+			Convey(fmt.Sprintf("When compiling %s", input), func() {
+				_, err := CompilePath(input)
+
+				Convey("Then it should fail", func() {
+					So(err, ShouldNotBeNil)
+				})
+			})
+		}
+	})
+}
@@ -0,0 +1,80 @@
+package data
+
+// LikeMatch checks whether s matches pattern following the semantics of
+// SQL's LIKE operator: '%' in pattern matches any sequence of zero or more
+// characters and '_' matches exactly one character. Other characters only
+// match themselves. Characters are compared as Unicode code points, so '_'
+// matches a multi-byte character as a whole. The whole string has to match
+// the pattern; an empty pattern only matches an empty string.
+//
+// When escape is not 0, it can be used in pattern to match '%', '_', or
+// escape itself literally (e.g., `100\%` with '\\' as escape matches
+// "100%"). An escape at the end of a pattern matches the escape character
+// itself.
+func LikeMatch(s, pattern string, escape rune) bool {
+	elems := compileLikePattern(pattern, escape)
+	str := []rune(s)
+
+	// This is the usual wildcard matching algorithm: when a mismatch
+	// occurs, it backtracks to the last '%' and lets it match one more
+	// character. Backtracking to the last '%' is sufficient because
+	// everything before it has already matched.
+	si, pi := 0, 0
+	star, starSi := -1, 0
+	for si < len(str) {
+		if pi < len(elems) {
+			e := elems[pi]
+			if e.anyRun {
+				star, starSi = pi, si
+				pi++
+				continue
+			}
+			if e.anyChar || e.r == str[si] {
+				si++
+				pi++
+				continue
+			}
+		}
+		if star < 0 {
+			return false
+		}
+		starSi++
+		si = starSi
+		pi = star + 1
+	}
+	for pi < len(elems) && elems[pi].anyRun {
+		pi++
+	}
+	return pi == len(elems)
+}
+
+// likeElem is an element of a compiled LIKE pattern.
+type likeElem struct {
+	anyRun  bool // '%'
+	anyChar bool // '_'
+	r       rune // a literal character
+}
+
+func compileLikePattern(pattern string, escape rune) []likeElem {
+	p := []rune(pattern)
+	elems := make([]likeElem, 0, len(p))
+	for i := 0; i < len(p); i++ {
+		switch r := p[i]; {
+		case escape != 0 && r == escape:
+			if i+1 < len(p) {
+				i++
+			}
+			elems = append(elems, likeElem{r: p[i]})
+		case r == '%':
+			// consecutive '%'s are equivalent to a single one
+			if len(elems) == 0 || !elems[len(elems)-1].anyRun {
+				elems = append(elems, likeElem{anyRun: true})
+			}
+		case r == '_':
+			elems = append(elems, likeElem{anyChar: true})
+		default:
+			elems = append(elems, likeElem{r: r})
+		}
+	}
+	return elems
+}
@@ -0,0 +1,65 @@
+package data
+
+import (
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestLikeMatch(t *testing.T) {
+	testCases := []struct {
+		s       string
+		pattern string
+		escape  rune
+		match   bool
+	}{
+		{"abc", "abc", 0, true},
+		{"abc", "ab", 0, false},
+		{"abc", "abcd", 0, false},
+		{"abc", "a%", 0, true},
+		{"abc", "%c", 0, true},
+		{"abc", "%b%", 0, true},
+		{"abc", "%d%", 0, false},
+		{"abc", "a_c", 0, true},
+		{"abc", "a__c", 0, false},
+		{"abc", "___", 0, true},
+		{"abc", "%", 0, true},
+		{"abc", "%%%", 0, true},
+		{"aXbXc", "a%b%c", 0, true},
+		{"abab", "%ab", 0, true},
+		{"mississippi", "m%iss%pi", 0, true},
+		{"mississippi", "m%iss%pix", 0, false},
+		{"Abc", "abc", 0, false},
+		// empty strings and patterns
+		{"", "", 0, true},
+		{"", "%", 0, true},
+		{"", "_", 0, false},
+		{"a", "", 0, false},
+		// escapes
+		{"100%", `100\%`, '\\', true},
+		{"1000", `100\%`, '\\', false},
+		{"100% sure", `100\%%`, '\\', true},
+		{"a_c", `a\_c`, '\\', true},
+		{"abc", `a\_c`, '\\', false},
+		{`a\c`, `a\\c`, '\\', true},
+		{`ab\`, `ab\`, '\\', true},
+		{"100%", "100!%", '!', true},
+		{`100\x`, `100\%`, 0, true},
+		// unicode
+		{"日本語", "日_語", 0, true},
+		{"日本語", "日__語", 0, false},
+		{"日本語", "%語", 0, true},
+		{"日本語テキスト", "日本%ト", 0, true},
+		{"😀x", "_x", 0, true},
+		{"日%", "日¥%", '¥', true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		Convey(fmt.Sprintf("When matching %q with %q", tc.s, tc.pattern), t, func() {
+			Convey(fmt.Sprintf("Then the result should be %v", tc.match), func() {
+				So(LikeMatch(tc.s, tc.pattern, tc.escape), ShouldEqual, tc.match)
+			})
+		})
+	}
+}
@@ -4,11 +4,19 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"reflect"
+	"sort"
+	"strings"
 	"time"
 )
 
 // Map is a map of Values. It can be assigned to Value interface. Only
 // string keys are allowed.
+//
+// Like a built-in map, a Map isn't safe for concurrent use: it can be read
+// from multiple goroutines at once, but it must not be modified while other
+// goroutines access it. Use SyncMap for a Map shared among goroutines, e.g.,
+// by a UDS accessed from multiple streams.
 type Map map[string]Value
 
 // Type returns TypeID of Map. It's always TypeMap.
@@ -90,6 +98,105 @@ func (m Map) Copy() Map {
 	return Map(out)
 }
 
+// Len returns the number of keys in the Map.
+func (m Map) Len() int {
+	return len(m)
+}
+
+// Keys returns all keys of the Map. The order of the keys is undefined.
+func (m Map) Keys() []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// SortedKeys returns all keys of the Map in lexical order.
+func (m Map) SortedKeys() []string {
+	keys := m.Keys()
+	sort.Strings(keys)
+	return keys
+}
+
+// Pick returns a new Map only having the given keys of the Map. A key can be
+// a dotted path such as "a.b" to pick a field of a nested Map, and the
+// result has the nested Map only having the field. Keys not found in the Map
+// are ignored. Values in the result are shared with the Map, so use Copy to
+// modify them without affecting the original.
+func (m Map) Pick(keys ...string) Map {
+	res := Map{}
+	for _, k := range keys {
+		pickPath(res, m, strings.Split(k, "."))
+	}
+	return res
+}
+
+func pickPath(dst, src Map, path []string) {
+	v, ok := src[path[0]]
+	if !ok {
+		return
+	}
+	if len(path) == 1 {
+		dst[path[0]] = v
+		return
+	}
+
+	srcChild, ok := v.(Map)
+	if !ok {
+		return
+	}
+	dstChild, ok := dst[path[0]].(Map)
+	if !ok {
+		dstChild = Map{}
+	} else if reflect.ValueOf(dstChild).Pointer() == reflect.ValueOf(srcChild).Pointer() {
+		// the whole child has already been picked
+		return
+	}
+	pickPath(dstChild, srcChild, path[1:])
+	if len(dstChild) > 0 {
+		dst[path[0]] = dstChild
+	}
+}
+
+// Without returns a new Map not having the given keys of the Map. A key can
+// be a dotted path such as "a.b" to remove a field of a nested Map. Keys not
+// found in the Map are ignored. The Map isn't modified because nested Maps
+// containing removed fields are copied. Other values in the result are
+// shared with the Map.
+func (m Map) Without(keys ...string) Map {
+	res := make(Map, len(m))
+	for k, v := range m {
+		res[k] = v
+	}
+	for _, k := range keys {
+		removePath(res, strings.Split(k, "."))
+	}
+	return res
+}
+
+// removePath removes path from m, which must be a copy that can be
+// modified. Nested Maps on the path are copied before being modified.
+func removePath(m Map, path []string) {
+	if len(path) == 1 {
+		delete(m, path[0])
+		return
+	}
+	child, ok := m[path[0]].(Map)
+	if !ok {
+		return
+	}
+	if _, ok := child[path[1]]; !ok {
+		return
+	}
+	c := make(Map, len(child))
+	for k, v := range child {
+		c[k] = v
+	}
+	removePath(c, path[1:])
+	m[path[0]] = c
+}
+
 // Get returns value(s) from a structured Map as addressed by the
 // given path expression. Returns an error when the path expression
 // is invalid or the path is not found in the Map.
@@ -124,6 +231,8 @@ func (m Map) Copy() Map {
 //  `["store"]`                     -> get store's Map
 //  `["store"]["name"]`             -> get "store name"
 //  `["store"]["book"][0]["title"]` -> get "book name"
+// or, with an explicit root element,
+//  `$.store.book[0]['title']`      -> get "book name"
 //
 func (m Map) Get(path Path) (Value, error) {
 	return path.evaluate(m)
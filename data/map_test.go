@@ -59,3 +59,128 @@ func TestMapMarshalJSON(t *testing.T) {
 		})
 	})
 }
+
+func TestMapKeys(t *testing.T) {
+	Convey("Given a Map", t, func() {
+		m := Map{
+			"b":  Int(1),
+			"a":  Null{},
+			"ab": Map{"z": Int(2)},
+			"B":  String("hoge"),
+		}
+
+		Convey("Then Keys should return all keys", func() {
+			keys := m.Keys()
+			So(len(keys), ShouldEqual, 4)
+			for _, k := range []string{"a", "ab", "b", "B"} {
+				So(keys, ShouldContain, k)
+			}
+		})
+
+		Convey("Then SortedKeys should return the keys in lexical order", func() {
+			So(m.SortedKeys(), ShouldResemble, []string{"B", "a", "ab", "b"})
+		})
+	})
+
+	Convey("Given an empty Map", t, func() {
+		m := Map{}
+
+		Convey("Then Keys and SortedKeys should return an empty slice", func() {
+			So(m.Keys(), ShouldBeEmpty)
+			So(m.SortedKeys(), ShouldBeEmpty)
+		})
+	})
+}
+
+func TestMapPickWithout(t *testing.T) {
+	Convey("Given a nested Map", t, func() {
+		m := Map{
+			"a": Int(1),
+			"b": String("hoge"),
+			"c": Map{
+				"d": Int(2),
+				"e": Map{
+					"f": Int(3),
+					"g": Int(4),
+				},
+			},
+		}
+		orig := m.Copy()
+
+		Convey("When picking two keys", func() {
+			res := m.Pick("a", "c", "x")
+
+			Convey("Then the result should only have them", func() {
+				So(res, ShouldResemble, Map{
+					"a": Int(1),
+					"c": m["c"],
+				})
+			})
+
+			Convey("Then the original Map should be unmodified", func() {
+				So(m, ShouldResemble, orig)
+			})
+		})
+
+		Convey("When picking nested keys via dotted paths", func() {
+			res := m.Pick("b", "c.e.f", "c.d", "c.x", "a.x")
+
+			Convey("Then the result should have nested Maps only having them", func() {
+				So(res, ShouldResemble, Map{
+					"b": String("hoge"),
+					"c": Map{
+						"d": Int(2),
+						"e": Map{
+							"f": Int(3),
+						},
+					},
+				})
+			})
+
+			Convey("Then the original Map should be unmodified", func() {
+				So(m, ShouldResemble, orig)
+			})
+		})
+
+		Convey("When picking a nested key after its parent", func() {
+			res := m.Pick("c", "c.e.f")
+
+			Convey("Then the result should have the whole parent", func() {
+				So(res, ShouldResemble, Map{"c": orig["c"]})
+				So(m, ShouldResemble, orig)
+			})
+		})
+
+		Convey("When removing a key", func() {
+			res := m.Without("b", "x")
+
+			Convey("Then the result should not have it", func() {
+				So(res, ShouldResemble, Map{
+					"a": Int(1),
+					"c": orig["c"],
+				})
+			})
+		})
+
+		Convey("When removing a nested key via a dotted path", func() {
+			res := m.Without("c.e.f", "c.x", "a.x")
+
+			Convey("Then the result should not have it", func() {
+				So(res, ShouldResemble, Map{
+					"a": Int(1),
+					"b": String("hoge"),
+					"c": Map{
+						"d": Int(2),
+						"e": Map{
+							"g": Int(4),
+						},
+					},
+				})
+			})
+
+			Convey("Then the original Map should be unmodified", func() {
+				So(m, ShouldResemble, orig)
+			})
+		})
+	})
+}
@@ -0,0 +1,44 @@
+package data
+
+import (
+	"errors"
+)
+
+// ApplyMergePatch applies patch to target following JSON Merge Patch
+// (RFC 7386) and returns the result. When patch is a Map, each of its keys
+// is merged into target recursively: a key having Null removes the key from
+// target, and other values replace the values in target unless both are
+// Maps, in which case they are merged. When target isn't a Map, it's treated
+// as an empty Map. When patch isn't a Map, including when it's an Array,
+// patch replaces target wholesale.
+//
+// Neither target nor patch is modified, and the result doesn't share Maps or
+// Arrays with them.
+func ApplyMergePatch(target, patch Value) (Value, error) {
+	if patch == nil {
+		return nil, errors.New("the patch must not be nil")
+	}
+	return applyMergePatch(target, patch), nil
+}
+
+func applyMergePatch(target, patch Value) Value {
+	p, ok := patch.(Map)
+	if !ok {
+		return patch.clone()
+	}
+
+	var res Map
+	if t, ok := target.(Map); ok {
+		res = t.Copy()
+	} else {
+		res = make(Map, len(p))
+	}
+	for k, v := range p {
+		if v.Type() == TypeNull {
+			delete(res, k)
+			continue
+		}
+		res[k] = applyMergePatch(res[k], v)
+	}
+	return res
+}
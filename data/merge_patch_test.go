@@ -0,0 +1,137 @@
+package data
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestApplyMergePatch(t *testing.T) {
+	Convey("Given a target map", t, func() {
+		target := Map{
+			"title": String("Goodbye!"),
+			"author": Map{
+				"given_name":  String("John"),
+				"family_name": String("Doe"),
+			},
+			"tags":    Array{String("example"), String("sample")},
+			"content": String("This will be unchanged"),
+		}
+		orig := target.Copy()
+
+		Convey("When applying a patch having null", func() {
+			res, err := ApplyMergePatch(target, Map{
+				"content": Null{},
+				"missing": Null{},
+			})
+			So(err, ShouldBeNil)
+
+			Convey("Then the keys should be deleted", func() {
+				So(res, ShouldResemble, Map{
+					"title": String("Goodbye!"),
+					"author": Map{
+						"given_name":  String("John"),
+						"family_name": String("Doe"),
+					},
+					"tags": Array{String("example"), String("sample")},
+				})
+			})
+
+			Convey("Then the target should not be modified", func() {
+				So(target, ShouldResemble, orig)
+			})
+		})
+
+		Convey("When applying a patch having a nested map", func() {
+			res, err := ApplyMergePatch(target, Map{
+				"title": String("Hello!"),
+				"author": Map{
+					"family_name": Null{},
+					"phone":       String("+01-123-456-7890"),
+				},
+			})
+			So(err, ShouldBeNil)
+
+			Convey("Then the maps should be merged recursively", func() {
+				So(res, ShouldResemble, Map{
+					"title": String("Hello!"),
+					"author": Map{
+						"given_name": String("John"),
+						"phone":      String("+01-123-456-7890"),
+					},
+					"tags":    Array{String("example"), String("sample")},
+					"content": String("This will be unchanged"),
+				})
+			})
+
+			Convey("Then the target should not be modified", func() {
+				So(target, ShouldResemble, orig)
+			})
+		})
+
+		Convey("When applying a patch having an array", func() {
+			patch := Map{
+				"tags": Array{String("example")},
+			}
+			res, err := ApplyMergePatch(target, patch)
+			So(err, ShouldBeNil)
+
+			Convey("Then the array should be replaced wholesale", func() {
+				So(res.(Map)["tags"], ShouldResemble, Array{String("example")})
+			})
+
+			Convey("Then the result should not share the array with the patch", func() {
+				res.(Map)["tags"].(Array)[0] = String("modified")
+				So(patch["tags"], ShouldResemble, Array{String("example")})
+			})
+		})
+
+		Convey("When applying a patch having a map for a scalar", func() {
+			res, err := ApplyMergePatch(target, Map{
+				"title": Map{"en": String("Hello!"), "ja": Null{}},
+			})
+			So(err, ShouldBeNil)
+
+			Convey("Then the scalar should be replaced by the map without nulls", func() {
+				So(res.(Map)["title"], ShouldResemble, Map{"en": String("Hello!")})
+			})
+		})
+
+		Convey("When applying a patch which isn't a map", func() {
+			res, err := ApplyMergePatch(target, Array{Int(1)})
+			So(err, ShouldBeNil)
+
+			Convey("Then the patch should replace the target", func() {
+				So(res, ShouldResemble, Array{Int(1)})
+			})
+		})
+
+		Convey("When applying an empty patch", func() {
+			res, err := ApplyMergePatch(target, Map{})
+			So(err, ShouldBeNil)
+
+			Convey("Then the result should be the same as the target", func() {
+				So(res, ShouldResemble, target)
+			})
+		})
+
+		Convey("When applying a nil patch", func() {
+			_, err := ApplyMergePatch(target, nil)
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+
+	Convey("Given a target which isn't a map", t, func() {
+		Convey("When applying a map patch", func() {
+			res, err := ApplyMergePatch(String("foo"), Map{"a": Int(1), "b": Null{}})
+			So(err, ShouldBeNil)
+
+			Convey("Then it should be treated as an empty map", func() {
+				So(res, ShouldResemble, Map{"a": Int(1)})
+			})
+		})
+	})
+}
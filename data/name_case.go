@@ -0,0 +1,67 @@
+package data
+
+import (
+	"bytes"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/fatih/camelcase"
+)
+
+// SnakeCase converts a camelCase or PascalCase name to snake_case. Each
+// sequence of upper case letters, lower case letters following at most one
+// upper case letter, and digits becomes a word. For example, "ParseBQL" is
+// converted to "parse_bql" and "B2B" to "b_2_b". Decoder uses SnakeCase by
+// default to convert the name of a struct field to the key in a Map.
+func SnakeCase(name string) string {
+	words := camelcase.Split(name)
+	buf := bytes.NewBuffer(nil)
+	for i, w := range words {
+		if i > 0 {
+			buf.WriteString("_")
+		}
+		buf.WriteString(strings.ToLower(w))
+	}
+	return string(buf.Bytes())
+}
+
+// CamelCase converts a snake_case name to camelCase. For example,
+// "another_param_1" is converted to "anotherParam1". Empty words caused by
+// consecutive underscores are ignored.
+func CamelCase(name string) string {
+	return joinSnakeCaseWords(name, false)
+}
+
+// PascalCase converts a snake_case name to PascalCase. For example,
+// "parse_bql" is converted to "ParseBql". Conversions between snake_case
+// and PascalCase don't always round-trip: PascalCase(SnakeCase(s)) differs
+// from s when s has an acronym (e.g. "ParseBQL"), and SnakeCase(PascalCase(s))
+// differs from s when s has consecutive one-letter words, which are merged
+// into one acronym (e.g. "x_y_z" becomes "XYZ" and then "xyz").
+func PascalCase(name string) string {
+	return joinSnakeCaseWords(name, true)
+}
+
+// joinSnakeCaseWords concatenates words in a snake_case name capitalizing
+// the first letter of each word. The first letter of the first word is
+// converted to lower case unless upperFirst is true.
+func joinSnakeCaseWords(name string, upperFirst bool) string {
+	buf := bytes.NewBuffer(nil)
+	first := true
+	for _, w := range strings.Split(name, "_") {
+		if w == "" {
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(w)
+		if first && !upperFirst {
+			r = unicode.ToLower(r)
+		} else {
+			r = unicode.ToUpper(r)
+		}
+		first = false
+		buf.WriteRune(r)
+		buf.WriteString(w[size:])
+	}
+	return string(buf.Bytes())
+}
@@ -0,0 +1,103 @@
+package data
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestSnakeCase(t *testing.T) {
+	Convey("SnakeCase should transform camelcase to snake case", t, func() {
+		cases := [][]string{
+			{"Test", "test"},
+			{"ParseBQL", "parse_bql"},
+			{"B2B", "b_2_b"},
+		}
+
+		for _, c := range cases {
+			So(SnakeCase(c[0]), ShouldEqual, c[1])
+		}
+	})
+}
+
+func TestCamelCase(t *testing.T) {
+	Convey("CamelCase should transform snake case to camelcase", t, func() {
+		cases := [][]string{
+			{"test", "test"},
+			{"parse_bql", "parseBql"},
+			{"b_2_b", "b2B"},
+			{"another_param_1", "anotherParam1"},
+			{"__leading__and_trailing_", "leadingAndTrailing"},
+			{"", ""},
+		}
+
+		for _, c := range cases {
+			So(CamelCase(c[0]), ShouldEqual, c[1])
+		}
+	})
+}
+
+func TestPascalCase(t *testing.T) {
+	Convey("PascalCase should transform snake case to pascal case", t, func() {
+		cases := [][]string{
+			{"test", "Test"},
+			{"parse_bql", "ParseBql"},
+			{"b_2_b", "B2B"},
+			{"another_param_1", "AnotherParam1"},
+			{"", ""},
+		}
+
+		for _, c := range cases {
+			So(PascalCase(c[0]), ShouldEqual, c[1])
+		}
+	})
+}
+
+func TestNameCaseRoundTrip(t *testing.T) {
+	Convey("Given snake case names", t, func() {
+		names := []string{"test", "parse_bql", "b_2_b", "another_param_1"}
+
+		Convey("When converting them to camelcase and back", func() {
+			Convey("Then they should be the same as the original names", func() {
+				for _, n := range names {
+					So(SnakeCase(CamelCase(n)), ShouldEqual, n)
+				}
+			})
+		})
+
+		Convey("When converting them to pascal case and back", func() {
+			Convey("Then they should be the same as the original names", func() {
+				for _, n := range names {
+					So(SnakeCase(PascalCase(n)), ShouldEqual, n)
+				}
+			})
+		})
+	})
+
+	Convey("Given snake case names having consecutive one-letter words", t, func() {
+		cases := [][]string{
+			{"a_b", "ab"},
+			{"x_y_z", "xyz"},
+		}
+
+		Convey("When converting them to pascal case and back", func() {
+			Convey("Then the words should be merged", func() {
+				for _, c := range cases {
+					So(SnakeCase(PascalCase(c[0])), ShouldEqual, c[1])
+				}
+			})
+		})
+	})
+
+	Convey("Given pascal case names without acronyms", t, func() {
+		names := []string{"Test", "B2B", "AnotherParam1"}
+
+		Convey("When converting them to snake case and back", func() {
+			Convey("Then they should be the same as the original names", func() {
+				for _, n := range names {
+					So(PascalCase(SnakeCase(n)), ShouldEqual, n)
+				}
+			})
+		})
+	})
+}
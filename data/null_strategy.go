@@ -0,0 +1,173 @@
+package data
+
+import (
+	"fmt"
+	"time"
+)
+
+// NullStrategy controls how Null is handled by conversions that have a
+// default value, such as NullStrategy.ToInt or Decoder.
+type NullStrategy int
+
+const (
+	// NullIsError makes a conversion of Null to a Go value fail.
+	NullIsError NullStrategy = iota
+
+	// NullIsDefault makes a conversion of Null result in the default value.
+	NullIsDefault
+)
+
+func (s NullStrategy) String() string {
+	switch s {
+	case NullIsError:
+		return "NullIsError"
+	case NullIsDefault:
+		return "NullIsDefault"
+	}
+	return "UnknownNullStrategy"
+}
+
+// isNull checks whether v is Null or nil. When it is, the error is non-nil
+// if the conversion to the type t must fail. t is only used in the error.
+func (s NullStrategy) isNull(v Value, t interface{}) (bool, error) {
+	if v != nil && v.Type() != TypeNull {
+		return false, nil
+	}
+	if s == NullIsDefault {
+		return true, nil
+	}
+	return true, fmt.Errorf("cannot convert null to %v", t)
+}
+
+// ToBool converts v to a bool with ToBool. When v is Null, it returns def
+// or an error depending on the strategy.
+func (s NullStrategy) ToBool(v Value, def bool) (bool, error) {
+	if null, err := s.isNull(v, TypeBool); null {
+		return def, err
+	}
+	return ToBool(v)
+}
+
+// ToInt converts v to an int64 with ToInt. When v is Null, it returns def
+// or an error depending on the strategy.
+func (s NullStrategy) ToInt(v Value, def int64) (int64, error) {
+	if null, err := s.isNull(v, TypeInt); null {
+		return def, err
+	}
+	return ToInt(v)
+}
+
+// ToFloat converts v to a float64 with ToFloat. When v is Null, it returns
+// def or an error depending on the strategy.
+func (s NullStrategy) ToFloat(v Value, def float64) (float64, error) {
+	if null, err := s.isNull(v, TypeFloat); null {
+		return def, err
+	}
+	return ToFloat(v)
+}
+
+// ToString converts v to a string with ToString. When v is Null, it returns
+// def or an error depending on the strategy.
+func (s NullStrategy) ToString(v Value, def string) (string, error) {
+	if null, err := s.isNull(v, TypeString); null {
+		return def, err
+	}
+	return ToString(v)
+}
+
+// ToBlob converts v to a []byte with ToBlob. When v is Null, it returns def
+// or an error depending on the strategy.
+func (s NullStrategy) ToBlob(v Value, def []byte) ([]byte, error) {
+	if null, err := s.isNull(v, TypeBlob); null {
+		return def, err
+	}
+	return ToBlob(v)
+}
+
+// ToTimestamp converts v to a time.Time with ToTimestamp. When v is Null,
+// it returns def or an error depending on the strategy.
+func (s NullStrategy) ToTimestamp(v Value, def time.Time) (time.Time, error) {
+	if null, err := s.isNull(v, TypeTimestamp); null {
+		return def, err
+	}
+	return ToTimestamp(v)
+}
+
+// ToDuration converts v to a time.Duration with ToDuration. When v is Null,
+// it returns def or an error depending on the strategy.
+func (s NullStrategy) ToDuration(v Value, def time.Duration) (time.Duration, error) {
+	if null, err := s.isNull(v, "Duration"); null {
+		return def, err
+	}
+	return ToDuration(v)
+}
+
+// ToBoolOr converts v to a bool with ToBool. It returns def when v is Null
+// or cannot be converted.
+func ToBoolOr(v Value, def bool) bool {
+	b, err := NullIsDefault.ToBool(v, def)
+	if err != nil {
+		return def
+	}
+	return b
+}
+
+// ToIntOr converts v to an int64 with ToInt. It returns def when v is Null
+// or cannot be converted.
+func ToIntOr(v Value, def int64) int64 {
+	i, err := NullIsDefault.ToInt(v, def)
+	if err != nil {
+		return def
+	}
+	return i
+}
+
+// ToFloatOr converts v to a float64 with ToFloat. It returns def when v is
+// Null or cannot be converted.
+func ToFloatOr(v Value, def float64) float64 {
+	f, err := NullIsDefault.ToFloat(v, def)
+	if err != nil {
+		return def
+	}
+	return f
+}
+
+// ToStringOr converts v to a string with ToString. It returns def when v is
+// Null or cannot be converted.
+func ToStringOr(v Value, def string) string {
+	s, err := NullIsDefault.ToString(v, def)
+	if err != nil {
+		return def
+	}
+	return s
+}
+
+// ToBlobOr converts v to a []byte with ToBlob. It returns def when v is Null
+// or cannot be converted.
+func ToBlobOr(v Value, def []byte) []byte {
+	b, err := NullIsDefault.ToBlob(v, def)
+	if err != nil {
+		return def
+	}
+	return b
+}
+
+// ToTimestampOr converts v to a time.Time with ToTimestamp. It returns def
+// when v is Null or cannot be converted.
+func ToTimestampOr(v Value, def time.Time) time.Time {
+	t, err := NullIsDefault.ToTimestamp(v, def)
+	if err != nil {
+		return def
+	}
+	return t
+}
+
+// ToDurationOr converts v to a time.Duration with ToDuration. It returns def
+// when v is Null or cannot be converted.
+func ToDurationOr(v Value, def time.Duration) time.Duration {
+	d, err := NullIsDefault.ToDuration(v, def)
+	if err != nil {
+		return def
+	}
+	return d
+}
@@ -0,0 +1,109 @@
+package data
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+	"time"
+)
+
+func TestNullStrategy(t *testing.T) {
+	now := time.Date(2015, time.April, 10, 10, 23, 0, 0, time.UTC)
+	past := time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	type conv struct {
+		name   string
+		to     func(s NullStrategy, v Value) (interface{}, error)
+		or     func(v Value) interface{}
+		def    interface{}
+		input  Value
+		output interface{}
+		bad    Value
+	}
+	convs := []conv{
+		{"Bool",
+			func(s NullStrategy, v Value) (interface{}, error) { return s.ToBool(v, true) },
+			func(v Value) interface{} { return ToBoolOr(v, true) },
+			true, Bool(false), false, String("hoge")},
+		{"Int",
+			func(s NullStrategy, v Value) (interface{}, error) { return s.ToInt(v, 42) },
+			func(v Value) interface{} { return ToIntOr(v, 42) },
+			int64(42), String("7"), int64(7), String("hoge")},
+		{"Float",
+			func(s NullStrategy, v Value) (interface{}, error) { return s.ToFloat(v, 0.5) },
+			func(v Value) interface{} { return ToFloatOr(v, 0.5) },
+			0.5, Int(3), 3.0, Map{}},
+		{"String",
+			func(s NullStrategy, v Value) (interface{}, error) { return s.ToString(v, "def") },
+			func(v Value) interface{} { return ToStringOr(v, "def") },
+			"def", Int(3), "3", nil},
+		{"Blob",
+			func(s NullStrategy, v Value) (interface{}, error) { return s.ToBlob(v, []byte("def")) },
+			func(v Value) interface{} { return ToBlobOr(v, []byte("def")) },
+			[]byte("def"), Blob("abc"), []byte("abc"), Int(1)},
+		{"Timestamp",
+			func(s NullStrategy, v Value) (interface{}, error) { return s.ToTimestamp(v, past) },
+			func(v Value) interface{} { return ToTimestampOr(v, past) },
+			past, Timestamp(now), now, Bool(true)},
+		{"Duration",
+			func(s NullStrategy, v Value) (interface{}, error) { return s.ToDuration(v, time.Minute) },
+			func(v Value) interface{} { return ToDurationOr(v, time.Minute) },
+			time.Minute, String("2s"), 2 * time.Second, Array{}},
+	}
+
+	for _, c := range convs {
+		c := c
+		Convey("Given a conversion to "+c.name, t, func() {
+			Convey("When converting Null with NullIsDefault", func() {
+				v, err := c.to(NullIsDefault, Null{})
+
+				Convey("Then it should return the default value", func() {
+					So(err, ShouldBeNil)
+					So(v, ShouldResemble, c.def)
+				})
+			})
+
+			Convey("When converting nil with NullIsDefault", func() {
+				v, err := c.to(NullIsDefault, nil)
+
+				Convey("Then it should return the default value", func() {
+					So(err, ShouldBeNil)
+					So(v, ShouldResemble, c.def)
+				})
+			})
+
+			Convey("When converting Null with NullIsError", func() {
+				_, err := c.to(NullIsError, Null{})
+
+				Convey("Then it should fail", func() {
+					So(err, ShouldNotBeNil)
+				})
+			})
+
+			Convey("When converting a non-Null value", func() {
+				Convey("Then it should be converted regardless of the strategy", func() {
+					for _, s := range []NullStrategy{NullIsDefault, NullIsError} {
+						v, err := c.to(s, c.input)
+						So(err, ShouldBeNil)
+						So(v, ShouldResemble, c.output)
+					}
+				})
+			})
+
+			Convey("When using the Or function", func() {
+				Convey("Then it should return the default value for Null", func() {
+					So(c.or(Null{}), ShouldResemble, c.def)
+				})
+
+				Convey("Then it should pass a non-Null value through", func() {
+					So(c.or(c.input), ShouldResemble, c.output)
+				})
+
+				if c.bad != nil {
+					Convey("Then it should return the default value for an inconvertible value", func() {
+						So(c.or(c.bad), ShouldResemble, c.def)
+					})
+				}
+			})
+		})
+	}
+}
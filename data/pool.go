@@ -0,0 +1,81 @@
+package data
+
+import (
+	"sync"
+)
+
+// Maps and Arrays are pooled so that hot paths creating and discarding a
+// lot of tuples can reuse them instead of allocating new ones for each
+// tuple. Pooled values are cleared when they're returned to the pool so
+// that the pool doesn't keep references to values stored in them.
+//
+// A Map or an Array must not be used in any way after it's returned by
+// PutMap or PutArray: it must not be read, modified, or put again, and it
+// must not be referenced from other values such as a Map, an Array, or a
+// Tuple which is still in use. Values nested in a returned Map or Array are
+// not returned to the pool and can be used as usual.
+var (
+	mapPool = sync.Pool{
+		New: func() interface{} {
+			return Map{}
+		},
+	}
+	arrayPool = sync.Pool{
+		New: func() interface{} {
+			return &Array{}
+		},
+	}
+
+	// arrayHolderPool keeps pointers which don't have an Array so that
+	// PutArray doesn't have to allocate a pointer to put an Array in
+	// arrayPool.
+	arrayHolderPool = sync.Pool{
+		New: func() interface{} {
+			return &Array{}
+		},
+	}
+)
+
+// GetMap returns an empty Map from the pool. The Map should be returned by
+// PutMap when it's no longer used.
+func GetMap() Map {
+	return mapPool.Get().(Map)
+}
+
+// PutMap clears m and returns it to the pool. m must not be used after
+// calling PutMap. It does nothing when m is nil.
+func PutMap(m Map) {
+	if m == nil {
+		return
+	}
+	for k := range m {
+		delete(m, k)
+	}
+	mapPool.Put(m)
+}
+
+// GetArray returns an empty Array from the pool. Elements can be appended to
+// it without allocation until its capacity is exceeded. The Array should be
+// returned by PutArray when it's no longer used.
+func GetArray() Array {
+	p := arrayPool.Get().(*Array)
+	a := *p
+	*p = nil
+	arrayHolderPool.Put(p)
+	return a
+}
+
+// PutArray clears a and returns it to the pool. a must not be used after
+// calling PutArray. It does nothing when a doesn't have a capacity.
+func PutArray(a Array) {
+	if cap(a) == 0 {
+		return
+	}
+	a = a[:cap(a)]
+	for i := range a {
+		a[i] = nil
+	}
+	p := arrayHolderPool.Get().(*Array)
+	*p = a[:0]
+	arrayPool.Put(p)
+}
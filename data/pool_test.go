@@ -0,0 +1,98 @@
+package data
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestPool(t *testing.T) {
+	Convey("Given a Map from the pool", t, func() {
+		m := GetMap()
+
+		Convey("Then it should be empty", func() {
+			So(m, ShouldBeEmpty)
+		})
+
+		Convey("When returning it to the pool after filling it", func() {
+			m["a"] = Int(1)
+			m["b"] = Map{"c": String("d")}
+			PutMap(m)
+
+			Convey("Then it should be cleared", func() {
+				So(m, ShouldBeEmpty)
+			})
+
+			Convey("Then Maps from the pool should be empty", func() {
+				for i := 0; i < 10; i++ {
+					So(GetMap(), ShouldBeEmpty)
+				}
+			})
+		})
+	})
+
+	Convey("Given an Array from the pool", t, func() {
+		a := GetArray()
+
+		Convey("Then it should be empty", func() {
+			So(a, ShouldBeEmpty)
+		})
+
+		Convey("When returning it to the pool after filling it", func() {
+			a = append(a, Int(1), String("x"), Map{"a": Int(2)})
+			PutArray(a)
+
+			Convey("Then its elements should be cleared", func() {
+				So(a, ShouldResemble, Array{nil, nil, nil})
+			})
+
+			Convey("Then Arrays from the pool should be empty", func() {
+				for i := 0; i < 10; i++ {
+					So(GetArray(), ShouldBeEmpty)
+				}
+			})
+		})
+
+		Convey("When returning a truncated Array to the pool", func() {
+			a = append(a, Int(1), Int(2))
+			a = a[:1]
+			PutArray(a)
+
+			Convey("Then elements beyond its length should also be cleared", func() {
+				So(a[:2], ShouldResemble, Array{nil, nil})
+			})
+		})
+	})
+
+	Convey("Given nil values", t, func() {
+		Convey("Then putting them to the pool should do nothing", func() {
+			So(func() { PutMap(nil) }, ShouldNotPanic)
+			So(func() { PutArray(nil) }, ShouldNotPanic)
+		})
+	})
+}
+
+func benchmarkTuple(m Map, a Array) (Map, Array) {
+	for i := 0; i < 8; i++ {
+		a = append(a, Int(i))
+	}
+	m["id"] = Int(1)
+	m["name"] = String("sensor")
+	m["values"] = a
+	return m, a
+}
+
+func BenchmarkTupleAlloc(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchmarkTuple(Map{}, nil)
+	}
+}
+
+func BenchmarkTupleAllocPooled(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		m, a := benchmarkTuple(GetMap(), GetArray())
+		PutMap(m)
+		PutArray(a)
+	}
+}
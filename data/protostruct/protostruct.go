@@ -0,0 +1,127 @@
+// Package protostruct converts data.Value to and from google.protobuf.Value
+// so that tuples can be exchanged with gRPC services as
+// google.protobuf.Struct. It's a separate package so that the data package
+// doesn't depend on protobuf.
+package protostruct
+
+import (
+	"errors"
+	"fmt"
+
+	structpb "github.com/golang/protobuf/ptypes/struct"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// FromValue converts a data.Value to a google.protobuf.Value. A Map is
+// converted to a Struct and an Array is converted to a ListValue.
+//
+// Because google.protobuf.Value only has a number type of double, both Int
+// and Float are converted to NumberValue. An Int whose absolute value is
+// greater than 2^53 cannot be represented exactly and loses its precision.
+// google.protobuf.Value doesn't have binary or time types either, so the
+// following conventions, which are the same as data.ToString, are used:
+//
+//  * Blob is converted to a StringValue having the base64 encoded data
+//  * Timestamp is converted to a StringValue in RFC3339Nano format
+func FromValue(v data.Value) (*structpb.Value, error) {
+	if v == nil {
+		return nil, errors.New("cannot convert nil to google.protobuf.Value")
+	}
+
+	switch v.Type() {
+	case data.TypeNull:
+		return &structpb.Value{Kind: &structpb.Value_NullValue{}}, nil
+	case data.TypeBool:
+		b, _ := data.AsBool(v)
+		return &structpb.Value{Kind: &structpb.Value_BoolValue{BoolValue: b}}, nil
+	case data.TypeInt:
+		i, _ := data.AsInt(v)
+		return &structpb.Value{Kind: &structpb.Value_NumberValue{NumberValue: float64(i)}}, nil
+	case data.TypeFloat:
+		f, _ := data.AsFloat(v)
+		return &structpb.Value{Kind: &structpb.Value_NumberValue{NumberValue: f}}, nil
+	case data.TypeString, data.TypeBlob, data.TypeTimestamp:
+		s, err := data.ToString(v)
+		if err != nil {
+			return nil, err
+		}
+		return &structpb.Value{Kind: &structpb.Value_StringValue{StringValue: s}}, nil
+	case data.TypeArray:
+		a, _ := data.AsArray(v)
+		l := &structpb.ListValue{Values: make([]*structpb.Value, len(a))}
+		for i, e := range a {
+			pv, err := FromValue(e)
+			if err != nil {
+				return nil, fmt.Errorf("[%v]: %v", i, err)
+			}
+			l.Values[i] = pv
+		}
+		return &structpb.Value{Kind: &structpb.Value_ListValue{ListValue: l}}, nil
+	case data.TypeMap:
+		m, _ := data.AsMap(v)
+		s := &structpb.Struct{Fields: make(map[string]*structpb.Value, len(m))}
+		for k, e := range m {
+			pv, err := FromValue(e)
+			if err != nil {
+				return nil, fmt.Errorf("%v: %v", k, err)
+			}
+			s.Fields[k] = pv
+		}
+		return &structpb.Value{Kind: &structpb.Value_StructValue{StructValue: s}}, nil
+	}
+	return nil, fmt.Errorf("unsupported type: %v", v.Type())
+}
+
+// ToValue converts a google.protobuf.Value to a data.Value. A Struct is
+// converted to a Map and a ListValue is converted to an Array.
+//
+// NumberValue is always converted to Float since google.protobuf.Value
+// doesn't distinguish integers from floating point numbers. Therefore, an
+// Int converted by FromValue comes back as a Float. Likewise, a Blob or a
+// Timestamp comes back as a String, which can be converted with data.ToBlob
+// or data.ToTimestamp.
+func ToValue(pv *structpb.Value) (data.Value, error) {
+	if pv == nil {
+		return nil, errors.New("cannot convert nil google.protobuf.Value")
+	}
+
+	switch k := pv.Kind.(type) {
+	case *structpb.Value_NullValue:
+		return data.Null{}, nil
+	case *structpb.Value_BoolValue:
+		return data.Bool(k.BoolValue), nil
+	case *structpb.Value_NumberValue:
+		return data.Float(k.NumberValue), nil
+	case *structpb.Value_StringValue:
+		return data.String(k.StringValue), nil
+	case *structpb.Value_ListValue:
+		var values []*structpb.Value
+		if k.ListValue != nil {
+			values = k.ListValue.Values
+		}
+		a := make(data.Array, len(values))
+		for i, e := range values {
+			v, err := ToValue(e)
+			if err != nil {
+				return nil, fmt.Errorf("[%v]: %v", i, err)
+			}
+			a[i] = v
+		}
+		return a, nil
+	case *structpb.Value_StructValue:
+		var fields map[string]*structpb.Value
+		if k.StructValue != nil {
+			fields = k.StructValue.Fields
+		}
+		m := make(data.Map, len(fields))
+		for key, e := range fields {
+			v, err := ToValue(e)
+			if err != nil {
+				return nil, fmt.Errorf("%v: %v", key, err)
+			}
+			m[key] = v
+		}
+		return m, nil
+	}
+	return nil, fmt.Errorf("unsupported kind of google.protobuf.Value: %T", pv.Kind)
+}
@@ -0,0 +1,120 @@
+package protostruct
+
+import (
+	"testing"
+	"time"
+
+	structpb "github.com/golang/protobuf/ptypes/struct"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+func TestProtoStruct(t *testing.T) {
+	Convey("Given a Map having nested maps and arrays", t, func() {
+		m := data.Map{
+			"null":   data.Null{},
+			"bool":   data.True,
+			"float":  data.Float(1.5),
+			"string": data.String("str"),
+			"array": data.Array{
+				data.String("a"),
+				data.Map{"b": data.False},
+				data.Array{},
+			},
+			"map": data.Map{
+				"nested": data.Map{
+					"array": data.Array{data.Float(2.5), data.Null{}},
+				},
+			},
+		}
+
+		Convey("When converting it to google.protobuf.Value and back", func() {
+			pv, err := FromValue(m)
+			So(err, ShouldBeNil)
+			v, err := ToValue(pv)
+			So(err, ShouldBeNil)
+
+			Convey("Then it should be converted to a Struct", func() {
+				s := pv.GetStructValue()
+				So(s, ShouldNotBeNil)
+				So(len(s.Fields), ShouldEqual, len(m))
+				So(s.Fields["array"].GetListValue().Values[1].GetStructValue().Fields["b"].GetBoolValue(), ShouldBeFalse)
+			})
+
+			Convey("Then the result should be the same as the original", func() {
+				So(v, ShouldResemble, m)
+			})
+		})
+	})
+
+	Convey("Given values which google.protobuf.Value cannot represent", t, func() {
+		ts := time.Date(2017, 1, 2, 3, 4, 5, 6, time.UTC)
+		m := data.Map{
+			"int":       data.Int(10),
+			"big_int":   data.Int(1<<53 + 1),
+			"blob":      data.Blob("abc"),
+			"timestamp": data.Timestamp(ts),
+		}
+
+		Convey("When converting them to google.protobuf.Value", func() {
+			pv, err := FromValue(m)
+			So(err, ShouldBeNil)
+			fs := pv.GetStructValue().Fields
+
+			Convey("Then Int should become a double", func() {
+				So(fs["int"].GetNumberValue(), ShouldEqual, 10)
+				So(fs["big_int"].GetNumberValue(), ShouldEqual, float64(1<<53))
+			})
+
+			Convey("Then Blob should become a base64 string", func() {
+				So(fs["blob"].GetStringValue(), ShouldEqual, "YWJj")
+			})
+
+			Convey("Then Timestamp should become an RFC3339Nano string", func() {
+				So(fs["timestamp"].GetStringValue(), ShouldEqual, "2017-01-02T03:04:05.000000006Z")
+			})
+
+			Convey("And converting them back", func() {
+				v, err := ToValue(pv)
+				So(err, ShouldBeNil)
+				r, _ := data.AsMap(v)
+
+				Convey("Then Int should come back as Float", func() {
+					So(r["int"], ShouldResemble, data.Float(10))
+				})
+
+				Convey("Then Blob and Timestamp should be restorable from strings", func() {
+					b, err := data.ToBlob(r["blob"])
+					So(err, ShouldBeNil)
+					So(b, ShouldResemble, []byte("abc"))
+					t, err := data.ToTimestamp(r["timestamp"])
+					So(err, ShouldBeNil)
+					So(t.Equal(ts), ShouldBeTrue)
+				})
+			})
+		})
+	})
+
+	Convey("Given invalid inputs", t, func() {
+		Convey("When converting nil", func() {
+			_, err1 := FromValue(nil)
+			_, err2 := ToValue(nil)
+			_, err3 := ToValue(&structpb.Value{})
+
+			Convey("Then they should fail", func() {
+				So(err1, ShouldNotBeNil)
+				So(err2, ShouldNotBeNil)
+				So(err3, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When converting a Map having nil in an array", func() {
+			_, err := FromValue(data.Map{"a": data.Array{data.Int(1), nil}})
+
+			Convey("Then the error should have the path", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldStartWith, "a: [1]: ")
+			})
+		})
+	})
+}
@@ -0,0 +1,56 @@
+package data
+
+import (
+	"regexp"
+	"sync"
+)
+
+// regexpCacheSize is the maximum number of compiled regular expressions
+// kept by RegexMatch.
+const regexpCacheSize = 256
+
+var regexpCache = struct {
+	sync.RWMutex
+	m map[string]*regexp.Regexp
+}{
+	m: map[string]*regexp.Regexp{},
+}
+
+// RegexMatch checks whether s contains a match of the regular expression
+// pattern, which follows the syntax of the regexp package. As in the ~
+// operator of PostgreSQL, the pattern can match any part of s unless it's
+// anchored with ^ or $. It returns an error when the pattern is invalid.
+//
+// Compiled patterns are cached, so calling RegexMatch repeatedly with the
+// same pattern (e.g., for each tuple of a stream) is cheap.
+func RegexMatch(s, pattern string) (bool, error) {
+	re, err := compileRegexp(pattern)
+	if err != nil {
+		return false, err
+	}
+	return re.MatchString(s), nil
+}
+
+func compileRegexp(pattern string) (*regexp.Regexp, error) {
+	regexpCache.RLock()
+	re, ok := regexpCache.m[pattern]
+	regexpCache.RUnlock()
+	if ok {
+		return re, nil
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	regexpCache.Lock()
+	defer regexpCache.Unlock()
+	if len(regexpCache.m) >= regexpCacheSize {
+		// Patterns are usually constants in a statement, so the cache
+		// rarely gets full. Just start over rather than tracking usage.
+		regexpCache.m = map[string]*regexp.Regexp{}
+	}
+	regexpCache.m[pattern] = re
+	return re, nil
+}
@@ -0,0 +1,69 @@
+package data
+
+import (
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestRegexMatch(t *testing.T) {
+	Convey("Given a valid pattern", t, func() {
+		pattern := `^sensor-[0-9]+$`
+
+		Convey("When matching a string matching the pattern", func() {
+			Convey("Then it should return true", func() {
+				m, err := RegexMatch("sensor-12", pattern)
+				So(err, ShouldBeNil)
+				So(m, ShouldBeTrue)
+			})
+		})
+
+		Convey("When matching a string not matching the pattern", func() {
+			Convey("Then it should return false", func() {
+				m, err := RegexMatch("sensor-x", pattern)
+				So(err, ShouldBeNil)
+				So(m, ShouldBeFalse)
+			})
+		})
+
+		Convey("When matching repeatedly", func() {
+			Convey("Then the compiled pattern should be cached", func() {
+				_, err := RegexMatch("sensor-1", pattern)
+				So(err, ShouldBeNil)
+				regexpCache.RLock()
+				_, ok := regexpCache.m[pattern]
+				regexpCache.RUnlock()
+				So(ok, ShouldBeTrue)
+			})
+		})
+	})
+
+	Convey("Given an unanchored pattern", t, func() {
+		Convey("Then it should match a part of a string", func() {
+			m, err := RegexMatch("日本語のテキスト", `本.`)
+			So(err, ShouldBeNil)
+			So(m, ShouldBeTrue)
+		})
+	})
+
+	Convey("Given an invalid pattern", t, func() {
+		Convey("Then RegexMatch should fail", func() {
+			_, err := RegexMatch("abc", `a(b`)
+			So(err, ShouldNotBeNil)
+		})
+	})
+
+	Convey("Given more patterns than the cache can hold", t, func() {
+		Convey("Then RegexMatch should still work", func() {
+			for i := 0; i < regexpCacheSize+10; i++ {
+				m, err := RegexMatch(fmt.Sprint(i), fmt.Sprintf("^%d$", i))
+				So(err, ShouldBeNil)
+				So(m, ShouldBeTrue)
+			}
+			regexpCache.RLock()
+			n := len(regexpCache.m)
+			regexpCache.RUnlock()
+			So(n, ShouldBeLessThanOrEqualTo, regexpCacheSize)
+		})
+	})
+}
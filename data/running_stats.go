@@ -0,0 +1,110 @@
+package data
+
+import (
+	"fmt"
+	"math"
+)
+
+// RunningStats incrementally computes statistics of numeric values without
+// keeping them. The mean and the variance are computed with Welford's
+// algorithm, which is numerically stable. The zero value is ready to use.
+// RunningStats isn't thread-safe.
+type RunningStats struct {
+	count int64
+	mean  float64
+	m2    float64 // sum of squares of differences from the mean
+
+	min, max Value
+}
+
+// Push adds an Int or a Float to the statistics. Null is ignored like
+// aggregate functions in SQL. Push returns an error for values of other
+// types and NaN.
+func (s *RunningStats) Push(v Value) error {
+	if v == nil {
+		return fmt.Errorf("cannot push nil")
+	}
+
+	var f float64
+	switch v.Type() {
+	case TypeNull:
+		return nil
+	case TypeInt:
+		i, _ := v.asInt()
+		f = float64(i)
+	case TypeFloat:
+		f, _ = v.asFloat()
+		if math.IsNaN(f) {
+			return fmt.Errorf("cannot push NaN")
+		}
+	default:
+		return fmt.Errorf("cannot push %v, only int or float can be pushed", v.Type())
+	}
+
+	s.count++
+	delta := f - s.mean
+	s.mean += delta / float64(s.count)
+	s.m2 += delta * (f - s.mean)
+
+	if s.min == nil || lessNumeric(v, s.min) {
+		s.min = v
+	}
+	if s.max == nil || lessNumeric(s.max, v) {
+		s.max = v
+	}
+	return nil
+}
+
+// lessNumeric compares two Values which must be Int or Float. Ints are
+// compared exactly.
+func lessNumeric(v1, v2 Value) bool {
+	if v1.Type() == TypeInt && v2.Type() == TypeInt {
+		i1, _ := v1.asInt()
+		i2, _ := v2.asInt()
+		return i1 < i2
+	}
+	f1, _ := ToFloat(v1)
+	f2, _ := ToFloat(v2)
+	return f1 < f2
+}
+
+// Count returns the number of values pushed, not including Nulls.
+func (s *RunningStats) Count() int64 {
+	return s.count
+}
+
+// Mean returns the mean of the values as a Float. It returns Null when no
+// value has been pushed.
+func (s *RunningStats) Mean() Value {
+	if s.count == 0 {
+		return Null{}
+	}
+	return Float(s.mean)
+}
+
+// Variance returns the population variance of the values as a Float. It
+// returns Null when no value has been pushed.
+func (s *RunningStats) Variance() Value {
+	if s.count == 0 {
+		return Null{}
+	}
+	return Float(s.m2 / float64(s.count))
+}
+
+// Min returns the smallest value pushed as it was pushed, i.e. it's an Int
+// when the smallest value was pushed as an Int. It returns Null when no value
+// has been pushed.
+func (s *RunningStats) Min() Value {
+	if s.min == nil {
+		return Null{}
+	}
+	return s.min
+}
+
+// Max returns the largest value pushed in the same way as Min.
+func (s *RunningStats) Max() Value {
+	if s.max == nil {
+		return Null{}
+	}
+	return s.max
+}
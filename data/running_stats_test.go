@@ -0,0 +1,76 @@
+package data
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"math"
+	"testing"
+)
+
+func TestRunningStats(t *testing.T) {
+	Convey("Given a RunningStats", t, func() {
+		s := &RunningStats{}
+
+		Convey("When no value is pushed", func() {
+			Convey("Then it should return Null", func() {
+				So(s.Count(), ShouldEqual, 0)
+				So(s.Mean(), ShouldResemble, Null{})
+				So(s.Variance(), ShouldResemble, Null{})
+				So(s.Min(), ShouldResemble, Null{})
+				So(s.Max(), ShouldResemble, Null{})
+			})
+		})
+
+		Convey("When pushing a known dataset", func() {
+			vs := []Value{Int(2), Float(4.5), Null{}, Int(-3), Float(7.25), Int(10), Float(-1.5), Int(4)}
+			for _, v := range vs {
+				So(s.Push(v), ShouldBeNil)
+			}
+
+			Convey("Then it should have the same results as a naive computation", func() {
+				var fs []float64
+				for _, v := range vs {
+					if v.Type() != TypeNull {
+						f, _ := ToFloat(v)
+						fs = append(fs, f)
+					}
+				}
+				sum := 0.0
+				for _, f := range fs {
+					sum += f
+				}
+				mean := sum / float64(len(fs))
+				sq := 0.0
+				for _, f := range fs {
+					sq += (f - mean) * (f - mean)
+				}
+
+				So(s.Count(), ShouldEqual, len(fs))
+				m, _ := AsFloat(s.Mean())
+				So(m, ShouldAlmostEqual, mean, 1e-12)
+				v, _ := AsFloat(s.Variance())
+				So(v, ShouldAlmostEqual, sq/float64(len(fs)), 1e-12)
+				So(s.Min(), ShouldResemble, Int(-3))
+				So(s.Max(), ShouldResemble, Int(10))
+			})
+		})
+
+		Convey("When pushing values having a large offset", func() {
+			for _, v := range []float64{4, 7, 13, 16} {
+				So(s.Push(Float(1e9+v)), ShouldBeNil)
+			}
+
+			Convey("Then the variance should still be accurate", func() {
+				v, _ := AsFloat(s.Variance())
+				So(v, ShouldAlmostEqual, 22.5, 1e-6)
+			})
+		})
+
+		Convey("When pushing an unsupported value", func() {
+			Convey("Then it should fail", func() {
+				So(s.Push(String("1")), ShouldNotBeNil)
+				So(s.Push(Float(math.NaN())), ShouldNotBeNil)
+				So(s.Count(), ShouldEqual, 0)
+			})
+		})
+	})
+}
@@ -0,0 +1,127 @@
+package data
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Schema describes the shape of a Value. It can be used to document tuples
+// flowing in a topology.
+type Schema struct {
+	// Type is the type of the value. When it's not set (i.e. 0), a value of
+	// any type is accepted.
+	Type TypeID
+
+	// Fields has the schema of each field of a Map. It can only be used when
+	// Type is TypeMap.
+	Fields map[string]*Schema
+
+	// Required lists the names of fields that a Map must have. It can only
+	// be used when Type is TypeMap.
+	Required []string
+
+	// Elem is the schema of elements of an Array. When it's nil, elements
+	// can be any value. It can only be used when Type is TypeArray.
+	Elem *Schema
+
+	// Nullable, if set to true, accepts Null in addition to Type.
+	Nullable bool
+}
+
+// ToJSONSchema converts the Schema to a JSON Schema (draft 4) document which
+// can be passed to JSON Schema validators such as gojsonschema. The document
+// validates the JSON representation of Values: a Blob is a base64 encoded
+// string and a Timestamp is a string in RFC 3339 format.
+func (s Schema) ToJSONSchema() (Map, error) {
+	js, err := s.toJSONSchema("")
+	if err != nil {
+		return nil, err
+	}
+	js["$schema"] = String("http://json-schema.org/draft-04/schema#")
+	return js, nil
+}
+
+func (s *Schema) toJSONSchema(path string) (Map, error) {
+	if path == "" {
+		path = "(root)"
+	}
+	if s.Type != TypeMap && (len(s.Fields) > 0 || len(s.Required) > 0) {
+		return nil, fmt.Errorf("%v: fields can only be defined for map, not %v", path, s.Type)
+	}
+	if s.Type != TypeArray && s.Elem != nil {
+		return nil, fmt.Errorf("%v: elements can only be defined for array, not %v", path, s.Type)
+	}
+
+	js := Map{}
+	var t string
+	switch s.Type {
+	case typeUnknown:
+		// any value
+		return js, nil
+	case TypeNull:
+		t = "null"
+	case TypeBool:
+		t = "boolean"
+	case TypeInt:
+		t = "integer"
+	case TypeFloat:
+		t = "number"
+	case TypeString:
+		t = "string"
+	case TypeBlob:
+		t = "string"
+		js["media"] = Map{"binaryEncoding": String("base64")}
+	case TypeTimestamp:
+		t = "string"
+		js["format"] = String("date-time")
+	case TypeArray:
+		t = "array"
+		if s.Elem != nil {
+			items, err := s.Elem.toJSONSchema(path + "[]")
+			if err != nil {
+				return nil, err
+			}
+			js["items"] = items
+		}
+	case TypeMap:
+		t = "object"
+		props := Map{}
+		for name, f := range s.Fields {
+			if f == nil {
+				return nil, fmt.Errorf("%v: the schema of the field '%v' is nil", path, name)
+			}
+			p, err := f.toJSONSchema(joinSchemaPath(path, name))
+			if err != nil {
+				return nil, err
+			}
+			props[name] = p
+		}
+		js["properties"] = props
+		if len(s.Required) > 0 {
+			req := make([]string, len(s.Required))
+			copy(req, s.Required)
+			sort.Strings(req)
+			a := make(Array, len(req))
+			for i, r := range req {
+				a[i] = String(r)
+			}
+			js["required"] = a
+		}
+	default:
+		return nil, fmt.Errorf("%v: unsupported type: %v", path, s.Type)
+	}
+
+	if s.Nullable && s.Type != TypeNull {
+		js["type"] = Array{String(t), String("null")}
+	} else {
+		js["type"] = String(t)
+	}
+	return js, nil
+}
+
+func joinSchemaPath(path, name string) string {
+	if path == "(root)" {
+		return name
+	}
+	return path + "." + name
+}
@@ -0,0 +1,125 @@
+package data
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/xeipuuv/gojsonschema"
+	"testing"
+	"time"
+)
+
+func TestSchemaToJSONSchema(t *testing.T) {
+	Convey("Given a Schema having nested objects and arrays", t, func() {
+		s := Schema{
+			Type: TypeMap,
+			Fields: map[string]*Schema{
+				"id":   {Type: TypeInt},
+				"name": {Type: TypeString, Nullable: true},
+				"ts":   {Type: TypeTimestamp},
+				"pos": {
+					Type: TypeMap,
+					Fields: map[string]*Schema{
+						"x": {Type: TypeFloat},
+						"y": {Type: TypeFloat},
+					},
+					Required: []string{"y", "x"},
+				},
+				"tags": {
+					Type: TypeArray,
+					Elem: &Schema{Type: TypeString},
+				},
+				"extra": {},
+			},
+			Required: []string{"id", "pos"},
+		}
+
+		Convey("When converting it to a JSON Schema", func() {
+			js, err := s.ToJSONSchema()
+			So(err, ShouldBeNil)
+
+			Convey("Then it should have the expected document", func() {
+				So(js, ShouldResemble, Map{
+					"$schema": String("http://json-schema.org/draft-04/schema#"),
+					"type":    String("object"),
+					"properties": Map{
+						"id":   Map{"type": String("integer")},
+						"name": Map{"type": Array{String("string"), String("null")}},
+						"ts":   Map{"type": String("string"), "format": String("date-time")},
+						"pos": Map{
+							"type": String("object"),
+							"properties": Map{
+								"x": Map{"type": String("number")},
+								"y": Map{"type": String("number")},
+							},
+							"required": Array{String("x"), String("y")},
+						},
+						"tags": Map{
+							"type":  String("array"),
+							"items": Map{"type": String("string")},
+						},
+						"extra": Map{},
+					},
+					"required": Array{String("id"), String("pos")},
+				})
+			})
+
+			Convey("Then gojsonschema should accept it", func() {
+				schema, err := gojsonschema.NewSchema(gojsonschema.NewStringLoader(js.String()))
+				So(err, ShouldBeNil)
+
+				Convey("And it should validate a conforming sample", func() {
+					sample := Map{
+						"id":   Int(1),
+						"name": Null{},
+						"ts":   Timestamp(time.Date(2015, time.May, 1, 12, 0, 0, 0, time.UTC)),
+						"pos":  Map{"x": Float(1.5), "y": Int(2)},
+						"tags": Array{String("a"), String("b")},
+						"extra": Array{
+							Blob("hoge"),
+						},
+					}
+					res, err := schema.Validate(gojsonschema.NewStringLoader(sample.String()))
+					So(err, ShouldBeNil)
+					So(res.Valid(), ShouldBeTrue)
+				})
+
+				Convey("And it should reject a non-conforming sample", func() {
+					sample := Map{
+						"id":   Float(1.5),
+						"pos":  Map{"x": Float(1.5)},
+						"tags": Array{Int(1)},
+					}
+					res, err := schema.Validate(gojsonschema.NewStringLoader(sample.String()))
+					So(err, ShouldBeNil)
+					So(res.Valid(), ShouldBeFalse)
+					So(len(res.Errors()), ShouldEqual, 3)
+				})
+			})
+		})
+	})
+
+	Convey("Given an invalid Schema", t, func() {
+		Convey("When a non-map schema has fields", func() {
+			s := Schema{
+				Type: TypeMap,
+				Fields: map[string]*Schema{
+					"a": {Type: TypeInt, Fields: map[string]*Schema{"b": {}}},
+				},
+			}
+			_, err := s.ToJSONSchema()
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldStartWith, "a:")
+			})
+		})
+
+		Convey("When a non-array schema has elements", func() {
+			s := Schema{Type: TypeString, Elem: &Schema{}}
+			_, err := s.ToJSONSchema()
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
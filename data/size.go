@@ -0,0 +1,58 @@
+package data
+
+// These constants approximate the memory used by Go values on
+// 64-bit platforms.
+const (
+	// interfaceSize is the size of an interface value holding a Value,
+	// e.g., an element of an Array.
+	interfaceSize = 16
+	// stringHeaderSize is the size of a string header.
+	stringHeaderSize = 16
+	// sliceHeaderSize is the size of a slice header.
+	sliceHeaderSize = 24
+	// mapOverhead is the size of the header of a Go map.
+	mapOverhead = 48
+	// timestampSize is the size of a time.Time.
+	timestampSize = 24
+)
+
+// ByteSize estimates the number of bytes that the given Value occupies
+// in memory. It is meant to be used for memory accounting, e.g., to
+// compute the cost of a tuple, and not an exact figure.
+//
+// Scalar values count their own size (e.g., 8 bytes for an Int), strings
+// and blobs count the length of their content in addition to a header,
+// and Arrays and Maps count a header, the interface value holding each
+// element, and the ByteSize of the elements (as well as the keys) in a
+// recursive fashion. Null counts 0 bytes.
+func ByteSize(v Value) int {
+	switch v.Type() {
+	case TypeBool:
+		return 1
+	case TypeInt, TypeFloat:
+		return 8
+	case TypeString:
+		s, _ := v.asString()
+		return stringHeaderSize + len(s)
+	case TypeBlob:
+		b, _ := v.asBlob()
+		return sliceHeaderSize + len(b)
+	case TypeTimestamp:
+		return timestampSize
+	case TypeArray:
+		a, _ := v.asArray()
+		size := sliceHeaderSize
+		for _, elem := range a {
+			size += interfaceSize + ByteSize(elem)
+		}
+		return size
+	case TypeMap:
+		m, _ := v.asMap()
+		size := mapOverhead
+		for k, elem := range m {
+			size += stringHeaderSize + len(k) + interfaceSize + ByteSize(elem)
+		}
+		return size
+	}
+	return 0
+}
@@ -0,0 +1,64 @@
+package data
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+	"time"
+)
+
+func TestLen(t *testing.T) {
+	Convey("Given a Map and an Array", t, func() {
+		m := Map{"a": Int(1), "b": Array{Int(1), Int(2)}}
+		a := Array{Int(1), Map{}, Null{}}
+
+		Convey("Then Len should return the number of top-level elements", func() {
+			So(m.Len(), ShouldEqual, 2)
+			So(a.Len(), ShouldEqual, 3)
+			So(Map{}.Len(), ShouldEqual, 0)
+			So(Array(nil).Len(), ShouldEqual, 0)
+		})
+	})
+}
+
+func TestByteSize(t *testing.T) {
+	Convey("Given scalar values", t, func() {
+		Convey("Then ByteSize should return their size", func() {
+			So(ByteSize(Null{}), ShouldEqual, 0)
+			So(ByteSize(Bool(true)), ShouldEqual, 1)
+			So(ByteSize(Int(-1)), ShouldEqual, 8)
+			So(ByteSize(Float(3.14)), ShouldEqual, 8)
+			So(ByteSize(Timestamp(time.Now())), ShouldEqual, 24)
+		})
+	})
+
+	Convey("Given a string and a blob", t, func() {
+		Convey("Then ByteSize should include the length of the content", func() {
+			So(ByteSize(String("")), ShouldEqual, 16)
+			So(ByteSize(String("hoge")), ShouldEqual, 20)
+			So(ByteSize(String("日本語")), ShouldEqual, 25)
+			So(ByteSize(Blob("hoge")), ShouldEqual, 28)
+		})
+	})
+
+	Convey("Given a nested structure", t, func() {
+		v := Map{
+			"id": Int(1),
+			"tags": Array{
+				String("a"),
+				String("bc"),
+			},
+		}
+
+		Convey("Then ByteSize should add up the elements recursively", func() {
+			arr := 24 + (16 + 17) + (16 + 18)
+			So(ByteSize(v["tags"]), ShouldEqual, arr)
+			So(ByteSize(v), ShouldEqual, 48+(16+2+16+8)+(16+4+16+arr))
+		})
+
+		Convey("Then ByteSize should grow with the content", func() {
+			before := ByteSize(v)
+			v["tags"] = append(v["tags"].(Array), Null{})
+			So(ByteSize(v), ShouldEqual, before+16)
+		})
+	})
+}
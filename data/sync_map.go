@@ -0,0 +1,126 @@
+package data
+
+import (
+	"sync"
+)
+
+// SyncMap is a Map which can safely be accessed from multiple goroutines
+// concurrently. It's meant to be used as a shared state of a UDS, which may
+// be accessed from multiple streams at the same time.
+//
+// Values are deeply copied when they're stored in or loaded from a SyncMap,
+// so a Map or an Array loaded from it can be modified without affecting the
+// SyncMap or other goroutines. Modifying such a value doesn't change the
+// SyncMap, so use Update to modify nested values atomically.
+type SyncMap struct {
+	m sync.RWMutex
+	v Map
+}
+
+// NewSyncMap creates a new SyncMap having a copy of m. m can be nil.
+func NewSyncMap(m Map) *SyncMap {
+	if m == nil {
+		m = Map{}
+	} else {
+		m = m.Copy()
+	}
+	return &SyncMap{
+		v: m,
+	}
+}
+
+// Load returns the value of the key. It returns false when the SyncMap
+// doesn't have the key.
+func (s *SyncMap) Load(key string) (Value, bool) {
+	s.m.RLock()
+	defer s.m.RUnlock()
+	v, ok := s.v[key]
+	if !ok {
+		return nil, false
+	}
+	return v.clone(), true
+}
+
+// Get returns the value pointed by the path like Map.Get.
+func (s *SyncMap) Get(path Path) (Value, error) {
+	s.m.RLock()
+	defer s.m.RUnlock()
+	v, err := s.v.Get(path)
+	if err != nil {
+		return nil, err
+	}
+	return v.clone(), nil
+}
+
+// Store sets a copy of the value to the key.
+func (s *SyncMap) Store(key string, v Value) {
+	v = v.clone()
+	s.m.Lock()
+	defer s.m.Unlock()
+	s.v[key] = v
+}
+
+// LoadOrStore returns the value of the key when the SyncMap has the key.
+// Otherwise, it stores v and returns it. The second return value is true
+// when the value was loaded.
+func (s *SyncMap) LoadOrStore(key string, v Value) (Value, bool) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if cur, ok := s.v[key]; ok {
+		return cur.clone(), true
+	}
+	s.v[key] = v.clone()
+	return v, false
+}
+
+// Delete removes the key from the SyncMap.
+func (s *SyncMap) Delete(key string) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	delete(s.v, key)
+}
+
+// Len returns the number of keys in the SyncMap.
+func (s *SyncMap) Len() int {
+	s.m.RLock()
+	defer s.m.RUnlock()
+	return len(s.v)
+}
+
+// Range calls f for each key and value in the SyncMap in lexical order of
+// keys. It stops when f returns false. f is called with a snapshot copied
+// when Range was called, so f can call other methods of the SyncMap and
+// changes made by them don't affect the iteration.
+func (s *SyncMap) Range(f func(key string, v Value) bool) {
+	s.m.RLock()
+	keys := s.v.SortedKeys()
+	vs := make([]Value, len(keys))
+	for i, k := range keys {
+		vs[i] = s.v[k].clone()
+	}
+	s.m.RUnlock()
+
+	for i, k := range keys {
+		if !f(k, vs[i]) {
+			return
+		}
+	}
+}
+
+// Update calls f with the Map held by the SyncMap while no other goroutine
+// can access it, so that f can read and modify the Map, including nested
+// values, atomically. f must not call methods of the SyncMap and must not
+// keep the Map after it returns.
+func (s *SyncMap) Update(f func(m Map) error) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+	return f(s.v)
+}
+
+// Copy returns a deep copy of the Map held by the SyncMap. The result can
+// be used with functions taking a Map such as Decode or AsMap.
+func (s *SyncMap) Copy() Map {
+	s.m.RLock()
+	defer s.m.RUnlock()
+	return s.v.Copy()
+}
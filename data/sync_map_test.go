@@ -0,0 +1,218 @@
+package data
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestSyncMap(t *testing.T) {
+	Convey("Given a SyncMap created from a Map", t, func() {
+		orig := Map{
+			"a": Int(1),
+			"b": Map{"c": String("d")},
+		}
+		s := NewSyncMap(orig)
+
+		Convey("When modifying the original Map", func() {
+			orig["a"] = Int(2)
+
+			Convey("Then the SyncMap should not be affected", func() {
+				v, ok := s.Load("a")
+				So(ok, ShouldBeTrue)
+				So(v, ShouldEqual, Int(1))
+			})
+		})
+
+		Convey("When storing and deleting values", func() {
+			s.Store("x", Float(1.5))
+			s.Delete("a")
+
+			Convey("Then Load should reflect the changes", func() {
+				v, ok := s.Load("x")
+				So(ok, ShouldBeTrue)
+				So(v, ShouldEqual, Float(1.5))
+				_, ok = s.Load("a")
+				So(ok, ShouldBeFalse)
+				So(s.Len(), ShouldEqual, 2)
+			})
+		})
+
+		Convey("When calling LoadOrStore", func() {
+			v1, loaded1 := s.LoadOrStore("a", Int(10))
+			v2, loaded2 := s.LoadOrStore("z", Int(10))
+
+			Convey("Then it should only store missing keys", func() {
+				So(loaded1, ShouldBeTrue)
+				So(v1, ShouldEqual, Int(1))
+				So(loaded2, ShouldBeFalse)
+				So(v2, ShouldEqual, Int(10))
+			})
+		})
+
+		Convey("When getting a nested value with a path", func() {
+			v, err := s.Get(MustCompilePath("b.c"))
+
+			Convey("Then it should return the value", func() {
+				So(err, ShouldBeNil)
+				So(v, ShouldEqual, String("d"))
+			})
+		})
+
+		Convey("When ranging over it", func() {
+			keys := []string{}
+			s.Range(func(k string, v Value) bool {
+				keys = append(keys, k)
+				// modifying the SyncMap in f doesn't deadlock
+				s.Store("new", Null{})
+				return true
+			})
+
+			Convey("Then it should visit the keys in the snapshot in order", func() {
+				So(keys, ShouldResemble, []string{"a", "b"})
+				So(s.Len(), ShouldEqual, 3)
+			})
+		})
+
+		Convey("When stopping Range", func() {
+			n := 0
+			s.Range(func(k string, v Value) bool {
+				n++
+				return false
+			})
+
+			Convey("Then f should only be called once", func() {
+				So(n, ShouldEqual, 1)
+			})
+		})
+
+		Convey("When copying it", func() {
+			m := s.Copy()
+
+			Convey("Then it should be usable with conversion helpers", func() {
+				var dst struct {
+					A int
+					B map[string]string
+				}
+				So(Decode(m, &dst), ShouldBeNil)
+				So(dst.A, ShouldEqual, 1)
+				So(dst.B["c"], ShouldEqual, "d")
+
+				b, err := AsMap(m["b"])
+				So(err, ShouldBeNil)
+				b["c"] = String("modified")
+				v, err := s.Get(MustCompilePath("b.c"))
+				So(err, ShouldBeNil)
+				So(v, ShouldEqual, String("d"))
+			})
+		})
+
+		Convey("When updating a nested value", func() {
+			err := s.Update(func(m Map) error {
+				return m.Set(MustCompilePath("b.e"), Int(3))
+			})
+
+			Convey("Then the value should be set", func() {
+				So(err, ShouldBeNil)
+				v, err := s.Get(MustCompilePath("b.e"))
+				So(err, ShouldBeNil)
+				So(v, ShouldEqual, Int(3))
+			})
+		})
+
+		Convey("When modifying nested values loaded from it", func() {
+			v, ok := s.Load("b")
+			So(ok, ShouldBeTrue)
+			v.(Map)["c"] = String("loaded")
+
+			v, err := s.Get(MustCompilePath("b"))
+			So(err, ShouldBeNil)
+			v.(Map)["c"] = String("got")
+
+			s.Range(func(k string, v Value) bool {
+				if m, ok := v.(Map); ok {
+					m["c"] = String("ranged")
+				}
+				return true
+			})
+
+			Convey("Then the SyncMap should not be affected", func() {
+				v, err := s.Get(MustCompilePath("b.c"))
+				So(err, ShouldBeNil)
+				So(v, ShouldEqual, String("d"))
+			})
+		})
+
+		Convey("When modifying a nested value after storing it", func() {
+			m := Map{"f": Int(1)}
+			s.Store("e", m)
+			m["f"] = Int(2)
+
+			Convey("Then the SyncMap should not be affected", func() {
+				v, err := s.Get(MustCompilePath("e.f"))
+				So(err, ShouldBeNil)
+				So(v, ShouldEqual, Int(1))
+			})
+		})
+	})
+
+	Convey("Given a SyncMap shared by goroutines", t, func() {
+		s := NewSyncMap(nil)
+		s.Store("count", Int(0))
+		s.Store("nested", Map{"count": Int(0)})
+
+		Convey("When readers and writers access it concurrently", func() {
+			const n = 8
+			const iter = 100
+			wg := sync.WaitGroup{}
+			for i := 0; i < n; i++ {
+				wg.Add(2)
+				go func(i int) {
+					defer wg.Done()
+					for j := 0; j < iter; j++ {
+						s.Store(fmt.Sprintf("key%v", i), Int(j))
+						s.Update(func(m Map) error {
+							c, _ := AsInt(m["count"])
+							m["count"] = Int(c + 1)
+							nested, _ := AsMap(m["nested"])
+							c, _ = AsInt(nested["count"])
+							nested["count"] = Int(c + 1)
+							return nil
+						})
+					}
+				}(i)
+				go func() {
+					defer wg.Done()
+					for j := 0; j < iter; j++ {
+						s.Load("count")
+						// values loaded from the SyncMap are copies and
+						// can be modified while others update the SyncMap
+						if v, ok := s.Load("nested"); ok {
+							v.(Map)["count"] = Int(-1)
+						}
+						s.Range(func(k string, v Value) bool {
+							if m, ok := v.(Map); ok {
+								m["reader"] = Int(j)
+							}
+							return true
+						})
+						s.Copy()
+					}
+				}()
+			}
+			wg.Wait()
+
+			Convey("Then all updates should be applied", func() {
+				v, ok := s.Load("count")
+				So(ok, ShouldBeTrue)
+				So(v, ShouldEqual, Int(n*iter))
+				v, err := s.Get(MustCompilePath("nested.count"))
+				So(err, ShouldBeNil)
+				So(v, ShouldEqual, Int(n*iter))
+				So(s.Len(), ShouldEqual, n+2)
+			})
+		})
+	})
+}
@@ -1,6 +1,7 @@
 package data
 
 import (
+	"fmt"
 	"time"
 )
 
@@ -82,3 +83,38 @@ func (t Timestamp) String() string {
 	s, _ := ToString(t)
 	return `"` + s + `"`
 }
+
+// ParseTimestampIn parses s with layout and returns it as a Timestamp. When
+// s doesn't have timezone information, it's interpreted as a time in loc.
+// When s has an offset, the offset takes precedence over loc. When layout is
+// empty, time.RFC3339Nano is used. A nil loc is treated as time.UTC.
+func ParseTimestampIn(s, layout string, loc *time.Location) (Value, error) {
+	if layout == "" {
+		layout = time.RFC3339Nano
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+	t, err := time.ParseInLocation(layout, s, loc)
+	if err != nil {
+		return nil, err
+	}
+	return Timestamp(t), nil
+}
+
+// FormatTimestamp formats the Timestamp v with layout after converting it to
+// the time in loc. When layout is empty, time.RFC3339Nano is used. A nil loc
+// is treated as time.UTC. It returns an error when v isn't a Timestamp.
+func FormatTimestamp(v Value, layout string, loc *time.Location) (string, error) {
+	if v.Type() != TypeTimestamp {
+		return "", fmt.Errorf("cannot format %s as a timestamp", v.Type())
+	}
+	if layout == "" {
+		layout = time.RFC3339Nano
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+	t, _ := v.asTimestamp()
+	return t.In(loc).Format(layout), nil
+}
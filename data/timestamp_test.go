@@ -0,0 +1,92 @@
+package data
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+	"time"
+)
+
+func TestTimestampInLocation(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*60*60)
+	newYork := time.FixedZone("EST", -5*60*60)
+
+	Convey("Given a timestamp string with an offset", t, func() {
+		s := "2015-04-10T19:23:00+09:00"
+
+		Convey("When parsing it in another timezone", func() {
+			v, err := ParseTimestampIn(s, time.RFC3339, newYork)
+
+			Convey("Then the offset should take precedence", func() {
+				So(err, ShouldBeNil)
+				ts, err := AsTimestamp(v)
+				So(err, ShouldBeNil)
+				So(ts.Equal(time.Date(2015, time.April, 10, 10, 23, 0, 0, time.UTC)), ShouldBeTrue)
+			})
+
+			Convey("And formatting it in two timezones", func() {
+				layout := "2006-01-02 15:04:05 MST"
+
+				Convey("Then both should represent the same instant", func() {
+					s1, err := FormatTimestamp(v, layout, tokyo)
+					So(err, ShouldBeNil)
+					So(s1, ShouldEqual, "2015-04-10 19:23:00 JST")
+
+					s2, err := FormatTimestamp(v, layout, newYork)
+					So(err, ShouldBeNil)
+					So(s2, ShouldEqual, "2015-04-10 05:23:00 EST")
+				})
+			})
+
+			Convey("And formatting it with the default layout and timezone", func() {
+				s, err := FormatTimestamp(v, "", nil)
+
+				Convey("Then it should be normalized to UTC", func() {
+					So(err, ShouldBeNil)
+					So(s, ShouldEqual, "2015-04-10T10:23:00Z")
+				})
+			})
+		})
+	})
+
+	Convey("Given a timestamp string without timezone information", t, func() {
+		s := "2015-04-10 19:23:00"
+		layout := "2006-01-02 15:04:05"
+
+		Convey("When parsing it in a timezone", func() {
+			v, err := ParseTimestampIn(s, layout, tokyo)
+
+			Convey("Then it should be interpreted as a time in the timezone", func() {
+				So(err, ShouldBeNil)
+				ts, _ := AsTimestamp(v)
+				So(ts.Equal(time.Date(2015, time.April, 10, 10, 23, 0, 0, time.UTC)), ShouldBeTrue)
+			})
+		})
+
+		Convey("When parsing it without a timezone", func() {
+			v, err := ParseTimestampIn(s, layout, nil)
+
+			Convey("Then it should be interpreted as a time in UTC", func() {
+				So(err, ShouldBeNil)
+				ts, _ := AsTimestamp(v)
+				So(ts.Equal(time.Date(2015, time.April, 10, 19, 23, 0, 0, time.UTC)), ShouldBeTrue)
+			})
+		})
+
+		Convey("When parsing it with a mismatching layout", func() {
+			_, err := ParseTimestampIn(s, time.RFC3339, nil)
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+
+	Convey("Given a non-Timestamp value", t, func() {
+		Convey("Then FormatTimestamp should fail", func() {
+			for _, v := range []Value{Null{}, Int(1), String("2015-04-10T10:23:00Z")} {
+				_, err := FormatTimestamp(v, "", nil)
+				So(err, ShouldNotBeNil)
+			}
+		})
+	})
+}
@@ -2,6 +2,7 @@ package data
 
 import (
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"math"
 	"strconv"
@@ -28,6 +29,47 @@ func AsBool(v Value) (bool, error) {
 	return v.asBool()
 }
 
+// AsBoolStrict returns a bool value when the type of Value is TypeBool and
+// false when it's TypeNull, otherwise it returns error. It's used to evaluate
+// conditions such as WHERE, where NULL isn't true but a value of any other
+// type is considered a mistake in the statement.
+func AsBoolStrict(v Value) (bool, error) {
+	if v == nil {
+		return false, fmt.Errorf(errNilConversionFormat, TypeBool)
+	}
+	if v.Type() == TypeNull {
+		return false, nil
+	}
+	return v.asBool()
+}
+
+// IsTruthy returns whether a given Value is considered true. Unlike ToBool,
+// it never fails and doesn't parse strings. The rules are as follows:
+//
+//  * nil, Null: false
+//  * Bool: actual boolean value
+//  * Int: true if non-zero
+//  * Float: true if non-zero and not NaN
+//  * String: true if non-empty
+//  * Blob: true if non-empty
+//  * Timestamp: true if IsZero() is false
+//  * Array: true if non-empty
+//  * Map: true if non-empty
+func IsTruthy(v Value) bool {
+	if v == nil {
+		return false
+	}
+	switch v.Type() {
+	case TypeString:
+		val, _ := v.asString()
+		return val != ""
+	default:
+		// ToBool only fails for strings
+		b, _ := ToBool(v)
+		return b
+	}
+}
+
 // AsInt returns an integer value only when the type of Value is TypeInt,
 // otherwise it returns error.
 func AsInt(v Value) (int64, error) {
@@ -91,6 +133,53 @@ func AsMap(v Value) (Map, error) {
 	return v.asMap()
 }
 
+// As stores v to the variable pointed by ptr by calling AsType function
+// corresponding to the type of the variable, e.g. AsInt for *int64. It
+// returns an error when the type of v doesn't match or ptr's type isn't
+// supported. Supported types are *bool, *int64, *int, *float64, *string,
+// *[]byte, *time.Time, *Array, *Map, and *Value.
+//
+// Example:
+//  var i int64
+//  if err := data.As(v, &i); err != nil { ... }
+func As(v Value, ptr interface{}) error {
+	var err error
+	switch p := ptr.(type) {
+	case *bool:
+		*p, err = AsBool(v)
+	case *int64:
+		*p, err = AsInt(v)
+	case *int:
+		var i int64
+		if i, err = AsInt(v); err == nil {
+			if int64(int(i)) != i {
+				return fmt.Errorf("%v is out of bounds for int conversion", i)
+			}
+			*p = int(i)
+		}
+	case *float64:
+		*p, err = AsFloat(v)
+	case *string:
+		*p, err = AsString(v)
+	case *[]byte:
+		*p, err = AsBlob(v)
+	case *time.Time:
+		*p, err = AsTimestamp(v)
+	case *Array:
+		*p, err = AsArray(v)
+	case *Map:
+		*p, err = AsMap(v)
+	case *Value:
+		if v == nil {
+			return errors.New("cannot convert nil to Value")
+		}
+		*p = v
+	default:
+		return fmt.Errorf("unsupported destination type: %T", ptr)
+	}
+	return err
+}
+
 // ToBool converts a given Value to a bool, if possible. The conversion
 // rules are similar to those in Python:
 //
@@ -140,7 +229,7 @@ func ToBool(v Value) (bool, error) {
 		return len(val) > 0, nil
 	default:
 		return defaultValue,
-			fmt.Errorf("cannot convert %T to bool", v)
+			convertError(v, "bool")
 	}
 }
 
@@ -188,7 +277,7 @@ func ToInt(v Value) (int64, error) {
 		return int64(seconds), nil
 	default:
 		return defaultValue,
-			fmt.Errorf("cannot convert %T to int64", v)
+			convertError(v, "int64")
 	}
 }
 
@@ -200,7 +289,9 @@ func ToInt(v Value) (int64, error) {
 //  * Int: conversion as done by float64(value)
 //  * Float: actual value
 //  * String: parsed float as per strconv.ParseFloat
-//    (values outside of valid float64 bounds will lead to an error)
+//    (values outside of valid float64 bounds will lead to an error),
+//    which also accepts "NaN", "Inf", "Infinity", "-Infinity", etc.
+//    regardless of case, as written by JSONEncoder
 //  * Blob: (error)
 //  * Timestamp: the number of seconds (not microseconds!) elapsed since
 //    January 1, 1970 UTC, with a decimal part
@@ -234,7 +325,7 @@ func ToFloat(v Value) (float64, error) {
 		return float64(val.Unix()) + float64(val.Nanosecond())/1e9, nil
 	default:
 		return defaultValue,
-			fmt.Errorf("cannot convert %T to float64", v)
+			convertError(v, "float64")
 	}
 }
 
@@ -298,7 +389,7 @@ func ToBlob(v Value) ([]byte, error) {
 		}
 		return b, nil
 	default:
-		return nil, fmt.Errorf("cannot convert %T to Blob", v)
+		return nil, convertError(v, "Blob")
 	}
 }
 
@@ -339,7 +430,7 @@ func ToTimestamp(v Value) (time.Time, error) {
 		return v.asTimestamp()
 	default:
 		return defaultValue,
-			fmt.Errorf("cannot convert %T to Time", v)
+			convertError(v, "Time")
 	}
 }
 
@@ -349,7 +440,9 @@ func ToTimestamp(v Value) (time.Time, error) {
 //  * Null: 0
 //	* Int: Converted to seconds (e.g. 3 is equal to 3 seconds)
 //	* Float: Converted to seconds (e.g. 3.141592 equals 3s + 141ms + 592us)
-//	* String: time.ParseDuration will be called
+//	* String: ParseISO8601Duration will be called when it starts with "P" or
+//	  "-P" (e.g. "PT1H30M"), otherwise time.ParseDuration will be called
+//	  (e.g. "1h30m")
 //	* other: (error)
 func ToDuration(v Value) (time.Duration, error) {
 	switch v.Type() {
@@ -364,8 +457,11 @@ func ToDuration(v Value) (time.Duration, error) {
 		return time.Duration(f * float64(time.Second)), nil
 	case TypeString:
 		s, _ := v.asString()
+		if strings.HasPrefix(s, "P") || strings.HasPrefix(s, "-P") {
+			return ParseISO8601Duration(s)
+		}
 		return time.ParseDuration(s)
 	default:
-		return 0, fmt.Errorf("cannot convert %T to Duration", v)
+		return 0, convertError(v, "Duration")
 	}
 }
@@ -100,6 +100,94 @@ func TestToBool(t *testing.T) {
 	})
 }
 
+func TestIsTruthy(t *testing.T) {
+	testCases := map[string][]convTestInput{
+		"Null": {
+			{"Null", Null{}, false},
+		},
+		"Bool": {
+			{"true", Bool(true), true},
+			{"false", Bool(false), false},
+		},
+		"Int": {
+			{"positive", Int(2), true},
+			{"negative", Int(-2), true},
+			{"zero", Int(0), false},
+		},
+		"Float": {
+			{"positive", Float(3.14), true},
+			{"negative", Float(-3.14), true},
+			{"zero", Float(0.0), false},
+			{"NaN", Float(math.NaN()), false},
+		},
+		"String": {
+			{"empty", String(""), false},
+			{"non-empty", String("hoge"), true},
+			{"false literal", String("false"), true},
+			{"zero literal", String("0"), true},
+		},
+		"Blob": {
+			{"empty", Blob(""), false},
+			{"nil", Blob(nil), false},
+			{"non-empty", Blob("hoge"), true},
+		},
+		"Timestamp": {
+			{"zero", Timestamp(time.Time{}), false},
+			{"now", Timestamp(time.Now()), true},
+		},
+		"Array": {
+			{"empty", Array{}, false},
+			{"non-empty", Array{Int(2), String("foo")}, true},
+		},
+		"Map": {
+			{"empty", Map{}, false},
+			{"non-empty", Map{"a": Int(2), "b": String("foo")}, true},
+		},
+	}
+
+	toFun := func(v Value) (interface{}, error) {
+		return IsTruthy(v), nil
+	}
+	runConversionTestCases(t, toFun, "IsTruthy", testCases)
+
+	Convey("Given nil", t, func() {
+		Convey("Then it shouldn't be truthy", func() {
+			So(IsTruthy(nil), ShouldBeFalse)
+		})
+	})
+}
+
+func TestAsBoolStrict(t *testing.T) {
+	Convey("Given a Bool", t, func() {
+		Convey("Then AsBoolStrict should return its value", func() {
+			b, err := AsBoolStrict(Bool(true))
+			So(err, ShouldBeNil)
+			So(b, ShouldBeTrue)
+
+			b, err = AsBoolStrict(Bool(false))
+			So(err, ShouldBeNil)
+			So(b, ShouldBeFalse)
+		})
+	})
+
+	Convey("Given a Null", t, func() {
+		Convey("Then AsBoolStrict should return false", func() {
+			b, err := AsBoolStrict(Null{})
+			So(err, ShouldBeNil)
+			So(b, ShouldBeFalse)
+		})
+	})
+
+	Convey("Given values of other types", t, func() {
+		Convey("Then AsBoolStrict should fail even if they're truthy", func() {
+			for _, v := range []Value{nil, Int(1), Float(1), String("true"), Array{Bool(true)}} {
+				_, err := AsBoolStrict(v)
+				So(err, ShouldNotBeNil)
+			}
+		})
+	})
+}
+
 func TestToInt(t *testing.T) {
 	now := time.Now()
 	negTime := time.Unix(-1, -1000)
@@ -411,6 +499,9 @@ func TestToDuration(t *testing.T) {
 			{"non-duration", String("1sec"), nil},
 			{"second", String("2.5s"), 2500 * time.Millisecond},
 			{"millsecond", String("-3.14ms"), -3140 * time.Microsecond},
+			{"ISO8601", String("PT1H30M"), 90 * time.Minute},
+			{"negative ISO8601", String("-PT0.5S"), -500 * time.Millisecond},
+			{"ISO8601 with years", String("P1Y"), nil},
 		},
 		"Blob": {
 			{"empty", Blob(""), nil},
@@ -523,3 +614,75 @@ func TestAsTypeWithNil(t *testing.T) {
 		})
 	})
 }
+
+func TestAs(t *testing.T) {
+	Convey("Given Values of various types", t, func() {
+		Convey("When converting an Int to int64", func() {
+			var i int64
+			err := As(Int(10), &i)
+
+			Convey("Then it should succeed", func() {
+				So(err, ShouldBeNil)
+				So(i, ShouldEqual, 10)
+			})
+		})
+
+		Convey("When converting an Int to int", func() {
+			var i int
+			err := As(Int(10), &i)
+
+			Convey("Then it should succeed", func() {
+				So(err, ShouldBeNil)
+				So(i, ShouldEqual, 10)
+			})
+		})
+
+		Convey("When converting a String to string", func() {
+			var s string
+			err := As(String("hoge"), &s)
+
+			Convey("Then it should succeed", func() {
+				So(err, ShouldBeNil)
+				So(s, ShouldEqual, "hoge")
+			})
+		})
+
+		Convey("When converting a Map to Map", func() {
+			var m Map
+			err := As(Map{"a": Int(1)}, &m)
+
+			Convey("Then it should succeed", func() {
+				So(err, ShouldBeNil)
+				So(m, ShouldResemble, Map{"a": Int(1)})
+			})
+		})
+
+		Convey("When converting a Value to a different type", func() {
+			var i int64
+			err := As(String("10"), &i)
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When converting to an unsupported type", func() {
+			var i int32
+			err := As(Int(10), &i)
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "unsupported destination type")
+			})
+		})
+
+		Convey("When converting nil", func() {
+			var v Value
+			err := As(nil, &v)
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
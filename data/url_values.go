@@ -0,0 +1,59 @@
+package data
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// ToURLValues flattens m into url.Values so that it can be sent as a query
+// string or a form-encoded body:
+//
+//  * a value in a nested Map is stored with a dotted key, e.g. "user.name"
+//  * each element of an Array is stored as a repeated key
+//  * other values are converted to strings by ToString, so Null becomes an
+//    empty string
+//
+// An empty Map or Array doesn't add any key. For example,
+//
+//	Map{"user": Map{"name": String("a")}, "tags": Array{String("x"), Int(1)}}
+//
+// is encoded as "tags=x&tags=1&user.name=a". Note that keys containing "."
+// cannot be distinguished from keys of nested Maps in the result.
+func ToURLValues(m Map) (url.Values, error) {
+	vs := url.Values{}
+	for k, v := range m {
+		if err := addURLValues(vs, k, v); err != nil {
+			return nil, err
+		}
+	}
+	return vs, nil
+}
+
+func addURLValues(vs url.Values, key string, v Value) error {
+	if v == nil {
+		return fmt.Errorf("%v: cannot encode nil", key)
+	}
+	switch v.Type() {
+	case TypeMap:
+		m, _ := AsMap(v)
+		for k, e := range m {
+			if err := addURLValues(vs, key+"."+k, e); err != nil {
+				return err
+			}
+		}
+	case TypeArray:
+		a, _ := AsArray(v)
+		for _, e := range a {
+			if err := addURLValues(vs, key, e); err != nil {
+				return err
+			}
+		}
+	default:
+		s, err := ToString(v)
+		if err != nil {
+			return fmt.Errorf("%v: %v", key, err)
+		}
+		vs.Add(key, s)
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package data
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestToURLValues(t *testing.T) {
+	Convey("Given a nested Map having arrays", t, func() {
+		m := Map{
+			"q":    String("sensor bee"),
+			"page": Int(2),
+			"user": Map{
+				"name":   String("a"),
+				"active": True,
+				"emails": Array{String("a@example.com"), String("b@example.com")},
+			},
+			"tags": Array{String("x"), Float(1.5), Null{}},
+			"items": Array{
+				Map{"id": Int(1), "n": Int(10)},
+				Map{"id": Int(2)},
+			},
+			"at":    Timestamp(time.Date(2017, 1, 2, 3, 4, 5, 0, time.UTC)),
+			"empty": Map{},
+			"none":  Array{},
+		}
+
+		Convey("When converting it to url.Values", func() {
+			vs, err := ToURLValues(m)
+			So(err, ShouldBeNil)
+
+			Convey("Then it should be flattened", func() {
+				So(vs, ShouldResemble, url.Values{
+					"q":           []string{"sensor bee"},
+					"page":        []string{"2"},
+					"user.name":   []string{"a"},
+					"user.active": []string{"true"},
+					"user.emails": []string{"a@example.com", "b@example.com"},
+					"tags":        []string{"x", "1.5", ""},
+					"items.id":    []string{"1", "2"},
+					"items.n":     []string{"10"},
+					"at":          []string{"2017-01-02T03:04:05Z"},
+				})
+			})
+
+			Convey("Then it should be encodable", func() {
+				So(url.Values{"user.emails": vs["user.emails"]}.Encode(), ShouldEqual,
+					"user.emails=a%40example.com&user.emails=b%40example.com")
+			})
+		})
+	})
+
+	Convey("Given a Map having nil", t, func() {
+		m := Map{"a": Map{"b": Array{nil}}}
+
+		Convey("When converting it to url.Values", func() {
+			_, err := ToURLValues(m)
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldStartWith, "a.b: ")
+			})
+		})
+	})
+}
@@ -38,8 +38,23 @@ type Value interface {
 	String() string
 }
 
+// conversionError is returned when a Value cannot be converted to another
+// type because of its type. Other errors, such as ones returned when a
+// string cannot be parsed, aren't conversionErrors.
+type conversionError struct {
+	msg string
+}
+
+func (e *conversionError) Error() string {
+	return e.msg
+}
+
 func castError(from TypeID, to TypeID) error {
-	return fmt.Errorf("unsupported cast %v from %v", to.String(), from.String())
+	return &conversionError{fmt.Sprintf("unsupported cast %v from %v", to.String(), from.String())}
+}
+
+func convertError(v Value, to string) error {
+	return &conversionError{fmt.Sprintf("cannot convert %T to %v", v, to)}
 }
 
 // TypeID is an ID of a type. A unique value is assigned to each type.
@@ -0,0 +1,113 @@
+package data
+
+import (
+	"container/list"
+	"sync"
+)
+
+// ValueCache is a bounded cache whose keys are Values. Keys are looked up by
+// their hash values computed by Hash and compared by Equal, so Int(2) and
+// Float(2.0) are the same key. When the cache is full, the least recently
+// used entry is evicted. A key containing NaN never hits because NaN isn't
+// equal to anything. It's meant to be used by UDFs to memoize expensive
+// computations for their inputs. It's safe for concurrent use.
+//
+// Values are stored as they are, so Maps and Arrays passed to or returned
+// from a ValueCache must not be modified.
+type ValueCache struct {
+	m          sync.Mutex
+	maxEntries int
+	hash       func(Value) HashValue
+	lru        *list.List // elements are *valueCacheEntry, most recent first
+	buckets    map[HashValue][]*list.Element
+}
+
+type valueCacheEntry struct {
+	hash HashValue
+	key  Value
+	val  Value
+}
+
+// NewValueCache creates a new ValueCache having at most maxEntries entries.
+// When maxEntries is less than 1, it's treated as 1.
+func NewValueCache(maxEntries int) *ValueCache {
+	if maxEntries < 1 {
+		maxEntries = 1
+	}
+	return &ValueCache{
+		maxEntries: maxEntries,
+		hash:       Hash,
+		lru:        list.New(),
+		buckets:    map[HashValue][]*list.Element{},
+	}
+}
+
+// Get returns the value cached for the key. It returns false when the key
+// isn't cached.
+func (c *ValueCache) Get(key Value) (Value, bool) {
+	c.m.Lock()
+	defer c.m.Unlock()
+	e := c.find(c.hash(key), key)
+	if e == nil {
+		return nil, false
+	}
+	c.lru.MoveToFront(e)
+	return e.Value.(*valueCacheEntry).val, true
+}
+
+// Put caches val for the key. When the key is already cached, the value is
+// replaced. When the cache is full, the least recently used entry is
+// evicted.
+func (c *ValueCache) Put(key, val Value) {
+	c.m.Lock()
+	defer c.m.Unlock()
+	h := c.hash(key)
+	if e := c.find(h, key); e != nil {
+		e.Value.(*valueCacheEntry).val = val
+		c.lru.MoveToFront(e)
+		return
+	}
+
+	if c.lru.Len() >= c.maxEntries {
+		c.remove(c.lru.Back())
+	}
+	e := c.lru.PushFront(&valueCacheEntry{
+		hash: h,
+		key:  key,
+		val:  val,
+	})
+	c.buckets[h] = append(c.buckets[h], e)
+}
+
+// Len returns the number of entries in the cache.
+func (c *ValueCache) Len() int {
+	c.m.Lock()
+	defer c.m.Unlock()
+	return c.lru.Len()
+}
+
+func (c *ValueCache) find(h HashValue, key Value) *list.Element {
+	for _, e := range c.buckets[h] {
+		if Equal(e.Value.(*valueCacheEntry).key, key) {
+			return e
+		}
+	}
+	return nil
+}
+
+func (c *ValueCache) remove(e *list.Element) {
+	h := e.Value.(*valueCacheEntry).hash
+	b := c.buckets[h]
+	for i, be := range b {
+		if be == e {
+			b = append(b[:i], b[i+1:]...)
+			break
+		}
+	}
+	if len(b) == 0 {
+		delete(c.buckets, h)
+	} else {
+		c.buckets[h] = b
+	}
+	c.lru.Remove(e)
+}
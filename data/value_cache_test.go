@@ -0,0 +1,118 @@
+package data
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestValueCache(t *testing.T) {
+	Convey("Given a ValueCache", t, func() {
+		c := NewValueCache(2)
+
+		Convey("When getting a value which isn't cached", func() {
+			_, ok := c.Get(Int(1))
+
+			Convey("Then it should miss", func() {
+				So(ok, ShouldBeFalse)
+			})
+		})
+
+		Convey("When putting values", func() {
+			c.Put(Map{"a": Int(1)}, String("map"))
+			c.Put(Int(2), String("two"))
+
+			Convey("Then equal keys should hit", func() {
+				v, ok := c.Get(Map{"a": Float(1.0)})
+				So(ok, ShouldBeTrue)
+				So(v, ShouldEqual, String("map"))
+
+				v, ok = c.Get(Float(2.0))
+				So(ok, ShouldBeTrue)
+				So(v, ShouldEqual, String("two"))
+			})
+
+			Convey("Then different keys should miss", func() {
+				_, ok := c.Get(Map{"a": Int(2)})
+				So(ok, ShouldBeFalse)
+				_, ok = c.Get(String("2"))
+				So(ok, ShouldBeFalse)
+			})
+
+			Convey("Then putting the same key should replace the value", func() {
+				c.Put(Int(2), String("TWO"))
+				v, ok := c.Get(Int(2))
+				So(ok, ShouldBeTrue)
+				So(v, ShouldEqual, String("TWO"))
+				So(c.Len(), ShouldEqual, 2)
+			})
+
+			Convey("Then putting a new key at capacity should evict the least recently used one", func() {
+				// Map{"a": 1} becomes the most recently used one
+				_, ok := c.Get(Map{"a": Int(1)})
+				So(ok, ShouldBeTrue)
+				c.Put(Int(3), String("three"))
+
+				So(c.Len(), ShouldEqual, 2)
+				_, ok = c.Get(Int(2))
+				So(ok, ShouldBeFalse)
+				_, ok = c.Get(Map{"a": Int(1)})
+				So(ok, ShouldBeTrue)
+				_, ok = c.Get(Int(3))
+				So(ok, ShouldBeTrue)
+			})
+		})
+	})
+
+	Convey("Given a ValueCache whose keys always collide", t, func() {
+		c := NewValueCache(3)
+		c.hash = func(Value) HashValue {
+			return 0
+		}
+
+		Convey("When putting values", func() {
+			c.Put(Int(1), String("one"))
+			c.Put(Int(2), String("two"))
+			c.Put(Int(3), String("three"))
+
+			Convey("Then keys should be distinguished by Equal", func() {
+				for k, v := range map[int64]string{1: "one", 2: "two", 3: "three"} {
+					res, ok := c.Get(Int(k))
+					So(ok, ShouldBeTrue)
+					So(res, ShouldEqual, String(v))
+				}
+				_, ok := c.Get(Int(4))
+				So(ok, ShouldBeFalse)
+			})
+
+			Convey("Then evicting one of them should keep the others", func() {
+				c.Put(Int(4), String("four"))
+
+				So(c.Len(), ShouldEqual, 3)
+				So(len(c.buckets[0]), ShouldEqual, 3)
+				_, ok := c.Get(Int(1))
+				So(ok, ShouldBeFalse)
+				for k, v := range map[int64]string{2: "two", 3: "three", 4: "four"} {
+					res, ok := c.Get(Int(k))
+					So(ok, ShouldBeTrue)
+					So(res, ShouldEqual, String(v))
+				}
+			})
+		})
+	})
+
+	Convey("Given a ValueCache with non-positive capacity", t, func() {
+		c := NewValueCache(0)
+
+		Convey("When putting values", func() {
+			c.Put(Int(1), Int(1))
+			c.Put(Int(2), Int(2))
+
+			Convey("Then it should keep one entry", func() {
+				So(c.Len(), ShouldEqual, 1)
+				_, ok := c.Get(Int(2))
+				So(ok, ShouldBeTrue)
+			})
+		})
+	})
+}
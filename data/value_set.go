@@ -0,0 +1,71 @@
+package data
+
+// ValueSet is a set of Values. Values are considered the same when they're
+// equal by Equal, which also means that they have the same Hash value:
+//
+//  * Int and Float are the same when the Float has an integer value, e.g.
+//    Int(1) and Float(1.0) are the same value while Float(1.5) isn't equal
+//    to any Int
+//  * Null is equal to Null, so a set has at most one Null
+//  * NaN isn't equal to anything including itself, so every NaN added is
+//    kept as a new element and Contains(NaN) is always false
+//
+// The first Value added is kept when the same Values are added, and Slice
+// returns Values in the order they were first added. The zero value is an
+// empty set ready to use. A ValueSet isn't safe for concurrent use.
+//
+// Values are stored as they are, so Maps and Arrays added to a ValueSet must
+// not be modified.
+type ValueSet struct {
+	buckets map[HashValue][]Value
+	values  []Value
+}
+
+// NewValueSet creates a ValueSet having the given Values.
+func NewValueSet(vs ...Value) *ValueSet {
+	s := &ValueSet{}
+	for _, v := range vs {
+		s.Add(v)
+	}
+	return s
+}
+
+// Add adds v to the set. It returns false when the set already has a Value
+// equal to v.
+func (s *ValueSet) Add(v Value) bool {
+	h := Hash(v)
+	for _, e := range s.buckets[h] {
+		if Equal(e, v) {
+			return false
+		}
+	}
+	if s.buckets == nil {
+		s.buckets = map[HashValue][]Value{}
+	}
+	s.buckets[h] = append(s.buckets[h], v)
+	s.values = append(s.values, v)
+	return true
+}
+
+// Contains returns true when the set has a Value equal to v.
+func (s *ValueSet) Contains(v Value) bool {
+	for _, e := range s.buckets[Hash(v)] {
+		if Equal(e, v) {
+			return true
+		}
+	}
+	return false
+}
+
+// Len returns the number of Values in the set.
+func (s *ValueSet) Len() int {
+	return len(s.values)
+}
+
+// Slice returns the Values in the set in the order they were added. The
+// returned slice is a copy and can be modified by the caller.
+func (s *ValueSet) Slice() []Value {
+	res := make([]Value, len(s.values))
+	copy(res, s.values)
+	return res
+}
@@ -0,0 +1,84 @@
+package data
+
+import (
+	"math"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestValueSet(t *testing.T) {
+	Convey("Given an empty ValueSet", t, func() {
+		s := &ValueSet{}
+
+		Convey("Then it should not have any value", func() {
+			So(s.Len(), ShouldEqual, 0)
+			So(s.Contains(Int(1)), ShouldBeFalse)
+			So(s.Slice(), ShouldBeEmpty)
+		})
+
+		Convey("When adding values", func() {
+			So(s.Add(Int(1)), ShouldBeTrue)
+			So(s.Add(String("a")), ShouldBeTrue)
+			So(s.Add(Map{"a": Array{Int(1)}}), ShouldBeTrue)
+
+			Convey("Then it should contain them", func() {
+				So(s.Len(), ShouldEqual, 3)
+				So(s.Contains(Int(1)), ShouldBeTrue)
+				So(s.Contains(String("a")), ShouldBeTrue)
+				So(s.Contains(Map{"a": Array{Int(1)}}), ShouldBeTrue)
+			})
+
+			Convey("Then it should not contain other values", func() {
+				So(s.Contains(Int(2)), ShouldBeFalse)
+				So(s.Contains(String("b")), ShouldBeFalse)
+				So(s.Contains(Map{"a": Array{Int(2)}}), ShouldBeFalse)
+				So(s.Contains(Null{}), ShouldBeFalse)
+			})
+
+			Convey("And adding the same values again", func() {
+				So(s.Add(Int(1)), ShouldBeFalse)
+				So(s.Add(String("a")), ShouldBeFalse)
+
+				Convey("Then they should not be duplicated", func() {
+					So(s.Len(), ShouldEqual, 3)
+					So(s.Slice(), ShouldResemble, []Value{Int(1), String("a"), Map{"a": Array{Int(1)}}})
+				})
+			})
+		})
+	})
+
+	Convey("Given a ValueSet having an Int and a Null", t, func() {
+		s := NewValueSet(Int(1), Null{}, Null{})
+
+		Convey("Then a Float having the same integer value should be the same", func() {
+			So(s.Len(), ShouldEqual, 2)
+			So(s.Contains(Float(1.0)), ShouldBeTrue)
+			So(s.Add(Float(1.0)), ShouldBeFalse)
+			So(s.Slice()[0], ShouldEqual, Int(1))
+		})
+
+		Convey("Then a Float having a fraction should be different", func() {
+			So(s.Contains(Float(1.5)), ShouldBeFalse)
+		})
+
+		Convey("Then values of other types should be different", func() {
+			So(s.Contains(String("1")), ShouldBeFalse)
+			So(s.Contains(True), ShouldBeFalse)
+		})
+
+		Convey("Then Null should be contained", func() {
+			So(s.Contains(Null{}), ShouldBeTrue)
+		})
+
+		Convey("When adding NaNs", func() {
+			So(s.Add(Float(math.NaN())), ShouldBeTrue)
+			So(s.Add(Float(math.NaN())), ShouldBeTrue)
+
+			Convey("Then each of them should be kept but never be contained", func() {
+				So(s.Len(), ShouldEqual, 4)
+				So(s.Contains(Float(math.NaN())), ShouldBeFalse)
+			})
+		})
+	})
+}
@@ -1,10 +1,11 @@
 package config
 
 import (
-	"errors"
+	"encoding/json"
 	"fmt"
 	"github.com/xeipuuv/gojsonschema"
 	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"regexp"
 	"strings"
 )
 
@@ -44,6 +45,22 @@ func mustToBool(v data.Value) bool {
 	return b
 }
 
+func mustToInt(v data.Value) int64 {
+	i, err := data.ToInt(v)
+	if err != nil {
+		panic(err)
+	}
+	return i
+}
+
+func mustParseJSONMap(js string) data.Map {
+	var m data.Map
+	if err := json.Unmarshal([]byte(js), &m); err != nil {
+		panic(err)
+	}
+	return m
+}
+
 func validate(schema *gojsonschema.Schema, m data.Map) error {
 	// GoLoader marshal and unmarshal the map.
 	res, err := schema.Validate(gojsonschema.NewGoLoader(m))
@@ -51,12 +68,242 @@ func validate(schema *gojsonschema.Schema, m data.Map) error {
 		return err
 	}
 	if !res.Valid() {
-		// TODO: provide better format
-		var errs []string
-		for _, e := range res.Errors() {
-			errs = append(errs, fmt.Sprintf("- %s", e))
-		}
-		return errors.New("validation errors:\n" + strings.Join(errs, "\n"))
+		return newValidationErrors(res.Errors())
 	}
 	return nil
 }
+
+// ValidationError is a violation of a JSON schema found in a config.
+type ValidationError struct {
+	// Field is the path to the field having the violation, e.g.
+	// "uds.params.dir". For a missing required field, it's the path to the
+	// missing field itself. It's "(root)" when the violation is on the top
+	// level.
+	Field string
+
+	// Type is the JSON schema keyword that the field violates, such as
+	// "required", "enum", or "additional_property_not_allowed".
+	Type string
+
+	// Description describes the violation.
+	Description string
+
+	// Value is the value violating the schema. It's Null when the value
+	// is missing or cannot be converted to data.Value.
+	Value data.Value
+}
+
+func newValidationError(e gojsonschema.ResultError) ValidationError {
+	field := e.Field()
+	v, err := data.NewValue(e.Value())
+	if err != nil {
+		v = data.Null{}
+	}
+	if e.Type() == "required" {
+		// gojsonschema reports a missing field on the object having it.
+		if p, ok := e.Details()["property"].(string); ok {
+			if field == gojsonschema.STRING_ROOT_SCHEMA_PROPERTY {
+				field = p
+			} else {
+				field += "." + p
+			}
+		}
+		v = data.Null{}
+	}
+	return ValidationError{
+		Field:       field,
+		Type:        e.Type(),
+		Description: e.Description(),
+		Value:       v,
+	}
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Field, e.Description)
+}
+
+// ValidationErrors is an error returned from functions creating config
+// parameters from a map, such as NewStorage, when the map violates the JSON
+// schema. It has an element for each violation so that a caller can render
+// a message for each field.
+type ValidationErrors []ValidationError
+
+func newValidationErrors(res []gojsonschema.ResultError) ValidationErrors {
+	errs := make(ValidationErrors, len(res))
+	for i, e := range res {
+		errs[i] = newValidationError(e)
+	}
+	return errs
+}
+
+func (e ValidationErrors) Error() string {
+	// TODO: provide better format
+	errs := make([]string, len(e))
+	for i := range e {
+		errs[i] = fmt.Sprintf("- %s", e[i].Error())
+	}
+	return "validation errors:\n" + strings.Join(errs, "\n")
+}
+
+// ValidateAll validates m against the schema and returns all violations
+// found. It returns an empty slice when m is valid.
+func ValidateAll(schema *gojsonschema.Schema, m data.Map) []ValidationError {
+	res, err := schema.Validate(gojsonschema.NewGoLoader(m))
+	if err != nil {
+		return []ValidationError{{
+			Field:       gojsonschema.STRING_ROOT_SCHEMA_PROPERTY,
+			Description: err.Error(),
+			Value:       data.Null{},
+		}}
+	}
+	return newValidationErrors(res.Errors())
+}
+
+// ApplyDefaults fills m with default values declared in the JSON schema
+// with the "default" keyword. A default value is only set when m doesn't
+// have the field. Fields of nested objects are filled recursively, and a
+// missing object is created when any of its fields has a default value.
+// m is modified in place, so it should be copied in advance when the
+// original map must be kept intact. Schemas combined by keywords such as
+// "anyOf" are not searched because which one applies is unknown before
+// validation.
+func ApplyDefaults(schema data.Map, m data.Map) {
+	props, ok := schema["properties"].(data.Map)
+	if !ok {
+		return
+	}
+	for name, ps := range props {
+		propSchema, ok := ps.(data.Map)
+		if !ok {
+			continue
+		}
+
+		v, ok := m[name]
+		if !ok {
+			if def, ok := propSchema["default"]; ok {
+				v = copyValue(def)
+				m[name] = v
+			}
+		}
+
+		switch c := v.(type) {
+		case data.Map:
+			ApplyDefaults(propSchema, c)
+		case nil:
+			// the field is missing and has no default value
+			sub := data.Map{}
+			ApplyDefaults(propSchema, sub)
+			if len(sub) > 0 {
+				m[name] = sub
+			}
+		}
+	}
+}
+
+// RedactedValue replaces the values of secret fields in the result of Redact.
+const RedactedValue = "***"
+
+// Redact returns a copy of m whose fields marked with a custom keyword
+// "secret": true in the JSON schema have RedactedValue instead of their
+// values. Null values are kept as they are. Unlike ApplyDefaults, schemas
+// combined by "anyOf", "oneOf", and "allOf" are also searched and a field is
+// considered secret when any of them marks it secret, so that a secret isn't
+// leaked even when it's in an invalid map. Properties matched by
+// "patternProperties" and elements of arrays described by "items" are
+// redacted as well.
+func Redact(schema data.Map, m data.Map) data.Map {
+	res, _ := redactValue([]data.Map{schema}, m).(data.Map)
+	return res
+}
+
+func redactValue(schemas []data.Map, v data.Value) data.Value {
+	schemas = flattenSchemas(schemas)
+	if v.Type() != data.TypeNull {
+		for _, s := range schemas {
+			if b, ok := s["secret"].(data.Bool); ok && bool(b) {
+				return data.String(RedactedValue)
+			}
+		}
+	}
+
+	switch c := v.(type) {
+	case data.Map:
+		res := make(data.Map, len(c))
+		for k, e := range c {
+			res[k] = redactValue(propertySchemas(schemas, k), e)
+		}
+		return res
+
+	case data.Array:
+		var items []data.Map
+		for _, s := range schemas {
+			if i, ok := s["items"].(data.Map); ok {
+				items = append(items, i)
+			}
+		}
+		res := make(data.Array, len(c))
+		for i, e := range c {
+			res[i] = redactValue(items, e)
+		}
+		return res
+	}
+	return v
+}
+
+// flattenSchemas returns schemas with all schemas combined by "anyOf",
+// "oneOf", and "allOf" in them.
+func flattenSchemas(schemas []data.Map) []data.Map {
+	var res []data.Map
+	for _, s := range schemas {
+		res = append(res, s)
+		for _, k := range []string{"anyOf", "oneOf", "allOf"} {
+			a, ok := s[k].(data.Array)
+			if !ok {
+				continue
+			}
+			var sub []data.Map
+			for _, e := range a {
+				if m, ok := e.(data.Map); ok {
+					sub = append(sub, m)
+				}
+			}
+			res = append(res, flattenSchemas(sub)...)
+		}
+	}
+	return res
+}
+
+// propertySchemas returns the schemas of the property name defined in
+// schemas.
+func propertySchemas(schemas []data.Map, name string) []data.Map {
+	var res []data.Map
+	for _, s := range schemas {
+		if props, ok := s["properties"].(data.Map); ok {
+			if p, ok := props[name].(data.Map); ok {
+				res = append(res, p)
+			}
+		}
+		if props, ok := s["patternProperties"].(data.Map); ok {
+			for pattern, p := range props {
+				ps, ok := p.(data.Map)
+				if !ok {
+					continue
+				}
+				if re, err := regexp.Compile(pattern); err == nil && re.MatchString(name) {
+					res = append(res, ps)
+				}
+			}
+		}
+	}
+	return res
+}
+
+func copyValue(v data.Value) data.Value {
+	switch c := v.(type) {
+	case data.Map:
+		return c.Copy()
+	case data.Array:
+		return c.Copy()
+	}
+	return v
+}
@@ -2,7 +2,10 @@ package config
 
 import (
 	"encoding/json"
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/xeipuuv/gojsonschema"
 	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"testing"
 )
 
 func toMap(js string) data.Map {
@@ -13,3 +16,273 @@ func toMap(js string) data.Map {
 	}
 	return m
 }
+
+func TestValidateAll(t *testing.T) {
+	Convey("Given a JSON schema", t, func() {
+		schema, err := gojsonschema.NewSchema(gojsonschema.NewStringLoader(`{
+	"type": "object",
+	"properties": {
+		"port": {
+			"type": "integer"
+		},
+		"name": {
+			"type": "string"
+		}
+	},
+	"additionalProperties": false
+}`))
+		So(err, ShouldBeNil)
+
+		Convey("When validating a valid config", func() {
+			errs := ValidateAll(schema, toMap(`{"port":8080,"name":"a"}`))
+
+			Convey("Then it should return no error", func() {
+				So(errs, ShouldBeEmpty)
+			})
+		})
+
+		Convey("When validating a config having two violations", func() {
+			errs := ValidateAll(schema, toMap(`{"port":"8080","name":1}`))
+
+			Convey("Then it should return both of them", func() {
+				So(len(errs), ShouldEqual, 2)
+
+				fields := map[string]ValidationError{}
+				for _, e := range errs {
+					fields[e.Field] = e
+				}
+				So(fields, ShouldContainKey, "port")
+				So(fields["port"].Description, ShouldNotBeBlank)
+				So(fields["port"].Value, ShouldResemble, data.String("8080"))
+				So(fields, ShouldContainKey, "name")
+				So(fields["name"].Description, ShouldNotBeBlank)
+				So(fields["name"].Value, ShouldResemble, data.Int(1))
+			})
+
+			Convey("Then each error should be formatted with its field", func() {
+				for _, e := range errs {
+					So(e.Error(), ShouldStartWith, e.Field+": ")
+				}
+			})
+		})
+	})
+}
+
+func TestApplyDefaults(t *testing.T) {
+	Convey("Given a JSON schema having default values", t, func() {
+		schema := toMap(`{
+	"type": "object",
+	"properties": {
+		"type": {
+			"type": "string",
+			"default": "in_memory"
+		},
+		"params": {
+			"type": "object",
+			"properties": {
+				"size": {
+					"type": "integer",
+					"default": 10
+				},
+				"dir": {
+					"type": "string"
+				}
+			}
+		},
+		"name": {
+			"type": "string"
+		}
+	}
+}`)
+
+		Convey("When applying defaults to a map missing defaulted fields", func() {
+			m := data.Map{}
+			ApplyDefaults(schema, m)
+
+			Convey("Then the fields should be populated", func() {
+				So(m, ShouldResemble, data.Map{
+					"type": data.String("in_memory"),
+					"params": data.Map{
+						"size": data.Int(10),
+					},
+				})
+			})
+		})
+
+		Convey("When applying defaults to a map having explicitly set fields", func() {
+			m := data.Map{
+				"type": data.String("fs"),
+				"params": data.Map{
+					"size": data.Int(1),
+					"dir":  data.String("/tmp"),
+				},
+				"name": data.String("a"),
+			}
+			ApplyDefaults(schema, m)
+
+			Convey("Then the fields should be left untouched", func() {
+				So(m, ShouldResemble, data.Map{
+					"type": data.String("fs"),
+					"params": data.Map{
+						"size": data.Int(1),
+						"dir":  data.String("/tmp"),
+					},
+					"name": data.String("a"),
+				})
+			})
+		})
+
+		Convey("When applying defaults to a map partially having nested fields", func() {
+			m := data.Map{
+				"params": data.Map{
+					"dir": data.String("/tmp"),
+				},
+			}
+			ApplyDefaults(schema, m)
+
+			Convey("Then only missing fields should be populated", func() {
+				So(m, ShouldResemble, data.Map{
+					"type": data.String("in_memory"),
+					"params": data.Map{
+						"size": data.Int(10),
+						"dir":  data.String("/tmp"),
+					},
+				})
+			})
+		})
+	})
+}
+
+func TestRedact(t *testing.T) {
+	Convey("Given a JSON schema having secret fields", t, func() {
+		schema := toMap(`{
+	"type": "object",
+	"properties": {
+		"name": {
+			"type": "string"
+		},
+		"token": {
+			"type": "string",
+			"secret": true
+		},
+		"uds": {
+			"anyOf": [
+				{
+					"type": "object",
+					"properties": {
+						"type": {
+							"enum": ["fs"]
+						},
+						"params": {
+							"type": "object",
+							"properties": {
+								"dir": {
+									"type": "string"
+								}
+							}
+						}
+					}
+				},
+				{
+					"type": "object",
+					"properties": {
+						"type": {
+							"enum": ["db"]
+						},
+						"params": {
+							"type": "object",
+							"properties": {
+								"connection_string": {
+									"type": "string",
+									"secret": true
+								}
+							}
+						}
+					}
+				}
+			]
+		},
+		"servers": {
+			"type": "array",
+			"items": {
+				"type": "object",
+				"patternProperties": {
+					"_password$": {
+						"secret": true
+					}
+				}
+			}
+		}
+	}
+}`)
+
+		Convey("When redacting a map having secrets", func() {
+			m := data.Map{
+				"name":  data.String("sensorbee"),
+				"token": data.String("abcdef"),
+				"uds": data.Map{
+					"type": data.String("db"),
+					"params": data.Map{
+						"connection_string": data.String("user:pass@localhost/db"),
+					},
+				},
+				"servers": data.Array{
+					data.Map{
+						"host":           data.String("localhost"),
+						"admin_password": data.String("pass"),
+					},
+				},
+			}
+			r := Redact(schema, m)
+
+			Convey("Then secrets should be masked", func() {
+				So(r, ShouldResemble, data.Map{
+					"name":  data.String("sensorbee"),
+					"token": data.String("***"),
+					"uds": data.Map{
+						"type": data.String("db"),
+						"params": data.Map{
+							"connection_string": data.String("***"),
+						},
+					},
+					"servers": data.Array{
+						data.Map{
+							"host":           data.String("localhost"),
+							"admin_password": data.String("***"),
+						},
+					},
+				})
+			})
+
+			Convey("Then the original map should be left untouched", func() {
+				So(m["token"], ShouldEqual, data.String("abcdef"))
+				conn, err := m.Get(data.MustCompilePath("uds.params.connection_string"))
+				So(err, ShouldBeNil)
+				So(conn, ShouldEqual, data.String("user:pass@localhost/db"))
+			})
+		})
+
+		Convey("When redacting a map having a null secret", func() {
+			r := Redact(schema, data.Map{"token": data.Null{}})
+
+			Convey("Then it should be kept as null", func() {
+				So(r, ShouldResemble, data.Map{"token": data.Null{}})
+			})
+		})
+
+		Convey("When redacting a map without secrets", func() {
+			m := data.Map{
+				"uds": data.Map{
+					"type": data.String("fs"),
+					"params": data.Map{
+						"dir": data.String("/tmp"),
+					},
+				},
+			}
+
+			Convey("Then it should be the same as the original map", func() {
+				So(Redact(schema, m), ShouldResemble, m)
+			})
+		})
+	})
+}
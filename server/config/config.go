@@ -1,9 +1,13 @@
 package config
 
 import (
+	"bytes"
 	"fmt"
 	"github.com/xeipuuv/gojsonschema"
 	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"gopkg.in/yaml.v2"
+	"io/ioutil"
+	"path/filepath"
 )
 
 // Config is a root of configuration trees.
@@ -22,6 +26,9 @@ type Config struct {
 
 	// Logging section has parameters related to logging.
 	Logging *Logging
+
+	// Plugins section has a list of plugins loaded on startup.
+	Plugins *Plugins
 }
 
 var (
@@ -31,11 +38,13 @@ var (
 		"network": %v,
 		"topologies": %v,
 		"storage": %v,
-		"logging": %v
+		"logging": %v,
+		"plugins": %v
 	},
 	"additionalProperties": false
-}`, networkSchemaString, topologiesSchemaString, storageSchemaString, loggingSchemaString)
-	rootSchema *gojsonschema.Schema
+}`, networkSchemaString, topologiesSchemaString, storageSchemaString, loggingSchemaString, pluginsSchemaString)
+	rootSchema    *gojsonschema.Schema
+	rootSchemaMap data.Map
 )
 
 func init() {
@@ -44,18 +53,26 @@ func init() {
 		panic(err)
 	}
 	rootSchema = s
+	rootSchemaMap = mustParseJSONMap(rootSchemaString)
 }
 
 // New creates a new config struct from JSON-style parameters.
 func New(m data.Map) (*Config, error) {
+	m = m.Copy()
+	ApplyDefaults(rootSchemaMap, m)
 	if err := validate(rootSchema, m); err != nil {
 		return nil, err
 	}
+	plugins := newPlugins(mustAsMap(getWithDefault(m, "plugins", data.Map{})))
+	if err := plugins.verifyFiles(); err != nil {
+		return nil, err
+	}
 	return &Config{
 		Network:    newNetwork(mustAsMap(getWithDefault(m, "network", data.Map{}))),
 		Topologies: newTopologies(mustAsMap(getWithDefault(m, "topologies", data.Map{}))),
 		Storage:    newStorage(mustAsMap(getWithDefault(m, "storage", data.Map{}))),
 		Logging:    newLogging(mustAsMap(getWithDefault(m, "logging", data.Map{}))),
+		Plugins:    plugins,
 	}, nil
 }
 
@@ -66,7 +83,45 @@ func (c *Config) ToMap() data.Map {
 		"topologies": c.Topologies.ToMap(),
 		"storage":    c.Storage.ToMap(),
 		"logging":    c.Logging.ToMap(),
+		"plugins":    c.Plugins.ToMap(),
 	}
 }
 
-// TODO: Add FromJSON or FromYAML if necessary
+// LoadFile reads a config file and creates a new config struct from it. A
+// file having ".toml" extension is parsed as TOML, a file having ".json5"
+// extension is parsed by data.ParseJSON5, and other files are parsed as
+// YAML.
+func LoadFile(path string) (*Config, error) {
+	in, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read the config file %v: %v", path, err)
+	}
+
+	var m data.Map
+	switch filepath.Ext(path) {
+	case ".toml":
+		m, err = ParseTOML(bytes.NewReader(in))
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse the config file %v: %v", path, err)
+		}
+	case ".json5":
+		v, err := data.ParseJSON5(in)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse the config file %v: %v", path, err)
+		}
+		m, err = data.AsMap(v)
+		if err != nil {
+			return nil, fmt.Errorf("the config file %v must have an object: %v", path, err)
+		}
+	default:
+		var yml map[string]interface{}
+		if err := yaml.Unmarshal(in, &yml); err != nil {
+			return nil, fmt.Errorf("cannot parse the config file %v: %v", path, err)
+		}
+		m, err = data.NewMap(yml)
+		if err != nil {
+			return nil, fmt.Errorf("the config file %v has invalid values: %v", path, err)
+		}
+	}
+	return New(m)
+}
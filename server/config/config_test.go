@@ -1,6 +1,9 @@
 package config
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
 	. "github.com/smartystreets/goconvey/convey"
@@ -33,6 +36,7 @@ func TestConfig(t *testing.T) {
 				So(c.Topologies["test1"].Name, ShouldEqual, "test1")
 				So(c.Topologies["test2"].BQLFile, ShouldEqual, "/path/to/hoge.bql")
 				So(c.Logging.Target, ShouldEqual, "stdout")
+				So(c.Plugins.Paths, ShouldBeEmpty)
 			})
 		})
 
@@ -80,6 +84,9 @@ func TestConfigToMap(t *testing.T) {
 				LogDestinationlessTuples: true,
 				SummarizeDroppedTuples:   true,
 			},
+			Plugins: &Plugins{
+				Paths: []string{"/path/to/plugin.so"},
+			},
 		}
 		Convey("When convert to data.Map", func() {
 			ac := c.ToMap()
@@ -111,9 +118,58 @@ func TestConfigToMap(t *testing.T) {
 						"log_destinationless_tuples": data.True,
 						"summarize_dropped_tuples":   data.True,
 					},
+					"plugins": data.Map{
+						"paths":        data.Array{data.String("/path/to/plugin.so")},
+						"verify_files": data.False,
+					},
 				}
 				So(ac, ShouldResemble, ex)
 			})
 		})
 	})
 }
+
+func TestLoadFile(t *testing.T) {
+	Convey("Given a JSON5 config file", t, func() {
+		dir, err := ioutil.TempDir("", "sensorbee_config_test")
+		So(err, ShouldBeNil)
+		Reset(func() {
+			os.RemoveAll(dir)
+		})
+		path := filepath.Join(dir, "sensorbee.json5")
+
+		Convey("When the config is valid", func() {
+			So(ioutil.WriteFile(path, []byte(`{
+	// comments and trailing commas are allowed
+	network: {listen_on: ":12345",},
+	logging: {target: "stdout"},
+}`), 0644), ShouldBeNil)
+			c, err := LoadFile(path)
+
+			Convey("Then it should have given parameters", func() {
+				So(err, ShouldBeNil)
+				So(c.Network.ListenOn, ShouldEqual, ":12345")
+				So(c.Logging.Target, ShouldEqual, "stdout")
+			})
+		})
+
+		Convey("When the config violates the schema", func() {
+			So(ioutil.WriteFile(path, []byte(`{network: {listen_on: 1}}`), 0644), ShouldBeNil)
+			_, err := LoadFile(path)
+
+			Convey("Then it should be invalid", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When the config isn't an object", func() {
+			So(ioutil.WriteFile(path, []byte(`[1, 2,]`), 0644), ShouldBeNil)
+			_, err := LoadFile(path)
+
+			Convey("Then it should be invalid", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "must have an object")
+			})
+		})
+	})
+}
@@ -0,0 +1,117 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/xeipuuv/gojsonschema"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// Plugins has configuration parameters for loading external plugins
+// providing UDFs, sources, sinks, and so on.
+type Plugins struct {
+	// Paths is a list of plugins to be loaded. Each entry can be a path to a
+	// shared object having ".so" extension or a package name.
+	Paths []string `json:"paths" yaml:"paths"`
+
+	// VerifyFiles controls whether the existence of shared objects listed in
+	// Paths is checked when the config is loaded. Package names aren't
+	// checked. The default is false.
+	VerifyFiles bool `json:"verify_files" yaml:"verify_files"`
+}
+
+var (
+	pluginsSchemaString = `{
+	"type": "object",
+	"properties": {
+		"paths": {
+			"type": "array",
+			"items": {
+				"type": "string",
+				"minLength": 1
+			},
+			"default": []
+		},
+		"verify_files": {
+			"type": "boolean",
+			"default": false
+		}
+	},
+	"additionalProperties": false
+}`
+	pluginsSchema    *gojsonschema.Schema
+	pluginsSchemaMap data.Map
+)
+
+func init() {
+	s, err := gojsonschema.NewSchema(gojsonschema.NewStringLoader(pluginsSchemaString))
+	if err != nil {
+		panic(err)
+	}
+	pluginsSchema = s
+	pluginsSchemaMap = mustParseJSONMap(pluginsSchemaString)
+}
+
+// NewPlugins creates a Plugins config parameters from a given map.
+func NewPlugins(m data.Map) (*Plugins, error) {
+	m = m.Copy()
+	ApplyDefaults(pluginsSchemaMap, m)
+	if err := validate(pluginsSchema, m); err != nil {
+		return nil, err
+	}
+	p := newPlugins(m)
+	if err := p.verifyFiles(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// newPlugins creates a Plugins from a map whose default values have already
+// been filled by ApplyDefaults.
+func newPlugins(m data.Map) *Plugins {
+	p := &Plugins{
+		Paths:       []string{},
+		VerifyFiles: mustToBool(getWithDefault(m, "verify_files", data.False)),
+	}
+	if paths, err := data.AsArray(getWithDefault(m, "paths", data.Array{})); err == nil {
+		for _, v := range paths {
+			p.Paths = append(p.Paths, mustAsString(v))
+		}
+	}
+	return p
+}
+
+// verifyFiles checks if all shared objects in Paths exist when VerifyFiles
+// is true.
+func (p *Plugins) verifyFiles() error {
+	if !p.VerifyFiles {
+		return nil
+	}
+	for i, path := range p.Paths {
+		if filepath.Ext(path) != ".so" {
+			continue
+		}
+		info, err := os.Stat(path)
+		if err != nil {
+			return fmt.Errorf("plugins.paths[%v]: cannot find the plugin: %v", i, err)
+		}
+		if info.IsDir() {
+			return fmt.Errorf("plugins.paths[%v]: the plugin is a directory: %v", i, path)
+		}
+	}
+	return nil
+}
+
+// ToMap returns plugins config information as data.Map.
+func (p *Plugins) ToMap() data.Map {
+	paths := make(data.Array, len(p.Paths))
+	for i, path := range p.Paths {
+		paths[i] = data.String(path)
+	}
+	return data.Map{
+		"paths":        paths,
+		"verify_files": data.Bool(p.VerifyFiles),
+	}
+}
@@ -0,0 +1,108 @@
+package config
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestPlugins(t *testing.T) {
+	Convey("Given a JSON config for plugins section", t, func() {
+		Convey("When the config is valid", func() {
+			p, err := NewPlugins(toMap(`{"paths":["/path/to/udf.so","github.com/user/plugin"]}`))
+			So(err, ShouldBeNil)
+
+			Convey("Then it should have given parameters", func() {
+				So(p.Paths, ShouldResemble, []string{"/path/to/udf.so", "github.com/user/plugin"})
+				So(p.VerifyFiles, ShouldBeFalse)
+			})
+
+			Convey("Then ToMap should return the same parameters", func() {
+				p2, err := NewPlugins(p.ToMap())
+				So(err, ShouldBeNil)
+				So(p2, ShouldResemble, p)
+			})
+		})
+
+		Convey("When the config is empty", func() {
+			p, err := NewPlugins(toMap(`{}`))
+
+			Convey("Then it should have default values", func() {
+				So(err, ShouldBeNil)
+				So(p.Paths, ShouldBeEmpty)
+				So(p.VerifyFiles, ShouldBeFalse)
+			})
+		})
+
+		Convey("When the config has an entry which isn't a string", func() {
+			_, err := NewPlugins(toMap(`{"paths":["/path/to/udf.so",1]}`))
+
+			Convey("Then it should be invalid", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When the config has an empty entry", func() {
+			_, err := NewPlugins(toMap(`{"paths":[""]}`))
+
+			Convey("Then it should be invalid", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When paths isn't an array", func() {
+			_, err := NewPlugins(toMap(`{"paths":"/path/to/udf.so"}`))
+
+			Convey("Then it should be invalid", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When the config has an undefined field", func() {
+			_, err := NewPlugins(toMap(`{"path":["/path/to/udf.so"]}`))
+
+			Convey("Then it should be invalid", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+
+	Convey("Given an existing shared object", t, func() {
+		dir, err := ioutil.TempDir("", "sensorbee_plugins_test")
+		So(err, ShouldBeNil)
+		Reset(func() {
+			os.RemoveAll(dir)
+		})
+		so := filepath.Join(dir, "udf.so")
+		So(ioutil.WriteFile(so, nil, 0644), ShouldBeNil)
+
+		Convey("When verifying files of plugins having it and a package name", func() {
+			p, err := NewPlugins(toMap(`{"paths":["` + so + `","github.com/user/plugin"],"verify_files":true}`))
+
+			Convey("Then it should succeed", func() {
+				So(err, ShouldBeNil)
+				So(p.VerifyFiles, ShouldBeTrue)
+			})
+		})
+
+		Convey("When verifying files of plugins having a missing shared object", func() {
+			missing := filepath.Join(dir, "missing.so")
+			_, err := NewPlugins(toMap(`{"paths":["` + so + `","` + missing + `"],"verify_files":true}`))
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldStartWith, "plugins.paths[1]: ")
+			})
+		})
+
+		Convey("When the missing shared object isn't verified", func() {
+			_, err := NewPlugins(toMap(`{"paths":["` + filepath.Join(dir, "missing.so") + `"]}`))
+
+			Convey("Then it should succeed", func() {
+				So(err, ShouldBeNil)
+			})
+		})
+	})
+}
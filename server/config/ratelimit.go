@@ -0,0 +1,75 @@
+package config
+
+import (
+	"github.com/xeipuuv/gojsonschema"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// RateLimit has parameters to cap the rate of tuples ingested by a source.
+// It's an optional "rate_limit" block in the configuration of a source.
+type RateLimit struct {
+	// EventsPerSecond is the maximum number of tuples a source emits per
+	// second. 0, the default, means no limit.
+	EventsPerSecond int64 `json:"events_per_second" yaml:"events_per_second"`
+
+	// Burst is the maximum number of tuples a source can emit at once
+	// exceeding EventsPerSecond. 0, the default, means EventsPerSecond.
+	Burst int64 `json:"burst" yaml:"burst"`
+}
+
+var (
+	rateLimitSchemaString = `{
+	"type": "object",
+	"properties": {
+		"events_per_second": {
+			"type": "integer",
+			"minimum": 0,
+			"default": 0
+		},
+		"burst": {
+			"type": "integer",
+			"minimum": 0,
+			"default": 0
+		}
+	},
+	"additionalProperties": false
+}`
+	rateLimitSchema    *gojsonschema.Schema
+	rateLimitSchemaMap data.Map
+)
+
+func init() {
+	s, err := gojsonschema.NewSchema(gojsonschema.NewStringLoader(rateLimitSchemaString))
+	if err != nil {
+		panic(err)
+	}
+	rateLimitSchema = s
+	rateLimitSchemaMap = mustParseJSONMap(rateLimitSchemaString)
+}
+
+// NewRateLimit creates a RateLimit config parameters from a given map.
+func NewRateLimit(m data.Map) (*RateLimit, error) {
+	m = m.Copy()
+	ApplyDefaults(rateLimitSchemaMap, m)
+	if err := validate(rateLimitSchema, m); err != nil {
+		return nil, err
+	}
+	return newRateLimit(m), nil
+}
+
+// newRateLimit creates a RateLimit from a map whose default values have
+// already been filled by ApplyDefaults.
+func newRateLimit(m data.Map) *RateLimit {
+	return &RateLimit{
+		EventsPerSecond: mustToInt(getWithDefault(m, "events_per_second", data.Int(0))),
+		Burst:           mustToInt(getWithDefault(m, "burst", data.Int(0))),
+	}
+}
+
+// ToMap returns rate limit config information as data.Map.
+func (r *RateLimit) ToMap() data.Map {
+	return data.Map{
+		"events_per_second": data.Int(r.EventsPerSecond),
+		"burst":             data.Int(r.Burst),
+	}
+}
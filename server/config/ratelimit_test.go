@@ -0,0 +1,68 @@
+package config
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestRateLimit(t *testing.T) {
+	Convey("Given a JSON config for rate_limit block", t, func() {
+		Convey("When the config is valid", func() {
+			r, err := NewRateLimit(toMap(`{"events_per_second":100,"burst":10}`))
+			So(err, ShouldBeNil)
+
+			Convey("Then it should have given parameters", func() {
+				So(r.EventsPerSecond, ShouldEqual, 100)
+				So(r.Burst, ShouldEqual, 10)
+			})
+
+			Convey("Then ToMap should return the same parameters", func() {
+				r2, err := NewRateLimit(r.ToMap())
+				So(err, ShouldBeNil)
+				So(r2, ShouldResemble, r)
+			})
+		})
+
+		Convey("When the config is empty", func() {
+			r, err := NewRateLimit(toMap(`{}`))
+
+			Convey("Then it should have default values", func() {
+				So(err, ShouldBeNil)
+				So(r.EventsPerSecond, ShouldEqual, 0)
+				So(r.Burst, ShouldEqual, 0)
+			})
+		})
+
+		Convey("When the config has a negative rate", func() {
+			_, err := NewRateLimit(toMap(`{"events_per_second":-1}`))
+
+			Convey("Then it should be invalid", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When the config has a negative burst", func() {
+			_, err := NewRateLimit(toMap(`{"events_per_second":10,"burst":-5}`))
+
+			Convey("Then it should be invalid", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When the config has a non-integer rate", func() {
+			_, err := NewRateLimit(toMap(`{"events_per_second":1.5}`))
+
+			Convey("Then it should be invalid", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When the config has an undefined field", func() {
+			_, err := NewRateLimit(toMap(`{"undefined":"invalid"}`))
+
+			Convey("Then it should be invalid", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
@@ -24,6 +24,9 @@ var (
 	"type": "object",
 	"properties": {
 		"uds": {
+			"default": {
+				"type": "in_memory"
+			},
 			"anyOf": [
 				{
 					"type": "object",
@@ -81,7 +84,8 @@ var (
 	},
 	"additionalProperties": false
 }`
-	storageSchema *gojsonschema.Schema
+	storageSchema    *gojsonschema.Schema
+	storageSchemaMap data.Map
 	// TODO: add patterns for filepath validation
 )
 
@@ -91,16 +95,21 @@ func init() {
 		panic(err)
 	}
 	storageSchema = s
+	storageSchemaMap = mustParseJSONMap(storageSchemaString)
 }
 
 // NewStorage creates a Storage config parameters from a given map.
 func NewStorage(m data.Map) (*Storage, error) {
+	m = m.Copy()
+	ApplyDefaults(storageSchemaMap, m)
 	if err := validate(storageSchema, m); err != nil {
 		return nil, err
 	}
 	return newStorage(m), nil
 }
 
+// newStorage creates a Storage from a map whose default values have already
+// been filled by ApplyDefaults.
 func newStorage(m data.Map) *Storage {
 	udsParams := getWithDefault(m, "uds.params", data.Map{})
 	if udsParams.Type() == data.TypeNull {
@@ -112,7 +121,7 @@ func newStorage(m data.Map) *Storage {
 
 	return &Storage{
 		UDS: UDSStorage{
-			Type:   mustAsString(getWithDefault(m, "uds.type", data.String("in_memory"))),
+			Type:   mustAsString(getWithDefault(m, "uds.type", data.Null{})),
 			Params: mustAsMap(udsParams),
 		},
 	}
@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
 	"testing"
 )
 
@@ -165,3 +166,51 @@ func TestUDSStorageFS(t *testing.T) {
 		})
 	})
 }
+
+func TestStorageValidationErrors(t *testing.T) {
+	Convey("Given a JSON config for storage section missing a required field", t, func() {
+		m := toMap(`{"uds":{"type":"fs","params":{"temp_dir":"/tmp"}}}`)
+
+		Convey("When creating a Storage from it", func() {
+			_, err := NewStorage(m)
+
+			Convey("Then the error should expose each violation", func() {
+				So(err, ShouldNotBeNil)
+				So(err, ShouldHaveSameTypeAs, ValidationErrors{})
+				errs := err.(ValidationErrors)
+
+				var missing *ValidationError
+				for i := range errs {
+					if errs[i].Type == "required" {
+						missing = &errs[i]
+					}
+				}
+				So(missing, ShouldNotBeNil)
+				So(missing.Field, ShouldEqual, "uds.params.dir")
+				So(missing.Value, ShouldResemble, data.Null{})
+				So(missing.Error(), ShouldContainSubstring, "dir")
+
+				Convey("And ValidateAll should report the same violation", func() {
+					all := ValidateAll(storageSchema, m)
+					So(all, ShouldContain, *missing)
+				})
+			})
+
+			Convey("Then the error message should list the violations", func() {
+				So(err.Error(), ShouldStartWith, "validation errors:\n- ")
+			})
+		})
+	})
+
+	Convey("Given a JSON config for storage section having an undefined field", t, func() {
+		_, err := NewStorage(toMap(`{"undefined":"invalid"}`))
+
+		Convey("Then the error should have the keyword of the violation", func() {
+			So(err, ShouldNotBeNil)
+			errs := err.(ValidationErrors)
+			So(len(errs), ShouldEqual, 1)
+			So(errs[0].Type, ShouldEqual, "additional_property_not_allowed")
+			So(errs[0].Field, ShouldEqual, "(root)")
+		})
+	})
+}
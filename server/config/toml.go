@@ -0,0 +1,20 @@
+package config
+
+import (
+	"github.com/BurntSushi/toml"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"io"
+)
+
+// ParseTOML reads a config written in TOML from r and converts it to a
+// data.Map so that it can be passed to New and other constructors. Types
+// are mapped to data.Value in the same way as JSON and YAML: integers are
+// converted to Int, floats to Float, tables to Map, arrays to Array, and
+// datetimes to Timestamp.
+func ParseTOML(r io.Reader) (data.Map, error) {
+	var m map[string]interface{}
+	if _, err := toml.DecodeReader(r, &m); err != nil {
+		return nil, err
+	}
+	return data.NewMap(m)
+}
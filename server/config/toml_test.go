@@ -0,0 +1,71 @@
+package config
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"strings"
+	"testing"
+)
+
+func TestParseTOML(t *testing.T) {
+	Convey("Given a TOML config having nested tables", t, func() {
+		in := `
+# comment
+name = "test"
+port = 15601
+ratio = 0.5
+enabled = true
+tags = ["a", "b"]
+
+[storage.uds]
+type = "fs"
+
+[storage.uds.params]
+dir = "/tmp/uds"
+`
+
+		Convey("When parsing it", func() {
+			m, err := ParseTOML(strings.NewReader(in))
+			So(err, ShouldBeNil)
+
+			Convey("Then it should return the expected map", func() {
+				So(m, ShouldResemble, data.Map{
+					"name":    data.String("test"),
+					"port":    data.Int(15601),
+					"ratio":   data.Float(0.5),
+					"enabled": data.Bool(true),
+					"tags":    data.Array{data.String("a"), data.String("b")},
+					"storage": data.Map{
+						"uds": data.Map{
+							"type": data.String("fs"),
+							"params": data.Map{
+								"dir": data.String("/tmp/uds"),
+							},
+						},
+					},
+				})
+			})
+
+			Convey("Then its storage section should be accepted by NewStorage", func() {
+				s, err := NewStorage(mustAsMap(m["storage"]))
+				So(err, ShouldBeNil)
+				So(s.UDS.Type, ShouldEqual, "fs")
+				So(s.UDS.Params, ShouldResemble, data.Map{
+					"dir": data.String("/tmp/uds"),
+				})
+			})
+		})
+	})
+
+	Convey("Given an invalid TOML config", t, func() {
+		in := `[storage`
+
+		Convey("When parsing it", func() {
+			_, err := ParseTOML(strings.NewReader(in))
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
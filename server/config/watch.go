@@ -0,0 +1,101 @@
+package config
+
+import (
+	"github.com/fsnotify/fsnotify"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// configWatchDebounce is the time WatchConfig waits after the last change of
+// a file before reloading it so that writes done in a row, e.g. by an editor,
+// result in only one reload.
+const configWatchDebounce = 100 * time.Millisecond
+
+// ConfigWatcher watches a config file. It's created by WatchConfig.
+type ConfigWatcher struct {
+	watcher *fsnotify.Watcher
+	done    chan struct{}
+	wg      sync.WaitGroup
+
+	stopOnce sync.Once
+	stopErr  error
+}
+
+// WatchConfig watches the config file at path and calls onChange every time
+// the file is changed. The file is loaded by LoadFile and onChange receives
+// its results, that is, a new config struct or an error when the file cannot
+// be read or has an invalid config. An error reported by the underlying file
+// system watcher is also passed to onChange with a nil config. onChange is
+// called from a single goroutine.
+//
+// The directory containing the file is watched instead of the file itself so
+// that the file can be replaced by renaming another file, which is how many
+// editors save files. The caller has to call Stop to release the resources.
+func WatchConfig(path string, onChange func(*Config, error)) (*ConfigWatcher, error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+	w, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+	if err := w.Add(filepath.Dir(abs)); err != nil {
+		w.Close()
+		return nil, err
+	}
+
+	cw := &ConfigWatcher{
+		watcher: w,
+		done:    make(chan struct{}),
+	}
+	cw.wg.Add(1)
+	go cw.run(abs, onChange)
+	return cw, nil
+}
+
+func (cw *ConfigWatcher) run(path string, onChange func(*Config, error)) {
+	defer cw.wg.Done()
+
+	var reload <-chan time.Time
+	for {
+		select {
+		case ev, ok := <-cw.watcher.Events:
+			if !ok {
+				return
+			}
+			if filepath.Clean(ev.Name) != path || ev.Op&(fsnotify.Write|fsnotify.Create) == 0 {
+				continue
+			}
+			// postpone the reload until the file stops changing
+			reload = time.After(configWatchDebounce)
+
+		case <-reload:
+			reload = nil
+			onChange(LoadFile(path))
+
+		case err, ok := <-cw.watcher.Errors:
+			if !ok {
+				return
+			}
+			onChange(nil, err)
+
+		case <-cw.done:
+			return
+		}
+	}
+}
+
+// Stop stops watching the config file. onChange won't be called after Stop
+// returns. Stop can be called more than once and returns the same error every
+// time. Because Stop waits for onChange to return, it must not be called from
+// onChange; call it from another goroutine instead.
+func (cw *ConfigWatcher) Stop() error {
+	cw.stopOnce.Do(func() {
+		cw.stopErr = cw.watcher.Close()
+		close(cw.done)
+	})
+	cw.wg.Wait()
+	return cw.stopErr
+}
@@ -0,0 +1,86 @@
+package config
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestWatchConfig(t *testing.T) {
+	Convey("Given a config file", t, func() {
+		dir, err := ioutil.TempDir("", "sensorbee_config_watch_test")
+		So(err, ShouldBeNil)
+		Reset(func() {
+			os.RemoveAll(dir)
+		})
+		path := filepath.Join(dir, "sensorbee.yaml")
+		So(ioutil.WriteFile(path, []byte("logging:\n  min_log_level: info\n"), 0644), ShouldBeNil)
+
+		Convey("When watching it", func() {
+			type result struct {
+				conf *Config
+				err  error
+			}
+			ch := make(chan result, 10)
+			w, err := WatchConfig(path, func(c *Config, err error) {
+				ch <- result{c, err}
+			})
+			So(err, ShouldBeNil)
+			Reset(func() {
+				w.Stop()
+			})
+
+			Convey("Then rewriting the file should call the callback with the new config", func() {
+				for i := 0; i < 3; i++ {
+					So(ioutil.WriteFile(path, []byte("logging:\n  min_log_level: warn\n"), 0644), ShouldBeNil)
+				}
+
+				select {
+				case r := <-ch:
+					So(r.err, ShouldBeNil)
+					So(r.conf.Logging.MinLogLevel, ShouldEqual, "warn")
+				case <-time.After(5 * time.Second):
+					So("the callback wasn't called", ShouldBeEmpty)
+				}
+
+				Convey("And successive writes should be debounced", func() {
+					select {
+					case <-ch:
+						So("the callback was called twice", ShouldBeEmpty)
+					case <-time.After(3 * configWatchDebounce):
+					}
+				})
+			})
+
+			Convey("Then writing an invalid config should call the callback with an error", func() {
+				So(ioutil.WriteFile(path, []byte("logging:\n  min_log_level: verbose\n"), 0644), ShouldBeNil)
+
+				select {
+				case r := <-ch:
+					So(r.err, ShouldNotBeNil)
+					So(r.conf, ShouldBeNil)
+				case <-time.After(5 * time.Second):
+					So("the callback wasn't called", ShouldBeEmpty)
+				}
+			})
+
+			Convey("Then stopping it twice should succeed", func() {
+				So(w.Stop(), ShouldBeNil)
+				So(w.Stop(), ShouldBeNil)
+			})
+
+			Convey("Then writing another file in the directory shouldn't call the callback", func() {
+				So(ioutil.WriteFile(filepath.Join(dir, "other.yaml"), []byte("a: 1\n"), 0644), ShouldBeNil)
+
+				select {
+				case <-ch:
+					So("the callback was called", ShouldBeEmpty)
+				case <-time.After(3 * configWatchDebounce):
+				}
+			})
+		})
+	})
+}
@@ -27,6 +27,10 @@ type Context struct {
 	// logger is used by core.Context, not for the server's Context. This logger
 	// can be shared with jasco.Context.
 	logger *logrus.Logger
+	// logs sends entries written to logger to clients of /logs.
+	logs *logBroadcaster
+	// queries has SELECT queries streaming their results.
+	queries *queryRegistry
 }
 
 // SetTopologyRegistry sets the registry of topologies to this context. This
@@ -102,9 +106,14 @@ func SetUpContextAndRouter(prefix string, jascoRoot *web.Router, gvariables *Con
 		return nil, err
 	}
 
+	lb := logBroadcasterOf(gvars.Logger)
+	qr := newQueryRegistry()
+
 	router := jascoRoot.Subrouter(Context{}, "/")
 	router.Middleware(func(c *Context, rw web.ResponseWriter, req *web.Request, next web.NextMiddlewareFunc) {
 		c.logger = gvars.Logger
+		c.logs = lb
+		c.queries = qr
 		c.udsStorage = udsStorage
 		c.topologies = gvars.Topologies
 		c.config = gvars.Config
@@ -0,0 +1,140 @@
+package server
+
+import (
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"sync"
+
+	"github.com/gocraft/web"
+	"github.com/sirupsen/logrus"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// logBroadcaster is a logrus.Hook sending log entries to subscribers of
+// /logs. An entry is dropped for a subscriber which cannot keep up with
+// logs so that logging never blocks.
+type logBroadcaster struct {
+	m    sync.Mutex
+	subs map[chan data.Map]struct{}
+}
+
+func newLogBroadcaster() *logBroadcaster {
+	return &logBroadcaster{
+		subs: map[chan data.Map]struct{}{},
+	}
+}
+
+var logBroadcasterMutex sync.Mutex
+
+// logBroadcasterOf returns the logBroadcaster added to l as a hook. It adds
+// a new one when l doesn't have it yet so that setting up routers multiple
+// times with the same logger doesn't add hooks repeatedly.
+func logBroadcasterOf(l *logrus.Logger) *logBroadcaster {
+	logBroadcasterMutex.Lock()
+	defer logBroadcasterMutex.Unlock()
+	for _, h := range l.Hooks[logrus.PanicLevel] {
+		if b, ok := h.(*logBroadcaster); ok {
+			return b
+		}
+	}
+	b := newLogBroadcaster()
+	l.AddHook(b)
+	return b
+}
+
+func (b *logBroadcaster) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+func (b *logBroadcaster) Fire(e *logrus.Entry) error {
+	b.m.Lock()
+	defer b.m.Unlock()
+	if len(b.subs) == 0 {
+		return nil
+	}
+
+	m := make(data.Map, len(e.Data)+3)
+	for k, v := range e.Data {
+		if err, ok := v.(error); ok {
+			v = err.Error()
+		}
+		if dv, err := data.NewValue(v); err == nil {
+			m[k] = dv
+		} else {
+			m[k] = data.String(fmt.Sprint(v))
+		}
+	}
+	m["time"] = data.Timestamp(e.Time)
+	m["level"] = data.String(e.Level.String())
+	m["msg"] = data.String(e.Message)
+
+	for ch := range b.subs {
+		select {
+		case ch <- m:
+		default:
+		}
+	}
+	return nil
+}
+
+func (b *logBroadcaster) subscribe() chan data.Map {
+	ch := make(chan data.Map, 1024)
+	b.m.Lock()
+	defer b.m.Unlock()
+	b.subs[ch] = struct{}{}
+	return ch
+}
+
+func (b *logBroadcaster) unsubscribe(ch chan data.Map) {
+	b.m.Lock()
+	defer b.m.Unlock()
+	delete(b.subs, ch)
+}
+
+type logs struct {
+	*APIContext
+}
+
+func setUpLogsRouter(prefix string, router *web.Router) {
+	root := router.Subrouter(logs{}, "")
+	root.Get("/logs", (*logs).Tail)
+}
+
+// Tail streams log entries written after the request as a multipart
+// response having a JSON object for each entry until the client closes the
+// connection.
+func (lc *logs) Tail(rw web.ResponseWriter, req *web.Request) {
+	ch := lc.logs.subscribe()
+	defer lc.logs.unsubscribe(ch)
+
+	mw := multipart.NewWriter(rw)
+	rw.Header().Set("Content-Type", fmt.Sprintf(`multipart/mixed; boundary="%v"`, mw.Boundary()))
+	rw.WriteHeader(http.StatusOK)
+	rw.Flush()
+
+	header := textproto.MIMEHeader{}
+	header.Add("Content-Type", "application/json")
+	closed := rw.CloseNotify()
+	for {
+		var m data.Map
+		select {
+		case <-closed:
+			return
+		case m = <-ch:
+		}
+
+		js := m.String()
+		header.Set("Content-Length", fmt.Sprint(len(js)))
+		w, err := mw.CreatePart(header)
+		if err != nil {
+			return
+		}
+		if _, err := io.WriteString(w, js); err != nil {
+			return
+		}
+		rw.Flush()
+	}
+}
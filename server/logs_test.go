@@ -0,0 +1,84 @@
+package server_test
+
+import (
+	"encoding/json"
+	"github.com/sirupsen/logrus"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/pfnet/jasco.v1"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"gopkg.in/sensorbee/sensorbee.v0/server"
+	"gopkg.in/sensorbee/sensorbee.v0/server/config"
+	"gopkg.in/sensorbee/sensorbee.v0/server/testutil"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"testing"
+)
+
+func TestLogsTail(t *testing.T) {
+	// the handler streams logs, so it needs a real HTTP server
+	orig := testutil.TestAPIWithRealHTTPServer
+	testutil.TestAPIWithRealHTTPServer = true
+	defer func() {
+		testutil.TestAPIWithRealHTTPServer = orig
+	}()
+
+	Convey("Given an API server", t, func() {
+		s := testutil.NewServer()
+		Reset(s.Close)
+
+		Convey("When tailing /logs", func() {
+			res, err := http.Get(s.URL() + "/api/v1/logs")
+			So(err, ShouldBeNil)
+			Reset(func() {
+				res.Body.Close()
+			})
+			So(res.StatusCode, ShouldEqual, http.StatusOK)
+
+			mediaType, params, err := mime.ParseMediaType(res.Header.Get("Content-Type"))
+			So(err, ShouldBeNil)
+			So(mediaType, ShouldEqual, "multipart/mixed")
+			mr := multipart.NewReader(res.Body, params["boundary"])
+
+			Convey("Then it should receive entries logged after the request", func() {
+				s.Logger().WithField("node", "n1").Info("hello")
+
+				part, err := mr.NextPart()
+				So(err, ShouldBeNil)
+				So(part.Header.Get("Content-Type"), ShouldEqual, "application/json")
+
+				// a part doesn't end until the next entry is written, so only
+				// one JSON object is decoded from it
+				var m map[string]interface{}
+				So(json.NewDecoder(part).Decode(&m), ShouldBeNil)
+				So(m["msg"], ShouldEqual, "hello")
+				So(m["level"], ShouldEqual, "info")
+				So(m["node"], ShouldEqual, "n1")
+				So(m["time"], ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestSetUpContextAndRouterLogHook(t *testing.T) {
+	Convey("Given global variables of a server", t, func() {
+		c, err := config.New(data.Map{})
+		So(err, ShouldBeNil)
+		gvars, err := server.SetUpContextGlobalVariables(c)
+		So(err, ShouldBeNil)
+		Reset(func() {
+			gvars.LogDestination.Close()
+		})
+
+		Convey("When setting up routers twice", func() {
+			for i := 0; i < 2; i++ {
+				_, err := server.SetUpContextAndRouter("/", jasco.New("/", nil), gvars)
+				So(err, ShouldBeNil)
+			}
+
+			Convey("Then the logger should only have one hook for /logs", func() {
+				So(gvars.Logger.Hooks[logrus.InfoLevel], ShouldHaveLength, 1)
+			})
+		})
+	})
+}
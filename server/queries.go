@@ -0,0 +1,75 @@
+package server
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"sync"
+)
+
+// QueryIDHeader is the name of the header having the ID of a SELECT query
+// streaming its results. The ID can be used to stop the query by
+// DELETE /topologies/:topologyName/queries/:queryID.
+const QueryIDHeader = "SensorBee-Query-ID"
+
+// queryRegistry manages SELECT queries which are streaming their results so
+// that they can be stopped by another request.
+type queryRegistry struct {
+	m       sync.Mutex
+	queries map[string]map[string]chan struct{}
+}
+
+func newQueryRegistry() *queryRegistry {
+	return &queryRegistry{
+		queries: map[string]map[string]chan struct{}{},
+	}
+}
+
+// register adds a new query running on the topology. It returns the ID of
+// the query and a channel closed when the query is stopped by stop. The
+// caller must call unregister when the query finishes.
+func (r *queryRegistry) register(topology string) (string, <-chan struct{}, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", nil, err
+	}
+	id := hex.EncodeToString(b)
+	ch := make(chan struct{})
+
+	r.m.Lock()
+	defer r.m.Unlock()
+	qs, ok := r.queries[topology]
+	if !ok {
+		qs = map[string]chan struct{}{}
+		r.queries[topology] = qs
+	}
+	qs[id] = ch
+	return id, ch, nil
+}
+
+// unregister removes the query from the registry.
+func (r *queryRegistry) unregister(topology, id string) {
+	r.m.Lock()
+	defer r.m.Unlock()
+	qs := r.queries[topology]
+	delete(qs, id)
+	if len(qs) == 0 {
+		delete(r.queries, topology)
+	}
+}
+
+// stop stops the query. It returns false when the query isn't running.
+func (r *queryRegistry) stop(topology, id string) bool {
+	r.m.Lock()
+	defer r.m.Unlock()
+	qs := r.queries[topology]
+	ch, ok := qs[id]
+	if !ok {
+		return false
+	}
+	close(ch)
+	delete(qs, id)
+	if len(qs) == 0 {
+		delete(r.queries, topology)
+	}
+	return true
+}
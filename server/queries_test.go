@@ -0,0 +1,69 @@
+package server
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func isClosed(ch <-chan struct{}) bool {
+	select {
+	case <-ch:
+		return true
+	default:
+		return false
+	}
+}
+
+func TestQueryRegistry(t *testing.T) {
+	Convey("Given a query registry having two queries", t, func() {
+		r := newQueryRegistry()
+		id1, stopped1, err := r.register("t1")
+		So(err, ShouldBeNil)
+		id2, stopped2, err := r.register("t1")
+		So(err, ShouldBeNil)
+
+		Convey("Then they should have different IDs", func() {
+			So(id1, ShouldNotBeBlank)
+			So(id2, ShouldNotEqual, id1)
+		})
+
+		Convey("When stopping one of them", func() {
+			So(r.stop("t1", id1), ShouldBeTrue)
+
+			Convey("Then only its channel should be closed", func() {
+				So(isClosed(stopped1), ShouldBeTrue)
+				So(isClosed(stopped2), ShouldBeFalse)
+			})
+
+			Convey("Then it cannot be stopped again", func() {
+				So(r.stop("t1", id1), ShouldBeFalse)
+			})
+
+			Convey("And unregistering it", func() {
+				r.unregister("t1", id1)
+
+				Convey("Then the other query should still be stoppable", func() {
+					So(r.stop("t1", id2), ShouldBeTrue)
+					So(r.queries, ShouldBeEmpty)
+				})
+			})
+		})
+
+		Convey("When stopping a query with a wrong topology name", func() {
+			Convey("Then it should fail", func() {
+				So(r.stop("t2", id1), ShouldBeFalse)
+				So(isClosed(stopped1), ShouldBeFalse)
+			})
+		})
+
+		Convey("When unregistering the queries", func() {
+			r.unregister("t1", id1)
+			r.unregister("t1", id2)
+
+			Convey("Then they cannot be stopped", func() {
+				So(r.stop("t1", id1), ShouldBeFalse)
+				So(r.queries, ShouldBeEmpty)
+			})
+		})
+	})
+}
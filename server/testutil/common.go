@@ -3,6 +3,7 @@ package testutil
 import (
 	"bytes"
 	"github.com/mattn/go-scan"
+	"github.com/sirupsen/logrus"
 	"gopkg.in/pfnet/jasco.v1"
 	"gopkg.in/sensorbee/sensorbee.v0/data"
 	"gopkg.in/sensorbee/sensorbee.v0/server"
@@ -28,6 +29,7 @@ type Server struct {
 		router     http.Handler
 		url        string
 	}
+	gvars *server.ContextGlobalVariables
 }
 
 // Close closes the server.
@@ -42,6 +44,11 @@ func (s *Server) URL() string {
 	return s.server.url
 }
 
+// Logger returns the logger shared by topologies of the server.
+func (s *Server) Logger() *logrus.Logger {
+	return s.gvars.Logger
+}
+
 // HTTPClient returns the HTTP client to send requests to the server.
 func (s *Server) HTTPClient() *http.Client {
 	if s.server.realServer != nil {
@@ -87,6 +94,7 @@ func NewServer() *Server {
 	if err != nil {
 		panic(err)
 	}
+	s.gvars = gvars
 	jascoRoot := jasco.New("/", nil)
 	root, err := server.SetUpContextAndRouter("/", jascoRoot, gvars)
 	if err != nil {
@@ -36,6 +36,7 @@ func setUpTopologiesRouter(prefix string, router *web.Router) {
 	root.Get(`/:topologyName`, (*topologies).Show)
 	root.Delete(`/:topologyName`, (*topologies).Destroy)
 	root.Post(`/:topologyName/queries`, (*topologies).Queries)
+	root.Delete(`/:topologyName/queries/:queryID`, (*topologies).StopQuery)
 	root.Get(`/:topologyName/wsqueries`, (*topologies).WebSocketQueries)
 
 	setUpSourcesRouter(prefix, root)
@@ -224,6 +225,25 @@ func (tc *topologies) Destroy(rw web.ResponseWriter, req *web.Request) {
 	}
 }
 
+// StopQuery stops a SELECT query streaming its results. The ID of the query
+// is sent in the SensorBee-Query-ID header of the response of the query.
+func (tc *topologies) StopQuery(rw web.ResponseWriter, req *web.Request) {
+	if tb := tc.fetchTopology(); tb == nil {
+		return
+	}
+
+	queryID := tc.PathParams().String("queryID", "")
+	tc.AddLogField("query_id", queryID)
+	if !tc.queries.stop(tc.topologyName, queryID) {
+		tc.Log().Error("The query is not running")
+		tc.RenderError(jasco.NewError(requestResourceNotFoundErrorCode,
+			"The query was not found", http.StatusNotFound, nil))
+		return
+	}
+	tc.Log().Info("Stopping the query")
+	tc.Render(map[string]interface{}{})
+}
+
 func (tc *topologies) Queries(rw web.ResponseWriter, req *web.Request) {
 	tb := tc.fetchTopology()
 	if tb == nil {
@@ -273,6 +293,9 @@ func (tc *topologies) Queries(rw web.ResponseWriter, req *web.Request) {
 		} else if stmt, ok := stmts[0].(parser.EvalStmt); ok {
 			tc.handleEvalStmt(rw, stmt, stmtStr)
 			return
+		} else if stmt, ok := stmts[0].(parser.ExplainStmt); ok {
+			tc.handleExplainStmt(rw, stmt, stmtStr)
+			return
 		}
 	}
 
@@ -336,6 +359,8 @@ func (tc *topologies) parseQueries(form data.Map) ([]interface{}, *jasco.Error)
 			dataReturningStmtIndex = len(stmts)
 		} else if _, ok := stmt.(parser.EvalStmt); ok {
 			dataReturningStmtIndex = len(stmts)
+		} else if _, ok := stmt.(parser.ExplainStmt); ok {
+			dataReturningStmtIndex = len(stmts)
 		}
 
 		stmts = append(stmts, stmt)
@@ -344,10 +369,10 @@ func (tc *topologies) parseQueries(form data.Map) ([]interface{}, *jasco.Error)
 
 	if dataReturningStmtIndex >= 0 {
 		if len(stmts) != 1 {
-			errMsg := "A SELECT or EVAL statement cannot be issued with other statements"
+			errMsg := "A SELECT, EVAL, or EXPLAIN statement cannot be issued with other statements"
 			tc.Log().Error(errMsg)
 			e := jasco.NewError(bqlStmtProcessingErrorCode, "Cannot process a statement", http.StatusBadRequest, nil)
-			e.Meta["error"] = "a SELECT or EVAL statement cannot be issued with other statements"
+			e.Meta["error"] = "a SELECT, EVAL, or EXPLAIN statement cannot be issued with other statements"
 			e.Meta["statement"] = fmt.Sprint(stmts[dataReturningStmtIndex])
 			return nil, e
 		}
@@ -389,6 +414,15 @@ func (tc *topologies) handleSelectUnionStmt(rw web.ResponseWriter, stmt parser.S
 		}
 	}()
 
+	queryID, stopped, err := tc.queries.register(tc.topologyName)
+	if err != nil {
+		tc.ErrLog(err).Error("Cannot register the query")
+		tc.RenderError(jasco.NewInternalServerError(err))
+		return
+	}
+	defer tc.queries.unregister(tc.topologyName, queryID)
+	tc.AddLogField("query_id", queryID)
+
 	conn, bufrw, err := rw.Hijack()
 	if err != nil {
 		tc.ErrLog(err).Error("Cannot hijack a connection")
@@ -420,6 +454,7 @@ func (tc *topologies) handleSelectUnionStmt(rw web.ResponseWriter, stmt parser.S
 	res := []string{
 		"HTTP/1.1 200 OK",
 		fmt.Sprintf(`Content-Type: multipart/mixed; boundary="%v"`, mw.Boundary()),
+		fmt.Sprintf("%v: %v", QueryIDHeader, queryID),
 		"\r\n",
 	}
 	if _, err := bufrw.WriteString(strings.Join(res, "\r\n")); err != nil {
@@ -447,6 +482,9 @@ func (tc *topologies) handleSelectUnionStmt(rw web.ResponseWriter, stmt parser.S
 			}
 			t = v
 			sent = true
+		case <-stopped:
+			tc.Log().Info("The query is stopped by a request")
+			return
 		case <-readPoll:
 			if sent {
 				sent = false
@@ -524,6 +562,22 @@ func (tc *topologies) handleEvalStmt(rw web.ResponseWriter, stmt parser.EvalStmt
 	})
 }
 
+func (tc *topologies) handleExplainStmt(rw web.ResponseWriter, stmt parser.ExplainStmt, stmtStr string) {
+	plan, err := stmt.Plan()
+	if err != nil {
+		tc.ErrLog(err).Error("Cannot process a statement")
+		e := jasco.NewError(bqlStmtProcessingErrorCode, "Cannot process a statement", http.StatusBadRequest, err)
+		e.Meta["error"] = err.Error()
+		e.Meta["statement"] = stmtStr
+		tc.RenderError(e)
+		return
+	}
+
+	tc.Render(map[string]interface{}{
+		"plan": plan,
+	})
+}
+
 // WebSocketQueries handles requests using WebSocket. A single WebSocket
 // connection can concurrently issue multiple requests including requests
 // containing a SELECT statement.
@@ -711,6 +765,9 @@ func (tc *topologies) processWebSocketMessage(conn *websocket.Conn, tb *bql.Topo
 			} else if stmt, ok := stmts[0].(parser.EvalStmt); ok {
 				w.handleEvalStmtWebSocket(conn, stmt, stmtStr)
 				return
+			} else if stmt, ok := stmts[0].(parser.ExplainStmt); ok {
+				w.handleExplainStmtWebSocket(conn, stmt, stmtStr)
+				return
 			}
 		}
 
@@ -876,3 +933,22 @@ func (w *webSocketTopologyQueryHandler) handleEvalStmtWebSocket(conn *websocket.
 		return
 	}
 }
+
+func (w *webSocketTopologyQueryHandler) handleExplainStmtWebSocket(conn *websocket.Conn, stmt parser.ExplainStmt, stmtStr string) {
+	plan, err := stmt.Plan()
+	if err != nil {
+		w.ErrLog(err).Error("Cannot process a statement")
+		e := jasco.NewError(bqlStmtProcessingErrorCode, "Cannot process a statement", http.StatusBadRequest, err)
+		e.Meta["error"] = err.Error()
+		e.Meta["statement"] = stmtStr
+		w.sendErr(e)
+		return
+	}
+
+	if err := w.send("result", map[string]interface{}{
+		"plan": plan,
+	}); err != nil {
+		w.ErrLog(err).Error("Cannot send data to the WebSocket client")
+		return
+	}
+}
@@ -102,11 +102,17 @@ func (s *fsUDSStorage) ListTopologies() ([]string, error) {
 	}
 
 	res := []string{}
+	found := map[string]bool{}
 	for _, f := range fs {
 		m := fsUDSStorageFilePathRegexp.FindStringSubmatch(f.Name())
 		if m == nil {
 			continue
 		}
+		// a topology having multiple states has multiple files
+		if found[m[1]] {
+			continue
+		}
+		found[m[1]] = true
 		res = append(res, m[1])
 	}
 	return res, nil
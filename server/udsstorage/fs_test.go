@@ -2,6 +2,7 @@ package udsstorage
 
 import (
 	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
 	"gopkg.in/sensorbee/sensorbee.v0/core"
 	"io"
 	"io/ioutil"
@@ -228,3 +229,67 @@ func TestFS(t *testing.T) {
 		})
 	})
 }
+
+func TestMigrateUDSStorageToFS(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sensorbee_uds_storage_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	Convey("Given an in memory UDSStorage with several saved states", t, func() {
+		from := udf.NewInMemoryUDSStorage()
+		entries := []struct {
+			topology, state, tag, data string
+		}{
+			{"test_topology", "state1", "", "hoge"},
+			{"test_topology", "state1", "v2", "fuga"},
+			{"test_topology", "state2", "", "foo"},
+			{"test_topology2", "state1", "", "bar"},
+		}
+		for _, e := range entries {
+			w, err := from.Save(e.topology, e.state, e.tag)
+			So(err, ShouldBeNil)
+			_, err = io.WriteString(w, e.data)
+			So(err, ShouldBeNil)
+			So(w.Commit(), ShouldBeNil)
+		}
+
+		Convey("When migrating them to a filesystem UDS storage", func() {
+			to, err := NewFS(dir, "")
+			So(err, ShouldBeNil)
+			So(udf.MigrateUDSStorage(from, to), ShouldBeNil)
+
+			Convey("Then all states should be present", func() {
+				ts, err := to.ListTopologies()
+				So(err, ShouldBeNil)
+				So(len(ts), ShouldEqual, 2)
+				So(ts, ShouldContain, "test_topology")
+				So(ts, ShouldContain, "test_topology2")
+
+				st, err := to.List("test_topology")
+				So(err, ShouldBeNil)
+				So(len(st), ShouldEqual, 2)
+				So(st["state1"], ShouldContain, "default")
+				So(st["state1"], ShouldContain, "v2")
+
+				for _, e := range entries {
+					r, err := to.Load(e.topology, e.state, e.tag)
+					So(err, ShouldBeNil)
+					data, err := ioutil.ReadAll(r)
+					r.Close()
+					So(err, ShouldBeNil)
+					So(string(data), ShouldEqual, e.data)
+				}
+			})
+		})
+
+		Convey("When creating a filesystem UDS storage with a missing directory", func() {
+			_, err := NewFS(dir+"/no_such_dir", "")
+
+			Convey("Then it should fail before migration", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}